@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"runtime/debug"
+	"strings"
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/color"
@@ -14,6 +15,7 @@ import (
 	"github.com/rs/zerolog/log"
 	"github.com/spf13/cobra"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/client-go/tools/clientcmd"
 )
 
 const (
@@ -43,6 +45,7 @@ func init() {
 	rootCmd.AddCommand(versionCmd(), infoCmd())
 	initK9sFlags()
 	initK8sFlags()
+	rootCmd.AddCommand(serveCmd())
 }
 
 // Execute root command.
@@ -53,11 +56,7 @@ func Execute() {
 }
 
 func run(cmd *cobra.Command, args []string) error {
-	if err := config.EnsureDirPath(*k9sFlags.LogFile, config.DefaultDirMod); err != nil {
-		return err
-	}
-	mod := os.O_CREATE | os.O_APPEND | os.O_WRONLY
-	file, err := os.OpenFile(*k9sFlags.LogFile, mod, config.DefaultFileMod)
+	file, err := openLogFile()
 	if err != nil {
 		return err
 	}
@@ -76,9 +75,9 @@ func run(cmd *cobra.Command, args []string) error {
 		}
 	}()
 
-	log.Logger = log.Output(zerolog.ConsoleWriter{Out: file})
-
-	zerolog.SetGlobalLevel(parseLevel(*k9sFlags.LogLevel))
+	if *k9sFlags.Batch != "" {
+		return runBatch(*k9sFlags.Batch)
+	}
 	app := view.NewApp(loadConfiguration())
 	if err := app.Init(version, *k9sFlags.RefreshRate); err != nil {
 		return err
@@ -93,6 +92,32 @@ func run(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// runBatch runs a k9s script without the terminal UI.
+func runBatch(path string) error {
+	b := view.NewBatch(loadConfiguration(), out)
+	if err := b.Init(); err != nil {
+		return err
+	}
+
+	return b.RunFile(path)
+}
+
+// openLogFile opens the K9s log file and routes the logger to it.
+func openLogFile() (*os.File, error) {
+	if err := config.EnsureDirPath(*k9sFlags.LogFile, config.DefaultDirMod); err != nil {
+		return nil, err
+	}
+	mod := os.O_CREATE | os.O_APPEND | os.O_WRONLY
+	file, err := os.OpenFile(*k9sFlags.LogFile, mod, config.DefaultFileMod)
+	if err != nil {
+		return nil, err
+	}
+	log.Logger = log.Output(zerolog.ConsoleWriter{Out: file})
+	zerolog.SetGlobalLevel(parseLevel(*k9sFlags.LogLevel))
+
+	return file, nil
+}
+
 func loadConfiguration() *config.Config {
 	log.Info().Msg("🐶 K9s starting up...")
 
@@ -104,6 +129,10 @@ func loadConfiguration() *config.Config {
 		log.Warn().Msg("Unable to locate K9s config. Generating new configuration...")
 	}
 
+	if cc := k9sCfg.K9s.Contexts; cc != nil && len(cc.KubeConfigs) > 0 {
+		mergeKubeConfigs(cc)
+	}
+
 	if *k9sFlags.RefreshRate != config.DefaultRefreshRate {
 		k9sCfg.K9s.OverrideRefreshRate(*k9sFlags.RefreshRate)
 	}
@@ -115,6 +144,7 @@ func loadConfiguration() *config.Config {
 	k9sCfg.K9s.OverrideWrite(*k9sFlags.Write)
 	k9sCfg.K9s.OverrideCommand(*k9sFlags.Command)
 	k9sCfg.K9s.OverrideScreenDumpDir(*k9sFlags.ScreenDumpDir)
+	k9sCfg.K9s.OverrideDebugAddr(*k9sFlags.DebugAddr)
 
 	if err := k9sCfg.Refine(k8sFlags, k9sFlags, k8sCfg); err != nil {
 		log.Error().Err(err).Msgf("refine failed")
@@ -140,6 +170,24 @@ func loadConfiguration() *config.Config {
 	return k9sCfg
 }
 
+// mergeKubeConfigs loads the configured kubeconfig files alongside the
+// KUBECONFIG or --kubeconfig ones. The merged list is exported so shelled out
+// kubectl commands see the same contexts.
+func mergeKubeConfigs(cc *config.Contexts) {
+	var explicit string
+	if k8sFlags.KubeConfig != nil {
+		explicit = *k8sFlags.KubeConfig
+	}
+	paths := cc.KubeConfigPaths(explicit, os.Getenv(clientcmd.RecommendedConfigPathEnvVar))
+	if err := os.Setenv(clientcmd.RecommendedConfigPathEnvVar, strings.Join(paths, string(os.PathListSeparator))); err != nil {
+		log.Error().Err(err).Msgf("Unable to merge kubeconfigs")
+		return
+	}
+	var none string
+	k8sFlags.KubeConfig = &none
+	log.Debug().Msgf("Merged kubeconfigs %v", paths)
+}
+
 func parseLevel(level string) zerolog.Level {
 	switch level {
 	case "trace":
@@ -225,6 +273,18 @@ func initK9sFlags() {
 		"",
 		"Sets a path to a dir for a screen dumps",
 	)
+	rootCmd.Flags().StringVar(
+		k9sFlags.Batch,
+		"batch",
+		"",
+		"Runs a script of k9s commands without the UI and exits",
+	)
+	rootCmd.Flags().StringVar(
+		k9sFlags.DebugAddr,
+		"debug-addr",
+		"",
+		"Serves pprof profiles and expvar self metrics on the given address, ie localhost:6060",
+	)
 	rootCmd.Flags()
 }
 
@@ -0,0 +1,67 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/derailed/k9s/internal/server"
+	"github.com/spf13/cobra"
+)
+
+const (
+	defaultServeAddress = "localhost:7007"
+	apiTokenEnv         = "K9S_API_TOKEN"
+)
+
+func serveCmd() *cobra.Command {
+	var address, token string
+
+	command := cobra.Command{
+		Use:   "serve",
+		Short: "Serve cluster resources over a local REST/WebSocket API",
+		Long:  "Run K9s without the terminal UI and expose table snapshots, logs and actions over a local REST/WebSocket API",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return serve(address, token)
+		},
+	}
+
+	command.Flags().StringVarP(&address, "address", "", defaultServeAddress, "Specify the API listen address")
+	command.Flags().StringVarP(&token, "token", "", "", "Specify the bearer token required by API clients. Defaults to $K9S_API_TOKEN or a random token when blank")
+	command.Flags().AddFlagSet(rootCmd.Flags())
+
+	return &command
+}
+
+func serve(address, token string) error {
+	file, err := openLogFile()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	generated := false
+	if token == "" {
+		token = os.Getenv(apiTokenEnv)
+		generated = token == ""
+	}
+	srv, err := server.NewServer(loadConfiguration(), token)
+	if err != nil {
+		return err
+	}
+	if err := srv.Init(); err != nil {
+		return err
+	}
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+	fmt.Fprintf(out, "K9s API listening on http://%s\n", address)
+	if generated {
+		fmt.Fprintf(out, "API token: %s\n", srv.Token())
+	}
+
+	return srv.ListenAndServe(ctx, address)
+}
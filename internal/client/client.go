@@ -13,8 +13,10 @@ import (
 	authorizationv1 "k8s.io/api/authorization/v1"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/cache"
 	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
 	"k8s.io/client-go/discovery/cached/disk"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
@@ -43,6 +45,7 @@ type APIClient struct {
 	mx                sync.Mutex
 	cache             *cache.LRUExpireCache
 	connOK            bool
+	pingFn            func(*genericclioptions.ConfigFlags) bool
 }
 
 // NewTestAPIClient for testing ONLY!!
@@ -61,6 +64,7 @@ func InitConnection(config *Config) (*APIClient, error) {
 		cache:  cache.NewLRUExpireCache(cacheSize),
 		connOK: true,
 	}
+	registerTelemetry()
 	err := a.supportsMetricsResources()
 	if err != nil {
 		log.Error().Err(err).Msgf("Fail to locate metrics-server")
@@ -234,44 +238,57 @@ func (a *APIClient) ValidNamespaces() ([]v1.Namespace, error) {
 
 // CheckConnectivity return true if api server is cool or false otherwise.
 func (a *APIClient) CheckConnectivity() bool {
+	// Ping outside the lock so a dead api server does not hold up context switches.
+	flags := a.config.Flags()
+	ping := a.ping
+	if a.pingFn != nil {
+		ping = a.pingFn
+	}
+	ok := ping(flags)
+
 	a.mx.Lock()
 	defer a.mx.Unlock()
+	if a.config.Flags() != flags {
+		log.Debug().Msgf("Context switched while checking connectivity. Ignoring result")
+		return a.connOK
+	}
+	if !ok {
+		a.connOK = false
+		a.clearCache()
+		return a.connOK
+	}
+	if !a.connOK {
+		a.reset()
+	}
 
+	return a.connOK
+}
+
+func (a *APIClient) ping(flags *genericclioptions.ConfigFlags) (ok bool) {
 	defer func() {
 		if err := recover(); err != nil {
-			a.connOK = false
-		}
-		if !a.connOK {
-			a.clearCache()
+			ok = false
 		}
 	}()
 
 	// Need reload to pick up any kubeconfig changes.
-	cfg, err := NewConfig(a.config.flags).RESTConfig()
+	cfg, err := NewConfig(flags).RESTConfig()
 	if err != nil {
 		log.Error().Err(err).Msgf("restConfig load failed")
-		a.connOK = false
-		return a.connOK
+		return false
 	}
 	cfg.Timeout = a.config.CallTimeout()
 	client, err := kubernetes.NewForConfig(cfg)
 	if err != nil {
 		log.Error().Err(err).Msgf("Unable to connect to api server")
-		a.connOK = false
-		return a.connOK
+		return false
 	}
-
-	// Check connection
-	if _, err := client.ServerVersion(); err == nil {
-		if !a.connOK {
-			a.reset()
-		}
-	} else {
+	if _, err := client.ServerVersion(); err != nil {
 		log.Error().Err(err).Msgf("can't connect to cluster")
-		a.connOK = false
+		return false
 	}
 
-	return a.connOK
+	return true
 }
 
 // Config return a kubernetes configuration.
@@ -299,7 +316,7 @@ func (a *APIClient) DialLogs() (kubernetes.Interface, error) {
 		return nil, err
 	}
 	cfg.Timeout = 0
-	if a.logClient, err = kubernetes.NewForConfig(cfg); err != nil {
+	if a.logClient, err = kubernetes.NewForConfig(withProtobuf(cfg)); err != nil {
 		return nil, err
 	}
 
@@ -319,16 +336,35 @@ func (a *APIClient) Dial() (kubernetes.Interface, error) {
 	if err != nil {
 		return nil, err
 	}
-	if a.client, err = kubernetes.NewForConfig(cfg); err != nil {
+	if a.client, err = kubernetes.NewForConfig(withProtobuf(cfg)); err != nil {
 		return nil, err
 	}
 
 	return a.client, nil
 }
 
-// RestConfig returns a rest api client.
+// withProtobuf negotiates protobuf encoding for built-in types. Json remains
+// accepted for resources without protobuf support. Custom content types are
+// left untouched.
+func withProtobuf(cfg *restclient.Config) *restclient.Config {
+	if cfg.ContentType != "" {
+		return cfg
+	}
+	cfg.ContentType = runtime.ContentTypeProtobuf
+	cfg.AcceptContentTypes = runtime.ContentTypeProtobuf + "," + runtime.ContentTypeJSON
+
+	return cfg
+}
+
+// RestConfig returns a rest api client. Calls are recorded in the api telemetry.
 func (a *APIClient) RestConfig() (*restclient.Config, error) {
-	return a.config.RESTConfig()
+	cfg, err := a.config.RESTConfig()
+	if err != nil {
+		return nil, err
+	}
+	cfg.Wrap(apiTelemetry.WrapTransport)
+
+	return cfg, nil
 }
 
 // CachedDiscovery returns a cached discovery client.
@@ -422,6 +458,7 @@ func (a *APIClient) reset() {
 	a.client, a.dClient, a.nsClient, a.mxsClient = nil, nil, nil, nil
 	a.cachedClient, a.logClient = nil, nil
 	a.connOK = true
+	apiTelemetry.Reset()
 }
 
 func (a *APIClient) checkCacheBool(key string) (state bool, ok bool) {
@@ -5,6 +5,8 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	restclient "k8s.io/client-go/rest"
 )
 
 func TestCheckCacheBool(t *testing.T) {
@@ -52,3 +54,71 @@ func TestCheckCacheBool(t *testing.T) {
 		})
 	}
 }
+
+func TestWithProtobuf(t *testing.T) {
+	uu := map[string]struct {
+		contentType, e, accept string
+	}{
+		"default": {
+			e:      "application/vnd.kubernetes.protobuf",
+			accept: "application/vnd.kubernetes.protobuf,application/json",
+		},
+		"custom": {
+			contentType: "application/json",
+			e:           "application/json",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			cfg := withProtobuf(&restclient.Config{ContentConfig: restclient.ContentConfig{ContentType: u.contentType}})
+			assert.Equal(t, u.e, cfg.ContentType)
+			assert.Equal(t, u.accept, cfg.AcceptContentTypes)
+		})
+	}
+}
+
+func TestCheckConnectivity(t *testing.T) {
+	uu := map[string]struct {
+		connOK, ping, e bool
+	}{
+		"lost": {
+			connOK: true,
+		},
+		"back": {
+			ping: true,
+			e:    true,
+		},
+		"still-down": {},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			c := NewTestAPIClient()
+			c.config = NewConfig(genericclioptions.NewConfigFlags(false))
+			c.connOK = u.connOK
+			c.pingFn = func(*genericclioptions.ConfigFlags) bool { return u.ping }
+
+			assert.Equal(t, u.e, c.CheckConnectivity())
+			assert.Equal(t, u.e, c.ConnectionOK())
+		})
+	}
+}
+
+func TestCheckConnectivityStalePing(t *testing.T) {
+	c := NewTestAPIClient()
+	c.config = NewConfig(genericclioptions.NewConfigFlags(false))
+	c.connOK = true
+	c.pingFn = func(*genericclioptions.ConfigFlags) bool {
+		// Context switched while the old cluster ping was in flight.
+		c.config.mutex.Lock()
+		c.config.flags = genericclioptions.NewConfigFlags(false)
+		c.config.mutex.Unlock()
+		return false
+	}
+
+	assert.True(t, c.CheckConnectivity())
+	assert.True(t, c.ConnectionOK())
+}
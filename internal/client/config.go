@@ -37,10 +37,11 @@ func NewConfig(f *genericclioptions.ConfigFlags) *Config {
 
 // CallTimeout returns the call timeout if set or the default if not set.
 func (c *Config) CallTimeout() time.Duration {
-	if !isSet(c.flags.Timeout) {
+	flags := c.Flags()
+	if !isSet(flags.Timeout) {
 		return defaultCallTimeoutDuration
 	}
-	dur, err := time.ParseDuration(*c.flags.Timeout)
+	dur, err := time.ParseDuration(*flags.Timeout)
 	if err != nil {
 		return defaultCallTimeoutDuration
 	}
@@ -54,6 +55,9 @@ func (c *Config) RESTConfig() (*restclient.Config, error) {
 
 // Flags returns configuration flags.
 func (c *Config) Flags() *genericclioptions.ConfigFlags {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
 	return c.flags
 }
 
@@ -62,7 +66,7 @@ func (c *Config) RawConfig() (clientcmdapi.Config, error) {
 }
 
 func (c *Config) clientConfig() clientcmd.ClientConfig {
-	return c.flags.ToRawKubeConfigLoader()
+	return c.Flags().ToRawKubeConfigLoader()
 }
 
 func (c *Config) reset() {}
@@ -72,10 +76,15 @@ func (c *Config) SwitchContext(name string) error {
 	if _, err := c.GetContext(name); err != nil {
 		return fmt.Errorf("context %q does not exist", name)
 	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
 	flags := genericclioptions.NewConfigFlags(UsePersistentConfig)
 	flags.Context = &name
 	flags.Timeout = c.flags.Timeout
 	flags.KubeConfig = c.flags.KubeConfig
+	flags.Impersonate = c.flags.Impersonate
+	flags.ImpersonateGroup = c.flags.ImpersonateGroup
 	c.flags = flags
 
 	return nil
@@ -83,8 +92,9 @@ func (c *Config) SwitchContext(name string) error {
 
 // CurrentContextName returns the currently active config context.
 func (c *Config) CurrentContextName() (string, error) {
-	if isSet(c.flags.Context) {
-		return *c.flags.Context, nil
+	flags := c.Flags()
+	if isSet(flags.Context) {
+		return *flags.Context, nil
 	}
 	cfg, err := c.RawConfig()
 	if err != nil {
@@ -162,8 +172,9 @@ func (c *Config) ClusterNameFromContext(context string) (string, error) {
 
 // CurrentClusterName returns the active cluster name.
 func (c *Config) CurrentClusterName() (string, error) {
-	if isSet(c.flags.ClusterName) {
-		return *c.flags.ClusterName, nil
+	flags := c.Flags()
+	if isSet(flags.ClusterName) {
+		return *flags.ClusterName, nil
 	}
 	cfg, err := c.RawConfig()
 	if err != nil {
@@ -198,8 +209,9 @@ func (c *Config) ClusterNames() (map[string]struct{}, error) {
 
 // CurrentGroupNames retrieves the active group names.
 func (c *Config) CurrentGroupNames() ([]string, error) {
-	if areSet(c.flags.ImpersonateGroup) {
-		return *c.flags.ImpersonateGroup, nil
+	flags := c.Flags()
+	if areSet(flags.ImpersonateGroup) {
+		return *flags.ImpersonateGroup, nil
 	}
 
 	return []string{}, errors.New("unable to locate current group")
@@ -207,8 +219,9 @@ func (c *Config) CurrentGroupNames() ([]string, error) {
 
 // ImpersonateGroups retrieves the active groups if set on the CLI.
 func (c *Config) ImpersonateGroups() (string, error) {
-	if areSet(c.flags.ImpersonateGroup) {
-		return strings.Join(*c.flags.ImpersonateGroup, ","), nil
+	flags := c.Flags()
+	if areSet(flags.ImpersonateGroup) {
+		return strings.Join(*flags.ImpersonateGroup, ","), nil
 	}
 
 	return "", errors.New("no groups set")
@@ -216,21 +229,38 @@ func (c *Config) ImpersonateGroups() (string, error) {
 
 // ImpersonateUser retrieves the active user name if set on the CLI.
 func (c *Config) ImpersonateUser() (string, error) {
-	if isSet(c.flags.Impersonate) {
-		return *c.flags.Impersonate, nil
+	flags := c.Flags()
+	if isSet(flags.Impersonate) {
+		return *flags.Impersonate, nil
 	}
 
 	return "", errors.New("no user set")
 }
 
+// Impersonate sets the user and groups to act as. An empty user and no groups
+// clears impersonation. The connection must be reset for this to take effect.
+func (c *Config) Impersonate(user string, groups []string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.flags.Impersonate, c.flags.ImpersonateGroup = &user, &groups
+}
+
+// IsImpersonating returns true if an impersonation user or groups are set.
+func (c *Config) IsImpersonating() bool {
+	flags := c.Flags()
+	return isSet(flags.Impersonate) || areSet(flags.ImpersonateGroup)
+}
+
 // CurrentUserName retrieves the active user name.
 func (c *Config) CurrentUserName() (string, error) {
-	if isSet(c.flags.Impersonate) {
-		return *c.flags.Impersonate, nil
+	flags := c.Flags()
+	if isSet(flags.Impersonate) {
+		return *flags.Impersonate, nil
 	}
 
-	if isSet(c.flags.AuthInfoName) {
-		return *c.flags.AuthInfoName, nil
+	if isSet(flags.AuthInfoName) {
+		return *flags.AuthInfoName, nil
 	}
 
 	cfg, err := c.RawConfig()
@@ -239,8 +269,8 @@ func (c *Config) CurrentUserName() (string, error) {
 	}
 
 	current := cfg.CurrentContext
-	if isSet(c.flags.Context) {
-		current = *c.flags.Context
+	if isSet(flags.Context) {
+		current = *flags.Context
 	}
 	if ctx, ok := cfg.Contexts[current]; ok {
 		return ctx.AuthInfo, nil
@@ -258,9 +288,6 @@ func (c *Config) CurrentNamespaceName() (string, error) {
 
 // ConfigAccess return the current kubeconfig api server access configuration.
 func (c *Config) ConfigAccess() (clientcmd.ConfigAccess, error) {
-	c.mutex.RLock()
-	defer c.mutex.RUnlock()
-
 	return c.clientConfig().ConfigAccess(), nil
 }
 
@@ -180,6 +180,35 @@ func TestConfigSwitchContext(t *testing.T) {
 	assert.Equal(t, "blee", ctx)
 }
 
+func TestConfigImpersonate(t *testing.T) {
+	cluster, kubeConfig := "duh", "./testdata/config"
+	flags := genericclioptions.ConfigFlags{
+		KubeConfig:  &kubeConfig,
+		ClusterName: &cluster,
+	}
+
+	cfg := client.NewConfig(&flags)
+	assert.False(t, cfg.IsImpersonating())
+
+	cfg.Impersonate("fred", []string{"g1", "g2"})
+	assert.True(t, cfg.IsImpersonating())
+	assert.Nil(t, cfg.SwitchContext("blee"))
+	u, err := cfg.ImpersonateUser()
+	assert.Nil(t, err)
+	assert.Equal(t, "fred", u)
+	gg, err := cfg.ImpersonateGroups()
+	assert.Nil(t, err)
+	assert.Equal(t, "g1,g2", gg)
+	u, err = cfg.CurrentUserName()
+	assert.Nil(t, err)
+	assert.Equal(t, "fred", u)
+
+	cfg.Impersonate("", nil)
+	assert.False(t, cfg.IsImpersonating())
+	_, err = cfg.ImpersonateUser()
+	assert.NotNil(t, err)
+}
+
 func TestConfigClusterNameFromContext(t *testing.T) {
 	cluster, kubeConfig := "duh", "./testdata/config"
 	flags := genericclioptions.ConfigFlags{
@@ -40,7 +40,8 @@ func ResetMetrics() {
 type MetricsServer struct {
 	Connection
 
-	cache *cache.LRUExpireCache
+	cache   *cache.LRUExpireCache
+	history *MetricsHistory
 }
 
 // NewMetricsServer return a metric server instance.
@@ -48,6 +49,7 @@ func NewMetricsServer(c Connection) *MetricsServer {
 	return &MetricsServer{
 		Connection: c,
 		cache:      cache.NewLRUExpireCache(mxCacheSize),
+		history:    NewMetricsHistory(mxHistorySize),
 	}
 }
 
@@ -239,10 +241,44 @@ func (m *MetricsServer) FetchPodsMetrics(ctx context.Context, ns string) (*mv1be
 		return mx, err
 	}
 	m.cache.Add(key, mxList, mxCacheExpiry)
+	m.recordPods(mxList)
 
 	return mxList, err
 }
 
+// ClearPodsMetrics evicts cached pods metrics for a given namespace.
+func (m *MetricsServer) ClearPodsMetrics(ns string) {
+	if ns == NamespaceAll {
+		ns = AllNamespaces
+	}
+	m.cache.Remove(FQN(ns, "pods"))
+}
+
+// PodsHistory returns the pods and containers metrics history.
+func (m *MetricsServer) PodsHistory() *MetricsHistory {
+	return m.history
+}
+
+func (m *MetricsServer) recordPods(mm *mv1beta1.PodMetricsList) {
+	for i := range mm.Items {
+		mx := mm.Items[i]
+		fqn := FQN(mx.Namespace, mx.Name)
+		s := MetricsSample{At: mx.Timestamp.Time}
+		for _, co := range mx.Containers {
+			cs := MetricsSample{
+				At:  s.At,
+				CPU: co.Usage.Cpu().MilliValue(),
+				MEM: co.Usage.Memory().Value(),
+			}
+			m.history.Record(fqn+":"+co.Name, cs)
+			s.CPU += cs.CPU
+			s.MEM += cs.MEM
+		}
+		m.history.Record(fqn, s)
+	}
+	m.history.Prune(time.Now().Add(-mxHistoryExpiry))
+}
+
 // FetchContainersMetrics returns a pod's containers metrics.
 func (m *MetricsServer) FetchContainersMetrics(ctx context.Context, fqn string) (ContainersMetrics, error) {
 	mm, err := m.FetchPodMetrics(ctx, fqn)
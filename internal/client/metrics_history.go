@@ -0,0 +1,77 @@
+package client
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	mxHistorySize   = 8
+	mxHistoryExpiry = 10 * time.Minute
+)
+
+// MetricsSample represents a point in time resource usage.
+type MetricsSample struct {
+	At  time.Time
+	CPU int64
+	MEM int64
+}
+
+// MetricsHistory tracks a rolling history of resources usage samples.
+type MetricsHistory struct {
+	size    int
+	samples map[string][]MetricsSample
+	mx      sync.RWMutex
+}
+
+// NewMetricsHistory returns a new history retaining up to size samples per resource.
+func NewMetricsHistory(size int) *MetricsHistory {
+	return &MetricsHistory{
+		size:    size,
+		samples: make(map[string][]MetricsSample),
+	}
+}
+
+// Record adds a new sample for a given resource. Samples older than the
+// latest recorded one are ignored.
+func (h *MetricsHistory) Record(id string, s MetricsSample) {
+	h.mx.Lock()
+	defer h.mx.Unlock()
+
+	ss := h.samples[id]
+	if n := len(ss); n > 0 && !s.At.After(ss[n-1].At) {
+		return
+	}
+	ss = append(ss, s)
+	if len(ss) > h.size {
+		ss = ss[len(ss)-h.size:]
+	}
+	h.samples[id] = ss
+}
+
+// Samples returns a resource samples, oldest first.
+func (h *MetricsHistory) Samples(id string) []MetricsSample {
+	h.mx.RLock()
+	defer h.mx.RUnlock()
+
+	ss := h.samples[id]
+	if len(ss) == 0 {
+		return nil
+	}
+	cc := make([]MetricsSample, len(ss))
+	copy(cc, ss)
+
+	return cc
+}
+
+// Prune evicts resources that have not been sampled since a given time.
+func (h *MetricsHistory) Prune(since time.Time) {
+	h.mx.Lock()
+	defer h.mx.Unlock()
+
+	for id, ss := range h.samples {
+		if len(ss) == 0 || ss[len(ss)-1].At.Before(since) {
+			delete(h.samples, id)
+		}
+	}
+}
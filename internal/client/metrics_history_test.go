@@ -0,0 +1,34 @@
+package client_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetricsHistoryRecord(t *testing.T) {
+	h := client.NewMetricsHistory(3)
+	t0 := time.Now()
+	for i := 0; i < 5; i++ {
+		h.Record("fred/p1", client.MetricsSample{At: t0.Add(time.Duration(i) * time.Minute), CPU: int64(i)})
+	}
+	h.Record("fred/p1", client.MetricsSample{At: t0, CPU: 100})
+
+	ss := h.Samples("fred/p1")
+	assert.Equal(t, 3, len(ss))
+	assert.Equal(t, []int64{2, 3, 4}, []int64{ss[0].CPU, ss[1].CPU, ss[2].CPU})
+	assert.Nil(t, h.Samples("fred/p2"))
+}
+
+func TestMetricsHistoryPrune(t *testing.T) {
+	h := client.NewMetricsHistory(3)
+	t0 := time.Now()
+	h.Record("fred/p1", client.MetricsSample{At: t0.Add(-time.Hour)})
+	h.Record("fred/p2", client.MetricsSample{At: t0})
+	h.Prune(t0.Add(-time.Minute))
+
+	assert.Nil(t, h.Samples("fred/p1"))
+	assert.Equal(t, 1, len(h.Samples("fred/p2")))
+}
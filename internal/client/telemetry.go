@@ -0,0 +1,306 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/client-go/tools/metrics"
+)
+
+const (
+	// throttleThreshold marks rate limiter waits as client side throttling.
+	throttleThreshold = 10 * time.Millisecond
+
+	telemetryWindow     = time.Minute
+	telemetryMaxSamples = 500
+	degradedMinSamples  = 5
+	degradedErrorRate   = 0.25
+	degradedLatency     = time.Second
+)
+
+var apiTelemetry = NewTelemetry()
+
+// APITelemetry returns the api server calls telemetry.
+func APITelemetry() *Telemetry {
+	return apiTelemetry
+}
+
+// registerTelemetry hooks the rate limiter waits into the telemetry. Client-go
+// only honors the first registration.
+func registerTelemetry() {
+	metrics.Register(metrics.RegisterOpts{
+		RateLimiterLatency: limiterLatency{apiTelemetry},
+	})
+}
+
+// APIStat tracks api calls for a given verb and resource.
+type APIStat struct {
+	Verb, GVR                 string
+	Count, Errors, Retries    int
+	Throttled                 int
+	Latency, MaxLatency, Wait time.Duration
+	Last                      time.Time
+}
+
+// AvgLatency returns the average call latency.
+func (s APIStat) AvgLatency() time.Duration {
+	if s.Count == 0 {
+		return 0
+	}
+	return s.Latency / time.Duration(s.Count)
+}
+
+// AvgWait returns the average rate limiter wait of throttled calls.
+func (s APIStat) AvgWait() time.Duration {
+	if s.Throttled == 0 {
+		return 0
+	}
+	return s.Wait / time.Duration(s.Throttled)
+}
+
+type apiSample struct {
+	at      time.Time
+	latency time.Duration
+	failed  bool
+}
+
+// Telemetry tracks api server calls latency, failures and throttling.
+type Telemetry struct {
+	stats   map[string]*APIStat
+	samples []apiSample
+	mx      sync.RWMutex
+}
+
+// NewTelemetry returns a new telemetry.
+func NewTelemetry() *Telemetry {
+	return &Telemetry{stats: make(map[string]*APIStat)}
+}
+
+// WrapTransport records the calls going through a given transport.
+func (t *Telemetry) WrapTransport(rt http.RoundTripper) http.RoundTripper {
+	return &telemetryTransport{telemetry: t, rt: rt}
+}
+
+// Observe records an api call outcome. A zero code denotes a transport error.
+func (t *Telemetry) Observe(method string, u *url.URL, code int, retry bool, latency time.Duration) {
+	verb, gvr := apiCall(method, u)
+	failed := code == 0 || code == http.StatusTooManyRequests || code >= http.StatusInternalServerError
+
+	t.mx.Lock()
+	defer t.mx.Unlock()
+
+	s := t.statFor(verb, gvr)
+	s.Count++
+	if code == 0 || code >= http.StatusBadRequest {
+		s.Errors++
+	}
+	if retry {
+		s.Retries++
+	}
+	s.Latency += latency
+	if latency > s.MaxLatency {
+		s.MaxLatency = latency
+	}
+	s.Last = time.Now()
+
+	t.samples = append(t.samples, apiSample{at: s.Last, latency: latency, failed: failed})
+	t.pruneSamples(s.Last)
+}
+
+// ObserveWait records a rate limiter wait. Short waits are not deemed throttling.
+func (t *Telemetry) ObserveWait(method string, u *url.URL, wait time.Duration) {
+	if wait < throttleThreshold {
+		return
+	}
+	verb, gvr := apiCall(method, u)
+
+	t.mx.Lock()
+	defer t.mx.Unlock()
+
+	s := t.statFor(verb, gvr)
+	s.Throttled++
+	s.Wait += wait
+}
+
+// Stats returns the calls stats sorted by verb and resource.
+func (t *Telemetry) Stats() []APIStat {
+	t.mx.RLock()
+	defer t.mx.RUnlock()
+
+	ss := make([]APIStat, 0, len(t.stats))
+	for _, s := range t.stats {
+		ss = append(ss, *s)
+	}
+	sort.Slice(ss, func(i, j int) bool {
+		if ss[i].GVR == ss[j].GVR {
+			return ss[i].Verb < ss[j].Verb
+		}
+		return ss[i].GVR < ss[j].GVR
+	})
+
+	return ss
+}
+
+// Degraded returns the reason the api server is deemed degraded based on the
+// most recent calls or blank if all is well.
+func (t *Telemetry) Degraded() string {
+	t.mx.RLock()
+	defer t.mx.RUnlock()
+
+	since := time.Now().Add(-telemetryWindow)
+	var (
+		count, failed int
+		latency       time.Duration
+	)
+	for _, s := range t.samples {
+		if s.at.Before(since) {
+			continue
+		}
+		count++
+		latency += s.latency
+		if s.failed {
+			failed++
+		}
+	}
+	if count < degradedMinSamples {
+		return ""
+	}
+	if rate := float64(failed) / float64(count); rate >= degradedErrorRate {
+		return fmt.Sprintf("%d%% of api calls failing", int(rate*100))
+	}
+	if avg := latency / time.Duration(count); avg >= degradedLatency {
+		return fmt.Sprintf("api calls averaging %s", avg.Round(time.Millisecond))
+	}
+
+	return ""
+}
+
+// Reset clears out all recorded calls.
+func (t *Telemetry) Reset() {
+	t.mx.Lock()
+	defer t.mx.Unlock()
+
+	t.stats = make(map[string]*APIStat)
+	t.samples = nil
+}
+
+func (t *Telemetry) statFor(verb, gvr string) *APIStat {
+	key := verb + " " + gvr
+	s, ok := t.stats[key]
+	if !ok {
+		s = &APIStat{Verb: verb, GVR: gvr}
+		t.stats[key] = s
+	}
+
+	return s
+}
+
+func (t *Telemetry) pruneSamples(now time.Time) {
+	since, i := now.Add(-telemetryWindow), 0
+	for i < len(t.samples) && t.samples[i].at.Before(since) {
+		i++
+	}
+	if n := len(t.samples) - i; n > telemetryMaxSamples {
+		i += n - telemetryMaxSamples
+	}
+	if i > 0 {
+		t.samples = append(t.samples[:0], t.samples[i:]...)
+	}
+}
+
+// apiCall derives a call verb and resource from a request method and path.
+func apiCall(method string, u *url.URL) (string, string) {
+	if u == nil {
+		return strings.ToLower(method), ""
+	}
+	tokens := strings.Split(strings.Trim(u.Path, "/"), "/")
+	var gv string
+	switch {
+	case len(tokens) >= 2 && tokens[0] == "api":
+		gv, tokens = tokens[1], tokens[2:]
+	case len(tokens) >= 3 && tokens[0] == "apis":
+		gv, tokens = tokens[1]+"/"+tokens[2], tokens[3:]
+	default:
+		return strings.ToLower(method), u.Path
+	}
+	if len(tokens) == 0 {
+		return strings.ToLower(method), "discovery"
+	}
+
+	watch := u.Query().Get("watch") == "true" || u.Query().Get("watch") == "1"
+	if tokens[0] == "watch" {
+		watch, tokens = true, tokens[1:]
+	}
+	if len(tokens) >= 3 && tokens[0] == "namespaces" {
+		tokens = tokens[2:]
+	}
+	gvr, named := gv+"/"+tokens[0], len(tokens) > 1
+	if len(tokens) > 2 {
+		gvr += "/" + tokens[2]
+	}
+
+	switch method {
+	case http.MethodGet:
+		switch {
+		case watch:
+			return "watch", gvr
+		case named:
+			return "get", gvr
+		default:
+			return "list", gvr
+		}
+	case http.MethodPost:
+		return "create", gvr
+	case http.MethodPut:
+		return "update", gvr
+	case http.MethodPatch:
+		return "patch", gvr
+	case http.MethodDelete:
+		if named {
+			return "delete", gvr
+		}
+		return "deletecollection", gvr
+	default:
+		return strings.ToLower(method), gvr
+	}
+}
+
+// telemetryTransport records the calls round tripping to the api server.
+type telemetryTransport struct {
+	telemetry *Telemetry
+	rt        http.RoundTripper
+}
+
+// RoundTrip executes a request and records its outcome.
+func (t *telemetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	resp, err := t.rt.RoundTrip(req)
+	var (
+		code  int
+		retry bool
+	)
+	if err == nil {
+		code = resp.StatusCode
+		retry = resp.Header.Get("Retry-After") != "" &&
+			(code == http.StatusTooManyRequests || code >= http.StatusInternalServerError)
+	}
+	t.telemetry.Observe(req.Method, req.URL, code, retry, time.Since(start))
+
+	return resp, err
+}
+
+// limiterLatency records client-go rate limiter waits.
+type limiterLatency struct {
+	telemetry *Telemetry
+}
+
+// Observe records a rate limiter wait.
+func (l limiterLatency) Observe(_ context.Context, verb string, u url.URL, latency time.Duration) {
+	l.telemetry.ObserveWait(verb, &u, latency)
+}
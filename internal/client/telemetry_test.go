@@ -0,0 +1,159 @@
+package client
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPICall(t *testing.T) {
+	uu := map[string]struct {
+		method, path string
+		verb, gvr    string
+	}{
+		"list": {
+			method: http.MethodGet,
+			path:   "/api/v1/namespaces/default/pods",
+			verb:   "list",
+			gvr:    "v1/pods",
+		},
+		"get": {
+			method: http.MethodGet,
+			path:   "/apis/apps/v1/namespaces/default/deployments/fred",
+			verb:   "get",
+			gvr:    "apps/v1/deployments",
+		},
+		"template": {
+			method: http.MethodGet,
+			path:   "/api/v1/namespaces/{namespace}/pods/{name}",
+			verb:   "get",
+			gvr:    "v1/pods",
+		},
+		"watch": {
+			method: http.MethodGet,
+			path:   "/api/v1/pods?watch=true",
+			verb:   "watch",
+			gvr:    "v1/pods",
+		},
+		"clusterList": {
+			method: http.MethodGet,
+			path:   "/api/v1/namespaces",
+			verb:   "list",
+			gvr:    "v1/namespaces",
+		},
+		"clusterGet": {
+			method: http.MethodGet,
+			path:   "/api/v1/namespaces/fred",
+			verb:   "get",
+			gvr:    "v1/namespaces",
+		},
+		"subresource": {
+			method: http.MethodGet,
+			path:   "/api/v1/namespaces/default/pods/fred/log",
+			verb:   "get",
+			gvr:    "v1/pods/log",
+		},
+		"patch": {
+			method: http.MethodPatch,
+			path:   "/apis/apps/v1/namespaces/default/deployments/fred",
+			verb:   "patch",
+			gvr:    "apps/v1/deployments",
+		},
+		"deleteCollection": {
+			method: http.MethodDelete,
+			path:   "/api/v1/namespaces/default/pods",
+			verb:   "deletecollection",
+			gvr:    "v1/pods",
+		},
+		"discovery": {
+			method: http.MethodGet,
+			path:   "/apis/apps/v1",
+			verb:   "get",
+			gvr:    "discovery",
+		},
+		"nonResource": {
+			method: http.MethodGet,
+			path:   "/version",
+			verb:   "get",
+			gvr:    "/version",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			url, err := url.Parse(u.path)
+			assert.Nil(t, err)
+			verb, gvr := apiCall(u.method, url)
+			assert.Equal(t, u.verb, verb)
+			assert.Equal(t, u.gvr, gvr)
+		})
+	}
+}
+
+func TestTelemetryObserve(t *testing.T) {
+	tm := NewTelemetry()
+	u, _ := url.Parse("/api/v1/namespaces/default/pods")
+	tm.Observe(http.MethodGet, u, http.StatusOK, false, 10*time.Millisecond)
+	tm.Observe(http.MethodGet, u, http.StatusTooManyRequests, true, 30*time.Millisecond)
+	tm.ObserveWait(http.MethodGet, u, time.Millisecond)
+	tm.ObserveWait(http.MethodGet, u, 200*time.Millisecond)
+
+	ss := tm.Stats()
+	assert.Equal(t, 1, len(ss))
+	s := ss[0]
+	assert.Equal(t, "list", s.Verb)
+	assert.Equal(t, "v1/pods", s.GVR)
+	assert.Equal(t, 2, s.Count)
+	assert.Equal(t, 1, s.Errors)
+	assert.Equal(t, 1, s.Retries)
+	assert.Equal(t, 1, s.Throttled)
+	assert.Equal(t, 20*time.Millisecond, s.AvgLatency())
+	assert.Equal(t, 30*time.Millisecond, s.MaxLatency)
+	assert.Equal(t, 200*time.Millisecond, s.AvgWait())
+
+	tm.Reset()
+	assert.Equal(t, 0, len(tm.Stats()))
+}
+
+func TestTelemetryDegraded(t *testing.T) {
+	uu := map[string]struct {
+		codes   []int
+		latency time.Duration
+		e       string
+	}{
+		"healthy": {
+			codes:   []int{200, 200, 200, 404, 200},
+			latency: 10 * time.Millisecond,
+		},
+		"tooFew": {
+			codes:   []int{500, 500},
+			latency: 10 * time.Millisecond,
+		},
+		"failing": {
+			codes:   []int{200, 503, 429, 0, 200},
+			latency: 10 * time.Millisecond,
+			e:       "60% of api calls failing",
+		},
+		"slow": {
+			codes:   []int{200, 200, 200, 200, 200},
+			latency: 2 * time.Second,
+			e:       "api calls averaging 2s",
+		},
+	}
+
+	u, _ := url.Parse("/api/v1/pods")
+	for k := range uu {
+		u1 := uu[k]
+		t.Run(k, func(t *testing.T) {
+			tm := NewTelemetry()
+			for _, c := range u1.codes {
+				tm.Observe(http.MethodGet, u, c, false, u1.latency)
+			}
+			assert.Equal(t, u1.e, tm.Degraded())
+		})
+	}
+}
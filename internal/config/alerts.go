@@ -0,0 +1,94 @@
+package config
+
+import (
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// CrashLoopAlert raises alerts for pods containers in CrashLoopBackOff.
+	CrashLoopAlert = "crashLoop"
+
+	// NodeNotReadyAlert raises alerts for nodes not ready.
+	NodeNotReadyAlert = "nodeNotReady"
+
+	// PVCPendingAlert raises alerts for claims pending for too long.
+	PVCPendingAlert = "pvcPending"
+
+	defaultPVCPendingAfter = "5m"
+)
+
+// AlertRule tracks a cluster alert condition.
+type AlertRule struct {
+	// Name the rule name.
+	Name string `yaml:"name"`
+
+	// Kind the alert condition, one of crashLoop, nodeNotReady or pvcPending.
+	Kind string `yaml:"kind"`
+
+	// After how long the condition must hold before the alert is raised, ie 5m.
+	After string `yaml:"after,omitempty"`
+
+	// Command an optional shell command executed when the alert is raised.
+	Command string `yaml:"command,omitempty"`
+
+	// Webhook an optional url the alert is posted to when raised.
+	Webhook string `yaml:"webhook,omitempty"`
+}
+
+// Grace returns how long the condition must hold before alerting.
+func (r AlertRule) Grace() time.Duration {
+	if r.After == "" {
+		return 0
+	}
+	d, err := time.ParseDuration(r.After)
+	if err != nil {
+		log.Warn().Err(err).Msgf("Invalid alert rule %q duration", r.Name)
+		return 0
+	}
+
+	return d
+}
+
+// Alerts tracks the cluster alerts watcher options.
+type Alerts struct {
+	// Enable turns on the background alerts watcher.
+	Enable bool `yaml:"enable"`
+
+	// Rules the alert rules to evaluate.
+	Rules []AlertRule `yaml:"rules,omitempty"`
+}
+
+// NewAlerts returns a new instance.
+func NewAlerts() *Alerts {
+	return &Alerts{
+		Rules: []AlertRule{
+			{Name: CrashLoopAlert, Kind: CrashLoopAlert},
+			{Name: NodeNotReadyAlert, Kind: NodeNotReadyAlert},
+			{Name: PVCPendingAlert, Kind: PVCPendingAlert, After: defaultPVCPendingAfter},
+		},
+	}
+}
+
+// Validate ensures the alert rules are valid.
+func (a *Alerts) Validate() {
+	if len(a.Rules) == 0 {
+		a.Rules = NewAlerts().Rules
+		return
+	}
+	rr := make([]AlertRule, 0, len(a.Rules))
+	for _, r := range a.Rules {
+		switch r.Kind {
+		case CrashLoopAlert, NodeNotReadyAlert, PVCPendingAlert:
+		default:
+			log.Warn().Msgf("Skipping alert rule %q with unknown kind %q", r.Name, r.Kind)
+			continue
+		}
+		if r.Name == "" {
+			r.Name = r.Kind
+		}
+		rr = append(rr, r)
+	}
+	a.Rules = rr
+}
@@ -0,0 +1,55 @@
+package config_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAlertsValidate(t *testing.T) {
+	uu := map[string]struct {
+		rules, e []config.AlertRule
+	}{
+		"defaults": {
+			e: config.NewAlerts().Rules,
+		},
+		"unknown": {
+			rules: []config.AlertRule{
+				{Name: "r1", Kind: "blee"},
+				{Kind: config.NodeNotReadyAlert},
+			},
+			e: []config.AlertRule{
+				{Name: config.NodeNotReadyAlert, Kind: config.NodeNotReadyAlert},
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			a := config.Alerts{Rules: u.rules}
+			a.Validate()
+			assert.Equal(t, u.e, a.Rules)
+		})
+	}
+}
+
+func TestAlertRuleGrace(t *testing.T) {
+	uu := map[string]struct {
+		after string
+		e     time.Duration
+	}{
+		"none":    {},
+		"minutes": {after: "5m", e: 5 * time.Minute},
+		"toast":   {after: "blee"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, config.AlertRule{After: u.after}.Grace())
+		})
+	}
+}
@@ -3,6 +3,7 @@ package config
 import (
 	"net/http"
 	"os"
+	"time"
 
 	"gopkg.in/yaml.v2"
 )
@@ -31,8 +32,9 @@ type (
 
 	// Benchmark represents a generic benchmark.
 	Benchmark struct {
-		C int `yaml:"concurrency"`
-		N int `yaml:"requests"`
+		C        int           `yaml:"concurrency"`
+		N        int           `yaml:"requests"`
+		Duration time.Duration `yaml:"duration,omitempty"`
 	}
 
 	// HTTP represents an http request.
@@ -47,11 +49,12 @@ type (
 
 	// BenchConfig represents a service benchmark.
 	BenchConfig struct {
-		Name string
-		C    int  `yaml:"concurrency"`
-		N    int  `yaml:"requests"`
-		Auth Auth `yaml:"auth"`
-		HTTP HTTP `yaml:"http"`
+		Name     string
+		C        int           `yaml:"concurrency"`
+		N        int           `yaml:"requests"`
+		Duration time.Duration `yaml:"duration,omitempty"`
+		Auth     Auth          `yaml:"auth"`
+		HTTP     HTTP          `yaml:"http"`
 	}
 )
 
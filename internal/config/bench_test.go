@@ -3,6 +3,7 @@ package config
 import (
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -70,6 +71,7 @@ func TestBenchServiceLoad(t *testing.T) {
 		body               string
 		auth               Auth
 		headers            http.Header
+		duration           time.Duration
 	}{
 		"s1": {
 			"default/nginx",
@@ -82,6 +84,7 @@ func TestBenchServiceLoad(t *testing.T) {
 			`{"fred": "blee"}`,
 			Auth{"fred", "blee"},
 			http.Header{"Accept": []string{"text/html"}, "Content-Type": []string{"application/json"}},
+			0,
 		},
 		"s2": {
 			"blee/fred",
@@ -94,6 +97,7 @@ func TestBenchServiceLoad(t *testing.T) {
 			`{"fred": "blee"}`,
 			Auth{"fred", "blee"},
 			http.Header{"Accept": []string{"text/html"}, "Content-Type": []string{"application/json"}},
+			30 * time.Second,
 		},
 	}
 
@@ -114,6 +118,7 @@ func TestBenchServiceLoad(t *testing.T) {
 			assert.Equal(t, u.body, svc.HTTP.Body)
 			assert.Equal(t, u.auth, svc.Auth)
 			assert.Equal(t, u.headers, svc.HTTP.Headers)
+			assert.Equal(t, u.duration, svc.Duration)
 		})
 	}
 }
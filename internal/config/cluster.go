@@ -11,6 +11,7 @@ type Cluster struct {
 	View               *View         `yaml:"view"`
 	FeatureGates       *FeatureGates `yaml:"featureGates"`
 	ShellPod           *ShellPod     `yaml:"shellPod"`
+	DebugPod           *DebugPod     `yaml:"debugPod,omitempty"`
 	PortForwardAddress string        `yaml:"portForwardAddress"`
 }
 
@@ -52,3 +53,13 @@ func (c *Cluster) Validate(conn client.Connection, ks KubeSettings) {
 	}
 	c.ShellPod.Validate(conn, ks)
 }
+
+// DebugPodConfig returns the troubleshooting pod settings.
+func (c *Cluster) DebugPodConfig() *DebugPod {
+	if c.DebugPod == nil {
+		return NewDebugPod()
+	}
+	c.DebugPod.Validate()
+
+	return c.DebugPod
+}
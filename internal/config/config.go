@@ -21,6 +21,14 @@ var (
 	K9sConfigFile = filepath.Join(K9sHome(), "config.yml")
 	// K9sDefaultScreenDumpDir represents a default directory where K9s screen dumps will be persisted.
 	K9sDefaultScreenDumpDir = filepath.Join(os.TempDir(), fmt.Sprintf("k9s-screens-%s", MustK9sUser()))
+	// K9sAuditFile represents the K9s mutating actions audit log location.
+	K9sAuditFile = filepath.Join(K9sHome(), "audit.log")
+	// K9sHistoryFile represents the K9s commands and filters history location.
+	K9sHistoryFile = filepath.Join(K9sHome(), "history.yml")
+	// K9sSessionsFile represents the K9s navigation sessions location.
+	K9sSessionsFile = filepath.Join(K9sHome(), "sessions.yml")
+	// K9sScriptsDir represents the K9s scripts directory.
+	K9sScriptsDir = filepath.Join(K9sHome(), "scripts")
 )
 
 type (
@@ -163,7 +171,24 @@ func (c *Config) ValidateFavorites() {
 func (c *Config) FavNamespaces() []string {
 	cl := c.K9s.ActiveCluster()
 
-	return cl.Namespace.Favorites
+	return cl.Namespace.Ranked()
+}
+
+// TogglePinNamespace pins or unpins a namespace in the current cluster.
+func (c *Config) TogglePinNamespace(ns string) (bool, error) {
+	cl := c.K9s.ActiveCluster()
+	if cl == nil {
+		return false, errors.New("no active cluster. unable to pin namespace")
+	}
+
+	return cl.Namespace.TogglePin(ns)
+}
+
+// IsPinnedNamespace checks if a namespace is pinned in the current cluster.
+func (c *Config) IsPinnedNamespace(ns string) bool {
+	cl := c.K9s.ActiveCluster()
+
+	return cl != nil && cl.Namespace.IsPinned(ns)
 }
 
 // SetActiveNamespace set the active namespace in the current cluster.
@@ -279,6 +279,7 @@ func (m *mockSettings) ClusterNames() (map[string]struct{}, error) { return nil,
 var expectedConfig = `k9s:
   refreshRate: 100
   maxConnRetry: 5
+  listPageSize: 5000
   enableMouse: false
   headless: false
   logoless: false
@@ -378,6 +379,7 @@ var expectedConfig = `k9s:
 var resetConfig = `k9s:
   refreshRate: 2
   maxConnRetry: 5
+  listPageSize: 5000
   enableMouse: false
   headless: false
   logoless: false
@@ -0,0 +1,122 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// Contexts tracks kubeconfig contexts settings.
+type Contexts struct {
+	// KubeConfigs extra kubeconfig files merged with the KUBECONFIG ones.
+	KubeConfigs []string `yaml:"kubeConfigs,omitempty"`
+
+	// Colors maps context names to badge colors. Supports glob patterns, ie prod-*.
+	Colors map[string]string `yaml:"colors,omitempty"`
+
+	// Namespaces tracks the last active namespace per context.
+	Namespaces map[string]string `yaml:"namespaces,omitempty"`
+
+	// Skins maps context names to skin files. Supports glob patterns, ie prod-*.
+	// A bare name refers to $XDG_CONFIG_HOME/k9s/skins/NAME.yml.
+	Skins map[string]string `yaml:"skins,omitempty"`
+}
+
+// NewContexts returns a new instance.
+func NewContexts() *Contexts {
+	return &Contexts{}
+}
+
+// KubeConfigPaths returns the kubeconfig files to merge. The explicit file or the
+// KUBECONFIG entries come first, the configured extra files last.
+func (c *Contexts) KubeConfigPaths(explicit, env string) []string {
+	pp := filepath.SplitList(env)
+	if explicit != "" {
+		pp = []string{explicit}
+	}
+	if len(pp) == 0 {
+		pp = append(pp, clientcmd.RecommendedHomeFile)
+	}
+	pp = append(pp, c.KubeConfigs...)
+
+	seen := make(map[string]struct{}, len(pp))
+	paths := make([]string, 0, len(pp))
+	for _, p := range pp {
+		p = expandHome(p)
+		if _, ok := seen[p]; ok || p == "" {
+			continue
+		}
+		seen[p] = struct{}{}
+		paths = append(paths, p)
+	}
+
+	return paths
+}
+
+// Color returns the badge color for a given context or blank if none.
+func (c *Contexts) Color(context string) string {
+	return matchContext(c.Colors, context)
+}
+
+// Skin returns the skin file bound to a given context or blank if none.
+func (c *Contexts) Skin(context string) string {
+	skin := matchContext(c.Skins, context)
+	if skin == "" {
+		return ""
+	}
+	if filepath.Ext(skin) == "" && !strings.ContainsRune(skin, filepath.Separator) {
+		return filepath.Join(K9sHome(), "skins", skin+".yml")
+	}
+
+	return expandHome(skin)
+}
+
+// matchContext returns the value for a context, trying glob patterns in order if
+// no exact match is found.
+func matchContext(m map[string]string, context string) string {
+	if v, ok := m[context]; ok {
+		return v
+	}
+	kk := make([]string, 0, len(m))
+	for k := range m {
+		kk = append(kk, k)
+	}
+	sort.Strings(kk)
+	for _, k := range kk {
+		if ok, _ := filepath.Match(k, context); ok {
+			return m[k]
+		}
+	}
+
+	return ""
+}
+
+// Namespace returns the last active namespace for a given context.
+func (c *Contexts) Namespace(context string) (string, bool) {
+	ns, ok := c.Namespaces[context]
+
+	return ns, ok && ns != ""
+}
+
+// SetNamespace remembers the active namespace for a given context.
+func (c *Contexts) SetNamespace(context, ns string) {
+	if c.Namespaces == nil {
+		c.Namespaces = make(map[string]string)
+	}
+	c.Namespaces[context] = ns
+}
+
+func expandHome(p string) string {
+	if !strings.HasPrefix(p, "~") {
+		return p
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return p
+	}
+
+	return filepath.Join(home, p[1:])
+}
@@ -0,0 +1,107 @@
+package config_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+func TestContextsKubeConfigPaths(t *testing.T) {
+	home, _ := os.UserHomeDir()
+	sep := string(os.PathListSeparator)
+
+	uu := map[string]struct {
+		configs       []string
+		explicit, env string
+		e             []string
+	}{
+		"default": {
+			e: []string{clientcmd.RecommendedHomeFile},
+		},
+		"env": {
+			configs: []string{"/tmp/c2", "~/c3"},
+			env:     "/tmp/c1" + sep + "/tmp/c2",
+			e:       []string{"/tmp/c1", "/tmp/c2", filepath.Join(home, "c3")},
+		},
+		"explicit": {
+			configs:  []string{"/tmp/c2"},
+			explicit: "/tmp/c0",
+			env:      "/tmp/c1",
+			e:        []string{"/tmp/c0", "/tmp/c2"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			c := config.Contexts{KubeConfigs: u.configs}
+			assert.Equal(t, u.e, c.KubeConfigPaths(u.explicit, u.env))
+		})
+	}
+}
+
+func TestContextsColor(t *testing.T) {
+	c := config.Contexts{
+		Colors: map[string]string{
+			"prod-*":  "red",
+			"prod-eu": "orange",
+			"stg-*":   "yellow",
+		},
+	}
+
+	uu := map[string]struct {
+		ctx, e string
+	}{
+		"exact": {ctx: "prod-eu", e: "orange"},
+		"glob":  {ctx: "prod-us", e: "red"},
+		"other": {ctx: "stg-1", e: "yellow"},
+		"none":  {ctx: "dev"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, c.Color(u.ctx))
+		})
+	}
+}
+
+func TestContextsSkin(t *testing.T) {
+	c := config.Contexts{
+		Skins: map[string]string{
+			"prod-*": "red",
+			"stg":    "/tmp/stg_skin.yml",
+		},
+	}
+
+	uu := map[string]struct {
+		ctx, e string
+	}{
+		"name": {ctx: "prod-us", e: filepath.Join(config.K9sHome(), "skins", "red.yml")},
+		"path": {ctx: "stg", e: "/tmp/stg_skin.yml"},
+		"none": {ctx: "dev"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, c.Skin(u.ctx))
+		})
+	}
+}
+
+func TestContextsNamespace(t *testing.T) {
+	c := config.NewContexts()
+
+	_, ok := c.Namespace("fred")
+	assert.False(t, ok)
+
+	c.SetNamespace("fred", "blee")
+	ns, ok := c.Namespace("fred")
+	assert.True(t, ok)
+	assert.Equal(t, "blee", ns)
+}
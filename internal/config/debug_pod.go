@@ -0,0 +1,73 @@
+package config
+
+import "time"
+
+const (
+	defaultDebugImage        = "nicolaka/netshoot"
+	defaultDebugReadyTimeout = 120
+)
+
+// DebugPod represents a troubleshooting pod configuration.
+type DebugPod struct {
+	// Image the troubleshooting image.
+	Image string `yaml:"image"`
+
+	// Command the container command. Must keep the container running.
+	Command []string `yaml:"command,omitempty"`
+
+	// Args the container command arguments.
+	Args []string `yaml:"args,omitempty"`
+
+	// Namespace the pod namespace. Defaults to the current namespace.
+	Namespace string `yaml:"namespace,omitempty"`
+
+	// Node pins the pod to a given node.
+	Node string `yaml:"node,omitempty"`
+
+	// HostNetwork runs the pod in the node network namespace.
+	HostNetwork bool `yaml:"hostNetwork,omitempty"`
+
+	// HostPID runs the pod in the node pid namespace.
+	HostPID bool `yaml:"hostPID,omitempty"`
+
+	// Privileged runs the container privileged.
+	Privileged bool `yaml:"privileged,omitempty"`
+
+	Limits Limits            `yaml:"resources,omitempty"`
+	Labels map[string]string `yaml:"labels,omitempty"`
+
+	// ReadyTimeout the time to wait for the pod to be ready in seconds.
+	ReadyTimeout int `yaml:"readyTimeout,omitempty"`
+}
+
+// NewDebugPod returns a new instance.
+func NewDebugPod() *DebugPod {
+	return &DebugPod{
+		Image:        defaultDebugImage,
+		Command:      []string{"sleep", "86400"},
+		Limits:       defaultLimits(),
+		ReadyTimeout: defaultDebugReadyTimeout,
+	}
+}
+
+// Validate ensures the debug pod options are set.
+func (d *DebugPod) Validate() {
+	def := NewDebugPod()
+	if d.Image == "" {
+		d.Image = def.Image
+	}
+	if len(d.Command) == 0 {
+		d.Command, d.Args = def.Command, nil
+	}
+	if len(d.Limits) == 0 {
+		d.Limits = def.Limits
+	}
+	if d.ReadyTimeout <= 0 {
+		d.ReadyTimeout = def.ReadyTimeout
+	}
+}
+
+// ReadyTimeoutDuration returns the pod readiness timeout.
+func (d *DebugPod) ReadyTimeoutDuration() time.Duration {
+	return time.Duration(d.ReadyTimeout) * time.Second
+}
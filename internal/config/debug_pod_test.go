@@ -0,0 +1,31 @@
+package config_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDebugPodValidate(t *testing.T) {
+	d := config.DebugPod{Image: "busybox", Args: []string{"-c", "blee"}}
+	d.Validate()
+
+	assert.Equal(t, "busybox", d.Image)
+	assert.Equal(t, []string{"sleep", "86400"}, d.Command)
+	assert.Nil(t, d.Args)
+	assert.Equal(t, 2*time.Minute, d.ReadyTimeoutDuration())
+	assert.Equal(t, 2, len(d.Limits))
+}
+
+func TestClusterDebugPodConfig(t *testing.T) {
+	c := config.NewCluster()
+	assert.Equal(t, config.NewDebugPod(), c.DebugPodConfig())
+
+	c.DebugPod = &config.DebugPod{Image: "fred", Command: []string{"sh"}, ReadyTimeout: 10}
+	d := c.DebugPodConfig()
+	assert.Equal(t, "fred", d.Image)
+	assert.Equal(t, []string{"sh"}, d.Command)
+	assert.Equal(t, 10*time.Second, d.ReadyTimeoutDuration())
+}
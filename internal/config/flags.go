@@ -33,6 +33,8 @@ type Flags struct {
 	Write         *bool
 	Crumbsless    *bool
 	ScreenDumpDir *string
+	Batch         *string
+	DebugAddr     *string
 }
 
 // NewFlags returns new configuration flags.
@@ -49,6 +51,8 @@ func NewFlags() *Flags {
 		Write:         boolPtr(false),
 		Crumbsless:    boolPtr(false),
 		ScreenDumpDir: strPtr(K9sDefaultScreenDumpDir),
+		Batch:         strPtr(""),
+		DebugAddr:     strPtr(""),
 	}
 }
 
@@ -0,0 +1,52 @@
+package config
+
+import "time"
+
+const (
+	defaultGPUMetricsQuery     = "avg by (Hostname) (DCGM_FI_DEV_GPU_UTIL)"
+	defaultGPUMetricsNodeLabel = "Hostname"
+	defaultGPUMetricsTimeout   = 5
+)
+
+// GPUMetrics tracks the gpu utilization metrics source options.
+type GPUMetrics struct {
+	// URL the prometheus server url, ie http://prometheus:9090. Blank disables gpu utilization.
+	URL string `yaml:"url,omitempty"`
+
+	// Query a promQL instant query returning a gpu utilization percentage per node.
+	Query string `yaml:"query,omitempty"`
+
+	// NodeLabel the query result label holding the node name.
+	NodeLabel string `yaml:"nodeLabel,omitempty"`
+
+	// Timeout the query timeout in seconds.
+	Timeout int `yaml:"timeout,omitempty"`
+}
+
+// NewGPUMetrics returns a new instance.
+func NewGPUMetrics() *GPUMetrics {
+	return &GPUMetrics{
+		Query:     defaultGPUMetricsQuery,
+		NodeLabel: defaultGPUMetricsNodeLabel,
+		Timeout:   defaultGPUMetricsTimeout,
+	}
+}
+
+// Validate ensures the gpu metrics options are set.
+func (g *GPUMetrics) Validate() {
+	def := NewGPUMetrics()
+	if g.Query == "" {
+		g.Query = def.Query
+	}
+	if g.NodeLabel == "" {
+		g.NodeLabel = def.NodeLabel
+	}
+	if g.Timeout <= 0 {
+		g.Timeout = def.Timeout
+	}
+}
+
+// TimeoutDuration returns the query timeout.
+func (g *GPUMetrics) TimeoutDuration() time.Duration {
+	return time.Duration(g.Timeout) * time.Second
+}
@@ -0,0 +1,41 @@
+package config_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGPUMetricsValidate(t *testing.T) {
+	uu := map[string]struct {
+		g       config.GPUMetrics
+		e       config.GPUMetrics
+		timeout time.Duration
+	}{
+		"defaults": {
+			e:       config.GPUMetrics{Query: "avg by (Hostname) (DCGM_FI_DEV_GPU_UTIL)", NodeLabel: "Hostname", Timeout: 5},
+			timeout: 5 * time.Second,
+		},
+		"custom": {
+			g:       config.GPUMetrics{URL: "http://prom:9090", Query: "gpu_util", NodeLabel: "node", Timeout: 10},
+			e:       config.GPUMetrics{URL: "http://prom:9090", Query: "gpu_util", NodeLabel: "node", Timeout: 10},
+			timeout: 10 * time.Second,
+		},
+		"bogus-timeout": {
+			g:       config.GPUMetrics{URL: "http://prom:9090", Timeout: -1},
+			e:       config.GPUMetrics{URL: "http://prom:9090", Query: "avg by (Hostname) (DCGM_FI_DEV_GPU_UTIL)", NodeLabel: "Hostname", Timeout: 5},
+			timeout: 5 * time.Second,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			u.g.Validate()
+			assert.Equal(t, u.e, u.g)
+			assert.Equal(t, u.timeout, u.g.TimeoutDuration())
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package config
+
+import (
+	"path/filepath"
+)
+
+// Guards tracks dangerous commands confirmation policies.
+type Guards struct {
+	// Contexts the contexts where deletes require typing the resource name. Supports glob patterns, ie prod-*.
+	Contexts []string `yaml:"contexts,omitempty"`
+
+	// Namespaces the namespaces where deletes require typing the resource name. Supports glob patterns.
+	Namespaces []string `yaml:"namespaces,omitempty"`
+
+	// DisableForceDelete disables force deletes entirely.
+	DisableForceDelete bool `yaml:"disableForceDelete"`
+
+	// ConfirmClusterScoped requires a second confirmation when deleting cluster scoped resources.
+	ConfirmClusterScoped bool `yaml:"confirmClusterScoped"`
+}
+
+// NewGuards returns a new instance.
+func NewGuards() *Guards {
+	return &Guards{}
+}
+
+// NameRequired checks if deletes in a given context and namespace require typing the resource name.
+func (g *Guards) NameRequired(context, ns string) bool {
+	return matchAny(g.Contexts, context) || (ns != "" && matchAny(g.Namespaces, ns))
+}
+
+func matchAny(pp []string, s string) bool {
+	for _, p := range pp {
+		if ok, _ := filepath.Match(p, s); ok {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,32 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGuardsNameRequired(t *testing.T) {
+	g := config.Guards{
+		Contexts:   []string{"prod-*"},
+		Namespaces: []string{"kube-system", "payments"},
+	}
+
+	uu := map[string]struct {
+		ctx, ns string
+		e       bool
+	}{
+		"none":          {ctx: "dev", ns: "default"},
+		"context":       {ctx: "prod-eu", ns: "default", e: true},
+		"namespace":     {ctx: "dev", ns: "payments", e: true},
+		"cluster-scope": {ctx: "dev"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, g.NameRequired(u.ctx, u.ns))
+		})
+	}
+}
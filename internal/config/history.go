@@ -0,0 +1,76 @@
+package config
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+
+	"gopkg.in/yaml.v2"
+)
+
+// History tracks commands and filters history per context.
+type History struct {
+	Contexts map[string]ContextHistory `yaml:"history"`
+}
+
+// ContextHistory tracks a context commands and filters, most recent first.
+type ContextHistory struct {
+	Commands []string `yaml:"commands,omitempty"`
+	Filters  []string `yaml:"filters,omitempty"`
+}
+
+// NewHistory returns a new instance.
+func NewHistory() *History {
+	return &History{Contexts: make(map[string]ContextHistory)}
+}
+
+// Load loads history from a given file. A missing file is not an error.
+func (h *History) Load(path string) error {
+	raw, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	var in History
+	if err := yaml.Unmarshal(raw, &in); err != nil {
+		return err
+	}
+	if in.Contexts != nil {
+		h.Contexts = in.Contexts
+	}
+
+	return nil
+}
+
+// Save saves history to a given file.
+func (h *History) Save(path string) error {
+	if err := EnsureDirPath(path, DefaultDirMod); err != nil {
+		return err
+	}
+	raw, err := yaml.Marshal(h)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, raw, DefaultFileMod)
+}
+
+// For returns the history of a given context.
+func (h *History) For(context string) ContextHistory {
+	return h.Contexts[context]
+}
+
+// Set updates the history of a given context.
+func (h *History) Set(context string, cmds, filters []string) {
+	if h.Contexts == nil {
+		h.Contexts = make(map[string]ContextHistory)
+	}
+	if len(cmds) == 0 && len(filters) == 0 {
+		delete(h.Contexts, context)
+		return
+	}
+	h.Contexts[context] = ContextHistory{Commands: cmds, Filters: filters}
+}
@@ -0,0 +1,31 @@
+package config_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHistoryLoadMissing(t *testing.T) {
+	h := config.NewHistory()
+
+	assert.Nil(t, h.Load(filepath.Join(t.TempDir(), "history.yml")))
+	assert.Equal(t, config.ContextHistory{}, h.For("fred"))
+}
+
+func TestHistorySaveLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "history.yml")
+	h := config.NewHistory()
+	h.Set("fred", []string{"dp", "po kube-system"}, []string{"nginx"})
+	h.Set("blee", []string{"svc"}, nil)
+	h.Set("zorg", nil, nil)
+	assert.Nil(t, h.Save(path))
+
+	l := config.NewHistory()
+	assert.Nil(t, l.Load(path))
+	assert.Equal(t, 2, len(l.Contexts))
+	assert.Equal(t, config.ContextHistory{Commands: []string{"dp", "po kube-system"}, Filters: []string{"nginx"}}, l.For("fred"))
+	assert.Equal(t, []string{"svc"}, l.For("blee").Commands)
+}
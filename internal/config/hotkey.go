@@ -3,6 +3,7 @@ package config
 import (
 	"os"
 	"path/filepath"
+	"strings"
 
 	"gopkg.in/yaml.v2"
 )
@@ -13,13 +14,39 @@ var K9sHotKeys = filepath.Join(K9sHome(), "hotkey.yml")
 // HotKeys represents a collection of plugins.
 type HotKeys struct {
 	HotKey map[string]HotKey `yaml:"hotKey"`
+	Unbind []KeyUnbind       `yaml:"unbind,omitempty"`
 }
 
 // HotKey describes a K9s hotkey.
 type HotKey struct {
+	// ShortCut a key or a two keys chord separated by a space, ie g d.
 	ShortCut    string `yaml:"shortCut"`
 	Description string `yaml:"description"`
 	Command     string `yaml:"command"`
+	// Scopes restricts the hotkey to the given resources. Scoped hotkeys take
+	// precedence over global ones.
+	Scopes []string `yaml:"scopes,omitempty"`
+}
+
+// KeyUnbind describes a built-in key to unbind.
+type KeyUnbind struct {
+	Key    string   `yaml:"key"`
+	Scopes []string `yaml:"scopes,omitempty"`
+}
+
+// Keys returns the hotkey shortcut keys.
+func (h HotKey) Keys() []string {
+	return strings.Fields(h.ShortCut)
+}
+
+// IsChord checks if the hotkey is a two keys chord.
+func (h HotKey) IsChord() bool {
+	return len(h.Keys()) == 2
+}
+
+// IsScoped checks if the hotkey is restricted to some resources.
+func (h HotKey) IsScoped() bool {
+	return len(h.Scopes) > 0
 }
 
 // NewHotKeys returns a new plugin.
@@ -30,12 +57,12 @@ func NewHotKeys() HotKeys {
 }
 
 // Load K9s plugins.
-func (h HotKeys) Load() error {
+func (h *HotKeys) Load() error {
 	return h.LoadHotKeys(K9sHotKeys)
 }
 
 // LoadHotKeys loads plugins from a given file.
-func (h HotKeys) LoadHotKeys(path string) error {
+func (h *HotKeys) LoadHotKeys(path string) error {
 	f, err := os.ReadFile(path)
 	if err != nil {
 		return err
@@ -48,6 +75,7 @@ func (h HotKeys) LoadHotKeys(path string) error {
 	for k, v := range hh.HotKey {
 		h.HotKey[k] = v
 	}
+	h.Unbind = append(h.Unbind, hh.Unbind...)
 
 	return nil
 }
@@ -11,7 +11,7 @@ func TestHotKeyLoad(t *testing.T) {
 	h := config.NewHotKeys()
 	assert.Nil(t, h.LoadHotKeys("testdata/hot_key.yml"))
 
-	assert.Equal(t, 1, len(h.HotKey))
+	assert.Equal(t, 3, len(h.HotKey))
 
 	k, ok := h.HotKey["pods"]
 	assert.True(t, ok)
@@ -19,3 +19,20 @@ func TestHotKeyLoad(t *testing.T) {
 	assert.Equal(t, "Launch pod view", k.Description)
 	assert.Equal(t, "pods", k.Command)
 }
+
+func TestHotKeyChords(t *testing.T) {
+	h := config.NewHotKeys()
+	assert.Nil(t, h.LoadHotKeys("testdata/hot_key.yml"))
+
+	k, ok := h.HotKey["deploys"]
+	assert.True(t, ok)
+	assert.True(t, k.IsChord())
+	assert.Equal(t, []string{"g", "d"}, k.Keys())
+	assert.False(t, k.IsScoped())
+	assert.False(t, h.HotKey["pods"].IsChord())
+	assert.True(t, h.HotKey["logs"].IsScoped())
+
+	assert.Equal(t, 1, len(h.Unbind))
+	assert.Equal(t, "ctrl-d", h.Unbind[0].Key)
+	assert.Equal(t, []string{"pods"}, h.Unbind[0].Scopes)
+}
@@ -0,0 +1,51 @@
+package config
+
+import "time"
+
+const (
+	defaultIdleTimeout     = 300
+	defaultIdleRefreshRate = 30
+)
+
+// Idle tracks the refresh options applied when the user is inactive.
+// Terminals do not report focus changes so a lack of input marks k9s idle.
+type Idle struct {
+	// Timeout the number of seconds without input before k9s idles. Negative disables idling.
+	Timeout int `yaml:"timeout,omitempty"`
+
+	// RefreshRate the refresh rate in seconds while idle.
+	RefreshRate int `yaml:"refreshRate,omitempty"`
+}
+
+// NewIdle returns a new instance.
+func NewIdle() *Idle {
+	return &Idle{
+		Timeout:     defaultIdleTimeout,
+		RefreshRate: defaultIdleRefreshRate,
+	}
+}
+
+// Validate ensures the idle options are set.
+func (i *Idle) Validate() {
+	if i.Timeout == 0 {
+		i.Timeout = defaultIdleTimeout
+	}
+	if i.RefreshRate <= 0 {
+		i.RefreshRate = defaultIdleRefreshRate
+	}
+}
+
+// Enabled checks if k9s idles when inactive.
+func (i *Idle) Enabled() bool {
+	return i.Timeout > 0
+}
+
+// TimeoutDuration returns the inactivity duration before idling.
+func (i *Idle) TimeoutDuration() time.Duration {
+	return time.Duration(i.Timeout) * time.Second
+}
+
+// RefreshDuration returns the refresh rate while idle.
+func (i *Idle) RefreshDuration() time.Duration {
+	return time.Duration(i.RefreshRate) * time.Second
+}
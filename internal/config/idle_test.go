@@ -0,0 +1,45 @@
+package config_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIdleValidate(t *testing.T) {
+	uu := map[string]struct {
+		i       config.Idle
+		enabled bool
+		timeout time.Duration
+		rate    time.Duration
+	}{
+		"defaults": {
+			enabled: true,
+			timeout: 5 * time.Minute,
+			rate:    30 * time.Second,
+		},
+		"custom": {
+			i:       config.Idle{Timeout: 60, RefreshRate: 10},
+			enabled: true,
+			timeout: time.Minute,
+			rate:    10 * time.Second,
+		},
+		"disabled": {
+			i:       config.Idle{Timeout: -1},
+			timeout: -time.Second,
+			rate:    30 * time.Second,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			u.i.Validate()
+			assert.Equal(t, u.enabled, u.i.Enabled())
+			assert.Equal(t, u.timeout, u.i.TimeoutDuration())
+			assert.Equal(t, u.rate, u.i.RefreshDuration())
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package config
+
+import "time"
+
+const (
+	// ScannerTrivy scans images using the trivy binary.
+	ScannerTrivy = "trivy"
+
+	// ScannerHTTP scans images using a remote scanner api.
+	ScannerHTTP = "http"
+
+	defaultTrivyBinary      = "trivy"
+	defaultImageScanTimeout = 300
+)
+
+// ImageScans tracks container images vulnerability scanner options.
+type ImageScans struct {
+	// Scanner the scanner kind, either trivy or http. Default trivy.
+	Scanner string `yaml:"scanner,omitempty"`
+
+	// Binary the trivy binary location.
+	Binary string `yaml:"binary,omitempty"`
+
+	// URL the http scanner endpoint. The image is passed as the image query parameter.
+	URL string `yaml:"url,omitempty"`
+
+	// Timeout a single image scan timeout in seconds.
+	Timeout int `yaml:"timeout,omitempty"`
+}
+
+// NewImageScans returns a new instance.
+func NewImageScans() *ImageScans {
+	return &ImageScans{
+		Scanner: ScannerTrivy,
+		Binary:  defaultTrivyBinary,
+		Timeout: defaultImageScanTimeout,
+	}
+}
+
+// Validate ensures the scanner options are set.
+func (i *ImageScans) Validate() {
+	def := NewImageScans()
+	if i.Scanner != ScannerHTTP {
+		i.Scanner = def.Scanner
+	}
+	if i.Binary == "" {
+		i.Binary = def.Binary
+	}
+	if i.Timeout <= 0 {
+		i.Timeout = def.Timeout
+	}
+}
+
+// TimeoutDuration returns a single image scan timeout.
+func (i *ImageScans) TimeoutDuration() time.Duration {
+	return time.Duration(i.Timeout) * time.Second
+}
@@ -0,0 +1,41 @@
+package config_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImageScansValidate(t *testing.T) {
+	uu := map[string]struct {
+		i       config.ImageScans
+		e       config.ImageScans
+		timeout time.Duration
+	}{
+		"defaults": {
+			e:       config.ImageScans{Scanner: "trivy", Binary: "trivy", Timeout: 300},
+			timeout: 5 * time.Minute,
+		},
+		"http": {
+			i:       config.ImageScans{Scanner: "http", URL: "http://scanner", Timeout: 30},
+			e:       config.ImageScans{Scanner: "http", Binary: "trivy", URL: "http://scanner", Timeout: 30},
+			timeout: 30 * time.Second,
+		},
+		"bogus": {
+			i:       config.ImageScans{Scanner: "bozo", Binary: "/usr/bin/trivy", Timeout: -1},
+			e:       config.ImageScans{Scanner: "trivy", Binary: "/usr/bin/trivy", Timeout: 300},
+			timeout: 5 * time.Minute,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			u.i.Validate()
+			assert.Equal(t, u.e, u.i)
+			assert.Equal(t, u.timeout, u.i.TimeoutDuration())
+		})
+	}
+}
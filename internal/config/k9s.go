@@ -7,19 +7,24 @@ import (
 const (
 	defaultRefreshRate  = 2
 	defaultMaxConnRetry = 5
+	defaultListPageSize = 5000
 )
 
 // K9s tracks K9s configuration options.
 type K9s struct {
 	RefreshRate         int                 `yaml:"refreshRate"`
+	TopRefreshRate      int                 `yaml:"topRefreshRate,omitempty"`
 	MaxConnRetry        int                 `yaml:"maxConnRetry"`
+	ListPageSize        int                 `yaml:"listPageSize"`
 	EnableMouse         bool                `yaml:"enableMouse"`
+	Theme               string              `yaml:"theme,omitempty"`
 	Headless            bool                `yaml:"headless"`
 	Logoless            bool                `yaml:"logoless"`
 	Crumbsless          bool                `yaml:"crumbsless"`
 	ReadOnly            bool                `yaml:"readOnly"`
 	NoExitOnCtrlC       bool                `yaml:"noExitOnCtrlC"`
 	NoIcons             bool                `yaml:"noIcons"`
+	Accessible          bool                `yaml:"accessible,omitempty"`
 	SkipLatestRevCheck  bool                `yaml:"skipLatestRevCheck"`
 	Logger              *Logger             `yaml:"logger"`
 	CurrentContext      string              `yaml:"currentContext"`
@@ -27,6 +32,16 @@ type K9s struct {
 	Clusters            map[string]*Cluster `yaml:"clusters,omitempty"`
 	Thresholds          Threshold           `yaml:"thresholds"`
 	ScreenDumpDir       string              `yaml:"screenDumpDir"`
+	Sniffer             *Sniffer            `yaml:"sniffer,omitempty"`
+	ImageScans          *ImageScans         `yaml:"imageScans,omitempty"`
+	Idle                *Idle               `yaml:"idle,omitempty"`
+	Alerts              *Alerts             `yaml:"alerts,omitempty"`
+	GPUMetrics          *GPUMetrics         `yaml:"gpuMetrics,omitempty"`
+	Pricing             *Pricing            `yaml:"pricing,omitempty"`
+	Guards              *Guards             `yaml:"guards,omitempty"`
+	Contexts            *Contexts           `yaml:"contexts,omitempty"`
+	SavedViews          SavedViews          `yaml:"savedViews,omitempty"`
+	StatusBar           *StatusBar          `yaml:"statusBar,omitempty"`
 	manualRefreshRate   int
 	manualHeadless      *bool
 	manualLogoless      *bool
@@ -34,6 +49,7 @@ type K9s struct {
 	manualReadOnly      *bool
 	manualCommand       *string
 	manualScreenDumpDir *string
+	manualDebugAddr     *string
 }
 
 // NewK9s create a new K9s configuration.
@@ -41,6 +57,7 @@ func NewK9s() *K9s {
 	return &K9s{
 		RefreshRate:   defaultRefreshRate,
 		MaxConnRetry:  defaultMaxConnRetry,
+		ListPageSize:  defaultListPageSize,
 		Logger:        NewLogger(),
 		Clusters:      make(map[string]*Cluster),
 		Thresholds:    NewThreshold(),
@@ -107,6 +124,20 @@ func (k *K9s) OverrideScreenDumpDir(dir string) {
 	k.manualScreenDumpDir = &dir
 }
 
+// OverrideDebugAddr set the pprof/expvar endpoint address manually.
+func (k *K9s) OverrideDebugAddr(addr string) {
+	k.manualDebugAddr = &addr
+}
+
+// DebugAddr returns the pprof/expvar endpoint address or blank if disabled.
+func (k *K9s) DebugAddr() string {
+	if k.manualDebugAddr == nil {
+		return ""
+	}
+
+	return *k.manualDebugAddr
+}
+
 // IsHeadless returns headless setting.
 func (k *K9s) IsHeadless() bool {
 	h := k.Headless
@@ -147,6 +178,15 @@ func (k *K9s) GetRefreshRate() int {
 	return rate
 }
 
+// GetTopRefreshRate returns the containers top view refresh rate.
+func (k *K9s) GetTopRefreshRate() int {
+	if k.TopRefreshRate > 0 {
+		return k.TopRefreshRate
+	}
+
+	return k.GetRefreshRate()
+}
+
 // IsReadOnly returns the readonly setting.
 func (k *K9s) IsReadOnly() bool {
 	readOnly := k.ReadOnly
@@ -170,6 +210,131 @@ func (k *K9s) ActiveCluster() *Cluster {
 	return k.Clusters[k.CurrentCluster]
 }
 
+// IsNoIcons returns true if icons should not be displayed.
+func (k *K9s) IsNoIcons() bool {
+	return k.NoIcons || k.Accessible
+}
+
+// IdleConfig returns the inactivity settings.
+func (k *K9s) IdleConfig() *Idle {
+	if k.Idle == nil {
+		return NewIdle()
+	}
+	k.Idle.Validate()
+
+	return k.Idle
+}
+
+// SnifferConfig returns the packet capture settings.
+func (k *K9s) SnifferConfig() *Sniffer {
+	if k.Sniffer == nil {
+		return NewSniffer()
+	}
+	k.Sniffer.Validate()
+
+	return k.Sniffer
+}
+
+// ImageScansConfig returns the image vulnerability scanner settings.
+func (k *K9s) ImageScansConfig() *ImageScans {
+	if k.ImageScans == nil {
+		return NewImageScans()
+	}
+	k.ImageScans.Validate()
+
+	return k.ImageScans
+}
+
+// AlertsConfig returns the alerts watcher settings.
+func (k *K9s) AlertsConfig() *Alerts {
+	if k.Alerts == nil {
+		return NewAlerts()
+	}
+	k.Alerts.Validate()
+
+	return k.Alerts
+}
+
+// GPUMetricsConfig returns the gpu utilization metrics settings.
+func (k *K9s) GPUMetricsConfig() *GPUMetrics {
+	if k.GPUMetrics == nil {
+		return NewGPUMetrics()
+	}
+	k.GPUMetrics.Validate()
+
+	return k.GPUMetrics
+}
+
+// PricingConfig returns the cost estimation settings.
+func (k *K9s) PricingConfig() *Pricing {
+	if k.Pricing == nil {
+		return NewPricing()
+	}
+	k.Pricing.Validate()
+
+	return k.Pricing
+}
+
+// GuardsConfig returns the dangerous commands confirmation policies.
+func (k *K9s) GuardsConfig() *Guards {
+	if k.Guards == nil {
+		return NewGuards()
+	}
+
+	return k.Guards
+}
+
+// StatusBarConfig returns the status bar settings.
+func (k *K9s) StatusBarConfig() *StatusBar {
+	if k.StatusBar == nil {
+		return NewStatusBar()
+	}
+
+	return k.StatusBar
+}
+
+// ContextsConfig returns the kubeconfig contexts settings.
+func (k *K9s) ContextsConfig() *Contexts {
+	if k.Contexts == nil {
+		return NewContexts()
+	}
+
+	return k.Contexts
+}
+
+// RememberNamespace tracks the active namespace for a given context.
+func (k *K9s) RememberNamespace(context, ns string) {
+	if k.Contexts == nil {
+		k.Contexts = NewContexts()
+	}
+	k.Contexts.SetNamespace(context, ns)
+}
+
+// SaveView stores a view state under a given name.
+func (k *K9s) SaveView(name string, v SavedView) {
+	if k.SavedViews == nil {
+		k.SavedViews = make(SavedViews)
+	}
+	k.SavedViews[name] = v
+}
+
+// SavedView returns a saved view by name.
+func (k *K9s) SavedView(name string) (SavedView, bool) {
+	v, ok := k.SavedViews[name]
+
+	return v, ok
+}
+
+// DeleteView removes a saved view.
+func (k *K9s) DeleteView(name string) bool {
+	if _, ok := k.SavedViews[name]; !ok {
+		return false
+	}
+	delete(k.SavedViews, name)
+
+	return true
+}
+
 func (k *K9s) GetScreenDumpDir() string {
 	screenDumpDir := k.ScreenDumpDir
 	if k.manualScreenDumpDir != nil && *k.manualScreenDumpDir != "" {
@@ -190,6 +355,9 @@ func (k *K9s) validateDefaults() {
 	if k.MaxConnRetry <= 0 {
 		k.MaxConnRetry = defaultMaxConnRetry
 	}
+	if k.ListPageSize == 0 {
+		k.ListPageSize = defaultListPageSize
+	}
 	if k.ScreenDumpDir == "" {
 		k.ScreenDumpDir = K9sDefaultScreenDumpDir
 	}
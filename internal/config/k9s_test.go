@@ -166,3 +166,63 @@ func TestGetScreenDumpDirEmpty(t *testing.T) {
 
 	assert.Equal(t, config.K9sDefaultScreenDumpDir, cfg.K9s.GetScreenDumpDir())
 }
+
+func TestK9sTopRefreshRate(t *testing.T) {
+	k := config.NewK9s()
+	k.RefreshRate = 4
+	assert.Equal(t, 4, k.GetTopRefreshRate())
+
+	k.TopRefreshRate = 1
+	assert.Equal(t, 1, k.GetTopRefreshRate())
+}
+
+func TestK9sSnifferConfig(t *testing.T) {
+	k := config.NewK9s()
+	assert.Equal(t, config.NewSniffer(), k.SnifferConfig())
+
+	k.Sniffer = &config.Sniffer{Image: "fred:1.0", Analyzer: "wireshark"}
+	s := k.SnifferConfig()
+	assert.Equal(t, "fred:1.0", s.Image)
+	assert.Equal(t, "any", s.Interface)
+	assert.Equal(t, "wireshark", s.Analyzer)
+	assert.NotEmpty(t, s.Dir)
+}
+
+func TestK9sStatusBarConfig(t *testing.T) {
+	k := config.NewK9s()
+	assert.False(t, k.StatusBarConfig().IsCustom())
+
+	k.StatusBar = &config.StatusBar{Sections: []string{"context", "time"}, Zen: true}
+	s := k.StatusBarConfig()
+	assert.True(t, s.IsCustom())
+	assert.True(t, s.Zen)
+}
+
+func TestK9sRememberNamespace(t *testing.T) {
+	k := config.NewK9s()
+	assert.Nil(t, k.Contexts)
+
+	k.RememberNamespace("fred", "blee")
+	ns, ok := k.ContextsConfig().Namespace("fred")
+	assert.True(t, ok)
+	assert.Equal(t, "blee", ns)
+}
+
+func TestK9sSavedViews(t *testing.T) {
+	k := config.NewK9s()
+	_, ok := k.SavedView("fred")
+	assert.False(t, ok)
+
+	v := config.SavedView{Command: "v1/pods", Namespace: "blee", Filter: "nginx", SortColumn: "AGE:desc"}
+	k.SaveView("fred", v)
+	k.SaveView("blee", config.SavedView{Command: "apps/v1/deployments"})
+	assert.Equal(t, []string{"blee", "fred"}, k.SavedViews.Names())
+
+	sv, ok := k.SavedView("fred")
+	assert.True(t, ok)
+	assert.Equal(t, v, sv)
+
+	assert.True(t, k.DeleteView("blee"))
+	assert.False(t, k.DeleteView("blee"))
+	assert.Equal(t, []string{"fred"}, k.SavedViews.Names())
+}
@@ -1,6 +1,9 @@
 package config
 
 import (
+	"fmt"
+	"sort"
+
 	"github.com/derailed/k9s/internal/client"
 	"github.com/rs/zerolog/log"
 )
@@ -14,9 +17,11 @@ const (
 
 // Namespace tracks active and favorites namespaces.
 type Namespace struct {
-	Active        string   `yaml:"active"`
-	LockFavorites bool     `yaml:"lockFavorites"`
-	Favorites     []string `yaml:"favorites"`
+	Active        string         `yaml:"active"`
+	LockFavorites bool           `yaml:"lockFavorites"`
+	Favorites     []string       `yaml:"favorites"`
+	Pinned        []string       `yaml:"pinned,omitempty"`
+	Usage         map[string]int `yaml:"usage,omitempty"`
 }
 
 // NewNamespace create a new namespace configuration.
@@ -47,6 +52,56 @@ func (n *Namespace) Validate(c client.Connection, ks KubeSettings) {
 			n.rmFavNS(ns)
 		}
 	}
+	for _, ns := range n.Pinned {
+		if ns != allNS && !InList(nn, ns) {
+			log.Debug().Msgf("[Config] Invalid pinned namespace found '%s'", ns)
+			n.Pinned = rmNS(n.Pinned, ns)
+		}
+	}
+}
+
+// Ranked returns the pinned namespaces followed by the favorites most used first.
+func (n *Namespace) Ranked() []string {
+	rr := make([]string, 0, MaxFavoritesNS)
+	for _, ns := range n.Pinned {
+		if !InList(rr, ns) {
+			rr = append(rr, ns)
+		}
+	}
+	ff := make([]string, 0, len(n.Favorites))
+	for _, ns := range n.Favorites {
+		if !InList(rr, ns) {
+			ff = append(ff, ns)
+		}
+	}
+	sort.SliceStable(ff, func(i, j int) bool {
+		return n.Usage[ff[i]] > n.Usage[ff[j]]
+	})
+	rr = append(rr, ff...)
+	if len(rr) > MaxFavoritesNS {
+		rr = rr[:MaxFavoritesNS]
+	}
+
+	return rr
+}
+
+// IsPinned checks if a namespace is pinned.
+func (n *Namespace) IsPinned(ns string) bool {
+	return InList(n.Pinned, ns)
+}
+
+// TogglePin pins or unpins a namespace. Returns true if the namespace is now pinned.
+func (n *Namespace) TogglePin(ns string) (bool, error) {
+	if n.IsPinned(ns) {
+		n.Pinned = rmNS(n.Pinned, ns)
+		return false, nil
+	}
+	if len(n.Pinned) >= MaxFavoritesNS {
+		return false, fmt.Errorf("only %d namespaces can be pinned", MaxFavoritesNS)
+	}
+	n.Pinned = append(n.Pinned, ns)
+
+	return true, nil
 }
 
 // SetActive set the active namespace.
@@ -54,14 +109,31 @@ func (n *Namespace) SetActive(ns string, ks KubeSettings) error {
 	if ns == client.NotNamespaced {
 		ns = client.AllNamespaces
 	}
+	changed := n.Active != ns
 	n.Active = ns
 	if ns != "" && !n.LockFavorites {
 		n.addFavNS(ns)
 	}
+	if ns != "" && changed {
+		n.trackUsage(ns)
+	}
 
 	return nil
 }
 
+// trackUsage bumps a namespace usage count and drops counts no longer relevant.
+func (n *Namespace) trackUsage(ns string) {
+	if n.Usage == nil {
+		n.Usage = make(map[string]int)
+	}
+	n.Usage[ns]++
+	for k := range n.Usage {
+		if !InList(n.Favorites, k) && !InList(n.Pinned, k) {
+			delete(n.Usage, k)
+		}
+	}
+}
+
 func (n *Namespace) isAllNamespaces() bool {
 	return n.Active == allNS || n.Active == ""
 }
@@ -82,16 +154,20 @@ func (n *Namespace) addFavNS(ns string) {
 }
 
 func (n *Namespace) rmFavNS(ns string) {
+	n.Favorites = rmNS(n.Favorites, ns)
+}
+
+func rmNS(nn []string, ns string) []string {
 	victim := -1
-	for i, f := range n.Favorites {
+	for i, f := range nn {
 		if f == ns {
 			victim = i
 			break
 		}
 	}
 	if victim < 0 {
-		return
+		return nn
 	}
 
-	n.Favorites = append(n.Favorites[:victim], n.Favorites[victim+1:]...)
+	return append(nn[:victim], nn[victim+1:]...)
 }
@@ -86,3 +86,73 @@ func TestNSValidateRmFavs(t *testing.T) {
 
 	assert.Equal(t, []string{"default", "fred"}, ns.Favorites)
 }
+
+func TestNSRanked(t *testing.T) {
+	uu := map[string]struct {
+		pinned, favs []string
+		usage        map[string]int
+		e            []string
+	}{
+		"recency": {
+			favs: []string{"ns3", "ns2", "ns1"},
+			e:    []string{"ns3", "ns2", "ns1"},
+		},
+		"usage": {
+			favs:  []string{"ns3", "ns2", "ns1"},
+			usage: map[string]int{"ns1": 5, "ns2": 2, "ns3": 2},
+			e:     []string{"ns1", "ns3", "ns2"},
+		},
+		"pinned": {
+			pinned: []string{"ns2", "ns4"},
+			favs:   []string{"ns3", "ns2", "ns1"},
+			usage:  map[string]int{"ns1": 5},
+			e:      []string{"ns2", "ns4", "ns1", "ns3"},
+		},
+		"capped": {
+			pinned: []string{"p1", "p2", "p3", "p4", "p5"},
+			favs:   []string{"f1", "f2", "f3", "f4", "f5"},
+			e:      []string{"p1", "p2", "p3", "p4", "p5", "f1", "f2", "f3", "f4"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ns := config.Namespace{Pinned: u.pinned, Favorites: u.favs, Usage: u.usage}
+			assert.Equal(t, u.e, ns.Ranked())
+		})
+	}
+}
+
+func TestNSUsage(t *testing.T) {
+	mk := NewMockKubeSettings()
+	ns := config.NewNamespace()
+	for _, n := range []string{"ns1", "ns2", "ns1", "ns1", "ns3", "ns1", "ns2"} {
+		assert.Nil(t, ns.SetActive(n, mk))
+	}
+
+	assert.Equal(t, map[string]int{"ns1": 3, "ns2": 2, "ns3": 1}, ns.Usage)
+	assert.Equal(t, []string{"ns1", "ns2", "ns3", "default"}, ns.Ranked())
+}
+
+func TestNSTogglePin(t *testing.T) {
+	ns := config.NewNamespace()
+
+	ok, err := ns.TogglePin("ns1")
+	assert.Nil(t, err)
+	assert.True(t, ok)
+	assert.True(t, ns.IsPinned("ns1"))
+	assert.Equal(t, []string{"ns1", "default"}, ns.Ranked())
+
+	ok, err = ns.TogglePin("ns1")
+	assert.Nil(t, err)
+	assert.False(t, ok)
+	assert.False(t, ns.IsPinned("ns1"))
+
+	for i := 0; i < config.MaxFavoritesNS; i++ {
+		_, err = ns.TogglePin(fmt.Sprintf("ns%d", i))
+		assert.Nil(t, err)
+	}
+	_, err = ns.TogglePin("fred")
+	assert.NotNil(t, err)
+}
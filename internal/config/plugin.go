@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"regexp"
 	"strings"
 
 	"gopkg.in/yaml.v2"
@@ -12,6 +13,20 @@ import (
 // K9sPlugins manages K9s plugins.
 var K9sPlugins = filepath.Join(K9sHome(), "plugin.yml")
 
+const (
+	// PluginOutputScreen hands the terminal over to the plugin. This is the default.
+	PluginOutputScreen = "screen"
+
+	// PluginOutputPane streams the plugin output into a k9s pane.
+	PluginOutputPane = "pane"
+
+	// PluginOutputYAML shows the plugin output in the YAML viewer.
+	PluginOutputYAML = "yaml"
+
+	// PluginOutputBackground runs the plugin silently and flashes its outcome.
+	PluginOutputBackground = "background"
+)
+
 // Plugins represents a collection of plugins.
 type Plugins struct {
 	Plugin map[string]Plugin `yaml:"plugin"`
@@ -19,20 +34,83 @@ type Plugins struct {
 
 // Plugin describes a K9s plugin.
 type Plugin struct {
-	Scopes      []string `yaml:"scopes"`
-	Args        []string `yaml:"args"`
-	ShortCut    string   `yaml:"shortCut"`
-	Pipes       []string `yaml:"pipes"`
-	Description string   `yaml:"description"`
-	Command     string   `yaml:"command"`
-	Confirm     bool     `yaml:"confirm"`
-	Background  bool     `yaml:"background"`
+	Scopes      []string      `yaml:"scopes"`
+	Args        []string      `yaml:"args"`
+	ShortCut    string        `yaml:"shortCut"`
+	Pipes       []string      `yaml:"pipes"`
+	Description string        `yaml:"description"`
+	Command     string        `yaml:"command"`
+	Confirm     bool          `yaml:"confirm"`
+	Background  bool          `yaml:"background"`
+	Output      string        `yaml:"output,omitempty"`
+	Inputs      []PluginInput `yaml:"inputs,omitempty"`
+}
+
+// PluginInput describes a plugin argument collected from the user on launch.
+type PluginInput struct {
+	// Name the input name, available to args as an env variable, ie $REPLICAS.
+	Name string `yaml:"name"`
+
+	// Prompt the input label. Defaults to the name.
+	Prompt string `yaml:"prompt,omitempty"`
+
+	// Default the input initial value. Supports env variables.
+	Default string `yaml:"default,omitempty"`
+
+	// Pattern an optional regular expression the value must match.
+	Pattern string `yaml:"pattern,omitempty"`
+
+	// Required rejects blank values.
+	Required bool `yaml:"required,omitempty"`
+}
+
+// Label returns the input prompt.
+func (i PluginInput) Label() string {
+	if i.Prompt != "" {
+		return i.Prompt
+	}
+
+	return i.Name
+}
+
+// Validate checks a value against the input constraints.
+func (i PluginInput) Validate(v string) error {
+	if v == "" {
+		if i.Required {
+			return fmt.Errorf("%s is required", i.Label())
+		}
+		return nil
+	}
+	if i.Pattern == "" {
+		return nil
+	}
+	rx, err := regexp.Compile(i.Pattern)
+	if err != nil {
+		return fmt.Errorf("invalid pattern for %s: %w", i.Label(), err)
+	}
+	if !rx.MatchString(v) {
+		return fmt.Errorf("%s must match %s", i.Label(), i.Pattern)
+	}
+
+	return nil
 }
 
 func (p Plugin) String() string {
 	return fmt.Sprintf("[%s] %s(%s)", p.ShortCut, p.Command, strings.Join(p.Args, " "))
 }
 
+// OutputMode returns how the plugin output is handled.
+func (p Plugin) OutputMode() (string, error) {
+	switch p.Output {
+	case "":
+		return PluginOutputScreen, nil
+	case PluginOutputScreen, PluginOutputPane, PluginOutputYAML, PluginOutputBackground:
+		return p.Output, nil
+	default:
+		return PluginOutputScreen, fmt.Errorf("invalid plugin output %q", p.Output)
+	}
+}
+
 // NewPlugins returns a new plugin.
 func NewPlugins() Plugins {
 	return Plugins{
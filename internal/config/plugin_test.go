@@ -11,7 +11,7 @@ func TestPluginLoad(t *testing.T) {
 	p := config.NewPlugins()
 	assert.Nil(t, p.LoadPlugins("testdata/plugin.yml"))
 
-	assert.Equal(t, 1, len(p.Plugin))
+	assert.Equal(t, 2, len(p.Plugin))
 	k, ok := p.Plugin["blah"]
 	assert.True(t, ok)
 	assert.Equal(t, "shift-s", k.ShortCut)
@@ -21,4 +21,77 @@ func TestPluginLoad(t *testing.T) {
 	assert.Equal(t, "duh", k.Command)
 	assert.False(t, k.Background)
 	assert.Equal(t, []string{"-n", "$NAMESPACE", "-boolean"}, k.Args)
+	m, err := k.OutputMode()
+	assert.Nil(t, err)
+	assert.Equal(t, config.PluginOutputScreen, m)
+
+	k, ok = p.Plugin["fred"]
+	assert.True(t, ok)
+	m, err = k.OutputMode()
+	assert.Nil(t, err)
+	assert.Equal(t, config.PluginOutputPane, m)
+}
+
+func TestPluginOutputMode(t *testing.T) {
+	uu := map[string]struct {
+		output, e string
+		err       bool
+	}{
+		"default":    {e: config.PluginOutputScreen},
+		"pane":       {output: "pane", e: config.PluginOutputPane},
+		"yaml":       {output: "yaml", e: config.PluginOutputYAML},
+		"background": {output: "background", e: config.PluginOutputBackground},
+		"toast":      {output: "blee", e: config.PluginOutputScreen, err: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			m, err := config.Plugin{Output: u.output}.OutputMode()
+			assert.Equal(t, u.err, err != nil)
+			assert.Equal(t, u.e, m)
+		})
+	}
+}
+
+func TestPluginInputValidate(t *testing.T) {
+	uu := map[string]struct {
+		in  config.PluginInput
+		v   string
+		err bool
+	}{
+		"blank": {
+			in: config.PluginInput{Name: "replicas"},
+		},
+		"required": {
+			in:  config.PluginInput{Name: "replicas", Required: true},
+			err: true,
+		},
+		"match": {
+			in: config.PluginInput{Name: "replicas", Pattern: `^\d+$`},
+			v:  "3",
+		},
+		"mismatch": {
+			in:  config.PluginInput{Name: "replicas", Pattern: `^\d+$`},
+			v:   "three",
+			err: true,
+		},
+		"bad-pattern": {
+			in:  config.PluginInput{Name: "replicas", Pattern: `(`},
+			v:   "3",
+			err: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.err, u.in.Validate(u.v) != nil)
+		})
+	}
+}
+
+func TestPluginInputLabel(t *testing.T) {
+	assert.Equal(t, "replicas", config.PluginInput{Name: "replicas"}.Label())
+	assert.Equal(t, "Replicas count", config.PluginInput{Name: "replicas", Prompt: "Replicas count"}.Label())
 }
@@ -0,0 +1,73 @@
+package config
+
+import "time"
+
+const (
+	// PricingStatic prices resources using a static price table.
+	PricingStatic = "static"
+
+	// PricingHTTP prices resources using a remote billing api.
+	PricingHTTP = "http"
+
+	defaultPricingCurrency = "USD"
+	defaultPricingTimeout  = 10
+)
+
+// Pricing tracks the resources cost estimation options. Prices are hourly.
+type Pricing struct {
+	// Provider the pricing provider, either static or http. Blank disables cost estimation.
+	Provider string `yaml:"provider,omitempty"`
+
+	// Currency the prices currency. Default USD.
+	Currency string `yaml:"currency,omitempty"`
+
+	// InstanceTypes the nodes hourly prices keyed by instance type.
+	InstanceTypes map[string]float64 `yaml:"instanceTypes,omitempty"`
+
+	// CPU the hourly price of a requested cpu core.
+	CPU float64 `yaml:"cpu,omitempty"`
+
+	// Memory the hourly price of a requested GiB of memory.
+	Memory float64 `yaml:"memory,omitempty"`
+
+	// GPU the hourly price of a requested gpu.
+	GPU float64 `yaml:"gpu,omitempty"`
+
+	// URL the http provider endpoint returning the price table as json.
+	URL string `yaml:"url,omitempty"`
+
+	// Timeout the http provider timeout in seconds.
+	Timeout int `yaml:"timeout,omitempty"`
+}
+
+// NewPricing returns a new instance.
+func NewPricing() *Pricing {
+	return &Pricing{
+		Currency: defaultPricingCurrency,
+		Timeout:  defaultPricingTimeout,
+	}
+}
+
+// Enabled checks if cost estimation is turned on.
+func (p *Pricing) Enabled() bool {
+	return p.Provider != ""
+}
+
+// Validate ensures the pricing options are set.
+func (p *Pricing) Validate() {
+	def := NewPricing()
+	if p.Provider != "" && p.Provider != PricingHTTP {
+		p.Provider = PricingStatic
+	}
+	if p.Currency == "" {
+		p.Currency = def.Currency
+	}
+	if p.Timeout <= 0 {
+		p.Timeout = def.Timeout
+	}
+}
+
+// TimeoutDuration returns the http provider timeout.
+func (p *Pricing) TimeoutDuration() time.Duration {
+	return time.Duration(p.Timeout) * time.Second
+}
@@ -0,0 +1,51 @@
+package config_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPricingValidate(t *testing.T) {
+	uu := map[string]struct {
+		p       config.Pricing
+		e       config.Pricing
+		enabled bool
+		timeout time.Duration
+	}{
+		"disabled": {
+			e:       config.Pricing{Currency: "USD", Timeout: 10},
+			timeout: 10 * time.Second,
+		},
+		"static": {
+			p:       config.Pricing{Provider: "static", Currency: "EUR", CPU: 0.03},
+			e:       config.Pricing{Provider: "static", Currency: "EUR", CPU: 0.03, Timeout: 10},
+			enabled: true,
+			timeout: 10 * time.Second,
+		},
+		"http": {
+			p:       config.Pricing{Provider: "http", URL: "http://billing", Timeout: 30},
+			e:       config.Pricing{Provider: "http", Currency: "USD", URL: "http://billing", Timeout: 30},
+			enabled: true,
+			timeout: 30 * time.Second,
+		},
+		"bogus": {
+			p:       config.Pricing{Provider: "bozo", Timeout: -1},
+			e:       config.Pricing{Provider: "static", Currency: "USD", Timeout: 10},
+			enabled: true,
+			timeout: 10 * time.Second,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			u.p.Validate()
+			assert.Equal(t, u.e, u.p)
+			assert.Equal(t, u.enabled, u.p.Enabled())
+			assert.Equal(t, u.timeout, u.p.TimeoutDuration())
+		})
+	}
+}
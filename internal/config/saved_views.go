@@ -0,0 +1,35 @@
+package config
+
+import "sort"
+
+// SavedView tracks a named view state.
+type SavedView struct {
+	// Command the resource command, ie v1/pods.
+	Command string `yaml:"command"`
+
+	// Namespace the view namespace.
+	Namespace string `yaml:"namespace,omitempty"`
+
+	// Filter the view filter expression.
+	Filter string `yaml:"filter,omitempty"`
+
+	// SortColumn the sort column, ie AGE:desc.
+	SortColumn string `yaml:"sortColumn,omitempty"`
+
+	// Columns the custom columns layout.
+	Columns []string `yaml:"columns,omitempty"`
+}
+
+// SavedViews tracks user defined views by name.
+type SavedViews map[string]SavedView
+
+// Names returns the saved view names sorted.
+func (s SavedViews) Names() []string {
+	nn := make([]string, 0, len(s))
+	for n := range s {
+		nn = append(nn, n)
+	}
+	sort.Strings(nn)
+
+	return nn
+}
@@ -0,0 +1,118 @@
+package config
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+
+	"gopkg.in/yaml.v2"
+)
+
+// Sessions tracks the navigation state per context.
+type Sessions struct {
+	Contexts map[string]Session `yaml:"sessions"`
+}
+
+// Session tracks a context navigation state as of the last exit.
+type Session struct {
+	// Workspaces the views of each workspace.
+	Workspaces []SessionWorkspace `yaml:"workspaces,omitempty"`
+
+	// Active the active workspace index.
+	Active int `yaml:"active,omitempty"`
+
+	// PortForwards the active port-forwards.
+	PortForwards []SessionPortForward `yaml:"portForwards,omitempty"`
+}
+
+// SessionWorkspace tracks a workspace resource view and log tail.
+type SessionWorkspace struct {
+	SavedView `yaml:",inline"`
+
+	// Log the log tail opened on top of the view if any.
+	Log *SessionLog `yaml:"log,omitempty"`
+}
+
+// SessionLog tracks a log tail.
+type SessionLog struct {
+	// Command the resource command, ie v1/pods.
+	Command string `yaml:"command"`
+
+	// Path the resource path.
+	Path string `yaml:"path"`
+
+	// Container the container name. Blank for all containers.
+	Container string `yaml:"container,omitempty"`
+}
+
+// SessionPortForward tracks a port-forward.
+type SessionPortForward struct {
+	Path          string `yaml:"path"`
+	Container     string `yaml:"container"`
+	Address       string `yaml:"address,omitempty"`
+	LocalPort     string `yaml:"localPort"`
+	ContainerPort string `yaml:"containerPort"`
+}
+
+// IsEmpty returns true if there is nothing to restore.
+func (s Session) IsEmpty() bool {
+	return len(s.Workspaces) == 0 && len(s.PortForwards) == 0
+}
+
+// NewSessions returns a new instance.
+func NewSessions() *Sessions {
+	return &Sessions{Contexts: make(map[string]Session)}
+}
+
+// Load loads sessions from a given file. A missing file is not an error.
+func (s *Sessions) Load(path string) error {
+	raw, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	var in Sessions
+	if err := yaml.Unmarshal(raw, &in); err != nil {
+		return err
+	}
+	if in.Contexts != nil {
+		s.Contexts = in.Contexts
+	}
+
+	return nil
+}
+
+// Save saves sessions to a given file.
+func (s *Sessions) Save(path string) error {
+	if err := EnsureDirPath(path, DefaultDirMod); err != nil {
+		return err
+	}
+	raw, err := yaml.Marshal(s)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, raw, DefaultFileMod)
+}
+
+// For returns the session of a given context.
+func (s *Sessions) For(context string) (Session, bool) {
+	ss, ok := s.Contexts[context]
+
+	return ss, ok && !ss.IsEmpty()
+}
+
+// Set updates the session of a given context.
+func (s *Sessions) Set(context string, ss Session) {
+	if s.Contexts == nil {
+		s.Contexts = make(map[string]Session)
+	}
+	if ss.IsEmpty() {
+		delete(s.Contexts, context)
+		return
+	}
+	s.Contexts[context] = ss
+}
@@ -0,0 +1,46 @@
+package config_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSessionsLoadMissing(t *testing.T) {
+	s := config.NewSessions()
+
+	assert.Nil(t, s.Load(filepath.Join(t.TempDir(), "sessions.yml")))
+	_, ok := s.For("fred")
+	assert.False(t, ok)
+}
+
+func TestSessionsSaveLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sessions.yml")
+	s := config.NewSessions()
+	s.Set("fred", config.Session{
+		Active: 1,
+		Workspaces: []config.SessionWorkspace{
+			{SavedView: config.SavedView{Command: "v1/pods", Namespace: "default", Filter: "nginx", SortColumn: "AGE:desc"}},
+			{
+				SavedView: config.SavedView{Command: "apps/v1/deployments"},
+				Log:       &config.SessionLog{Command: "v1/pods", Path: "default/p1", Container: "c1"},
+			},
+		},
+		PortForwards: []config.SessionPortForward{
+			{Path: "default/p1", Container: "c1", Address: "localhost", LocalPort: "8080", ContainerPort: "80"},
+		},
+	})
+	s.Set("blee", config.Session{})
+	assert.Nil(t, s.Save(path))
+
+	l := config.NewSessions()
+	assert.Nil(t, l.Load(path))
+	assert.Equal(t, 1, len(l.Contexts))
+	ss, ok := l.For("fred")
+	assert.True(t, ok)
+	assert.Equal(t, s.Contexts["fred"], ss)
+	assert.Equal(t, "nginx", ss.Workspaces[0].Filter)
+	assert.Equal(t, "default/p1", ss.Workspaces[1].Log.Path)
+}
@@ -10,14 +10,32 @@ const defaultDockerShellImage = "busybox:1.35.0"
 // Limits represents resource limits.
 type Limits map[v1.ResourceName]string
 
+// ShellToleration represents a node shell pod toleration.
+type ShellToleration struct {
+	Key      string `yaml:"key,omitempty"`
+	Operator string `yaml:"operator,omitempty"`
+	Value    string `yaml:"value,omitempty"`
+	Effect   string `yaml:"effect,omitempty"`
+}
+
+// ShellSecurityContext represents the node shell container security settings.
+type ShellSecurityContext struct {
+	Privileged   *bool    `yaml:"privileged,omitempty"`
+	RunAsUser    *int64   `yaml:"runAsUser,omitempty"`
+	Capabilities []string `yaml:"capabilities,omitempty"`
+}
+
 // ShellPod represents k9s shell configuration.
 type ShellPod struct {
-	Image     string            `json:"image"`
-	Command   []string          `json:"command,omitempty"`
-	Args      []string          `json:"args,omitempty"`
-	Namespace string            `json:"namespace"`
-	Limits    Limits            `json:"resources,omitempty"`
-	Labels    map[string]string `json:"labels,omitempty"`
+	Image           string                `yaml:"image"`
+	Command         []string              `yaml:"command"`
+	Args            []string              `yaml:"args"`
+	Namespace       string                `yaml:"namespace"`
+	Limits          Limits                `yaml:"limits"`
+	Labels          map[string]string     `yaml:"labels"`
+	Tolerations     []ShellToleration     `yaml:"tolerations,omitempty"`
+	SecurityContext *ShellSecurityContext `yaml:"securityContext,omitempty"`
+	NSEnter         bool                  `yaml:"nsenter,omitempty"`
 }
 
 // NewShellPod returns a new instance.
@@ -45,3 +63,50 @@ func defaultLimits() Limits {
 		v1.ResourceMemory: "100Mi",
 	}
 }
+
+// PodTolerations returns the shell pod tolerations. Defaults to tolerate everything.
+func (s *ShellPod) PodTolerations() []v1.Toleration {
+	if len(s.Tolerations) == 0 {
+		return []v1.Toleration{
+			{Operator: v1.TolerationOpExists},
+		}
+	}
+
+	tt := make([]v1.Toleration, 0, len(s.Tolerations))
+	for _, t := range s.Tolerations {
+		tt = append(tt, v1.Toleration{
+			Key:      t.Key,
+			Operator: v1.TolerationOperator(t.Operator),
+			Value:    t.Value,
+			Effect:   v1.TaintEffect(t.Effect),
+		})
+	}
+
+	return tt
+}
+
+// PodSecurityContext returns the shell container security context.
+// Defaults to a privileged container.
+func (s *ShellPod) PodSecurityContext() *v1.SecurityContext {
+	priv := true
+	if s.SecurityContext == nil {
+		return &v1.SecurityContext{Privileged: &priv}
+	}
+
+	sc := v1.SecurityContext{
+		Privileged: &priv,
+		RunAsUser:  s.SecurityContext.RunAsUser,
+	}
+	if s.SecurityContext.Privileged != nil {
+		sc.Privileged = s.SecurityContext.Privileged
+	}
+	if len(s.SecurityContext.Capabilities) > 0 {
+		cc := make([]v1.Capability, 0, len(s.SecurityContext.Capabilities))
+		for _, c := range s.SecurityContext.Capabilities {
+			cc = append(cc, v1.Capability(c))
+		}
+		sc.Capabilities = &v1.Capabilities{Add: cc}
+	}
+
+	return &sc
+}
@@ -0,0 +1,69 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v2"
+	v1 "k8s.io/api/core/v1"
+)
+
+func TestShellPodUnmarshal(t *testing.T) {
+	raw := `
+image: busybox
+namespace: fred
+limits:
+  cpu: 200m
+nsenter: true
+tolerations:
+- key: blee
+  operator: Exists
+securityContext:
+  runAsUser: 1000
+  capabilities:
+  - SYS_ADMIN
+`
+	var s config.ShellPod
+	assert.Nil(t, yaml.Unmarshal([]byte(raw), &s))
+	assert.Equal(t, "busybox", s.Image)
+	assert.Equal(t, "fred", s.Namespace)
+	assert.Equal(t, config.Limits{v1.ResourceCPU: "200m"}, s.Limits)
+	assert.True(t, s.NSEnter)
+	assert.Equal(t, []config.ShellToleration{{Key: "blee", Operator: "Exists"}}, s.Tolerations)
+	assert.Equal(t, int64(1000), *s.SecurityContext.RunAsUser)
+	assert.Equal(t, []string{"SYS_ADMIN"}, s.SecurityContext.Capabilities)
+}
+
+func TestShellPodTolerations(t *testing.T) {
+	s := config.NewShellPod()
+	assert.Equal(t, []v1.Toleration{{Operator: v1.TolerationOpExists}}, s.PodTolerations())
+
+	s.Tolerations = []config.ShellToleration{
+		{Key: "fred", Operator: "Equal", Value: "blee", Effect: "NoSchedule"},
+	}
+	assert.Equal(t, []v1.Toleration{
+		{Key: "fred", Operator: v1.TolerationOpEqual, Value: "blee", Effect: v1.TaintEffectNoSchedule},
+	}, s.PodTolerations())
+}
+
+func TestShellPodSecurityContext(t *testing.T) {
+	s := config.NewShellPod()
+	sc := s.PodSecurityContext()
+	assert.True(t, *sc.Privileged)
+	assert.Nil(t, sc.Capabilities)
+
+	var (
+		priv bool
+		uid  int64 = 1000
+	)
+	s.SecurityContext = &config.ShellSecurityContext{
+		Privileged:   &priv,
+		RunAsUser:    &uid,
+		Capabilities: []string{"SYS_ADMIN"},
+	}
+	sc = s.PodSecurityContext()
+	assert.False(t, *sc.Privileged)
+	assert.Equal(t, int64(1000), *sc.RunAsUser)
+	assert.Equal(t, []v1.Capability{"SYS_ADMIN"}, sc.Capabilities.Add)
+}
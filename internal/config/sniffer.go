@@ -0,0 +1,49 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+)
+
+const (
+	defaultSnifferImage     = "nicolaka/netshoot:v0.11"
+	defaultSnifferInterface = "any"
+)
+
+// Sniffer tracks pod packet capture options.
+type Sniffer struct {
+	// Image the capture container image. Must provide tcpdump.
+	Image string `yaml:"image,omitempty"`
+
+	// Interface the network interface to capture on.
+	Interface string `yaml:"interface,omitempty"`
+
+	// Dir the local directory where captures are stored.
+	Dir string `yaml:"dir,omitempty"`
+
+	// Analyzer an optional command launched on the capture file once done, ie wireshark.
+	Analyzer string `yaml:"analyzer,omitempty"`
+}
+
+// NewSniffer returns a new instance.
+func NewSniffer() *Sniffer {
+	return &Sniffer{
+		Image:     defaultSnifferImage,
+		Interface: defaultSnifferInterface,
+		Dir:       filepath.Join(os.TempDir(), "k9s-sniff-"+MustK9sUser()),
+	}
+}
+
+// Validate ensures the sniffer options are set.
+func (s *Sniffer) Validate() {
+	def := NewSniffer()
+	if s.Image == "" {
+		s.Image = def.Image
+	}
+	if s.Interface == "" {
+		s.Interface = def.Interface
+	}
+	if s.Dir == "" {
+		s.Dir = def.Dir
+	}
+}
@@ -0,0 +1,23 @@
+package config
+
+// StatusBar tracks the status bar options displayed when the header is collapsed.
+type StatusBar struct {
+	// Template a go template rendering the status bar, ie `{{.Context}} {{.Namespace}}`.
+	Template string `yaml:"template,omitempty"`
+
+	// Sections lists the sections to display in order, ie k9s, context, cpu, mem.
+	Sections []string `yaml:"sections,omitempty"`
+
+	// Zen collapses the header and crumbs to maximize table space.
+	Zen bool `yaml:"zen,omitempty"`
+}
+
+// NewStatusBar returns a new instance.
+func NewStatusBar() *StatusBar {
+	return &StatusBar{}
+}
+
+// IsCustom returns true if the default status bar layout was overridden.
+func (s *StatusBar) IsCustom() bool {
+	return s.Template != "" || len(s.Sections) > 0
+}
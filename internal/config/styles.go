@@ -4,12 +4,31 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"reflect"
+	"strconv"
 
 	"github.com/derailed/tcell/v2"
 	"github.com/derailed/tview"
 	"gopkg.in/yaml.v2"
 )
 
+const (
+	// ThemeDark represents the stock dark background palette.
+	ThemeDark = "dark"
+
+	// ThemeLight represents the stock light background palette.
+	ThemeLight = "light"
+
+	// ThemeMono represents a colorless palette using the terminal colors.
+	ThemeMono = "mono"
+)
+
+// NoColor returns true if colors are disabled via the NO_COLOR env var.
+// See https://no-color.org.
+func NoColor() bool {
+	return os.Getenv("NO_COLOR") != ""
+}
+
 // K9sStylesFile represents K9s skins file location.
 var K9sStylesFile = filepath.Join(K9sHome(), "skin.yml")
 
@@ -29,6 +48,7 @@ type (
 	// Styles tracks K9s styling options.
 	Styles struct {
 		K9s       Style `yaml:"k9s"`
+		theme     string
 		listeners []StyleListener
 	}
 
@@ -233,11 +253,19 @@ func (c Color) isHex() bool {
 	return len(c) == 7 && c[0] == '#'
 }
 
-// Color returns a view color.
+// Color returns a view color. Colors may be W3C names, #rrggbb or #rgb hex
+// values or 256 colors palette indexes. RGB colors are degraded to the closest
+// palette color on terminals lacking truecolor support.
 func (c Color) Color() tcell.Color {
 	if c == DefaultColor {
 		return tcell.ColorDefault
 	}
+	if len(c) == 4 && c[0] == '#' {
+		return Color([]byte{'#', c[1], c[1], c[2], c[2], c[3], c[3]}).Color()
+	}
+	if n, err := strconv.Atoi(string(c)); err == nil && n >= 0 && n < 256 {
+		return tcell.PaletteColor(n)
+	}
 
 	return tcell.GetColor(string(c)).TrueColor()
 }
@@ -263,6 +291,58 @@ func newStyle() Style {
 	}
 }
 
+// newLightStyle returns a palette suited for light background terminals.
+func newLightStyle() Style {
+	s := newStyle()
+
+	s.Body = Body{FgColor: "#303030", BgColor: "#fafafa", LogoColor: "#d75f00"}
+	s.Prompt = Prompt{FgColor: "#303030", BgColor: "#fafafa", SuggestColor: "#0087af"}
+	s.Help = Help{FgColor: "#303030", BgColor: "#fafafa", SectionColor: "#008700", KeyColor: "#005faf", NumKeyColor: "#af005f"}
+	s.Dialog = Dialog{
+		FgColor:            "#303030",
+		BgColor:            "#fafafa",
+		ButtonBgColor:      "#d0d0d0",
+		ButtonFgColor:      "#303030",
+		ButtonFocusBgColor: "#005faf",
+		ButtonFocusFgColor: "#ffffff",
+		LabelFgColor:       "#005faf",
+		FieldFgColor:       "#303030",
+	}
+	s.Info = Info{SectionColor: "#303030", FgColor: "#d75f00"}
+
+	s.Frame.Title = Title{FgColor: "#005f87", BgColor: "#fafafa", HighlightColor: "#af005f", CounterColor: "#875f00", FilterColor: "#008700"}
+	s.Frame.Border = Border{FgColor: "#87afd7", FocusColor: "#005faf"}
+	s.Frame.Menu = Menu{FgColor: "#303030", KeyColor: "#005faf", NumKeyColor: "#af005f"}
+	s.Frame.Crumb = Crumb{FgColor: "#ffffff", BgColor: "#005faf", ActiveColor: "#d75f00"}
+	s.Frame.Status = Status{
+		NewColor:       "#005f87",
+		ModifyColor:    "#5f8700",
+		AddColor:       "#0000af",
+		PendingColor:   "#af5f00",
+		ErrorColor:     "#d70000",
+		HighlightColor: "#008787",
+		KillColor:      "#5f00af",
+		CompletedColor: "#6c6c6c",
+	}
+
+	s.Views.Table = Table{
+		FgColor:       "#005f87",
+		BgColor:       "#fafafa",
+		CursorFgColor: "#ffffff",
+		CursorBgColor: "#005faf",
+		MarkColor:     "#5f8700",
+		Header:        TableHeader{FgColor: "#303030", BgColor: "#fafafa", SorterColor: "#005faf"},
+	}
+	s.Views.Xray = Xray{FgColor: "#005f87", BgColor: "#fafafa", CursorColor: "#005faf", CursorTextColor: "#ffffff", GraphicColor: "#5f87af"}
+	s.Views.Yaml = Yaml{KeyColor: "#005faf", ColonColor: "#303030", ValueColor: "#875f00"}
+	s.Views.Log = Log{FgColor: "#303030", BgColor: "#fafafa", Indicator: LogIndicator{FgColor: "#005faf", BgColor: "#fafafa"}}
+	s.Views.Charts.BgColor = "#fafafa"
+	s.Views.Charts.DialBgColor = "#fafafa"
+	s.Views.Charts.ChartBgColor = "#fafafa"
+
+	return s
+}
+
 func newDialog() Dialog {
 	return Dialog{
 		FgColor:            "cadetblue",
@@ -450,12 +530,55 @@ func NewStyles() *Styles {
 
 // Reset resets styles.
 func (s *Styles) Reset() {
-	s.K9s = newStyle()
+	s.K9s = themeStyle(s.theme)
 }
 
 // DefaultSkin loads the default skin.
 func (s *Styles) DefaultSkin() {
-	s.K9s = newStyle()
+	s.K9s = themeStyle(s.theme)
+}
+
+// SetTheme sets the built-in palette skins are layered on, ie dark or light.
+func (s *Styles) SetTheme(theme string) {
+	s.theme = theme
+}
+
+func themeStyle(theme string) Style {
+	switch theme {
+	case ThemeLight:
+		return newLightStyle()
+	case ThemeMono:
+		s := newStyle()
+		monochrome(reflect.ValueOf(&s).Elem())
+		return s
+	default:
+		return newStyle()
+	}
+}
+
+// monochrome resets all colors to the terminal default colors.
+func monochrome(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			monochrome(v.Field(i))
+		}
+	case reflect.Map:
+		for _, k := range v.MapKeys() {
+			c := reflect.New(v.Type().Elem()).Elem()
+			c.Set(v.MapIndex(k))
+			monochrome(c)
+			v.SetMapIndex(k, c)
+		}
+	case reflect.Slice:
+		if v.Type() == reflect.TypeOf(Colors{}) {
+			v.Set(reflect.ValueOf(Colors{DefaultColor, DefaultColor}))
+		}
+	case reflect.String:
+		if v.Type() == reflect.TypeOf(DefaultColor) && v.CanSet() {
+			v.SetString(string(DefaultColor))
+		}
+	}
 }
 
 // FgColor returns the foreground color.
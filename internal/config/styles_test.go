@@ -15,6 +15,9 @@ func TestColor(t *testing.T) {
 		"blue":    tcell.ColorBlue.TrueColor(),
 		"#ffffff": tcell.NewHexColor(16777215),
 		"#ff0000": tcell.NewHexColor(16711680),
+		"#f00":    tcell.NewHexColor(16711680),
+		"208":     tcell.PaletteColor(208),
+		"256":     tcell.ColorDefault,
 	}
 
 	for k := range uu {
@@ -51,6 +54,31 @@ func TestSkin(t *testing.T) {
 	assert.Equal(t, tcell.ColorBlack.TrueColor(), tview.Styles.PrimitiveBackgroundColor)
 }
 
+func TestSkinLightTheme(t *testing.T) {
+	s := config.NewStyles()
+	s.SetTheme(config.ThemeLight)
+	s.Reset()
+	assert.Equal(t, "#fafafa", s.Body().BgColor.String())
+
+	assert.Nil(t, s.Load("testdata/empty_skin.yml"))
+	assert.Equal(t, "#fafafa", s.Table().BgColor.String())
+
+	s.SetTheme(config.ThemeDark)
+	s.Reset()
+	assert.Equal(t, "#000000", s.Body().BgColor.String())
+}
+
+func TestSkinMonoTheme(t *testing.T) {
+	s := config.NewStyles()
+	s.SetTheme(config.ThemeMono)
+	s.Reset()
+
+	assert.Equal(t, tcell.ColorDefault, s.FgColor())
+	assert.Equal(t, tcell.ColorDefault, s.Table().CursorBgColor.Color())
+	assert.Equal(t, tcell.ColorDefault, s.Frame().Status.ErrorColor.Color())
+	assert.Equal(t, []tcell.Color{tcell.ColorDefault, tcell.ColorDefault}, s.Charts().ResourceColors["cpu"].Colors())
+}
+
 func TestSkinNotExits(t *testing.T) {
 	s := config.NewStyles()
 	assert.NotNil(t, s.Load("testdata/blee.yml"))
@@ -0,0 +1,147 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+
+	"gopkg.in/yaml.v2"
+)
+
+// K9sTemplates manages K9s resource templates.
+var K9sTemplates = filepath.Join(K9sHome(), "templates.yml")
+
+// Templates represents a collection of resource templates.
+type Templates struct {
+	Template map[string]Template `yaml:"template"`
+}
+
+// Template describes a resource manifest template. Manifests reference
+// inputs and k9s env variables ie $NAMESPACE or ${NAME}.
+type Template struct {
+	// Kind the resource the template creates, ie pod or batch/v1/jobs.
+	Kind        string        `yaml:"kind"`
+	Description string        `yaml:"description"`
+	Inputs      []PluginInput `yaml:"inputs,omitempty"`
+	Manifest    string        `yaml:"manifest"`
+}
+
+// NewTemplates returns the built in templates.
+func NewTemplates() Templates {
+	return Templates{
+		Template: map[string]Template{
+			"debug": {
+				Kind:        "pod",
+				Description: "Debug pod",
+				Inputs: []PluginInput{
+					{Name: "NAME", Default: "debug", Required: true},
+					{Name: "IMAGE", Default: "busybox:1.36", Required: true},
+				},
+				Manifest: debugPodTemplate,
+			},
+			"netshoot": {
+				Kind:        "pod",
+				Description: "Network troubleshooting pod",
+				Inputs: []PluginInput{
+					{Name: "NAME", Default: "netshoot", Required: true},
+				},
+				Manifest: netshootPodTemplate,
+			},
+			"busybox": {
+				Kind:        "job",
+				Description: "Busybox job",
+				Inputs: []PluginInput{
+					{Name: "NAME", Default: "busybox", Required: true},
+					{Name: "COMMAND", Prompt: "Command", Default: "echo hello", Required: true},
+				},
+				Manifest: busyboxJobTemplate,
+			},
+		},
+	}
+}
+
+// Load K9s templates.
+func (t Templates) Load() error {
+	return t.LoadTemplates(K9sTemplates)
+}
+
+// LoadTemplates loads templates from a given file. Custom templates override
+// built in ones with the same name.
+func (t Templates) LoadTemplates(path string) error {
+	f, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	var tt Templates
+	if err := yaml.Unmarshal(f, &tt); err != nil {
+		return err
+	}
+	for k, v := range tt.Template {
+		t.Template[k] = v
+	}
+
+	return nil
+}
+
+// Names returns the sorted names of the templates matching a given kind filter.
+func (t Templates) Names(match func(kind string) bool) []string {
+	nn := make([]string, 0, len(t.Template))
+	for n, tpl := range t.Template {
+		if match(tpl.Kind) {
+			nn = append(nn, n)
+		}
+	}
+	sort.Strings(nn)
+
+	return nn
+}
+
+const debugPodTemplate = `apiVersion: v1
+kind: Pod
+metadata:
+  name: ${NAME}
+  namespace: ${NAMESPACE}
+  labels:
+    app.kubernetes.io/managed-by: k9s
+spec:
+  restartPolicy: Never
+  containers:
+  - name: debug
+    image: ${IMAGE}
+    command: ["sleep", "3600"]
+`
+
+const netshootPodTemplate = `apiVersion: v1
+kind: Pod
+metadata:
+  name: ${NAME}
+  namespace: ${NAMESPACE}
+  labels:
+    app.kubernetes.io/managed-by: k9s
+spec:
+  restartPolicy: Never
+  containers:
+  - name: netshoot
+    image: nicolaka/netshoot
+    command: ["sleep", "3600"]
+`
+
+const busyboxJobTemplate = `apiVersion: batch/v1
+kind: Job
+metadata:
+  name: ${NAME}
+  namespace: ${NAMESPACE}
+  labels:
+    app.kubernetes.io/managed-by: k9s
+spec:
+  backoffLimit: 0
+  ttlSecondsAfterFinished: 600
+  template:
+    spec:
+      restartPolicy: Never
+      containers:
+      - name: busybox
+        image: busybox:1.36
+        command: ["sh", "-c", "${COMMAND}"]
+`
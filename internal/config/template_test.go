@@ -0,0 +1,43 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTemplatesLoad(t *testing.T) {
+	tt := config.NewTemplates()
+	assert.Nil(t, tt.LoadTemplates("testdata/templates.yml"))
+
+	assert.Equal(t, 4, len(tt.Template))
+	d, ok := tt.Template["debug"]
+	assert.True(t, ok)
+	assert.Equal(t, "po", d.Kind)
+	assert.Equal(t, "Custom debug pod", d.Description)
+	assert.Equal(t, []config.PluginInput{{Name: "NAME", Default: "fred"}}, d.Inputs)
+	assert.Equal(t, "apiVersion: v1\nkind: Pod\nmetadata:\n  name: ${NAME}\n", d.Manifest)
+	_, ok = tt.Template["netshoot"]
+	assert.True(t, ok)
+}
+
+func TestTemplatesNames(t *testing.T) {
+	tt := config.NewTemplates()
+
+	uu := map[string]struct {
+		kind string
+		e    []string
+	}{
+		"pods": {kind: "pod", e: []string{"debug", "netshoot"}},
+		"jobs": {kind: "job", e: []string{"busybox"}},
+		"none": {kind: "svc", e: []string{}},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, tt.Names(func(kind string) bool { return kind == u.kind }))
+		})
+	}
+}
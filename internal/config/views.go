@@ -4,6 +4,7 @@ import (
 	"os"
 	"path/filepath"
 
+	"github.com/derailed/k9s/internal/client"
 	"gopkg.in/yaml.v2"
 )
 
@@ -19,7 +20,7 @@ type ViewConfigListener interface {
 // ViewSetting represents a view configuration.
 type ViewSetting struct {
 	Columns    []string `yaml:"columns"`
-	SortColumn string   `yaml:"sortColumn"`
+	SortColumn string   `yaml:"sortColumn,omitempty"`
 }
 
 // ViewSettings represent a collection of view configurations.
@@ -72,6 +73,37 @@ func (v *CustomView) Load(path string) error {
 	return nil
 }
 
+// Save saves view configurations to disk.
+func (v *CustomView) Save(path string) error {
+	if err := EnsureDirPath(path, DefaultDirMod); err != nil {
+		return err
+	}
+	raw, err := yaml.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, raw, 0644)
+}
+
+// SetColumns sets a given view columns layout. An empty layout reverts
+// the view to its default columns.
+func (v *CustomView) SetColumns(gvr string, cols []string) {
+	if v.K9s.Views == nil {
+		v.K9s.Views = make(map[string]ViewSetting)
+	}
+	vs := v.K9s.Views[gvr]
+	vs.Columns = cols
+	if len(vs.Columns) == 0 && vs.SortColumn == "" {
+		delete(v.K9s.Views, gvr)
+	} else {
+		v.K9s.Views[gvr] = vs
+	}
+	if l, ok := v.listeners[gvr]; ok {
+		l.ViewSettingsChanged(vs)
+	}
+}
+
 // AddListener registers a new listener.
 func (v *CustomView) AddListener(gvr string, l ViewConfigListener) {
 	v.listeners[gvr] = l
@@ -83,10 +115,25 @@ func (v *CustomView) RemoveListener(gvr string) {
 	delete(v.listeners, gvr)
 }
 
+// Setting returns a view setting for a given resource. Custom resources
+// settings may also be keyed by their CRD name ie widgets.acme.io.
+func (v *CustomView) Setting(gvr string) (ViewSetting, bool) {
+	if vs, ok := v.K9s.Views[gvr]; ok {
+		return vs, true
+	}
+	g := client.NewGVR(gvr)
+	if g.G() == "" {
+		return ViewSetting{}, false
+	}
+	vs, ok := v.K9s.Views[g.R()+"."+g.G()]
+
+	return vs, ok
+}
+
 func (v *CustomView) fireConfigChanged() {
 	for gvr, list := range v.listeners {
-		if v, ok := v.K9s.Views[gvr]; ok {
-			list.ViewSettingsChanged(v)
+		if vs, ok := v.Setting(gvr); ok {
+			list.ViewSettingsChanged(vs)
 		}
 	}
 }
@@ -1,6 +1,7 @@
 package config_test
 
 import (
+	"path/filepath"
 	"testing"
 
 	"github.com/derailed/k9s/internal/config"
@@ -14,3 +15,55 @@ func TestViewSettingsLoad(t *testing.T) {
 	assert.Equal(t, 1, len(cfg.K9s.Views))
 	assert.Equal(t, 4, len(cfg.K9s.Views["v1/pods"].Columns))
 }
+
+func TestViewSettingsSetColumns(t *testing.T) {
+	cfg := config.NewCustomView()
+	assert.Nil(t, cfg.Load("testdata/view_settings.yml"))
+
+	cfg.SetColumns("v1/services", []string{"NAME", "TYPE"})
+	assert.Equal(t, 2, len(cfg.K9s.Views))
+	assert.Equal(t, []string{"NAME", "TYPE"}, cfg.K9s.Views["v1/services"].Columns)
+
+	cfg.SetColumns("v1/pods", nil)
+	assert.Equal(t, 1, len(cfg.K9s.Views))
+	_, ok := cfg.K9s.Views["v1/pods"]
+	assert.False(t, ok)
+}
+
+func TestViewSettingsSave(t *testing.T) {
+	cfg := config.NewCustomView()
+	cfg.SetColumns("v1/pods", []string{"NAME", "APP:label:app"})
+
+	path := filepath.Join(t.TempDir(), "views.yml")
+	assert.Nil(t, cfg.Save(path))
+
+	cfg1 := config.NewCustomView()
+	assert.Nil(t, cfg1.Load(path))
+	assert.Equal(t, cfg.K9s.Views, cfg1.K9s.Views)
+}
+
+func TestViewSettingsSetting(t *testing.T) {
+	cfg := config.NewCustomView()
+	cfg.SetColumns("v1/pods", []string{"NAME"})
+	cfg.SetColumns("widgets.acme.io", []string{"NAME", "SIZE:.spec.size"})
+
+	uu := map[string]struct {
+		gvr string
+		e   []string
+		ok  bool
+	}{
+		"gvr":     {gvr: "v1/pods", e: []string{"NAME"}, ok: true},
+		"crdName": {gvr: "acme.io/v1/widgets", e: []string{"NAME", "SIZE:.spec.size"}, ok: true},
+		"none":    {gvr: "acme.io/v1/gadgets"},
+		"core":    {gvr: "v1/services"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			vs, ok := cfg.Setting(u.gvr)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.e, vs.Columns)
+		})
+	}
+}
@@ -0,0 +1,352 @@
+package dao
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ Accessor = (*Alert)(nil)
+
+// Alert represents the active cluster alerts.
+type Alert struct {
+	NonResource
+}
+
+// List returns the active cluster alerts.
+func (a *Alert) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	aa, ok := ctx.Value(internal.KeyAlerts).(*Alerts)
+	if !ok || aa == nil {
+		return nil, errors.New("alerts watcher is disabled. Check your k9s alerts configuration")
+	}
+	rr := aa.Active()
+	oo := make([]runtime.Object, 0, len(rr))
+	for _, r := range rr {
+		oo = append(oo, r)
+	}
+
+	return oo, nil
+}
+
+const (
+	// alertsQueue bounds the number of pending alert notifications.
+	alertsQueue = 50
+
+	// alertNotifyTimeout bounds an alert command or webhook run.
+	alertNotifyTimeout = 30 * time.Second
+)
+
+// Alerts tracks cluster alerts raised by a collection of rules.
+type Alerts struct {
+	rules  []config.AlertRule
+	active map[string]render.AlertRes
+	seeded bool
+	queue  chan render.AlertRes
+	mx     sync.RWMutex
+}
+
+// NewAlerts returns a new alerts tracker.
+func NewAlerts(rules []config.AlertRule) *Alerts {
+	return &Alerts{
+		rules:  rules,
+		active: make(map[string]render.AlertRes),
+		queue:  make(chan render.AlertRes, alertsQueue),
+	}
+}
+
+// Active returns the currently active alerts.
+func (a *Alerts) Active() []render.AlertRes {
+	a.mx.RLock()
+	defer a.mx.RUnlock()
+
+	rr := make([]render.AlertRes, 0, len(a.active))
+	for _, r := range a.active {
+		rr = append(rr, r)
+	}
+	sort.Slice(rr, func(i, j int) bool {
+		return rr[i].ID() < rr[j].ID()
+	})
+
+	return rr
+}
+
+// Update records the current alerts and returns the newly raised ones. The
+// first update only seeds the active alerts so conditions already present
+// on startup are not reported.
+func (a *Alerts) Update(rr []render.AlertRes) []render.AlertRes {
+	a.mx.Lock()
+	defer a.mx.Unlock()
+
+	var raised []render.AlertRes
+	active := make(map[string]render.AlertRes, len(rr))
+	for _, r := range rr {
+		if _, ok := a.active[r.ID()]; !ok && a.seeded {
+			raised = append(raised, r)
+		}
+		active[r.ID()] = r
+	}
+	a.active, a.seeded = active, true
+
+	return raised
+}
+
+// Check evaluates the alert rules against the cluster and returns the newly raised alerts.
+func (a *Alerts) Check(ctx context.Context, f Factory) ([]render.AlertRes, error) {
+	var (
+		pods  []v1.Pod
+		nodes []v1.Node
+		pvcs  []v1.PersistentVolumeClaim
+	)
+	for _, r := range a.rules {
+		var err error
+		switch r.Kind {
+		case config.CrashLoopAlert:
+			if pods == nil {
+				pods, err = listPods(f)
+			}
+		case config.NodeNotReadyAlert:
+			if nodes == nil {
+				var nn *v1.NodeList
+				if nn, err = FetchNodes(ctx, f, ""); err == nil {
+					nodes = nn.Items
+				}
+			}
+		case config.PVCPendingAlert:
+			if pvcs == nil {
+				pvcs, err = listPVCs(f)
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return a.Update(EvalAlerts(a.rules, pods, nodes, pvcs, time.Now())), nil
+}
+
+// Dispatch queues an alert notification. Notifications are dropped when the
+// queue is full.
+func (a *Alerts) Dispatch(r render.AlertRes) bool {
+	select {
+	case a.queue <- r:
+		return true
+	default:
+		return false
+	}
+}
+
+// Notifier runs the queued alert notifications one at a time until canceled.
+func (a *Alerts) Notifier(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case r := <-a.queue:
+			nctx, cancel := context.WithTimeout(ctx, alertNotifyTimeout)
+			if err := a.Notify(nctx, r); err != nil {
+				log.Error().Err(err).Msgf("Alert %s notification failed", r.Rule)
+			}
+			cancel()
+		}
+	}
+}
+
+// Notify runs the alert rule command and webhook if any.
+func (a *Alerts) Notify(ctx context.Context, r render.AlertRes) error {
+	if r.RuleIndex < 0 || r.RuleIndex >= len(a.rules) {
+		return fmt.Errorf("no alert rule found for %q", r.Rule)
+	}
+	rule := a.rules[r.RuleIndex]
+	if rule.Command != "" {
+		// #nosec G204
+		cmd := exec.CommandContext(ctx, "sh", "-c", rule.Command)
+		cmd.Env = append(os.Environ(),
+			"K9S_ALERT_RULE="+r.Rule,
+			"K9S_ALERT_KIND="+r.Kind,
+			"K9S_ALERT_RESOURCE="+r.Resource,
+			"K9S_ALERT_MESSAGE="+r.Message,
+		)
+		if out, err := cmd.CombinedOutput(); err != nil {
+			return fmt.Errorf("alert command failed: %w -- %s", err, string(out))
+		}
+	}
+	if rule.Webhook != "" {
+		return postAlert(ctx, rule.Webhook, r)
+	}
+
+	return nil
+}
+
+// EvalAlerts evaluates the alert rules against the given resources.
+func EvalAlerts(rules []config.AlertRule, pods []v1.Pod, nodes []v1.Node, pvcs []v1.PersistentVolumeClaim, now time.Time) []render.AlertRes {
+	var rr []render.AlertRes
+	for i, r := range rules {
+		var cc []render.AlertRes
+		switch r.Kind {
+		case config.CrashLoopAlert:
+			cc = crashLoopAlerts(pods, now)
+		case config.NodeNotReadyAlert:
+			cc = nodeNotReadyAlerts(nodes)
+		case config.PVCPendingAlert:
+			cc = pvcPendingAlerts(pvcs)
+		}
+		grace := r.Grace()
+		for _, c := range cc {
+			if now.Sub(c.Since) < grace {
+				continue
+			}
+			c.Rule, c.Kind, c.RuleIndex = r.Name, r.Kind, i
+			rr = append(rr, c)
+		}
+	}
+
+	return rr
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func crashLoopAlerts(pods []v1.Pod, now time.Time) []render.AlertRes {
+	var rr []render.AlertRes
+	for _, po := range pods {
+		cs := append(append([]v1.ContainerStatus{}, po.Status.InitContainerStatuses...), po.Status.ContainerStatuses...)
+		for _, s := range cs {
+			if s.State.Waiting == nil || s.State.Waiting.Reason != "CrashLoopBackOff" {
+				continue
+			}
+			since := now
+			if t := s.LastTerminationState.Terminated; t != nil && !t.FinishedAt.IsZero() {
+				since = t.FinishedAt.Time
+			}
+			rr = append(rr, render.AlertRes{
+				GVR:      "v1/pods",
+				Resource: client.FQN(po.Namespace, po.Name),
+				Message:  fmt.Sprintf("container %s is crash looping (%d restarts)", s.Name, s.RestartCount),
+				Since:    since,
+			})
+			break
+		}
+	}
+
+	return rr
+}
+
+func nodeNotReadyAlerts(nodes []v1.Node) []render.AlertRes {
+	var rr []render.AlertRes
+	for _, no := range nodes {
+		for _, c := range no.Status.Conditions {
+			if c.Type != v1.NodeReady || c.Status == v1.ConditionTrue {
+				continue
+			}
+			msg := "node is not ready"
+			if c.Reason != "" {
+				msg += ": " + c.Reason
+			}
+			rr = append(rr, render.AlertRes{
+				GVR:      "v1/nodes",
+				Resource: no.Name,
+				Message:  msg,
+				Since:    c.LastTransitionTime.Time,
+			})
+		}
+	}
+
+	return rr
+}
+
+func pvcPendingAlerts(pvcs []v1.PersistentVolumeClaim) []render.AlertRes {
+	var rr []render.AlertRes
+	for _, pvc := range pvcs {
+		if pvc.Status.Phase != v1.ClaimPending {
+			continue
+		}
+		rr = append(rr, render.AlertRes{
+			GVR:      "v1/persistentvolumeclaims",
+			Resource: client.FQN(pvc.Namespace, pvc.Name),
+			Message:  "claim is pending",
+			Since:    pvc.CreationTimestamp.Time,
+		})
+	}
+
+	return rr
+}
+
+func listPods(f Factory) ([]v1.Pod, error) {
+	oo, err := f.List("v1/pods", client.AllNamespaces, false, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	pp := make([]v1.Pod, 0, len(oo))
+	for _, o := range oo {
+		var po v1.Pod
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &po); err != nil {
+			return nil, err
+		}
+		pp = append(pp, po)
+	}
+
+	return pp, nil
+}
+
+func listPVCs(f Factory) ([]v1.PersistentVolumeClaim, error) {
+	oo, err := f.List("v1/persistentvolumeclaims", client.AllNamespaces, false, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	pp := make([]v1.PersistentVolumeClaim, 0, len(oo))
+	for _, o := range oo {
+		var pvc v1.PersistentVolumeClaim
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &pvc); err != nil {
+			return nil, err
+		}
+		pp = append(pp, pvc)
+	}
+
+	return pp, nil
+}
+
+func postAlert(ctx context.Context, url string, r render.AlertRes) error {
+	raw, err := json.Marshal(map[string]string{
+		"rule":     r.Rule,
+		"kind":     r.Kind,
+		"resource": r.Resource,
+		"message":  r.Message,
+		"since":    r.Since.Format(time.RFC3339),
+	})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(raw))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("alert webhook failed: %s", resp.Status)
+	}
+
+	return nil
+}
@@ -0,0 +1,168 @@
+package dao_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestEvalAlerts(t *testing.T) {
+	now := time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)
+	pods := []v1.Pod{
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "p1"},
+			Status: v1.PodStatus{
+				ContainerStatuses: []v1.ContainerStatus{
+					{
+						Name:         "c1",
+						RestartCount: 5,
+						State:        v1.ContainerState{Waiting: &v1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}},
+					},
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "p2"},
+			Status: v1.PodStatus{
+				ContainerStatuses: []v1.ContainerStatus{
+					{Name: "c1", State: v1.ContainerState{Running: &v1.ContainerStateRunning{}}},
+				},
+			},
+		},
+	}
+	nodes := []v1.Node{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "n1"},
+			Status: v1.NodeStatus{
+				Conditions: []v1.NodeCondition{
+					{
+						Type:               v1.NodeReady,
+						Status:             v1.ConditionUnknown,
+						Reason:             "NodeStatusUnknown",
+						LastTransitionTime: metav1.NewTime(now.Add(-time.Minute)),
+					},
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "n2"},
+			Status: v1.NodeStatus{
+				Conditions: []v1.NodeCondition{{Type: v1.NodeReady, Status: v1.ConditionTrue}},
+			},
+		},
+	}
+	pvcs := []v1.PersistentVolumeClaim{
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "c1", CreationTimestamp: metav1.NewTime(now.Add(-10 * time.Minute))},
+			Status:     v1.PersistentVolumeClaimStatus{Phase: v1.ClaimPending},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "c2", CreationTimestamp: metav1.NewTime(now.Add(-time.Minute))},
+			Status:     v1.PersistentVolumeClaimStatus{Phase: v1.ClaimPending},
+		},
+	}
+
+	uu := map[string]struct {
+		rules []config.AlertRule
+		e     []render.AlertRes
+	}{
+		"none": {},
+		"crashloop": {
+			rules: []config.AlertRule{{Name: "r1", Kind: config.CrashLoopAlert}},
+			e: []render.AlertRes{
+				{
+					Rule:     "r1",
+					Kind:     config.CrashLoopAlert,
+					GVR:      "v1/pods",
+					Resource: "ns1/p1",
+					Message:  "container c1 is crash looping (5 restarts)",
+					Since:    now,
+				},
+			},
+		},
+		"node": {
+			rules: []config.AlertRule{{Name: "r1", Kind: config.NodeNotReadyAlert}},
+			e: []render.AlertRes{
+				{
+					Rule:     "r1",
+					Kind:     config.NodeNotReadyAlert,
+					GVR:      "v1/nodes",
+					Resource: "n1",
+					Message:  "node is not ready: NodeStatusUnknown",
+					Since:    now.Add(-time.Minute),
+				},
+			},
+		},
+		"node-grace": {
+			rules: []config.AlertRule{{Name: "r1", Kind: config.NodeNotReadyAlert, After: "5m"}},
+		},
+		"pvc": {
+			rules: []config.AlertRule{{Name: "r1", Kind: config.PVCPendingAlert, After: "5m"}},
+			e: []render.AlertRes{
+				{
+					Rule:     "r1",
+					Kind:     config.PVCPendingAlert,
+					GVR:      "v1/persistentvolumeclaims",
+					Resource: "ns1/c1",
+					Message:  "claim is pending",
+					Since:    now.Add(-10 * time.Minute),
+				},
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, dao.EvalAlerts(u.rules, pods, nodes, pvcs, now))
+		})
+	}
+}
+
+func TestAlertsUpdate(t *testing.T) {
+	a := dao.NewAlerts(nil)
+	r1 := render.AlertRes{Rule: "r1", Resource: "ns1/p1"}
+	r2 := render.AlertRes{Rule: "r1", Resource: "ns1/p2"}
+	r3 := render.AlertRes{Rule: "r1", RuleIndex: 1, Resource: "ns1/p2"}
+
+	assert.Nil(t, a.Update([]render.AlertRes{r1}))
+	assert.Equal(t, []render.AlertRes{r1}, a.Active())
+	assert.Equal(t, []render.AlertRes{r2}, a.Update([]render.AlertRes{r1, r2}))
+	assert.Equal(t, 2, len(a.Active()))
+	assert.Nil(t, a.Update([]render.AlertRes{r2}))
+	assert.Equal(t, []render.AlertRes{r2}, a.Active())
+	assert.Equal(t, []render.AlertRes{r3}, a.Update([]render.AlertRes{r2, r3}))
+}
+
+func TestAlertsNotify(t *testing.T) {
+	dir := t.TempDir()
+	rules := []config.AlertRule{
+		{Name: "r1", Kind: config.CrashLoopAlert, Command: "echo first > " + filepath.Join(dir, "out")},
+		{Name: "r1", Kind: config.CrashLoopAlert, Command: "echo second > " + filepath.Join(dir, "out")},
+	}
+	a := dao.NewAlerts(rules)
+
+	assert.Nil(t, a.Notify(context.Background(), render.AlertRes{Rule: "r1", RuleIndex: 1}))
+	bb, err := os.ReadFile(filepath.Join(dir, "out"))
+	assert.Nil(t, err)
+	assert.Equal(t, "second\n", string(bb))
+	assert.Error(t, a.Notify(context.Background(), render.AlertRes{Rule: "r1", RuleIndex: 2}))
+}
+
+func TestAlertsDispatch(t *testing.T) {
+	a := dao.NewAlerts(nil)
+	var n int
+	for a.Dispatch(render.AlertRes{Rule: "r1"}) {
+		n++
+	}
+	assert.Equal(t, 50, n)
+}
@@ -0,0 +1,291 @@
+package dao
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+)
+
+const (
+	apfLevelsPath     = "/debug/api_priority_and_fairness/dump_priority_levels"
+	apfMetricsPath    = "/metrics"
+	apfExecutingSeats = "apiserver_flowcontrol_current_executing_seats"
+
+	// Api servers tag each response with the flowschema and priority level
+	// that classified the request.
+	apfFlowSchemaHeader    = "X-Kubernetes-PF-FlowSchema-UID"
+	apfPriorityLevelHeader = "X-Kubernetes-PF-PriorityLevel-UID"
+)
+
+// FlowControlGroupVersions tracks the api priority and fairness group versions.
+var FlowControlGroupVersions = []string{
+	"flowcontrol.apiserver.k8s.io/v1",
+	"flowcontrol.apiserver.k8s.io/v1beta3",
+	"flowcontrol.apiserver.k8s.io/v1beta2",
+	"flowcontrol.apiserver.k8s.io/v1beta1",
+}
+
+var (
+	// Seats limits metrics, latest first.
+	apfSeatsLimits = []string{
+		"apiserver_flowcontrol_current_limit_seats",
+		"apiserver_flowcontrol_nominal_limit_seats",
+		"apiserver_flowcontrol_request_concurrency_limit",
+	}
+	apfLevelRX = regexp.MustCompile(`priority_level="([^"]+)"`)
+)
+
+var (
+	_ Accessor = (*FlowSchema)(nil)
+	_ Accessor = (*PriorityLevel)(nil)
+)
+
+// FlowSchema represents a K8s api priority and fairness flowschema.
+type FlowSchema struct {
+	Resource
+}
+
+// List returns a collection of flowschemas along with their priority level
+// live stats.
+func (f *FlowSchema) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	oo, err := f.Resource.List(ctx, ns)
+	if err != nil {
+		return oo, err
+	}
+
+	return withAPFStats(ctx, f.Factory, oo, "spec", "priorityLevelConfiguration", "name")
+}
+
+// PriorityLevel represents a K8s api priority and fairness priority level
+// configuration.
+type PriorityLevel struct {
+	Resource
+}
+
+// List returns a collection of priority levels along with their live stats.
+func (p *PriorityLevel) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	oo, err := p.Resource.List(ctx, ns)
+	if err != nil {
+		return oo, err
+	}
+
+	return withAPFStats(ctx, p.Factory, oo, "metadata", "name")
+}
+
+// APFSnapshot represents the api server priority levels live stats along
+// with the flowschema and priority level classifying k9s requests.
+type APFSnapshot struct {
+	Levels           map[string]*render.APFLevelStats
+	FlowSchemaUID    string
+	PriorityLevelUID string
+}
+
+// APFStats returns the api server priority levels live stats. Seats usage is
+// only reported when the api server metrics are readable.
+func APFStats(ctx context.Context, f Factory) (*APFSnapshot, error) {
+	dial, err := f.Client().Dial()
+	if err != nil {
+		return nil, err
+	}
+	rc, ok := dial.Discovery().RESTClient().(*rest.RESTClient)
+	if !ok || rc == nil {
+		return nil, fmt.Errorf("no rest client available")
+	}
+	raw, header, err := apfGet(ctx, rc, apfLevelsPath)
+	if err != nil {
+		return nil, err
+	}
+	levels, err := parseAPFLevels(raw)
+	if err != nil {
+		return nil, err
+	}
+	snap := APFSnapshot{
+		Levels:           levels,
+		FlowSchemaUID:    header.Get(apfFlowSchemaHeader),
+		PriorityLevelUID: header.Get(apfPriorityLevelHeader),
+	}
+	mx, _, err := apfGet(ctx, rc, apfMetricsPath)
+	if err != nil {
+		log.Debug().Err(err).Msgf("APF seats metrics unavailable")
+		return &snap, nil
+	}
+	parseAPFSeats(mx, levels)
+
+	return &snap, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// apfGet fetches a raw api server path along with the response headers.
+func apfGet(ctx context.Context, rc *rest.RESTClient, path string) ([]byte, http.Header, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rc.Get().AbsPath(path).URL().String(), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	resp, err := rc.Client.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+	raw, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, nil, fmt.Errorf("get %s failed (%s): %s", path, resp.Status, strings.TrimSpace(string(raw)))
+	}
+
+	return raw, resp.Header, nil
+}
+
+// withAPFStats decorates apf resources with the stats of the priority level
+// found at the given field path.
+func withAPFStats(ctx context.Context, f Factory, oo []runtime.Object, level ...string) ([]runtime.Object, error) {
+	snap, err := APFStats(ctx, f)
+	if err != nil {
+		log.Debug().Err(err).Msgf("APF stats unavailable")
+	}
+	res := make([]runtime.Object, 0, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return res, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+		}
+		a := render.APFWithStats{Raw: u}
+		if snap != nil {
+			n, _, _ := unstructured.NestedString(u.Object, level...)
+			a.Stats = snap.Levels[n]
+			uid := string(u.GetUID())
+			a.Self = uid != "" && (uid == snap.FlowSchemaUID || uid == snap.PriorityLevelUID)
+		}
+		res = append(res, &a)
+	}
+
+	return res, nil
+}
+
+// parseAPFLevels parses the api server priority levels debug dump. Columns
+// are resolved by name as they vary across api server versions.
+func parseAPFLevels(raw []byte) (map[string]*render.APFLevelStats, error) {
+	var (
+		cols  map[string]int
+		stats = make(map[string]*render.APFLevelStats)
+	)
+	scanner := bufio.NewScanner(bytes.NewReader(raw))
+	for scanner.Scan() {
+		ff := strings.Split(scanner.Text(), ",")
+		for i := range ff {
+			ff[i] = strings.TrimSpace(ff[i])
+		}
+		if len(ff) < 2 {
+			continue
+		}
+		if cols == nil {
+			cols = make(map[string]int, len(ff))
+			for i, f := range ff {
+				cols[f] = i
+			}
+			if _, ok := cols["PriorityLevelName"]; !ok {
+				return nil, fmt.Errorf("unexpected priority levels dump header %q", scanner.Text())
+			}
+			continue
+		}
+		col := func(n string) int {
+			i, ok := cols[n]
+			if !ok || i >= len(ff) {
+				return 0
+			}
+			v, _ := strconv.Atoi(ff[i])
+			return v
+		}
+		stats[ff[cols["PriorityLevelName"]]] = &render.APFLevelStats{
+			ActiveQueues: col("ActiveQueues"),
+			Waiting:      col("WaitingRequests"),
+			Executing:    col("ExecutingRequests"),
+			Dispatched:   col("DispatchedRequests"),
+			Rejected:     col("RejectedRequests"),
+			TimedOut:     col("TimedoutRequests"),
+			Cancelled:    col("CancelledRequests"),
+		}
+	}
+	if cols == nil {
+		return nil, fmt.Errorf("no priority levels found")
+	}
+
+	return stats, scanner.Err()
+}
+
+// parseAPFSeats sums up the priority levels seats metrics.
+func parseAPFSeats(raw []byte, stats map[string]*render.APFLevelStats) {
+	executing, limits := make(map[string]float64), make(map[string]map[string]float64)
+	scanner := bufio.NewScanner(bytes.NewReader(raw))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		l := scanner.Text()
+		if !strings.HasPrefix(l, "apiserver_flowcontrol_") {
+			continue
+		}
+		name, level, v, ok := parseAPFSample(l)
+		if !ok {
+			continue
+		}
+		if name == apfExecutingSeats {
+			executing[level] += v
+			continue
+		}
+		for _, n := range apfSeatsLimits {
+			if name != n {
+				continue
+			}
+			if limits[n] == nil {
+				limits[n] = make(map[string]float64)
+			}
+			limits[n][level] += v
+		}
+	}
+
+	for level, s := range stats {
+		for _, n := range apfSeatsLimits {
+			l, ok := limits[n][level]
+			if !ok {
+				continue
+			}
+			s.HasSeats, s.SeatsLimit, s.ExecutingSeats = true, int(l), int(executing[level])
+			break
+		}
+	}
+}
+
+func parseAPFSample(l string) (string, string, float64, bool) {
+	i := strings.Index(l, "{")
+	if i < 0 {
+		return "", "", 0, false
+	}
+	mm := apfLevelRX.FindStringSubmatch(l)
+	if mm == nil {
+		return "", "", 0, false
+	}
+	ff := strings.Fields(l[strings.LastIndex(l, "}")+1:])
+	if len(ff) == 0 {
+		return "", "", 0, false
+	}
+	v, err := strconv.ParseFloat(ff[0], 64)
+	if err != nil {
+		return "", "", 0, false
+	}
+
+	return l[:i], mm[1], v, true
+}
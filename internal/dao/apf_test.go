@@ -0,0 +1,93 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+const apfLevelsDump = `PriorityLevelName, ActiveQueues, IsIdle, IsQuiescing, WaitingRequests, ExecutingRequests, DispatchedRequests, RejectedRequests, TimedoutRequests, CancelledRequests
+catch-all,         0,            true,   false,       0,               0,                 12,                 0,                0,                0
+exempt,            <none>,       <none>, <none>,      <none>,          <none>,            <none>,             <none>,           <none>,           <none>
+workload-low,      2,            false,  false,       7,               30,                1024,               3,                1,                0
+`
+
+const apfMetrics = `# HELP apiserver_flowcontrol_current_executing_seats [ALPHA] Concurrency (number of seats) occupied by the currently executing requests
+# TYPE apiserver_flowcontrol_current_executing_seats gauge
+apiserver_flowcontrol_current_executing_seats{flow_schema="service-accounts",priority_level="workload-low"} 20
+apiserver_flowcontrol_current_executing_seats{flow_schema="kube-controller-manager",priority_level="workload-low"} 12
+apiserver_flowcontrol_current_executing_seats{flow_schema="catch-all",priority_level="catch-all"} 1
+apiserver_flowcontrol_nominal_limit_seats{priority_level="workload-low"} 40
+apiserver_flowcontrol_nominal_limit_seats{priority_level="catch-all"} 13
+apiserver_flowcontrol_current_limit_seats{priority_level="workload-low"} 35
+apiserver_request_total{code="200"} 10
+`
+
+func TestParseAPFLevels(t *testing.T) {
+	uu := map[string]struct {
+		raw string
+		e   map[string]*render.APFLevelStats
+		err string
+	}{
+		"empty": {
+			err: "no priority levels found",
+		},
+		"bad-header": {
+			raw: "Bozo, Blee\n",
+			err: `unexpected priority levels dump header "Bozo, Blee"`,
+		},
+		"happy": {
+			raw: apfLevelsDump,
+			e: map[string]*render.APFLevelStats{
+				"catch-all":    {Dispatched: 12},
+				"exempt":       {},
+				"workload-low": {ActiveQueues: 2, Waiting: 7, Executing: 30, Dispatched: 1024, Rejected: 3, TimedOut: 1},
+			},
+		},
+		"missing-columns": {
+			raw: "PriorityLevelName, WaitingRequests\nglobal-default, 4\n",
+			e: map[string]*render.APFLevelStats{
+				"global-default": {Waiting: 4},
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			stats, err := parseAPFLevels([]byte(u.raw))
+			if u.err != "" {
+				assert.EqualError(t, err, u.err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, stats)
+		})
+	}
+}
+
+func TestParseAPFSeats(t *testing.T) {
+	stats, err := parseAPFLevels([]byte(apfLevelsDump))
+	assert.Nil(t, err)
+	parseAPFSeats([]byte(apfMetrics), stats)
+
+	assert.Equal(t, &render.APFLevelStats{
+		ActiveQueues:   2,
+		Waiting:        7,
+		Executing:      30,
+		Dispatched:     1024,
+		Rejected:       3,
+		TimedOut:       1,
+		HasSeats:       true,
+		ExecutingSeats: 32,
+		SeatsLimit:     35,
+	}, stats["workload-low"])
+	assert.Equal(t, &render.APFLevelStats{
+		Dispatched:     12,
+		HasSeats:       true,
+		ExecutingSeats: 1,
+		SeatsLimit:     13,
+	}, stats["catch-all"])
+	assert.False(t, stats["exempt"].HasSeats)
+}
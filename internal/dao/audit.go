@@ -0,0 +1,181 @@
+package dao
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	maxAuditLine = 1024 * 1024
+
+	// maxAuditSize caps the audit log size before it gets rotated.
+	maxAuditSize = 10 * 1024 * 1024
+)
+
+// AuditFile tracks the audit log location.
+var AuditFile = config.K9sAuditFile
+
+var (
+	_ Accessor = (*Audit)(nil)
+
+	auditMx sync.Mutex
+)
+
+// Audit represents the mutating actions audit log.
+type Audit struct {
+	NonResource
+}
+
+// List returns the audit log entries, most recent first.
+func (a *Audit) List(context.Context, string) ([]runtime.Object, error) {
+	ee, err := ReadAudit(AuditFile)
+	if err != nil {
+		return nil, err
+	}
+	oo := make([]runtime.Object, 0, len(ee))
+	for i := len(ee) - 1; i >= 0; i-- {
+		oo = append(oo, ee[i])
+	}
+
+	return oo, nil
+}
+
+// RecordAudit appends a mutating action to the audit log.
+func RecordAudit(f Factory, action, gvr, path string, payload interface{}) {
+	e := render.AuditRes{
+		Time:   time.Now(),
+		Action: action,
+		GVR:    gvr,
+		Path:   path,
+	}
+	if f != nil && f.Client() != nil {
+		cfg := f.Client().Config()
+		e.Context, _ = cfg.CurrentContextName()
+		e.User, _ = cfg.CurrentUserName()
+	}
+	if payload != nil {
+		raw, err := json.Marshal(payload)
+		if err != nil {
+			log.Warn().Err(err).Msgf("Audit payload failed for %s %s", action, path)
+		}
+		e.Payload = string(raw)
+	}
+	if err := appendAudit(AuditFile, e); err != nil {
+		log.Error().Err(err).Msgf("Audit log write failed")
+	}
+}
+
+// ReadAudit reads an audit log. Malformed entries are skipped.
+func ReadAudit(file string) ([]render.AuditRes, error) {
+	f, err := os.Open(file)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.Error().Err(err).Msgf("Closing audit log")
+		}
+	}()
+
+	var ee []render.AuditRes
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxAuditLine)
+	for scanner.Scan() {
+		var e render.AuditRes
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			log.Warn().Err(err).Msgf("Skipping invalid audit entry")
+			continue
+		}
+		ee = append(ee, e)
+	}
+	sort.SliceStable(ee, func(i, j int) bool {
+		return ee[i].Time.Before(ee[j].Time)
+	})
+
+	return ee, scanner.Err()
+}
+
+func appendAudit(file string, e render.AuditRes) error {
+	raw, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	auditMx.Lock()
+	defer auditMx.Unlock()
+	if err := rotateAudit(file); err != nil {
+		log.Warn().Err(err).Msgf("Audit log rotation failed")
+	}
+	f, err := os.OpenFile(file, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(append(raw, '\n')); err != nil {
+		_ = f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+// audited records a mutating action in the audit log when it succeeded.
+func audited(err error, f Factory, action, gvr, path string, payload interface{}) error {
+	if err == nil {
+		RecordAudit(f, action, gvr, path, payload)
+	}
+
+	return err
+}
+
+// rotateAudit moves an oversized audit log aside so it does not grow unbounded.
+func rotateAudit(file string) error {
+	fi, err := os.Stat(file)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if fi.Size() < maxAuditSize {
+		return nil
+	}
+
+	return os.Rename(file, file+".1")
+}
+
+// manifestDigest returns an audit payload identifying a manifest without
+// recording its content, which may carry secrets.
+func manifestDigest(manifest string) map[string]string {
+	sum := sha256.Sum256([]byte(manifest))
+
+	return map[string]string{"sha256": hex.EncodeToString(sum[:])}
+}
+
+// envAudit returns an audit payload for env updates omitting the values.
+func envAudit(spec EnvSpec) map[string]interface{} {
+	kk := make([]string, 0, len(spec.Set))
+	for _, e := range spec.Set {
+		kk = append(kk, e.Name)
+	}
+
+	return map[string]interface{}{
+		"container": spec.Container,
+		"set":       kk,
+		"delete":    spec.Delete,
+	}
+}
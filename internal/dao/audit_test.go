@@ -0,0 +1,85 @@
+package dao
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+)
+
+func TestAuditLog(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "audit.log")
+
+	ee, err := ReadAudit(file)
+	assert.Nil(t, err)
+	assert.Empty(t, ee)
+
+	t1 := time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)
+	e1 := render.AuditRes{Time: t1, User: "fred", Context: "c1", Action: "delete", GVR: "v1/pods", Path: "ns1/p1"}
+	e2 := render.AuditRes{Time: t1.Add(time.Minute), Action: "scale", GVR: "apps/v1/deployments", Path: "ns1/d1", Payload: `{"replicas":3}`}
+	assert.Nil(t, appendAudit(file, e2))
+	assert.Nil(t, appendAudit(file, e1))
+
+	f, err := os.OpenFile(file, os.O_APPEND|os.O_WRONLY, 0600)
+	assert.Nil(t, err)
+	_, err = f.WriteString("blee\n")
+	assert.Nil(t, err)
+	assert.Nil(t, f.Close())
+
+	ee, err = ReadAudit(file)
+	assert.Nil(t, err)
+	assert.Equal(t, []render.AuditRes{e1, e2}, ee)
+}
+
+func TestAudited(t *testing.T) {
+	defer func(f string) { AuditFile = f }(AuditFile)
+	AuditFile = filepath.Join(t.TempDir(), "audit.log")
+
+	assert.Nil(t, audited(nil, nil, "delete", "v1/pods", "ns1/p1", map[string]int{"grace": 0}))
+	assert.Equal(t, os.ErrClosed, audited(os.ErrClosed, nil, "delete", "v1/pods", "ns1/p2", nil))
+
+	ee, err := ReadAudit(AuditFile)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(ee))
+	assert.Equal(t, "ns1/p1", ee[0].Path)
+	assert.Equal(t, `{"grace":0}`, ee[0].Payload)
+}
+
+func TestAuditRotate(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "audit.log")
+	assert.Nil(t, os.WriteFile(file, make([]byte, maxAuditSize), 0600))
+
+	e := render.AuditRes{Time: time.Now().UTC(), Action: "delete", GVR: "v1/pods", Path: "ns1/p1"}
+	assert.Nil(t, appendAudit(file, e))
+
+	ee, err := ReadAudit(file)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(ee))
+	_, err = os.Stat(file + ".1")
+	assert.Nil(t, err)
+}
+
+func TestAuditPayloadRedacted(t *testing.T) {
+	defer func(f string) { AuditFile = f }(AuditFile)
+	AuditFile = filepath.Join(t.TempDir(), "audit.log")
+
+	manifest := "apiVersion: v1\nkind: Secret\ndata:\n  pwd: c2VjcmV0\n"
+	spec := EnvSpec{
+		Container: "c1",
+		Set:       []v1.EnvVar{{Name: "PWD", Value: "secret"}},
+		Delete:    []string{"USER"},
+	}
+	assert.Nil(t, audited(nil, nil, "edit", "v1/secrets", "ns1/s1", manifestDigest(manifest)))
+	assert.Nil(t, audited(nil, nil, "set-env", "apps/v1/deployments", "ns1/d1", envAudit(spec)))
+
+	ee, err := ReadAudit(AuditFile)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(ee))
+	assert.NotContains(t, ee[0].Payload, "c2VjcmV0")
+	assert.Regexp(t, `^\{"sha256":"[0-9a-f]{64}"\}$`, ee[0].Payload)
+	assert.Equal(t, `{"container":"c1","delete":["USER"],"set":["PWD"]}`, ee[1].Payload)
+}
@@ -0,0 +1,24 @@
+package dao
+
+import "context"
+
+// cancelable runs a call not supporting cancellation. The call is abandoned
+// and the context error returned when the context is done first.
+func cancelable(ctx context.Context, f func() (string, error)) (string, error) {
+	type result struct {
+		s   string
+		err error
+	}
+	c := make(chan result, 1)
+	go func() {
+		s, err := f()
+		c <- result{s: s, err: err}
+	}()
+
+	select {
+	case <-ctx.Done():
+		return "", ctx.Err()
+	case r := <-c:
+		return r.s, r.err
+	}
+}
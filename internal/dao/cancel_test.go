@@ -0,0 +1,51 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCancelable(t *testing.T) {
+	uu := map[string]struct {
+		delay  time.Duration
+		cancel bool
+		e      string
+		err    error
+	}{
+		"done": {
+			e: "fred",
+		},
+		"failed": {
+			err: errors.New("boom"),
+		},
+		"canceled": {
+			delay:  time.Second,
+			cancel: true,
+			err:    context.Canceled,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			if u.cancel {
+				cancel()
+			}
+			s, err := cancelable(ctx, func() (string, error) {
+				time.Sleep(u.delay)
+				if u.err != nil && !u.cancel {
+					return "", u.err
+				}
+				return "fred", nil
+			})
+			assert.Equal(t, u.err, err)
+			assert.Equal(t, u.e, s)
+		})
+	}
+}
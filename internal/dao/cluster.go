@@ -22,8 +22,10 @@ type RefScanner interface {
 
 // Ref represents a resource reference.
 type Ref struct {
-	GVR string
-	FQN string
+	GVR   string
+	FQN   string
+	UID   string
+	Usage string
 }
 
 // Refs represents a collection of resource references.
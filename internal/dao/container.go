@@ -25,8 +25,11 @@ List 函数是 Accessor 接口的一个方法，它返回一个包含多个容
 
 TailLogs 函数是 Loggable 接口的一个方法，它返回一个用于跟踪给定容器日志的通道数组。该函数通过一个名为 Pod 的类型，它同样实现了 Loggable 接口，来获取日志。实际上，它将Pod的日志访问委托给 Pod 类型的 TailLogs 方法。
 
-此文件还包含一些帮助函数，例如 makeContainerRes 用于创建容器运行时对象，getContainerStatus 用于获取容器的状态。此外还包含一个私有函数 fetchPod，该函数通过传入的Pod名称从Kubernetes API服务器获取Pod对象。
+此文件还包含一些帮助函数，例如 makeContainerRes 用于创建容器运行时对象，getContainerStatus 用于获取容器的状态。此外还包含一个私有函数 fetchRawPod，该函数通过传入的Pod名称从Kubernetes API服务器获取Pod对象，sidecarContainers 则用于识别以 sidecar 方式运行的Init容器。
 */
+// sidecarRestartPolicy denotes an init container running as a sidecar.
+const sidecarRestartPolicy = "Always"
+
 var (
 	_ Accessor = (*Container)(nil)
 	_ Loggable = (*Container)(nil)
@@ -52,16 +55,30 @@ func (c *Container) List(ctx context.Context, _ string) ([]runtime.Object, error
 		cmx, _ = client.DialMetrics(c.Client()).FetchContainersMetrics(ctx, fqn)
 	}
 
-	po, err := c.fetchPod(fqn)
+	u, err := c.fetchRawPod(fqn)
 	if err != nil {
 		return nil, err
 	}
-	res := make([]runtime.Object, 0, len(po.Spec.InitContainers)+len(po.Spec.Containers))
+	var po v1.Pod
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &po); err != nil {
+		return nil, err
+	}
+	sidecars := sidecarContainers(u)
+	res := make([]runtime.Object, 0, len(po.Spec.InitContainers)+len(po.Spec.Containers)+len(po.Spec.EphemeralContainers))
 	for _, co := range po.Spec.InitContainers {
-		res = append(res, makeContainerRes(co, po, cmx[co.Name], true))
+		cr := makeContainerRes(co, &po, cmx[co.Name], true)
+		if _, ok := sidecars[co.Name]; ok {
+			cr.Role = render.ContainerRoleSidecar
+		}
+		res = append(res, cr)
 	}
 	for _, co := range po.Spec.Containers {
-		res = append(res, makeContainerRes(co, po, cmx[co.Name], false))
+		res = append(res, makeContainerRes(co, &po, cmx[co.Name], false))
+	}
+	for _, ec := range po.Spec.EphemeralContainers {
+		cr := makeContainerRes(v1.Container(ec.EphemeralContainerCommon), &po, cmx[ec.Name], false)
+		cr.Role = render.ContainerRoleEphemeral
+		res = append(res, cr)
 	}
 
 	return res, nil
@@ -79,13 +96,16 @@ func (c *Container) TailLogs(ctx context.Context, opts *LogOptions) ([]LogChan,
 // Helpers...
 
 func makeContainerRes(co v1.Container, po *v1.Pod, cmx *mv1beta1.ContainerMetrics, isInit bool) render.ContainerRes {
-	return render.ContainerRes{
+	res := render.ContainerRes{
 		Container: &co,
 		Status:    getContainerStatus(co.Name, po.Status),
 		MX:        cmx,
 		IsInit:    isInit,
 		Age:       po.GetCreationTimestamp(),
 	}
+	res.Scan.Critical, res.Scan.Scanned = ImageCriticalCVEs(co.Image)
+
+	return res
 }
 
 func getContainerStatus(co string, status v1.PodStatus) *v1.ContainerStatus {
@@ -99,16 +119,45 @@ func getContainerStatus(co string, status v1.PodStatus) *v1.ContainerStatus {
 			return &c
 		}
 	}
+	for _, c := range status.EphemeralContainerStatuses {
+		if c.Name == co {
+			return &c
+		}
+	}
 
 	return nil
 }
 
-func (c *Container) fetchPod(fqn string) (*v1.Pod, error) {
+// sidecarContainers returns the names of a pod init containers running as
+// sidecars ie restartPolicy=Always.
+func sidecarContainers(u *unstructured.Unstructured) map[string]struct{} {
+	cc, _, _ := unstructured.NestedSlice(u.Object, "spec", "initContainers")
+	ss := make(map[string]struct{})
+	for _, c := range cc {
+		m, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if p, _, _ := unstructured.NestedString(m, "restartPolicy"); p != sidecarRestartPolicy {
+			continue
+		}
+		if n, _, _ := unstructured.NestedString(m, "name"); n != "" {
+			ss[n] = struct{}{}
+		}
+	}
+
+	return ss
+}
+
+func (c *Container) fetchRawPod(fqn string) (*unstructured.Unstructured, error) {
 	o, err := c.GetFactory().Get("v1/pods", fqn, true, labels.Everything())
 	if err != nil {
 		return nil, err
 	}
-	var po v1.Pod
-	err = runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &po)
-	return &po, err
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expecting unstructured but got %T", o)
+	}
+
+	return u, nil
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/watch"
 	"github.com/ghodss/yaml"
 	"github.com/stretchr/testify/assert"
@@ -33,6 +34,29 @@ func TestContainerList(t *testing.T) {
 	assert.Equal(t, 1, len(oo))
 }
 
+func TestContainerListRoles(t *testing.T) {
+	c := dao.Container{}
+	c.Init(sidecarPodFactory{}, client.NewGVR("containers"))
+
+	ctx := context.WithValue(context.Background(), internal.KeyPath, "fred/p1")
+	oo, err := c.List(ctx, "")
+	assert.Nil(t, err)
+
+	ee := map[string]string{
+		"i1":    render.ContainerRoleInit,
+		"proxy": render.ContainerRoleSidecar,
+		"fred":  render.ContainerRoleApp,
+		"dbg":   render.ContainerRoleEphemeral,
+	}
+	assert.Equal(t, len(ee), len(oo))
+	for _, o := range oo {
+		co, ok := o.(render.ContainerRes)
+		assert.True(t, ok)
+		assert.Equal(t, ee[co.Container.Name], co.ContainerRole())
+	}
+	assert.Equal(t, "dbg", oo[3].(render.ContainerRes).Status.Name)
+}
+
 // ----------------------------------------------------------------------------
 // Helpers...
 
@@ -93,6 +117,18 @@ func (f podFactory) WaitForCacheSync()            {}
 func (f podFactory) Forwarders() watch.Forwarders { return nil }
 func (f podFactory) DeleteForwarder(string)       {}
 
+type sidecarPodFactory struct {
+	podFactory
+}
+
+func (f sidecarPodFactory) Get(gvr, path string, wait bool, sel labels.Selector) (runtime.Object, error) {
+	var m map[string]interface{}
+	if err := yaml.Unmarshal([]byte(sidecarPoYaml()), &m); err != nil {
+		return nil, err
+	}
+	return &unstructured.Unstructured{Object: m}, nil
+}
+
 func makePodFactory() dao.Factory {
 	return podFactory{}
 }
@@ -138,3 +174,32 @@ status:
   phase: Running
 `
 }
+
+func sidecarPoYaml() string {
+	return `apiVersion: v1
+kind: Pod
+metadata:
+  name: fred
+  namespace: blee
+spec:
+  initContainers:
+  - image: busybox
+    name: i1
+  - image: envoy
+    name: proxy
+    restartPolicy: Always
+  containers:
+  - image: blee
+    name: fred
+  ephemeralContainers:
+  - image: busybox
+    name: dbg
+status:
+  ephemeralContainerStatuses:
+  - name: dbg
+    ready: false
+    restartCount: 0
+    state:
+      running: {}
+`
+}
@@ -0,0 +1,118 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ Accessor = (*ContainerTop)(nil)
+
+// ContainerTop represents a live containers usage dao.
+type ContainerTop struct {
+	NonResource
+}
+
+// List returns a collection of containers with their current usage and ranks.
+func (c *ContainerTop) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	oo, err := c.GetFactory().List("v1/pods", ns, false, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		pmx  client.PodsMetricsMap
+		hist *client.MetricsHistory
+	)
+	if withMx, ok := ctx.Value(internal.KeyWithMetrics).(bool); withMx || !ok {
+		mx := client.DialMetrics(c.Client())
+		mx.ClearPodsMetrics(ns)
+		pmx, _ = mx.FetchPodsMetricsMap(ctx, ns)
+		hist = mx.PodsHistory()
+	}
+
+	tt := make([]*render.ContainerTopRes, 0, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+		}
+		var po v1.Pod
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &po); err != nil {
+			return nil, err
+		}
+		fqn := client.FQN(po.Namespace, po.Name)
+		cmx := make(client.ContainersMetrics)
+		if mx, ok := pmx[fqn]; ok && mx != nil {
+			for i := range mx.Containers {
+				cmx[mx.Containers[i].Name] = &mx.Containers[i]
+			}
+		}
+		for _, co := range po.Spec.Containers {
+			t := render.ContainerTopRes{
+				Pod:          fqn,
+				ContainerRes: makeContainerRes(co, &po, cmx[co.Name], false),
+			}
+			if hist != nil {
+				t.History = hist.Samples(fqn + ":" + co.Name)
+			}
+			tt = append(tt, &t)
+		}
+	}
+	rankContainers(tt)
+
+	res := make([]runtime.Object, 0, len(tt))
+	for _, t := range tt {
+		res = append(res, *t)
+	}
+
+	return res, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// rankContainers ranks containers by current and previous cpu usage.
+func rankContainers(tt []*render.ContainerTopRes) {
+	assignRanks(tt, func(t *render.ContainerTopRes) int64 {
+		if t.MX == nil {
+			return 0
+		}
+		return t.MX.Usage.Cpu().MilliValue()
+	}, func(t *render.ContainerTopRes, r int) { t.Rank = r })
+	assignRanks(tt, func(t *render.ContainerTopRes) int64 {
+		return sampleAt(t.History, 2).CPU
+	}, func(t *render.ContainerTopRes, r int) {
+		if len(t.History) >= 2 {
+			t.PrevRank = r
+		}
+	})
+}
+
+func assignRanks(tt []*render.ContainerTopRes, valFn func(*render.ContainerTopRes) int64, setFn func(*render.ContainerTopRes, int)) {
+	ss := make([]*render.ContainerTopRes, len(tt))
+	copy(ss, tt)
+	sort.SliceStable(ss, func(i, j int) bool {
+		return valFn(ss[i]) > valFn(ss[j])
+	})
+	for i, t := range ss {
+		setFn(t, i+1)
+	}
+}
+
+// sampleAt returns the nth sample from the end of a series.
+func sampleAt(ss []client.MetricsSample, n int) client.MetricsSample {
+	if len(ss) < n {
+		return client.MetricsSample{}
+	}
+
+	return ss[len(ss)-n]
+}
@@ -50,14 +50,10 @@ func (c *CronJob) Run(path string) error {
 	if err != nil {
 		return errors.New("expecting CronJob resource")
 	}
-	jobName := cj.Name
-	if len(cj.Name) >= maxJobNameSize {
-		jobName = cj.Name[0:maxJobNameSize]
-	}
 	true := true
 	job := &batchv1.Job{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      jobName + "-manual-" + rand.String(3),
+			Name:      jobName(cj.Name, "manual"),
 			Namespace: ns,
 			Labels:    cj.Spec.JobTemplate.Labels,
 			OwnerReferences: []metav1.OwnerReference{
@@ -80,7 +76,16 @@ func (c *CronJob) Run(path string) error {
 	defer cancel()
 	_, err = dial.BatchV1().Jobs(ns).Create(ctx, job, metav1.CreateOptions{})
 
-	return err
+	return audited(err, c.Factory, "trigger", c.GVR(), path, nil)
+}
+
+// jobName returns a fresh job name for a given base name and suffix.
+func jobName(base, suffix string) string {
+	if len(base) >= maxJobNameSize {
+		base = base[0:maxJobNameSize]
+	}
+
+	return base + "-" + suffix + "-" + rand.String(3)
 }
 
 // ScanSA scans for serviceaccount refs.
@@ -137,7 +142,7 @@ func (c *CronJob) ToggleSuspend(ctx context.Context, path string) error {
 	}
 	_, err = dial.BatchV1().CronJobs(ns).Update(ctx, cj, metav1.UpdateOptions{})
 
-	return err
+	return audited(err, c.Factory, "suspend", c.GVR(), path, map[string]bool{"suspend": *cj.Spec.Suspend})
 }
 
 // Scan scans for cluster resource refs.
@@ -157,25 +162,28 @@ func (c *CronJob) Scan(ctx context.Context, gvr, fqn string, wait bool) (Refs, e
 		}
 		switch gvr {
 		case "v1/configmaps":
-			if !hasConfigMap(&cj.Spec.JobTemplate.Spec.Template.Spec, n) {
+			usage := configMapUsage(&cj.Spec.JobTemplate.Spec.Template.Spec, n)
+			if usage == "" {
 				continue
 			}
 			refs = append(refs, Ref{
-				GVR: c.GVR(),
-				FQN: client.FQN(cj.Namespace, cj.Name),
+				GVR:   c.GVR(),
+				FQN:   client.FQN(cj.Namespace, cj.Name),
+				Usage: usage,
 			})
 		case "v1/secrets":
-			found, err := hasSecret(c.Factory, &cj.Spec.JobTemplate.Spec.Template.Spec, cj.Namespace, n, wait)
+			usage, err := secretUsage(c.Factory, &cj.Spec.JobTemplate.Spec.Template.Spec, cj.Namespace, n, wait)
 			if err != nil {
 				log.Warn().Err(err).Msgf("locate secret %q", fqn)
 				continue
 			}
-			if !found {
+			if usage == "" {
 				continue
 			}
 			refs = append(refs, Ref{
-				GVR: c.GVR(),
-				FQN: client.FQN(cj.Namespace, cj.Name),
+				GVR:   c.GVR(),
+				FQN:   client.FQN(cj.Namespace, cj.Name),
+				Usage: usage,
 			})
 		case "scheduling.k8s.io/v1/priorityclasses":
 			if !hasPC(&cj.Spec.JobTemplate.Spec.Template.Spec, n) {
@@ -0,0 +1,87 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"strconv"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/watch"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ Accessor = (*Debug)(nil)
+
+// SelfStats represents k9s own resources consumption.
+type SelfStats struct {
+	Goroutines  int               `json:"goroutines"`
+	HeapAlloc   uint64            `json:"heapAlloc"`
+	HeapInuse   uint64            `json:"heapInuse"`
+	HeapObjects uint64            `json:"heapObjects"`
+	Sys         uint64            `json:"sys"`
+	NumGC       uint32            `json:"numGC"`
+	Caches      []watch.CacheStat `json:"caches"`
+	LogBuffers  []LogBufferStat   `json:"logBuffers"`
+}
+
+// ReadSelfStats collects k9s runtime, informer caches and log buffers sizes.
+func ReadSelfStats(f Factory) SelfStats {
+	var mem runtime.MemStats
+	runtime.ReadMemStats(&mem)
+	s := SelfStats{
+		Goroutines:  runtime.NumGoroutine(),
+		HeapAlloc:   mem.HeapAlloc,
+		HeapInuse:   mem.HeapInuse,
+		HeapObjects: mem.HeapObjects,
+		Sys:         mem.Sys,
+		NumGC:       mem.NumGC,
+		LogBuffers:  LogBufferStats(),
+	}
+	if c, ok := f.(CacheReporter); ok {
+		s.Caches = c.CacheStats()
+	}
+
+	return s
+}
+
+// Debug represents k9s self metrics.
+type Debug struct {
+	NonResource
+}
+
+// List returns k9s self metrics.
+func (d *Debug) List(context.Context, string) ([]k8sruntime.Object, error) {
+	s := ReadSelfStats(d.Factory)
+	oo := []k8sruntime.Object{
+		render.DebugRes{Section: "runtime", Name: "goroutines", Value: strconv.Itoa(s.Goroutines)},
+		render.DebugRes{Section: "runtime", Name: "gc-cycles", Value: strconv.Itoa(int(s.NumGC))},
+		render.DebugRes{Section: "memory", Name: "heap-alloc", Value: toMiB(s.HeapAlloc)},
+		render.DebugRes{Section: "memory", Name: "heap-inuse", Value: toMiB(s.HeapInuse)},
+		render.DebugRes{Section: "memory", Name: "heap-objects", Value: strconv.FormatUint(s.HeapObjects, 10)},
+		render.DebugRes{Section: "memory", Name: "sys", Value: toMiB(s.Sys)},
+	}
+	for _, c := range s.Caches {
+		n := c.GVR
+		if c.Namespace != "" {
+			n = c.Namespace + "/" + n
+		}
+		if c.Selector != "" {
+			n += " [" + c.Selector + "]"
+		}
+		oo = append(oo, render.DebugRes{Section: c.Kind, Name: n, Value: strconv.Itoa(c.Items) + " items"})
+	}
+	for _, l := range s.LogBuffers {
+		oo = append(oo, render.DebugRes{
+			Section: "logs",
+			Name:    l.Path,
+			Value:   fmt.Sprintf("%d lines (%s)", l.Lines, toMiB(uint64(l.Bytes))),
+		})
+	}
+
+	return oo, nil
+}
+
+func toMiB(b uint64) string {
+	return fmt.Sprintf("%.1fMi", float64(b)/(1024*1024))
+}
@@ -0,0 +1,216 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const lastAppliedConfig = "kubectl.kubernetes.io/last-applied-configuration"
+
+// DeprecatedAPI represents an api deprecated upstream. Versions are
+// Kubernetes 1.x minor versions.
+type DeprecatedAPI struct {
+	// GroupVersion the deprecated api group version.
+	GroupVersion string
+	// Kind the deprecated kind or blank for all the group version kinds.
+	Kind        string
+	Deprecated  int
+	Removed     int
+	Replacement string
+}
+
+// deprecatedAPIs tracks apis deprecated or removed upstream. Kind specific
+// entries must precede group version wide entries.
+var deprecatedAPIs = []DeprecatedAPI{
+	{GroupVersion: "extensions/v1beta1", Kind: "Ingress", Deprecated: 14, Removed: 22, Replacement: "networking.k8s.io/v1"},
+	{GroupVersion: "extensions/v1beta1", Kind: "PodSecurityPolicy", Deprecated: 11, Removed: 16, Replacement: "policy/v1beta1"},
+	{GroupVersion: "extensions/v1beta1", Kind: "NetworkPolicy", Deprecated: 9, Removed: 16, Replacement: "networking.k8s.io/v1"},
+	{GroupVersion: "extensions/v1beta1", Deprecated: 8, Removed: 16, Replacement: "apps/v1"},
+	{GroupVersion: "apps/v1beta1", Deprecated: 9, Removed: 16, Replacement: "apps/v1"},
+	{GroupVersion: "apps/v1beta2", Deprecated: 9, Removed: 16, Replacement: "apps/v1"},
+	{GroupVersion: "batch/v1beta1", Kind: "CronJob", Deprecated: 21, Removed: 25, Replacement: "batch/v1"},
+	{GroupVersion: "policy/v1beta1", Kind: "PodSecurityPolicy", Deprecated: 21, Removed: 25, Replacement: "pod security admission"},
+	{GroupVersion: "policy/v1beta1", Deprecated: 21, Removed: 25, Replacement: "policy/v1"},
+	{GroupVersion: "autoscaling/v2beta1", Deprecated: 22, Removed: 25, Replacement: "autoscaling/v2"},
+	{GroupVersion: "autoscaling/v2beta2", Deprecated: 23, Removed: 26, Replacement: "autoscaling/v2"},
+	{GroupVersion: "networking.k8s.io/v1beta1", Deprecated: 19, Removed: 22, Replacement: "networking.k8s.io/v1"},
+	{GroupVersion: "discovery.k8s.io/v1beta1", Deprecated: 21, Removed: 25, Replacement: "discovery.k8s.io/v1"},
+	{GroupVersion: "events.k8s.io/v1beta1", Deprecated: 22, Removed: 25, Replacement: "events.k8s.io/v1"},
+	{GroupVersion: "node.k8s.io/v1beta1", Deprecated: 22, Removed: 25, Replacement: "node.k8s.io/v1"},
+	{GroupVersion: "storage.k8s.io/v1beta1", Kind: "CSIStorageCapacity", Deprecated: 24, Removed: 27, Replacement: "storage.k8s.io/v1"},
+	{GroupVersion: "storage.k8s.io/v1beta1", Deprecated: 19, Removed: 22, Replacement: "storage.k8s.io/v1"},
+	{GroupVersion: "rbac.authorization.k8s.io/v1beta1", Deprecated: 17, Removed: 22, Replacement: "rbac.authorization.k8s.io/v1"},
+	{GroupVersion: "scheduling.k8s.io/v1beta1", Deprecated: 14, Removed: 22, Replacement: "scheduling.k8s.io/v1"},
+	{GroupVersion: "coordination.k8s.io/v1beta1", Deprecated: 14, Removed: 22, Replacement: "coordination.k8s.io/v1"},
+	{GroupVersion: "admissionregistration.k8s.io/v1beta1", Deprecated: 16, Removed: 22, Replacement: "admissionregistration.k8s.io/v1"},
+	{GroupVersion: "apiextensions.k8s.io/v1beta1", Deprecated: 16, Removed: 22, Replacement: "apiextensions.k8s.io/v1"},
+	{GroupVersion: "apiregistration.k8s.io/v1beta1", Deprecated: 19, Removed: 22, Replacement: "apiregistration.k8s.io/v1"},
+	{GroupVersion: "certificates.k8s.io/v1beta1", Deprecated: 19, Removed: 22, Replacement: "certificates.k8s.io/v1"},
+	{GroupVersion: "flowcontrol.apiserver.k8s.io/v1beta1", Deprecated: 23, Removed: 26, Replacement: "flowcontrol.apiserver.k8s.io/v1beta3"},
+	{GroupVersion: "flowcontrol.apiserver.k8s.io/v1beta2", Deprecated: 26, Removed: 29, Replacement: "flowcontrol.apiserver.k8s.io/v1"},
+}
+
+// FindDeprecatedAPI returns the deprecation matching a group version and kind.
+func FindDeprecatedAPI(gv, kind string) (DeprecatedAPI, bool) {
+	for _, d := range deprecatedAPIs {
+		if d.GroupVersion != gv {
+			continue
+		}
+		if d.Kind == "" || d.Kind == kind {
+			return d, true
+		}
+	}
+
+	return DeprecatedAPI{}, false
+}
+
+// DeprecationStatus returns a deprecation status given a cluster minor version.
+func DeprecationStatus(d DeprecatedAPI, minor int) string {
+	switch {
+	case minor <= 0:
+		return render.DeprecationDeprecated
+	case minor >= d.Removed:
+		return render.DeprecationRemoved
+	case minor+1 == d.Removed:
+		return render.DeprecationNext
+	default:
+		return render.DeprecationDeprecated
+	}
+}
+
+var _ Accessor = (*Deprecation)(nil)
+
+// Deprecation detects resources relying on deprecated apis.
+type Deprecation struct {
+	NonResource
+}
+
+// List walks the watched resources and returns the ones relying on deprecated apis.
+func (d *Deprecation) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	minor := clusterMinor(d.Client())
+	var oo []runtime.Object
+	for _, gvr := range d.watchedGVRs(ns) {
+		err := listAs(d.Factory, gvr, ns, func(u *unstructured.Unstructured) error {
+			for _, r := range CheckDeprecations(gvr, u, minor) {
+				oo = append(oo, r)
+			}
+			return nil
+		})
+		if err != nil {
+			log.Warn().Err(err).Msgf("Deprecations skipping %s", gvr)
+		}
+	}
+
+	return oo, nil
+}
+
+// watchedGVRs returns the gvrs of the active informers and the main workloads.
+func (d *Deprecation) watchedGVRs(ns string) []string {
+	set := make(map[string]struct{}, len(lintedGVRs))
+	for _, gvr := range lintedGVRs {
+		set[gvr] = struct{}{}
+	}
+	if r, ok := d.Factory.(CacheReporter); ok {
+		for _, s := range r.CacheStats() {
+			if s.Kind != "informer" || (!client.IsAllNamespaces(ns) && s.Namespace != ns && !client.IsAllNamespaces(s.Namespace)) {
+				continue
+			}
+			set[s.GVR] = struct{}{}
+		}
+	}
+	gg := make([]string, 0, len(set))
+	for gvr := range set {
+		gg = append(gg, gvr)
+	}
+	sort.Strings(gg)
+
+	return gg
+}
+
+// CheckDeprecations returns the deprecated apis a resource relies on, either
+// via the watched api version or the versions used to manage it.
+func CheckDeprecations(gvr string, u *unstructured.Unstructured, minor int) []render.DeprecationRes {
+	var (
+		rr   []render.DeprecationRes
+		seen = make(map[string]struct{})
+	)
+	for _, v := range managedVersions(u) {
+		if _, ok := seen[v]; ok {
+			continue
+		}
+		seen[v] = struct{}{}
+		d, ok := FindDeprecatedAPI(v, u.GetKind())
+		if !ok {
+			continue
+		}
+		rr = append(rr, render.DeprecationRes{
+			GVR:         gvr,
+			Path:        client.FQN(u.GetNamespace(), u.GetName()),
+			Kind:        u.GetKind(),
+			APIVersion:  v,
+			Deprecated:  d.Deprecated,
+			Removed:     d.Removed,
+			Replacement: d.Replacement,
+			Status:      DeprecationStatus(d, minor),
+		})
+	}
+
+	return rr
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// managedVersions returns the api versions a resource is served or managed with.
+func managedVersions(u *unstructured.Unstructured) []string {
+	vv := []string{u.GetAPIVersion()}
+	if raw, ok := u.GetAnnotations()[lastAppliedConfig]; ok {
+		var m struct {
+			APIVersion string `json:"apiVersion"`
+		}
+		if err := json.Unmarshal([]byte(raw), &m); err == nil && m.APIVersion != "" {
+			vv = append(vv, m.APIVersion)
+		}
+	}
+	for _, f := range u.GetManagedFields() {
+		vv = append(vv, f.APIVersion)
+	}
+
+	return vv
+}
+
+func clusterMinor(c client.Connection) int {
+	if c == nil {
+		return 0
+	}
+	info, err := c.ServerVersion()
+	if err != nil {
+		log.Warn().Err(err).Msgf("No server version")
+		return 0
+	}
+	minor, err := strconv.Atoi(strings.TrimRight(info.Minor, "+"))
+	if err != nil {
+		return 0
+	}
+
+	return minor
+}
+
+func deprecatedVersion(u *unstructured.Unstructured) (string, bool) {
+	for _, v := range managedVersions(u)[1:] {
+		if _, ok := FindDeprecatedAPI(v, u.GetKind()); ok {
+			return v, true
+		}
+	}
+
+	return "", false
+}
@@ -0,0 +1,88 @@
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestFindDeprecatedAPI(t *testing.T) {
+	uu := map[string]struct {
+		gv, kind, replacement string
+		removed               int
+		ok                    bool
+	}{
+		"kind": {
+			gv: "extensions/v1beta1", kind: "Ingress", replacement: "networking.k8s.io/v1", removed: 22, ok: true,
+		},
+		"group": {
+			gv: "extensions/v1beta1", kind: "Deployment", replacement: "apps/v1", removed: 16, ok: true,
+		},
+		"psp": {
+			gv: "policy/v1beta1", kind: "PodSecurityPolicy", replacement: "pod security admission", removed: 25, ok: true,
+		},
+		"current": {
+			gv: "apps/v1", kind: "Deployment",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			d, ok := dao.FindDeprecatedAPI(u.gv, u.kind)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.replacement, d.Replacement)
+			assert.Equal(t, u.removed, d.Removed)
+		})
+	}
+}
+
+func TestDeprecationStatus(t *testing.T) {
+	d := dao.DeprecatedAPI{GroupVersion: "batch/v1beta1", Deprecated: 21, Removed: 25}
+	uu := map[string]struct {
+		minor int
+		e     string
+	}{
+		"unknown":    {e: render.DeprecationDeprecated},
+		"deprecated": {minor: 22, e: render.DeprecationDeprecated},
+		"next":       {minor: 24, e: render.DeprecationNext},
+		"removed":    {minor: 25, e: render.DeprecationRemoved},
+		"past":       {minor: 26, e: render.DeprecationRemoved},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, dao.DeprecationStatus(d, u.minor))
+		})
+	}
+}
+
+func TestCheckDeprecations(t *testing.T) {
+	u := lintObj("cj1")
+	u.SetAPIVersion("batch/v1")
+	u.SetKind("CronJob")
+	u.SetAnnotations(map[string]string{"kubectl.kubernetes.io/last-applied-configuration": `{"apiVersion":"batch/v1beta1"}`})
+	u.SetManagedFields([]metav1.ManagedFieldsEntry{
+		{Manager: "kubectl", APIVersion: "batch/v1beta1"},
+		{Manager: "helm", APIVersion: "batch/v1"},
+	})
+
+	rr := dao.CheckDeprecations("batch/v1/cronjobs", u, 24)
+	assert.Equal(t, []render.DeprecationRes{
+		{
+			GVR:         "batch/v1/cronjobs",
+			Path:        "default/cj1",
+			Kind:        "CronJob",
+			APIVersion:  "batch/v1beta1",
+			Deprecated:  21,
+			Removed:     25,
+			Replacement: "batch/v1",
+			Status:      render.DeprecationNext,
+		},
+	}, rr)
+	assert.Empty(t, dao.CheckDeprecations("batch/v1/cronjobs", lintObj("cj2"), 24))
+}
@@ -1,40 +1,59 @@
 package dao
 
 import (
+	"context"
+
 	"github.com/derailed/k9s/internal/client"
 	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/kubectl/pkg/describe"
 )
 
-// Describe describes a resource.
-func Describe(c client.Connection, gvr client.GVR, path string) (string, error) {
-	mapper := RestMapper{Connection: c}
-	m, err := mapper.ToRESTMapper()
-	if err != nil {
-		log.Error().Err(err).Msgf("No REST mapper for resource %s", gvr)
-		return "", err
-	}
-
-	gvk, err := m.KindFor(gvr.GVR())
-	if err != nil {
-		log.Error().Err(err).Msgf("No GVK for resource %s", gvr)
-		return "", err
-	}
+// describeChunkSize matches kubectl describe default chunk size when listing events.
+const describeChunkSize = 500
 
+// Describe describes a resource. The description is abandoned once the
+// context is canceled.
+func Describe(ctx context.Context, c client.Connection, gvr client.GVR, path string) (string, error) {
 	ns, n := client.Namespaced(path)
 	if client.IsClusterScoped(ns) {
 		ns = client.AllNamespaces
 	}
-	mapping, err := mapper.ResourceFor(gvr.AsResourceName(), gvk.Kind)
+	d, err := describe.Describer(c.Config().Flags(), restMappingFor(c, gvr))
 	if err != nil {
-		log.Error().Err(err).Msgf("Unable to find mapper for %s %s", gvr, n)
+		log.Error().Err(err).Msgf("Unable to find describer for %s", gvr)
 		return "", err
 	}
-	d, err := describe.Describer(c.Config().Flags(), mapping)
+
+	return cancelable(ctx, func() (string, error) {
+		return d.Describe(ns, n, describe.DescriberSettings{
+			ShowEvents: true,
+			ChunkSize:  describeChunkSize,
+		})
+	})
+}
+
+// restMappingFor resolves a resource mapping. Resources unknown to the
+// discovery cache fallback to a mapping derived from the gvr so they are
+// still described generically.
+func restMappingFor(c client.Connection, gvr client.GVR) *meta.RESTMapping {
+	mapper := RestMapper{Connection: c}
+	fallback := mapper.toRESTMapping(gvr.GVR(), "")
+	m, err := mapper.ToRESTMapper()
 	if err != nil {
-		log.Error().Err(err).Msgf("Unable to find describer for %#v", mapping)
-		return "", err
+		log.Warn().Err(err).Msgf("No REST mapper for resource %s", gvr)
+		return fallback
+	}
+	gvk, err := m.KindFor(gvr.GVR())
+	if err != nil {
+		log.Warn().Err(err).Msgf("No GVK for resource %s", gvr)
+		return fallback
+	}
+	mapping, err := mapper.ResourceFor(gvr.AsResourceName(), gvk.Kind)
+	if err != nil {
+		log.Warn().Err(err).Msgf("Unable to find mapper for %s", gvr)
+		return mapper.toRESTMapping(gvr.GVR(), gvk.Kind)
 	}
 
-	return d.Describe(ns, n, describe.DescriberSettings{ShowEvents: true})
+	return mapping
 }
@@ -0,0 +1,79 @@
+package dao
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// serverFields tracks metadata fields set by the api-server.
+var serverFields = []string{
+	"uid",
+	"resourceVersion",
+	"generation",
+	"creationTimestamp",
+	"managedFields",
+	"selfLink",
+}
+
+// ResourceYAML returns a given resource yaml.
+func ResourceYAML(f Factory, gvr client.GVR, path string) (string, error) {
+	o, err := f.Get(gvr.String(), path, true, labels.Everything())
+	if err != nil {
+		return "", err
+	}
+
+	return ToYAML(o, false)
+}
+
+// LastAppliedYAML returns a resource live yaml stripped from server side
+// fields along with its last applied configuration.
+func LastAppliedYAML(f Factory, gvr client.GVR, path string) (string, string, error) {
+	o, err := f.Get(gvr.String(), path, true, labels.Everything())
+	if err != nil {
+		return "", "", err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return "", "", fmt.Errorf("expecting unstructured but got %T", o)
+	}
+	raw, ok := u.GetAnnotations()[v1.LastAppliedConfigAnnotation]
+	if !ok {
+		return "", "", fmt.Errorf("no last applied configuration found for %s", path)
+	}
+	var applied unstructured.Unstructured
+	if err := json.Unmarshal([]byte(raw), &applied.Object); err != nil {
+		return "", "", err
+	}
+	appliedYAML, err := ToYAML(&applied, false)
+	if err != nil {
+		return "", "", err
+	}
+	liveYAML, err := ToYAML(stripServerFields(u), false)
+	if err != nil {
+		return "", "", err
+	}
+
+	return liveYAML, appliedYAML, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func stripServerFields(u *unstructured.Unstructured) *unstructured.Unstructured {
+	c := u.DeepCopy()
+	for _, f := range serverFields {
+		unstructured.RemoveNestedField(c.Object, "metadata", f)
+	}
+	unstructured.RemoveNestedField(c.Object, "metadata", "annotations", v1.LastAppliedConfigAnnotation)
+	if len(c.GetAnnotations()) == 0 {
+		unstructured.RemoveNestedField(c.Object, "metadata", "annotations")
+	}
+	unstructured.RemoveNestedField(c.Object, "status")
+
+	return c
+}
@@ -0,0 +1,30 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestStripServerFields(t *testing.T) {
+	u := unstructured.Unstructured{Object: map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"name":            "fred",
+			"uid":             "u1",
+			"resourceVersion": "10",
+			"annotations": map[string]interface{}{
+				v1.LastAppliedConfigAnnotation: "{}",
+			},
+		},
+		"spec":   map[string]interface{}{"replicas": int64(1)},
+		"status": map[string]interface{}{"ready": true},
+	}}
+
+	assert.Equal(t, map[string]interface{}{
+		"metadata": map[string]interface{}{"name": "fred"},
+		"spec":     map[string]interface{}{"replicas": int64(1)},
+	}, stripServerFields(&u).Object)
+	assert.Equal(t, "u1", string(u.GetUID()))
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/rs/zerolog/log"
@@ -24,9 +25,13 @@ var (
 	_ Nuker           = (*Deployment)(nil)
 	_ Loggable        = (*Deployment)(nil)
 	_ Restartable     = (*Deployment)(nil)
+	_ Pausable        = (*Deployment)(nil)
+	_ Rollbackable    = (*Deployment)(nil)
 	_ Scalable        = (*Deployment)(nil)
 	_ Controller      = (*Deployment)(nil)
 	_ ContainsPodSpec = (*Deployment)(nil)
+	_ EnvEditor       = (*Deployment)(nil)
+	_ ResourcesEditor = (*Deployment)(nil)
 )
 
 // Deployment represents a deployment K8s resource.
@@ -61,17 +66,43 @@ func (d *Deployment) Scale(ctx context.Context, path string, replicas int32) err
 	scale.Spec.Replicas = replicas
 	_, err = dial.AppsV1().Deployments(ns).UpdateScale(ctx, n, scale, metav1.UpdateOptions{})
 
-	return err
+	return audited(err, d.Factory, "scale", d.GVR(), path, map[string]int32{"replicas": replicas})
 }
 
 // Restart a Deployment rollout.
 func (d *Deployment) Restart(ctx context.Context, path string) error {
-	o, err := d.GetFactory().Get("apps/v1/deployments", path, true, labels.Everything())
+	return d.patchRollout(ctx, path, "restart", polymorphichelpers.ObjectRestarterFn)
+}
+
+// Pause pauses a Deployment rollout.
+func (d *Deployment) Pause(ctx context.Context, path string) error {
+	return d.patchRollout(ctx, path, "pause", polymorphichelpers.ObjectPauserFn)
+}
+
+// Resume resumes a paused Deployment rollout.
+func (d *Deployment) Resume(ctx context.Context, path string) error {
+	return d.patchRollout(ctx, path, "resume", polymorphichelpers.ObjectResumerFn)
+}
+
+// History returns a Deployment rollout revisions.
+func (d *Deployment) History(ctx context.Context, path string) (Revisions, error) {
+	return RolloutHistory(d.Client(), appsv1.SchemeGroupVersion.WithKind("Deployment").GroupKind(), path)
+}
+
+// Undo rolls a Deployment back to a given revision.
+func (d *Deployment) Undo(ctx context.Context, path string, revision int64) error {
+	dp, err := d.Load(d.Factory, path)
 	if err != nil {
 		return err
 	}
-	var dp appsv1.Deployment
-	err = runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &dp)
+
+	err = RolloutUndo(d.Client(), appsv1.SchemeGroupVersion.WithKind("Deployment").GroupKind(), dp, revision)
+
+	return audited(err, d.Factory, "undo", d.GVR(), path, map[string]int64{"revision": revision})
+}
+
+func (d *Deployment) patchRollout(ctx context.Context, path, action string, patchFn func(runtime.Object) ([]byte, error)) error {
+	dp, err := d.Load(d.Factory, path)
 	if err != nil {
 		return err
 	}
@@ -81,7 +112,7 @@ func (d *Deployment) Restart(ctx context.Context, path string) error {
 		return err
 	}
 	if !auth {
-		return fmt.Errorf("user is not authorized to restart a deployment")
+		return fmt.Errorf("user is not authorized to %s a deployment", action)
 	}
 
 	dial, err := d.Client().Dial()
@@ -89,16 +120,16 @@ func (d *Deployment) Restart(ctx context.Context, path string) error {
 		return err
 	}
 
-	before, err := runtime.Encode(scheme.Codecs.LegacyCodec(appsv1.SchemeGroupVersion), &dp)
+	before, err := runtime.Encode(scheme.Codecs.LegacyCodec(appsv1.SchemeGroupVersion), dp)
 	if err != nil {
 		return err
 	}
 
-	after, err := polymorphichelpers.ObjectRestarterFn(&dp)
+	after, err := patchFn(dp)
 	if err != nil {
 		return err
 	}
-	diff, err := strategicpatch.CreateTwoWayMergePatch(before, after, dp)
+	diff, err := strategicpatch.CreateTwoWayMergePatch(before, after, *dp)
 	if err != nil {
 		return err
 	}
@@ -110,7 +141,7 @@ func (d *Deployment) Restart(ctx context.Context, path string) error {
 		metav1.PatchOptions{},
 	)
 
-	return err
+	return audited(err, d.Factory, action, d.GVR(), path, nil)
 }
 
 // TailLogs tail logs for all pods represented by this Deployment.
@@ -195,25 +226,28 @@ func (d *Deployment) Scan(ctx context.Context, gvr, fqn string, wait bool) (Refs
 		}
 		switch gvr {
 		case "v1/configmaps":
-			if !hasConfigMap(&dp.Spec.Template.Spec, n) {
+			usage := configMapUsage(&dp.Spec.Template.Spec, n)
+			if usage == "" {
 				continue
 			}
 			refs = append(refs, Ref{
-				GVR: d.GVR(),
-				FQN: client.FQN(dp.Namespace, dp.Name),
+				GVR:   d.GVR(),
+				FQN:   client.FQN(dp.Namespace, dp.Name),
+				Usage: usage,
 			})
 		case "v1/secrets":
-			found, err := hasSecret(d.Factory, &dp.Spec.Template.Spec, dp.Namespace, n, wait)
+			usage, err := secretUsage(d.Factory, &dp.Spec.Template.Spec, dp.Namespace, n, wait)
 			if err != nil {
 				log.Warn().Err(err).Msgf("scanning secret %q", fqn)
 				continue
 			}
-			if !found {
+			if usage == "" {
 				continue
 			}
 			refs = append(refs, Ref{
-				GVR: d.GVR(),
-				FQN: client.FQN(dp.Namespace, dp.Name),
+				GVR:   d.GVR(),
+				FQN:   client.FQN(dp.Namespace, dp.Name),
+				Usage: usage,
 			})
 		case "v1/persistentvolumeclaims":
 			if !hasPVC(&dp.Spec.Template.Spec, n) {
@@ -273,7 +307,40 @@ func (d *Deployment) SetImages(ctx context.Context, path string, imageSpecs Imag
 		jsonPatch,
 		metav1.PatchOptions{},
 	)
-	return err
+	return audited(err, d.Factory, "set-image", d.GVR(), path, imageSpecs)
+}
+
+// SetResources sets container resources requests and limits.
+func (d *Deployment) SetResources(ctx context.Context, path string, specs ResourceSpecs) error {
+	return d.patchResources(ctx, path, specs)
+}
+
+// SetEnv updates a container environment variables.
+func (d *Deployment) SetEnv(ctx context.Context, path string, spec EnvSpec) error {
+	ns, n := client.Namespaced(path)
+	auth, err := d.Client().CanI(ns, "apps/v1/deployments", []string{client.PatchVerb})
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to patch a deployment")
+	}
+	jsonPatch, err := GetTemplateEnvPatch(spec)
+	if err != nil {
+		return err
+	}
+	dial, err := d.Client().Dial()
+	if err != nil {
+		return err
+	}
+	_, err = dial.AppsV1().Deployments(ns).Patch(
+		ctx,
+		n,
+		types.StrategicMergePatchType,
+		jsonPatch,
+		metav1.PatchOptions{},
+	)
+	return audited(err, d.Factory, "set-env", d.GVR(), path, envAudit(spec))
 }
 
 func hasPVC(spec *v1.PodSpec, name string) bool {
@@ -289,38 +356,70 @@ func hasPC(spec *v1.PodSpec, name string) bool {
 	return spec.PriorityClassName == name
 }
 
-func hasConfigMap(spec *v1.PodSpec, name string) bool {
-	for _, c := range spec.InitContainers {
-		if containerHasConfigMap(c, name) {
-			return true
+// configMapUsage returns how a pod spec references a given configmap or
+// an empty string if not referenced.
+func configMapUsage(spec *v1.PodSpec, name string) string {
+	var uu usages
+	for _, c := range podSpecContainers(spec) {
+		for _, e := range c.EnvFrom {
+			if e.ConfigMapRef != nil && e.ConfigMapRef.Name == name {
+				uu.add(usageEnvFrom)
+			}
 		}
-	}
-	for _, c := range spec.Containers {
-		if containerHasConfigMap(c, name) {
-			return true
+		for _, e := range c.Env {
+			if e.ValueFrom != nil && e.ValueFrom.ConfigMapKeyRef != nil && e.ValueFrom.ConfigMapKeyRef.Name == name {
+				uu.add(usageEnv)
+			}
 		}
 	}
-
 	for _, v := range spec.Volumes {
-		if cm := v.VolumeSource.ConfigMap; cm != nil {
-			if cm.LocalObjectReference.Name == name {
-				return true
+		if cm := v.VolumeSource.ConfigMap; cm != nil && cm.Name == name {
+			uu.add(usageVolume)
+		}
+		if p := v.VolumeSource.Projected; p != nil {
+			for _, s := range p.Sources {
+				if s.ConfigMap != nil && s.ConfigMap.Name == name {
+					uu.add(usageProjected)
+				}
 			}
 		}
 	}
-	return false
+
+	return uu.String()
 }
 
+// secretUsage returns how a pod spec references a given secret or
+// an empty string if not referenced.
 // BOZO !! Need to deal with ephemeral containers.
-func hasSecret(f Factory, spec *v1.PodSpec, ns, name string, wait bool) (bool, error) {
-	for _, c := range spec.InitContainers {
-		if containerHasSecret(c, name) {
-			return true, nil
+func secretUsage(f Factory, spec *v1.PodSpec, ns, name string, wait bool) (string, error) {
+	var uu usages
+	for _, c := range podSpecContainers(spec) {
+		for _, e := range c.EnvFrom {
+			if e.SecretRef != nil && e.SecretRef.Name == name {
+				uu.add(usageEnvFrom)
+			}
+		}
+		for _, e := range c.Env {
+			if e.ValueFrom != nil && e.ValueFrom.SecretKeyRef != nil && e.ValueFrom.SecretKeyRef.Name == name {
+				uu.add(usageEnv)
+			}
 		}
 	}
-	for _, c := range spec.Containers {
-		if containerHasSecret(c, name) {
-			return true, nil
+	for _, v := range spec.Volumes {
+		if sec := v.VolumeSource.Secret; sec != nil && sec.SecretName == name {
+			uu.add(usageVolume)
+		}
+		if p := v.VolumeSource.Projected; p != nil {
+			for _, s := range p.Sources {
+				if s.Secret != nil && s.Secret.Name == name {
+					uu.add(usageProjected)
+				}
+			}
+		}
+	}
+	for _, ref := range spec.ImagePullSecrets {
+		if ref.Name == name {
+			uu.add(usagePullSecret)
 		}
 	}
 
@@ -328,64 +427,57 @@ func hasSecret(f Factory, spec *v1.PodSpec, ns, name string, wait bool) (bool, e
 	if saName != "" {
 		o, err := f.Get("v1/serviceaccounts", client.FQN(ns, saName), wait, labels.Everything())
 		if err != nil {
-			return false, err
+			if len(uu) > 0 {
+				return uu.String(), nil
+			}
+			return "", err
 		}
 
 		var sa v1.ServiceAccount
 		err = runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &sa)
 		if err != nil {
-			return false, errors.New("expecting ServiceAccount resource")
+			return "", errors.New("expecting ServiceAccount resource")
 		}
 
 		for _, ref := range sa.Secrets {
 			if ref.Namespace == ns && ref.Name == name {
-				return true, nil
+				uu.add(usageServiceAccount)
 			}
 		}
 	}
 
-	for _, v := range spec.Volumes {
-		if sec := v.VolumeSource.Secret; sec != nil {
-			if sec.SecretName == name {
-				return true, nil
-			}
-		}
-	}
-	return false, nil
+	return uu.String(), nil
 }
 
-func containerHasSecret(c v1.Container, name string) bool {
-	for _, e := range c.EnvFrom {
-		if e.SecretRef != nil && e.SecretRef.Name == name {
-			return true
-		}
-	}
-	for _, e := range c.Env {
-		if e.ValueFrom == nil || e.ValueFrom.SecretKeyRef == nil {
-			continue
-		}
-		if e.ValueFrom.SecretKeyRef.Name == name {
-			return true
-		}
-	}
+func podSpecContainers(spec *v1.PodSpec) []v1.Container {
+	cc := make([]v1.Container, 0, len(spec.InitContainers)+len(spec.Containers))
+	cc = append(cc, spec.InitContainers...)
 
-	return false
+	return append(cc, spec.Containers...)
 }
 
-func containerHasConfigMap(c v1.Container, name string) bool {
-	for _, e := range c.EnvFrom {
-		if e.ConfigMapRef != nil && e.ConfigMapRef.Name == name {
-			return true
-		}
-	}
-	for _, e := range c.Env {
-		if e.ValueFrom == nil || e.ValueFrom.ConfigMapKeyRef == nil {
-			continue
-		}
-		if e.ValueFrom.ConfigMapKeyRef.Name == name {
-			return true
+const (
+	usageEnv            = "env"
+	usageEnvFrom        = "envFrom"
+	usageVolume         = "volume"
+	usageProjected      = "projected"
+	usagePullSecret     = "imagePullSecret"
+	usageServiceAccount = "serviceAccount"
+)
+
+// usages tracks distinct resource reference kinds.
+type usages []string
+
+func (u *usages) add(kind string) {
+	for _, k := range *u {
+		if k == kind {
+			return
 		}
 	}
+	*u = append(*u, kind)
+}
 
-	return false
+// String returns a comma separated list of usages.
+func (u usages) String() string {
+	return strings.Join(u, ",")
 }
@@ -0,0 +1,120 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+)
+
+func TestConfigMapUsage(t *testing.T) {
+	uu := map[string]struct {
+		spec v1.PodSpec
+		e    string
+	}{
+		"none": {
+			spec: v1.PodSpec{Containers: []v1.Container{{Name: "c1"}}},
+		},
+		"env": {
+			spec: v1.PodSpec{
+				InitContainers: []v1.Container{
+					{
+						Name: "i1",
+						Env: []v1.EnvVar{
+							{
+								Name: "fred",
+								ValueFrom: &v1.EnvVarSource{
+									ConfigMapKeyRef: &v1.ConfigMapKeySelector{
+										LocalObjectReference: v1.LocalObjectReference{Name: "cm1"},
+										Key:                  "k1",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			e: "env",
+		},
+		"all": {
+			spec: v1.PodSpec{
+				Containers: []v1.Container{
+					{
+						Name: "c1",
+						EnvFrom: []v1.EnvFromSource{
+							{ConfigMapRef: &v1.ConfigMapEnvSource{LocalObjectReference: v1.LocalObjectReference{Name: "cm1"}}},
+						},
+					},
+				},
+				Volumes: []v1.Volume{
+					{
+						Name: "v1",
+						VolumeSource: v1.VolumeSource{
+							ConfigMap: &v1.ConfigMapVolumeSource{LocalObjectReference: v1.LocalObjectReference{Name: "cm1"}},
+						},
+					},
+					{
+						Name: "v2",
+						VolumeSource: v1.VolumeSource{
+							Projected: &v1.ProjectedVolumeSource{
+								Sources: []v1.VolumeProjection{
+									{ConfigMap: &v1.ConfigMapProjection{LocalObjectReference: v1.LocalObjectReference{Name: "cm1"}}},
+								},
+							},
+						},
+					},
+				},
+			},
+			e: "envFrom,volume,projected",
+		},
+		"other": {
+			spec: v1.PodSpec{
+				Volumes: []v1.Volume{
+					{
+						Name: "v1",
+						VolumeSource: v1.VolumeSource{
+							ConfigMap: &v1.ConfigMapVolumeSource{LocalObjectReference: v1.LocalObjectReference{Name: "cm2"}},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, configMapUsage(&u.spec, "cm1"))
+		})
+	}
+}
+
+func TestSecretUsage(t *testing.T) {
+	spec := v1.PodSpec{
+		Containers: []v1.Container{
+			{
+				Name: "c1",
+				EnvFrom: []v1.EnvFromSource{
+					{SecretRef: &v1.SecretEnvSource{LocalObjectReference: v1.LocalObjectReference{Name: "s1"}}},
+				},
+			},
+		},
+		Volumes: []v1.Volume{
+			{
+				Name: "v1",
+				VolumeSource: v1.VolumeSource{
+					Projected: &v1.ProjectedVolumeSource{
+						Sources: []v1.VolumeProjection{
+							{Secret: &v1.SecretProjection{LocalObjectReference: v1.LocalObjectReference{Name: "s1"}}},
+						},
+					},
+				},
+			},
+		},
+		ImagePullSecrets: []v1.LocalObjectReference{{Name: "s1"}},
+	}
+
+	usage, err := secretUsage(nil, &spec, "default", "s1", false)
+	assert.Nil(t, err)
+	assert.Equal(t, "envFrom,projected,imagePullSecret", usage)
+}
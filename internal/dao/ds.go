@@ -27,8 +27,11 @@ var (
 	_ Nuker           = (*DaemonSet)(nil)
 	_ Loggable        = (*DaemonSet)(nil)
 	_ Restartable     = (*DaemonSet)(nil)
+	_ Rollbackable    = (*DaemonSet)(nil)
 	_ Controller      = (*DaemonSet)(nil)
 	_ ContainsPodSpec = (*DaemonSet)(nil)
+	_ EnvEditor       = (*DaemonSet)(nil)
+	_ ResourcesEditor = (*DaemonSet)(nil)
 )
 
 // DaemonSet represents a K8s daemonset.
@@ -87,7 +90,24 @@ func (d *DaemonSet) Restart(ctx context.Context, path string) error {
 		metav1.PatchOptions{},
 	)
 
-	return err
+	return audited(err, d.Factory, "restart", d.GVR(), path, nil)
+}
+
+// History returns a DaemonSet rollout revisions.
+func (d *DaemonSet) History(ctx context.Context, path string) (Revisions, error) {
+	return RolloutHistory(d.Client(), appsv1.SchemeGroupVersion.WithKind("DaemonSet").GroupKind(), path)
+}
+
+// Undo rolls a DaemonSet back to a given revision.
+func (d *DaemonSet) Undo(ctx context.Context, path string, revision int64) error {
+	ds, err := d.GetInstance(path)
+	if err != nil {
+		return err
+	}
+
+	err = RolloutUndo(d.Client(), appsv1.SchemeGroupVersion.WithKind("DaemonSet").GroupKind(), ds, revision)
+
+	return audited(err, d.Factory, "undo", d.GVR(), path, map[string]int64{"revision": revision})
 }
 
 // TailLogs tail logs for all pods represented by this DaemonSet.
@@ -215,25 +235,28 @@ func (d *DaemonSet) Scan(ctx context.Context, gvr, fqn string, wait bool) (Refs,
 		}
 		switch gvr {
 		case "v1/configmaps":
-			if !hasConfigMap(&ds.Spec.Template.Spec, n) {
+			usage := configMapUsage(&ds.Spec.Template.Spec, n)
+			if usage == "" {
 				continue
 			}
 			refs = append(refs, Ref{
-				GVR: d.GVR(),
-				FQN: client.FQN(ds.Namespace, ds.Name),
+				GVR:   d.GVR(),
+				FQN:   client.FQN(ds.Namespace, ds.Name),
+				Usage: usage,
 			})
 		case "v1/secrets":
-			found, err := hasSecret(d.Factory, &ds.Spec.Template.Spec, ds.Namespace, n, wait)
+			usage, err := secretUsage(d.Factory, &ds.Spec.Template.Spec, ds.Namespace, n, wait)
 			if err != nil {
 				log.Warn().Err(err).Msgf("locate secret %q", fqn)
 				continue
 			}
-			if !found {
+			if usage == "" {
 				continue
 			}
 			refs = append(refs, Ref{
-				GVR: d.GVR(),
-				FQN: client.FQN(ds.Namespace, ds.Name),
+				GVR:   d.GVR(),
+				FQN:   client.FQN(ds.Namespace, ds.Name),
+				Usage: usage,
 			})
 		case "v1/persistentvolumeclaims":
 			if !hasPVC(&ds.Spec.Template.Spec, n) {
@@ -292,7 +315,40 @@ func (d *DaemonSet) SetImages(ctx context.Context, path string, imageSpecs Image
 		jsonPatch,
 		metav1.PatchOptions{},
 	)
-	return err
+	return audited(err, d.Factory, "set-image", d.GVR(), path, imageSpecs)
+}
+
+// SetResources sets container resources requests and limits.
+func (d *DaemonSet) SetResources(ctx context.Context, path string, specs ResourceSpecs) error {
+	return d.patchResources(ctx, path, specs)
+}
+
+// SetEnv updates a container environment variables.
+func (d *DaemonSet) SetEnv(ctx context.Context, path string, spec EnvSpec) error {
+	ns, n := client.Namespaced(path)
+	auth, err := d.Client().CanI(ns, "apps/v1/daemonsets", []string{client.PatchVerb})
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to patch a daemonset")
+	}
+	jsonPatch, err := GetTemplateEnvPatch(spec)
+	if err != nil {
+		return err
+	}
+	dial, err := d.Client().Dial()
+	if err != nil {
+		return err
+	}
+	_, err = dial.AppsV1().DaemonSets(ns).Patch(
+		ctx,
+		n,
+		types.StrategicMergePatchType,
+		jsonPatch,
+		metav1.PatchOptions{},
+	)
+	return audited(err, d.Factory, "set-env", d.GVR(), path, envAudit(spec))
 }
 
 // ----------------------------------------------------------------------------
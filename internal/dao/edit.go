@@ -0,0 +1,164 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+)
+
+// EditYAML returns a fresh copy of a resource manifest for editing.
+func (g *Generic) EditYAML(ctx context.Context, path string) (string, error) {
+	o, err := g.Get(ctx, path)
+	if err != nil {
+		return "", err
+	}
+
+	return ToYAML(o, false)
+}
+
+// ApplyEdit updates a resource from an edited manifest. The edit is rejected
+// if the resource was modified since the original manifest was retrieved.
+// When dryRun is set the update is only validated server side.
+func (g *Generic) ApplyEdit(ctx context.Context, path, original, manifest string, dryRun bool) error {
+	ns, n := client.Namespaced(path)
+	auth, err := g.Client().CanI(ns, g.gvr.String(), []string{client.UpdateVerb})
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to update %s", path)
+	}
+
+	orig, err := ParseManifest(original)
+	if err != nil {
+		return err
+	}
+	edited, err := ParseManifest(manifest)
+	if err != nil {
+		return err
+	}
+	if edited.GetName() != n {
+		return fmt.Errorf("resource name can not be changed from %q to %q", n, edited.GetName())
+	}
+	if !client.IsClusterScoped(ns) && edited.GetNamespace() != ns {
+		return fmt.Errorf("resource namespace can not be changed from %q to %q", ns, edited.GetNamespace())
+	}
+
+	live, err := g.Get(ctx, path)
+	if err != nil {
+		return err
+	}
+	u, ok := live.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("expecting unstructured but got %T", live)
+	}
+	if err := checkConflict(orig.GetResourceVersion(), u.GetResourceVersion()); err != nil {
+		return err
+	}
+	edited.SetResourceVersion(orig.GetResourceVersion())
+
+	opts := metav1.UpdateOptions{}
+	if dryRun {
+		opts.DryRun = []string{metav1.DryRunAll}
+	}
+	dial, err := g.dynClient()
+	if err != nil {
+		return err
+	}
+	if client.IsClusterScoped(ns) {
+		_, err = dial.Update(ctx, edited, opts)
+	} else {
+		_, err = dial.Namespace(ns).Update(ctx, edited, opts)
+	}
+	if apierrors.IsConflict(err) {
+		return fmt.Errorf("%s was modified while editing. Please reload and try again", path)
+	}
+	if dryRun {
+		return err
+	}
+
+	return audited(err, g.Factory, "edit", g.GVR(), path, manifestDigest(manifest))
+}
+
+// Create creates a resource from a manifest and returns its path. Resources
+// with no namespace are created in the given namespace. When dryRun is set
+// the creation is only validated server side.
+func (g *Generic) Create(ctx context.Context, ns, manifest string, dryRun bool) (string, error) {
+	u, err := ParseManifest(manifest)
+	if err != nil {
+		return "", err
+	}
+	if gv := u.GroupVersionKind().GroupVersion(); gv != g.gvr.GV() {
+		return "", fmt.Errorf("manifest api version %q does not match %s", gv, g.gvr)
+	}
+	namespaced := true
+	if m, err := MetaAccess.MetaFor(g.gvr); err == nil {
+		namespaced = m.Namespaced
+	}
+	if !namespaced {
+		ns = client.ClusterScope
+		u.SetNamespace("")
+	} else if u.GetNamespace() != "" {
+		ns = u.GetNamespace()
+	}
+	if namespaced && client.IsAllNamespaces(ns) {
+		ns = client.DefaultNamespace
+	}
+	path := client.FQN(ns, u.GetName())
+
+	auth, err := g.Client().CanI(ns, g.gvr.String(), []string{client.CreateVerb})
+	if err != nil {
+		return "", err
+	}
+	if !auth {
+		return "", fmt.Errorf("user is not authorized to create %s", g.gvr)
+	}
+
+	opts := metav1.CreateOptions{}
+	if dryRun {
+		opts.DryRun = []string{metav1.DryRunAll}
+	}
+	dial, err := g.dynClient()
+	if err != nil {
+		return "", err
+	}
+	if namespaced {
+		_, err = dial.Namespace(ns).Create(ctx, u, opts)
+	} else {
+		_, err = dial.Create(ctx, u, opts)
+	}
+	if dryRun {
+		return path, err
+	}
+
+	return path, audited(err, g.Factory, "create", g.GVR(), path, manifestDigest(manifest))
+}
+
+// ParseManifest converts a yaml manifest to a resource.
+func ParseManifest(manifest string) (*unstructured.Unstructured, error) {
+	var m map[string]interface{}
+	if err := yaml.Unmarshal([]byte(manifest), &m); err != nil {
+		return nil, fmt.Errorf("invalid manifest: %w", err)
+	}
+	if len(m) == 0 {
+		return nil, fmt.Errorf("manifest is empty")
+	}
+
+	return &unstructured.Unstructured{Object: m}, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func checkConflict(orig, live string) error {
+	if orig != live {
+		return fmt.Errorf("conflict: resource version changed from %s to %s while editing", orig, live)
+	}
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseManifest(t *testing.T) {
+	uu := map[string]struct {
+		manifest string
+		name     string
+		err      bool
+	}{
+		"happy": {
+			manifest: "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: fred\n  namespace: blee\n",
+			name:     "fred",
+		},
+		"empty": {
+			err: true,
+		},
+		"toast": {
+			manifest: "metadata: [\n",
+			err:      true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			o, err := ParseManifest(u.manifest)
+			if u.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, u.name, o.GetName())
+		})
+	}
+}
+
+func TestCheckConflict(t *testing.T) {
+	assert.NoError(t, checkConflict("10", "10"))
+	assert.Error(t, checkConflict("10", "11"))
+}
@@ -0,0 +1,101 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	involvedUIDFmt = "involvedObject.uid=%s"
+	maxOwnerDepth  = 5
+)
+
+var _ Accessor = (*Event)(nil)
+
+// Event represents a collection of cluster events.
+type Event struct {
+	Table
+}
+
+// List returns a collection of events. When an involved resource is
+// present in the context, only events pertaining to that resource and
+// its owners are returned.
+func (e *Event) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	gvr, ok := ctx.Value(internal.KeyInvolved).(string)
+	if !ok || gvr == "" {
+		return e.Table.List(ctx, ns)
+	}
+	path, ok := ctx.Value(internal.KeyPath).(string)
+	if !ok || path == "" {
+		return e.Table.List(ctx, ns)
+	}
+
+	uids, err := OwnerChain(e.Factory, client.NewGVR(gvr), path)
+	if err != nil {
+		return nil, err
+	}
+	if pns, _ := client.Namespaced(path); pns != "" {
+		ns = pns
+	}
+	var merged *metav1.Table
+	for _, uid := range uids {
+		oo, err := e.list(ctx, ns, metav1.ListOptions{
+			FieldSelector: fmt.Sprintf(involvedUIDFmt, uid),
+		})
+		if err != nil {
+			return nil, err
+		}
+		if len(oo) == 0 {
+			continue
+		}
+		t, ok := oo[0].(*metav1.Table)
+		if !ok {
+			return nil, fmt.Errorf("expecting a meta table but got %T", oo[0])
+		}
+		if merged == nil {
+			merged = t
+			continue
+		}
+		merged.Rows = append(merged.Rows, t.Rows...)
+	}
+	if merged == nil {
+		return nil, nil
+	}
+
+	return []runtime.Object{merged}, nil
+}
+
+// OwnerChain returns the uids of a given resource and its controlling owners.
+func OwnerChain(f Factory, gvr client.GVR, path string) ([]string, error) {
+	refs, err := Owners(f, gvr, path)
+	if err != nil {
+		return nil, err
+	}
+	uids := make([]string, 0, len(refs))
+	for _, r := range refs {
+		uids = append(uids, r.UID)
+	}
+
+	return uids, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func controllerRef(refs []metav1.OwnerReference) *metav1.OwnerReference {
+	for i := range refs {
+		if refs[i].Controller != nil && *refs[i].Controller {
+			return &refs[i]
+		}
+	}
+	if len(refs) > 0 {
+		return &refs[0]
+	}
+
+	return nil
+}
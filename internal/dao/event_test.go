@@ -0,0 +1,38 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestControllerRef(t *testing.T) {
+	yes := true
+	uu := map[string]struct {
+		refs []metav1.OwnerReference
+		e    string
+	}{
+		"none": {},
+		"first": {
+			refs: []metav1.OwnerReference{{Name: "a"}, {Name: "b"}},
+			e:    "a",
+		},
+		"controller": {
+			refs: []metav1.OwnerReference{{Name: "a"}, {Name: "b", Controller: &yes}},
+			e:    "b",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ref := controllerRef(u.refs)
+			if u.e == "" {
+				assert.Nil(t, ref)
+				return
+			}
+			assert.Equal(t, u.e, ref.Name)
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package dao
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/remotecommand"
+
+	"github.com/derailed/k9s/internal/client"
+)
+
+// ExecIn runs a command in a pod container via the exec subresource.
+// Stdin and stdout are wired only when provided.
+func ExecIn(ctx context.Context, conn client.Connection, fqn, co string, cmd []string, stdin io.Reader, stdout io.Writer) error {
+	dial, err := conn.Dial()
+	if err != nil {
+		return err
+	}
+	cfg, err := conn.RestConfig()
+	if err != nil {
+		return err
+	}
+	ns, n := client.Namespaced(fqn)
+	req := dial.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Name(n).
+		Namespace(ns).
+		SubResource("exec").
+		VersionedParams(&v1.PodExecOptions{
+			Container: co,
+			Command:   cmd,
+			Stdin:     stdin != nil,
+			Stdout:    stdout != nil,
+			Stderr:    true,
+		}, scheme.ParameterCodec)
+	exec, err := remotecommand.NewSPDYExecutor(cfg, "POST", req.URL())
+	if err != nil {
+		return err
+	}
+	var stderr bytes.Buffer
+	err = exec.StreamWithContext(ctx, remotecommand.StreamOptions{
+		Stdin:  stdin,
+		Stdout: stdout,
+		Stderr: &stderr,
+	})
+	if err != nil && stderr.Len() > 0 {
+		return fmt.Errorf("%w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	return err
+}
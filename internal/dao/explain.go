@@ -0,0 +1,229 @@
+package dao
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/kube-openapi/pkg/util/proto"
+	"k8s.io/kubectl/pkg/explain"
+	"k8s.io/kubectl/pkg/util/openapi"
+)
+
+// maxExplainDepth caps recursive schema walks.
+const maxExplainDepth = 15
+
+var (
+	_ Accessor = (*Explain)(nil)
+
+	explainSchemas schemaCache
+)
+
+// Explain browses resources schema documentation.
+type Explain struct {
+	NonResource
+}
+
+// List returns the fields of a resource or field path. The path is of the
+// form gvr[.field.path].
+func (e *Explain) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	path, ok := ctx.Value(internal.KeyPath).(string)
+	if !ok || path == "" {
+		return nil, fmt.Errorf("no resource to explain")
+	}
+	recursive, _ := ctx.Value(internal.KeyRecursive).(bool)
+
+	res, fields := SplitExplainPath(path)
+	s, _, err := ExplainSchema(e.Client(), client.NewGVR(res))
+	if err != nil {
+		return nil, err
+	}
+	ff, err := ExplainFields(s, fields, recursive)
+	if err != nil {
+		return nil, err
+	}
+	oo := make([]runtime.Object, 0, len(ff))
+	for _, f := range ff {
+		oo = append(oo, f)
+	}
+
+	return oo, nil
+}
+
+// ExplainDoc returns the documentation of a resource or field path.
+func ExplainDoc(c client.Connection, path string) (string, error) {
+	res, fields := SplitExplainPath(path)
+	s, gvk, err := ExplainSchema(c, client.NewGVR(res))
+	if err != nil {
+		return "", err
+	}
+	var b bytes.Buffer
+	if err := explain.PrintModelDescription(fields, &b, s, gvk, false); err != nil {
+		return "", err
+	}
+
+	return b.String(), nil
+}
+
+// ExplainNested checks if a resource or field path has nested fields.
+func ExplainNested(c client.Connection, path string) (bool, error) {
+	res, fields := SplitExplainPath(path)
+	s, _, err := ExplainSchema(c, client.NewGVR(res))
+	if err != nil {
+		return false, err
+	}
+	ff, err := ExplainFields(s, fields, false)
+
+	return len(ff) > 0, err
+}
+
+// ExplainSchema returns a resource openapi schema.
+func ExplainSchema(c client.Connection, gvr client.GVR) (proto.Schema, schema.GroupVersionKind, error) {
+	var gvk schema.GroupVersionKind
+	if c == nil {
+		return nil, gvk, fmt.Errorf("no connection to explain %s", gvr)
+	}
+	m, err := (&RestMapper{Connection: c}).ToRESTMapper()
+	if err != nil {
+		return nil, gvk, err
+	}
+	if gvk, err = m.KindFor(gvr.GVR()); err != nil {
+		return nil, gvk, err
+	}
+	rr, err := explainSchemas.resources(c)
+	if err != nil {
+		return nil, gvk, err
+	}
+	s := rr.LookupResource(gvk)
+	if s == nil {
+		return nil, gvk, fmt.Errorf("no schema found for %s", gvk)
+	}
+
+	return s, gvk, nil
+}
+
+// ExplainFields returns the fields nested under a schema field path. Recursive
+// listings include all the descendant fields.
+func ExplainFields(root proto.Schema, fields []string, recursive bool) ([]render.ExplainRes, error) {
+	s, err := explain.LookupSchemaForField(root, fields)
+	if err != nil {
+		return nil, err
+	}
+	depth := 1
+	if recursive {
+		depth = maxExplainDepth
+	}
+	var rr []render.ExplainRes
+	walkFields(s, fields, nil, depth, make(map[string]struct{}), &rr)
+
+	return rr, nil
+}
+
+// SplitExplainPath splits an explain path into a resource and a field path.
+func SplitExplainPath(path string) (string, []string) {
+	path = strings.TrimSpace(path)
+	start := strings.LastIndex(path, "/") + 1
+	idx := strings.Index(path[start:], ".")
+	if idx < 0 {
+		return path, nil
+	}
+	res, ff := path[:start+idx], strings.Split(path[start+idx+1:], ".")
+	fields := make([]string, 0, len(ff))
+	for _, f := range ff {
+		if f != "" {
+			fields = append(fields, f)
+		}
+	}
+
+	return res, fields
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+type schemaCache struct {
+	mx      sync.Mutex
+	cluster string
+	rr      openapi.Resources
+}
+
+func (c *schemaCache) resources(conn client.Connection) (openapi.Resources, error) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	if c.rr != nil && c.cluster == conn.ActiveCluster() {
+		return c.rr, nil
+	}
+	dial, err := conn.CachedDiscovery()
+	if err != nil {
+		return nil, err
+	}
+	doc, err := dial.OpenAPISchema()
+	if err != nil {
+		return nil, err
+	}
+	rr, err := openapi.NewOpenAPIData(doc)
+	if err != nil {
+		return nil, err
+	}
+	c.cluster, c.rr = conn.ActiveCluster(), rr
+
+	return rr, nil
+}
+
+// walkFields collects the fields of a schema. References already visited on
+// the current branch are not expanded to guard against recursive schemas.
+func walkFields(s proto.Schema, base, rel []string, depth int, seen map[string]struct{}, rr *[]render.ExplainRes) {
+	k, ref := kindFor(s)
+	if k == nil || depth == 0 {
+		return
+	}
+	if ref != "" {
+		if _, ok := seen[ref]; ok {
+			return
+		}
+		seen[ref] = struct{}{}
+		defer delete(seen, ref)
+	}
+	for _, n := range k.Keys() {
+		f := k.Fields[n]
+		path := append(append([]string{}, rel...), n)
+		*rr = append(*rr, render.ExplainRes{
+			Path:        strings.Join(append(append([]string{}, base...), path...), "."),
+			Field:       strings.Join(path, "."),
+			Type:        explain.GetTypeName(f),
+			Required:    k.IsRequired(n),
+			Description: f.GetDescription(),
+		})
+		walkFields(f, base, path, depth-1, seen, rr)
+	}
+}
+
+// kindFor resolves the object nested under a schema and the name of the
+// reference it resolves through if any.
+func kindFor(s proto.Schema) (*proto.Kind, string) {
+	var ref string
+	for s != nil {
+		switch t := s.(type) {
+		case *proto.Kind:
+			return t, ref
+		case proto.Reference:
+			ref, s = t.Reference(), t.SubSchema()
+		case *proto.Array:
+			s = t.SubType
+		case *proto.Map:
+			s = t.SubType
+		default:
+			return nil, ""
+		}
+	}
+
+	return nil, ""
+}
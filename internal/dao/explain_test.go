@@ -0,0 +1,113 @@
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	openapitesting "k8s.io/kubectl/pkg/util/openapi/testing"
+)
+
+func TestSplitExplainPath(t *testing.T) {
+	uu := map[string]struct {
+		path, res string
+		fields    []string
+	}{
+		"alias": {
+			path: "deploy",
+			res:  "deploy",
+		},
+		"alias-fields": {
+			path:   "deploy.spec.replicas",
+			res:    "deploy",
+			fields: []string{"spec", "replicas"},
+		},
+		"gvr": {
+			path:   "apps/v1/deployments.spec",
+			res:    "apps/v1/deployments",
+			fields: []string{"spec"},
+		},
+		"dotted-group": {
+			path:   "networking.k8s.io/v1/ingresses.spec.rules",
+			res:    "networking.k8s.io/v1/ingresses",
+			fields: []string{"spec", "rules"},
+		},
+		"trailing": {
+			path:   "v1/pods.spec.",
+			res:    "v1/pods",
+			fields: []string{"spec"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			res, fields := dao.SplitExplainPath(u.path)
+			assert.Equal(t, u.res, res)
+			assert.Equal(t, u.fields, fields)
+		})
+	}
+}
+
+func TestExplainFields(t *testing.T) {
+	s := openapitesting.NewFakeResources("testdata/swagger.json").LookupResource(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"})
+	assert.NotNil(t, s)
+
+	uu := map[string]struct {
+		fields    []string
+		recursive bool
+		e         []string
+	}{
+		"root": {
+			e: []string{"apiVersion", "spec"},
+		},
+		"spec": {
+			fields: []string{"spec"},
+			e:      []string{"containers", "replicas"},
+		},
+		"array": {
+			fields: []string{"spec", "containers"},
+			e:      []string{"name", "props"},
+		},
+		"leaf": {
+			fields: []string{"spec", "replicas"},
+		},
+		"recursive": {
+			fields:    []string{"spec"},
+			recursive: true,
+			e:         []string{"containers", "containers.name", "containers.props", "containers.props.nested", "replicas"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			rr, err := dao.ExplainFields(s, u.fields, u.recursive)
+			assert.Nil(t, err)
+			var ff []string
+			for _, r := range rr {
+				ff = append(ff, r.Field)
+			}
+			assert.Equal(t, u.e, ff)
+		})
+	}
+}
+
+func TestExplainFieldsDetails(t *testing.T) {
+	s := openapitesting.NewFakeResources("testdata/swagger.json").LookupResource(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"})
+
+	rr, err := dao.ExplainFields(s, []string{"spec"}, false)
+	assert.Nil(t, err)
+	assert.Equal(t, render.ExplainRes{
+		Path:        "spec.containers",
+		Field:       "containers",
+		Type:        "[]Object",
+		Required:    true,
+		Description: "List of containers.",
+	}, rr[0])
+
+	_, err = dao.ExplainFields(s, []string{"spec", "blee"}, false)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,109 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// MaxListWorkers caps the number of namespaces listed concurrently.
+const MaxListWorkers = 5
+
+// ListFunc lists resources in a given namespace.
+type ListFunc func(ctx context.Context, ns string) ([]runtime.Object, error)
+
+// PartialError tracks namespaces that failed to list while others succeeded.
+type PartialError map[string]error
+
+// Error returns the failed namespaces sorted by name.
+func (p PartialError) Error() string {
+	nss := make([]string, 0, len(p))
+	for ns := range p {
+		nss = append(nss, ns)
+	}
+	sort.Strings(nss)
+	ee := make([]string, 0, len(nss))
+	for _, ns := range nss {
+		ee = append(ee, fmt.Sprintf("%s: %s", ns, p[ns]))
+	}
+
+	return fmt.Sprintf("listing failed in %d namespace(s) -- %s", len(p), strings.Join(ee, "; "))
+}
+
+// ListNamespaces lists resources across namespaces using a bounded pool of
+// workers. Results are returned for the namespaces that succeeded along with
+// a PartialError for the ones that did not.
+func ListNamespaces(ctx context.Context, nss []string, workers int, list ListFunc) ([]runtime.Object, error) {
+	if workers <= 0 {
+		workers = 1
+	}
+	type result struct {
+		ns  string
+		oo  []runtime.Object
+		err error
+	}
+	var (
+		in  = make(chan string)
+		out = make(chan result, len(nss))
+		wg  sync.WaitGroup
+	)
+	for i := 0; i < workers && i < len(nss); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for ns := range in {
+				oo, err := list(ctx, ns)
+				out <- result{ns: ns, oo: oo, err: err}
+			}
+		}()
+	}
+	for _, ns := range nss {
+		in <- ns
+	}
+	close(in)
+	wg.Wait()
+	close(out)
+
+	var (
+		oo   []runtime.Object
+		errs = make(PartialError)
+	)
+	for r := range out {
+		if r.err != nil {
+			errs[r.ns] = r.err
+			continue
+		}
+		oo = append(oo, r.oo...)
+	}
+	switch {
+	case len(errs) == 0:
+		return oo, nil
+	case len(errs) == len(nss):
+		return nil, errs
+	default:
+		return oo, errs
+	}
+}
+
+// accessibleNamespaces returns the namespaces to list when all namespaces
+// may not be listed at once.
+func accessibleNamespaces(ctx context.Context, c client.Connection) []string {
+	if nns, err := c.ValidNamespaces(); err == nil {
+		return client.NamespaceNames(nns)
+	}
+	nss, _ := ctx.Value(internal.KeyNamespaces).([]string)
+	res := make([]string, 0, len(nss))
+	for _, ns := range nss {
+		if client.IsNamespaced(ns) && !client.IsClusterScoped(ns) {
+			res = append(res, ns)
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,81 @@
+package dao_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestListNamespaces(t *testing.T) {
+	uu := map[string]struct {
+		nss    []string
+		failed map[string]bool
+		count  int
+		err    string
+	}{
+		"none": {},
+		"all": {
+			nss:   []string{"ns1", "ns2", "ns3"},
+			count: 3,
+		},
+		"partial": {
+			nss:    []string{"ns1", "ns2", "ns3"},
+			failed: map[string]bool{"ns3": true, "ns1": true},
+			count:  1,
+			err:    "listing failed in 2 namespace(s) -- ns1: denied; ns3: denied",
+		},
+		"failed": {
+			nss:    []string{"ns1", "ns2"},
+			failed: map[string]bool{"ns1": true, "ns2": true},
+			err:    "listing failed in 2 namespace(s) -- ns1: denied; ns2: denied",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			oo, err := dao.ListNamespaces(context.Background(), u.nss, 2, func(_ context.Context, ns string) ([]runtime.Object, error) {
+				if u.failed[ns] {
+					return nil, errors.New("denied")
+				}
+				return []runtime.Object{&unstructured.Unstructured{}}, nil
+			})
+			assert.Equal(t, u.count, len(oo))
+			if u.err == "" {
+				assert.Nil(t, err)
+				return
+			}
+			assert.EqualError(t, err, u.err)
+			var partial dao.PartialError
+			assert.Equal(t, u.count > 0, errors.As(err, &partial) && len(oo) > 0)
+		})
+	}
+}
+
+func TestListNamespacesBounded(t *testing.T) {
+	var active, peak int32
+	nss := []string{"ns1", "ns2", "ns3", "ns4", "ns5", "ns6", "ns7"}
+	oo, err := dao.ListNamespaces(context.Background(), nss, 3, func(context.Context, string) ([]runtime.Object, error) {
+		n := atomic.AddInt32(&active, 1)
+		for {
+			p := atomic.LoadInt32(&peak)
+			if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		atomic.AddInt32(&active, -1)
+		return []runtime.Object{&unstructured.Unstructured{}}, nil
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, len(nss), len(oo))
+	assert.LessOrEqual(t, atomic.LoadInt32(&peak), int32(3))
+}
@@ -0,0 +1,183 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	faultHistorySize = 30
+
+	// FaultEventsWindow the period warning events are reported over.
+	FaultEventsWindow = 1 * time.Hour
+)
+
+// ClusterFaults tracks the faults counts observed during this session.
+var ClusterFaults = NewFaultHistory(faultHistorySize)
+
+var _ Accessor = (*Fault)(nil)
+
+// Fault represents the cluster faulty resources tallies.
+type Fault struct {
+	NonResource
+}
+
+type faultRenderer interface {
+	Header(string) render.Header
+	Render(interface{}, string, *render.Row) error
+}
+
+// List returns the faulty resources tallies per resource kind.
+func (f *Fault) List(_ context.Context, _ string) ([]runtime.Object, error) {
+	pods, err := f.tally("v1/pods", "pods failing to run", render.Pod{}, func(u *unstructured.Unstructured) interface{} {
+		return &render.PodWithMetrics{Raw: u}
+	})
+	if err != nil {
+		return nil, err
+	}
+	dps, err := f.tally("apps/v1/deployments", "deployments not available", render.Deployment{}, nil)
+	if err != nil {
+		return nil, err
+	}
+	nodes, err := f.tally("v1/nodes", "nodes not ready", render.Node{}, func(u *unstructured.Unstructured) interface{} {
+		return &render.NodeWithMetrics{Raw: u}
+	})
+	if err != nil {
+		return nil, err
+	}
+	evs, err := f.warnings(time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	rr := []render.FaultRes{pods, dps, nodes, evs}
+	oo := make([]runtime.Object, 0, len(rr))
+	for _, r := range rr {
+		r.History = ClusterFaults.Record(r.GVR, r.Faults)
+		oo = append(oo, r)
+	}
+
+	return oo, nil
+}
+
+// tally counts the resources flagged as invalid by their renderer, ie the
+// ones listed when toggling faults on their view.
+func (f *Fault) tally(gvr, desc string, re faultRenderer, wrap func(*unstructured.Unstructured) interface{}) (render.FaultRes, error) {
+	res := render.FaultRes{GVR: gvr, Description: desc}
+	oo, err := f.GetFactory().List(gvr, client.AllNamespaces, false, labels.Everything())
+	if err != nil {
+		return res, err
+	}
+	h := re.Header(client.AllNamespaces)
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return res, fmt.Errorf("expecting unstructured but got %T", o)
+		}
+		var obj interface{} = u
+		if wrap != nil {
+			obj = wrap(u)
+		}
+		var row render.Row
+		if err := re.Render(obj, client.AllNamespaces, &row); err != nil {
+			return res, err
+		}
+		if !render.Happy(client.AllNamespaces, h, row) {
+			res.Faults++
+		}
+	}
+	res.Total = len(oo)
+
+	return res, nil
+}
+
+func (f *Fault) warnings(now time.Time) (render.FaultRes, error) {
+	res := render.FaultRes{
+		GVR:         "v1/events",
+		Description: "warning events over the last " + FaultEventsWindow.String(),
+	}
+	oo, err := f.GetFactory().List(res.GVR, client.AllNamespaces, false, labels.Everything())
+	if err != nil {
+		return res, err
+	}
+	evs := make([]v1.Event, 0, len(oo))
+	for _, o := range oo {
+		var ev v1.Event
+		if err := fromUnstructured(o, &ev); err != nil {
+			return res, err
+		}
+		evs = append(evs, ev)
+	}
+	res.Faults, res.Total = CountWarnings(evs, now.Add(-FaultEventsWindow))
+
+	return res, nil
+}
+
+// CountWarnings returns the warning events and the events last seen since a
+// given time.
+func CountWarnings(evs []v1.Event, since time.Time) (int, int) {
+	var warns, total int
+	for _, ev := range evs {
+		if lastSeen(ev).Before(since) {
+			continue
+		}
+		total++
+		if ev.Type == v1.EventTypeWarning {
+			warns++
+		}
+	}
+
+	return warns, total
+}
+
+func lastSeen(ev v1.Event) time.Time {
+	switch {
+	case ev.Series != nil && !ev.Series.LastObservedTime.IsZero():
+		return ev.Series.LastObservedTime.Time
+	case !ev.LastTimestamp.IsZero():
+		return ev.LastTimestamp.Time
+	case !ev.EventTime.IsZero():
+		return ev.EventTime.Time
+	default:
+		return ev.CreationTimestamp.Time
+	}
+}
+
+// FaultHistory tracks resource kinds faults counts over time.
+type FaultHistory struct {
+	size   int
+	series map[string][]int64
+	mx     sync.Mutex
+}
+
+// NewFaultHistory returns a new history retaining up to size counts per
+// resource kind.
+func NewFaultHistory(size int) *FaultHistory {
+	return &FaultHistory{
+		size:   size,
+		series: make(map[string][]int64),
+	}
+}
+
+// Record records a resource kind faults count and returns its history.
+func (h *FaultHistory) Record(gvr string, count int) []int64 {
+	h.mx.Lock()
+	defer h.mx.Unlock()
+
+	s := append(h.series[gvr], int64(count))
+	if len(s) > h.size {
+		s = s[len(s)-h.size:]
+	}
+	h.series[gvr] = s
+
+	return append([]int64(nil), s...)
+}
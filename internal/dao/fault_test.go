@@ -0,0 +1,41 @@
+package dao_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestCountWarnings(t *testing.T) {
+	now := time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)
+	evs := []v1.Event{
+		{Type: v1.EventTypeWarning, LastTimestamp: metav1.NewTime(now.Add(-5 * time.Minute))},
+		{Type: v1.EventTypeNormal, LastTimestamp: metav1.NewTime(now.Add(-5 * time.Minute))},
+		{Type: v1.EventTypeWarning, LastTimestamp: metav1.NewTime(now.Add(-2 * time.Hour))},
+		{
+			Type:          v1.EventTypeWarning,
+			LastTimestamp: metav1.NewTime(now.Add(-2 * time.Hour)),
+			Series:        &v1.EventSeries{LastObservedTime: metav1.NewMicroTime(now.Add(-time.Minute))},
+		},
+		{Type: v1.EventTypeWarning, EventTime: metav1.NewMicroTime(now.Add(-10 * time.Minute))},
+	}
+
+	warns, total := dao.CountWarnings(evs, now.Add(-dao.FaultEventsWindow))
+	assert.Equal(t, 3, warns)
+	assert.Equal(t, 4, total)
+}
+
+func TestFaultHistoryRecord(t *testing.T) {
+	h := dao.NewFaultHistory(3)
+	for i := 1; i <= 4; i++ {
+		h.Record("v1/pods", i)
+	}
+	h.Record("v1/nodes", 1)
+
+	assert.Equal(t, []int64{3, 4, 5}, h.Record("v1/pods", 5))
+	assert.Equal(t, []int64{1, 0}, h.Record("v1/nodes", 0))
+}
@@ -36,6 +36,7 @@ type Generic struct {
 // BOZO!! no auth check??
 func (g *Generic) List(ctx context.Context, ns string) ([]runtime.Object, error) {
 	labelSel, _ := ctx.Value(internal.KeyLabels).(string)
+	fieldSel, _ := ctx.Value(internal.KeyFields).(string)
 	if client.IsAllNamespace(ns) {
 		ns = client.AllNamespaces
 	}
@@ -50,9 +51,9 @@ func (g *Generic) List(ctx context.Context, ns string) ([]runtime.Object, error)
 	}
 
 	if client.IsClusterScoped(ns) {
-		ll, err = dial.List(ctx, metav1.ListOptions{LabelSelector: labelSel})
+		ll, err = dial.List(ctx, metav1.ListOptions{LabelSelector: labelSel, FieldSelector: fieldSel})
 	} else {
-		ll, err = dial.Namespace(ns).List(ctx, metav1.ListOptions{LabelSelector: labelSel})
+		ll, err = dial.Namespace(ns).List(ctx, metav1.ListOptions{LabelSelector: labelSel, FieldSelector: fieldSel})
 	}
 	if err != nil {
 		return nil, err
@@ -82,13 +83,13 @@ func (g *Generic) Get(ctx context.Context, path string) (runtime.Object, error)
 }
 
 // Describe describes a resource.
-func (g *Generic) Describe(path string) (string, error) {
-	return Describe(g.Client(), g.gvr, path)
+func (g *Generic) Describe(ctx context.Context, path string) (string, error) {
+	return Describe(ctx, g.Client(), g.gvr, path)
 }
 
 // ToYAML returns a resource yaml.
-func (g *Generic) ToYAML(path string, showManaged bool) (string, error) {
-	o, err := g.Get(context.Background(), path)
+func (g *Generic) ToYAML(ctx context.Context, path string, showManaged bool) (string, error) {
+	o, err := g.Get(ctx, path)
 	if err != nil {
 		return "", err
 	}
@@ -125,12 +126,12 @@ func (g *Generic) Delete(ctx context.Context, path string, propagation *metav1.D
 		return err
 	}
 	if client.IsClusterScoped(ns) {
-		return dial.Delete(ctx, n, opts)
+		return audited(dial.Delete(ctx, n, opts), g.Factory, "delete", g.GVR(), path, opts)
 	}
 	ctx, cancel := context.WithTimeout(ctx, g.Client().Config().CallTimeout())
 	defer cancel()
 
-	return dial.Namespace(ns).Delete(ctx, n, opts)
+	return audited(dial.Namespace(ns).Delete(ctx, n, opts), g.Factory, "delete", g.GVR(), path, opts)
 }
 
 func (g *Generic) dynClient() (dynamic.NamespaceableResourceInterface, error) {
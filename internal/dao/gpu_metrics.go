@@ -0,0 +1,122 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+)
+
+const gpuMetricsCacheExpiry = 10 * time.Second
+
+// GPUMetrics tracks nodes gpu utilization as reported by a prometheus server
+// ie scraping the nvidia DCGM exporter. The utilization is queried in the
+// background.
+type GPUMetrics struct {
+	cfg    *config.GPUMetrics
+	client *http.Client
+	cache  *refresher
+}
+
+// NewGPUMetrics returns a new gpu utilization source.
+func NewGPUMetrics(cfg *config.GPUMetrics) *GPUMetrics {
+	g := GPUMetrics{
+		cfg:    cfg,
+		client: &http.Client{Timeout: cfg.TimeoutDuration()},
+	}
+	g.cache = newRefresher("GPU metrics", gpuMetricsCacheExpiry, cfg.TimeoutDuration(), func(ctx context.Context) (interface{}, error) {
+		return g.query(ctx)
+	})
+
+	return &g
+}
+
+// Utilization returns the last known gpu utilization percentage per node.
+func (g *GPUMetrics) Utilization(context.Context) (map[string]float64, error) {
+	v, err := g.cache.get()
+	if err != nil {
+		return nil, err
+	}
+
+	return v.(map[string]float64), nil
+}
+
+func (g *GPUMetrics) query(ctx context.Context) (map[string]float64, error) {
+	u, err := url.Parse(strings.TrimSuffix(g.cfg.URL, "/") + "/api/v1/query")
+	if err != nil {
+		return nil, err
+	}
+	q := u.Query()
+	q.Set("query", g.cfg.Query)
+	u.RawQuery = q.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := g.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("gpu metrics query returned %s", resp.Status)
+	}
+
+	var r promResponse
+	if err := json.NewDecoder(resp.Body).Decode(&r); err != nil {
+		return nil, fmt.Errorf("invalid gpu metrics response: %w", err)
+	}
+
+	return r.byLabel(g.cfg.NodeLabel)
+}
+
+type promSample struct {
+	Metric map[string]string `json:"metric"`
+	Value  []interface{}     `json:"value"`
+}
+
+type promResponse struct {
+	Status string `json:"status"`
+	Error  string `json:"error"`
+	Data   struct {
+		ResultType string       `json:"resultType"`
+		Result     []promSample `json:"result"`
+	} `json:"data"`
+}
+
+// byLabel returns an instant vector samples keyed by a given label.
+func (r promResponse) byLabel(label string) (map[string]float64, error) {
+	if r.Status != "success" {
+		return nil, fmt.Errorf("gpu metrics query failed: %s", r.Error)
+	}
+	if r.Data.ResultType != "vector" {
+		return nil, fmt.Errorf("gpu metrics query must return a vector but got %q", r.Data.ResultType)
+	}
+
+	mm := make(map[string]float64, len(r.Data.Result))
+	for _, s := range r.Data.Result {
+		node, ok := s.Metric[label]
+		if !ok || len(s.Value) != 2 {
+			continue
+		}
+		raw, ok := s.Value[1].(string)
+		if !ok {
+			continue
+		}
+		v, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			continue
+		}
+		mm[node] = v
+	}
+
+	return mm, nil
+}
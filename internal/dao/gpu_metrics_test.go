@@ -0,0 +1,103 @@
+package dao_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGPUMetricsUtilization(t *testing.T) {
+	uu := map[string]struct {
+		status int
+		body   string
+		e      map[string]float64
+		err    bool
+	}{
+		"vector": {
+			status: http.StatusOK,
+			body: `{"status":"success","data":{"resultType":"vector","result":[
+{"metric":{"Hostname":"n1"},"value":[1700000000,"42.5"]},
+{"metric":{"Hostname":"n2"},"value":[1700000000,"0"]},
+{"metric":{"gpu":"0"},"value":[1700000000,"10"]},
+{"metric":{"Hostname":"n3"},"value":[1700000000,"NaN?"]}]}}`,
+			e: map[string]float64{"n1": 42.5, "n2": 0},
+		},
+		"failed": {
+			status: http.StatusOK,
+			body:   `{"status":"error","error":"bad query"}`,
+			err:    true,
+		},
+		"matrix": {
+			status: http.StatusOK,
+			body:   `{"status":"success","data":{"resultType":"matrix","result":[]}}`,
+			err:    true,
+		},
+		"unavailable": {
+			status: http.StatusServiceUnavailable,
+			err:    true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/api/v1/query" || r.URL.Query().Get("query") == "" {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				w.WriteHeader(u.status)
+				_, _ = w.Write([]byte(u.body))
+			}))
+			defer srv.Close()
+
+			cfg := config.GPUMetrics{URL: srv.URL + "/"}
+			cfg.Validate()
+			g := dao.NewGPUMetrics(&cfg)
+			_, err := g.Utilization(context.Background())
+			assert.Error(t, err)
+
+			var mm map[string]float64
+			assert.Eventually(t, func() bool {
+				mm, err = g.Utilization(context.Background())
+				return err == nil || err.Error() != "not available yet"
+			}, time.Second, 10*time.Millisecond)
+			if u.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, u.e, mm)
+		})
+	}
+}
+
+func TestGPUMetricsBackoff(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	cfg := config.GPUMetrics{URL: srv.URL}
+	cfg.Validate()
+	g := dao.NewGPUMetrics(&cfg)
+	assert.Eventually(t, func() bool {
+		_, err := g.Utilization(context.Background())
+		return err != nil && strings.Contains(err.Error(), "503")
+	}, time.Second, 10*time.Millisecond)
+	for i := 0; i < 5; i++ {
+		_, err := g.Utilization(context.Background())
+		assert.Error(t, err)
+	}
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/rs/zerolog/log"
 	"gopkg.in/yaml.v2"
 	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/release"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
@@ -81,33 +82,38 @@ func (h *Helm) GetValues(path string, allValues bool) ([]byte, error) {
 }
 
 // Describe returns the chart notes.
-func (h *Helm) Describe(path string) (string, error) {
-	ns, n := client.Namespaced(path)
-	cfg, err := h.EnsureHelmConfig(ns)
-	if err != nil {
-		return "", err
-	}
-	resp, err := action.NewGet(cfg).Run(n)
-	if err != nil {
-		return "", err
-	}
-
-	return resp.Info.Notes, nil
+func (h *Helm) Describe(ctx context.Context, path string) (string, error) {
+	return cancelable(ctx, func() (string, error) {
+		resp, err := h.release(path)
+		if err != nil {
+			return "", err
+		}
+
+		return resp.Info.Notes, nil
+	})
 }
 
 // ToYAML returns the chart manifest.
-func (h *Helm) ToYAML(path string, showManaged bool) (string, error) {
+func (h *Helm) ToYAML(ctx context.Context, path string, _ bool) (string, error) {
+	return cancelable(ctx, func() (string, error) {
+		resp, err := h.release(path)
+		if err != nil {
+			return "", err
+		}
+
+		return resp.Manifest, nil
+	})
+}
+
+// release fetches a given release.
+func (h *Helm) release(path string) (*release.Release, error) {
 	ns, n := client.Namespaced(path)
 	cfg, err := h.EnsureHelmConfig(ns)
 	if err != nil {
-		return "", err
-	}
-	resp, err := action.NewGet(cfg).Run(n)
-	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	return resp.Manifest, nil
+	return action.NewGet(cfg).Run(n)
 }
 
 // Delete uninstall a Helm.
@@ -127,6 +133,8 @@ func (h *Helm) Delete(_ context.Context, path string, _ *metav1.DeletionPropagat
 		return fmt.Errorf("%s", res.Info)
 	}
 
+	RecordAudit(h.Factory, "uninstall", h.GVR(), path, nil)
+
 	return nil
 }
 
@@ -0,0 +1,167 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"gopkg.in/yaml.v2"
+	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/release"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var (
+	_ Accessor  = (*HelmHistory)(nil)
+	_ Describer = (*HelmHistory)(nil)
+)
+
+// HelmHistory represents a helm release revisions history.
+type HelmHistory struct {
+	NonResource
+}
+
+// List returns a release revisions, latest first.
+func (h *HelmHistory) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	path, ok := ctx.Value(internal.KeyPath).(string)
+	if !ok {
+		return nil, errors.New("no context path found")
+	}
+	ns, n := client.Namespaced(path)
+	cfg, err := h.helm().EnsureHelmConfig(ns)
+	if err != nil {
+		return nil, err
+	}
+	rr, err := action.NewHistory(cfg).Run(n)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(rr, func(i, j int) bool {
+		return rr[i].Version > rr[j].Version
+	})
+
+	oo := make([]runtime.Object, 0, len(rr))
+	for _, r := range rr {
+		oo = append(oo, render.HelmHistoryRes{Release: r})
+	}
+
+	return oo, nil
+}
+
+// Get returns a given release revision.
+func (h *HelmHistory) Get(_ context.Context, path string) (runtime.Object, error) {
+	r, err := h.revision(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return render.HelmHistoryRes{Release: r}, nil
+}
+
+// Describe returns a release revision notes.
+func (h *HelmHistory) Describe(ctx context.Context, path string) (string, error) {
+	return cancelable(ctx, func() (string, error) {
+		r, err := h.revision(path)
+		if err != nil {
+			return "", err
+		}
+
+		return r.Info.Notes, nil
+	})
+}
+
+// ToYAML returns a release revision rendered manifests.
+func (h *HelmHistory) ToYAML(ctx context.Context, path string, _ bool) (string, error) {
+	return cancelable(ctx, func() (string, error) {
+		r, err := h.revision(path)
+		if err != nil {
+			return "", err
+		}
+
+		return r.Manifest, nil
+	})
+}
+
+// Values returns a release revision user supplied values.
+func (h *HelmHistory) Values(path string) ([]byte, error) {
+	r, err := h.revision(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return yaml.Marshal(r.Config)
+}
+
+// Rollback rolls a release back to a given revision. Helm rollbacks can't be
+// canceled so a rollback outliving the context completes in the background.
+func (h *HelmHistory) Rollback(ctx context.Context, path string) error {
+	ns, n, rev, err := ParseHelmRevision(path)
+	if err != nil {
+		return err
+	}
+	cfg, err := h.helm().EnsureHelmConfig(ns)
+	if err != nil {
+		return err
+	}
+	rb := action.NewRollback(cfg)
+	rb.Version = rev
+	if dl, ok := ctx.Deadline(); ok {
+		rb.Timeout = time.Until(dl)
+	}
+
+	c := make(chan error, 1)
+	go func() {
+		c <- rb.Run(n)
+	}()
+	select {
+	case err = <-c:
+	case <-ctx.Done():
+		err = fmt.Errorf("rollback of %s still pending: %w", n, ctx.Err())
+	}
+
+	return audited(err, h.Factory, "rollback", "helm", path, nil)
+}
+
+func (h *HelmHistory) revision(path string) (*release.Release, error) {
+	ns, n, rev, err := ParseHelmRevision(path)
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := h.helm().EnsureHelmConfig(ns)
+	if err != nil {
+		return nil, err
+	}
+	get := action.NewGet(cfg)
+	get.Version = rev
+
+	return get.Run(n)
+}
+
+func (h *HelmHistory) helm() *Helm {
+	var hm Helm
+	hm.Init(h.GetFactory(), client.NewGVR("helm"))
+
+	return &hm
+}
+
+// ParseHelmRevision extracts a release namespace, name and revision from a ns/name:rev path.
+func ParseHelmRevision(path string) (string, string, int, error) {
+	i := strings.LastIndex(path, ":")
+	if i < 0 {
+		return "", "", 0, fmt.Errorf("invalid helm revision path %q", path)
+	}
+	rev, err := strconv.Atoi(path[i+1:])
+	if err != nil {
+		return "", "", 0, fmt.Errorf("invalid helm revision path %q", path)
+	}
+	ns, n := client.Namespaced(path[:i])
+
+	return ns, n, rev, nil
+}
@@ -0,0 +1,47 @@
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseHelmRevision(t *testing.T) {
+	uu := map[string]struct {
+		path    string
+		ns, n   string
+		rev     int
+		wantErr bool
+	}{
+		"plain": {
+			path: "default/fred:3",
+			ns:   "default",
+			n:    "fred",
+			rev:  3,
+		},
+		"no-rev": {
+			path:    "default/fred",
+			wantErr: true,
+		},
+		"bad-rev": {
+			path:    "default/fred:blee",
+			wantErr: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ns, n, rev, err := dao.ParseHelmRevision(u.path)
+			if u.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, u.ns, ns)
+			assert.Equal(t, u.n, n)
+			assert.Equal(t, u.rev, rev)
+		})
+	}
+}
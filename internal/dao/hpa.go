@@ -0,0 +1,70 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ Accessor = (*HorizontalPodAutoscaler)(nil)
+
+// HorizontalPodAutoscaler represents a K8s hpa.
+type HorizontalPodAutoscaler struct {
+	Table
+}
+
+// Status returns an hpa metrics current and target values along with its
+// recent scaling events.
+func (h *HorizontalPodAutoscaler) Status(ctx context.Context, path string) (*render.HPAStatus, error) {
+	hpa, err := h.load(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	dial, err := h.Client().Dial()
+	if err != nil {
+		return nil, err
+	}
+	ee, err := dial.CoreV1().Events(hpa.Namespace).List(ctx, metav1.ListOptions{
+		FieldSelector: fmt.Sprintf(involvedUIDFmt, hpa.UID),
+	})
+	if err != nil {
+		return nil, err
+	}
+	s := render.NewHPAStatus(hpa, ee.Items)
+
+	return &s, nil
+}
+
+// load fetches an hpa as autoscaling/v2, falling back to v2beta2 on clusters
+// not serving v2 yet.
+func (h *HorizontalPodAutoscaler) load(ctx context.Context, path string) (*autoscalingv2.HorizontalPodAutoscaler, error) {
+	ns, n := client.Namespaced(path)
+	dial, err := h.Client().Dial()
+	if err != nil {
+		return nil, err
+	}
+	hpa, err := dial.AutoscalingV2().HorizontalPodAutoscalers(ns).Get(ctx, n, metav1.GetOptions{})
+	if err == nil {
+		return hpa, nil
+	}
+	beta, berr := dial.AutoscalingV2beta2().HorizontalPodAutoscalers(ns).Get(ctx, n, metav1.GetOptions{})
+	if berr != nil {
+		return nil, err
+	}
+	// v2beta2 and v2 share the same wire format.
+	raw, err := json.Marshal(beta)
+	if err != nil {
+		return nil, err
+	}
+	var v2 autoscalingv2.HorizontalPodAutoscaler
+	if err := json.Unmarshal(raw, &v2); err != nil {
+		return nil, err
+	}
+
+	return &v2, nil
+}
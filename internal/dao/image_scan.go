@@ -0,0 +1,290 @@
+package dao
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os/exec"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+)
+
+// Vulnerability severities, most severe first.
+const (
+	SeverityCritical = "CRITICAL"
+	SeverityHigh     = "HIGH"
+	SeverityMedium   = "MEDIUM"
+	SeverityLow      = "LOW"
+	SeverityUnknown  = "UNKNOWN"
+)
+
+// Severities lists vulnerability severities, most severe first.
+var Severities = []string{SeverityCritical, SeverityHigh, SeverityMedium, SeverityLow, SeverityUnknown}
+
+var imageScans = scanCache{
+	reports: make(map[string]*ScanReport),
+	pods:    make(map[string][]string),
+}
+
+// Vulnerability represents an image vulnerability.
+type Vulnerability struct {
+	ID        string `json:"id"`
+	Package   string `json:"package"`
+	Installed string `json:"installed"`
+	Fixed     string `json:"fixed"`
+	Severity  string `json:"severity"`
+	Title     string `json:"title"`
+}
+
+// ScanReport represents an image scan outcome.
+type ScanReport struct {
+	Image string
+	Time  time.Time
+	Vulns []Vulnerability
+	Err   error
+}
+
+// Count returns the number of vulnerabilities of a given severity.
+func (r *ScanReport) Count(severity string) int {
+	var n int
+	for _, v := range r.Vulns {
+		if v.Severity == severity {
+			n++
+		}
+	}
+
+	return n
+}
+
+// BySeverity returns the vulnerabilities of a given severity sorted by id.
+func (r *ScanReport) BySeverity(severity string) []Vulnerability {
+	vv := make([]Vulnerability, 0, len(r.Vulns))
+	for _, v := range r.Vulns {
+		if v.Severity == severity {
+			vv = append(vv, v)
+		}
+	}
+	sort.Slice(vv, func(i, j int) bool {
+		return vv[i].ID < vv[j].ID
+	})
+
+	return vv
+}
+
+// ImageScanner scans container images for vulnerabilities.
+type ImageScanner interface {
+	// Scan returns the vulnerabilities found in a given image.
+	Scan(ctx context.Context, image string) ([]Vulnerability, error)
+}
+
+// NewImageScanner returns a scanner for the given options.
+func NewImageScanner(cfg *config.ImageScans) (ImageScanner, error) {
+	switch cfg.Scanner {
+	case config.ScannerHTTP:
+		if cfg.URL == "" {
+			return nil, fmt.Errorf("no url specified for the http image scanner")
+		}
+		return &httpScanner{url: cfg.URL, client: &http.Client{Timeout: cfg.TimeoutDuration()}}, nil
+	default:
+		bin, err := exec.LookPath(cfg.Binary)
+		if err != nil {
+			return nil, fmt.Errorf("image scanner %q not found: %w", cfg.Binary, err)
+		}
+		return &trivyScanner{bin: bin, timeout: cfg.TimeoutDuration()}, nil
+	}
+}
+
+// ScanImages scans a collection of images and records the outcome for a given
+// resource path. Failed scans are reported individually.
+func ScanImages(ctx context.Context, s ImageScanner, path string, images []string) []*ScanReport {
+	rr := make([]*ScanReport, 0, len(images))
+	for _, img := range images {
+		if ctx.Err() != nil {
+			break
+		}
+		vv, err := s.Scan(ctx, img)
+		for i := range vv {
+			vv[i].Severity = normalizeSeverity(vv[i].Severity)
+		}
+		rr = append(rr, &ScanReport{Image: img, Time: time.Now(), Vulns: vv, Err: err})
+	}
+	imageScans.record(path, rr)
+
+	return rr
+}
+
+// ImageCriticalCVEs returns the number of critical vulnerabilities of a scanned image.
+func ImageCriticalCVEs(image string) (int, bool) {
+	return imageScans.critical([]string{image})
+}
+
+// PodCriticalCVEs returns the number of critical vulnerabilities of a scanned pod images.
+func PodCriticalCVEs(path string) (int, bool) {
+	imageScans.mx.RLock()
+	ii, ok := imageScans.pods[path]
+	imageScans.mx.RUnlock()
+	if !ok {
+		return 0, false
+	}
+
+	return imageScans.critical(ii)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+type scanCache struct {
+	mx      sync.RWMutex
+	reports map[string]*ScanReport
+	pods    map[string][]string
+}
+
+func (c *scanCache) record(path string, rr []*ScanReport) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	ii := make([]string, 0, len(rr))
+	for _, r := range rr {
+		if r.Err != nil {
+			continue
+		}
+		c.reports[r.Image] = r
+		ii = append(ii, r.Image)
+	}
+	if path != "" && len(ii) > 0 {
+		c.pods[path] = ii
+	}
+}
+
+func (c *scanCache) critical(ii []string) (int, bool) {
+	c.mx.RLock()
+	defer c.mx.RUnlock()
+
+	var (
+		n       int
+		scanned bool
+	)
+	for _, img := range ii {
+		if r, ok := c.reports[img]; ok {
+			n, scanned = n+r.Count(SeverityCritical), true
+		}
+	}
+
+	return n, scanned
+}
+
+func normalizeSeverity(s string) string {
+	s = strings.ToUpper(strings.TrimSpace(s))
+	for _, sev := range Severities {
+		if s == sev {
+			return s
+		}
+	}
+
+	return SeverityUnknown
+}
+
+type trivyScanner struct {
+	bin     string
+	timeout time.Duration
+}
+
+type trivyReport struct {
+	Results []struct {
+		Vulnerabilities []struct {
+			VulnerabilityID  string
+			PkgName          string
+			InstalledVersion string
+			FixedVersion     string
+			Severity         string
+			Title            string
+		}
+	}
+}
+
+// Scan runs trivy against an image.
+func (t *trivyScanner) Scan(ctx context.Context, image string) ([]Vulnerability, error) {
+	ctx, cancel := context.WithTimeout(ctx, t.timeout)
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, t.bin, "image", "--quiet", "--format", "json", "--", image)
+	cmd.Stdout, cmd.Stderr = &stdout, &stderr
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return nil, fmt.Errorf("%w: %s", err, msg)
+		}
+		return nil, err
+	}
+
+	return parseTrivy(stdout.Bytes())
+}
+
+func parseTrivy(raw []byte) ([]Vulnerability, error) {
+	var r trivyReport
+	if err := json.Unmarshal(raw, &r); err != nil {
+		return nil, fmt.Errorf("invalid trivy report: %w", err)
+	}
+	var vv []Vulnerability
+	for _, res := range r.Results {
+		for _, v := range res.Vulnerabilities {
+			vv = append(vv, Vulnerability{
+				ID:        v.VulnerabilityID,
+				Package:   v.PkgName,
+				Installed: v.InstalledVersion,
+				Fixed:     v.FixedVersion,
+				Severity:  v.Severity,
+				Title:     v.Title,
+			})
+		}
+	}
+
+	return vv, nil
+}
+
+type httpScanner struct {
+	url    string
+	client *http.Client
+}
+
+type httpReport struct {
+	Vulnerabilities []Vulnerability `json:"vulnerabilities"`
+}
+
+// Scan queries a remote scanner api for an image.
+func (h *httpScanner) Scan(ctx context.Context, image string) ([]Vulnerability, error) {
+	u, err := url.Parse(h.url)
+	if err != nil {
+		return nil, err
+	}
+	q := u.Query()
+	q.Set("image", image)
+	u.RawQuery = q.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("image scanner returned %s", resp.Status)
+	}
+	var r httpReport
+	if err := json.NewDecoder(resp.Body).Decode(&r); err != nil {
+		return nil, fmt.Errorf("invalid scanner report: %w", err)
+	}
+
+	return r.Vulnerabilities, nil
+}
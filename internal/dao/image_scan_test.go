@@ -0,0 +1,96 @@
+package dao_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+const trivyReport = `{"Results":[{"Vulnerabilities":[
+{"VulnerabilityID":"CVE-2","PkgName":"openssl","InstalledVersion":"1.1","FixedVersion":"1.2","Severity":"CRITICAL","Title":"boom"},
+{"VulnerabilityID":"CVE-1","PkgName":"zlib","InstalledVersion":"1.0","Severity":"CRITICAL"},
+{"VulnerabilityID":"CVE-3","PkgName":"curl","InstalledVersion":"7.0","Severity":"low"}]}]}`
+
+func TestScanImagesTrivy(t *testing.T) {
+	bin := filepath.Join(t.TempDir(), "trivy")
+	assert.NoError(t, os.WriteFile(bin, []byte("#!/bin/sh\ncat <<'EOF'\n"+trivyReport+"\nEOF\n"), 0700))
+
+	s, err := dao.NewImageScanner(&config.ImageScans{Binary: bin, Timeout: 10})
+	assert.NoError(t, err)
+	rr := dao.ScanImages(context.Background(), s, "default/p1", []string{"trivy:1.0"})
+
+	assert.Equal(t, 1, len(rr))
+	assert.NoError(t, rr[0].Err)
+	assert.Equal(t, 2, rr[0].Count(dao.SeverityCritical))
+	assert.Equal(t, 1, rr[0].Count(dao.SeverityLow))
+	assert.Equal(t, "CVE-1", rr[0].BySeverity(dao.SeverityCritical)[0].ID)
+
+	n, ok := dao.PodCriticalCVEs("default/p1")
+	assert.True(t, ok)
+	assert.Equal(t, 2, n)
+	n, ok = dao.ImageCriticalCVEs("trivy:1.0")
+	assert.True(t, ok)
+	assert.Equal(t, 2, n)
+	_, ok = dao.PodCriticalCVEs("default/p2")
+	assert.False(t, ok)
+}
+
+func TestScanImagesTrivyArgs(t *testing.T) {
+	bin := filepath.Join(t.TempDir(), "trivy")
+	script := "#!/bin/sh\n[ \"$5\" = \"--\" ] && [ \"$6\" = \"--config=/tmp/x\" ] || exit 1\necho '{}'\n"
+	assert.NoError(t, os.WriteFile(bin, []byte(script), 0700))
+
+	s, err := dao.NewImageScanner(&config.ImageScans{Binary: bin, Timeout: 10})
+	assert.NoError(t, err)
+	rr := dao.ScanImages(context.Background(), s, "", []string{"--config=/tmp/x"})
+
+	assert.Equal(t, 1, len(rr))
+	assert.NoError(t, rr[0].Err)
+}
+
+func TestScanImagesHTTP(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("image") != "http:1.0" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"vulnerabilities": []dao.Vulnerability{
+				{ID: "CVE-1", Package: "openssl", Severity: "High"},
+				{ID: "CVE-2", Package: "bash", Severity: "bozo"},
+			},
+		})
+	}))
+	defer srv.Close()
+
+	s, err := dao.NewImageScanner(&config.ImageScans{Scanner: config.ScannerHTTP, URL: srv.URL, Timeout: 10})
+	assert.NoError(t, err)
+	rr := dao.ScanImages(context.Background(), s, "", []string{"http:1.0", "http:2.0"})
+
+	assert.Equal(t, 2, len(rr))
+	assert.NoError(t, rr[0].Err)
+	assert.Equal(t, 1, rr[0].Count(dao.SeverityHigh))
+	assert.Equal(t, 1, rr[0].Count(dao.SeverityUnknown))
+	assert.Error(t, rr[1].Err)
+
+	n, ok := dao.ImageCriticalCVEs("http:1.0")
+	assert.True(t, ok)
+	assert.Equal(t, 0, n)
+	_, ok = dao.ImageCriticalCVEs("http:2.0")
+	assert.False(t, ok)
+}
+
+func TestNewImageScannerFailed(t *testing.T) {
+	_, err := dao.NewImageScanner(&config.ImageScans{Scanner: config.ScannerHTTP})
+	assert.Error(t, err)
+	_, err = dao.NewImageScanner(&config.ImageScans{Binary: "/blee/trivy"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,344 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/port"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+const (
+	ingressGVR = "networking.k8s.io/v1/ingresses"
+	anyHost    = "*"
+)
+
+var _ Accessor = (*IngressRoute)(nil)
+
+// RouteProbe represents an ingress route test outcome.
+type RouteProbe struct {
+	Status  string
+	Latency time.Duration
+}
+
+// RouteProbes tracks ingress routes test outcomes.
+type RouteProbes struct {
+	probes map[string]RouteProbe
+	mx     sync.RWMutex
+}
+
+// NewRouteProbes returns a new probes tracker.
+func NewRouteProbes() *RouteProbes {
+	return &RouteProbes{probes: make(map[string]RouteProbe)}
+}
+
+// Get returns a route probe if any.
+func (r *RouteProbes) Get(id string) (RouteProbe, bool) {
+	r.mx.RLock()
+	defer r.mx.RUnlock()
+	p, ok := r.probes[id]
+
+	return p, ok
+}
+
+// Set records a route probe.
+func (r *RouteProbes) Set(id string, p RouteProbe) {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+	r.probes[id] = p
+}
+
+// IngressRoute represents an ingress host/path routes listing.
+type IngressRoute struct {
+	NonResource
+}
+
+// List returns the routes for a given ingress.
+func (r *IngressRoute) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	path, ok := ctx.Value(internal.KeyPath).(string)
+	if !ok {
+		return nil, errors.New("no context path found")
+	}
+	probes, _ := ctx.Value(internal.KeyProbes).(*RouteProbes)
+
+	ing, err := r.ingress(path)
+	if err != nil {
+		return nil, err
+	}
+	rr := IngressRoutes(ing)
+	oo := make([]runtime.Object, 0, len(rr))
+	for _, rt := range rr {
+		rt.Pods = r.backendPods(rt.Namespace, rt.Service)
+		if probes != nil {
+			if p, ok := probes.Get(rt.ID()); ok {
+				rt.Status, rt.Latency = p.Status, p.Latency.Round(time.Millisecond).String()
+			}
+		}
+		oo = append(oo, rt)
+	}
+
+	return oo, nil
+}
+
+// Probe issues a test request for a given route via a temporary port-forward.
+func (r *IngressRoute) Probe(ctx context.Context, rt render.IngressRouteRes) RouteProbe {
+	p, err := r.probe(ctx, rt)
+	if err != nil {
+		log.Warn().Err(err).Msgf("Route probe failed %s", rt.ID())
+		return RouteProbe{Status: err.Error()}
+	}
+
+	return p
+}
+
+func (r *IngressRoute) probe(ctx context.Context, rt render.IngressRouteRes) (RouteProbe, error) {
+	if rt.Service == "" {
+		return RouteProbe{}, errors.New("route has no backend service")
+	}
+	var res Service
+	res.Init(r.Factory, client.NewGVR("v1/services"))
+	svc, err := res.GetInstance(client.FQN(rt.Namespace, rt.Service))
+	if err != nil {
+		return RouteProbe{}, err
+	}
+	target, err := ServiceTargetPort(svc, rt.Port)
+	if err != nil {
+		return RouteProbe{}, err
+	}
+	po, err := r.readyPod(svc.Namespace, svc.Spec.Selector)
+	if err != nil {
+		return RouteProbe{}, err
+	}
+	cport, ok := ContainerPortFor(po, target)
+	if !ok {
+		return RouteProbe{}, fmt.Errorf("no container port %s on pod %s", target.String(), po.Name)
+	}
+
+	pf := NewPortForwarder(r.Factory)
+	fwd, err := pf.Start(client.FQN(po.Namespace, po.Name), port.NewPortTunnel("localhost", "", "0", strconv.Itoa(int(cport))))
+	if err != nil {
+		return RouteProbe{}, err
+	}
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- fwd.ForwardPorts()
+	}()
+	defer pf.Stop()
+
+	select {
+	case <-pf.readyChan:
+	case err := <-errChan:
+		return RouteProbe{}, err
+	case <-ctx.Done():
+		return RouteProbe{}, ctx.Err()
+	}
+	pp, err := fwd.GetPorts()
+	if err != nil || len(pp) == 0 {
+		return RouteProbe{}, fmt.Errorf("unable to resolve forwarded port: %w", err)
+	}
+
+	url := fmt.Sprintf("http://localhost:%d%s", pp[0].Local, rt.Path)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return RouteProbe{}, err
+	}
+	if rt.Host != anyHost {
+		req.Host = rt.Host
+	}
+	t := time.Now()
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return RouteProbe{}, err
+	}
+	defer resp.Body.Close()
+
+	return RouteProbe{Status: resp.Status, Latency: time.Since(t)}, nil
+}
+
+func (r *IngressRoute) ingress(path string) (*netv1.Ingress, error) {
+	o, err := r.GetFactory().Get(ingressGVR, path, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expecting unstructured but got %T", o)
+	}
+	var ing netv1.Ingress
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &ing); err != nil {
+		return nil, err
+	}
+
+	return &ing, nil
+}
+
+func (r *IngressRoute) backendPods(ns, svcName string) string {
+	if svcName == "" {
+		return render.NAValue
+	}
+	var res Service
+	res.Init(r.Factory, client.NewGVR("v1/services"))
+	svc, err := res.GetInstance(client.FQN(ns, svcName))
+	if err != nil || len(svc.Spec.Selector) == 0 {
+		return render.NAValue
+	}
+	pods, err := r.pods(ns, svc.Spec.Selector)
+	if err != nil {
+		return render.NAValue
+	}
+	var ready int
+	for i := range pods {
+		if isPodReady(&pods[i]) {
+			ready++
+		}
+	}
+
+	return strconv.Itoa(ready) + "/" + strconv.Itoa(len(pods))
+}
+
+func (r *IngressRoute) readyPod(ns string, sel map[string]string) (*v1.Pod, error) {
+	if len(sel) == 0 {
+		return nil, errors.New("backend service has no selector")
+	}
+	pods, err := r.pods(ns, sel)
+	if err != nil {
+		return nil, err
+	}
+	for i := range pods {
+		if isPodReady(&pods[i]) {
+			return &pods[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("no ready pods for %v", sel)
+}
+
+func (r *IngressRoute) pods(ns string, sel map[string]string) ([]v1.Pod, error) {
+	oo, err := r.GetFactory().List("v1/pods", ns, true, labels.Set(sel).AsSelector())
+	if err != nil {
+		return nil, err
+	}
+	pods := make([]v1.Pod, 0, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting unstructured but got %T", o)
+		}
+		var po v1.Pod
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &po); err != nil {
+			return nil, err
+		}
+		pods = append(pods, po)
+	}
+
+	return pods, nil
+}
+
+// IngressRoutes enumerates an ingress host/path rules.
+func IngressRoutes(ing *netv1.Ingress) []render.IngressRouteRes {
+	var rr []render.IngressRouteRes
+	if b := ing.Spec.DefaultBackend; b != nil {
+		rr = append(rr, toRoute(ing.Namespace, anyHost, netv1.HTTPIngressPath{Path: "/", Backend: *b}))
+	}
+	for _, rule := range ing.Spec.Rules {
+		host := rule.Host
+		if host == "" {
+			host = anyHost
+		}
+		if rule.HTTP == nil {
+			continue
+		}
+		for _, p := range rule.HTTP.Paths {
+			rr = append(rr, toRoute(ing.Namespace, host, p))
+		}
+	}
+
+	return rr
+}
+
+// ServiceTargetPort returns the target port for a given service port name or number.
+func ServiceTargetPort(svc *v1.Service, p string) (intstr.IntOrString, error) {
+	for _, sp := range svc.Spec.Ports {
+		if sp.Name != p && strconv.Itoa(int(sp.Port)) != p {
+			continue
+		}
+		if sp.TargetPort.Type == intstr.Int && sp.TargetPort.IntVal == 0 {
+			return intstr.FromInt(int(sp.Port)), nil
+		}
+		return sp.TargetPort, nil
+	}
+
+	return intstr.IntOrString{}, fmt.Errorf("no port %q on service %s", p, svc.Name)
+}
+
+// ContainerPortFor resolves a target port against a pod containers ports.
+func ContainerPortFor(po *v1.Pod, target intstr.IntOrString) (int32, bool) {
+	if target.Type == intstr.Int {
+		return target.IntVal, true
+	}
+	for _, co := range po.Spec.Containers {
+		for _, p := range co.Ports {
+			if p.Name == target.StrVal {
+				return p.ContainerPort, true
+			}
+		}
+	}
+
+	return 0, false
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func toRoute(ns, host string, p netv1.HTTPIngressPath) render.IngressRouteRes {
+	rt := render.IngressRouteRes{
+		Namespace: ns,
+		Host:      host,
+		Path:      p.Path,
+	}
+	if rt.Path == "" {
+		rt.Path = "/"
+	}
+	if p.PathType != nil {
+		rt.PathType = string(*p.PathType)
+	}
+	switch {
+	case p.Backend.Service != nil:
+		rt.Service = p.Backend.Service.Name
+		rt.Port = p.Backend.Service.Port.Name
+		if rt.Port == "" {
+			rt.Port = strconv.Itoa(int(p.Backend.Service.Port.Number))
+		}
+	case p.Backend.Resource != nil:
+		rt.Resource = p.Backend.Resource.Kind + "/" + p.Backend.Resource.Name
+	}
+
+	return rt
+}
+
+func isPodReady(po *v1.Pod) bool {
+	if po.Status.Phase != v1.PodRunning || po.DeletionTimestamp != nil {
+		return false
+	}
+	for _, c := range po.Status.Conditions {
+		if c.Type == v1.PodReady {
+			return c.Status == v1.ConditionTrue
+		}
+	}
+
+	return false
+}
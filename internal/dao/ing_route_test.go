@@ -0,0 +1,112 @@
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func TestIngressRoutes(t *testing.T) {
+	prefix := netv1.PathTypePrefix
+	ing := netv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "ing1"},
+		Spec: netv1.IngressSpec{
+			DefaultBackend: &netv1.IngressBackend{
+				Service: &netv1.IngressServiceBackend{Name: "dflt", Port: netv1.ServiceBackendPort{Number: 80}},
+			},
+			Rules: []netv1.IngressRule{
+				{
+					Host: "fred.com",
+					IngressRuleValue: netv1.IngressRuleValue{
+						HTTP: &netv1.HTTPIngressRuleValue{
+							Paths: []netv1.HTTPIngressPath{
+								{
+									Path:     "/api",
+									PathType: &prefix,
+									Backend: netv1.IngressBackend{
+										Service: &netv1.IngressServiceBackend{Name: "api", Port: netv1.ServiceBackendPort{Name: "http"}},
+									},
+								},
+								{
+									PathType: &prefix,
+									Backend: netv1.IngressBackend{
+										Resource: &v1.TypedLocalObjectReference{Kind: "StorageBucket", Name: "static"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	assert.Equal(t, []render.IngressRouteRes{
+		{Namespace: "ns1", Host: "*", Path: "/", Service: "dflt", Port: "80"},
+		{Namespace: "ns1", Host: "fred.com", Path: "/api", PathType: "Prefix", Service: "api", Port: "http"},
+		{Namespace: "ns1", Host: "fred.com", Path: "/", PathType: "Prefix", Resource: "StorageBucket/static"},
+	}, dao.IngressRoutes(&ing))
+}
+
+func TestServiceTargetPort(t *testing.T) {
+	svc := v1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "svc1"},
+		Spec: v1.ServiceSpec{
+			Ports: []v1.ServicePort{
+				{Name: "http", Port: 80, TargetPort: intstr.FromString("web")},
+				{Name: "metrics", Port: 9090},
+			},
+		},
+	}
+
+	uu := map[string]struct {
+		port string
+		e    intstr.IntOrString
+		err  bool
+	}{
+		"name":    {port: "http", e: intstr.FromString("web")},
+		"number":  {port: "80", e: intstr.FromString("web")},
+		"default": {port: "metrics", e: intstr.FromInt(9090)},
+		"missing": {port: "443", err: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p, err := dao.ServiceTargetPort(&svc, u.port)
+			if u.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, p)
+		})
+	}
+}
+
+func TestContainerPortFor(t *testing.T) {
+	po := v1.Pod{
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{
+				{Name: "c1", Ports: []v1.ContainerPort{{Name: "web", ContainerPort: 8080}}},
+			},
+		},
+	}
+
+	p, ok := dao.ContainerPortFor(&po, intstr.FromString("web"))
+	assert.True(t, ok)
+	assert.Equal(t, int32(8080), p)
+
+	p, ok = dao.ContainerPortFor(&po, intstr.FromInt(9000))
+	assert.True(t, ok)
+	assert.Equal(t, int32(9000), p)
+
+	_, ok = dao.ContainerPortFor(&po, intstr.FromString("grpc"))
+	assert.False(t, ok)
+}
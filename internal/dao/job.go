@@ -9,6 +9,7 @@ import (
 	"github.com/derailed/k9s/internal/client"
 	"github.com/rs/zerolog/log"
 	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -18,8 +19,17 @@ var (
 	_ Accessor = (*Job)(nil)
 	_ Nuker    = (*Job)(nil)
 	_ Loggable = (*Job)(nil)
+	_ Runnable = (*Job)(nil)
 )
 
+// Labels stamped by the job controller on a job and its pod template.
+var jobControllerLabels = []string{
+	"controller-uid",
+	"job-name",
+	"batch.kubernetes.io/controller-uid",
+	"batch.kubernetes.io/job-name",
+}
+
 // Job represents a K8s job resource.
 type Job struct {
 	Resource
@@ -56,6 +66,76 @@ func (j *Job) List(ctx context.Context, ns string) ([]runtime.Object, error) {
 	return ll, nil
 }
 
+// Run re-runs a Job by cloning its spec under a fresh name.
+func (j *Job) Run(path string) error {
+	ns, _ := client.Namespaced(path)
+	auth, err := j.Client().CanI(ns, jobGVR, []string{client.GetVerb, client.CreateVerb})
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to run jobs")
+	}
+
+	o, err := j.GetFactory().Get(j.GVR(), path, true, labels.Everything())
+	if err != nil {
+		return err
+	}
+	var job batchv1.Job
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &job)
+	if err != nil {
+		return errors.New("expecting Job resource")
+	}
+
+	dial, err := j.Client().Dial()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), j.Client().Config().CallTimeout())
+	defer cancel()
+	_, err = dial.BatchV1().Jobs(ns).Create(ctx, CloneJob(&job), metav1.CreateOptions{})
+
+	return audited(err, j.Factory, "rerun", j.GVR(), path, nil)
+}
+
+// CloneJob returns a copy of a job suitable for a re-run.
+// Controller generated selector and labels are dropped unless the selector was set manually.
+func CloneJob(job *batchv1.Job) *batchv1.Job {
+	clone := batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            jobName(job.Name, "rerun"),
+			Namespace:       job.Namespace,
+			Labels:          job.Labels,
+			Annotations:     job.Annotations,
+			OwnerReferences: job.OwnerReferences,
+		},
+		Spec: *job.Spec.DeepCopy(),
+	}
+	if job.Spec.ManualSelector != nil && *job.Spec.ManualSelector {
+		return &clone
+	}
+	clone.Spec.Selector = nil
+	clone.Labels = withoutLabels(clone.Labels, jobControllerLabels)
+	clone.Spec.Template.Labels = withoutLabels(clone.Spec.Template.Labels, jobControllerLabels)
+
+	return &clone
+}
+
+func withoutLabels(ll map[string]string, kk []string) map[string]string {
+	if len(ll) == 0 {
+		return ll
+	}
+	res := make(map[string]string, len(ll))
+	for k, v := range ll {
+		res[k] = v
+	}
+	for _, k := range kk {
+		delete(res, k)
+	}
+
+	return res
+}
+
 // TailLogs tail logs for all pods represented by this Job.
 func (j *Job) TailLogs(ctx context.Context, opts *LogOptions) ([]LogChan, error) {
 	o, err := j.GetFactory().Get(j.gvr.String(), opts.Path, true, labels.Everything())
@@ -119,25 +199,28 @@ func (j *Job) Scan(ctx context.Context, gvr, fqn string, wait bool) (Refs, error
 		}
 		switch gvr {
 		case "v1/configmaps":
-			if !hasConfigMap(&job.Spec.Template.Spec, n) {
+			usage := configMapUsage(&job.Spec.Template.Spec, n)
+			if usage == "" {
 				continue
 			}
 			refs = append(refs, Ref{
-				GVR: j.GVR(),
-				FQN: client.FQN(job.Namespace, job.Name),
+				GVR:   j.GVR(),
+				FQN:   client.FQN(job.Namespace, job.Name),
+				Usage: usage,
 			})
 		case "v1/secrets":
-			found, err := hasSecret(j.Factory, &job.Spec.Template.Spec, job.Namespace, n, wait)
+			usage, err := secretUsage(j.Factory, &job.Spec.Template.Spec, job.Namespace, n, wait)
 			if err != nil {
 				log.Warn().Err(err).Msgf("locate secret %q", fqn)
 				continue
 			}
-			if !found {
+			if usage == "" {
 				continue
 			}
 			refs = append(refs, Ref{
-				GVR: j.GVR(),
-				FQN: client.FQN(job.Namespace, job.Name),
+				GVR:   j.GVR(),
+				FQN:   client.FQN(job.Namespace, job.Name),
+				Usage: usage,
 			})
 		case "scheduling.k8s.io/v1/priorityclasses":
 			if !hasPC(&job.Spec.Template.Spec, n) {
@@ -0,0 +1,52 @@
+package dao_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestCloneJob(t *testing.T) {
+	manual := true
+	uu := map[string]struct {
+		manual   *bool
+		selector bool
+		labels   map[string]string
+	}{
+		"generated": {
+			labels: map[string]string{"app": "fred"},
+		},
+		"manual": {
+			manual:   &manual,
+			selector: true,
+			labels:   map[string]string{"app": "fred", "controller-uid": "abc", "job-name": "fred"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ll := map[string]string{"app": "fred", "controller-uid": "abc", "job-name": "fred"}
+			job := batchv1.Job{
+				ObjectMeta: metav1.ObjectMeta{Name: "fred", Namespace: "ns1", Labels: ll},
+				Spec: batchv1.JobSpec{
+					ManualSelector: u.manual,
+					Selector:       &metav1.LabelSelector{MatchLabels: map[string]string{"controller-uid": "abc"}},
+				},
+			}
+			job.Spec.Template.Labels = ll
+
+			c := dao.CloneJob(&job)
+			assert.True(t, strings.HasPrefix(c.Name, "fred-rerun-"))
+			assert.Equal(t, "ns1", c.Namespace)
+			assert.Equal(t, u.selector, c.Spec.Selector != nil)
+			assert.Equal(t, u.labels, c.Labels)
+			assert.Equal(t, u.labels, c.Spec.Template.Labels)
+			assert.Equal(t, "abc", job.Labels["controller-uid"])
+		})
+	}
+}
@@ -0,0 +1,332 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Lint checks.
+const (
+	LintUnusedConfigMap = "unused-configmap"
+	LintMissingProbes   = "missing-probes"
+	LintPrivileged      = "privileged"
+	LintDeprecatedAPI   = "deprecated-api"
+
+	rootCACertCM       = "kube-root-ca.crt"
+	lintPenaltyWarning = 50
+)
+
+// lintedGVRs tracks the workload resources checked for deprecated apis.
+var lintedGVRs = []string{
+	"apps/v1/deployments",
+	"apps/v1/daemonsets",
+	"apps/v1/statefulsets",
+	"batch/v1/cronjobs",
+	"networking.k8s.io/v1/ingresses",
+	"policy/v1/poddisruptionbudgets",
+	"autoscaling/v1/horizontalpodautoscalers",
+}
+
+var (
+	_ Accessor = (*Lint)(nil)
+
+	lintScores sync.Map
+)
+
+// Lint sanitizes cluster resources.
+type Lint struct {
+	NonResource
+}
+
+// LintReport represents a sanitization outcome.
+type LintReport struct {
+	// Scanned the number of checked resources.
+	Scanned int
+	Issues  []render.LintRes
+}
+
+// Score returns the percentage of healthy resources. Resources with errors
+// score nil, resources with warnings score half.
+func (r LintReport) Score() int {
+	if r.Scanned == 0 {
+		return 100
+	}
+	worst := make(map[string]int, len(r.Issues))
+	for _, i := range r.Issues {
+		if i.Level > worst[i.Path] {
+			worst[i.Path] = i.Level
+		}
+	}
+	penalty := 0
+	for _, l := range worst {
+		switch l {
+		case render.LintError:
+			penalty += 100
+		case render.LintWarn:
+			penalty += lintPenaltyWarning
+		}
+	}
+	if penalty > r.Scanned*100 {
+		return 0
+	}
+
+	return 100 - penalty/r.Scanned
+}
+
+// Grade returns a letter grade for a given score.
+func (r LintReport) Grade() string {
+	s := r.Score()
+	for i, g := range []string{"A", "B", "C", "D", "E"} {
+		if s >= 90-10*i {
+			return g
+		}
+	}
+
+	return "F"
+}
+
+// LintScore returns the last sanitization report for a given namespace.
+func LintScore(ns string) (LintReport, bool) {
+	r, ok := lintScores.Load(ns)
+	if !ok {
+		return LintReport{}, false
+	}
+
+	return r.(LintReport), true
+}
+
+// List returns the sanitization issues for a given namespace.
+func (l *Lint) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	r, err := l.Sanitize(ns)
+	if err != nil {
+		return nil, err
+	}
+	lintScores.Store(ns, r)
+	oo := make([]runtime.Object, 0, len(r.Issues))
+	for _, i := range r.Issues {
+		oo = append(oo, i)
+	}
+
+	return oo, nil
+}
+
+// Sanitize lints the resources of a given namespace.
+func (l *Lint) Sanitize(ns string) (LintReport, error) {
+	var pp []v1.Pod
+	err := listAs(l.Factory, "v1/pods", ns, func(u *unstructured.Unstructured) error {
+		var po v1.Pod
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &po); err != nil {
+			return err
+		}
+		pp = append(pp, po)
+		return nil
+	})
+	if err != nil {
+		return LintReport{}, err
+	}
+	var cms []v1.ConfigMap
+	err = listAs(l.Factory, "v1/configmaps", ns, func(u *unstructured.Unstructured) error {
+		var cm v1.ConfigMap
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &cm); err != nil {
+			return err
+		}
+		cms = append(cms, cm)
+		return nil
+	})
+	if err != nil {
+		return LintReport{}, err
+	}
+
+	r := LintReport{Scanned: len(pp) + len(cms)}
+	r.Issues = append(r.Issues, LintPods(pp)...)
+	r.Issues = append(r.Issues, LintConfigMaps(cms, pp)...)
+	for _, gvr := range lintedGVRs {
+		var uu []*unstructured.Unstructured
+		err := listAs(l.Factory, gvr, ns, func(u *unstructured.Unstructured) error {
+			uu = append(uu, u)
+			return nil
+		})
+		if err != nil {
+			log.Warn().Err(err).Msgf("Lint skipping %s", gvr)
+			continue
+		}
+		r.Scanned += len(uu)
+		r.Issues = append(r.Issues, LintDeprecated(gvr, uu)...)
+	}
+	sort.SliceStable(r.Issues, func(i, j int) bool {
+		return r.Issues[i].Level > r.Issues[j].Level
+	})
+
+	return r, nil
+}
+
+// LintPods checks pods for missing probes and privileged containers.
+func LintPods(pp []v1.Pod) []render.LintRes {
+	var ii []render.LintRes
+	for _, po := range pp {
+		path := client.FQN(po.Namespace, po.Name)
+		for _, co := range po.Spec.Containers {
+			if sc := co.SecurityContext; sc != nil && sc.Privileged != nil && *sc.Privileged {
+				ii = append(ii, render.LintRes{
+					Level:   render.LintError,
+					Check:   LintPrivileged,
+					GVR:     "v1/pods",
+					Path:    path,
+					Message: fmt.Sprintf("container %s runs privileged", co.Name),
+				})
+			}
+			if isJobPod(po) {
+				continue
+			}
+			switch {
+			case co.ReadinessProbe == nil && co.LivenessProbe == nil:
+				ii = append(ii, render.LintRes{
+					Level:   render.LintWarn,
+					Check:   LintMissingProbes,
+					GVR:     "v1/pods",
+					Path:    path,
+					Message: fmt.Sprintf("container %s has no liveness nor readiness probes", co.Name),
+				})
+			case co.ReadinessProbe == nil:
+				ii = append(ii, render.LintRes{
+					Level:   render.LintInfo,
+					Check:   LintMissingProbes,
+					GVR:     "v1/pods",
+					Path:    path,
+					Message: fmt.Sprintf("container %s has no readiness probe", co.Name),
+				})
+			case co.LivenessProbe == nil:
+				ii = append(ii, render.LintRes{
+					Level:   render.LintInfo,
+					Check:   LintMissingProbes,
+					GVR:     "v1/pods",
+					Path:    path,
+					Message: fmt.Sprintf("container %s has no liveness probe", co.Name),
+				})
+			}
+		}
+	}
+
+	return ii
+}
+
+// LintConfigMaps checks for config maps not referenced by any pods.
+func LintConfigMaps(cms []v1.ConfigMap, pp []v1.Pod) []render.LintRes {
+	used := make(map[string]struct{})
+	for _, po := range pp {
+		for _, n := range podConfigMaps(po.Spec) {
+			used[client.FQN(po.Namespace, n)] = struct{}{}
+		}
+	}
+
+	var ii []render.LintRes
+	for _, cm := range cms {
+		if cm.Name == rootCACertCM {
+			continue
+		}
+		path := client.FQN(cm.Namespace, cm.Name)
+		if _, ok := used[path]; ok {
+			continue
+		}
+		ii = append(ii, render.LintRes{
+			Level:   render.LintInfo,
+			Check:   LintUnusedConfigMap,
+			GVR:     "v1/configmaps",
+			Path:    path,
+			Message: "config map is not referenced by any pods",
+		})
+	}
+
+	return ii
+}
+
+// LintDeprecated checks for resources managed via deprecated api versions.
+func LintDeprecated(gvr string, uu []*unstructured.Unstructured) []render.LintRes {
+	var ii []render.LintRes
+	for _, u := range uu {
+		if v, ok := deprecatedVersion(u); ok {
+			ii = append(ii, render.LintRes{
+				Level:   render.LintWarn,
+				Check:   LintDeprecatedAPI,
+				GVR:     gvr,
+				Path:    client.FQN(u.GetNamespace(), u.GetName()),
+				Message: fmt.Sprintf("managed via deprecated api %s", v),
+			})
+		}
+	}
+
+	return ii
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func listAs(f Factory, gvr, ns string, fn func(*unstructured.Unstructured) error) error {
+	oo, err := f.List(gvr, ns, false, labels.Everything())
+	if err != nil {
+		return err
+	}
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return fmt.Errorf("expecting unstructured but got %T", o)
+		}
+		if err := fn(u); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func isJobPod(po v1.Pod) bool {
+	for _, o := range po.OwnerReferences {
+		if o.Kind == "Job" {
+			return true
+		}
+	}
+
+	return false
+}
+
+func podConfigMaps(spec v1.PodSpec) []string {
+	var nn []string
+	for _, v := range spec.Volumes {
+		if v.ConfigMap != nil {
+			nn = append(nn, v.ConfigMap.Name)
+		}
+		if v.Projected == nil {
+			continue
+		}
+		for _, s := range v.Projected.Sources {
+			if s.ConfigMap != nil {
+				nn = append(nn, s.ConfigMap.Name)
+			}
+		}
+	}
+	cc := append(append([]v1.Container{}, spec.InitContainers...), spec.Containers...)
+	for _, co := range cc {
+		for _, e := range co.EnvFrom {
+			if e.ConfigMapRef != nil {
+				nn = append(nn, e.ConfigMapRef.Name)
+			}
+		}
+		for _, e := range co.Env {
+			if e.ValueFrom != nil && e.ValueFrom.ConfigMapKeyRef != nil {
+				nn = append(nn, e.ValueFrom.ConfigMapKeyRef.Name)
+			}
+		}
+	}
+
+	return nn
+}
@@ -0,0 +1,121 @@
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestLintPods(t *testing.T) {
+	priv, probe := true, &v1.Probe{}
+	pp := []v1.Pod{
+		lintPod("p1", nil, v1.Container{Name: "c1", ReadinessProbe: probe, LivenessProbe: probe}),
+		lintPod("p2", nil, v1.Container{Name: "c1", ReadinessProbe: probe}),
+		lintPod("p3", nil, v1.Container{Name: "c1", LivenessProbe: probe, ReadinessProbe: probe, SecurityContext: &v1.SecurityContext{Privileged: &priv}}),
+		lintPod("p4", nil, v1.Container{Name: "c1"}),
+		lintPod("p5", []metav1.OwnerReference{{Kind: "Job", Name: "j1"}}, v1.Container{Name: "c1"}),
+	}
+
+	ii := dao.LintPods(pp)
+	assert.Equal(t, []render.LintRes{
+		{Level: render.LintInfo, Check: dao.LintMissingProbes, GVR: "v1/pods", Path: "default/p2", Message: "container c1 has no liveness probe"},
+		{Level: render.LintError, Check: dao.LintPrivileged, GVR: "v1/pods", Path: "default/p3", Message: "container c1 runs privileged"},
+		{Level: render.LintWarn, Check: dao.LintMissingProbes, GVR: "v1/pods", Path: "default/p4", Message: "container c1 has no liveness nor readiness probes"},
+	}, ii)
+}
+
+func TestLintConfigMaps(t *testing.T) {
+	po := lintPod("p1", nil, v1.Container{
+		Name: "c1",
+		Env: []v1.EnvVar{{Name: "a", ValueFrom: &v1.EnvVarSource{
+			ConfigMapKeyRef: &v1.ConfigMapKeySelector{LocalObjectReference: v1.LocalObjectReference{Name: "cm2"}},
+		}}},
+	})
+	po.Spec.Volumes = []v1.Volume{{Name: "v1", VolumeSource: v1.VolumeSource{
+		ConfigMap: &v1.ConfigMapVolumeSource{LocalObjectReference: v1.LocalObjectReference{Name: "cm1"}},
+	}}}
+	cms := []v1.ConfigMap{lintCM("default", "cm1"), lintCM("default", "cm2"), lintCM("default", "cm3"), lintCM("fred", "cm1"), lintCM("default", "kube-root-ca.crt")}
+
+	ii := dao.LintConfigMaps(cms, []v1.Pod{po})
+	assert.Equal(t, 2, len(ii))
+	assert.Equal(t, "default/cm3", ii[0].Path)
+	assert.Equal(t, "fred/cm1", ii[1].Path)
+}
+
+func TestLintDeprecated(t *testing.T) {
+	u1, u2, u3 := lintObj("d1"), lintObj("d2"), lintObj("d3")
+	u1.SetAnnotations(map[string]string{"kubectl.kubernetes.io/last-applied-configuration": `{"apiVersion":"extensions/v1beta1"}`})
+	u2.SetManagedFields([]metav1.ManagedFieldsEntry{{Manager: "helm", APIVersion: "apps/v1beta2"}})
+	u3.SetManagedFields([]metav1.ManagedFieldsEntry{{Manager: "helm", APIVersion: "apps/v1"}})
+
+	ii := dao.LintDeprecated("apps/v1/deployments", []*unstructured.Unstructured{u1, u2, u3})
+	assert.Equal(t, 2, len(ii))
+	assert.Equal(t, "managed via deprecated api extensions/v1beta1", ii[0].Message)
+	assert.Equal(t, "default/d2", ii[1].Path)
+}
+
+func TestLintReportScore(t *testing.T) {
+	uu := map[string]struct {
+		r     dao.LintReport
+		score int
+		grade string
+	}{
+		"empty": {
+			score: 100,
+			grade: "A",
+		},
+		"clean": {
+			r:     dao.LintReport{Scanned: 10, Issues: []render.LintRes{{Level: render.LintInfo, Path: "p1"}}},
+			score: 100,
+			grade: "A",
+		},
+		"mixed": {
+			r: dao.LintReport{Scanned: 10, Issues: []render.LintRes{
+				{Level: render.LintError, Path: "p1"},
+				{Level: render.LintWarn, Path: "p1"},
+				{Level: render.LintWarn, Path: "p2"},
+			}},
+			score: 85,
+			grade: "B",
+		},
+		"toast": {
+			r:     dao.LintReport{Scanned: 1, Issues: []render.LintRes{{Level: render.LintError, Path: "p1"}}},
+			score: 0,
+			grade: "F",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.score, u.r.Score())
+			assert.Equal(t, u.grade, u.r.Grade())
+		})
+	}
+}
+
+// Helpers...
+
+func lintPod(n string, oo []metav1.OwnerReference, cc ...v1.Container) v1.Pod {
+	return v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: n, OwnerReferences: oo},
+		Spec:       v1.PodSpec{Containers: cc},
+	}
+}
+
+func lintCM(ns, n string) v1.ConfigMap {
+	return v1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: ns, Name: n}}
+}
+
+func lintObj(n string) *unstructured.Unstructured {
+	var u unstructured.Unstructured
+	u.SetNamespace("default")
+	u.SetName(n)
+
+	return &u
+}
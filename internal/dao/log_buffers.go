@@ -0,0 +1,51 @@
+package dao
+
+import (
+	"sort"
+	"sync"
+)
+
+// LogBufferStat tracks a log view buffer size.
+type LogBufferStat struct {
+	Path         string
+	Lines, Bytes int
+}
+
+var logBuffers = struct {
+	mx      sync.RWMutex
+	buffers map[*LogItems]string
+}{
+	buffers: make(map[*LogItems]string),
+}
+
+// TrackLogBuffer registers a log buffer for self metrics.
+func TrackLogBuffer(path string, l *LogItems) {
+	logBuffers.mx.Lock()
+	defer logBuffers.mx.Unlock()
+
+	logBuffers.buffers[l] = path
+}
+
+// UntrackLogBuffer deregisters a log buffer.
+func UntrackLogBuffer(l *LogItems) {
+	logBuffers.mx.Lock()
+	defer logBuffers.mx.Unlock()
+
+	delete(logBuffers.buffers, l)
+}
+
+// LogBufferStats returns the sizes of all tracked log buffers.
+func LogBufferStats() []LogBufferStat {
+	logBuffers.mx.RLock()
+	defer logBuffers.mx.RUnlock()
+
+	ss := make([]LogBufferStat, 0, len(logBuffers.buffers))
+	for l, path := range logBuffers.buffers {
+		ss = append(ss, LogBufferStat{Path: path, Lines: l.Len(), Bytes: l.Size()})
+	}
+	sort.Slice(ss, func(i, j int) bool {
+		return ss[i].Path < ss[j].Path
+	})
+
+	return ss
+}
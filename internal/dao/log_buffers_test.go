@@ -0,0 +1,25 @@
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogBufferStats(t *testing.T) {
+	l1, l2 := dao.NewLogItems(), dao.NewLogItems()
+	l1.Add(dao.NewLogItem([]byte("hello")), dao.NewLogItem([]byte("world!")))
+	l2.Add(dao.NewLogItem([]byte("bozo")))
+
+	dao.TrackLogBuffer("ns1/p1", l1)
+	dao.TrackLogBuffer("ns1/p2", l2)
+	assert.Equal(t, []dao.LogBufferStat{
+		{Path: "ns1/p1", Lines: 2, Bytes: 11},
+		{Path: "ns1/p2", Lines: 1, Bytes: 4},
+	}, dao.LogBufferStats())
+
+	dao.UntrackLogBuffer(l1)
+	dao.UntrackLogBuffer(l2)
+	assert.Equal(t, 0, len(dao.LogBufferStats()))
+}
@@ -51,6 +51,19 @@ func (l *LogItems) Len() int {
 	return len(l.items)
 }
 
+// Size returns the items size in bytes.
+func (l *LogItems) Size() int {
+	l.mx.RLock()
+	defer l.mx.RUnlock()
+
+	var n int
+	for _, i := range l.items {
+		n += len(i.Bytes)
+	}
+
+	return n
+}
+
 // Clear removes all items.
 func (l *LogItems) Clear() {
 	l.mx.Lock()
@@ -0,0 +1,138 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	// LabelsField tracks resource labels.
+	LabelsField = "labels"
+	// AnnotationsField tracks resource annotations.
+	AnnotationsField = "annotations"
+)
+
+var _ MetaEditor = (*Generic)(nil)
+
+// MetaChanges represents labels or annotations edits.
+type MetaChanges struct {
+	Set    map[string]string
+	Remove []string
+}
+
+// IsEmpty checks if there are no changes.
+func (m MetaChanges) IsEmpty() bool {
+	return len(m.Set) == 0 && len(m.Remove) == 0
+}
+
+// PatchOp represents a json patch operation.
+type PatchOp struct {
+	Op    string      `json:"op"`
+	Path  string      `json:"path"`
+	Value interface{} `json:"value,omitempty"`
+}
+
+// EditMeta applies labels or annotations changes to a resource.
+func (g *Generic) EditMeta(ctx context.Context, path, field string, changes MetaChanges) error {
+	ns, n := client.Namespaced(path)
+	auth, err := g.Client().CanI(ns, g.gvr.String(), []string{client.PatchVerb})
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to patch %s", path)
+	}
+
+	o, err := g.Get(ctx, path)
+	if err != nil {
+		return err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("expecting unstructured but got %T", o)
+	}
+	current := u.GetLabels()
+	if field == AnnotationsField {
+		current = u.GetAnnotations()
+	}
+	patch, err := MetaPatch(field, current, changes)
+	if err != nil || patch == nil {
+		return err
+	}
+
+	dial, err := g.dynClient()
+	if err != nil {
+		return err
+	}
+	if client.IsClusterScoped(ns) {
+		_, err = dial.Patch(ctx, n, types.JSONPatchType, patch, metav1.PatchOptions{})
+		return audited(err, g.Factory, "edit-"+field, g.GVR(), path, changes)
+	}
+	_, err = dial.Namespace(ns).Patch(ctx, n, types.JSONPatchType, patch, metav1.PatchOptions{})
+
+	return audited(err, g.Factory, "edit-"+field, g.GVR(), path, changes)
+}
+
+// MetaPatch builds a json patch applying changes to a resource labels or annotations.
+// Returns nil when the changes are a no-op.
+func MetaPatch(field string, current map[string]string, changes MetaChanges) ([]byte, error) {
+	if field != LabelsField && field != AnnotationsField {
+		return nil, fmt.Errorf("invalid metadata field %q", field)
+	}
+	root := "/metadata/" + field
+
+	var ops []PatchOp
+	if len(current) == 0 {
+		if len(changes.Set) == 0 {
+			return nil, nil
+		}
+		ops = append(ops, PatchOp{Op: "add", Path: root, Value: changes.Set})
+		return json.Marshal(ops)
+	}
+
+	kk := make([]string, 0, len(changes.Set))
+	for k := range changes.Set {
+		if k == "" {
+			return nil, errors.New("metadata keys must not be blank")
+		}
+		kk = append(kk, k)
+	}
+	sort.Strings(kk)
+	for _, k := range kk {
+		v, ok := current[k]
+		switch {
+		case !ok:
+			ops = append(ops, PatchOp{Op: "add", Path: root + "/" + escapePointer(k), Value: changes.Set[k]})
+		case v != changes.Set[k]:
+			ops = append(ops, PatchOp{Op: "replace", Path: root + "/" + escapePointer(k), Value: changes.Set[k]})
+		}
+	}
+	for _, k := range changes.Remove {
+		if _, ok := current[k]; !ok {
+			continue
+		}
+		if _, ok := changes.Set[k]; ok {
+			continue
+		}
+		ops = append(ops, PatchOp{Op: "remove", Path: root + "/" + escapePointer(k)})
+	}
+	if len(ops) == 0 {
+		return nil, nil
+	}
+
+	return json.Marshal(ops)
+}
+
+// escapePointer escapes a json pointer token.
+func escapePointer(s string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(s, "~", "~0"), "/", "~1")
+}
@@ -0,0 +1,55 @@
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetaPatch(t *testing.T) {
+	uu := map[string]struct {
+		field   string
+		current map[string]string
+		changes dao.MetaChanges
+		e       string
+		err     bool
+	}{
+		"noop": {
+			field:   dao.LabelsField,
+			current: map[string]string{"a": "1"},
+			changes: dao.MetaChanges{Set: map[string]string{"a": "1"}, Remove: []string{"b"}},
+		},
+		"empty": {
+			field:   dao.LabelsField,
+			changes: dao.MetaChanges{Set: map[string]string{"a": "1"}},
+			e:       `[{"op":"add","path":"/metadata/labels","value":{"a":"1"}}]`,
+		},
+		"ops": {
+			field:   dao.AnnotationsField,
+			current: map[string]string{"a": "1", "b": "2", "app.kubernetes.io/name": "fred"},
+			changes: dao.MetaChanges{
+				Set:    map[string]string{"a": "", "c": "3"},
+				Remove: []string{"app.kubernetes.io/name"},
+			},
+			e: `[{"op":"replace","path":"/metadata/annotations/a","value":""},{"op":"add","path":"/metadata/annotations/c","value":"3"},{"op":"remove","path":"/metadata/annotations/app.kubernetes.io~1name"}]`,
+		},
+		"bad-field": {
+			field: "spec",
+			err:   true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p, err := dao.MetaPatch(u.field, u.current, u.changes)
+			if u.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, string(p))
+		})
+	}
+}
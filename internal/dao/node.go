@@ -4,16 +4,21 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"strings"
+	"sync"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/rs/zerolog/log"
 	v1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
 	"k8s.io/kubectl/pkg/drain"
 	"k8s.io/kubectl/pkg/scheme"
 	mv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
@@ -61,11 +66,12 @@ func (n *Node) ToggleCordon(path string, cordon bool) error {
 	if patchErr != nil {
 		return patchErr
 	}
-	if err != nil {
-		return err
+	action := "uncordon"
+	if cordon {
+		action = "cordon"
 	}
 
-	return nil
+	return audited(err, n.Factory, action, n.GVR(), path, nil)
 }
 
 func (o DrainOptions) toDrainHelper(k kubernetes.Interface, w io.Writer) drain.Helper {
@@ -75,13 +81,116 @@ func (o DrainOptions) toDrainHelper(k kubernetes.Interface, w io.Writer) drain.H
 		Timeout:             o.Timeout,
 		DeleteEmptyDirData:  o.DeleteEmptyDirData,
 		IgnoreAllDaemonSets: o.IgnoreAllDaemonSets,
+		DisableEviction:     o.DisableEviction,
 		Out:                 w,
 		ErrOut:              w,
 		Force:               o.Force,
 	}
 }
 
-// Drain drains a node.
+// SetTaints applies the edits made from the original taints to the live node
+// taints. Taints left untouched keep their live settings. The update is retried
+// if the node changed in the meantime.
+func (n *Node) SetTaints(ctx context.Context, path string, original, edited []v1.Taint) error {
+	auth, err := n.Client().CanI(client.ClusterScope, n.GVR(), []string{client.UpdateVerb})
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to update %s", path)
+	}
+
+	dial, err := n.Client().Dial()
+	if err != nil {
+		return err
+	}
+	_, nn := client.Namespaced(path)
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		no, err := dial.CoreV1().Nodes().Get(ctx, nn, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		no.Spec.Taints = EditTaints(no.Spec.Taints, original, edited)
+		_, err = dial.CoreV1().Nodes().Update(ctx, no, metav1.UpdateOptions{})
+		return err
+	})
+
+	return audited(err, n.Factory, "taint", n.GVR(), path, edited)
+}
+
+// EditTaints applies the changes between the original and edited taints to
+// the live taints. Taints are identified by key and effect.
+func EditTaints(live, original, edited []v1.Taint) []v1.Taint {
+	id := func(t v1.Taint) string {
+		return t.Key + ":" + string(t.Effect)
+	}
+	kept := make(map[string]v1.Taint, len(edited))
+	for _, t := range edited {
+		kept[id(t)] = t
+	}
+	removed := make(map[string]struct{}, len(original))
+	unchanged := make(map[string]struct{}, len(original))
+	for _, t := range original {
+		e, ok := kept[id(t)]
+		switch {
+		case !ok:
+			removed[id(t)] = struct{}{}
+		case e.Value == t.Value:
+			unchanged[id(t)] = struct{}{}
+		}
+	}
+
+	taints := make([]v1.Taint, 0, len(live)+len(edited))
+	seen := make(map[string]struct{}, len(live))
+	for _, t := range live {
+		k := id(t)
+		if _, ok := removed[k]; ok {
+			continue
+		}
+		seen[k] = struct{}{}
+		if _, ok := unchanged[k]; !ok {
+			if e, ok := kept[k]; ok {
+				t.Value = e.Value
+			}
+		}
+		taints = append(taints, t)
+	}
+	for _, t := range edited {
+		k := id(t)
+		if _, ok := seen[k]; ok {
+			continue
+		}
+		if _, ok := unchanged[k]; ok {
+			continue
+		}
+		taints = append(taints, t)
+	}
+
+	return taints
+}
+
+// ParseTaint parses a key=value:effect taint spec.
+func ParseTaint(spec string) (v1.Taint, error) {
+	var t v1.Taint
+	kv, effect, ok := strings.Cut(strings.TrimSpace(spec), ":")
+	if !ok {
+		return t, fmt.Errorf("invalid taint %q, expecting key=value:effect", spec)
+	}
+	t.Key, t.Value, _ = strings.Cut(kv, "=")
+	t.Effect = v1.TaintEffect(effect)
+	if t.Key == "" {
+		return t, fmt.Errorf("invalid taint %q, key must not be blank", spec)
+	}
+	switch t.Effect {
+	case v1.TaintEffectNoSchedule, v1.TaintEffectPreferNoSchedule, v1.TaintEffectNoExecute:
+		return t, nil
+	default:
+		return t, fmt.Errorf("invalid taint effect %q", effect)
+	}
+}
+
+// Drain drains a node. Pods are evicted unless eviction is disabled, thus
+// honoring their disruption budgets.
 func (n *Node) Drain(path string, opts DrainOptions, w io.Writer) error {
 	_ = n.ToggleCordon(path, true)
 
@@ -99,15 +208,91 @@ func (n *Node) Drain(path string, opts DrainOptions, w io.Writer) error {
 		}
 		return errs[0]
 	}
+	if w := dd.Warnings(); w != "" {
+		fmt.Fprintf(h.Out, "WARNING: %s\n", w)
+	}
+
+	pods := dd.Pods()
+	fmt.Fprintf(h.Out, "Draining %d pod(s) from node %s...\n", len(pods), path)
+	if !opts.DisableEviction {
+		for _, b := range blockedPods(context.Background(), dial, pods) {
+			fmt.Fprintf(h.Out, "Pod %s is guarded by disruption budget %s (no disruptions allowed). Waiting...\n", b.pod, b.pdb)
+		}
+	}
+	h.OnPodDeletedOrEvicted = drainProgress(h.Out, len(pods))
 
-	if err := h.DeleteOrEvictPods(dd.Pods()); err != nil {
+	if err := h.DeleteOrEvictPods(pods); err != nil {
 		return err
 	}
 	fmt.Fprintf(h.Out, "Node %s drained!", path)
+	RecordAudit(n.Factory, "drain", n.GVR(), path, opts)
 
 	return nil
 }
 
+// drainProgress reports evictions as they complete. Pods are evicted
+// concurrently so the counter and the writer are guarded.
+func drainProgress(w io.Writer, total int) func(*v1.Pod, bool) {
+	var (
+		count int
+		mx    sync.Mutex
+	)
+
+	return func(po *v1.Pod, evicted bool) {
+		verb := "deleted"
+		if evicted {
+			verb = "evicted"
+		}
+		mx.Lock()
+		defer mx.Unlock()
+		count++
+		fmt.Fprintf(w, "[%d/%d] Pod %s %s\n", count, total, client.FQN(po.Namespace, po.Name), verb)
+	}
+}
+
+type blockedPod struct {
+	pod, pdb string
+}
+
+func blockedPods(ctx context.Context, dial kubernetes.Interface, pods []v1.Pod) []blockedPod {
+	pdbs := make(map[string][]policyv1.PodDisruptionBudget)
+	bb := make([]blockedPod, 0, len(pods))
+	for _, po := range pods {
+		ll, ok := pdbs[po.Namespace]
+		if !ok {
+			list, err := dial.PolicyV1().PodDisruptionBudgets(po.Namespace).List(ctx, metav1.ListOptions{})
+			if err != nil {
+				log.Warn().Err(err).Msgf("Unable to list disruption budgets in %q", po.Namespace)
+			} else {
+				ll = list.Items
+			}
+			pdbs[po.Namespace] = ll
+		}
+		if pdb, ok := blockingPDB(ll, po); ok {
+			bb = append(bb, blockedPod{pod: client.FQN(po.Namespace, po.Name), pdb: pdb})
+		}
+	}
+
+	return bb
+}
+
+func blockingPDB(pdbs []policyv1.PodDisruptionBudget, po v1.Pod) (string, bool) {
+	for _, pdb := range pdbs {
+		if pdb.Status.DisruptionsAllowed > 0 {
+			continue
+		}
+		sel, err := metav1.LabelSelectorAsSelector(pdb.Spec.Selector)
+		if err != nil || sel.Empty() {
+			continue
+		}
+		if sel.Matches(labels.Set(po.Labels)) {
+			return pdb.Name, true
+		}
+	}
+
+	return "", false
+}
+
 // Get returns a node resource.
 func (n *Node) Get(ctx context.Context, path string) (runtime.Object, error) {
 	oo, err := n.Resource.List(ctx, "")
@@ -145,6 +330,19 @@ func (n *Node) List(ctx context.Context, ns string) ([]runtime.Object, error) {
 		nmx, _ = client.DialMetrics(n.Client()).FetchNodesMetricsMap(ctx)
 	}
 
+	reqs, err := n.ExtendedRequests()
+	if err != nil {
+		log.Error().Err(err).Msgf("unable to get nodes extended resources requests")
+	}
+	var gpus map[string]float64
+	if g, ok := ctx.Value(internal.KeyGPUMetrics).(*GPUMetrics); ok && g != nil {
+		if gpus, err = g.Utilization(ctx); err != nil {
+			log.Debug().Err(err).Msgf("Nodes gpu utilization unavailable")
+		}
+	}
+
+	rates := pricingRates(ctx)
+
 	res := make([]runtime.Object, 0, len(oo))
 	for _, o := range oo {
 		u, ok := o.(*unstructured.Unstructured)
@@ -158,16 +356,41 @@ func (n *Node) List(ctx context.Context, ns string) ([]runtime.Object, error) {
 		if err != nil {
 			log.Error().Err(err).Msgf("unable to get pods count for %s", name)
 		}
-		res = append(res, &render.NodeWithMetrics{
+		no := render.NodeWithMetrics{
 			Raw:      u,
 			MX:       nmx[name],
 			PodCount: podCount,
-		})
+			Requests: reqs[name],
+			Rates:    rates,
+		}
+		if v, ok := gpus[name]; ok {
+			no.GPUUtil = &v
+		}
+		res = append(res, &no)
 	}
 
 	return res, nil
 }
 
+// Capacity returns a node allocatable vs requested vs actual resources usage.
+func (n *Node) Capacity(ctx context.Context, path string) (*render.NodeCapacity, error) {
+	no, err := FetchNode(ctx, n.Factory, path)
+	if err != nil {
+		return nil, err
+	}
+	pp, err := n.GetPods(no.Name)
+	if err != nil {
+		return nil, err
+	}
+	mx, err := client.DialMetrics(n.Client()).FetchNodeMetrics(ctx, no.Name)
+	if err != nil {
+		log.Debug().Err(err).Msgf("No metrics for node %s", no.Name)
+	}
+	c := render.NewNodeCapacity(no, pp, mx)
+
+	return &c, nil
+}
+
 // CountPods counts the pods scheduled on a given node.
 func (n *Node) CountPods(nodeName string) (int, error) {
 	var count int
@@ -193,6 +416,45 @@ func (n *Node) CountPods(nodeName string) (int, error) {
 	return count, nil
 }
 
+// ExtendedRequests returns the extended resources requested by active pods
+// keyed by node name.
+func (n *Node) ExtendedRequests() (map[string]v1.ResourceList, error) {
+	oo, err := n.GetFactory().List("v1/pods", client.AllNamespaces, false, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	reqs := make(map[string]v1.ResourceList)
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+		}
+		if !requestsExtended(u) {
+			continue
+		}
+		var po v1.Pod
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &po); err != nil {
+			return nil, err
+		}
+		if po.Spec.NodeName == "" || po.Status.Phase == v1.PodSucceeded || po.Status.Phase == v1.PodFailed {
+			continue
+		}
+		rl, ok := reqs[po.Spec.NodeName]
+		if !ok {
+			rl = make(v1.ResourceList)
+			reqs[po.Spec.NodeName] = rl
+		}
+		for r, q := range render.ExtendedRequests(po.Spec) {
+			acc := rl[r]
+			acc.Add(q)
+			rl[r] = acc
+		}
+	}
+
+	return reqs, nil
+}
+
 // GetPods returns all pods running on given node.
 func (n *Node) GetPods(nodeName string) ([]*v1.Pod, error) {
 	oo, err := n.GetFactory().List("v1/pods", client.AllNamespaces, false, labels.Everything())
@@ -267,3 +529,26 @@ func FetchNodes(ctx context.Context, f Factory, labelsSel string) (*v1.NodeList,
 
 	return &v1.NodeList{Items: nn}, nil
 }
+
+// requestsExtended checks if a raw pod containers request extended resources.
+func requestsExtended(u *unstructured.Unstructured) bool {
+	for _, f := range []string{"containers", "initContainers"} {
+		cc, _, _ := unstructured.NestedSlice(u.Object, "spec", f)
+		for _, c := range cc {
+			co, ok := c.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			for _, k := range []string{"requests", "limits"} {
+				rl, _, _ := unstructured.NestedMap(co, "resources", k)
+				for r := range rl {
+					if render.IsExtendedResource(v1.ResourceName(r)) {
+						return true
+					}
+				}
+			}
+		}
+	}
+
+	return false
+}
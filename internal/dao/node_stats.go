@@ -0,0 +1,47 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/derailed/k9s/internal/render"
+)
+
+// Stats returns a node kubelet stats summary along with its conditions.
+// Kubelet stats are optional as they require nodes/proxy access.
+func (n *Node) Stats(ctx context.Context, path string) (*render.NodeStats, error) {
+	no, err := FetchNode(ctx, n.Factory, path)
+	if err != nil {
+		return nil, err
+	}
+	s := render.NodeStats{
+		Node:       no.Name,
+		Conditions: no.Status.Conditions,
+	}
+	s.Summary, s.SummaryErr = n.kubeletSummary(ctx, no.Name)
+
+	return &s, nil
+}
+
+func (n *Node) kubeletSummary(ctx context.Context, name string) (*render.KubeletSummary, error) {
+	dial, err := n.Client().Dial()
+	if err != nil {
+		return nil, err
+	}
+	raw, err := dial.CoreV1().RESTClient().Get().
+		Resource("nodes").
+		Name(name).
+		SubResource("proxy").
+		Suffix("stats", "summary").
+		DoRaw(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var s render.KubeletSummary
+	if err := json.Unmarshal(raw, &s); err != nil {
+		return nil, err
+	}
+
+	return &s, nil
+}
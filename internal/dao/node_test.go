@@ -0,0 +1,227 @@
+package dao
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestBlockingPDB(t *testing.T) {
+	pdb := func(n string, allowed int32, sel map[string]string) policyv1.PodDisruptionBudget {
+		return policyv1.PodDisruptionBudget{
+			ObjectMeta: metav1.ObjectMeta{Name: n},
+			Spec:       policyv1.PodDisruptionBudgetSpec{Selector: &metav1.LabelSelector{MatchLabels: sel}},
+			Status:     policyv1.PodDisruptionBudgetStatus{DisruptionsAllowed: allowed},
+		}
+	}
+	po := v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "p1", Labels: map[string]string{"app": "fred"}}}
+
+	uu := map[string]struct {
+		pdbs []policyv1.PodDisruptionBudget
+		e    string
+		ok   bool
+	}{
+		"none": {},
+		"allowed": {
+			pdbs: []policyv1.PodDisruptionBudget{pdb("b1", 1, map[string]string{"app": "fred"})},
+		},
+		"noMatch": {
+			pdbs: []policyv1.PodDisruptionBudget{pdb("b1", 0, map[string]string{"app": "blee"})},
+		},
+		"blocked": {
+			pdbs: []policyv1.PodDisruptionBudget{
+				pdb("b1", 0, map[string]string{"app": "blee"}),
+				pdb("b2", 0, map[string]string{"app": "fred"}),
+			},
+			e:  "b2",
+			ok: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			n, ok := blockingPDB(u.pdbs, po)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.e, n)
+		})
+	}
+}
+
+func TestDrainProgress(t *testing.T) {
+	var buff bytes.Buffer
+	progress := drainProgress(&buff, 20)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			progress(&v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: fmt.Sprintf("p%d", i)}}, i%2 == 0)
+		}(i)
+	}
+	wg.Wait()
+
+	ll := strings.Split(strings.TrimSpace(buff.String()), "\n")
+	assert.Equal(t, 20, len(ll))
+	assert.True(t, strings.HasPrefix(ll[19], "[20/20] Pod ns1/p"))
+}
+
+func TestParseTaint(t *testing.T) {
+	uu := map[string]struct {
+		spec string
+		e    v1.Taint
+		err  bool
+	}{
+		"full": {
+			spec: "dedicated=gpu:NoSchedule",
+			e:    v1.Taint{Key: "dedicated", Value: "gpu", Effect: v1.TaintEffectNoSchedule},
+		},
+		"no-value": {
+			spec: "maintenance:NoExecute",
+			e:    v1.Taint{Key: "maintenance", Effect: v1.TaintEffectNoExecute},
+		},
+		"no-effect": {
+			spec: "dedicated=gpu",
+			err:  true,
+		},
+		"bad-effect": {
+			spec: "dedicated=gpu:Never",
+			err:  true,
+		},
+		"no-key": {
+			spec: "=gpu:NoSchedule",
+			err:  true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ta, err := ParseTaint(u.spec)
+			if u.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, ta)
+		})
+	}
+}
+
+func TestEditTaints(t *testing.T) {
+	added := metav1.NewTime(time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC))
+	t1 := v1.Taint{Key: "k1", Value: "v1", Effect: v1.TaintEffectNoExecute, TimeAdded: &added}
+	t2 := v1.Taint{Key: "k2", Value: "v2", Effect: v1.TaintEffectNoSchedule}
+	t3 := v1.Taint{Key: "k3", Effect: v1.TaintEffectNoSchedule}
+	strip := func(t v1.Taint) v1.Taint {
+		t.TimeAdded = nil
+		return t
+	}
+	with := func(t v1.Taint, val string) v1.Taint {
+		t.Value = val
+		return t
+	}
+
+	uu := map[string]struct {
+		live, original, edited, e []v1.Taint
+	}{
+		"noop": {
+			live:     []v1.Taint{t1, t2},
+			original: []v1.Taint{t1, t2},
+			edited:   []v1.Taint{strip(t1), t2},
+			e:        []v1.Taint{t1, t2},
+		},
+		"add": {
+			live:     []v1.Taint{t1},
+			original: []v1.Taint{t1},
+			edited:   []v1.Taint{strip(t1), t3},
+			e:        []v1.Taint{t1, t3},
+		},
+		"remove": {
+			live:     []v1.Taint{t1, t2},
+			original: []v1.Taint{t1, t2},
+			edited:   []v1.Taint{strip(t1)},
+			e:        []v1.Taint{t1},
+		},
+		"update": {
+			live:     []v1.Taint{t1},
+			original: []v1.Taint{t1},
+			edited:   []v1.Taint{with(strip(t1), "blee")},
+			e:        []v1.Taint{with(t1, "blee")},
+		},
+		"concurrent-add": {
+			live:     []v1.Taint{t1, t2},
+			original: []v1.Taint{t1},
+			edited:   []v1.Taint{strip(t1), t3},
+			e:        []v1.Taint{t1, t2, t3},
+		},
+		"concurrent-remove": {
+			live:     []v1.Taint{t2},
+			original: []v1.Taint{t1, t2},
+			edited:   []v1.Taint{strip(t1), t2},
+			e:        []v1.Taint{t2},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, EditTaints(u.live, u.original, u.edited))
+		})
+	}
+}
+
+func TestRequestsExtended(t *testing.T) {
+	pod := func(field string, res map[string]interface{}) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"spec": map[string]interface{}{
+				field: []interface{}{
+					map[string]interface{}{"name": "c1", "resources": res},
+				},
+			},
+		}}
+	}
+
+	uu := map[string]struct {
+		u *unstructured.Unstructured
+		e bool
+	}{
+		"none": {
+			u: &unstructured.Unstructured{Object: map[string]interface{}{}},
+		},
+		"native": {
+			u: pod("containers", map[string]interface{}{
+				"requests": map[string]interface{}{"cpu": "1", "memory": "1Gi"},
+			}),
+		},
+		"gpuLimits": {
+			u: pod("containers", map[string]interface{}{
+				"limits": map[string]interface{}{"nvidia.com/gpu": "1"},
+			}),
+			e: true,
+		},
+		"initHugepages": {
+			u: pod("initContainers", map[string]interface{}{
+				"requests": map[string]interface{}{"hugepages-2Mi": "64Mi"},
+			}),
+			e: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, requestsExtended(u.u))
+		})
+	}
+}
@@ -0,0 +1,359 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	v1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	npGVR = "networking.k8s.io/v1/networkpolicies"
+
+	// IngressDirection tracks traffic entering the destination pod.
+	IngressDirection = "ingress"
+	// EgressDirection tracks traffic leaving the source pod.
+	EgressDirection = "egress"
+	// OverallDirection tracks the final traffic verdict.
+	OverallDirection = "overall"
+)
+
+var _ Accessor = (*NetworkPolicySim)(nil)
+
+// Traffic represents a pod to pod connection to simulate.
+type Traffic struct {
+	Source, Destination string
+	Port                int32
+	Protocol            v1.Protocol
+}
+
+// ParseTraffic returns a traffic spec given source/destination pod paths and a port.
+func ParseTraffic(src, dst, port, proto string) (Traffic, error) {
+	if src == "" || dst == "" {
+		return Traffic{}, errors.New("source and destination pods must be specified")
+	}
+	p, err := strconv.Atoi(port)
+	if err != nil || p <= 0 || p > 65535 {
+		return Traffic{}, fmt.Errorf("invalid port %q", port)
+	}
+	if proto == "" {
+		proto = string(v1.ProtocolTCP)
+	}
+
+	return Traffic{
+		Source:      src,
+		Destination: dst,
+		Port:        int32(p),
+		Protocol:    v1.Protocol(strings.ToUpper(proto)),
+	}, nil
+}
+
+// PolicyPeer represents a pod and its namespace labels.
+type PolicyPeer struct {
+	Pod      *v1.Pod
+	NSLabels map[string]string
+}
+
+// NetworkPolicySim simulates network policies effect on pod to pod traffic.
+type NetworkPolicySim struct {
+	NonResource
+}
+
+// List returns the policies verdicts for a given traffic.
+func (n *NetworkPolicySim) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	t, ok := ctx.Value(internal.KeyTraffic).(Traffic)
+	if !ok {
+		return nil, errors.New("no context traffic found")
+	}
+	src, err := n.peer(t.Source)
+	if err != nil {
+		return nil, err
+	}
+	dst, err := n.peer(t.Destination)
+	if err != nil {
+		return nil, err
+	}
+	pols, err := n.policies(src.Pod.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	if dst.Pod.Namespace != src.Pod.Namespace {
+		dpols, err := n.policies(dst.Pod.Namespace)
+		if err != nil {
+			return nil, err
+		}
+		pols = append(pols, dpols...)
+	}
+
+	rr := SimulatePolicies(pols, src, dst, t.Port, t.Protocol)
+	oo := make([]runtime.Object, 0, len(rr))
+	for _, r := range rr {
+		oo = append(oo, r)
+	}
+
+	return oo, nil
+}
+
+func (n *NetworkPolicySim) peer(path string) (PolicyPeer, error) {
+	o, err := n.GetFactory().Get("v1/pods", path, true, labels.Everything())
+	if err != nil {
+		return PolicyPeer{}, err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return PolicyPeer{}, fmt.Errorf("expecting unstructured but got %T", o)
+	}
+	var po v1.Pod
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &po); err != nil {
+		return PolicyPeer{}, err
+	}
+	o, err = n.GetFactory().Get("v1/namespaces", client.FQN(client.ClusterScope, po.Namespace), true, labels.Everything())
+	if err != nil {
+		return PolicyPeer{}, err
+	}
+	ns, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return PolicyPeer{}, fmt.Errorf("expecting unstructured but got %T", o)
+	}
+
+	return PolicyPeer{Pod: &po, NSLabels: ns.GetLabels()}, nil
+}
+
+func (n *NetworkPolicySim) policies(ns string) ([]netv1.NetworkPolicy, error) {
+	oo, err := n.GetFactory().List(npGVR, ns, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	pp := make([]netv1.NetworkPolicy, 0, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting unstructured but got %T", o)
+		}
+		var np netv1.NetworkPolicy
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &np); err != nil {
+			return nil, err
+		}
+		pp = append(pp, np)
+	}
+
+	return pp, nil
+}
+
+// SimulatePolicies evaluates network policies for traffic flowing from a source to a destination pod.
+// Traffic is allowed when both the source egress and the destination ingress allow it.
+func SimulatePolicies(pols []netv1.NetworkPolicy, src, dst PolicyPeer, port int32, proto v1.Protocol) []render.PolicyVerdictRes {
+	sort.Slice(pols, func(i, j int) bool {
+		return pols[i].Namespace+pols[i].Name < pols[j].Namespace+pols[j].Name
+	})
+	egr, egrOK := evalDirection(EgressDirection, pols, src, dst, port, proto)
+	ing, ingOK := evalDirection(IngressDirection, pols, dst, src, port, proto)
+
+	rr := append(egr, ing...)
+	overall := render.PolicyVerdictRes{
+		Direction: OverallDirection,
+		Namespace: dst.Pod.Namespace,
+		Policy:    render.NAValue,
+		Allowed:   egrOK && ingOK,
+		Reason: fmt.Sprintf("%s/%s -> %s/%s:%d/%s",
+			src.Pod.Namespace, src.Pod.Name, dst.Pod.Namespace, dst.Pod.Name, port, proto),
+	}
+
+	return append(rr, overall)
+}
+
+// evalDirection evaluates all policies selecting the subject for a given direction.
+func evalDirection(dir string, pols []netv1.NetworkPolicy, subject, peer PolicyPeer, port int32, proto v1.Protocol) ([]render.PolicyVerdictRes, bool) {
+	var (
+		rr      []render.PolicyVerdictRes
+		allowed bool
+	)
+	// Named ports always resolve against the destination pod.
+	dst := subject
+	if dir == EgressDirection {
+		dst = peer
+	}
+	for _, np := range pols {
+		if np.Namespace != subject.Pod.Namespace || !hasPolicyType(np, dir) {
+			continue
+		}
+		sel, err := metav1.LabelSelectorAsSelector(&np.Spec.PodSelector)
+		if err != nil || !sel.Matches(labels.Set(subject.Pod.Labels)) {
+			continue
+		}
+		ok, reason := matchPolicyRules(dir, np, peer, dst.Pod, port, proto)
+		allowed = allowed || ok
+		rr = append(rr, render.PolicyVerdictRes{
+			Direction: dir,
+			Namespace: np.Namespace,
+			Policy:    np.Name,
+			Allowed:   ok,
+			Reason:    reason,
+		})
+	}
+	if len(rr) == 0 {
+		return []render.PolicyVerdictRes{{
+			Direction: dir,
+			Namespace: subject.Pod.Namespace,
+			Policy:    render.NAValue,
+			Allowed:   true,
+			Reason:    fmt.Sprintf("pod %s is not isolated for %s", subject.Pod.Name, dir),
+		}}, true
+	}
+
+	return rr, allowed
+}
+
+func matchPolicyRules(dir string, np netv1.NetworkPolicy, peer PolicyPeer, dst *v1.Pod, port int32, proto v1.Protocol) (bool, string) {
+	type rule struct {
+		peers []netv1.NetworkPolicyPeer
+		ports []netv1.NetworkPolicyPort
+	}
+	var rules []rule
+	if dir == IngressDirection {
+		for _, r := range np.Spec.Ingress {
+			rules = append(rules, rule{peers: r.From, ports: r.Ports})
+		}
+	} else {
+		for _, r := range np.Spec.Egress {
+			rules = append(rules, rule{peers: r.To, ports: r.Ports})
+		}
+	}
+	if len(rules) == 0 {
+		return false, "policy denies all " + dir
+	}
+
+	var peerMatched bool
+	for i, r := range rules {
+		if !matchPeers(r.peers, np.Namespace, peer) {
+			continue
+		}
+		peerMatched = true
+		if matchPorts(r.ports, dst, port, proto) {
+			return true, fmt.Sprintf("matched %s rule #%d", dir, i+1)
+		}
+	}
+	if peerMatched {
+		return false, fmt.Sprintf("port %d/%s not allowed", port, proto)
+	}
+
+	return false, "no rule matches peer " + peer.Pod.Namespace + "/" + peer.Pod.Name
+}
+
+func matchPeers(pp []netv1.NetworkPolicyPeer, policyNS string, peer PolicyPeer) bool {
+	if len(pp) == 0 {
+		return true
+	}
+	for _, p := range pp {
+		if matchPeer(p, policyNS, peer) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func matchPeer(p netv1.NetworkPolicyPeer, policyNS string, peer PolicyPeer) bool {
+	if p.IPBlock != nil {
+		return matchIPBlock(p.IPBlock, peer.Pod.Status.PodIP)
+	}
+	if p.NamespaceSelector == nil {
+		if peer.Pod.Namespace != policyNS {
+			return false
+		}
+	} else if !matchSelector(p.NamespaceSelector, peer.NSLabels) {
+		return false
+	}
+	if p.PodSelector == nil {
+		return true
+	}
+
+	return matchSelector(p.PodSelector, peer.Pod.Labels)
+}
+
+func matchSelector(s *metav1.LabelSelector, ll map[string]string) bool {
+	sel, err := metav1.LabelSelectorAsSelector(s)
+	if err != nil {
+		return false
+	}
+
+	return sel.Matches(labels.Set(ll))
+}
+
+func matchIPBlock(b *netv1.IPBlock, ip string) bool {
+	addr := net.ParseIP(ip)
+	if addr == nil {
+		return false
+	}
+	_, cidr, err := net.ParseCIDR(b.CIDR)
+	if err != nil || !cidr.Contains(addr) {
+		return false
+	}
+	for _, e := range b.Except {
+		if _, ex, err := net.ParseCIDR(e); err == nil && ex.Contains(addr) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func matchPorts(pp []netv1.NetworkPolicyPort, dst *v1.Pod, port int32, proto v1.Protocol) bool {
+	if len(pp) == 0 {
+		return true
+	}
+	for _, p := range pp {
+		pproto := v1.ProtocolTCP
+		if p.Protocol != nil {
+			pproto = *p.Protocol
+		}
+		if pproto != proto {
+			continue
+		}
+		if p.Port == nil {
+			return true
+		}
+		num, ok := ContainerPortFor(dst, *p.Port)
+		if !ok {
+			continue
+		}
+		end := num
+		if p.EndPort != nil {
+			end = *p.EndPort
+		}
+		if port >= num && port <= end {
+			return true
+		}
+	}
+
+	return false
+}
+
+func hasPolicyType(np netv1.NetworkPolicy, dir string) bool {
+	if len(np.Spec.PolicyTypes) == 0 {
+		if dir == IngressDirection {
+			return true
+		}
+		return len(np.Spec.Egress) > 0
+	}
+	for _, t := range np.Spec.PolicyTypes {
+		if strings.EqualFold(string(t), dir) {
+			return true
+		}
+	}
+
+	return false
+}
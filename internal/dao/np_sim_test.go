@@ -0,0 +1,123 @@
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func TestParseTraffic(t *testing.T) {
+	tr, err := dao.ParseTraffic("ns1/p1", "ns2/p2", "8080", "udp")
+	assert.Nil(t, err)
+	assert.Equal(t, dao.Traffic{Source: "ns1/p1", Destination: "ns2/p2", Port: 8080, Protocol: v1.ProtocolUDP}, tr)
+
+	_, err = dao.ParseTraffic("ns1/p1", "ns2/p2", "blee", "")
+	assert.Error(t, err)
+	_, err = dao.ParseTraffic("", "ns2/p2", "80", "")
+	assert.Error(t, err)
+}
+
+func TestSimulatePolicies(t *testing.T) {
+	src := dao.PolicyPeer{
+		Pod: &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "fe", Name: "web", Labels: map[string]string{"app": "web"}},
+			Status:     v1.PodStatus{PodIP: "10.0.1.5"},
+		},
+		NSLabels: map[string]string{"team": "fe"},
+	}
+	dst := dao.PolicyPeer{
+		Pod: &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "be", Name: "api", Labels: map[string]string{"app": "api"}},
+			Spec: v1.PodSpec{
+				Containers: []v1.Container{{Name: "c1", Ports: []v1.ContainerPort{{Name: "http", ContainerPort: 8080}}}},
+			},
+		},
+		NSLabels: map[string]string{"team": "be"},
+	}
+	http := intstr.FromString("http")
+	allowFE := netv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "be", Name: "allow-fe"},
+		Spec: netv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "api"}},
+			Ingress: []netv1.NetworkPolicyIngressRule{
+				{
+					From: []netv1.NetworkPolicyPeer{
+						{NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "fe"}}},
+					},
+					Ports: []netv1.NetworkPolicyPort{{Port: &http}},
+				},
+			},
+		},
+	}
+	denyAll := netv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "be", Name: "deny-all"},
+		Spec: netv1.NetworkPolicySpec{
+			PolicyTypes: []netv1.PolicyType{netv1.PolicyTypeIngress},
+		},
+	}
+	egrBlock := netv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "fe", Name: "egress-dns"},
+		Spec: netv1.NetworkPolicySpec{
+			PolicyTypes: []netv1.PolicyType{netv1.PolicyTypeEgress},
+			Egress: []netv1.NetworkPolicyEgressRule{
+				{To: []netv1.NetworkPolicyPeer{{IPBlock: &netv1.IPBlock{CIDR: "10.96.0.0/12"}}}},
+			},
+		},
+	}
+
+	uu := map[string]struct {
+		pols []netv1.NetworkPolicy
+		port int32
+		e    []render.PolicyVerdictRes
+	}{
+		"open": {
+			port: 8080,
+			e: []render.PolicyVerdictRes{
+				{Direction: "egress", Namespace: "fe", Policy: "n/a", Allowed: true, Reason: "pod web is not isolated for egress"},
+				{Direction: "ingress", Namespace: "be", Policy: "n/a", Allowed: true, Reason: "pod api is not isolated for ingress"},
+				{Direction: "overall", Namespace: "be", Policy: "n/a", Allowed: true, Reason: "fe/web -> be/api:8080/TCP"},
+			},
+		},
+		"allowed": {
+			pols: []netv1.NetworkPolicy{denyAll, allowFE},
+			port: 8080,
+			e: []render.PolicyVerdictRes{
+				{Direction: "egress", Namespace: "fe", Policy: "n/a", Allowed: true, Reason: "pod web is not isolated for egress"},
+				{Direction: "ingress", Namespace: "be", Policy: "allow-fe", Allowed: true, Reason: "matched ingress rule #1"},
+				{Direction: "ingress", Namespace: "be", Policy: "deny-all", Allowed: false, Reason: "policy denies all ingress"},
+				{Direction: "overall", Namespace: "be", Policy: "n/a", Allowed: true, Reason: "fe/web -> be/api:8080/TCP"},
+			},
+		},
+		"badPort": {
+			pols: []netv1.NetworkPolicy{allowFE},
+			port: 9090,
+			e: []render.PolicyVerdictRes{
+				{Direction: "egress", Namespace: "fe", Policy: "n/a", Allowed: true, Reason: "pod web is not isolated for egress"},
+				{Direction: "ingress", Namespace: "be", Policy: "allow-fe", Allowed: false, Reason: "port 9090/TCP not allowed"},
+				{Direction: "overall", Namespace: "be", Policy: "n/a", Allowed: false, Reason: "fe/web -> be/api:9090/TCP"},
+			},
+		},
+		"egressDenied": {
+			pols: []netv1.NetworkPolicy{egrBlock, allowFE},
+			port: 8080,
+			e: []render.PolicyVerdictRes{
+				{Direction: "egress", Namespace: "fe", Policy: "egress-dns", Allowed: false, Reason: "no rule matches peer be/api"},
+				{Direction: "ingress", Namespace: "be", Policy: "allow-fe", Allowed: true, Reason: "matched ingress rule #1"},
+				{Direction: "overall", Namespace: "be", Policy: "n/a", Allowed: false, Reason: "fe/web -> be/api:8080/TCP"},
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, dao.SimulatePolicies(u.pols, src, dst, u.port, v1.ProtocolTCP))
+		})
+	}
+}
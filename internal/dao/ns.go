@@ -2,7 +2,13 @@ package dao
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -15,12 +21,99 @@ type Namespace struct {
 	Generic
 }
 
-// List returns a collection of nodes.
+// List returns a collection of namespaces.
 func (n *Namespace) List(ctx context.Context, ns string) ([]runtime.Object, error) {
 	oo, err := n.Generic.List(ctx, ns)
 	if err != nil {
 		return nil, err
 	}
+	rates := pricingRates(ctx)
+	if rates == nil {
+		return oo, nil
+	}
+
+	costs, err := n.Costs(rates)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]runtime.Object, 0, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+		}
+		nwc := render.NamespaceWithCost{Raw: u}
+		if c, ok := costs[u.GetName()]; ok {
+			nwc.Cost = &c
+		}
+		res = append(res, &nwc)
+	}
+
+	return res, nil
+}
+
+// Costs returns the active pods estimated hourly cost keyed by namespace.
+func (n *Namespace) Costs(rates *render.Rates) (map[string]float64, error) {
+	oo, err := n.GetFactory().List("v1/pods", client.AllNamespaces, false, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	costs := make(map[string]float64)
+	for _, o := range oo {
+		var po v1.Pod
+		if err := fromUnstructured(o, &po); err != nil {
+			return nil, err
+		}
+		if po.Status.Phase == v1.PodSucceeded || po.Status.Phase == v1.PodFailed {
+			continue
+		}
+		if c, ok := rates.PodCost(po.Spec); ok {
+			costs[po.Namespace] += c
+		}
+	}
+
+	return costs, nil
+}
+
+// Quota returns a namespace resource quotas consumption and limit ranges.
+func (n *Namespace) Quota(ctx context.Context, path string) (*render.NamespaceQuota, error) {
+	_, ns := client.Namespaced(path)
+	oo, err := n.GetFactory().List("v1/resourcequotas", ns, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	qq := make([]v1.ResourceQuota, 0, len(oo))
+	for _, o := range oo {
+		var q v1.ResourceQuota
+		if err := fromUnstructured(o, &q); err != nil {
+			return nil, err
+		}
+		qq = append(qq, q)
+	}
+
+	oo, err = n.GetFactory().List("v1/limitranges", ns, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	ll := make([]v1.LimitRange, 0, len(oo))
+	for _, o := range oo {
+		var lr v1.LimitRange
+		if err := fromUnstructured(o, &lr); err != nil {
+			return nil, err
+		}
+		ll = append(ll, lr)
+	}
+	q := render.NewNamespaceQuota(ns, qq, ll)
+
+	return &q, nil
+}
+
+func fromUnstructured(o runtime.Object, obj interface{}) error {
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("expecting unstructured but got %T", o)
+	}
 
-	return oo, nil
+	return runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, obj)
 }
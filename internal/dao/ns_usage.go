@@ -0,0 +1,62 @@
+package dao
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ Accessor = (*NamespaceUsage)(nil)
+
+// NamespaceUsage represents namespaces aggregated resources usage.
+type NamespaceUsage struct {
+	NonResource
+}
+
+// List returns the pods resources usage aggregated per namespace.
+func (n *NamespaceUsage) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	oo, err := n.GetFactory().List("v1/pods", client.AllNamespaces, false, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	pods := make([]*v1.Pod, 0, len(oo))
+	for _, o := range oo {
+		var po v1.Pod
+		if err := fromUnstructured(o, &po); err != nil {
+			return nil, err
+		}
+		pods = append(pods, &po)
+	}
+
+	var nss []string
+	if oo, err := n.GetFactory().List("v1/namespaces", client.ClusterScope, false, labels.Everything()); err != nil {
+		log.Warn().Err(err).Msgf("Unable to list namespaces")
+	} else {
+		nss = make([]string, 0, len(oo))
+		for _, o := range oo {
+			if u, ok := o.(*unstructured.Unstructured); ok {
+				nss = append(nss, u.GetName())
+			}
+		}
+	}
+
+	var pmx client.PodsMetricsMap
+	if withMx, ok := ctx.Value(internal.KeyWithMetrics).(bool); withMx || !ok {
+		pmx, _ = client.DialMetrics(n.Client()).FetchPodsMetricsMap(ctx, client.AllNamespaces)
+	}
+
+	uu := render.NamespaceUsages(nss, pods, pmx, pricingRates(ctx))
+	res := make([]runtime.Object, 0, len(uu))
+	for _, u := range uu {
+		res = append(res, u)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,171 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// ownedGVRs tracks the resources a given resource may control.
+var ownedGVRs = map[string][]string{
+	"apps/v1/deployments":  {"apps/v1/replicasets"},
+	"apps/v1/replicasets":  {"v1/pods"},
+	"apps/v1/statefulsets": {"v1/pods"},
+	"apps/v1/daemonsets":   {"v1/pods"},
+	"batch/v1/jobs":        {"v1/pods"},
+	"batch/v1/cronjobs":    {"batch/v1/jobs"},
+}
+
+var _ Accessor = (*Owner)(nil)
+
+// Owner represents a resource ownership trail.
+type Owner struct {
+	NonResource
+}
+
+// List returns a resource owners, the resource itself and its children.
+func (o *Owner) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	gvr, ok := ctx.Value(internal.KeyGVR).(string)
+	if !ok {
+		return nil, errors.New("no context GVR found")
+	}
+	path, ok := ctx.Value(internal.KeyPath).(string)
+	if !ok {
+		return nil, errors.New("no context path found")
+	}
+
+	owners, err := Owners(o.Factory, client.NewGVR(gvr), path)
+	if err != nil {
+		return nil, err
+	}
+	children, err := OwnedBy(o.Factory, client.NewGVR(gvr), path)
+	if err != nil {
+		return nil, err
+	}
+
+	oo := make([]runtime.Object, 0, len(owners)+len(children))
+	depth := len(owners) - 1
+	for i, ref := range owners {
+		rel := render.OwnerRelation
+		if i == 0 {
+			rel = render.SelfRelation
+		}
+		oo = append(oo, toOwnerRes(ref, rel, depth-i))
+	}
+	for _, ref := range children {
+		oo = append(oo, toOwnerRes(ref, render.ChildRelation, depth+1))
+	}
+
+	return oo, nil
+}
+
+// Owners returns a resource followed by its controlling owners.
+func Owners(f Factory, gvr client.GVR, path string) (Refs, error) {
+	ns, _ := client.Namespaced(path)
+	refs := make(Refs, 0, maxOwnerDepth)
+	for i := 0; i < maxOwnerDepth; i++ {
+		o, err := f.Get(gvr.String(), path, true, labels.Everything())
+		if err != nil {
+			if i == 0 {
+				return nil, err
+			}
+			break
+		}
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting unstructured but got %T", o)
+		}
+		refs = append(refs, Ref{GVR: gvr.String(), FQN: path, UID: string(u.GetUID())})
+		ref := controllerRef(u.GetOwnerReferences())
+		if ref == nil {
+			break
+		}
+		if gvr, ok = MetaAccess.GVRForKind(ref.APIVersion, ref.Kind); !ok {
+			break
+		}
+		path = ref.Name
+		if m, err := MetaAccess.MetaFor(gvr); err != nil || m.Namespaced {
+			path = client.FQN(ns, ref.Name)
+		}
+	}
+
+	return refs, nil
+}
+
+// OwnedBy returns the resources controlled by a given resource.
+func OwnedBy(f Factory, gvr client.GVR, path string) (Refs, error) {
+	kinds, ok := ownedGVRs[gvr.String()]
+	if !ok {
+		return nil, nil
+	}
+	o, err := f.Get(gvr.String(), path, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expecting unstructured but got %T", o)
+	}
+
+	ns, _ := client.Namespaced(path)
+	var refs Refs
+	for _, kind := range kinds {
+		oo, err := f.List(kind, ns, true, labels.Everything())
+		if err != nil {
+			return nil, err
+		}
+		for _, o := range oo {
+			c, ok := o.(*unstructured.Unstructured)
+			if !ok {
+				continue
+			}
+			if ref := controllerRef(c.GetOwnerReferences()); ref == nil || ref.UID != u.GetUID() {
+				continue
+			}
+			refs = append(refs, Ref{
+				GVR: kind,
+				FQN: client.FQN(c.GetNamespace(), c.GetName()),
+				UID: string(c.GetUID()),
+			})
+		}
+	}
+	sort.Slice(refs, func(i, j int) bool {
+		return refs[i].FQN < refs[j].FQN
+	})
+
+	return refs, nil
+}
+
+// OwnerTrail returns a breadcrumb trail from the top most owner down to the resource.
+func OwnerTrail(refs Refs) []string {
+	trail := make([]string, 0, len(refs))
+	for i := len(refs) - 1; i >= 0; i-- {
+		_, n := client.Namespaced(refs[i].FQN)
+		trail = append(trail, client.NewGVR(refs[i].GVR).R()+"/"+n)
+	}
+
+	return trail
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func toOwnerRes(ref Ref, rel string, depth int) render.OwnerRes {
+	ns, n := client.Namespaced(ref.FQN)
+
+	return render.OwnerRes{
+		Relation:  rel,
+		Depth:     depth,
+		Namespace: ns,
+		Name:      n,
+		GVR:       ref.GVR,
+	}
+}
@@ -0,0 +1,41 @@
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOwnerTrail(t *testing.T) {
+	uu := map[string]struct {
+		refs dao.Refs
+		e    []string
+	}{
+		"empty": {
+			e: []string{},
+		},
+		"chain": {
+			refs: dao.Refs{
+				{GVR: "v1/pods", FQN: "ns1/fred-abc-123"},
+				{GVR: "apps/v1/replicasets", FQN: "ns1/fred-abc"},
+				{GVR: "apps/v1/deployments", FQN: "ns1/fred"},
+			},
+			e: []string{"deployments/fred", "replicasets/fred-abc", "pods/fred-abc-123"},
+		},
+		"cluster": {
+			refs: dao.Refs{
+				{GVR: "v1/pods", FQN: "kube-system/etcd-n1"},
+				{GVR: "v1/nodes", FQN: "n1"},
+			},
+			e: []string{"nodes/n1", "pods/etcd-n1"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, dao.OwnerTrail(u.refs))
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Page tracks a chunked resource listing. Listers honoring the page resume
+// from the continue token if any and set the next continue token and
+// remaining count once the chunk is listed.
+type Page struct {
+	// Limit caps the number of listed resources.
+	Limit int64
+
+	// Continue resumes a listing and is set when more resources remain on the api server.
+	Continue string
+
+	// Remaining estimates the number of resources left to list or -1 if unknown.
+	Remaining int64
+}
+
+// NewPage returns a new page given a limit.
+func NewPage(limit int64) *Page {
+	return &Page{Limit: limit, Remaining: -1}
+}
+
+// More checks if more resources remain to be listed.
+func (p *Page) More() bool {
+	return p.Continue != ""
+}
+
+// listPage lists at most a page worth of resources directly from the api server.
+func (r *Resource) listPage(ctx context.Context, ns string, p *Page) ([]runtime.Object, error) {
+	if client.IsAllNamespace(ns) {
+		ns = client.AllNamespaces
+	}
+	auth, err := r.Client().CanI(ns, r.gvr.String(), client.ListAccess)
+	if err != nil {
+		return nil, err
+	}
+	if !auth {
+		return nil, fmt.Errorf("user is not authorized to list %s", r.gvr)
+	}
+	dial, err := r.dynClient()
+	if err != nil {
+		return nil, err
+	}
+
+	opts := metav1.ListOptions{Limit: p.Limit, Continue: p.Continue}
+	opts.LabelSelector, _ = ctx.Value(internal.KeyLabels).(string)
+	opts.FieldSelector, _ = ctx.Value(internal.KeyFields).(string)
+	var ll *unstructured.UnstructuredList
+	if client.IsClusterScoped(ns) {
+		ll, err = dial.List(ctx, opts)
+	} else {
+		ll, err = dial.Namespace(ns).List(ctx, opts)
+	}
+	if err != nil {
+		return nil, err
+	}
+	p.Continue, p.Remaining = ll.GetContinue(), -1
+	if c := ll.GetRemainingItemCount(); c != nil {
+		p.Remaining = *c
+	}
+
+	oo := make([]runtime.Object, len(ll.Items))
+	for i := range ll.Items {
+		oo[i] = &ll.Items[i]
+	}
+
+	return oo, nil
+}
@@ -2,6 +2,11 @@ package dao
 
 import (
 	"encoding/json"
+	"fmt"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 )
 
 // ImageSpec represents a container image.
@@ -41,7 +46,7 @@ type ImagesSpec struct {
 type Element struct {
 	Image     string `json:"image,omitempty"`
 	Name      string `json:"name"`
-	NameSpace string `json:"namespace"`
+	NameSpace string `json:"namespace,omitempty"`
 }
 
 // GetTemplateJsonPatch builds a json patch string to update PodSpec images.
@@ -85,3 +90,321 @@ func extractElements(imageSpecs ImageSpecs) (initElementsOrders []Element, initE
 	}
 	return initElementsOrders, initElements, elementsOrders, elements
 }
+
+// ResourceSpec represents a container resources update.
+// An empty quantity indicates the given resource should be removed.
+type ResourceSpec struct {
+	Name     string
+	Init     bool
+	Requests map[v1.ResourceName]string
+	Limits   map[v1.ResourceName]string
+}
+
+// ResourceSpecs represents a collection of container resources updates.
+type ResourceSpecs []ResourceSpec
+
+// ResourcesJsonPatch tracks pod template resources updates.
+type ResourcesJsonPatch struct {
+	Spec ResourcesTemplateSpec `json:"spec"`
+}
+
+// ResourcesTemplateSpec represents a pod template resources update.
+type ResourcesTemplateSpec struct {
+	Template ResourcesPodSpec `json:"template"`
+}
+
+// ResourcesPodSpec represents a pod spec resources update.
+type ResourcesPodSpec struct {
+	Spec ResourcesSpec `json:"spec"`
+}
+
+// ResourcesSpec tracks container resources updates.
+type ResourcesSpec struct {
+	SetElementOrderContainers     []Element          `json:"$setElementOrder/containers,omitempty"`
+	SetElementOrderInitContainers []Element          `json:"$setElementOrder/initContainers,omitempty"`
+	Containers                    []ResourcesElement `json:"containers,omitempty"`
+	InitContainers                []ResourcesElement `json:"initContainers,omitempty"`
+}
+
+// ResourcesElement tracks a given container resources.
+type ResourcesElement struct {
+	Name      string       `json:"name"`
+	Resources ResourceList `json:"resources"`
+}
+
+// ResourceList tracks container requests and limits. A nil quantity
+// clears the resource.
+type ResourceList struct {
+	Requests map[v1.ResourceName]*string `json:"requests,omitempty"`
+	Limits   map[v1.ResourceName]*string `json:"limits,omitempty"`
+}
+
+// GetTemplateResourcesPatch builds a json patch string to update PodSpec resources.
+func GetTemplateResourcesPatch(specs ResourceSpecs) ([]byte, error) {
+	if err := specs.Validate(); err != nil {
+		return nil, err
+	}
+	return json.Marshal(ResourcesJsonPatch{
+		Spec: ResourcesTemplateSpec{
+			Template: getResourcesPodSpec(specs),
+		},
+	})
+}
+
+// Validate checks all quantities are valid and cpu and memory requests do
+// not exceed their limits.
+func (ss ResourceSpecs) Validate() error {
+	for _, s := range ss {
+		for _, rl := range []map[v1.ResourceName]string{s.Requests, s.Limits} {
+			for n, q := range rl {
+				if q == "" {
+					continue
+				}
+				if _, err := resource.ParseQuantity(q); err != nil {
+					return fmt.Errorf("invalid %s quantity %q for container %s", n, q, s.Name)
+				}
+			}
+		}
+		for _, n := range []v1.ResourceName{v1.ResourceCPU, v1.ResourceMemory} {
+			req, lim := s.Requests[n], s.Limits[n]
+			if req == "" || lim == "" {
+				continue
+			}
+			rq, lq := resource.MustParse(req), resource.MustParse(lim)
+			if rq.Cmp(lq) > 0 {
+				return fmt.Errorf("%s request %s exceeds limit %s for container %s", n, req, lim, s.Name)
+			}
+		}
+	}
+
+	return nil
+}
+
+func getResourcesPodSpec(specs ResourceSpecs) ResourcesPodSpec {
+	var spec ResourcesSpec
+	for _, s := range specs {
+		e := ResourcesElement{
+			Name: s.Name,
+			Resources: ResourceList{
+				Requests: toQuantityRefs(s.Requests),
+				Limits:   toQuantityRefs(s.Limits),
+			},
+		}
+		if s.Init {
+			spec.SetElementOrderInitContainers = append(spec.SetElementOrderInitContainers, Element{Name: s.Name})
+			spec.InitContainers = append(spec.InitContainers, e)
+		} else {
+			spec.SetElementOrderContainers = append(spec.SetElementOrderContainers, Element{Name: s.Name})
+			spec.Containers = append(spec.Containers, e)
+		}
+	}
+
+	return ResourcesPodSpec{Spec: spec}
+}
+
+func toQuantityRefs(rl map[v1.ResourceName]string) map[v1.ResourceName]*string {
+	if len(rl) == 0 {
+		return nil
+	}
+	mm := make(map[v1.ResourceName]*string, len(rl))
+	for n, q := range rl {
+		if q == "" {
+			mm[n] = nil
+			continue
+		}
+		q := q
+		mm[n] = &q
+	}
+
+	return mm
+}
+
+// EnvSource represents where an env var value comes from.
+type EnvSource string
+
+const (
+	// EnvValue a literal value.
+	EnvValue EnvSource = "value"
+
+	// EnvSecret a secret key reference, ie name/key.
+	EnvSecret EnvSource = "secret"
+
+	// EnvConfigMap a configmap key reference, ie name/key.
+	EnvConfigMap EnvSource = "configmap"
+
+	// EnvField a pod field reference.
+	EnvField EnvSource = "field"
+
+	// EnvResource a container resource reference.
+	EnvResource EnvSource = "resource"
+)
+
+// EditableEnvSources lists the env var sources that can be set.
+var EditableEnvSources = []EnvSource{EnvValue, EnvSecret, EnvConfigMap}
+
+// Editable returns true if vars from this source can be set.
+func (s EnvSource) Editable() bool {
+	for _, e := range EditableEnvSources {
+		if e == s {
+			return true
+		}
+	}
+
+	return false
+}
+
+// EnvSpec represents a container environment update.
+type EnvSpec struct {
+	Container string
+	Init      bool
+	Set       []v1.EnvVar
+	Delete    []string
+}
+
+// IsEmpty checks if the spec carries any updates.
+func (e EnvSpec) IsEmpty() bool {
+	return len(e.Set) == 0 && len(e.Delete) == 0
+}
+
+// GetTemplateEnvPatch builds a json patch string to update PodSpec env vars.
+func GetTemplateEnvPatch(spec EnvSpec) ([]byte, error) {
+	return json.Marshal(map[string]interface{}{
+		"spec": map[string]interface{}{
+			"template": getEnvPodSpec(spec),
+		},
+	})
+}
+
+func getEnvPodSpec(spec EnvSpec) map[string]interface{} {
+	env := make([]map[string]interface{}, 0, len(spec.Set)+len(spec.Delete))
+	for _, e := range spec.Set {
+		item := map[string]interface{}{
+			"name":      e.Name,
+			"value":     nil,
+			"valueFrom": nil,
+		}
+		if e.ValueFrom != nil {
+			item["valueFrom"] = envSourcePatch(e.ValueFrom)
+		} else {
+			item["value"] = e.Value
+		}
+		env = append(env, item)
+	}
+	for _, n := range spec.Delete {
+		env = append(env, map[string]interface{}{
+			"name":   n,
+			"$patch": "delete",
+		})
+	}
+	key := "containers"
+	if spec.Init {
+		key = "initContainers"
+	}
+
+	return map[string]interface{}{
+		"spec": map[string]interface{}{
+			"$setElementOrder/" + key: []Element{{Name: spec.Container}},
+			key: []map[string]interface{}{
+				{
+					"name": spec.Container,
+					"env":  env,
+				},
+			},
+		},
+	}
+}
+
+// envSourcePatch clears the refs not set on a value source since a strategic
+// merge would otherwise keep a previous ref alongside the new one.
+func envSourcePatch(src *v1.EnvVarSource) map[string]interface{} {
+	m := map[string]interface{}{
+		"secretKeyRef":     nil,
+		"configMapKeyRef":  nil,
+		"fieldRef":         nil,
+		"resourceFieldRef": nil,
+	}
+	if src.SecretKeyRef != nil {
+		m["secretKeyRef"] = src.SecretKeyRef
+	}
+	if src.ConfigMapKeyRef != nil {
+		m["configMapKeyRef"] = src.ConfigMapKeyRef
+	}
+	if src.FieldRef != nil {
+		m["fieldRef"] = src.FieldRef
+	}
+	if src.ResourceFieldRef != nil {
+		m["resourceFieldRef"] = src.ResourceFieldRef
+	}
+
+	return m
+}
+
+// EnvVarToString returns an env var source and a representation of its value.
+// Refs to secrets and configmaps use name/key.
+func EnvVarToString(e v1.EnvVar) (EnvSource, string) {
+	if e.ValueFrom == nil {
+		return EnvValue, e.Value
+	}
+	switch {
+	case e.ValueFrom.SecretKeyRef != nil:
+		ref := e.ValueFrom.SecretKeyRef
+		return EnvSecret, ref.Name + "/" + ref.Key
+	case e.ValueFrom.ConfigMapKeyRef != nil:
+		ref := e.ValueFrom.ConfigMapKeyRef
+		return EnvConfigMap, ref.Name + "/" + ref.Key
+	case e.ValueFrom.FieldRef != nil:
+		return EnvField, e.ValueFrom.FieldRef.FieldPath
+	case e.ValueFrom.ResourceFieldRef != nil:
+		ref := e.ValueFrom.ResourceFieldRef
+		if ref.ContainerName == "" {
+			return EnvResource, ref.Resource
+		}
+		return EnvResource, ref.ContainerName + "/" + ref.Resource
+	default:
+		return "", ""
+	}
+}
+
+// EnvVarFromString converts a source and its value back into an env var.
+func EnvVarFromString(name string, src EnvSource, s string) (v1.EnvVar, error) {
+	e := v1.EnvVar{Name: name}
+	switch src {
+	case EnvValue:
+		e.Value = s
+	case EnvSecret:
+		n, k, err := splitEnvRef(s)
+		if err != nil {
+			return e, err
+		}
+		e.ValueFrom = &v1.EnvVarSource{
+			SecretKeyRef: &v1.SecretKeySelector{
+				LocalObjectReference: v1.LocalObjectReference{Name: n},
+				Key:                  k,
+			},
+		}
+	case EnvConfigMap:
+		n, k, err := splitEnvRef(s)
+		if err != nil {
+			return e, err
+		}
+		e.ValueFrom = &v1.EnvVarSource{
+			ConfigMapKeyRef: &v1.ConfigMapKeySelector{
+				LocalObjectReference: v1.LocalObjectReference{Name: n},
+				Key:                  k,
+			},
+		}
+	default:
+		return e, fmt.Errorf("env var %q from %q can not be set", name, src)
+	}
+
+	return e, nil
+}
+
+func splitEnvRef(s string) (string, string, error) {
+	tokens := strings.Split(s, "/")
+	if len(tokens) != 2 || tokens[0] == "" || tokens[1] == "" {
+		return "", "", fmt.Errorf("invalid reference %q. Expecting name/key", s)
+	}
+
+	return tokens[0], tokens[1], nil
+}
@@ -3,7 +3,11 @@ package dao
 import (
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
 )
 
 func TestGetTemplateJsonPatch(t *testing.T) {
@@ -91,3 +95,205 @@ func TestGetJsonPatch(t *testing.T) {
 		})
 	}
 }
+
+func TestGetTemplateResourcesPatch(t *testing.T) {
+	uu := map[string]struct {
+		specs ResourceSpecs
+		want  string
+		err   bool
+	}{
+		"set": {
+			specs: ResourceSpecs{
+				{
+					Name:     "nginx",
+					Requests: map[v1.ResourceName]string{v1.ResourceCPU: "100m"},
+					Limits:   map[v1.ResourceName]string{v1.ResourceMemory: "1Gi"},
+				},
+			},
+			want: `{"spec":{"template":{"spec":{"$setElementOrder/containers":[{"name":"nginx"}],"containers":[{"name":"nginx","resources":{"requests":{"cpu":"100m"},"limits":{"memory":"1Gi"}}}]}}}}`,
+		},
+		"clear": {
+			specs: ResourceSpecs{
+				{
+					Name:     "init",
+					Init:     true,
+					Requests: map[v1.ResourceName]string{v1.ResourceCPU: ""},
+				},
+			},
+			want: `{"spec":{"template":{"spec":{"$setElementOrder/initContainers":[{"name":"init"}],"initContainers":[{"name":"init","resources":{"requests":{"cpu":null}}}]}}}}`,
+		},
+		"toast": {
+			specs: ResourceSpecs{
+				{
+					Name:   "nginx",
+					Limits: map[v1.ResourceName]string{v1.ResourceCPU: "blee"},
+				},
+			},
+			err: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			got, err := GetTemplateResourcesPatch(u.specs)
+			if u.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.JSONEq(t, u.want, string(got))
+		})
+	}
+}
+
+func TestGetTemplateEnvPatch(t *testing.T) {
+	e, err := EnvVarFromString("PWD", EnvSecret, "creds/password")
+	require.NoError(t, err)
+	spec := EnvSpec{
+		Container: "nginx",
+		Set: []v1.EnvVar{
+			{Name: "FRED", Value: "blee"},
+			e,
+		},
+		Delete: []string{"ZORG"},
+	}
+	got, err := GetTemplateEnvPatch(spec)
+
+	require.NoError(t, err)
+	require.JSONEq(t, `{"spec":{"template":{"spec":{"$setElementOrder/containers":[{"name":"nginx"}],"containers":[{"name":"nginx","env":[{"name":"FRED","value":"blee","valueFrom":null},{"name":"PWD","value":null,"valueFrom":{"secretKeyRef":{"name":"creds","key":"password"},"configMapKeyRef":null,"fieldRef":null,"resourceFieldRef":null}},{"name":"ZORG","$patch":"delete"}]}]}}}}`, string(got))
+}
+
+func TestGetTemplateEnvPatchSwitchSource(t *testing.T) {
+	e, err := EnvVarFromString("PWD", EnvConfigMap, "creds/password")
+	require.NoError(t, err)
+	got, err := GetTemplateEnvPatch(EnvSpec{Container: "nginx", Set: []v1.EnvVar{e}})
+	require.NoError(t, err)
+
+	// Patching a var currently sourced from a secret must drop the secret ref.
+	raw := []byte(`{"spec":{"template":{"spec":{"containers":[{"name":"nginx","env":[{"name":"PWD","valueFrom":{"secretKeyRef":{"name":"creds","key":"password"}}}]}]}}}}`)
+	merged, err := strategicpatch.StrategicMergePatch(raw, got, appsv1.Deployment{})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"spec":{"template":{"spec":{"containers":[{"name":"nginx","env":[{"name":"PWD","valueFrom":{"configMapKeyRef":{"name":"creds","key":"password"}}}]}]}}}}`, string(merged))
+}
+
+func TestEnvVarToString(t *testing.T) {
+	uu := map[string]struct {
+		src EnvSource
+		s   string
+	}{
+		"plain":        {src: EnvValue, s: "blee"},
+		"plain-prefix": {src: EnvValue, s: "secret:fred/blee"},
+		"secret":       {src: EnvSecret, s: "fred/blee"},
+		"configmap":    {src: EnvConfigMap, s: "fred/blee"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			e, err := EnvVarFromString("A", u.src, u.s)
+			require.NoError(t, err)
+			src, s := EnvVarToString(e)
+			assert.Equal(t, u.src, src)
+			assert.Equal(t, u.s, s)
+		})
+	}
+}
+
+func TestEnvVarFromStringToast(t *testing.T) {
+	uu := map[string]struct {
+		src EnvSource
+		s   string
+	}{
+		"bad-ref":  {src: EnvSecret, s: "fred"},
+		"readonly": {src: EnvField, s: "metadata.name"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			_, err := EnvVarFromString("A", u.src, u.s)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestEnvVarToStringReadOnly(t *testing.T) {
+	uu := map[string]struct {
+		e   v1.EnvVar
+		src EnvSource
+		s   string
+	}{
+		"field": {
+			e:   v1.EnvVar{Name: "A", ValueFrom: &v1.EnvVarSource{FieldRef: &v1.ObjectFieldSelector{FieldPath: "metadata.name"}}},
+			src: EnvField,
+			s:   "metadata.name",
+		},
+		"resource": {
+			e:   v1.EnvVar{Name: "A", ValueFrom: &v1.EnvVarSource{ResourceFieldRef: &v1.ResourceFieldSelector{ContainerName: "nginx", Resource: "limits.cpu"}}},
+			src: EnvResource,
+			s:   "nginx/limits.cpu",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			src, s := EnvVarToString(u.e)
+			assert.Equal(t, u.src, src)
+			assert.False(t, src.Editable())
+			assert.Equal(t, u.s, s)
+		})
+	}
+}
+
+func TestResourceSpecsValidate(t *testing.T) {
+	uu := map[string]struct {
+		spec ResourceSpec
+		err  bool
+	}{
+		"empty": {},
+		"ok": {
+			spec: ResourceSpec{
+				Requests: map[v1.ResourceName]string{v1.ResourceCPU: "100m", v1.ResourceMemory: "64Mi"},
+				Limits:   map[v1.ResourceName]string{v1.ResourceCPU: "1", v1.ResourceMemory: "64Mi"},
+			},
+		},
+		"request-only": {
+			spec: ResourceSpec{Requests: map[v1.ResourceName]string{v1.ResourceCPU: "2"}},
+		},
+		"cleared-limit": {
+			spec: ResourceSpec{
+				Requests: map[v1.ResourceName]string{v1.ResourceCPU: "2"},
+				Limits:   map[v1.ResourceName]string{v1.ResourceCPU: ""},
+			},
+		},
+		"bad-quantity": {
+			spec: ResourceSpec{Requests: map[v1.ResourceName]string{v1.ResourceCPU: "fred"}},
+			err:  true,
+		},
+		"cpu-over-limit": {
+			spec: ResourceSpec{
+				Requests: map[v1.ResourceName]string{v1.ResourceCPU: "1500m"},
+				Limits:   map[v1.ResourceName]string{v1.ResourceCPU: "1"},
+			},
+			err: true,
+		},
+		"mem-over-limit": {
+			spec: ResourceSpec{
+				Requests: map[v1.ResourceName]string{v1.ResourceMemory: "1Gi"},
+				Limits:   map[v1.ResourceName]string{v1.ResourceMemory: "512Mi"},
+			},
+			err: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			u.spec.Name = "nginx"
+			err := ResourceSpecs{u.spec}.Validate()
+			assert.Equal(t, u.err, err != nil)
+		})
+	}
+}
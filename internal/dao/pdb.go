@@ -0,0 +1,150 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+var _ Accessor = (*PodDisruptionBudget)(nil)
+
+// PodDisruptionBudget represents a K8s pdb.
+type PodDisruptionBudget struct {
+	Table
+}
+
+// Impact returns a pdb matched pods, allowed disruptions and the node drains
+// it holds up.
+func (p *PodDisruptionBudget) Impact(ctx context.Context, path string) (*render.PDBImpact, error) {
+	ns, n := client.Namespaced(path)
+	dial, err := p.Client().Dial()
+	if err != nil {
+		return nil, err
+	}
+	pdb, err := dial.PolicyV1().PodDisruptionBudgets(ns).Get(ctx, n, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	sel, err := metav1.LabelSelectorAsSelector(pdb.Spec.Selector)
+	if err != nil {
+		return nil, err
+	}
+
+	impact := render.PDBImpact{
+		Path:           path,
+		MinAvailable:   intOrStr(pdb.Spec.MinAvailable),
+		MaxUnavailable: intOrStr(pdb.Spec.MaxUnavailable),
+		Allowed:        pdb.Status.DisruptionsAllowed,
+		Healthy:        pdb.Status.CurrentHealthy,
+		Desired:        pdb.Status.DesiredHealthy,
+		Expected:       pdb.Status.ExpectedPods,
+	}
+	oo, err := p.GetFactory().List("v1/pods", ns, true, sel)
+	if err != nil {
+		return nil, err
+	}
+	cordoned, err := cordonedNodes(p.Factory)
+	if err != nil {
+		log.Warn().Err(err).Msgf("Unable to check cordoned nodes")
+	}
+	for _, o := range oo {
+		var po v1.Pod
+		if err := fromUnstructured(o, &po); err != nil {
+			return nil, err
+		}
+		impact.Pods = append(impact.Pods, render.PDBPod{
+			Name:     po.Name,
+			Node:     po.Spec.NodeName,
+			Phase:    string(po.Status.Phase),
+			Ready:    isPodReady(&po),
+			Cordoned: cordoned[po.Spec.NodeName],
+		})
+	}
+	sort.Slice(impact.Pods, func(i, j int) bool {
+		return impact.Pods[i].Name < impact.Pods[j].Name
+	})
+
+	return &impact, nil
+}
+
+// PodPDBs returns the disruption budgets protecting a given pod.
+func PodPDBs(ctx context.Context, f Factory, path string) ([]string, error) {
+	o, err := f.Get("v1/pods", path, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	var po v1.Pod
+	if err := fromUnstructured(o, &po); err != nil {
+		return nil, err
+	}
+	dial, err := f.Client().Dial()
+	if err != nil {
+		return nil, err
+	}
+	ll, err := dial.PolicyV1().PodDisruptionBudgets(po.Namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return protectingPDBs(ll.Items, po), nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func protectingPDBs(pdbs []policyv1.PodDisruptionBudget, po v1.Pod) []string {
+	var nn []string
+	for _, pdb := range pdbs {
+		sel, err := metav1.LabelSelectorAsSelector(pdb.Spec.Selector)
+		if err != nil {
+			continue
+		}
+		// policy/v1 empty selectors match all pods.
+		if sel.Matches(labels.Set(po.Labels)) {
+			nn = append(nn, client.FQN(pdb.Namespace, pdb.Name))
+		}
+	}
+	sort.Strings(nn)
+
+	return nn
+}
+
+func intOrStr(n *intstr.IntOrString) string {
+	if n == nil {
+		return render.NAValue
+	}
+
+	return n.String()
+}
+
+func cordonedNodes(f Factory) (map[string]bool, error) {
+	oo, err := f.List("v1/nodes", "", true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	cordoned := make(map[string]bool, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting unstructured but got %T", o)
+		}
+		var no v1.Node
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &no); err != nil {
+			return nil, err
+		}
+		cordoned[no.Name] = no.Spec.Unschedulable
+	}
+
+	return cordoned, nil
+}
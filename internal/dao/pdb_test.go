@@ -0,0 +1,52 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func TestProtectingPDBs(t *testing.T) {
+	pdb := func(n string, sel *metav1.LabelSelector) policyv1.PodDisruptionBudget {
+		return policyv1.PodDisruptionBudget{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: n},
+			Spec:       policyv1.PodDisruptionBudgetSpec{Selector: sel},
+		}
+	}
+	pdbs := []policyv1.PodDisruptionBudget{
+		pdb("web", &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}}),
+		pdb("all", &metav1.LabelSelector{}),
+		pdb("tier", &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "front"}}),
+		pdb("db", &metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}}),
+		pdb("nil", nil),
+	}
+
+	uu := map[string]struct {
+		labels map[string]string
+		e      []string
+	}{
+		"unselected": {labels: map[string]string{"app": "cache"}, e: []string{"default/all"}},
+		"single":     {labels: map[string]string{"app": "db"}, e: []string{"default/all", "default/db"}},
+		"multiple":   {labels: map[string]string{"app": "web", "tier": "front"}, e: []string{"default/all", "default/tier", "default/web"}},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			po := v1.Pod{ObjectMeta: metav1.ObjectMeta{Labels: u.labels}}
+			assert.Equal(t, u.e, protectingPDBs(pdbs, po))
+		})
+	}
+}
+
+func TestIntOrStr(t *testing.T) {
+	i, s := intstr.FromInt(2), intstr.FromString("50%")
+
+	assert.Equal(t, "n/a", intOrStr(nil))
+	assert.Equal(t, "2", intOrStr(&i))
+	assert.Equal(t, "50%", intOrStr(&s))
+}
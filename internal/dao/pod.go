@@ -11,8 +11,10 @@ import (
 
 	"github.com/rs/zerolog/log"
 	v1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -37,14 +39,32 @@ var (
 	_ Loggable        = (*Pod)(nil)
 	_ Controller      = (*Pod)(nil)
 	_ ContainsPodSpec = (*Pod)(nil)
+	_ Debuggable      = (*Pod)(nil)
+	_ Evictable       = (*Pod)(nil)
 )
 
 const (
 	logRetryCount                 = 20
 	logRetryWait                  = 1 * time.Second
 	defaultLogContainerAnnotation = "kubectl.kubernetes.io/default-logs-container"
+	debugContainerPrefix          = "debugger"
 )
 
+// DebugOptions represents an ephemeral debug container specification.
+type DebugOptions struct {
+	// Image the debug container image.
+	Image string
+
+	// Target the container whose process namespace is shared.
+	Target string
+
+	// Command overrides the image entrypoint.
+	Command []string
+
+	// Capabilities extra kernel capabilities granted to the container.
+	Capabilities []v1.Capability
+}
+
 // Pod represents a pod resource.
 type Pod struct {
 	Resource
@@ -87,17 +107,24 @@ func (p *Pod) List(ctx context.Context, ns string) ([]runtime.Object, error) {
 		return oo, err
 	}
 
-	var pmx client.PodsMetricsMap
+	var (
+		pmx  client.PodsMetricsMap
+		hist *client.MetricsHistory
+	)
 	if withMx, ok := ctx.Value(internal.KeyWithMetrics).(bool); withMx || !ok {
-		pmx, _ = client.DialMetrics(p.Client()).FetchPodsMetricsMap(ctx, ns)
+		mx := client.DialMetrics(p.Client())
+		pmx, _ = mx.FetchPodsMetricsMap(ctx, ns)
+		hist = mx.PodsHistory()
 	}
+	var nodeName string
 	sel, _ := ctx.Value(internal.KeyFields).(string)
-	fsel, err := labels.ConvertSelectorToLabelsMap(sel)
-	if err != nil {
-		return nil, err
+	if fsel, err := fields.ParseSelector(sel); err == nil {
+		nodeName, _ = fsel.RequiresExactMatch("spec.nodeName")
 	}
-	nodeName := fsel["spec.nodeName"]
 
+	rates := pricingRates(ctx)
+	now := time.Now()
+	PodRestarts.Prune(now.Add(-restartHistoryExpiry))
 	res := make([]runtime.Object, 0, len(oo))
 	for _, o := range oo {
 		u, ok := o.(*unstructured.Unstructured)
@@ -105,8 +132,15 @@ func (p *Pod) List(ctx context.Context, ns string) ([]runtime.Object, error) {
 			return res, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
 		}
 		fqn := extractFQN(o)
+		pwm := render.PodWithMetrics{Raw: u, MX: pmx[fqn], Rates: rates}
+		if hist != nil {
+			pwm.History = hist.Samples(fqn)
+		}
+		PodRestarts.Record(fqn, podRestarts(u), now)
+		pwm.RestartTrend = PodRestarts.Trend(fqn, now.Add(-RestartTrendWindow))
+		pwm.Scan.Critical, pwm.Scan.Scanned = PodCriticalCVEs(fqn)
 		if nodeName == "" {
-			res = append(res, &render.PodWithMetrics{Raw: u, MX: pmx[fqn]})
+			res = append(res, &pwm)
 			continue
 		}
 
@@ -115,7 +149,7 @@ func (p *Pod) List(ctx context.Context, ns string) ([]runtime.Object, error) {
 			return res, fmt.Errorf("expecting interface map but got `%T", o)
 		}
 		if spec["nodeName"] == nodeName {
-			res = append(res, &render.PodWithMetrics{Raw: u, MX: pmx[fqn]})
+			res = append(res, &pwm)
 		}
 	}
 
@@ -281,25 +315,28 @@ func (p *Pod) Scan(ctx context.Context, gvr, fqn string, wait bool) (Refs, error
 		}
 		switch gvr {
 		case "v1/configmaps":
-			if !hasConfigMap(&pod.Spec, n) {
+			usage := configMapUsage(&pod.Spec, n)
+			if usage == "" {
 				continue
 			}
 			refs = append(refs, Ref{
-				GVR: p.GVR(),
-				FQN: client.FQN(pod.Namespace, pod.Name),
+				GVR:   p.GVR(),
+				FQN:   client.FQN(pod.Namespace, pod.Name),
+				Usage: usage,
 			})
 		case "v1/secrets":
-			found, err := hasSecret(p.Factory, &pod.Spec, pod.Namespace, n, wait)
+			usage, err := secretUsage(p.Factory, &pod.Spec, pod.Namespace, n, wait)
 			if err != nil {
 				log.Warn().Err(err).Msgf("locate secret %q", fqn)
 				continue
 			}
-			if !found {
+			if usage == "" {
 				continue
 			}
 			refs = append(refs, Ref{
-				GVR: p.GVR(),
-				FQN: client.FQN(pod.Namespace, pod.Name),
+				GVR:   p.GVR(),
+				FQN:   client.FQN(pod.Namespace, pod.Name),
+				Usage: usage,
 			})
 		case "v1/persistentvolumeclaims":
 			if !hasPVC(&pod.Spec, n) {
@@ -437,6 +474,49 @@ func extractFQN(o runtime.Object) string {
 	return FQN(u.GetNamespace(), u.GetName())
 }
 
+// podRestarts returns a pod containers total restart count.
+func podRestarts(u *unstructured.Unstructured) int {
+	cc, _, _ := unstructured.NestedSlice(u.Object, "status", "containerStatuses")
+	var count int
+	for _, c := range cc {
+		m, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if n, ok, _ := unstructured.NestedInt64(m, "restartCount"); ok {
+			count += int(n)
+		}
+	}
+
+	return count
+}
+
+// Evict evicts a pod via the eviction API so disruption budgets are honored.
+func (p *Pod) Evict(ctx context.Context, path string, grace Grace) error {
+	ns, n := client.Namespaced(path)
+	auth, err := p.Client().CanI(ns, "v1/pods:eviction", []string{client.CreateVerb})
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to evict %s", path)
+	}
+
+	dial, err := p.Client().Dial()
+	if err != nil {
+		return err
+	}
+	ev := policyv1.Eviction{
+		ObjectMeta: metav1.ObjectMeta{Name: n, Namespace: ns},
+	}
+	if grace != DefaultGrace {
+		g := int64(grace)
+		ev.DeleteOptions = &metav1.DeleteOptions{GracePeriodSeconds: &g}
+	}
+
+	return audited(dial.CoreV1().Pods(ns).EvictV1(ctx, &ev), p.Factory, "evict", p.GVR(), path, ev.DeleteOptions)
+}
+
 // GetPodSpec returns a pod spec given a resource.
 func (p *Pod) GetPodSpec(path string) (*v1.PodSpec, error) {
 	pod, err := p.GetInstance(path)
@@ -479,7 +559,7 @@ func (p *Pod) SetImages(ctx context.Context, path string, imageSpecs ImageSpecs)
 		jsonPatch,
 		metav1.PatchOptions{},
 	)
-	return err
+	return audited(err, p.Factory, "set-image", p.GVR(), path, imageSpecs)
 }
 
 /*
@@ -517,6 +597,99 @@ func (p *Pod) SetTraceLogs(ctx context.Context, path string, imageSpecs ImageSpe
 	return err
 }*/
 
+// Debug adds an ephemeral debug container to the given pod.
+func (p *Pod) Debug(ctx context.Context, path string, opts DebugOptions) (string, error) {
+	if opts.Image == "" {
+		return "", errors.New("a debug container image must be specified")
+	}
+	ns, n := client.Namespaced(path)
+	auth, err := p.Client().CanI(ns, "v1/pods:ephemeralcontainers", []string{client.UpdateVerb})
+	if err != nil {
+		return "", err
+	}
+	if !auth {
+		return "", fmt.Errorf("user is not authorized to add ephemeral containers to a pod")
+	}
+	pod, err := p.GetInstance(path)
+	if err != nil {
+		return "", err
+	}
+	co := NewEphemeralContainer(pod.Spec, opts)
+	pod.Spec.EphemeralContainers = append(pod.Spec.EphemeralContainers, co)
+	dial, err := p.Client().Dial()
+	if err != nil {
+		return "", err
+	}
+	_, err = dial.CoreV1().Pods(ns).UpdateEphemeralContainers(ctx, n, pod, metav1.UpdateOptions{})
+	payload := map[string]interface{}{
+		"container":    co.Name,
+		"image":        co.Image,
+		"target":       co.TargetContainerName,
+		"capabilities": opts.Capabilities,
+	}
+	if err := audited(err, p.Factory, "debug", p.GVR(), path, payload); err != nil {
+		return "", err
+	}
+
+	return co.Name, nil
+}
+
+// IsEphemeralRunning checks if the given ephemeral container is running.
+func (p *Pod) IsEphemeralRunning(path, co string) (bool, error) {
+	pod, err := p.GetInstance(path)
+	if err != nil {
+		return false, err
+	}
+	for _, s := range pod.Status.EphemeralContainerStatuses {
+		if s.Name != co {
+			continue
+		}
+		if s.State.Terminated != nil {
+			return false, fmt.Errorf("debug container %s terminated: %s", co, s.State.Terminated.Reason)
+		}
+		return s.State.Running != nil, nil
+	}
+
+	return false, nil
+}
+
+// NewEphemeralContainer returns a new debug container with a name unique to the pod.
+func NewEphemeralContainer(spec v1.PodSpec, opts DebugOptions) v1.EphemeralContainer {
+	var sec *v1.SecurityContext
+	if len(opts.Capabilities) > 0 {
+		sec = &v1.SecurityContext{
+			Capabilities: &v1.Capabilities{Add: opts.Capabilities},
+		}
+	}
+
+	return v1.EphemeralContainer{
+		TargetContainerName: opts.Target,
+		EphemeralContainerCommon: v1.EphemeralContainerCommon{
+			Name:                     debugContainerName(spec),
+			Image:                    opts.Image,
+			Command:                  opts.Command,
+			ImagePullPolicy:          v1.PullIfNotPresent,
+			Stdin:                    true,
+			TTY:                      true,
+			TerminationMessagePolicy: v1.TerminationMessageReadFile,
+			SecurityContext:          sec,
+		},
+	}
+}
+
+func debugContainerName(spec v1.PodSpec) string {
+	taken := make(map[string]struct{}, len(spec.EphemeralContainers))
+	for _, co := range spec.EphemeralContainers {
+		taken[co.Name] = struct{}{}
+	}
+	for i := 0; ; i++ {
+		n := fmt.Sprintf("%s-%d", debugContainerPrefix, i)
+		if _, ok := taken[n]; !ok {
+			return n
+		}
+	}
+}
+
 func (p *Pod) isControlled(path string) (string, bool, error) {
 	pod, err := p.GetInstance(path)
 	if err != nil {
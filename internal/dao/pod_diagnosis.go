@@ -0,0 +1,30 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal/render"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Diagnose explains a pod status given its container statuses and events.
+func (p *Pod) Diagnose(ctx context.Context, path string) (*render.PodDiagnosis, error) {
+	po, err := p.GetInstance(path)
+	if err != nil {
+		return nil, err
+	}
+	dial, err := p.Client().Dial()
+	if err != nil {
+		return nil, err
+	}
+	ee, err := dial.CoreV1().Events(po.Namespace).List(ctx, metav1.ListOptions{
+		FieldSelector: fmt.Sprintf(involvedUIDFmt, po.UID),
+	})
+	if err != nil {
+		return nil, err
+	}
+	d := render.NewPodDiagnosis(path, po, ee.Items)
+
+	return &d, nil
+}
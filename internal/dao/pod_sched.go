@@ -0,0 +1,287 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/selection"
+)
+
+const nodeNameField = "metadata.name"
+
+var _ Accessor = (*PodSchedule)(nil)
+
+// PodSchedule explains a pod scheduling against all cluster nodes.
+type PodSchedule struct {
+	NonResource
+}
+
+// List returns a per node scheduling verdict for a given pod.
+func (p *PodSchedule) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	path, ok := ctx.Value(internal.KeyPath).(string)
+	if !ok {
+		return nil, errors.New("no context path found")
+	}
+	po, err := p.pod(path)
+	if err != nil {
+		return nil, err
+	}
+	nn, err := FetchNodes(ctx, p.Factory, "")
+	if err != nil {
+		return nil, err
+	}
+	pods, err := p.GetFactory().List("v1/pods", client.AllNamespaces, false, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	pp := make([]v1.Pod, 0, len(pods))
+	for _, o := range pods {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting unstructured but got %T", o)
+		}
+		var po v1.Pod
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &po); err != nil {
+			return nil, err
+		}
+		pp = append(pp, po)
+	}
+
+	rr := ExplainScheduling(po, nn.Items, pp)
+	oo := make([]runtime.Object, 0, len(rr))
+	for _, r := range rr {
+		oo = append(oo, r)
+	}
+
+	return oo, nil
+}
+
+func (p *PodSchedule) pod(path string) (*v1.Pod, error) {
+	o, err := p.GetFactory().Get("v1/pods", path, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expecting unstructured but got %T", o)
+	}
+	var po v1.Pod
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &po); err != nil {
+		return nil, err
+	}
+
+	return &po, nil
+}
+
+// ExplainScheduling evaluates the scheduler predicates for a pod against each node.
+// Pods are the cluster pods used to compute each node requested resources.
+func ExplainScheduling(po *v1.Pod, nodes []v1.Node, pods []v1.Pod) []render.NodeFitRes {
+	reqs := make(map[string]v1.ResourceList, len(nodes))
+	counts := make(map[string]int64, len(nodes))
+	for i := range pods {
+		p := &pods[i]
+		if p.Spec.NodeName == "" || p.UID == po.UID ||
+			p.Status.Phase == v1.PodSucceeded || p.Status.Phase == v1.PodFailed {
+			continue
+		}
+		counts[p.Spec.NodeName]++
+		acc, ok := reqs[p.Spec.NodeName]
+		if !ok {
+			acc = make(v1.ResourceList)
+			reqs[p.Spec.NodeName] = acc
+		}
+		for n, q := range render.EffectiveRequests(p.Spec) {
+			sum := acc[n]
+			sum.Add(q)
+			acc[n] = sum
+		}
+	}
+
+	want := render.EffectiveRequests(po.Spec)
+	rr := make([]render.NodeFitRes, 0, len(nodes))
+	for i := range nodes {
+		no := &nodes[i]
+		r := render.NodeFitRes{
+			Node:        no.Name,
+			Schedulable: checkSchedulable(po, no),
+			Selector:    checkNodeSelector(po, no),
+			Affinity:    checkNodeAffinity(po, no),
+			Taints:      checkTaints(po, no),
+			Resources:   checkResources(want, reqs[no.Name], counts[no.Name], no),
+		}
+		r.Fits = r.Schedulable == "" && r.Selector == "" && r.Affinity == "" && r.Taints == "" && r.Resources == ""
+		rr = append(rr, r)
+	}
+	sort.Slice(rr, func(i, j int) bool {
+		if rr[i].Fits != rr[j].Fits {
+			return rr[i].Fits
+		}
+		return rr[i].Node < rr[j].Node
+	})
+
+	return rr
+}
+
+func checkSchedulable(po *v1.Pod, no *v1.Node) string {
+	if po.Spec.NodeName != "" && po.Spec.NodeName != no.Name {
+		return "pod bound to node " + po.Spec.NodeName
+	}
+	if !no.Spec.Unschedulable {
+		return ""
+	}
+	t := v1.Taint{Key: v1.TaintNodeUnschedulable, Effect: v1.TaintEffectNoSchedule}
+	if tolerates(po.Spec.Tolerations, &t) {
+		return ""
+	}
+
+	return "node is cordoned"
+}
+
+func checkNodeSelector(po *v1.Pod, no *v1.Node) string {
+	if len(po.Spec.NodeSelector) == 0 {
+		return ""
+	}
+	if labels.SelectorFromSet(po.Spec.NodeSelector).Matches(labels.Set(no.Labels)) {
+		return ""
+	}
+
+	return "nodeSelector mismatch " + labels.SelectorFromSet(po.Spec.NodeSelector).String()
+}
+
+func checkNodeAffinity(po *v1.Pod, no *v1.Node) string {
+	a := po.Spec.Affinity
+	if a == nil || a.NodeAffinity == nil || a.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution == nil {
+		return ""
+	}
+	terms := a.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms
+	for _, t := range terms {
+		ok, err := matchNodeSelectorTerm(t, no)
+		if err != nil {
+			return err.Error()
+		}
+		if ok {
+			return ""
+		}
+	}
+
+	return fmt.Sprintf("no required affinity term matched (%d terms)", len(terms))
+}
+
+// matchNodeSelectorTerm checks if all term requirements match a node.
+// Empty terms never match.
+func matchNodeSelectorTerm(t v1.NodeSelectorTerm, no *v1.Node) (bool, error) {
+	if len(t.MatchExpressions) == 0 && len(t.MatchFields) == 0 {
+		return false, nil
+	}
+	for _, e := range t.MatchExpressions {
+		ok, err := matchNodeRequirement(e, labels.Set(no.Labels))
+		if err != nil || !ok {
+			return false, err
+		}
+	}
+	for _, f := range t.MatchFields {
+		if f.Key != nodeNameField {
+			return false, fmt.Errorf("unsupported field selector %q", f.Key)
+		}
+		ok, err := matchNodeRequirement(f, labels.Set{nodeNameField: no.Name})
+		if err != nil || !ok {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+func matchNodeRequirement(e v1.NodeSelectorRequirement, ll labels.Set) (bool, error) {
+	ops := map[v1.NodeSelectorOperator]selection.Operator{
+		v1.NodeSelectorOpIn:           selection.In,
+		v1.NodeSelectorOpNotIn:        selection.NotIn,
+		v1.NodeSelectorOpExists:       selection.Exists,
+		v1.NodeSelectorOpDoesNotExist: selection.DoesNotExist,
+		v1.NodeSelectorOpGt:           selection.GreaterThan,
+		v1.NodeSelectorOpLt:           selection.LessThan,
+	}
+	op, ok := ops[e.Operator]
+	if !ok {
+		return false, fmt.Errorf("invalid node selector operator %q", e.Operator)
+	}
+	r, err := labels.NewRequirement(e.Key, op, e.Values)
+	if err != nil {
+		return false, err
+	}
+
+	return r.Matches(ll), nil
+}
+
+func checkTaints(po *v1.Pod, no *v1.Node) string {
+	var ss []string
+	for i := range no.Spec.Taints {
+		t := &no.Spec.Taints[i]
+		if t.Effect == v1.TaintEffectPreferNoSchedule {
+			continue
+		}
+		if !tolerates(po.Spec.Tolerations, t) {
+			ss = append(ss, "untolerated "+render.TaintSpec(*t))
+		}
+	}
+
+	return strings.Join(ss, ", ")
+}
+
+func tolerates(tt []v1.Toleration, t *v1.Taint) bool {
+	for i := range tt {
+		if tt[i].ToleratesTaint(t) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func checkResources(want, used v1.ResourceList, count int64, no *v1.Node) string {
+	var ss []string
+	if pods, ok := no.Status.Allocatable[v1.ResourcePods]; ok && count+1 > pods.Value() {
+		ss = append(ss, fmt.Sprintf("too many pods (%d/%d)", count, pods.Value()))
+	}
+
+	kk := make([]string, 0, len(want))
+	for n := range want {
+		kk = append(kk, string(n))
+	}
+	sort.Strings(kk)
+	for _, k := range kk {
+		n := v1.ResourceName(k)
+		q := want[n]
+		if q.IsZero() {
+			continue
+		}
+		alloc, ok := no.Status.Allocatable[n]
+		if !ok {
+			ss = append(ss, "no "+k+" allocatable")
+			continue
+		}
+		free := alloc.DeepCopy()
+		if u, ok := used[n]; ok {
+			free.Sub(u)
+		}
+		if q.Cmp(free) > 0 {
+			if free.Sign() < 0 {
+				free = resource.Quantity{}
+			}
+			ss = append(ss, fmt.Sprintf("insufficient %s (want %s, free %s)", k, q.String(), free.String()))
+		}
+	}
+
+	return strings.Join(ss, ", ")
+}
@@ -0,0 +1,158 @@
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestExplainScheduling(t *testing.T) {
+	uu := map[string]struct {
+		pod   v1.Pod
+		node  v1.Node
+		pods  []v1.Pod
+		fits  bool
+		check func(*testing.T, string, string, string, string, string)
+	}{
+		"fits": {
+			pod:  makeSchedPod("100m", nil),
+			node: makeSchedNode("n1", nil),
+			fits: true,
+		},
+		"cordoned": {
+			pod: makeSchedPod("", nil),
+			node: func() v1.Node {
+				no := makeSchedNode("n1", nil)
+				no.Spec.Unschedulable = true
+				return no
+			}(),
+			check: func(t *testing.T, sched, _, _, _, _ string) {
+				assert.Equal(t, "node is cordoned", sched)
+			},
+		},
+		"selector": {
+			pod: func() v1.Pod {
+				po := makeSchedPod("", nil)
+				po.Spec.NodeSelector = map[string]string{"disk": "ssd"}
+				return po
+			}(),
+			node: makeSchedNode("n1", map[string]string{"disk": "hdd"}),
+			check: func(t *testing.T, _, sel, _, _, _ string) {
+				assert.Equal(t, "nodeSelector mismatch disk=ssd", sel)
+			},
+		},
+		"affinity-in": {
+			pod:  makeSchedPod("", affinity("zone", v1.NodeSelectorOpIn, "z1", "z2")),
+			node: makeSchedNode("n1", map[string]string{"zone": "z2"}),
+			fits: true,
+		},
+		"affinity-gt": {
+			pod:  makeSchedPod("", affinity("cores", v1.NodeSelectorOpGt, "8")),
+			node: makeSchedNode("n1", map[string]string{"cores": "4"}),
+			check: func(t *testing.T, _, _, aff, _, _ string) {
+				assert.Equal(t, "no required affinity term matched (1 terms)", aff)
+			},
+		},
+		"taint": {
+			pod: makeSchedPod("", nil),
+			node: func() v1.Node {
+				no := makeSchedNode("n1", nil)
+				no.Spec.Taints = []v1.Taint{
+					{Key: "dedicated", Value: "gpu", Effect: v1.TaintEffectNoSchedule},
+					{Key: "soft", Effect: v1.TaintEffectPreferNoSchedule},
+				}
+				return no
+			}(),
+			check: func(t *testing.T, _, _, _, taints, _ string) {
+				assert.Equal(t, "untolerated dedicated=gpu:NoSchedule", taints)
+			},
+		},
+		"tolerated": {
+			pod: func() v1.Pod {
+				po := makeSchedPod("", nil)
+				po.Spec.Tolerations = []v1.Toleration{{Key: "dedicated", Operator: v1.TolerationOpExists}}
+				return po
+			}(),
+			node: func() v1.Node {
+				no := makeSchedNode("n1", nil)
+				no.Spec.Taints = []v1.Taint{{Key: "dedicated", Value: "gpu", Effect: v1.TaintEffectNoExecute}}
+				return no
+			}(),
+			fits: true,
+		},
+		"insufficient": {
+			pod:  makeSchedPod("1500m", nil),
+			node: makeSchedNode("n1", nil),
+			pods: []v1.Pod{
+				func() v1.Pod {
+					po := makeSchedPod("1", nil)
+					po.Name, po.UID, po.Spec.NodeName = "p2", "u2", "n1"
+					return po
+				}(),
+			},
+			check: func(t *testing.T, _, _, _, _, res string) {
+				assert.Equal(t, "insufficient cpu (want 1500m, free 1)", res)
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			rr := dao.ExplainScheduling(&u.pod, []v1.Node{u.node}, u.pods)
+			assert.Equal(t, 1, len(rr))
+			assert.Equal(t, u.fits, rr[0].Fits)
+			if u.check != nil {
+				u.check(t, rr[0].Schedulable, rr[0].Selector, rr[0].Affinity, rr[0].Taints, rr[0].Resources)
+			}
+		})
+	}
+}
+
+// Helpers...
+
+func makeSchedPod(cpu string, a *v1.Affinity) v1.Pod {
+	po := v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "p1", UID: "u1"},
+		Spec: v1.PodSpec{
+			Affinity:   a,
+			Containers: []v1.Container{{Name: "c1"}},
+		},
+	}
+	if cpu != "" {
+		po.Spec.Containers[0].Resources.Requests = v1.ResourceList{
+			v1.ResourceCPU: resource.MustParse(cpu),
+		}
+	}
+
+	return po
+}
+
+func makeSchedNode(n string, ll map[string]string) v1.Node {
+	return v1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: n, Labels: ll},
+		Status: v1.NodeStatus{
+			Allocatable: v1.ResourceList{
+				v1.ResourceCPU:    resource.MustParse("2"),
+				v1.ResourceMemory: resource.MustParse("4Gi"),
+				v1.ResourcePods:   resource.MustParse("110"),
+			},
+		},
+	}
+}
+
+func affinity(k string, op v1.NodeSelectorOperator, vv ...string) *v1.Affinity {
+	return &v1.Affinity{
+		NodeAffinity: &v1.NodeAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: &v1.NodeSelector{
+				NodeSelectorTerms: []v1.NodeSelectorTerm{
+					{MatchExpressions: []v1.NodeSelectorRequirement{{Key: k, Operator: op, Values: vv}}},
+				},
+			},
+		},
+	}
+}
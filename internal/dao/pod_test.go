@@ -54,3 +54,37 @@ func TestGetDefaultLogContainer(t *testing.T) {
 		})
 	}
 }
+
+func TestNewEphemeralContainer(t *testing.T) {
+	uu := map[string]struct {
+		spec     v1.PodSpec
+		opts     DebugOptions
+		wantName string
+	}{
+		"first": {
+			opts:     DebugOptions{Image: "busybox", Target: "c1"},
+			wantName: "debugger-0",
+		},
+		"taken": {
+			spec: v1.PodSpec{
+				EphemeralContainers: []v1.EphemeralContainer{
+					{EphemeralContainerCommon: v1.EphemeralContainerCommon{Name: "debugger-0"}},
+					{EphemeralContainerCommon: v1.EphemeralContainerCommon{Name: "debugger-2"}},
+				},
+			},
+			opts:     DebugOptions{Image: "busybox"},
+			wantName: "debugger-1",
+		},
+	}
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			co := NewEphemeralContainer(u.spec, u.opts)
+			assert.Equal(t, u.wantName, co.Name)
+			assert.Equal(t, u.opts.Image, co.Image)
+			assert.Equal(t, u.opts.Target, co.TargetContainerName)
+			assert.True(t, co.Stdin)
+			assert.True(t, co.TTY)
+		})
+	}
+}
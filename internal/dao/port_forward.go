@@ -97,5 +97,6 @@ func BenchConfigFor(benchFile, path string) config.BenchConfig {
 	}
 
 	def.C, def.N = cust.Benchmarks.Defaults.C, cust.Benchmarks.Defaults.N
+	def.Duration = cust.Benchmarks.Defaults.Duration
 	return def
 }
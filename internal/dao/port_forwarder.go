@@ -78,6 +78,16 @@ func (p *PortForwarder) ID() string {
 	return PortForwardID(p.path, p.tunnel.Container, p.tunnel.PortMap())
 }
 
+// Path returns the target's path.
+func (p *PortForwarder) Path() string {
+	return p.path
+}
+
+// Tunnel returns the port tunnel.
+func (p *PortForwarder) Tunnel() port.PortTunnel {
+	return p.tunnel
+}
+
 // Container returns the target's container.
 func (p *PortForwarder) Container() string {
 	return p.tunnel.Container
@@ -0,0 +1,116 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+)
+
+const pricingCacheExpiry = 10 * time.Minute
+
+// PricingProvider provides resources estimated hourly prices.
+type PricingProvider interface {
+	// Rates returns the current resources prices.
+	Rates(ctx context.Context) (*render.Rates, error)
+}
+
+// NewPricingProvider returns a pricing provider for the given options.
+func NewPricingProvider(cfg *config.Pricing) (PricingProvider, error) {
+	switch cfg.Provider {
+	case config.PricingHTTP:
+		if cfg.URL == "" {
+			return nil, fmt.Errorf("no url specified for the http pricing provider")
+		}
+		h := httpPricing{
+			url:      cfg.URL,
+			currency: cfg.Currency,
+			client:   &http.Client{Timeout: cfg.TimeoutDuration()},
+		}
+		h.cache = newRefresher("Pricing", pricingCacheExpiry, cfg.TimeoutDuration(), func(ctx context.Context) (interface{}, error) {
+			return h.fetch(ctx)
+		})
+		return &h, nil
+	default:
+		return &staticPricing{rates: &render.Rates{
+			Currency:      cfg.Currency,
+			InstanceTypes: cfg.InstanceTypes,
+			CPU:           cfg.CPU,
+			Memory:        cfg.Memory,
+			GPU:           cfg.GPU,
+		}}, nil
+	}
+}
+
+// pricingRates returns the active pricing provider rates if any.
+func pricingRates(ctx context.Context) *render.Rates {
+	p, ok := ctx.Value(internal.KeyPricing).(PricingProvider)
+	if !ok || p == nil {
+		return nil
+	}
+	r, err := p.Rates(ctx)
+	if err != nil {
+		log.Debug().Err(err).Msgf("Pricing rates unavailable")
+		return nil
+	}
+
+	return r
+}
+
+type staticPricing struct {
+	rates *render.Rates
+}
+
+// Rates returns the configured price table.
+func (s *staticPricing) Rates(context.Context) (*render.Rates, error) {
+	return s.rates, nil
+}
+
+type httpPricing struct {
+	url      string
+	currency string
+	client   *http.Client
+	cache    *refresher
+}
+
+// Rates returns the price table fetched in the background from a remote
+// billing api.
+func (h *httpPricing) Rates(context.Context) (*render.Rates, error) {
+	v, err := h.cache.get()
+	if err != nil {
+		return nil, err
+	}
+
+	return v.(*render.Rates), nil
+}
+
+func (h *httpPricing) fetch(ctx context.Context) (*render.Rates, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, h.url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("pricing provider returned %s", resp.Status)
+	}
+	var r render.Rates
+	if err := json.NewDecoder(resp.Body).Decode(&r); err != nil {
+		return nil, fmt.Errorf("invalid pricing response: %w", err)
+	}
+	if r.Currency == "" {
+		r.Currency = h.currency
+	}
+
+	return &r, nil
+}
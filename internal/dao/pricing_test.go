@@ -0,0 +1,76 @@
+package dao_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPricingProviderStatic(t *testing.T) {
+	cfg := config.Pricing{Provider: config.PricingStatic, CPU: 0.03, InstanceTypes: map[string]float64{"m5.large": 0.096}}
+	cfg.Validate()
+	p, err := dao.NewPricingProvider(&cfg)
+	assert.NoError(t, err)
+
+	r, err := p.Rates(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, &render.Rates{Currency: "USD", CPU: 0.03, InstanceTypes: map[string]float64{"m5.large": 0.096}}, r)
+}
+
+func TestPricingProviderHTTP(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		_, _ = w.Write([]byte(`{"instanceTypes":{"n2-standard-4":0.194},"cpu":0.031,"memory":0.004}`))
+	}))
+	defer srv.Close()
+
+	cfg := config.Pricing{Provider: config.PricingHTTP, Currency: "EUR", URL: srv.URL}
+	cfg.Validate()
+	p, err := dao.NewPricingProvider(&cfg)
+	assert.NoError(t, err)
+
+	_, err = p.Rates(context.Background())
+	assert.Error(t, err)
+	assert.Eventually(t, func() bool {
+		_, err := p.Rates(context.Background())
+		return err == nil
+	}, time.Second, 10*time.Millisecond)
+	r, err := p.Rates(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, &render.Rates{Currency: "EUR", CPU: 0.031, Memory: 0.004, InstanceTypes: map[string]float64{"n2-standard-4": 0.194}}, r)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestPricingProviderHTTPFailed(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer srv.Close()
+
+	_, err := dao.NewPricingProvider(&config.Pricing{Provider: config.PricingHTTP})
+	assert.Error(t, err)
+
+	p, err := dao.NewPricingProvider(&config.Pricing{Provider: config.PricingHTTP, URL: srv.URL, Timeout: 1})
+	assert.NoError(t, err)
+	assert.Eventually(t, func() bool {
+		_, err := p.Rates(context.Background())
+		return err != nil && strings.Contains(err.Error(), "502")
+	}, time.Second, 10*time.Millisecond)
+	for i := 0; i < 5; i++ {
+		_, err = p.Rates(context.Background())
+		assert.Error(t, err)
+	}
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
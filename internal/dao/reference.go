@@ -55,6 +55,7 @@ func (r *Reference) Scan(ctx context.Context) ([]runtime.Object, error) {
 			Namespace: ns,
 			Name:      n,
 			GVR:       ref.GVR,
+			Usage:     ref.Usage,
 		})
 	}
 
@@ -0,0 +1,84 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	backoff "github.com/cenkalti/backoff/v4"
+	"github.com/rs/zerolog/log"
+)
+
+const refresherRetry = 15 * time.Second
+
+// errNotReady indicates a remote value has not been fetched yet.
+var errNotReady = errors.New("not available yet")
+
+type fetchFunc func(context.Context) (interface{}, error)
+
+// refresher caches a remote value and refreshes it in the background so
+// callers never wait on the remote end. Failed fetches are retried with an
+// exponential backoff.
+type refresher struct {
+	name    string
+	fetch   fetchFunc
+	ttl     time.Duration
+	timeout time.Duration
+	bf      *backoff.ExponentialBackOff
+	val     interface{}
+	err     error
+	next    time.Time
+	busy    bool
+	mx      sync.Mutex
+}
+
+func newRefresher(name string, ttl, timeout time.Duration, f fetchFunc) *refresher {
+	bf := backoff.NewExponentialBackOff()
+	bf.InitialInterval, bf.MaxInterval, bf.MaxElapsedTime = refresherRetry, ttl, 0
+
+	return &refresher{
+		name:    name,
+		fetch:   f,
+		ttl:     ttl,
+		timeout: timeout,
+		bf:      bf,
+	}
+}
+
+// get returns the last fetched value and kicks off a refresh once the value
+// expired. A stale value is preferred over a failed refresh.
+func (r *refresher) get() (interface{}, error) {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	if !r.busy && !time.Now().Before(r.next) {
+		r.busy = true
+		go r.refresh()
+	}
+	switch {
+	case r.val != nil:
+		return r.val, nil
+	case r.err != nil:
+		return nil, r.err
+	default:
+		return nil, errNotReady
+	}
+}
+
+func (r *refresher) refresh() {
+	ctx, cancel := context.WithTimeout(context.Background(), r.timeout)
+	defer cancel()
+	v, err := r.fetch(ctx)
+
+	r.mx.Lock()
+	defer r.mx.Unlock()
+	r.busy = false
+	if err != nil {
+		r.err, r.next = err, time.Now().Add(r.bf.NextBackOff())
+		log.Warn().Err(err).Msgf("%s refresh failed. Retrying in %v", r.name, time.Until(r.next).Round(time.Second))
+		return
+	}
+	r.bf.Reset()
+	r.val, r.err, r.next = v, nil, time.Now().Add(r.ttl)
+}
@@ -13,6 +13,8 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/version"
 )
 
 // CRD identifies a CRD.
@@ -64,13 +66,17 @@ var stdGroups = []string{
 
 // Meta represents available resource metas.
 type Meta struct {
-	resMetas ResourceMetas
-	mx       sync.RWMutex
+	resMetas  ResourceMetas
+	preferred map[schema.GroupKind]client.GVR
+	mx        sync.RWMutex
 }
 
 // NewMeta returns a resource meta.
 func NewMeta() *Meta {
-	return &Meta{resMetas: make(ResourceMetas)}
+	return &Meta{
+		resMetas:  make(ResourceMetas),
+		preferred: make(map[schema.GroupKind]client.GVR),
+	}
 }
 
 // AccessorFor returns a client accessor for a resource if registered.
@@ -85,6 +91,8 @@ func AccessorFor(f Factory, gvr client.GVR) (Accessor, error) {
 		client.NewGVR("portforwards"):           &PortForward{},
 		client.NewGVR("v1/services"):            &Service{},
 		client.NewGVR("v1/pods"):                &Pod{},
+		client.NewGVR("v1/secrets"):             &Secret{},
+		client.NewGVR("v1/serviceaccounts"):     &ServiceAccount{},
 		client.NewGVR("v1/nodes"):               &Node{},
 		client.NewGVR("apps/v1/deployments"):    &Deployment{},
 		client.NewGVR("apps/v1/daemonsets"):     &DaemonSet{},
@@ -93,12 +101,26 @@ func AccessorFor(f Factory, gvr client.GVR) (Accessor, error) {
 		client.NewGVR("batch/v1beta1/cronjobs"): &CronJob{},
 		client.NewGVR("batch/v1/jobs"):          &Job{},
 		client.NewGVR("v1/namespaces"):          &Namespace{},
+		client.NewGVR("v1/events"):              &Event{},
+		client.NewGVR("ingroutes"):              &IngressRoute{},
 		// BOZO!! Revamp with latest...
 		// client.NewGVR("openfaas"):               &OpenFaas{},
-		client.NewGVR("popeye"):    &Popeye{},
-		client.NewGVR("sanitizer"): &Popeye{},
-		client.NewGVR("helm"):      &Helm{},
-		client.NewGVR("dir"):       &Dir{},
+		client.NewGVR("popeye"):       &Popeye{},
+		client.NewGVR("sanitizer"):    &Popeye{},
+		client.NewGVR("helm"):         &Helm{},
+		client.NewGVR("helmhistory"):  &HelmHistory{},
+		client.NewGVR("podsched"):     &PodSchedule{},
+		client.NewGVR("alerts"):       &Alert{},
+		client.NewGVR("audit"):        &Audit{},
+		client.NewGVR("stats"):        &Stats{},
+		client.NewGVR("nsusage"):      &NamespaceUsage{},
+		client.NewGVR("faults"):       &Fault{},
+		client.NewGVR("lint"):         &Lint{},
+		client.NewGVR("deprecations"): &Deprecation{},
+		client.NewGVR("tlscerts"):     &TLSCert{},
+		client.NewGVR("explain"):      &Explain{},
+		client.NewGVR("debug"):        &Debug{},
+		client.NewGVR("dir"):          &Dir{},
 	}
 
 	r, ok := m[gvr]
@@ -155,6 +177,39 @@ func (m *Meta) MetaFor(gvr client.GVR) (metav1.APIResource, error) {
 	return meta, nil
 }
 
+// GVRForKind returns the resource matching a given api version and kind.
+// When the version is not served, it falls back to the server preferred
+// version of the kind or else its highest priority version.
+func (m *Meta) GVRForKind(apiVersion, kind string) (client.GVR, bool) {
+	gv, err := schema.ParseGroupVersion(apiVersion)
+	if err != nil {
+		return client.GVR{}, false
+	}
+	m.mx.RLock()
+	defer m.mx.RUnlock()
+
+	var (
+		best  client.GVR
+		found bool
+	)
+	for gvr, meta := range m.resMetas {
+		if meta.Kind != kind || meta.Group != gv.Group || strings.Contains(meta.Name, "/") {
+			continue
+		}
+		if meta.Version == gv.Version {
+			return gvr, true
+		}
+		if !found || version.CompareKubeAwareVersionStrings(meta.Version, m.resMetas[best].Version) > 0 {
+			best, found = gvr, true
+		}
+	}
+	if gvr, ok := m.preferred[schema.GroupKind{Group: gv.Group, Kind: kind}]; ok {
+		return gvr, true
+	}
+
+	return best, found
+}
+
 // IsK8sMeta checks for non resource meta.
 func IsK8sMeta(m metav1.APIResource) bool {
 	for _, c := range m.Categories {
@@ -186,6 +241,12 @@ func (m *Meta) LoadResources(f Factory) error {
 	if err := loadPreferred(f, m.resMetas); err != nil {
 		return err
 	}
+	m.preferred = make(map[schema.GroupKind]client.GVR, len(m.resMetas))
+	for gvr, meta := range m.resMetas {
+		if !strings.Contains(meta.Name, "/") {
+			m.preferred[schema.GroupKind{Group: meta.Group, Kind: meta.Kind}] = gvr
+		}
+	}
 	loadNonResource(m.resMetas)
 	loadCRDs(f, m.resMetas)
 
@@ -230,6 +291,124 @@ func loadK9s(m ResourceMetas) {
 		Verbs:        []string{},
 		Categories:   []string{"k9s"},
 	}
+	m[client.NewGVR("owners")] = metav1.APIResource{
+		Name:         "owners",
+		Kind:         "Owners",
+		SingularName: "owner",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("svcendpoints")] = metav1.APIResource{
+		Name:         "svcendpoints",
+		Kind:         "ServiceEndpoints",
+		SingularName: "svcendpoint",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("ingroutes")] = metav1.APIResource{
+		Name:         "ingroutes",
+		Kind:         "IngressRoutes",
+		SingularName: "ingroute",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("npsim")] = metav1.APIResource{
+		Name:         "npsim",
+		Kind:         "NetworkPolicySimulation",
+		SingularName: "npsim",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("helmhistory")] = metav1.APIResource{
+		Name:         "helmhistory",
+		Kind:         "HelmHistory",
+		SingularName: "helmhistory",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("podsched")] = metav1.APIResource{
+		Name:         "podsched",
+		Kind:         "PodSchedule",
+		SingularName: "podsched",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("alerts")] = metav1.APIResource{
+		Name:         "alerts",
+		Kind:         "Alerts",
+		SingularName: "alert",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("audit")] = metav1.APIResource{
+		Name:         "audit",
+		Kind:         "Audit",
+		SingularName: "audit",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("lint")] = metav1.APIResource{
+		Name:         "lint",
+		Kind:         "Lint",
+		SingularName: "lint",
+		Namespaced:   true,
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("deprecations")] = metav1.APIResource{
+		Name:         "deprecations",
+		Kind:         "Deprecation",
+		SingularName: "deprecation",
+		ShortNames:   []string{"deps"},
+		Namespaced:   true,
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("tlscerts")] = metav1.APIResource{
+		Name:         "tlscerts",
+		Kind:         "TLSCert",
+		SingularName: "tlscert",
+		ShortNames:   []string{"tls"},
+		Namespaced:   true,
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("explain")] = metav1.APIResource{
+		Name:         "explain",
+		Kind:         "Explain",
+		SingularName: "explain",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("stats")] = metav1.APIResource{
+		Name:         "stats",
+		Kind:         "Stats",
+		SingularName: "stat",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("nsusage")] = metav1.APIResource{
+		Name:         "nsusage",
+		Kind:         "NamespaceUsage",
+		SingularName: "nsusage",
+		ShortNames:   []string{"nsu", "tenants"},
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("faults")] = metav1.APIResource{
+		Name:         "faults",
+		Kind:         "Faults",
+		SingularName: "fault",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("debug")] = metav1.APIResource{
+		Name:         "debug",
+		Kind:         "Debug",
+		SingularName: "debug",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
 	m[client.NewGVR("aliases")] = metav1.APIResource{
 		Name:         "aliases",
 		Kind:         "Aliases",
@@ -285,6 +464,15 @@ func loadK9s(m ResourceMetas) {
 		Verbs:        []string{"delete"},
 		Categories:   []string{"k9s"},
 	}
+	m[client.NewGVR("top")] = metav1.APIResource{
+		Name:         "top",
+		Kind:         "Top",
+		SingularName: "top",
+		ShortNames:   []string{"ctop"},
+		Namespaced:   true,
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
 	m[client.NewGVR("containers")] = metav1.APIResource{
 		Name:         "containers",
 		Kind:         "Containers",
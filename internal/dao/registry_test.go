@@ -6,9 +6,11 @@ import (
 	"os"
 	"testing"
 
+	"github.com/derailed/k9s/internal/client"
 	"github.com/stretchr/testify/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
 func TestExtractMeta(t *testing.T) {
@@ -98,3 +100,71 @@ func load(t *testing.T, n string) *unstructured.Unstructured {
 
 	return &o
 }
+
+func TestMetaGVRForKind(t *testing.T) {
+	m := NewMeta()
+	m.RegisterMeta("apps/v1/deployments", metav1.APIResource{Name: "deployments", Kind: "Deployment", Group: "apps", Version: "v1"})
+	m.RegisterMeta("apps/v1/deployments/scale", metav1.APIResource{Name: "deployments/scale", Kind: "Scale", Group: "apps", Version: "v1"})
+	m.RegisterMeta("v1/pods", metav1.APIResource{Name: "pods", Kind: "Pod", Version: "v1"})
+	m.RegisterMeta("autoscaling/v1/horizontalpodautoscalers", metav1.APIResource{Name: "horizontalpodautoscalers", Kind: "HorizontalPodAutoscaler", Group: "autoscaling", Version: "v1"})
+	m.RegisterMeta("autoscaling/v2/horizontalpodautoscalers", metav1.APIResource{Name: "horizontalpodautoscalers", Kind: "HorizontalPodAutoscaler", Group: "autoscaling", Version: "v2"})
+	m.preferred[schema.GroupKind{Group: "autoscaling", Kind: "HorizontalPodAutoscaler"}] = client.NewGVR("autoscaling/v1/horizontalpodautoscalers")
+	m.RegisterMeta("batch/v1beta1/cronjobs", metav1.APIResource{Name: "cronjobs", Kind: "CronJob", Group: "batch", Version: "v1beta1"})
+	m.RegisterMeta("batch/v1/cronjobs", metav1.APIResource{Name: "cronjobs", Kind: "CronJob", Group: "batch", Version: "v1"})
+
+	uu := map[string]struct {
+		apiVersion, kind string
+		gvr              string
+		ok               bool
+	}{
+		"core": {
+			apiVersion: "v1",
+			kind:       "Pod",
+			gvr:        "v1/pods",
+			ok:         true,
+		},
+		"grouped": {
+			apiVersion: "apps/v1",
+			kind:       "Deployment",
+			gvr:        "apps/v1/deployments",
+			ok:         true,
+		},
+		"exactVersion": {
+			apiVersion: "autoscaling/v2",
+			kind:       "HorizontalPodAutoscaler",
+			gvr:        "autoscaling/v2/horizontalpodautoscalers",
+			ok:         true,
+		},
+		"preferred": {
+			apiVersion: "autoscaling/v2beta2",
+			kind:       "HorizontalPodAutoscaler",
+			gvr:        "autoscaling/v1/horizontalpodautoscalers",
+			ok:         true,
+		},
+		"highestVersion": {
+			apiVersion: "batch/v2alpha1",
+			kind:       "CronJob",
+			gvr:        "batch/v1/cronjobs",
+			ok:         true,
+		},
+		"wrongGroup": {
+			apiVersion: "extensions/v1beta1",
+			kind:       "Deployment",
+		},
+		"missing": {
+			apiVersion: "v1",
+			kind:       "Fred",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			gvr, ok := m.GVRForKind(u.apiVersion, u.kind)
+			assert.Equal(t, u.ok, ok)
+			if u.ok {
+				assert.Equal(t, u.gvr, gvr.String())
+			}
+		})
+	}
+}
@@ -5,8 +5,13 @@ import (
 	"fmt"
 
 	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 )
 
 var (
@@ -20,27 +25,98 @@ type Resource struct {
 	Generic
 }
 
-// List returns a collection of resources.
+// List returns a collection of resources. Label and field selectors are
+// resolved by the api server when supported by the factory. A page in the
+// context lists a chunk of resources bypassing the informers.
 func (r *Resource) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	if nss := r.fanOut(ctx, ns); len(nss) > 0 {
+		ctx = context.WithValue(ctx, internal.KeyPage, nil)
+		return ListNamespaces(ctx, nss, MaxListWorkers, r.List)
+	}
+	if p, ok := ctx.Value(internal.KeyPage).(*Page); ok && p.Limit > 0 {
+		return r.listPage(ctx, ns, p)
+	}
 	strLabel, _ := ctx.Value(internal.KeyLabels).(string)
+	strField, _ := ctx.Value(internal.KeyFields).(string)
+	if f, ok := r.GetFactory().(SelectorFactory); ok && validSelectors(strLabel, strField) {
+		return f.ListSelected(r.gvr.String(), ns, strLabel, strField)
+	}
+
 	lsel := labels.Everything()
 	if strLabel != "" {
 		if sel, err := labels.Parse(strLabel); err == nil {
 			lsel = sel
 		}
 	}
+	if f, ok := r.GetFactory().(MetadataFactory); ok && strField == "" {
+		if meta, _ := ctx.Value(internal.KeyMetadataOnly).(bool); meta {
+			return r.listMetadata(f, ns, lsel)
+		}
+	}
 
 	return r.GetFactory().List(r.gvr.String(), ns, false, lsel)
 }
 
-// Get returns a resource instance if found, else an error.
-func (r *Resource) Get(_ context.Context, path string) (runtime.Object, error) {
+// listMetadata lists resources metadata as unstructured objects.
+func (r *Resource) listMetadata(f MetadataFactory, ns string, sel labels.Selector) ([]runtime.Object, error) {
+	oo, err := f.ListMetadata(r.gvr.String(), ns, sel)
+	if err != nil {
+		return nil, err
+	}
+	for i, o := range oo {
+		m, err := runtime.DefaultUnstructuredConverter.ToUnstructured(o)
+		if err != nil {
+			return nil, err
+		}
+		oo[i] = &unstructured.Unstructured{Object: m}
+	}
+
+	return oo, nil
+}
+
+// fanOut returns the namespaces to list one by one when namespaced resources
+// may not be listed across all namespaces.
+func (r *Resource) fanOut(ctx context.Context, ns string) []string {
+	if !client.IsAllNamespaces(ns) {
+		return nil
+	}
+	meta, err := MetaAccess.MetaFor(r.gvr)
+	if err != nil || !meta.Namespaced {
+		return nil
+	}
+	if auth, err := r.Client().CanI(client.AllNamespaces, r.gvr.String(), client.ListAccess); err != nil || auth {
+		return nil
+	}
+
+	return accessibleNamespaces(ctx, r.Client())
+}
+
+// validSelectors checks for non blank and well formed selectors.
+func validSelectors(labelSel, fieldSel string) bool {
+	if labelSel == "" && fieldSel == "" {
+		return false
+	}
+	if _, err := labels.Parse(labelSel); err != nil {
+		return false
+	}
+	_, err := fields.ParseSelector(fieldSel)
+
+	return err == nil
+}
+
+// Get returns a resource instance if found, else an error. Resources only
+// cached as metadata are fetched from the api server.
+func (r *Resource) Get(ctx context.Context, path string) (runtime.Object, error) {
+	if f, ok := r.GetFactory().(MetadataFactory); ok && f.IsMetadataOnly(r.gvr.String()) {
+		return r.Generic.Get(ctx, path)
+	}
+
 	return r.GetFactory().Get(r.gvr.String(), path, true, labels.Everything())
 }
 
 // ToYAML returns a resource yaml.
-func (r *Resource) ToYAML(path string, showManaged bool) (string, error) {
-	o, err := r.Get(context.Background(), path)
+func (r *Resource) ToYAML(ctx context.Context, path string, showManaged bool) (string, error) {
+	o, err := r.Get(ctx, path)
 	if err != nil {
 		return "", err
 	}
@@ -51,3 +127,26 @@ func (r *Resource) ToYAML(path string, showManaged bool) (string, error) {
 	}
 	return raw, nil
 }
+
+// patchResources patches the containers resources of a workload pod template.
+func (r *Resource) patchResources(ctx context.Context, path string, specs ResourceSpecs) error {
+	ns, n := client.Namespaced(path)
+	auth, err := r.Client().CanI(ns, r.gvr.String(), []string{client.PatchVerb})
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to patch %s", path)
+	}
+	patch, err := GetTemplateResourcesPatch(specs)
+	if err != nil {
+		return err
+	}
+	dial, err := r.dynClient()
+	if err != nil {
+		return err
+	}
+	_, err = dial.Namespace(ns).Patch(ctx, n, types.StrategicMergePatchType, patch, metav1.PatchOptions{})
+
+	return audited(err, r.Factory, "set-resources", r.GVR(), path, specs)
+}
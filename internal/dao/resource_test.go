@@ -0,0 +1,79 @@
+package dao
+
+import (
+	"context"
+	"testing"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestValidSelectors(t *testing.T) {
+	uu := map[string]struct {
+		labels, fields string
+		e              bool
+	}{
+		"none":      {},
+		"labels":    {labels: "app=fred,env in (dev,prod)", e: true},
+		"fields":    {fields: "spec.nodeName=n1,status.phase!=Running", e: true},
+		"both":      {labels: "app=fred", fields: "spec.nodeName=n1", e: true},
+		"badLabels": {labels: "app=(fred", fields: "spec.nodeName=n1"},
+		"badFields": {labels: "app=fred", fields: "spec.nodeName"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, validSelectors(u.labels, u.fields))
+		})
+	}
+}
+
+func TestResourceListMetadata(t *testing.T) {
+	var r Resource
+	r.Init(metaFactory{}, client.NewGVR("rbac.authorization.k8s.io/v1/roles"))
+	ctx := context.WithValue(context.Background(), internal.KeyMetadataOnly, true)
+	ctx = context.WithValue(ctx, internal.KeyLabels, "app=fred")
+
+	oo, err := r.List(ctx, "blee")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(oo))
+	u, ok := oo[0].(*unstructured.Unstructured)
+	assert.True(t, ok)
+	assert.Equal(t, "blee", u.GetNamespace())
+	assert.Equal(t, "fred", u.GetName())
+	assert.Equal(t, map[string]string{"app": "fred"}, u.GetLabels())
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+type metaFactory struct {
+	Factory
+}
+
+var _ MetadataFactory = metaFactory{}
+
+func (metaFactory) ListMetadata(gvr, ns string, sel labels.Selector) ([]runtime.Object, error) {
+	m := metav1.PartialObjectMetadata{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: ns,
+			Name:      "fred",
+			Labels:    map[string]string{"app": "fred"},
+		},
+	}
+	if !sel.Matches(labels.Set(m.Labels)) {
+		return nil, nil
+	}
+
+	return []runtime.Object{&m}, nil
+}
+
+func (metaFactory) IsMetadataOnly(string) bool {
+	return true
+}
@@ -0,0 +1,75 @@
+package dao
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+
+	"github.com/derailed/k9s/internal/render"
+	v1 "k8s.io/api/core/v1"
+)
+
+// RestartForensics collects the last termination state and previous logs of
+// a pod restarting containers.
+func (p *Pod) RestartForensics(ctx context.Context, path string, tail int64) ([]render.ContainerRestart, error) {
+	pod, err := p.GetInstance(path)
+	if err != nil {
+		return nil, err
+	}
+
+	cc := restartingContainers(path, pod)
+	for i := range cc {
+		req, err := p.Logs(path, &v1.PodLogOptions{
+			Container: cc[i].Container,
+			Previous:  true,
+			TailLines: &tail,
+		})
+		if err != nil {
+			cc[i].LogsErr = err
+			continue
+		}
+		raw, err := req.DoRaw(ctx)
+		if err != nil {
+			cc[i].LogsErr = err
+			continue
+		}
+		cc[i].Logs = logLines(raw)
+	}
+
+	return cc, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func restartingContainers(path string, pod *v1.Pod) []render.ContainerRestart {
+	ss := make([]v1.ContainerStatus, 0, len(pod.Status.InitContainerStatuses)+len(pod.Status.ContainerStatuses))
+	ss = append(ss, pod.Status.InitContainerStatuses...)
+	ss = append(ss, pod.Status.ContainerStatuses...)
+
+	cc := make([]render.ContainerRestart, 0, len(ss))
+	for _, s := range ss {
+		if s.RestartCount == 0 {
+			continue
+		}
+		cc = append(cc, render.ContainerRestart{
+			Pod:       path,
+			Container: s.Name,
+			Restarts:  s.RestartCount,
+			State:     s.State,
+			LastState: s.LastTerminationState.Terminated,
+		})
+	}
+
+	return cc
+}
+
+func logLines(raw []byte) []string {
+	var ll []string
+	scanner := bufio.NewScanner(bytes.NewReader(raw))
+	for scanner.Scan() {
+		ll = append(ll, scanner.Text())
+	}
+
+	return ll
+}
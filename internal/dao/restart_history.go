@@ -0,0 +1,104 @@
+package dao
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	restartHistorySize = 16
+
+	// RestartTrendWindow the period pods restarts trends are computed over.
+	RestartTrendWindow = 15 * time.Minute
+
+	restartHistoryExpiry = 1 * time.Hour
+)
+
+// PodRestarts tracks the pods restart counts observed during this session.
+var PodRestarts = NewRestartHistory(restartHistorySize)
+
+// RestartSample represents a restart count observed at a point in time.
+type RestartSample struct {
+	At    time.Time
+	Count int
+}
+
+type restartSeries struct {
+	samples []RestartSample
+	seen    time.Time
+}
+
+// RestartHistory tracks resources restart counts changes over time.
+type RestartHistory struct {
+	size   int
+	series map[string]*restartSeries
+	mx     sync.RWMutex
+}
+
+// NewRestartHistory returns a new history retaining up to size count changes
+// per resource.
+func NewRestartHistory(size int) *RestartHistory {
+	return &RestartHistory{
+		size:   size,
+		series: make(map[string]*restartSeries),
+	}
+}
+
+// Record records a resource restart count. Only count changes are retained.
+// A count lower than the last one denotes a new resource and resets its history.
+func (h *RestartHistory) Record(id string, count int, at time.Time) {
+	h.mx.Lock()
+	defer h.mx.Unlock()
+
+	s, ok := h.series[id]
+	if !ok {
+		s = &restartSeries{}
+		h.series[id] = s
+	}
+	s.seen = at
+	if n := len(s.samples); n > 0 {
+		last := s.samples[n-1].Count
+		if count == last {
+			return
+		}
+		if count < last {
+			s.samples = s.samples[:0]
+		}
+	}
+	s.samples = append(s.samples, RestartSample{At: at, Count: count})
+	if len(s.samples) > h.size {
+		s.samples = s.samples[len(s.samples)-h.size:]
+	}
+}
+
+// Trend returns the number of restarts recorded since a given time.
+func (h *RestartHistory) Trend(id string, since time.Time) int {
+	h.mx.RLock()
+	defer h.mx.RUnlock()
+
+	s, ok := h.series[id]
+	if !ok || len(s.samples) == 0 {
+		return 0
+	}
+	base := s.samples[0]
+	for _, sample := range s.samples[1:] {
+		if sample.At.After(since) {
+			break
+		}
+		base = sample
+	}
+
+	return s.samples[len(s.samples)-1].Count - base.Count
+}
+
+// Prune evicts resources that have not been observed since a given time.
+func (h *RestartHistory) Prune(since time.Time) {
+	h.mx.Lock()
+	defer h.mx.Unlock()
+
+	for id, s := range h.series {
+		if s.seen.Before(since) {
+			delete(h.series, id)
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package dao
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRestartHistoryTrend(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	uu := map[string]struct {
+		counts []int
+		since  time.Duration
+		e      int
+	}{
+		"none": {
+			since: 15 * time.Minute,
+		},
+		"stable": {
+			counts: []int{3, 3, 3, 3},
+			since:  15 * time.Minute,
+		},
+		"restarting": {
+			counts: []int{0, 1, 1, 3},
+			since:  15 * time.Minute,
+			e:      3,
+		},
+		"window": {
+			counts: []int{1, 2, 4, 7},
+			since:  15 * time.Second,
+			e:      3,
+		},
+		"recreated": {
+			counts: []int{5, 6, 0, 2},
+			since:  15 * time.Minute,
+			e:      2,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			h := NewRestartHistory(4)
+			for i, c := range u.counts {
+				h.Record("default/p1", c, now.Add(time.Duration(i)*10*time.Second))
+			}
+			last := now.Add(time.Duration(len(u.counts)) * 10 * time.Second)
+			assert.Equal(t, u.e, h.Trend("default/p1", last.Add(-u.since)))
+		})
+	}
+}
+
+func TestRestartHistorySize(t *testing.T) {
+	now := time.Now()
+	h := NewRestartHistory(2)
+	for i := 0; i < 5; i++ {
+		h.Record("default/p1", i, now.Add(time.Duration(i)*time.Second))
+	}
+
+	assert.Equal(t, 2, len(h.series["default/p1"].samples))
+	assert.Equal(t, 1, h.Trend("default/p1", now.Add(-time.Hour)))
+}
+
+func TestRestartHistoryPrune(t *testing.T) {
+	now := time.Now()
+	h := NewRestartHistory(4)
+	h.Record("default/p1", 1, now.Add(-2*time.Hour))
+	h.Record("default/p2", 1, now)
+	h.Prune(now.Add(-time.Hour))
+
+	assert.Equal(t, 1, len(h.series))
+	_, ok := h.series["default/p2"]
+	assert.True(t, ok)
+}
@@ -0,0 +1,127 @@
+package dao
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/derailed/k9s/internal/client"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+	"k8s.io/kubectl/pkg/polymorphichelpers"
+)
+
+// Revision represents a workload rollout revision.
+type Revision struct {
+	Number      int64
+	ChangeCause string
+	Images      []string
+}
+
+// Revisions represents a collection of revisions.
+type Revisions []Revision
+
+// RolloutHistory returns a workload rollout revisions, latest first.
+func RolloutHistory(c client.Connection, gk schema.GroupKind, path string) (Revisions, error) {
+	ns, n := client.Namespaced(path)
+	if err := canRollout(c, ns, gk, client.GetVerb); err != nil {
+		return nil, err
+	}
+	dial, err := c.Dial()
+	if err != nil {
+		return nil, err
+	}
+	hv, err := polymorphichelpers.HistoryViewerFor(gk, dial)
+	if err != nil {
+		return nil, err
+	}
+	hh, err := hv.GetHistory(ns, n)
+	if err != nil {
+		return nil, err
+	}
+
+	return toRevisions(hh), nil
+}
+
+// RolloutUndo rolls a workload back to a given revision. A zero revision
+// rolls back to the previous one.
+func RolloutUndo(c client.Connection, gk schema.GroupKind, o runtime.Object, revision int64) error {
+	m, err := meta.Accessor(o)
+	if err != nil {
+		return err
+	}
+	if err := canRollout(c, m.GetNamespace(), gk, client.PatchVerb); err != nil {
+		return err
+	}
+	dial, err := c.Dial()
+	if err != nil {
+		return err
+	}
+	rb, err := polymorphichelpers.RollbackerFor(gk, dial)
+	if err != nil {
+		return err
+	}
+	_, err = rb.Rollback(o, map[string]string{}, revision, cmdutil.DryRunNone)
+
+	return err
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func canRollout(c client.Connection, ns string, gk schema.GroupKind, verb string) error {
+	res := map[string]string{
+		"Deployment":  "apps/v1/deployments",
+		"DaemonSet":   "apps/v1/daemonsets",
+		"StatefulSet": "apps/v1/statefulsets",
+	}[gk.Kind]
+	if res == "" {
+		return fmt.Errorf("no rollout support for %s", gk)
+	}
+	auth, err := c.CanI(ns, res, []string{verb})
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to %s %s rollouts", verb, gk.Kind)
+	}
+
+	return nil
+}
+
+func toRevisions(hh map[int64]runtime.Object) Revisions {
+	rr := make(Revisions, 0, len(hh))
+	for rev, o := range hh {
+		r := Revision{Number: rev}
+		if m, err := meta.Accessor(o); err == nil {
+			r.ChangeCause = m.GetAnnotations()[polymorphichelpers.ChangeCauseAnnotation]
+		}
+		if tpl := podTemplate(o); tpl != nil {
+			for _, co := range tpl.Spec.Containers {
+				r.Images = append(r.Images, co.Image)
+			}
+		}
+		rr = append(rr, r)
+	}
+	sort.Slice(rr, func(i, j int) bool {
+		return rr[i].Number > rr[j].Number
+	})
+
+	return rr
+}
+
+func podTemplate(o runtime.Object) *v1.PodTemplateSpec {
+	switch r := o.(type) {
+	case *appsv1.ReplicaSet:
+		return &r.Spec.Template
+	case *appsv1.DaemonSet:
+		return &r.Spec.Template
+	case *appsv1.StatefulSet:
+		return &r.Spec.Template
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,37 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestToRevisions(t *testing.T) {
+	rs := func(cause, image string) *appsv1.ReplicaSet {
+		return &appsv1.ReplicaSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{"kubernetes.io/change-cause": cause},
+			},
+			Spec: appsv1.ReplicaSetSpec{
+				Template: v1.PodTemplateSpec{
+					Spec: v1.PodSpec{Containers: []v1.Container{{Name: "c1", Image: image}}},
+				},
+			},
+		}
+	}
+	hh := map[int64]runtime.Object{
+		1: rs("initial", "fred:0.1"),
+		3: rs("", "fred:0.3"),
+		2: rs("bump", "fred:0.2"),
+	}
+
+	assert.Equal(t, Revisions{
+		{Number: 3, Images: []string{"fred:0.3"}},
+		{Number: 2, ChangeCause: "bump", Images: []string{"fred:0.2"}},
+		{Number: 1, ChangeCause: "initial", Images: []string{"fred:0.1"}},
+	}, toRevisions(hh))
+}
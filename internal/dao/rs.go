@@ -102,9 +102,6 @@ func (r *ReplicaSet) Rollback(fqn string) error {
 	}
 
 	_, err = rb.Rollback(dp, map[string]string{}, version, cmdutil.DryRunNone)
-	if err != nil {
-		return err
-	}
 
-	return nil
+	return audited(err, r.Factory, "rollback", r.GVR(), fqn, map[string]int64{"revision": version})
 }
@@ -0,0 +1,96 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	authv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+var (
+	_ Accessor = (*ServiceAccount)(nil)
+	_ Tokener  = (*ServiceAccount)(nil)
+)
+
+// ServiceAccount represents a service account K8s resource.
+type ServiceAccount struct {
+	Resource
+}
+
+// Token mints a short-lived token for a given service account.
+func (s *ServiceAccount) Token(ctx context.Context, path string, ttl time.Duration, audiences []string) (string, time.Time, error) {
+	ns, n := client.Namespaced(path)
+	auth, err := s.Client().CanI(ns, "v1/serviceaccounts:token", []string{client.CreateVerb})
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	if !auth {
+		return "", time.Time{}, fmt.Errorf("user is not authorized to request tokens for %s", path)
+	}
+
+	dial, err := s.Client().Dial()
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	secs := int64(ttl.Seconds())
+	req := authv1.TokenRequest{
+		Spec: authv1.TokenRequestSpec{
+			Audiences:         audiences,
+			ExpirationSeconds: &secs,
+		},
+	}
+	res, err := dial.CoreV1().ServiceAccounts(ns).CreateToken(ctx, n, &req, metav1.CreateOptions{})
+	if err := audited(err, s.Factory, "create-token", s.GVR(), path, map[string]interface{}{"ttl": ttl.String(), "audiences": audiences}); err != nil {
+		return "", time.Time{}, err
+	}
+
+	return res.Status.Token, res.Status.ExpirationTimestamp.Time, nil
+}
+
+// KubeConfig returns a kubeconfig for a given service account token on the current cluster.
+func (s *ServiceAccount) KubeConfig(path, token string) ([]byte, error) {
+	raw, err := s.Client().Config().RawConfig()
+	if err != nil {
+		return nil, err
+	}
+	cluster, err := s.Client().Config().CurrentClusterName()
+	if err != nil {
+		return nil, err
+	}
+
+	return SAKubeConfig(&raw, cluster, path, token)
+}
+
+// SAKubeConfig generates a kubeconfig granting a service account token access to a given cluster.
+func SAKubeConfig(raw *clientcmdapi.Config, cluster, path, token string) ([]byte, error) {
+	c, ok := raw.Clusters[cluster]
+	if !ok {
+		return nil, fmt.Errorf("no cluster named %q found in kubeconfig", cluster)
+	}
+	ns, n := client.Namespaced(path)
+	user, ctx := n, n+"@"+cluster
+
+	cfg := clientcmdapi.NewConfig()
+	cfg.Clusters[cluster] = &clientcmdapi.Cluster{
+		Server:                   c.Server,
+		TLSServerName:            c.TLSServerName,
+		InsecureSkipTLSVerify:    c.InsecureSkipTLSVerify,
+		CertificateAuthority:     c.CertificateAuthority,
+		CertificateAuthorityData: c.CertificateAuthorityData,
+		ProxyURL:                 c.ProxyURL,
+	}
+	cfg.AuthInfos[user] = &clientcmdapi.AuthInfo{Token: token}
+	cfg.Contexts[ctx] = &clientcmdapi.Context{
+		Cluster:   cluster,
+		AuthInfo:  user,
+		Namespace: ns,
+	}
+	cfg.CurrentContext = ctx
+
+	return clientcmd.Write(*cfg)
+}
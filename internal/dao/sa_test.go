@@ -0,0 +1,32 @@
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+func TestSAKubeConfig(t *testing.T) {
+	raw := clientcmdapi.NewConfig()
+	raw.Clusters["c1"] = &clientcmdapi.Cluster{
+		Server:                   "https://c1:6443",
+		CertificateAuthorityData: []byte("ca"),
+	}
+
+	bb, err := dao.SAKubeConfig(raw, "c1", "ns1/sa1", "t0k3n")
+	assert.Nil(t, err)
+
+	cfg, err := clientcmd.Load(bb)
+	assert.Nil(t, err)
+	assert.Equal(t, "sa1@c1", cfg.CurrentContext)
+	assert.Equal(t, "https://c1:6443", cfg.Clusters["c1"].Server)
+	assert.Equal(t, []byte("ca"), cfg.Clusters["c1"].CertificateAuthorityData)
+	assert.Equal(t, "t0k3n", cfg.AuthInfos["sa1"].Token)
+	assert.Equal(t, "ns1", cfg.Contexts["sa1@c1"].Namespace)
+
+	_, err = dao.SAKubeConfig(raw, "c2", "ns1/sa1", "t0k3n")
+	assert.Error(t, err)
+}
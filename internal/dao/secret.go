@@ -0,0 +1,95 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/derailed/k9s/internal/client"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var (
+	_ Accessor    = (*Secret)(nil)
+	_ Nuker       = (*Secret)(nil)
+	_ DataPatcher = (*Secret)(nil)
+)
+
+// Secret represents a secret K8s resource.
+type Secret struct {
+	Resource
+}
+
+// Data returns the secret data.
+func (s *Secret) Data(ctx context.Context, path string) (map[string][]byte, error) {
+	o, err := s.Get(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expecting unstructured but got %T", o)
+	}
+	var sec v1.Secret
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &sec); err != nil {
+		return nil, err
+	}
+
+	return sec.Data, nil
+}
+
+// SetKey updates a given secret data key. The value gets encoded on save.
+func (s *Secret) SetKey(ctx context.Context, path, key string, value []byte) error {
+	ns, n := client.Namespaced(path)
+	auth, err := s.Client().CanI(ns, "v1/secrets", []string{client.PatchVerb})
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to patch secret %s", path)
+	}
+
+	patch, err := SecretKeyPatch(key, value)
+	if err != nil {
+		return err
+	}
+	dial, err := s.Client().Dial()
+	if err != nil {
+		return err
+	}
+	_, err = dial.CoreV1().Secrets(ns).Patch(ctx, n, types.MergePatchType, patch, metav1.PatchOptions{})
+
+	return audited(err, s.Factory, "set-key", s.GVR(), path, map[string]string{"key": key})
+}
+
+// SecretKeyPatch builds a merge patch updating a secret data key.
+func SecretKeyPatch(key string, value []byte) ([]byte, error) {
+	if key == "" {
+		return nil, fmt.Errorf("secret key must be specified")
+	}
+	// A nil value would remove the key from the secret.
+	if value == nil {
+		value = []byte{}
+	}
+
+	// Marshaling a byte slice base64 encodes it.
+	return json.Marshal(map[string]interface{}{
+		"data": map[string][]byte{key: value},
+	})
+}
+
+// SortedKeys returns secret data keys in order.
+func SortedKeys(data map[string][]byte) []string {
+	kk := make([]string, 0, len(data))
+	for k := range data {
+		kk = append(kk, k)
+	}
+	sort.Strings(kk)
+
+	return kk
+}
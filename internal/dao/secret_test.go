@@ -0,0 +1,48 @@
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSecretKeyPatch(t *testing.T) {
+	uu := map[string]struct {
+		key   string
+		value []byte
+		e     string
+		err   bool
+	}{
+		"plain": {
+			key:   "password",
+			value: []byte("s3cr3t"),
+			e:     `{"data":{"password":"czNjcjN0"}}`,
+		},
+		"empty": {
+			key: "token",
+			e:   `{"data":{"token":""}}`,
+		},
+		"no-key": {
+			value: []byte("fred"),
+			err:   true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			raw, err := dao.SecretKeyPatch(u.key, u.value)
+			assert.Equal(t, u.err, err != nil)
+			if err == nil {
+				assert.Equal(t, u.e, string(raw))
+			}
+		})
+	}
+}
+
+func TestSortedKeys(t *testing.T) {
+	kk := dao.SortedKeys(map[string][]byte{"b": nil, "c": nil, "a": nil})
+
+	assert.Equal(t, []string{"a", "b", "c"}, kk)
+}
@@ -0,0 +1,91 @@
+package dao
+
+import (
+	"context"
+	"io"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+// SniffCapabilities tracks the capabilities required to capture packets.
+var SniffCapabilities = []v1.Capability{"NET_ADMIN", "NET_RAW"}
+
+// SniffOptions represents a packet capture specification.
+type SniffOptions struct {
+	// Interface the network interface to capture on.
+	Interface string
+
+	// Filter an optional BPF filter expression.
+	Filter string
+}
+
+// Sniff streams a pcap capture taken by a pod container into the given writer.
+func (p *Pod) Sniff(ctx context.Context, path, co string, opts SniffOptions, w io.Writer) error {
+	return ExecIn(ctx, p.Client(), path, co, TcpdumpCmd(opts), nil, w)
+}
+
+// SnifferContainer returns a running ephemeral sniffer container launched
+// with the given image or an empty string if none is available for reuse.
+func (p *Pod) SnifferContainer(path, image string) (string, error) {
+	pod, err := p.GetInstance(path)
+	if err != nil {
+		return "", err
+	}
+
+	return FindSniffer(pod, image), nil
+}
+
+// FindSniffer returns a running ephemeral container granted the capture
+// capabilities with the given image.
+func FindSniffer(pod *v1.Pod, image string) string {
+	running := make(map[string]struct{}, len(pod.Status.EphemeralContainerStatuses))
+	for _, s := range pod.Status.EphemeralContainerStatuses {
+		if s.State.Running != nil {
+			running[s.Name] = struct{}{}
+		}
+	}
+	for _, co := range pod.Spec.EphemeralContainers {
+		if _, ok := running[co.Name]; !ok || co.Image != image {
+			continue
+		}
+		if hasCapabilities(co.SecurityContext, SniffCapabilities) {
+			return co.Name
+		}
+	}
+
+	return ""
+}
+
+func hasCapabilities(sec *v1.SecurityContext, cc []v1.Capability) bool {
+	if sec == nil || sec.Capabilities == nil {
+		return false
+	}
+	for _, c := range cc {
+		var found bool
+		for _, a := range sec.Capabilities.Add {
+			if a == c {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+// TcpdumpCmd returns a tcpdump command line writing a pcap stream to stdout.
+func TcpdumpCmd(opts SniffOptions) []string {
+	iface := opts.Interface
+	if iface == "" {
+		iface = "any"
+	}
+	cmd := []string{"tcpdump", "-i", iface, "-U", "-w", "-"}
+	if opts.Filter != "" {
+		cmd = append(cmd, opts.Filter)
+	}
+
+	return cmd
+}
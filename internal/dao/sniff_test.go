@@ -0,0 +1,90 @@
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+)
+
+func TestTcpdumpCmd(t *testing.T) {
+	uu := map[string]struct {
+		opts dao.SniffOptions
+		e    []string
+	}{
+		"default": {
+			e: []string{"tcpdump", "-i", "any", "-U", "-w", "-"},
+		},
+		"filter": {
+			opts: dao.SniffOptions{Interface: "eth0", Filter: "tcp port 80"},
+			e:    []string{"tcpdump", "-i", "eth0", "-U", "-w", "-", "tcp port 80"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, dao.TcpdumpCmd(u.opts))
+		})
+	}
+}
+
+func TestFindSniffer(t *testing.T) {
+	sniffer := func(n, img string, cc ...v1.Capability) v1.EphemeralContainer {
+		co := v1.EphemeralContainer{}
+		co.Name, co.Image = n, img
+		if len(cc) > 0 {
+			co.SecurityContext = &v1.SecurityContext{Capabilities: &v1.Capabilities{Add: cc}}
+		}
+		return co
+	}
+	status := func(n string, running bool) v1.ContainerStatus {
+		s := v1.ContainerStatus{Name: n}
+		if running {
+			s.State.Running = &v1.ContainerStateRunning{}
+		} else {
+			s.State.Terminated = &v1.ContainerStateTerminated{}
+		}
+		return s
+	}
+
+	uu := map[string]struct {
+		cc []v1.EphemeralContainer
+		ss []v1.ContainerStatus
+		e  string
+	}{
+		"none": {},
+		"running": {
+			cc: []v1.EphemeralContainer{sniffer("d1", "netshoot", dao.SniffCapabilities...)},
+			ss: []v1.ContainerStatus{status("d1", true)},
+			e:  "d1",
+		},
+		"terminated": {
+			cc: []v1.EphemeralContainer{sniffer("d1", "netshoot", dao.SniffCapabilities...)},
+			ss: []v1.ContainerStatus{status("d1", false)},
+		},
+		"image": {
+			cc: []v1.EphemeralContainer{sniffer("d1", "busybox", dao.SniffCapabilities...)},
+			ss: []v1.ContainerStatus{status("d1", true)},
+		},
+		"caps": {
+			cc: []v1.EphemeralContainer{
+				sniffer("d1", "netshoot"),
+				sniffer("d2", "netshoot", "NET_RAW"),
+				sniffer("d3", "netshoot", "NET_RAW", "NET_ADMIN"),
+			},
+			ss: []v1.ContainerStatus{status("d1", true), status("d2", true), status("d3", true)},
+			e:  "d3",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var po v1.Pod
+			po.Spec.EphemeralContainers, po.Status.EphemeralContainerStatuses = u.cc, u.ss
+			assert.Equal(t, u.e, dao.FindSniffer(&po, "netshoot"))
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package dao
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ Accessor = (*Stats)(nil)
+
+// Stats represents the api calls telemetry.
+type Stats struct {
+	NonResource
+}
+
+// List returns the api calls stats per verb and resource.
+func (s *Stats) List(context.Context, string) ([]runtime.Object, error) {
+	ss := client.APITelemetry().Stats()
+	oo := make([]runtime.Object, 0, len(ss))
+	for _, st := range ss {
+		oo = append(oo, render.StatsRes{APIStat: st})
+	}
+
+	return oo, nil
+}
@@ -25,9 +25,12 @@ var (
 	_ Nuker           = (*StatefulSet)(nil)
 	_ Loggable        = (*StatefulSet)(nil)
 	_ Restartable     = (*StatefulSet)(nil)
+	_ Rollbackable    = (*StatefulSet)(nil)
 	_ Scalable        = (*StatefulSet)(nil)
 	_ Controller      = (*StatefulSet)(nil)
 	_ ContainsPodSpec = (*StatefulSet)(nil)
+	_ EnvEditor       = (*StatefulSet)(nil)
+	_ ResourcesEditor = (*StatefulSet)(nil)
 )
 
 // StatefulSet represents a K8s sts.
@@ -62,7 +65,7 @@ func (s *StatefulSet) Scale(ctx context.Context, path string, replicas int32) er
 	scale.Spec.Replicas = replicas
 	_, err = dial.AppsV1().StatefulSets(ns).UpdateScale(ctx, n, scale, metav1.UpdateOptions{})
 
-	return err
+	return audited(err, s.Factory, "scale", s.GVR(), path, map[string]int32{"replicas": replicas})
 }
 
 // Restart a StatefulSet rollout.
@@ -111,8 +114,24 @@ func (s *StatefulSet) Restart(ctx context.Context, path string) error {
 		metav1.PatchOptions{},
 	)
 
-	return err
+	return audited(err, s.Factory, "restart", s.GVR(), path, nil)
+}
+
+// History returns a StatefulSet rollout revisions.
+func (s *StatefulSet) History(ctx context.Context, path string) (Revisions, error) {
+	return RolloutHistory(s.Client(), appsv1.SchemeGroupVersion.WithKind("StatefulSet").GroupKind(), path)
+}
+
+// Undo rolls a StatefulSet back to a given revision.
+func (s *StatefulSet) Undo(ctx context.Context, path string, revision int64) error {
+	sts, err := s.Load(s.Factory, path)
+	if err != nil {
+		return err
+	}
+
+	err = RolloutUndo(s.Client(), appsv1.SchemeGroupVersion.WithKind("StatefulSet").GroupKind(), sts, revision)
 
+	return audited(err, s.Factory, "undo", s.GVR(), path, map[string]int64{"revision": revision})
 }
 
 // Load returns a statefulset instance.
@@ -212,25 +231,28 @@ func (s *StatefulSet) Scan(ctx context.Context, gvr, fqn string, wait bool) (Ref
 		}
 		switch gvr {
 		case "v1/configmaps":
-			if !hasConfigMap(&sts.Spec.Template.Spec, n) {
+			usage := configMapUsage(&sts.Spec.Template.Spec, n)
+			if usage == "" {
 				continue
 			}
 			refs = append(refs, Ref{
-				GVR: s.GVR(),
-				FQN: client.FQN(sts.Namespace, sts.Name),
+				GVR:   s.GVR(),
+				FQN:   client.FQN(sts.Namespace, sts.Name),
+				Usage: usage,
 			})
 		case "v1/secrets":
-			found, err := hasSecret(s.Factory, &sts.Spec.Template.Spec, sts.Namespace, n, wait)
+			usage, err := secretUsage(s.Factory, &sts.Spec.Template.Spec, sts.Namespace, n, wait)
 			if err != nil {
 				log.Warn().Err(err).Msgf("locate secret %q", fqn)
 				continue
 			}
-			if !found {
+			if usage == "" {
 				continue
 			}
 			refs = append(refs, Ref{
-				GVR: s.GVR(),
-				FQN: client.FQN(sts.Namespace, sts.Name),
+				GVR:   s.GVR(),
+				FQN:   client.FQN(sts.Namespace, sts.Name),
+				Usage: usage,
 			})
 		case "v1/persistentvolumeclaims":
 			for _, v := range sts.Spec.VolumeClaimTemplates {
@@ -299,5 +321,38 @@ func (s *StatefulSet) SetImages(ctx context.Context, path string, imageSpecs Ima
 		jsonPatch,
 		metav1.PatchOptions{},
 	)
-	return err
+	return audited(err, s.Factory, "set-image", s.GVR(), path, imageSpecs)
+}
+
+// SetResources sets container resources requests and limits.
+func (s *StatefulSet) SetResources(ctx context.Context, path string, specs ResourceSpecs) error {
+	return s.patchResources(ctx, path, specs)
+}
+
+// SetEnv updates a container environment variables.
+func (s *StatefulSet) SetEnv(ctx context.Context, path string, spec EnvSpec) error {
+	ns, n := client.Namespaced(path)
+	auth, err := s.Client().CanI(ns, "apps/v1/statefulsets", []string{client.PatchVerb})
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to patch a statefulset")
+	}
+	jsonPatch, err := GetTemplateEnvPatch(spec)
+	if err != nil {
+		return err
+	}
+	dial, err := s.Client().Dial()
+	if err != nil {
+		return err
+	}
+	_, err = dial.AppsV1().StatefulSets(ns).Patch(
+		ctx,
+		n,
+		types.StrategicMergePatchType,
+		jsonPatch,
+		metav1.PatchOptions{},
+	)
+	return audited(err, s.Factory, "set-env", s.GVR(), path, envAudit(spec))
 }
@@ -0,0 +1,283 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Ordinal represents a statefulset replica.
+type Ordinal struct {
+	Ordinal int            `json:"ordinal"`
+	Pod     string         `json:"pod"`
+	Phase   string         `json:"phase"`
+	Ready   bool           `json:"ready"`
+	Node    string         `json:"node,omitempty"`
+	Claims  []OrdinalClaim `json:"claims,omitempty"`
+}
+
+// OrdinalClaim represents a statefulset replica volume claim binding.
+type OrdinalClaim struct {
+	Name     string `json:"name"`
+	Status   string `json:"status"`
+	Volume   string `json:"volume,omitempty"`
+	Capacity string `json:"capacity,omitempty"`
+}
+
+// List returns a collection of statefulsets along with their ready ordinals.
+func (s *StatefulSet) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	oo, err := s.Resource.List(ctx, ns)
+	if err != nil {
+		return oo, err
+	}
+
+	ready, err := s.readyOrdinals(ns)
+	if err != nil {
+		log.Warn().Err(err).Msgf("Unable to list statefulset pods")
+	}
+	res := make([]runtime.Object, 0, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return res, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+		}
+		sts := render.StatefulSetWithOrdinals{Raw: u}
+		if err == nil {
+			sts.Ready = ready[client.FQN(u.GetNamespace(), u.GetName())]
+			if sts.Ready == nil {
+				sts.Ready = make(map[int]bool)
+			}
+		}
+		res = append(res, &sts)
+	}
+
+	return res, nil
+}
+
+// readyOrdinals returns the pods readiness by ordinal keyed by statefulset.
+func (s *StatefulSet) readyOrdinals(ns string) (map[string]map[int]bool, error) {
+	oo, err := s.GetFactory().List("v1/pods", ns, false, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	ready := make(map[string]map[int]bool)
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			continue
+		}
+		owner, ok := stsOwner(u.GetOwnerReferences())
+		if !ok {
+			continue
+		}
+		idx, ok := stsOrdinal(owner, u.GetName())
+		if !ok {
+			continue
+		}
+		var po v1.Pod
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &po); err != nil {
+			continue
+		}
+		fqn := client.FQN(u.GetNamespace(), owner)
+		if _, ok := ready[fqn]; !ok {
+			ready[fqn] = make(map[int]bool)
+		}
+		ready[fqn][idx] = isPodReady(&po)
+	}
+
+	return ready, nil
+}
+
+// Ordinals returns a statefulset replicas and their volume claims bindings.
+// Pods lingering past the desired replicas are included.
+func (s *StatefulSet) Ordinals(path string) ([]Ordinal, error) {
+	sts, err := s.Load(s.Factory, path)
+	if err != nil {
+		return nil, err
+	}
+	oo, err := s.GetFactory().List("v1/pods", sts.Namespace, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	pods := make(map[int]*v1.Pod)
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			continue
+		}
+		if owner, ok := stsOwner(u.GetOwnerReferences()); !ok || owner != sts.Name {
+			continue
+		}
+		idx, ok := stsOrdinal(sts.Name, u.GetName())
+		if !ok {
+			continue
+		}
+		var po v1.Pod
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &po); err != nil {
+			return nil, err
+		}
+		pods[idx] = &po
+	}
+
+	ii := render.StatefulSetOrdinals(sts, stsReplicas(sts))
+	seen := make(map[int]struct{}, len(ii))
+	for _, i := range ii {
+		seen[i] = struct{}{}
+	}
+	for i := range pods {
+		if _, ok := seen[i]; !ok {
+			ii = append(ii, i)
+		}
+	}
+
+	res := make([]Ordinal, 0, len(ii))
+	for _, i := range ii {
+		o := Ordinal{
+			Ordinal: i,
+			Pod:     stsPodName(sts.Name, i),
+			Phase:   render.MissingValue,
+		}
+		if po, ok := pods[i]; ok {
+			o.Phase, o.Ready, o.Node = string(po.Status.Phase), isPodReady(po), po.Spec.NodeName
+		}
+		for _, t := range sts.Spec.VolumeClaimTemplates {
+			o.Claims = append(o.Claims, s.ordinalClaim(sts.Namespace, t.Name+"-"+o.Pod))
+		}
+		res = append(res, o)
+	}
+
+	return res, nil
+}
+
+func (s *StatefulSet) ordinalClaim(ns, n string) OrdinalClaim {
+	c := OrdinalClaim{Name: n, Status: render.MissingValue}
+	o, err := s.GetFactory().Get("v1/persistentvolumeclaims", client.FQN(ns, n), true, labels.Everything())
+	if err != nil {
+		return c
+	}
+	var pvc v1.PersistentVolumeClaim
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &pvc); err != nil {
+		return c
+	}
+	c.Status, c.Volume = string(pvc.Status.Phase), pvc.Spec.VolumeName
+	if q, ok := pvc.Status.Capacity[v1.ResourceStorage]; ok {
+		c.Capacity = q.String()
+	}
+
+	return c
+}
+
+// RestartOrdinal deletes a statefulset replica pod so it gets recreated.
+func (s *StatefulSet) RestartOrdinal(ctx context.Context, path string, ordinal int) error {
+	sts, err := s.Load(s.Factory, path)
+	if err != nil {
+		return err
+	}
+	if !hasOrdinal(render.StatefulSetOrdinals(sts, stsReplicas(sts)), ordinal) {
+		return fmt.Errorf("ordinal %d is not a replica of statefulset %s", ordinal, path)
+	}
+
+	auth, err := s.Client().CanI(sts.Namespace, "v1/pods", []string{client.DeleteVerb})
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to delete pods")
+	}
+	dial, err := s.Client().Dial()
+	if err != nil {
+		return err
+	}
+	po := stsPodName(sts.Name, ordinal)
+	err = dial.CoreV1().Pods(sts.Namespace).Delete(ctx, po, metav1.DeleteOptions{})
+
+	return audited(err, s.Factory, "restart", "v1/pods", client.FQN(sts.Namespace, po), map[string]int{"ordinal": ordinal})
+}
+
+// OrdinalScaleImpact describes the ordinals added or removed when scaling a
+// statefulset to a given replica count.
+func OrdinalScaleImpact(sts *appsv1.StatefulSet, replicas int32) string {
+	cur := render.StatefulSetOrdinals(sts, stsReplicas(sts))
+	next := render.StatefulSetOrdinals(sts, replicas)
+	switch {
+	case len(next) > len(cur):
+		return "Adds ordinals " + render.OrdinalRanges(next[len(cur):])
+	case len(next) < len(cur):
+		gone := cur[len(next):]
+		nn := make([]string, 0, len(gone))
+		for i := len(gone) - 1; i >= 0; i-- {
+			nn = append(nn, strconv.Itoa(gone[i]))
+		}
+		msg := "Removes ordinals " + strings.Join(nn, ",")
+		if len(sts.Spec.VolumeClaimTemplates) == 0 {
+			return msg
+		}
+		if p := sts.Spec.PersistentVolumeClaimRetentionPolicy; p != nil && p.WhenScaled == appsv1.DeletePersistentVolumeClaimRetentionPolicyType {
+			return msg + " and deletes their volume claims"
+		}
+		return msg + " and retains their volume claims"
+	default:
+		return ""
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func stsReplicas(sts *appsv1.StatefulSet) int32 {
+	if sts.Spec.Replicas == nil {
+		return 1
+	}
+
+	return *sts.Spec.Replicas
+}
+
+func stsPodName(sts string, ordinal int) string {
+	return sts + "-" + strconv.Itoa(ordinal)
+}
+
+func stsOwner(refs []metav1.OwnerReference) (string, bool) {
+	for _, r := range refs {
+		if r.Kind == "StatefulSet" && r.Controller != nil && *r.Controller {
+			return r.Name, true
+		}
+	}
+
+	return "", false
+}
+
+// stsOrdinal extracts a statefulset pod ordinal from its name.
+func stsOrdinal(sts, pod string) (int, bool) {
+	if !strings.HasPrefix(pod, sts+"-") {
+		return 0, false
+	}
+	i, err := strconv.Atoi(strings.TrimPrefix(pod, sts+"-"))
+	if err != nil || i < 0 {
+		return 0, false
+	}
+
+	return i, true
+}
+
+func hasOrdinal(ii []int, ordinal int) bool {
+	for _, i := range ii {
+		if i == ordinal {
+			return true
+		}
+	}
+
+	return false
+}
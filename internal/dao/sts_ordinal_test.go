@@ -0,0 +1,118 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestOrdinalScaleImpact(t *testing.T) {
+	uu := map[string]struct {
+		replicas, to int32
+		start        int32
+		claims       bool
+		policy       appsv1.PersistentVolumeClaimRetentionPolicyType
+		e            string
+	}{
+		"same": {
+			replicas: 3, to: 3,
+		},
+		"up": {
+			replicas: 3, to: 5,
+			e: "Adds ordinals 3-4",
+		},
+		"up-start": {
+			replicas: 1, to: 2, start: 5,
+			e: "Adds ordinals 6",
+		},
+		"down": {
+			replicas: 4, to: 1,
+			e: "Removes ordinals 3,2,1",
+		},
+		"down-retain": {
+			replicas: 2, to: 1, claims: true,
+			e: "Removes ordinals 1 and retains their volume claims",
+		},
+		"down-delete": {
+			replicas: 2, to: 0, claims: true, policy: appsv1.DeletePersistentVolumeClaimRetentionPolicyType,
+			e: "Removes ordinals 1,0 and deletes their volume claims",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var sts appsv1.StatefulSet
+			sts.Spec.Replicas = &u.replicas
+			if u.start > 0 {
+				sts.Spec.Ordinals = &appsv1.StatefulSetOrdinals{Start: u.start}
+			}
+			if u.claims {
+				sts.Spec.VolumeClaimTemplates = []v1.PersistentVolumeClaim{{}}
+			}
+			if u.policy != "" {
+				sts.Spec.PersistentVolumeClaimRetentionPolicy = &appsv1.StatefulSetPersistentVolumeClaimRetentionPolicy{WhenScaled: u.policy}
+			}
+			assert.Equal(t, u.e, OrdinalScaleImpact(&sts, u.to))
+		})
+	}
+}
+
+func TestStsOrdinal(t *testing.T) {
+	uu := map[string]struct {
+		sts, pod string
+		ok       bool
+		e        int
+	}{
+		"plain":   {sts: "web", pod: "web-2", ok: true, e: 2},
+		"dashed":  {sts: "my-web", pod: "my-web-10", ok: true, e: 10},
+		"other":   {sts: "web", pod: "db-0"},
+		"nested":  {sts: "web", pod: "web-db-0"},
+		"suffix":  {sts: "web", pod: "web-"},
+		"noDash":  {sts: "web", pod: "web0"},
+		"negated": {sts: "web", pod: "web--1"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			i, ok := stsOrdinal(u.sts, u.pod)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.e, i)
+		})
+	}
+}
+
+func TestStsOwner(t *testing.T) {
+	yes, no := true, false
+	uu := map[string]struct {
+		refs []metav1.OwnerReference
+		e    string
+		ok   bool
+	}{
+		"none": {},
+		"sts": {
+			refs: []metav1.OwnerReference{{Kind: "StatefulSet", Name: "web", Controller: &yes}},
+			e:    "web",
+			ok:   true,
+		},
+		"notController": {
+			refs: []metav1.OwnerReference{{Kind: "StatefulSet", Name: "web", Controller: &no}},
+		},
+		"rs": {
+			refs: []metav1.OwnerReference{{Kind: "ReplicaSet", Name: "web", Controller: &yes}},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			n, ok := stsOwner(u.refs)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.e, n)
+		})
+	}
+}
@@ -0,0 +1,197 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	v1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const endpointSlicesGVR = "discovery.k8s.io/v1/endpointslices"
+
+var _ Accessor = (*ServiceEndpoints)(nil)
+
+// ServiceEndpoints represents a service endpoints readiness breakdown.
+type ServiceEndpoints struct {
+	NonResource
+}
+
+// List returns the endpoints backing a given service.
+func (s *ServiceEndpoints) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	path, ok := ctx.Value(internal.KeyPath).(string)
+	if !ok {
+		return nil, errors.New("no context path found")
+	}
+	ns, n := client.Namespaced(path)
+	sel := labels.SelectorFromSet(labels.Set{discoveryv1.LabelServiceName: n})
+	oo, err := s.GetFactory().List(endpointSlicesGVR, ns, false, sel)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]runtime.Object, 0, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting unstructured but got %T", o)
+		}
+		var es discoveryv1.EndpointSlice
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &es); err != nil {
+			return nil, err
+		}
+		res = append(res, s.sliceEndpoints(&es)...)
+	}
+
+	return res, nil
+}
+
+func (s *ServiceEndpoints) sliceEndpoints(es *discoveryv1.EndpointSlice) []runtime.Object {
+	ports := make([]string, 0, len(es.Ports))
+	for _, p := range es.Ports {
+		ports = append(ports, EndpointPort(p))
+	}
+	if len(ports) == 0 {
+		ports = append(ports, render.NAValue)
+	}
+
+	oo := make([]runtime.Object, 0, len(es.Endpoints)*len(ports))
+	for _, ep := range es.Endpoints {
+		res := render.ServiceEndpointRes{
+			Slice:       es.Name,
+			Namespace:   es.Namespace,
+			Address:     strings.Join(ep.Addresses, ","),
+			Ready:       isTrue(ep.Conditions.Ready, true),
+			Serving:     isTrue(ep.Conditions.Serving, true),
+			Terminating: isTrue(ep.Conditions.Terminating, false),
+		}
+		if ep.NodeName != nil {
+			res.Node = *ep.NodeName
+		}
+		if ref := ep.TargetRef; ref != nil && ref.Kind == "Pod" {
+			res.Pod = client.FQN(ref.Namespace, ref.Name)
+			if !res.Ready {
+				res.Reason = s.notReadyReason(res.Pod)
+			}
+		}
+		for _, p := range ports {
+			r := res
+			r.Port = p
+			oo = append(oo, r)
+		}
+	}
+
+	return oo
+}
+
+func (s *ServiceEndpoints) notReadyReason(path string) string {
+	o, err := s.GetFactory().Get("v1/pods", path, false, labels.Everything())
+	if err != nil {
+		return err.Error()
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return ""
+	}
+	var po v1.Pod
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &po); err != nil {
+		return err.Error()
+	}
+
+	return NotReadyReason(&po)
+}
+
+// EndpointPort returns a human readable endpoint port.
+func EndpointPort(p discoveryv1.EndpointPort) string {
+	var port, proto, name string
+	if p.Port != nil {
+		port = strconv.Itoa(int(*p.Port))
+	}
+	if p.Protocol != nil {
+		proto = string(*p.Protocol)
+	}
+	if p.Name != nil && *p.Name != "" {
+		name = *p.Name + ":"
+	}
+
+	return name + port + "/" + proto
+}
+
+// NotReadyReason returns why a pod is not ready based on its status.
+func NotReadyReason(po *v1.Pod) string {
+	if po.DeletionTimestamp != nil {
+		return "Terminating"
+	}
+	for _, cs := range append(po.Status.InitContainerStatuses, po.Status.ContainerStatuses...) {
+		if cs.Ready {
+			continue
+		}
+		switch {
+		case cs.State.Waiting != nil:
+			return fmt.Sprintf("%s: %s", cs.Name, joinReason(cs.State.Waiting.Reason, cs.State.Waiting.Message))
+		case cs.State.Terminated != nil:
+			if cs.State.Terminated.ExitCode == 0 && isInitContainer(po, cs.Name) {
+				continue
+			}
+			return fmt.Sprintf("%s: %s", cs.Name, joinReason(cs.State.Terminated.Reason, cs.State.Terminated.Message))
+		case cs.State.Running != nil:
+			return fmt.Sprintf("%s: readiness probe failing", cs.Name)
+		}
+	}
+	for _, c := range po.Status.Conditions {
+		if c.Status == v1.ConditionTrue {
+			continue
+		}
+		if c.Type == v1.PodScheduled || c.Type == v1.PodReady || c.Type == v1.ContainersReady {
+			if r := joinReason(c.Reason, c.Message); r != "" {
+				return r
+			}
+		}
+	}
+	if po.Status.Reason != "" {
+		return joinReason(po.Status.Reason, po.Status.Message)
+	}
+
+	return string(po.Status.Phase)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func isTrue(b *bool, dflt bool) bool {
+	if b == nil {
+		return dflt
+	}
+
+	return *b
+}
+
+func isInitContainer(po *v1.Pod, n string) bool {
+	for _, c := range po.Spec.InitContainers {
+		if c.Name == n {
+			return true
+		}
+	}
+
+	return false
+}
+
+func joinReason(reason, msg string) string {
+	switch {
+	case reason == "":
+		return msg
+	case msg == "":
+		return reason
+	default:
+		return reason + " - " + msg
+	}
+}
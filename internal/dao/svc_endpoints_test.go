@@ -0,0 +1,66 @@
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+)
+
+func TestNotReadyReason(t *testing.T) {
+	uu := map[string]struct {
+		po v1.Pod
+		e  string
+	}{
+		"waiting": {
+			po: v1.Pod{Status: v1.PodStatus{
+				ContainerStatuses: []v1.ContainerStatus{
+					{Name: "c1", State: v1.ContainerState{Waiting: &v1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}}},
+				},
+			}},
+			e: "c1: CrashLoopBackOff",
+		},
+		"probe": {
+			po: v1.Pod{Status: v1.PodStatus{
+				ContainerStatuses: []v1.ContainerStatus{
+					{Name: "c1", Ready: true, State: v1.ContainerState{Running: &v1.ContainerStateRunning{}}},
+					{Name: "c2", State: v1.ContainerState{Running: &v1.ContainerStateRunning{}}},
+				},
+			}},
+			e: "c2: readiness probe failing",
+		},
+		"condition": {
+			po: v1.Pod{Status: v1.PodStatus{
+				Phase: v1.PodPending,
+				Conditions: []v1.PodCondition{
+					{Type: v1.PodScheduled, Status: v1.ConditionFalse, Reason: "Unschedulable", Message: "0/3 nodes are available"},
+				},
+			}},
+			e: "Unschedulable - 0/3 nodes are available",
+		},
+		"phase": {
+			po: v1.Pod{Status: v1.PodStatus{Phase: v1.PodPending}},
+			e:  "Pending",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, dao.NotReadyReason(&u.po))
+		})
+	}
+}
+
+func TestEndpointPort(t *testing.T) {
+	var (
+		name  = "http"
+		port  = int32(80)
+		proto = v1.ProtocolTCP
+	)
+
+	assert.Equal(t, "http:80/TCP", dao.EndpointPort(discoveryv1.EndpointPort{Name: &name, Port: &port, Protocol: &proto}))
+	assert.Equal(t, "80/TCP", dao.EndpointPort(discoveryv1.EndpointPort{Port: &port, Protocol: &proto}))
+}
@@ -48,7 +48,12 @@ func (t *Table) List(ctx context.Context, ns string) ([]runtime.Object, error) {
 	if !ok {
 		labelSel = ""
 	}
+	fieldSel, _ := ctx.Value(internal.KeyFields).(string)
 
+	return t.list(ctx, ns, metav1.ListOptions{LabelSelector: labelSel, FieldSelector: fieldSel})
+}
+
+func (t *Table) list(ctx context.Context, ns string, opts metav1.ListOptions) ([]runtime.Object, error) {
 	a := fmt.Sprintf(gvFmt, metav1beta1.SchemeGroupVersion.Version, metav1beta1.GroupName)
 	_, codec := t.codec()
 
@@ -56,12 +61,15 @@ func (t *Table) List(ctx context.Context, ns string) ([]runtime.Object, error) {
 	if err != nil {
 		return nil, err
 	}
-	o, err := c.Get().
+	req := c.Get().
 		SetHeader("Accept", a).
 		Namespace(ns).
 		Resource(t.gvr.R()).
-		VersionedParams(&metav1.ListOptions{LabelSelector: labelSel}, codec).
-		Do(ctx).Get()
+		VersionedParams(&opts, codec)
+	if full, _ := ctx.Value(internal.KeyIncludeObject).(bool); full {
+		req = req.Param("includeObject", string(metav1.IncludeObject))
+	}
+	o, err := req.Do(ctx).Get()
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,212 @@
+package dao
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	certManagerGVR  = "cert-manager.io/v1/certificates"
+	certNameAnnot   = "cert-manager.io/certificate-name"
+	certIssuing     = "Issuing"
+	certRenewReason = "ManuallyTriggered"
+)
+
+var _ Accessor = (*TLSCert)(nil)
+
+// TLSCert tracks tls secrets and cert-manager certificates expiry.
+type TLSCert struct {
+	NonResource
+}
+
+// List returns the cert-manager certificates and the tls secrets they do not manage.
+func (t *TLSCert) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	var (
+		oo      []runtime.Object
+		managed = make(map[string]struct{})
+	)
+	if _, err := MetaAccess.MetaFor(client.NewGVR(certManagerGVR)); err == nil {
+		err := listAs(t.Factory, certManagerGVR, ns, func(u *unstructured.Unstructured) error {
+			c := CertManagerCert(u)
+			if c.Secret != "" {
+				managed[client.FQN(c.Namespace, c.Secret)] = struct{}{}
+			}
+			oo = append(oo, c)
+			return nil
+		})
+		if err != nil {
+			log.Warn().Err(err).Msgf("Certificates skipping %s", certManagerGVR)
+		}
+	}
+
+	err := listAs(t.Factory, "v1/secrets", ns, func(u *unstructured.Unstructured) error {
+		if _, ok := managed[client.FQN(u.GetNamespace(), u.GetName())]; ok {
+			return nil
+		}
+		var sec v1.Secret
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &sec); err != nil {
+			return err
+		}
+		if sec.Type != v1.SecretTypeTLS {
+			return nil
+		}
+		c, err := SecretCert(&sec)
+		if err != nil {
+			log.Warn().Err(err).Msgf("Unable to parse certificate in secret %s", client.FQN(sec.Namespace, sec.Name))
+		}
+		oo = append(oo, c)
+		return nil
+	})
+
+	return oo, err
+}
+
+// Renew triggers a cert-manager certificate re-issuance.
+func (t *TLSCert) Renew(ctx context.Context, path string) error {
+	gvr, fqn, ok := strings.Cut(path, "|")
+	if !ok || gvr != certManagerGVR {
+		return errors.New("only cert-manager certificates can be renewed")
+	}
+	ns, n := client.Namespaced(fqn)
+	auth, err := t.Client().CanI(ns, certManagerGVR+":status", []string{client.UpdateVerb})
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to renew certificate %s", fqn)
+	}
+
+	dial, err := t.Client().DynDial()
+	if err != nil {
+		return err
+	}
+	res := dial.Resource(client.NewGVR(certManagerGVR).GVR()).Namespace(ns)
+	u, err := res.Get(ctx, n, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	if err := markIssuing(u, time.Now()); err != nil {
+		return err
+	}
+	_, err = res.UpdateStatus(ctx, u, metav1.UpdateOptions{})
+
+	return audited(err, t.Factory, "renew", certManagerGVR, fqn, nil)
+}
+
+// CertManagerCert returns a cert-manager certificate expiry info.
+func CertManagerCert(u *unstructured.Unstructured) render.TLSCertRes {
+	c := render.TLSCertRes{
+		GVR:       certManagerGVR,
+		Namespace: u.GetNamespace(),
+		Name:      u.GetName(),
+		Kind:      "Certificate",
+	}
+	c.Secret, _, _ = unstructured.NestedString(u.Object, "spec", "secretName")
+	c.Subject, _, _ = unstructured.NestedString(u.Object, "spec", "commonName")
+	c.DNSNames, _, _ = unstructured.NestedStringSlice(u.Object, "spec", "dnsNames")
+	if c.Subject == "" && len(c.DNSNames) > 0 {
+		c.Subject = c.DNSNames[0]
+	}
+	kind, _, _ := unstructured.NestedString(u.Object, "spec", "issuerRef", "kind")
+	name, _, _ := unstructured.NestedString(u.Object, "spec", "issuerRef", "name")
+	if kind == "" {
+		kind = "Issuer"
+	}
+	if name != "" {
+		c.Issuer = kind + "/" + name
+	}
+	if s, _, _ := unstructured.NestedString(u.Object, "status", "notAfter"); s != "" {
+		if t, err := time.Parse(time.RFC3339, s); err == nil {
+			c.NotAfter = t
+		}
+	}
+	cc, _, _ := unstructured.NestedSlice(u.Object, "status", "conditions")
+	for _, raw := range cc {
+		m, ok := raw.(map[string]interface{})
+		if !ok || m["type"] != "Ready" {
+			continue
+		}
+		c.Ready, _ = m["status"].(string)
+	}
+
+	return c
+}
+
+// SecretCert returns a tls secret certificate expiry info.
+func SecretCert(sec *v1.Secret) (render.TLSCertRes, error) {
+	c := render.TLSCertRes{
+		GVR:       "v1/secrets",
+		Namespace: sec.Namespace,
+		Name:      sec.Name,
+		Kind:      "Secret",
+	}
+	cert, err := parseCert(sec.Data[v1.TLSCertKey])
+	if err != nil {
+		return c, err
+	}
+	c.Subject, c.DNSNames, c.NotAfter = cert.Subject.CommonName, cert.DNSNames, cert.NotAfter
+	if c.Subject == "" && len(c.DNSNames) > 0 {
+		c.Subject = c.DNSNames[0]
+	}
+	c.Issuer = cert.Issuer.CommonName
+	if c.Issuer == "" {
+		c.Issuer = cert.Issuer.String()
+	}
+
+	return c, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// parseCert returns the leaf certificate of a pem bundle.
+func parseCert(raw []byte) (*x509.Certificate, error) {
+	block, _ := pem.Decode(raw)
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, errors.New("no pem certificate found")
+	}
+
+	return x509.ParseCertificate(block.Bytes)
+}
+
+// markIssuing flags a cert-manager certificate for re-issuance the way
+// cmctl renew does.
+func markIssuing(u *unstructured.Unstructured, now time.Time) error {
+	cc, _, err := unstructured.NestedSlice(u.Object, "status", "conditions")
+	if err != nil {
+		return err
+	}
+	res := make([]interface{}, 0, len(cc)+1)
+	for _, raw := range cc {
+		m, ok := raw.(map[string]interface{})
+		if !ok || m["type"] != certIssuing {
+			res = append(res, raw)
+			continue
+		}
+		if m["status"] == string(metav1.ConditionTrue) {
+			return fmt.Errorf("certificate %s is already being issued", u.GetName())
+		}
+	}
+	res = append(res, map[string]interface{}{
+		"type":               certIssuing,
+		"status":             string(metav1.ConditionTrue),
+		"reason":             certRenewReason,
+		"message":            "Certificate re-issuance manually triggered",
+		"lastTransitionTime": now.UTC().Format(time.RFC3339),
+	})
+
+	return unstructured.SetNestedSlice(u.Object, res, "status", "conditions")
+}
@@ -0,0 +1,131 @@
+package dao
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestSecretCert(t *testing.T) {
+	notAfter := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+	sec := v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "web-tls"},
+		Type:       v1.SecretTypeTLS,
+		Data:       map[string][]byte{v1.TLSCertKey: makeCert(t, "", []string{"web.example.com"}, notAfter)},
+	}
+
+	c, err := SecretCert(&sec)
+	assert.Nil(t, err)
+	assert.Equal(t, "v1/secrets|default/web-tls", c.ID())
+	assert.Equal(t, "web.example.com", c.Subject)
+	assert.Equal(t, "k9s-ca", c.Issuer)
+	assert.Equal(t, []string{"web.example.com"}, c.DNSNames)
+	assert.True(t, notAfter.Equal(c.NotAfter))
+
+	sec.Data[v1.TLSCertKey] = []byte("bozo")
+	_, err = SecretCert(&sec)
+	assert.Error(t, err)
+}
+
+func TestCertManagerCert(t *testing.T) {
+	u := unstructured.Unstructured{Object: map[string]interface{}{
+		"metadata": map[string]interface{}{"namespace": "default", "name": "web"},
+		"spec": map[string]interface{}{
+			"secretName": "web-tls",
+			"dnsNames":   []interface{}{"web.example.com"},
+			"issuerRef":  map[string]interface{}{"kind": "ClusterIssuer", "name": "le"},
+		},
+		"status": map[string]interface{}{
+			"notAfter":   "2030-01-02T03:04:05Z",
+			"conditions": []interface{}{map[string]interface{}{"type": "Ready", "status": "False"}},
+		},
+	}}
+
+	c := CertManagerCert(&u)
+	assert.Equal(t, "cert-manager.io/v1/certificates|default/web", c.ID())
+	assert.Equal(t, "web-tls", c.Secret)
+	assert.Equal(t, "web.example.com", c.Subject)
+	assert.Equal(t, "ClusterIssuer/le", c.Issuer)
+	assert.Equal(t, "False", c.Ready)
+	assert.Equal(t, time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC), c.NotAfter)
+}
+
+func TestMarkIssuing(t *testing.T) {
+	now := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+	uu := map[string]struct {
+		conditions []interface{}
+		err        string
+		count      int
+	}{
+		"none": {
+			count: 1,
+		},
+		"ready": {
+			conditions: []interface{}{map[string]interface{}{"type": "Ready", "status": "True"}},
+			count:      2,
+		},
+		"stale": {
+			conditions: []interface{}{map[string]interface{}{"type": "Issuing", "status": "False"}},
+			count:      1,
+		},
+		"issuing": {
+			conditions: []interface{}{map[string]interface{}{"type": "Issuing", "status": "True"}},
+			err:        "certificate web is already being issued",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			o := unstructured.Unstructured{Object: map[string]interface{}{
+				"metadata": map[string]interface{}{"name": "web"},
+				"status":   map[string]interface{}{"conditions": u.conditions},
+			}}
+			err := markIssuing(&o, now)
+			if u.err != "" {
+				assert.EqualError(t, err, u.err)
+				return
+			}
+			assert.Nil(t, err)
+			cc, _, _ := unstructured.NestedSlice(o.Object, "status", "conditions")
+			assert.Equal(t, u.count, len(cc))
+			last := cc[len(cc)-1].(map[string]interface{})
+			assert.Equal(t, "Issuing", last["type"])
+			assert.Equal(t, "True", last["status"])
+			assert.Equal(t, "ManuallyTriggered", last["reason"])
+			assert.Equal(t, "2023-01-02T03:04:05Z", last["lastTransitionTime"])
+		})
+	}
+}
+
+// Helpers...
+
+func makeCert(t *testing.T, cn string, dns []string, notAfter time.Time) []byte {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.Nil(t, err)
+	tpl := x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: cn},
+		Issuer:       pkix.Name{CommonName: "k9s-ca"},
+		DNSNames:     dns,
+		NotBefore:    notAfter.Add(-24 * time.Hour),
+		NotAfter:     notAfter,
+	}
+	ca := tpl
+	ca.Subject = pkix.Name{CommonName: "k9s-ca"}
+	raw, err := x509.CreateCertificate(rand.Reader, &tpl, &ca, &key.PublicKey, key)
+	assert.Nil(t, err)
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: raw})
+}
@@ -0,0 +1,324 @@
+package dao
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/derailed/k9s/internal/client"
+)
+
+const (
+	listScript     = `cd "$0" && stat -c '%F|%s|%n' * .[!.]* 2>/dev/null; true`
+	sizeScript     = `stat -c '%s' "$0"`
+	tailScript     = `tail -c +"$1" "$0"`
+	appendScript   = `cat >> "$0"`
+	truncateScript = `cat > "$0"`
+	renameScript   = `mv -f "$0" "$1"`
+
+	partialExt = ".part"
+)
+
+// TransferProgress reports the number of bytes copied so far.
+type TransferProgress func(copied int64)
+
+// RemoteFile represents a file entry in a container filesystem.
+type RemoteFile struct {
+	Name string
+	Dir  bool
+	Size int64
+}
+
+// Transfer copies files between the local host and a pod container
+// over the exec subresource, much like kubectl cp. Files are first
+// written to a .part sibling and renamed once the copy completes.
+type Transfer struct {
+	conn     client.Connection
+	fqn, co  string
+	progress TransferProgress
+	resume   bool
+}
+
+// NewTransfer returns a new file transfer for a given pod container.
+func NewTransfer(conn client.Connection, fqn, co string, progress TransferProgress) *Transfer {
+	return &Transfer{conn: conn, fqn: fqn, co: co, progress: progress}
+}
+
+// SetResume toggles resuming from a leftover .part file.
+func (t *Transfer) SetResume(b bool) {
+	t.resume = b
+}
+
+// Resume returns true if partial transfers are resumed.
+func (t *Transfer) Resume() bool {
+	return t.resume
+}
+
+// List returns the entries of a container directory.
+func (t *Transfer) List(ctx context.Context, dir string) ([]RemoteFile, error) {
+	var out bytes.Buffer
+	if err := t.exec(ctx, []string{"sh", "-c", listScript, dir}, nil, &out); err != nil {
+		return nil, err
+	}
+
+	return parseRemoteFiles(out.String()), nil
+}
+
+// Size returns a container file size or -1 if the file does not exist.
+func (t *Transfer) Size(ctx context.Context, file string) int64 {
+	var out bytes.Buffer
+	if err := t.exec(ctx, []string{"sh", "-c", sizeScript, file}, nil, &out); err != nil {
+		return -1
+	}
+	s, err := strconv.ParseInt(strings.TrimSpace(out.String()), 10, 64)
+	if err != nil {
+		return -1
+	}
+
+	return s
+}
+
+// Download copies a container file or directory into a local directory.
+// When resume is on, a leftover partial download is picked up where it
+// left off.
+func (t *Transfer) Download(ctx context.Context, remote RemoteFile, remoteDir, localDir string) error {
+	src := path.Join(remoteDir, remote.Name)
+	if remote.Dir {
+		r, w := io.Pipe()
+		errc := make(chan error, 1)
+		go func() {
+			errc <- untar(r, localDir)
+			_ = r.Close()
+		}()
+		err := t.exec(ctx, []string{"tar", "cf", "-", "-C", remoteDir, remote.Name}, nil, t.counter(w, 0))
+		_ = w.CloseWithError(err)
+		if uerr := <-errc; err == nil {
+			err = uerr
+		}
+		return err
+	}
+
+	dst := filepath.Join(localDir, remote.Name)
+	part := dst + partialExt
+	var offset int64
+	if fi, err := os.Stat(part); t.resume && err == nil && fi.Size() <= remote.Size {
+		offset = fi.Size()
+	}
+	flags := os.O_CREATE | os.O_WRONLY | os.O_APPEND
+	if offset == 0 {
+		flags |= os.O_TRUNC
+	}
+	f, err := os.OpenFile(part, flags, 0644)
+	if err != nil {
+		return err
+	}
+	if offset > 0 {
+		log.Debug().Msgf("Resuming download of %s at %d", src, offset)
+	}
+	if offset == 0 || offset < remote.Size {
+		err = t.exec(ctx, []string{"sh", "-c", tailScript, src, strconv.FormatInt(offset+1, 10)}, nil, t.counter(f, offset))
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(part, dst)
+}
+
+// Upload copies a local file or directory into a container directory.
+// When resume is on, a leftover partial upload is picked up where it
+// left off.
+func (t *Transfer) Upload(ctx context.Context, local, remoteDir string) error {
+	fi, err := os.Stat(local)
+	if err != nil {
+		return err
+	}
+	if fi.IsDir() {
+		r, w := io.Pipe()
+		defer r.Close()
+		go func() {
+			_ = w.CloseWithError(writeTar(t.counter(w, 0), local))
+		}()
+		return t.exec(ctx, []string{"tar", "xf", "-", "-C", remoteDir}, r, nil)
+	}
+
+	dst := path.Join(remoteDir, filepath.Base(local))
+	part := dst + partialExt
+	var offset int64
+	if t.resume {
+		offset = t.Size(ctx, part)
+	}
+	script := appendScript
+	if offset <= 0 || offset > fi.Size() {
+		offset, script = 0, truncateScript
+	}
+	if offset == 0 || offset < fi.Size() {
+		if err := t.send(ctx, local, part, script, offset); err != nil {
+			return err
+		}
+	}
+
+	return t.exec(ctx, []string{"sh", "-c", renameScript, part, dst}, nil, nil)
+}
+
+func (t *Transfer) send(ctx context.Context, local, dst, script string, offset int64) error {
+	f, err := os.Open(local)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.Error().Err(err).Msgf("closing %s", local)
+		}
+	}()
+	if _, err := f.Seek(offset, io.SeekStart); err != nil {
+		return err
+	}
+	if offset > 0 {
+		log.Debug().Msgf("Resuming upload of %s at %d", local, offset)
+	}
+	r, w := io.Pipe()
+	defer r.Close()
+	go func() {
+		_, err := io.Copy(t.counter(w, offset), f)
+		_ = w.CloseWithError(err)
+	}()
+
+	return t.exec(ctx, []string{"sh", "-c", script, dst}, r, nil)
+}
+
+func (t *Transfer) counter(w io.Writer, offset int64) io.Writer {
+	return &progressWriter{w: w, copied: offset, progress: t.progress}
+}
+
+func (t *Transfer) exec(ctx context.Context, cmd []string, stdin io.Reader, stdout io.Writer) error {
+	return ExecIn(ctx, t.conn, t.fqn, t.co, cmd, stdin, stdout)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+type progressWriter struct {
+	w        io.Writer
+	copied   int64
+	progress TransferProgress
+}
+
+func (p *progressWriter) Write(b []byte) (int, error) {
+	n, err := p.w.Write(b)
+	p.copied += int64(n)
+	if p.progress != nil {
+		p.progress(p.copied)
+	}
+
+	return n, err
+}
+
+func parseRemoteFiles(s string) []RemoteFile {
+	ff := make([]RemoteFile, 0, 10)
+	for _, l := range strings.Split(s, "\n") {
+		tokens := strings.SplitN(l, "|", 3)
+		if len(tokens) < 3 {
+			continue
+		}
+		size, _ := strconv.ParseInt(tokens[1], 10, 64)
+		ff = append(ff, RemoteFile{
+			Name: tokens[2],
+			Dir:  tokens[0] == "directory",
+			Size: size,
+		})
+	}
+
+	return ff
+}
+
+func writeTar(w io.Writer, src string) error {
+	tw := tar.NewWriter(w)
+	base := filepath.Dir(src)
+	err := filepath.Walk(src, func(file string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(base, file)
+		if err != nil {
+			return err
+		}
+		hdr, err := tar.FileInfoHeader(fi, "")
+		if err != nil {
+			return err
+		}
+		hdr.Name = filepath.ToSlash(rel)
+		if err := tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+		if !fi.Mode().IsRegular() {
+			return nil
+		}
+		f, err := os.Open(file)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		_, err = io.Copy(tw, f)
+
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	return tw.Close()
+}
+
+func untar(r io.Reader, dst string) error {
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, filepath.Clean(hdr.Name))
+		if !strings.HasPrefix(target, filepath.Clean(dst)+string(os.PathSeparator)) {
+			return fmt.Errorf("invalid archive entry %q", hdr.Name)
+		}
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, 0755); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+				return err
+			}
+			f, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, os.FileMode(hdr.Mode)&os.ModePerm)
+			if err != nil {
+				return err
+			}
+			if _, err := io.Copy(f, tr); err != nil {
+				_ = f.Close()
+				return err
+			}
+			if err := f.Close(); err != nil {
+				return err
+			}
+		default:
+			log.Debug().Msgf("Skipping archive entry %s", hdr.Name)
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package dao
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRemoteFiles(t *testing.T) {
+	out := "directory|4096|etc\nregular file|12|fred.txt\nsymbolic link|7|blee\nbad line\n"
+
+	assert.Equal(t, []RemoteFile{
+		{Name: "etc", Dir: true, Size: 4096},
+		{Name: "fred.txt", Size: 12},
+		{Name: "blee", Size: 7},
+	}, parseRemoteFiles(out))
+}
+
+func TestTarRoundTrip(t *testing.T) {
+	src, dst := t.TempDir(), t.TempDir()
+	dir := filepath.Join(src, "fred")
+	assert.NoError(t, os.MkdirAll(filepath.Join(dir, "blee"), 0755))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "blee", "zorg.txt"), []byte("duh"), 0644))
+
+	var buff bytes.Buffer
+	var copied int64
+	w := &progressWriter{w: &buff, progress: func(n int64) { copied = n }}
+	assert.NoError(t, writeTar(w, dir))
+	assert.Equal(t, int64(buff.Len()), copied)
+	assert.NoError(t, untar(&buff, dst))
+
+	bb, err := os.ReadFile(filepath.Join(dst, "fred", "blee", "zorg.txt"))
+	assert.NoError(t, err)
+	assert.Equal(t, "duh", string(bb))
+}
@@ -49,6 +49,27 @@ type Factory interface {
 	Forwarders() watch.Forwarders
 }
 
+// SelectorFactory represents a factory resolving selectors on the api server.
+type SelectorFactory interface {
+	// ListSelected fetch a collection of resources matching label and field selectors.
+	ListSelected(gvr, ns, labelSel, fieldSel string) ([]runtime.Object, error)
+}
+
+// MetadataFactory represents a factory caching resources metadata only.
+type MetadataFactory interface {
+	// ListMetadata fetch the metadata of resources matching a label selector.
+	ListMetadata(gvr, ns string, sel labels.Selector) ([]runtime.Object, error)
+
+	// IsMetadataOnly checks if a resource is only cached as metadata.
+	IsMetadataOnly(gvr string) bool
+}
+
+// CacheReporter represents a factory reporting its informer caches size.
+type CacheReporter interface {
+	// CacheStats returns the size of all active informer caches.
+	CacheStats() []watch.CacheStat
+}
+
 // Getter represents a resource getter.
 type Getter interface {
 	// Get return a given resource.
@@ -80,6 +101,7 @@ type DrainOptions struct {
 	IgnoreAllDaemonSets bool
 	DeleteEmptyDirData  bool
 	Force               bool
+	DisableEviction     bool
 }
 
 // NodeMaintainer performs node maintenance operations.
@@ -89,6 +111,9 @@ type NodeMaintainer interface {
 
 	// Drain drains the given node.
 	Drain(path string, opts DrainOptions, w io.Writer) error
+
+	// SetTaints applies the edits made from the original taints.
+	SetTaints(ctx context.Context, path string, original, edited []v1.Taint) error
 }
 
 // Loggable represents resources with logs.
@@ -100,10 +125,10 @@ type Loggable interface {
 // Describer describes a resource.
 type Describer interface {
 	// Describe describes a resource.
-	Describe(path string) (string, error)
+	Describe(ctx context.Context, path string) (string, error)
 
 	// ToYAML dumps a resource to YAML.
-	ToYAML(path string, showManaged bool) (string, error)
+	ToYAML(ctx context.Context, path string, showManaged bool) (string, error)
 }
 
 // Scalable represents resources that can scale.
@@ -130,24 +155,94 @@ type Switchable interface {
 	Switch(ctx string) error
 }
 
+// Tokener represents a resource which can mint access tokens.
+type Tokener interface {
+	// Token returns a short-lived token and its expiry.
+	Token(ctx context.Context, path string, ttl time.Duration, audiences []string) (string, time.Time, error)
+
+	// KubeConfig returns a kubeconfig for a given token.
+	KubeConfig(path, token string) ([]byte, error)
+}
+
+// DataPatcher represents a resource which data keys can be updated.
+type DataPatcher interface {
+	// Data returns the resource data.
+	Data(ctx context.Context, path string) (map[string][]byte, error)
+
+	// SetKey updates a given data key.
+	SetKey(ctx context.Context, path, key string, value []byte) error
+}
+
 // Restartable represents a restartable resource.
 type Restartable interface {
 	// Restart performs a rollout restart.
 	Restart(ctx context.Context, path string) error
 }
 
+// Pausable represents a resource which rollout can be paused.
+type Pausable interface {
+	// Pause pauses a rollout.
+	Pause(ctx context.Context, path string) error
+
+	// Resume resumes a paused rollout.
+	Resume(ctx context.Context, path string) error
+}
+
+// Rollbackable represents a resource with a rollout history.
+type Rollbackable interface {
+	// History returns the rollout revisions.
+	History(ctx context.Context, path string) (Revisions, error)
+
+	// Undo rolls back to a given revision.
+	Undo(ctx context.Context, path string, revision int64) error
+}
+
 // Runnable represents a runnable resource.
 type Runnable interface {
 	// Run triggers a run.
 	Run(path string) error
 }
 
+// MetaEditor represents a resource with editable labels and annotations.
+type MetaEditor interface {
+	// EditMeta applies labels or annotations changes.
+	EditMeta(ctx context.Context, path, field string, changes MetaChanges) error
+}
+
+// Evictable represents a resource that can be evicted.
+type Evictable interface {
+	// Evict evicts a resource honoring disruption budgets.
+	Evict(ctx context.Context, path string, grace Grace) error
+}
+
+// Debuggable represents a resource that can host ephemeral debug containers.
+type Debuggable interface {
+	// Debug injects an ephemeral debug container and returns its name.
+	Debug(ctx context.Context, path string, opts DebugOptions) (string, error)
+}
+
 // Logger represents a resource that exposes logs.
 type Logger interface {
 	// Logs tails a resource logs.
 	Logs(path string, opts *v1.PodLogOptions) (*restclient.Request, error)
 }
 
+// EnvEditor represents a resource with editable containers environment.
+type EnvEditor interface {
+	ContainsPodSpec
+
+	// SetEnv updates a container environment variables.
+	SetEnv(ctx context.Context, path string, spec EnvSpec) error
+}
+
+// ResourcesEditor represents a resource with editable containers requests and limits.
+type ResourcesEditor interface {
+	ContainsPodSpec
+
+	// SetResources updates containers requests and limits for a resource.
+	SetResources(ctx context.Context, path string, specs ResourceSpecs) error
+}
+
 // ContainsPodSpec represents a resource with a pod template.
 type ContainsPodSpec interface {
 	// Get PodSpec of a resource
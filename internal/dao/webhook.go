@@ -0,0 +1,372 @@
+package dao
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	admv1 "k8s.io/api/admissionregistration/v1"
+	v1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/duration"
+	"k8s.io/client-go/tools/portforward"
+	"k8s.io/client-go/transport/spdy"
+)
+
+const (
+	webhookServiceCheck   = "service"
+	webhookEndpointsCheck = "endpoints"
+	webhookReachCheck     = "reachability"
+	webhookCACheck        = "ca bundle"
+	webhookTLSCheck       = "tls"
+
+	webhookDialTimeout = 5 * time.Second
+)
+
+var _ Accessor = (*Webhook)(nil)
+
+// Webhook represents a K8s validating or mutating webhook configuration.
+type Webhook struct {
+	Resource
+}
+
+// Webhooks returns a webhook configuration webhooks.
+func (w *Webhook) Webhooks(path string) ([]render.WebhookSpec, error) {
+	o, err := w.GetFactory().Get(w.gvr.String(), path, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expecting unstructured but got %T", o)
+	}
+
+	return render.Webhooks(u)
+}
+
+// Test checks each webhook backing service has ready endpoints and can be
+// reached via the api server and that its serving certificate is trusted by
+// its ca bundle. Service backed webhooks are dialed over a port-forward.
+func (w *Webhook) Test(ctx context.Context, path string) ([]render.WebhookProbe, error) {
+	ww, err := w.Webhooks(path)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	pp := make([]render.WebhookProbe, 0, 4*len(ww))
+	for _, wh := range ww {
+		cc := wh.ClientConfig
+		switch {
+		case cc.Service != nil:
+			pp = append(pp, w.serviceProbes(ctx, wh.Name, cc.Service, cc.CABundle)...)
+		case cc.URL != nil:
+			pp = append(pp, urlProbe(ctx, wh.Name, *cc.URL, cc.CABundle, now))
+		}
+		pp = append(pp, caBundleProbe(wh.Name, cc.CABundle, cc.Service != nil, now))
+	}
+
+	return pp, nil
+}
+
+func (w *Webhook) serviceProbes(ctx context.Context, name string, ref *admv1.ServiceReference, bundle []byte) []render.WebhookProbe {
+	fqn := client.FQN(ref.Namespace, ref.Name)
+	dial, err := w.Client().Dial()
+	if err != nil {
+		return []render.WebhookProbe{failedProbe(name, webhookServiceCheck, err)}
+	}
+	svc, err := dial.CoreV1().Services(ref.Namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+	if err != nil {
+		return []render.WebhookProbe{failedProbe(name, webhookServiceCheck, err)}
+	}
+	pp := []render.WebhookProbe{
+		{Webhook: name, Check: webhookServiceCheck, OK: true, Message: "svc/" + fqn},
+	}
+
+	ep, epErr := dial.CoreV1().Endpoints(ref.Namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+	if epErr != nil {
+		pp = append(pp, failedProbe(name, webhookEndpointsCheck, epErr))
+	} else {
+		pp = append(pp, endpointsProbe(name, ep))
+	}
+
+	port := strconv.Itoa(int(render.WebhookPort(ref)))
+	var p string
+	if ref.Path != nil {
+		p = *ref.Path
+	}
+	_, err = dial.CoreV1().Services(ref.Namespace).ProxyGet("https", ref.Name, port, p, nil).DoRaw(ctx)
+	pp = append(pp, proxyProbe(name, err))
+	if epErr != nil {
+		return append(pp, unverifiedProbe(name, epErr))
+	}
+
+	return append(pp, w.servingCertProbe(ctx, name, svc, ep, render.WebhookPort(ref), bundle))
+}
+
+// servingCertProbe checks a service backed webhook serving certificate by
+// dialing one of its pods over a port-forward.
+func (w *Webhook) servingCertProbe(ctx context.Context, name string, svc *v1.Service, ep *v1.Endpoints, port int32, bundle []byte) render.WebhookProbe {
+	if len(bundle) == 0 {
+		return unverifiedProbe(name, errors.New("no CA bundle"))
+	}
+	pod, podPort, ok := endpointTarget(svc, ep, port)
+	if !ok {
+		return unverifiedProbe(name, errors.New("no ready pod endpoint"))
+	}
+	ctx, cancel := context.WithTimeout(ctx, webhookDialTimeout)
+	defer cancel()
+	local, err := w.forward(ctx, svc.Namespace, pod, podPort)
+	if err != nil {
+		return unverifiedProbe(name, err)
+	}
+	serverName := svc.Name + "." + svc.Namespace + ".svc"
+
+	return tlsProbe(ctx, name, net.JoinHostPort("127.0.0.1", local), serverName, bundle, time.Now())
+}
+
+// forward port-forwards a pod port to a random local port until the context
+// is done. Returns the local port.
+func (w *Webhook) forward(ctx context.Context, ns, pod string, port int32) (string, error) {
+	dial, err := w.Client().Dial()
+	if err != nil {
+		return "", err
+	}
+	cfg, err := w.Client().RestConfig()
+	if err != nil {
+		return "", err
+	}
+	transport, upgrader, err := spdy.RoundTripperFor(cfg)
+	if err != nil {
+		return "", err
+	}
+	u := dial.CoreV1().RESTClient().Post().Resource("pods").Namespace(ns).Name(pod).SubResource("portforward").URL()
+	dialer := spdy.NewDialer(upgrader, &http.Client{Transport: transport}, http.MethodPost, u)
+	stopChan, readyChan := make(chan struct{}), make(chan struct{})
+	fw, err := portforward.NewOnAddresses(dialer, []string{"127.0.0.1"}, []string{fmt.Sprintf("0:%d", port)}, stopChan, readyChan, io.Discard, io.Discard)
+	if err != nil {
+		return "", err
+	}
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- fw.ForwardPorts()
+	}()
+	go func() {
+		<-ctx.Done()
+		close(stopChan)
+	}()
+	select {
+	case <-readyChan:
+	case err := <-errChan:
+		return "", err
+	case <-ctx.Done():
+		return "", ctx.Err()
+	}
+	pp, err := fw.GetPorts()
+	if err != nil {
+		return "", err
+	}
+	if len(pp) == 0 {
+		return "", errors.New("no forwarded port")
+	}
+
+	return strconv.Itoa(int(pp[0].Local)), nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func failedProbe(name, check string, err error) render.WebhookProbe {
+	return render.WebhookProbe{Webhook: name, Check: check, Message: err.Error()}
+}
+
+func unverifiedProbe(name string, err error) render.WebhookProbe {
+	return render.WebhookProbe{Webhook: name, Check: webhookTLSCheck, Message: "serving certificate not verified: " + err.Error()}
+}
+
+// endpointTarget returns a ready pod backing a service port and its target port.
+func endpointTarget(svc *v1.Service, ep *v1.Endpoints, port int32) (string, int32, bool) {
+	var portName string
+	for _, p := range svc.Spec.Ports {
+		if p.Port == port {
+			portName = p.Name
+			break
+		}
+	}
+	for _, s := range ep.Subsets {
+		var target int32
+		for _, p := range s.Ports {
+			if p.Name == portName {
+				target = p.Port
+				break
+			}
+		}
+		if target == 0 {
+			continue
+		}
+		for _, a := range s.Addresses {
+			if a.TargetRef != nil && a.TargetRef.Kind == "Pod" {
+				return a.TargetRef.Name, target, true
+			}
+		}
+	}
+
+	return "", 0, false
+}
+
+func endpointsProbe(name string, ep *v1.Endpoints) render.WebhookProbe {
+	var ready, notReady int
+	for _, s := range ep.Subsets {
+		ready += len(s.Addresses)
+		notReady += len(s.NotReadyAddresses)
+	}
+
+	return render.WebhookProbe{
+		Webhook: name,
+		Check:   webhookEndpointsCheck,
+		OK:      ready > 0,
+		Message: fmt.Sprintf("%d ready, %d not ready", ready, notReady),
+	}
+}
+
+// proxyProbe checks a webhook proxied call outcome. Webhooks are not expected
+// to serve gets, hence any response short of a gateway error proves the
+// webhook is reachable.
+func proxyProbe(name string, err error) render.WebhookProbe {
+	p := render.WebhookProbe{Webhook: name, Check: webhookReachCheck, OK: true, Message: "webhook responded"}
+	if err == nil {
+		return p
+	}
+	var serr *kerrors.StatusError
+	if !errors.As(err, &serr) {
+		p.OK, p.Message = false, err.Error()
+		return p
+	}
+	switch code := serr.Status().Code; code {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		p.OK, p.Message = false, fmt.Sprintf("unreachable (%d): %s", code, serr.Error())
+	default:
+		p.Message = fmt.Sprintf("webhook responded (%d)", code)
+	}
+
+	return p
+}
+
+// caBundleProbe checks a webhook ca bundle certificates expiry.
+func caBundleProbe(name string, bundle []byte, required bool, now time.Time) render.WebhookProbe {
+	p := render.WebhookProbe{Webhook: name, Check: webhookCACheck}
+	if len(bundle) == 0 {
+		p.OK, p.Message = !required, "no CA bundle, relying on the api server trust roots"
+		return p
+	}
+	certs, err := parseCerts(bundle)
+	if err != nil {
+		p.Message = err.Error()
+		return p
+	}
+	p.OK = true
+	for _, c := range certs {
+		s := render.CertStatus(c.NotAfter, now)
+		if s == render.CertExpired {
+			p.OK = false
+		}
+		if p.Message != "" {
+			p.Message += ", "
+		}
+		p.Message += fmt.Sprintf("%s %s (%s)", c.Subject.CommonName, s, certExpiry(c.NotAfter, now))
+	}
+
+	return p
+}
+
+// urlProbe checks a webhook url serving certificate is trusted by its ca
+// bundle and not expired.
+func urlProbe(ctx context.Context, name, rawURL string, bundle []byte, now time.Time) render.WebhookProbe {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return failedProbe(name, webhookTLSCheck, err)
+	}
+	host := u.Host
+	if u.Port() == "" {
+		host = net.JoinHostPort(u.Hostname(), strconv.Itoa(443))
+	}
+
+	return tlsProbe(ctx, name, host, u.Hostname(), bundle, now)
+}
+
+// tlsProbe checks the certificate served on a given address is trusted by a
+// ca bundle and not expired.
+func tlsProbe(ctx context.Context, name, addr, serverName string, bundle []byte, now time.Time) render.WebhookProbe {
+	p := render.WebhookProbe{Webhook: name, Check: webhookTLSCheck}
+	cfg := tls.Config{ServerName: serverName, MinVersion: tls.VersionTLS12}
+	if len(bundle) > 0 {
+		cfg.RootCAs = x509.NewCertPool()
+		cfg.RootCAs.AppendCertsFromPEM(bundle)
+	}
+	d := tls.Dialer{NetDialer: &net.Dialer{Timeout: webhookDialTimeout}, Config: &cfg}
+	conn, err := d.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		p.Message = err.Error()
+		return p
+	}
+	defer conn.Close()
+
+	tconn, ok := conn.(*tls.Conn)
+	if !ok || len(tconn.ConnectionState().PeerCertificates) == 0 {
+		p.Message = "no serving certificate presented"
+		return p
+	}
+	leaf := tconn.ConnectionState().PeerCertificates[0]
+	s := render.CertStatus(leaf.NotAfter, now)
+	p.OK = s != render.CertExpired
+	p.Message = fmt.Sprintf("serving certificate trusted, %s (%s)", s, certExpiry(leaf.NotAfter, now))
+
+	return p
+}
+
+// parseCerts returns all certificates of a pem bundle.
+func parseCerts(raw []byte) ([]*x509.Certificate, error) {
+	var cc []*x509.Certificate
+	for {
+		var block *pem.Block
+		block, raw = pem.Decode(raw)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		c, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		cc = append(cc, c)
+	}
+	if len(cc) == 0 {
+		return nil, errors.New("no pem certificate found")
+	}
+
+	return cc, nil
+}
+
+func certExpiry(notAfter, now time.Time) string {
+	if !notAfter.After(now) {
+		return "expired " + duration.HumanDuration(now.Sub(notAfter)) + " ago"
+	}
+
+	return "expires in " + duration.HumanDuration(notAfter.Sub(now))
+}
@@ -0,0 +1,189 @@
+package dao
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestEndpointsProbe(t *testing.T) {
+	uu := map[string]struct {
+		ep  v1.Endpoints
+		ok  bool
+		msg string
+	}{
+		"empty": {
+			msg: "0 ready, 0 not ready",
+		},
+		"not-ready": {
+			ep:  v1.Endpoints{Subsets: []v1.EndpointSubset{{NotReadyAddresses: []v1.EndpointAddress{{IP: "10.0.0.1"}}}}},
+			msg: "0 ready, 1 not ready",
+		},
+		"ready": {
+			ep: v1.Endpoints{Subsets: []v1.EndpointSubset{
+				{Addresses: []v1.EndpointAddress{{IP: "10.0.0.1"}, {IP: "10.0.0.2"}}},
+				{NotReadyAddresses: []v1.EndpointAddress{{IP: "10.0.0.3"}}},
+			}},
+			ok:  true,
+			msg: "2 ready, 1 not ready",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p := endpointsProbe("h1", &u.ep)
+			assert.Equal(t, u.ok, p.OK)
+			assert.Equal(t, u.msg, p.Message)
+		})
+	}
+}
+
+func TestEndpointTarget(t *testing.T) {
+	svc := v1.Service{Spec: v1.ServiceSpec{Ports: []v1.ServicePort{
+		{Name: "metrics", Port: 8080},
+		{Name: "https", Port: 443},
+	}}}
+	uu := map[string]struct {
+		ep   v1.Endpoints
+		pod  string
+		port int32
+		ok   bool
+	}{
+		"empty": {},
+		"not-ready": {
+			ep: v1.Endpoints{Subsets: []v1.EndpointSubset{{
+				NotReadyAddresses: []v1.EndpointAddress{{TargetRef: &v1.ObjectReference{Kind: "Pod", Name: "p1"}}},
+				Ports:             []v1.EndpointPort{{Name: "https", Port: 9443}},
+			}}},
+		},
+		"no-pod": {
+			ep: v1.Endpoints{Subsets: []v1.EndpointSubset{{
+				Addresses: []v1.EndpointAddress{{IP: "10.0.0.1"}},
+				Ports:     []v1.EndpointPort{{Name: "https", Port: 9443}},
+			}}},
+		},
+		"ready": {
+			ep: v1.Endpoints{Subsets: []v1.EndpointSubset{{
+				Addresses: []v1.EndpointAddress{{TargetRef: &v1.ObjectReference{Kind: "Pod", Name: "p1"}}},
+				Ports:     []v1.EndpointPort{{Name: "metrics", Port: 9090}, {Name: "https", Port: 9443}},
+			}}},
+			pod:  "p1",
+			port: 9443,
+			ok:   true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			pod, port, ok := endpointTarget(&svc, &u.ep, 443)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.pod, pod)
+			assert.Equal(t, u.port, port)
+		})
+	}
+}
+
+func TestProxyProbe(t *testing.T) {
+	gr := schema.GroupResource{Resource: "services"}
+	uu := map[string]struct {
+		err error
+		ok  bool
+		msg string
+	}{
+		"ok": {
+			ok:  true,
+			msg: "webhook responded",
+		},
+		"bad-request": {
+			err: kerrors.NewBadRequest("no admission review"),
+			ok:  true,
+			msg: "webhook responded (400)",
+		},
+		"no-endpoints": {
+			err: kerrors.NewServiceUnavailable("no endpoints available for service"),
+			msg: "unreachable (503): no endpoints available for service",
+		},
+		"gateway": {
+			err: kerrors.NewGenericServerResponse(http.StatusBadGateway, "get", gr, "hook", "dial tcp: i/o timeout", 0, false),
+		},
+		"other": {
+			err: errors.New("boom"),
+			msg: "boom",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p := proxyProbe("h1", u.err)
+			assert.Equal(t, u.ok, p.OK)
+			if u.msg != "" {
+				assert.Equal(t, u.msg, p.Message)
+			}
+		})
+	}
+}
+
+func TestCABundleProbe(t *testing.T) {
+	now := time.Now()
+	uu := map[string]struct {
+		bundle   []byte
+		required bool
+		ok       bool
+		msg      string
+	}{
+		"none-optional": {
+			ok:  true,
+			msg: "no CA bundle, relying on the api server trust roots",
+		},
+		"none-required": {
+			required: true,
+			msg:      "no CA bundle, relying on the api server trust roots",
+		},
+		"garbage": {
+			bundle: []byte("bozo"),
+			msg:    "no pem certificate found",
+		},
+		"valid": {
+			bundle: makeCert(t, "hook-ca", nil, now.Add(90*24*time.Hour)),
+			ok:     true,
+		},
+		"expiring": {
+			bundle: makeCert(t, "hook-ca", nil, now.Add(24*time.Hour)),
+			ok:     true,
+		},
+		"expired": {
+			bundle: makeCert(t, "hook-ca", nil, now.Add(-time.Hour)),
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p := caBundleProbe("h1", u.bundle, u.required, now)
+			assert.Equal(t, u.ok, p.OK)
+			if u.msg != "" {
+				assert.Equal(t, u.msg, p.Message)
+			}
+		})
+	}
+}
+
+func TestParseCerts(t *testing.T) {
+	now := time.Now()
+	bundle := append(makeCert(t, "ca1", nil, now.Add(time.Hour)), makeCert(t, "ca2", nil, now.Add(time.Hour))...)
+
+	cc, err := parseCerts(bundle)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(cc))
+	assert.Equal(t, "ca1", cc[0].Subject.CommonName)
+	assert.Equal(t, "ca2", cc[1].Subject.CommonName)
+}
@@ -5,29 +5,40 @@ type ContextKey string
 
 // A collection of context keys.
 const (
-	KeyFactory     ContextKey = "factory"
-	KeyLabels      ContextKey = "labels"
-	KeyFields      ContextKey = "fields"
-	KeyTable       ContextKey = "table"
-	KeyDir         ContextKey = "dir"
-	KeyPath        ContextKey = "path"
-	KeySubject     ContextKey = "subject"
-	KeyGVR         ContextKey = "gvr"
-	KeyForwards    ContextKey = "forwards"
-	KeyContainers  ContextKey = "containers"
-	KeyBenchCfg    ContextKey = "benchcfg"
-	KeyAliases     ContextKey = "aliases"
-	KeyUID         ContextKey = "uid"
-	KeySubjectKind ContextKey = "subjectKind"
-	KeySubjectName ContextKey = "subjectName"
-	KeyNamespace   ContextKey = "namespace"
-	KeyCluster     ContextKey = "cluster"
-	KeyApp         ContextKey = "app"
-	KeyStyles      ContextKey = "styles"
-	KeyMetrics     ContextKey = "metrics"
-	KeyHasMetrics  ContextKey = "has-metrics"
-	KeyToast       ContextKey = "toast"
-	KeyWithMetrics ContextKey = "withMetrics"
-	KeyViewConfig  ContextKey = "viewConfig"
-	KeyWait        ContextKey = "wait"
+	KeyFactory       ContextKey = "factory"
+	KeyLabels        ContextKey = "labels"
+	KeyFields        ContextKey = "fields"
+	KeyTable         ContextKey = "table"
+	KeyDir           ContextKey = "dir"
+	KeyPath          ContextKey = "path"
+	KeySubject       ContextKey = "subject"
+	KeyGVR           ContextKey = "gvr"
+	KeyForwards      ContextKey = "forwards"
+	KeyContainers    ContextKey = "containers"
+	KeyBenchCfg      ContextKey = "benchcfg"
+	KeyAliases       ContextKey = "aliases"
+	KeyUID           ContextKey = "uid"
+	KeySubjectKind   ContextKey = "subjectKind"
+	KeySubjectName   ContextKey = "subjectName"
+	KeyNamespace     ContextKey = "namespace"
+	KeyCluster       ContextKey = "cluster"
+	KeyApp           ContextKey = "app"
+	KeyStyles        ContextKey = "styles"
+	KeyMetrics       ContextKey = "metrics"
+	KeyHasMetrics    ContextKey = "has-metrics"
+	KeyToast         ContextKey = "toast"
+	KeyWithMetrics   ContextKey = "withMetrics"
+	KeyViewConfig    ContextKey = "viewConfig"
+	KeyIncludeObject ContextKey = "includeObject"
+	KeyWait          ContextKey = "wait"
+	KeyInvolved      ContextKey = "involved"
+	KeyProbes        ContextKey = "probes"
+	KeyTraffic       ContextKey = "traffic"
+	KeyAlerts        ContextKey = "alerts"
+	KeyGPUMetrics    ContextKey = "gpuMetrics"
+	KeyPricing       ContextKey = "pricing"
+	KeyPage          ContextKey = "page"
+	KeyMetadataOnly  ContextKey = "metadataOnly"
+	KeyNamespaces    ContextKey = "namespaces"
+	KeyRecursive     ContextKey = "recursive"
 )
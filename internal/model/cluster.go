@@ -89,6 +89,11 @@ func (c *Cluster) UserName() string {
 	return n
 }
 
+// IsImpersonating returns true if the connection acts as another identity.
+func (c *Cluster) IsImpersonating() bool {
+	return c.factory.Client().Config().IsImpersonating()
+}
+
 // Metrics gathers node level metrics and compute utilization percentages.
 func (c *Cluster) Metrics(ctx context.Context, mx *client.ClusterMetrics) error {
 	var (
@@ -33,10 +33,19 @@ type ClusterInfoListener interface {
 // ClusterMeta represents cluster meta data.
 type ClusterMeta struct {
 	Context, Cluster    string
+	Namespace           string
 	User                string
+	Impersonated        bool
 	K9sVer, K9sLatest   string
 	K8sVer              string
 	Cpu, Mem, Ephemeral int
+	Latency             time.Duration
+	Watches             int
+}
+
+// watchCounter represents a factory tracking its active watches.
+type watchCounter interface {
+	Watches() int
 }
 
 // NewClusterMeta returns a new instance.
@@ -44,6 +53,7 @@ func NewClusterMeta() ClusterMeta {
 	return ClusterMeta{
 		Context:   client.NA,
 		Cluster:   client.NA,
+		Namespace: client.NA,
 		User:      client.NA,
 		K9sVer:    client.NA,
 		K8sVer:    client.NA,
@@ -61,7 +71,9 @@ func (c ClusterMeta) Deltas(n ClusterMeta) bool {
 
 	return c.Context != n.Context ||
 		c.Cluster != n.Cluster ||
+		c.Namespace != n.Namespace ||
 		c.User != n.User ||
+		c.Impersonated != n.Impersonated ||
 		c.K8sVer != n.K8sVer ||
 		c.K9sVer != n.K9sVer ||
 		c.K9sLatest != n.K9sLatest
@@ -121,7 +133,11 @@ func (c *ClusterInfo) Refresh() {
 		data.Context = c.cluster.ContextName()
 		data.Cluster = c.cluster.ClusterName()
 		data.User = c.cluster.UserName()
+		data.Namespace = c.factory.Client().ActiveNamespace()
+		data.Impersonated = c.cluster.IsImpersonating()
+		t := time.Now()
 		data.K8sVer = c.cluster.Version()
+		data.Latency = time.Since(t)
 		ctx, cancel := context.WithTimeout(context.Background(), c.cluster.factory.Client().Config().CallTimeout())
 		defer cancel()
 		var mx client.ClusterMetrics
@@ -131,6 +147,9 @@ func (c *ClusterInfo) Refresh() {
 			log.Warn().Err(err).Msgf("Cluster metrics failed")
 		}
 	}
+	if w, ok := c.factory.(watchCounter); ok {
+		data.Watches = w.Watches()
+	}
 	data.K9sVer = c.version
 	v1 := NewSemVer(data.K9sVer)
 
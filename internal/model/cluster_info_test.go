@@ -2,6 +2,7 @@ package model_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/derailed/k9s/internal/model"
 	"github.com/rs/zerolog"
@@ -30,6 +31,20 @@ func TestClusterMetaDelta(t *testing.T) {
 			n: makeClusterMeta("freddie"),
 			e: true,
 		},
+		"namespace": {
+			o: makeClusterMeta("fred"),
+			n: makeNamespacedClusterMeta("fred", "blee"),
+			e: true,
+		},
+		"latency": {
+			o: makeClusterMeta("fred"),
+			n: makeLatencyClusterMeta("fred"),
+		},
+		"impersonated": {
+			o: makeClusterMeta("fred"),
+			n: makeImpersonatedClusterMeta("fred"),
+			e: true,
+		},
 	}
 
 	for k := range uu {
@@ -49,3 +64,24 @@ func makeClusterMeta(cluster string) model.ClusterMeta {
 
 	return m
 }
+
+func makeImpersonatedClusterMeta(cluster string) model.ClusterMeta {
+	m := makeClusterMeta(cluster)
+	m.Impersonated = true
+
+	return m
+}
+
+func makeNamespacedClusterMeta(cluster, ns string) model.ClusterMeta {
+	m := makeClusterMeta(cluster)
+	m.Namespace = ns
+
+	return m
+}
+
+func makeLatencyClusterMeta(cluster string) model.ClusterMeta {
+	m := makeClusterMeta(cluster)
+	m.Latency, m.Watches = 10*time.Millisecond, 3
+
+	return m
+}
@@ -159,7 +159,11 @@ func (d *Describe) refresh(ctx context.Context) error {
 }
 
 func (d *Describe) reconcile(ctx context.Context) error {
-	s, err := d.describe(ctx, d.gvr, d.path)
+	var s string
+	err := withCallTimeout(ctx, d.gvr, func(ctx context.Context) (err error) {
+		s, err = d.describe(ctx, d.gvr, d.path)
+		return
+	})
 	if err != nil {
 		return err
 	}
@@ -179,6 +183,11 @@ func (d *Describe) describe(ctx context.Context, gvr client.GVR, path string) (s
 		log.Debug().Msgf("Describe model elapsed: %v", time.Since(t))
 	}(time.Now())
 
+	return DescribeFor(ctx, gvr, path)
+}
+
+// DescribeFor describes a given resource using its registered accessor.
+func DescribeFor(ctx context.Context, gvr client.GVR, path string) (string, error) {
 	meta, err := getMeta(ctx, gvr)
 	if err != nil {
 		return "", err
@@ -188,7 +197,7 @@ func (d *Describe) describe(ctx context.Context, gvr client.GVR, path string) (s
 		return "", fmt.Errorf("no describer for %q", meta.DAO.GVR())
 	}
 
-	return desc.Describe(path)
+	return desc.Describe(ctx, path)
 }
 
 // AddListener adds a new model listener.
@@ -2,9 +2,14 @@ package model
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"time"
 
 	"github.com/cenkalti/backoff/v4"
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/tview"
 	runewidth "github.com/mattn/go-runewidth"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -34,3 +39,34 @@ func NewExpBackOff(ctx context.Context, start, max time.Duration) backoff.BackOf
 	bf.InitialInterval, bf.MaxElapsedTime = start, max
 	return backoff.WithContext(bf, ctx)
 }
+
+// withCallTimeout bounds a view call by the connection call timeout. Expired
+// calls report which resource timed out.
+func withCallTimeout(ctx context.Context, gvr client.GVR, f func(context.Context) error) error {
+	timeout := callTimeout(ctx)
+	if timeout <= 0 {
+		return f(ctx)
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	err := f(ctx)
+	if errors.Is(err, context.DeadlineExceeded) {
+		return fmt.Errorf("%s call timed out after %s", gvr, timeout)
+	}
+
+	return err
+}
+
+func callTimeout(ctx context.Context) time.Duration {
+	f, ok := ctx.Value(internal.KeyFactory).(dao.Factory)
+	if !ok || f == nil {
+		return 0
+	}
+	c := f.Client()
+	if c == nil || c.Config() == nil || c.Config().Flags() == nil {
+		return 0
+	}
+
+	return c.Config().CallTimeout()
+}
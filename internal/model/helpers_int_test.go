@@ -0,0 +1,95 @@
+package model
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+)
+
+func TestWithCallTimeout(t *testing.T) {
+	uu := map[string]struct {
+		timeout string
+		delay   time.Duration
+		err     error
+		e       string
+	}{
+		"fast": {
+			timeout: "1s",
+		},
+		"failed": {
+			timeout: "1s",
+			err:     errors.New("boom"),
+			e:       "boom",
+		},
+		"slow": {
+			timeout: "10ms",
+			delay:   time.Second,
+			e:       "v1/pods call timed out after 10ms",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			flags := genericclioptions.NewConfigFlags(false)
+			flags.Timeout = &u.timeout
+			f := timeoutFactory{conn: timeoutConn{cfg: client.NewConfig(flags)}}
+			ctx := context.WithValue(context.Background(), internal.KeyFactory, f)
+
+			err := withCallTimeout(ctx, client.NewGVR("v1/pods"), func(ctx context.Context) error {
+				select {
+				case <-ctx.Done():
+					return ctx.Err()
+				case <-time.After(u.delay):
+					return u.err
+				}
+			})
+			if u.e == "" {
+				assert.Nil(t, err)
+			} else {
+				assert.EqualError(t, err, u.e)
+			}
+		})
+	}
+}
+
+func TestWithCallTimeoutNoFactory(t *testing.T) {
+	var called bool
+	err := withCallTimeout(context.Background(), client.NewGVR("v1/pods"), func(ctx context.Context) error {
+		_, ok := ctx.Deadline()
+		called = !ok
+		return nil
+	})
+
+	assert.Nil(t, err)
+	assert.True(t, called)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+type timeoutConn struct {
+	client.Connection
+
+	cfg *client.Config
+}
+
+func (c timeoutConn) Config() *client.Config {
+	return c.cfg
+}
+
+type timeoutFactory struct {
+	testFactory
+
+	conn client.Connection
+}
+
+func (f timeoutFactory) Client() client.Connection {
+	return f.conn
+}
@@ -44,6 +44,36 @@ func (h *History) Push(c string) {
 	h.commands = append([]string{c}, h.commands[:len(h.commands)-1]...)
 }
 
+// Set replaces the history with the given commands, most recent first.
+func (h *History) Set(cc []string) {
+	h.commands = nil
+	for i := len(cc) - 1; i >= 0; i-- {
+		h.Push(cc[i])
+	}
+}
+
+// Search returns the index of the first command past a given index that
+// contains the query, or -1 if none.
+func (h *History) Search(q string, from int) int {
+	q = strings.ToLower(q)
+	for i := from + 1; i < len(h.commands); i++ {
+		if strings.Contains(h.commands[i], q) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// At returns the command at a given index.
+func (h *History) At(i int) string {
+	if i < 0 || i >= len(h.commands) {
+		return ""
+	}
+
+	return h.commands[i]
+}
+
 // Clear clears out the stack.
 func (h *History) Clear() {
 	log.Debug().Msgf("History CLEARED!!!")
@@ -2,6 +2,7 @@ package model_test
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/derailed/k9s/internal/model"
@@ -29,3 +30,37 @@ func TestHistoryDups(t *testing.T) {
 
 	assert.Equal(t, []string{"cmd3", "cmd2", "cmd1"}, h.List())
 }
+
+func TestHistorySet(t *testing.T) {
+	h := model.NewHistory(3)
+	h.Set([]string{"cmd1", "cmd2", "cmd3", "cmd4"})
+
+	assert.Equal(t, []string{"cmd1", "cmd2", "cmd3"}, h.List())
+}
+
+func TestHistorySearch(t *testing.T) {
+	h := model.NewHistory(model.MaxHistory)
+	h.Set([]string{"po kube-system", "dp", "po default"})
+
+	uu := map[string]struct {
+		q       string
+		from, e int
+	}{
+		"first": {q: "po", from: -1, e: 0},
+		"next":  {q: "po", from: 0, e: 2},
+		"done":  {q: "po", from: 2, e: -1},
+		"none":  {q: "zorg", from: -1, e: -1},
+		"case":  {q: "DP", from: -1, e: 1},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			i := h.Search(u.q, u.from)
+			assert.Equal(t, u.e, i)
+			if i >= 0 {
+				assert.Contains(t, h.At(i), strings.ToLower(u.q))
+			}
+		})
+	}
+}
@@ -160,6 +160,7 @@ func (l *Log) Restart(ctx context.Context) {
 
 // Start starts logging.
 func (l *Log) Start(ctx context.Context) {
+	dao.TrackLogBuffer(l.logOptions.Path, l.lines)
 	if err := l.load(ctx); err != nil {
 		log.Error().Err(err).Msgf("Tail logs failed!")
 		l.fireLogError(err)
@@ -169,6 +170,7 @@ func (l *Log) Start(ctx context.Context) {
 // Stop terminates logging.
 func (l *Log) Stop() {
 	l.cancel()
+	dao.UntrackLogBuffer(l.lines)
 }
 
 // Set sets the log lines (for testing only!)
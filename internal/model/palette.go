@@ -0,0 +1,80 @@
+package model
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/sahilm/fuzzy"
+)
+
+const (
+	// PaletteResource tracks resource commands.
+	PaletteResource = "resource"
+
+	// PaletteCommand tracks k9s commands.
+	PaletteCommand = "command"
+
+	recentBoost = 10
+)
+
+// PaletteEntry represents a command palette entry.
+type PaletteEntry struct {
+	Command, Kind, Help string
+}
+
+// PaletteEntries represents a collection of palette entries.
+type PaletteEntries []PaletteEntry
+
+// String returns entry command at a given index.
+func (pp PaletteEntries) String(i int) string {
+	return pp[i].Command
+}
+
+// Len returns the entries count.
+func (pp PaletteEntries) Len() int {
+	return len(pp)
+}
+
+// Rank returns the entries matching a query ranked by fuzzy score and recency.
+// Recent lists the most recently used commands first.
+func (pp PaletteEntries) Rank(q string, recent []string) PaletteEntries {
+	boosts := make(map[string]int, len(recent))
+	for i, r := range recent {
+		tokens := strings.Fields(r)
+		if len(tokens) == 0 {
+			continue
+		}
+		if _, ok := boosts[tokens[0]]; !ok {
+			boosts[tokens[0]] = (len(recent) - i) * recentBoost
+		}
+	}
+
+	q = strings.TrimSpace(strings.ToLower(q))
+	if q == "" {
+		ee := make(PaletteEntries, len(pp))
+		copy(ee, pp)
+		sort.SliceStable(ee, func(i, j int) bool {
+			bi, bj := boosts[ee[i].Command], boosts[ee[j].Command]
+			if bi != bj {
+				return bi > bj
+			}
+			return ee[i].Command < ee[j].Command
+		})
+		return ee
+	}
+
+	mm := fuzzy.FindFrom(q, pp)
+	sort.SliceStable(mm, func(i, j int) bool {
+		si, sj := mm[i].Score+boosts[mm[i].Str], mm[j].Score+boosts[mm[j].Str]
+		if si != sj {
+			return si > sj
+		}
+		return mm[i].Str < mm[j].Str
+	})
+	ee := make(PaletteEntries, 0, len(mm))
+	for _, m := range mm {
+		ee = append(ee, pp[m.Index])
+	}
+
+	return ee
+}
@@ -0,0 +1,58 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPaletteRank(t *testing.T) {
+	pp := model.PaletteEntries{
+		{Command: "deploy", Kind: model.PaletteResource},
+		{Command: "dp", Kind: model.PaletteResource},
+		{Command: "pod", Kind: model.PaletteResource},
+		{Command: "po", Kind: model.PaletteResource},
+		{Command: "ctx", Kind: model.PaletteResource},
+		{Command: "help", Kind: model.PaletteCommand},
+	}
+
+	uu := map[string]struct {
+		q      string
+		recent []string
+		e      []string
+	}{
+		"empty": {
+			e: []string{"ctx", "deploy", "dp", "help", "po", "pod"},
+		},
+		"empty-recent": {
+			recent: []string{"pod kube-system", "help"},
+			e:      []string{"pod", "help", "ctx", "deploy", "dp", "po"},
+		},
+		"fuzzy": {
+			q: "dpl",
+			e: []string{"deploy"},
+		},
+		"none": {
+			q: "zorg",
+			e: []string{},
+		},
+		"recent": {
+			q:      "po",
+			recent: []string{"pod"},
+			e:      []string{"pod", "po", "deploy"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ee := pp.Rank(u.q, u.recent)
+			cc := make([]string, 0, len(ee))
+			for _, e := range ee {
+				cc = append(cc, e.Command)
+			}
+			assert.Equal(t, u.e, cc)
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/health"
@@ -36,6 +37,7 @@ func (h *PulseHealth) List(ctx context.Context, ns string) ([]runtime.Object, er
 		"apps/v1/daemonsets",
 		"batch/v1/jobs",
 		"v1/persistentvolumes",
+		"v1/nodes",
 	}
 
 	hh := make([]runtime.Object, 0, 10)
@@ -109,6 +111,8 @@ func (h *PulseHealth) check(ctx context.Context, ns, gvr string) (*health.Check,
 	}
 
 	meta.DAO.Init(h.factory, client.NewGVR(gvr))
+	// Node metrics are tracked by the cpu/mem checks.
+	ctx = context.WithValue(ctx, internal.KeyWithMetrics, false)
 	oo, err := meta.DAO.List(ctx, ns)
 	if err != nil {
 		return nil, err
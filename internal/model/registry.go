@@ -14,6 +14,70 @@ var Registry = map[string]ResourceMeta{
 		DAO:      &dao.Reference{},
 		Renderer: &render.Reference{},
 	},
+	"owners": {
+		DAO:      &dao.Owner{},
+		Renderer: &render.Owner{},
+	},
+	"svcendpoints": {
+		DAO:      &dao.ServiceEndpoints{},
+		Renderer: &render.ServiceEndpoints{},
+	},
+	"ingroutes": {
+		DAO:      &dao.IngressRoute{},
+		Renderer: &render.IngressRoute{},
+	},
+	"npsim": {
+		DAO:      &dao.NetworkPolicySim{},
+		Renderer: &render.NetworkPolicySim{},
+	},
+	"helmhistory": {
+		DAO:      &dao.HelmHistory{},
+		Renderer: &render.HelmHistory{},
+	},
+	"podsched": {
+		DAO:      &dao.PodSchedule{},
+		Renderer: &render.PodSchedule{},
+	},
+	"alerts": {
+		DAO:      &dao.Alert{},
+		Renderer: &render.Alert{},
+	},
+	"faults": {
+		DAO:      &dao.Fault{},
+		Renderer: &render.Fault{},
+	},
+	"audit": {
+		DAO:      &dao.Audit{},
+		Renderer: &render.Audit{},
+	},
+	"lint": {
+		DAO:      &dao.Lint{},
+		Renderer: &render.Lint{},
+	},
+	"deprecations": {
+		DAO:      &dao.Deprecation{},
+		Renderer: &render.Deprecation{},
+	},
+	"tlscerts": {
+		DAO:      &dao.TLSCert{},
+		Renderer: &render.TLSCert{},
+	},
+	"explain": {
+		DAO:      &dao.Explain{},
+		Renderer: &render.Explain{},
+	},
+	"stats": {
+		DAO:      &dao.Stats{},
+		Renderer: &render.Stats{},
+	},
+	"nsusage": {
+		DAO:      &dao.NamespaceUsage{},
+		Renderer: &render.NamespaceUsage{},
+	},
+	"debug": {
+		DAO:      &dao.Debug{},
+		Renderer: &render.Debug{},
+	},
 	"dir": {
 		DAO:      &dao.Dir{},
 		Renderer: &render.Dir{},
@@ -35,6 +99,10 @@ var Registry = map[string]ResourceMeta{
 		Renderer:     &render.Container{},
 		TreeRenderer: &xray.Container{},
 	},
+	"top": {
+		DAO:      &dao.ContainerTop{},
+		Renderer: &render.ContainerTop{},
+	},
 	"contexts": {
 		DAO:      &dao.Context{},
 		Renderer: &render.Context{},
@@ -111,7 +179,7 @@ var Registry = map[string]ResourceMeta{
 		Renderer: &render.PersistentVolumeClaim{},
 	},
 	"v1/events": {
-		DAO:      &dao.Table{},
+		DAO:      &dao.Event{},
 		Renderer: &render.Event{},
 	},
 
@@ -166,6 +234,16 @@ var Registry = map[string]ResourceMeta{
 		Renderer: &render.PodDisruptionBudget{},
 	},
 
+	// Admission...
+	"admissionregistration.k8s.io/v1/validatingwebhookconfigurations": {
+		DAO:      &dao.Webhook{},
+		Renderer: &render.WebhookConfiguration{},
+	},
+	"admissionregistration.k8s.io/v1/mutatingwebhookconfigurations": {
+		DAO:      &dao.Webhook{},
+		Renderer: &render.WebhookConfiguration{},
+	},
+
 	// RBAC...
 	"rbac.authorization.k8s.io/v1/clusterroles": {
 		DAO:      &dao.Rbac{},
@@ -181,3 +259,17 @@ var Registry = map[string]ResourceMeta{
 		Renderer: &render.RoleBinding{},
 	},
 }
+
+func init() {
+	// Flow control...
+	for _, gv := range dao.FlowControlGroupVersions {
+		Registry[gv+"/flowschemas"] = ResourceMeta{
+			DAO:      &dao.FlowSchema{},
+			Renderer: &render.FlowSchema{},
+		}
+		Registry[gv+"/prioritylevelconfigurations"] = ResourceMeta{
+			DAO:      &dao.PriorityLevel{},
+			Renderer: &render.PriorityLevel{},
+		}
+	}
+}
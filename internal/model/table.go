@@ -2,7 +2,12 @@ package model
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -13,12 +18,37 @@ import (
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/cache"
 )
 
-const initRefreshRate = 300 * time.Millisecond
+const (
+	initRefreshRate = 300 * time.Millisecond
+
+	// rowsTTL bounds how long rendered rows may be reused. It matches the
+	// metrics cache expiry so usage and time based columns stay current.
+	rowsTTL = time.Minute
+
+	// eventQuiet is the quiet period ending a burst of resource changes.
+	eventQuiet = 250 * time.Millisecond
+)
+
+// eventSource represents a provider of resource change notifications.
+type eventSource interface {
+	AddEventHandler(gvr, ns, labelSel, fieldSel string, h cache.ResourceEventHandler) (func(), error)
+}
+
+// cachedRow tracks a rendered row and the resource version it was rendered from.
+type cachedRow struct {
+	sig     string
+	created metav1.Time
+	row     render.Row
+}
 
 // TableListener represents a table model listener.
 type TableListener interface {
@@ -40,6 +70,22 @@ type Table struct {
 	instance    string
 	mx          sync.RWMutex
 	labelFilter string
+	fieldFilter string
+	customCols  render.CustomColumns
+	isolated    bool
+	accessor    dao.Accessor
+	rows        map[string]cachedRow
+	rendered    time.Time
+	dirty       int32
+	lastEvent   atomic.Int64
+	pageSize    int64
+	page        *dao.Page
+	paged       []runtime.Object
+	pagedAt     time.Time
+	loadMore    bool
+	probed      bool
+	partial     dao.PartialError
+	reported    string
 }
 
 // NewTable returns a new table model.
@@ -54,8 +100,65 @@ func NewTable(gvr client.GVR) *Table {
 // SetLabelFilter sets the labels filter.
 func (t *Table) SetLabelFilter(f string) {
 	t.mx.Lock()
-	t.labelFilter = f
+	t.labelFilter, t.rows = f, nil
+	t.page, t.paged, t.probed = nil, nil, false
+	t.mx.Unlock()
+	atomic.StoreInt32(&t.dirty, 1)
+}
+
+// SetFieldFilter sets the fields filter.
+func (t *Table) SetFieldFilter(f string) {
+	t.mx.Lock()
+	t.fieldFilter, t.rows = f, nil
+	t.page, t.paged, t.probed = nil, nil, false
 	t.mx.Unlock()
+	atomic.StoreInt32(&t.dirty, 1)
+}
+
+// SetCustomColumns sets user defined columns.
+func (t *Table) SetCustomColumns(cc render.CustomColumns) {
+	t.mx.Lock()
+	t.customCols, t.rows = cc, nil
+	t.mx.Unlock()
+}
+
+// SetPageSize sets the listing chunk size. Resource sets larger than a page
+// are listed incrementally. Zero lists all resources.
+func (t *Table) SetPageSize(n int64) {
+	t.mx.Lock()
+	t.pageSize, t.page, t.paged, t.probed = n, nil, nil, false
+	t.mx.Unlock()
+}
+
+// Page returns the current partial listing or nil if all resources are listed.
+func (t *Table) Page() *dao.Page {
+	t.mx.RLock()
+	defer t.mx.RUnlock()
+	if t.page == nil || !t.page.More() {
+		return nil
+	}
+	p := *t.page
+
+	return &p
+}
+
+// LoadMore lists the next chunk of resources.
+func (t *Table) LoadMore(ctx context.Context) error {
+	t.mx.Lock()
+	if t.page == nil || !t.page.More() {
+		t.mx.Unlock()
+		return nil
+	}
+	t.loadMore = true
+	t.mx.Unlock()
+
+	return t.refresh(ctx)
+}
+
+// Isolate uses a private resource accessor so the table may list from a
+// different factory than other tables of the same resource.
+func (t *Table) Isolate() {
+	t.isolated = true
 }
 
 // SetInstance sets a single entry table.
@@ -85,12 +188,13 @@ func (t *Table) RemoveListener(l TableListener) {
 	}
 }
 
-// Watch initiates model updates.
+// Watch initiates model updates. Informer backed resources are only
+// relisted once their informer reports changes.
 func (t *Table) Watch(ctx context.Context) error {
 	if err := t.refresh(ctx); err != nil {
 		return err
 	}
-	go t.updater(ctx)
+	go t.updater(ctx, t.subscribe(ctx))
 
 	return nil
 }
@@ -134,6 +238,10 @@ func (t *Table) GetNamespace() string {
 func (t *Table) SetNamespace(ns string) {
 	t.namespace = ns
 	t.data.Clear()
+	t.mx.Lock()
+	t.rows = nil
+	t.page, t.paged, t.probed = nil, nil, false
+	t.mx.Unlock()
 }
 
 // InNamespace checks if current namespace matches desired namespace.
@@ -169,18 +277,31 @@ func (t *Table) Peek() *render.TableData {
 	return t.data.Clone()
 }
 
-func (t *Table) updater(ctx context.Context) {
+func (t *Table) updater(ctx context.Context, deltas bool) {
 	defer log.Debug().Msgf("TABLE-UPDATER canceled -- %q", t.gvr)
 
 	bf := backoff.NewExponentialBackOff()
 	bf.InitialInterval, bf.MaxElapsedTime = initRefreshRate, maxReaderRetryInterval
-	rate := initRefreshRate
+	rate, settled := initRefreshRate, false
 	for {
+		r, wake := throttled(rate)
 		select {
 		case <-ctx.Done():
 			return
-		case <-time.After(rate):
+		case <-wake:
+			rate = initRefreshRate
+		case <-time.After(r):
 			rate = t.refreshRate
+			if deltas && t.stable() && t.idle() {
+				t.refreshAges()
+				continue
+			}
+			// Let bursts of changes settle so they render at once.
+			if d := t.settling(); deltas && d > 0 && !settled {
+				rate, settled = d, true
+				continue
+			}
+			settled = false
 			err := backoff.Retry(func() error {
 				return t.refresh(ctx)
 			}, backoff.WithContext(bf, ctx))
@@ -204,10 +325,155 @@ func (t *Table) refresh(ctx context.Context) error {
 		return err
 	}
 	t.fireTableChanged(t.Peek())
+	t.reportPartial()
 
 	return nil
 }
 
+// reportPartial notifies listeners of namespaces that failed to list. Failures
+// are only reported when they change.
+func (t *Table) reportPartial() {
+	t.mx.Lock()
+	partial := t.partial
+	var msg string
+	if partial != nil {
+		msg = partial.Error()
+	}
+	changed := msg != t.reported
+	t.reported = msg
+	t.mx.Unlock()
+
+	if changed && partial != nil {
+		t.fireTableLoadFailed(partial)
+	}
+}
+
+// subscribe tracks the resource informer changes. Returns false if the
+// resource is not backed by an informer.
+func (t *Table) subscribe(ctx context.Context) bool {
+	src, ok := ctx.Value(internal.KeyFactory).(eventSource)
+	if !ok || t.instance != "" || resourceMeta(t.gvr).Renderer.IsGeneric() {
+		return false
+	}
+	meta, err := dao.MetaAccess.MetaFor(t.gvr)
+	if err != nil || !dao.IsK8sMeta(meta) || !canWatch(meta.Verbs) {
+		return false
+	}
+	ns := client.CleanseNamespace(t.namespace)
+	if client.IsClusterScoped(t.namespace) {
+		ns = client.AllNamespaces
+	}
+	t.mx.RLock()
+	lsel, fsel := t.selectors(ctx)
+	paged, metaOnly := t.page != nil, t.metadataOnly(resourceMeta(t.gvr).Renderer)
+	t.mx.RUnlock()
+	if paged || metaOnly {
+		return false
+	}
+	touch := func() {
+		t.lastEvent.Store(time.Now().UnixNano())
+		atomic.StoreInt32(&t.dirty, 1)
+	}
+	remove, err := src.AddEventHandler(t.gvr.String(), ns, lsel, fsel, cache.ResourceEventHandlerFuncs{
+		AddFunc:    func(interface{}) { touch() },
+		UpdateFunc: func(interface{}, interface{}) { touch() },
+		DeleteFunc: func(interface{}) { touch() },
+	})
+	if err != nil {
+		log.Warn().Err(err).Msgf("Unable to track %q changes", t.gvr)
+		return false
+	}
+	go func() {
+		<-ctx.Done()
+		remove()
+	}()
+
+	return true
+}
+
+// selectors returns the effective label and field selectors. The table
+// filters take precedence over the context labels and narrow the context fields.
+func (t *Table) selectors(ctx context.Context) (string, string) {
+	lsel, _ := ctx.Value(internal.KeyLabels).(string)
+	if t.labelFilter != "" {
+		lsel = t.labelFilter
+	}
+	fsel, _ := ctx.Value(internal.KeyFields).(string)
+	switch {
+	case fsel == "":
+		fsel = t.fieldFilter
+	case t.fieldFilter != "":
+		fsel += "," + t.fieldFilter
+	}
+
+	return lsel, fsel
+}
+
+// metadataOnly checks if listing the resources metadata is enough to render
+// the table.
+func (t *Table) metadataOnly(re Renderer) bool {
+	if len(t.customCols) > 0 {
+		return false
+	}
+	m, ok := re.(MetadataRenderer)
+
+	return ok && m.MetadataOnly()
+}
+
+// settling returns how long to wait for a burst of changes to settle.
+func (t *Table) settling() time.Duration {
+	last := time.Unix(0, t.lastEvent.Load())
+	if d := eventQuiet - time.Since(last); d > 0 {
+		return d
+	}
+
+	return 0
+}
+
+// stable checks if the table rows only change when its resources change.
+// Rows with derived or external inputs, ie metrics, must be refreshed.
+func (t *Table) stable() bool {
+	if len(t.customCols) > 0 {
+		return false
+	}
+	s, ok := resourceMeta(t.gvr).Renderer.(StableRenderer)
+
+	return ok && s.Stable()
+}
+
+// idle checks if no changes were reported since the rows were last rendered.
+func (t *Table) idle() bool {
+	if atomic.LoadInt32(&t.dirty) != 0 {
+		return false
+	}
+	t.mx.RLock()
+	defer t.mx.RUnlock()
+
+	return time.Since(t.rendered) < rowsTTL
+}
+
+// refreshAges updates the age of unchanged rows.
+func (t *Table) refreshAges() {
+	t.mx.Lock()
+	ageCol, changed := t.data.Header.IndexOf("AGE", true), false
+	if ageCol >= 0 {
+		for _, re := range t.data.RowEvents {
+			c, ok := t.rows[re.Row.ID]
+			if !ok || ageCol >= len(re.Row.Fields) {
+				continue
+			}
+			if age := render.ToAge(c.created); age != re.Row.Fields[ageCol] {
+				re.Row.Fields[ageCol], changed = age, true
+			}
+		}
+	}
+	t.mx.Unlock()
+
+	if changed {
+		t.fireTableChanged(t.Peek())
+	}
+}
+
 func (t *Table) list(ctx context.Context, a dao.Accessor) ([]runtime.Object, error) {
 	factory, ok := ctx.Value(internal.KeyFactory).(dao.Factory)
 	if !ok {
@@ -220,27 +486,119 @@ func (t *Table) list(ctx context.Context, a dao.Accessor) ([]runtime.Object, err
 		ns = client.AllNamespaces
 	}
 
-	return a.List(ctx, ns)
+	if t.pageSize <= 0 || (t.probed && t.page == nil) {
+		return a.List(ctx, ns)
+	}
+
+	// Probe with a single page and only keep paging large resource sets.
+	if t.page == nil {
+		p := dao.NewPage(t.pageSize)
+		oo, err := a.List(context.WithValue(ctx, internal.KeyPage, p), ns)
+		if err != nil {
+			return nil, err
+		}
+		t.probed = true
+		if p.More() {
+			t.page, t.paged, t.pagedAt = p, oo, time.Now()
+		}
+		return oo, nil
+	}
+	if t.loadMore {
+		t.loadMore = false
+		return t.listNext(ctx, a, ns)
+	}
+	if time.Since(t.pagedAt) < rowsTTL {
+		return t.paged, nil
+	}
+
+	return t.relistPages(ctx, a, ns)
+}
+
+// listNext appends the next chunk to the loaded resources. An expired
+// continue token relists the loaded resources first.
+func (t *Table) listNext(ctx context.Context, a dao.Accessor, ns string) ([]runtime.Object, error) {
+	if !t.page.More() {
+		return t.paged, nil
+	}
+	next := func() (*dao.Page, []runtime.Object, error) {
+		p := *t.page
+		p.Limit = t.pageSize
+		oo, err := a.List(context.WithValue(ctx, internal.KeyPage, &p), ns)
+		return &p, oo, err
+	}
+	p, oo, err := next()
+	if apierrors.IsResourceExpired(err) {
+		if _, err := t.relistPages(ctx, a, ns); err != nil {
+			return nil, err
+		}
+		if !t.page.More() {
+			return t.paged, nil
+		}
+		p, oo, err = next()
+	}
+	if err != nil {
+		return nil, err
+	}
+	t.page, t.paged = p, append(t.paged, oo...)
+
+	return t.paged, nil
+}
+
+// relistPages refreshes the loaded resources chunk by chunk.
+func (t *Table) relistPages(ctx context.Context, a dao.Accessor, ns string) ([]runtime.Object, error) {
+	p := dao.NewPage(t.pageSize)
+	oo := make([]runtime.Object, 0, len(t.paged))
+	for {
+		chunk, err := a.List(context.WithValue(ctx, internal.KeyPage, p), ns)
+		if err != nil {
+			return nil, err
+		}
+		oo = append(oo, chunk...)
+		if !p.More() || len(oo) >= len(t.paged) {
+			break
+		}
+	}
+	t.page, t.paged, t.pagedAt = p, oo, time.Now()
+
+	return oo, nil
 }
 
 func (t *Table) reconcile(ctx context.Context) error {
 	t.mx.Lock()
 	defer t.mx.Unlock()
+	atomic.StoreInt32(&t.dirty, 0)
 	meta := resourceMeta(t.gvr)
-	if t.labelFilter != "" {
-		ctx = context.WithValue(ctx, internal.KeyLabels, t.labelFilter)
+	if t.isolated {
+		if t.accessor == nil {
+			t.accessor = reflect.New(reflect.TypeOf(meta.DAO).Elem()).Interface().(dao.Accessor)
+		}
+		meta.DAO = t.accessor
 	}
-	var (
-		oo  []runtime.Object
-		err error
-	)
-	if t.instance == "" {
-		oo, err = t.list(ctx, meta.DAO)
-	} else {
-		o, e := t.Get(ctx, t.instance)
-		oo, err = []runtime.Object{o}, e
+	lsel, fsel := t.selectors(ctx)
+	if lsel != "" {
+		ctx = context.WithValue(ctx, internal.KeyLabels, lsel)
 	}
-	if err != nil {
+	if fsel != "" {
+		ctx = context.WithValue(ctx, internal.KeyFields, fsel)
+	}
+	if t.customCols.NeedsObject() {
+		ctx = context.WithValue(ctx, internal.KeyIncludeObject, true)
+	}
+	if t.metadataOnly(meta.Renderer) {
+		ctx = context.WithValue(ctx, internal.KeyMetadataOnly, true)
+	}
+	var oo []runtime.Object
+	err := withCallTimeout(ctx, t.gvr, func(ctx context.Context) (e error) {
+		if t.instance == "" {
+			oo, e = t.list(ctx, meta.DAO)
+			return
+		}
+		o, e := t.Get(ctx, t.instance)
+		oo = []runtime.Object{o}
+		return
+	})
+	t.partial = nil
+	if !errors.As(err, &t.partial) && err != nil {
 		return err
 	}
 
@@ -255,21 +613,27 @@ func (t *Table) reconcile(ctx context.Context) error {
 			if err := genericHydrate(t.namespace, table, rows, meta.Renderer); err != nil {
 				return err
 			}
+			for i := range table.Rows {
+				t.customCols.Render(table.Rows[i], &rows[i])
+			}
 		} else {
 			rows = make(render.Rows, len(oo))
-			if err := hydrate(t.namespace, oo, rows, meta.Renderer); err != nil {
+			if err := t.renderRows(oo, rows, meta.Renderer); err != nil {
 				return err
 			}
 		}
 	}
 
-	// if labelSelector in place might as well clear the model data.
-	sel, ok := ctx.Value(internal.KeyLabels).(string)
-	if ok && sel != "" {
+	// if selectors in place might as well clear the model data.
+	if lsel != "" || fsel != "" {
 		t.data.Clear()
 	}
 	t.data.Update(rows)
-	t.data.SetHeader(t.namespace, meta.Renderer.Header(t.namespace))
+	h := meta.Renderer.Header(t.namespace)
+	if len(t.customCols) > 0 {
+		h = append(h.Clone(), t.customCols.Header()...)
+	}
+	t.data.SetHeader(t.namespace, h)
 
 	if len(t.data.Header) == 0 {
 		return fmt.Errorf("fail to list resource %s", t.gvr)
@@ -278,6 +642,44 @@ func (t *Table) reconcile(ctx context.Context) error {
 	return nil
 }
 
+// renderRows renders the resources that changed since the last reconcile and
+// reuses the other rows. All rows are rendered once the cache expires.
+func (t *Table) renderRows(oo []runtime.Object, rr render.Rows, re Renderer) error {
+	full := time.Since(t.rendered) >= rowsTTL
+	ageCol := re.Header(t.namespace).IndexOf("AGE", true)
+	s, stable := re.(StableRenderer)
+	stable = stable && s.Stable()
+	rows := make(map[string]cachedRow, len(oo))
+	for i, o := range oo {
+		id, sig, created, ok := rowSignature(o)
+		// Plain resources rows are only reused when rendered solely from the
+		// resource. Decorated resources carry their extra inputs in the signature.
+		if _, plain := o.(*unstructured.Unstructured); plain && !stable {
+			ok = false
+		}
+		if c, hit := t.rows[id]; ok && !full && hit && c.sig == sig {
+			if ageCol >= 0 && ageCol < len(c.row.Fields) {
+				c.row.Fields[ageCol] = render.ToAge(c.created)
+			}
+			rr[i], rows[id] = c.row, c
+			continue
+		}
+		if err := re.Render(o, t.namespace, &rr[i]); err != nil {
+			return err
+		}
+		t.customCols.Render(o, &rr[i])
+		if ok {
+			rows[rr[i].ID] = cachedRow{sig: sig, created: created, row: rr[i]}
+		}
+	}
+	t.rows = rows
+	if full {
+		t.rendered = time.Now()
+	}
+
+	return nil
+}
+
 func (t *Table) fireTableChanged(data *render.TableData) {
 	t.mx.RLock()
 	defer t.mx.RUnlock()
@@ -296,6 +698,59 @@ func (t *Table) fireTableLoadFailed(err error) {
 // ----------------------------------------------------------------------------
 // Helpers...
 
+// rowSignature identifies an informer resource and its rendering inputs.
+func rowSignature(o runtime.Object) (string, string, metav1.Time, bool) {
+	var (
+		u     *unstructured.Unstructured
+		extra string
+	)
+	switch v := o.(type) {
+	case *unstructured.Unstructured:
+		u = v
+	case *render.PodWithMetrics:
+		u, extra = v.Raw, fmt.Sprintf(":%p:%d", v.MX, v.RestartTrend)
+		if v.Rates != nil {
+			extra += fmt.Sprintf(":%p", v.Rates)
+		}
+	case *render.NodeWithMetrics:
+		u, extra = v.Raw, fmt.Sprintf(":%p:%d:%s", v.MX, v.PodCount, resourcesSignature(v.Requests))
+		if v.GPUUtil != nil {
+			extra += fmt.Sprintf(":%.1f", *v.GPUUtil)
+		}
+		if v.Rates != nil {
+			extra += fmt.Sprintf(":%p", v.Rates)
+		}
+	case *render.NamespaceWithCost:
+		u = v.Raw
+		if v.Cost != nil {
+			extra = fmt.Sprintf(":%.4f", *v.Cost)
+		}
+	case *render.StatefulSetWithOrdinals:
+		u, extra = v.Raw, fmt.Sprintf(":%v", v.Ready)
+	case *render.APFWithStats:
+		u, extra = v.Raw, fmt.Sprintf(":%v:%t", v.Stats, v.Self)
+	}
+	if u == nil || u.GetResourceVersion() == "" {
+		return "", "", metav1.Time{}, false
+	}
+
+	return client.FQN(u.GetNamespace(), u.GetName()), u.GetResourceVersion() + extra, u.GetCreationTimestamp(), true
+}
+
+func canWatch(verbs []string) bool {
+	var list, watch bool
+	for _, v := range verbs {
+		switch v {
+		case "list":
+			list = true
+		case "watch":
+			watch = true
+		}
+	}
+
+	return list && watch
+}
+
 func hydrate(ns string, oo []runtime.Object, rr render.Rows, re Renderer) error {
 	for i, o := range oo {
 		if err := re.Render(o, ns, &rr[i]); err != nil {
@@ -332,3 +787,13 @@ func genericHydrate(ns string, table *metav1beta1.Table, rr render.Rows, re Rend
 
 	return nil
 }
+
+func resourcesSignature(rl v1.ResourceList) string {
+	ss := make([]string, 0, len(rl))
+	for n, q := range rl {
+		ss = append(ss, string(n)+"="+strconv.FormatInt(q.MilliValue(), 10))
+	}
+	sort.Strings(ss)
+
+	return strings.Join(ss, ",")
+}
@@ -3,8 +3,10 @@ package model
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
+	"strconv"
 	"testing"
 
 	"github.com/derailed/k9s/internal"
@@ -13,6 +15,9 @@ import (
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/watch"
 	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
 	"k8s.io/apimachinery/pkg/labels"
@@ -32,11 +37,27 @@ func TestTableReconcile(t *testing.T) {
 	err := ta.reconcile(ctx)
 	assert.Nil(t, err)
 	data := ta.Peek()
-	assert.Equal(t, 22, len(data.Header))
+	assert.Equal(t, 28, len(data.Header))
 	assert.Equal(t, 1, len(data.RowEvents))
 	assert.Equal(t, client.NamespaceAll, data.Namespace)
 }
 
+func TestTablePartialRefresh(t *testing.T) {
+	ta := NewTable(client.NewGVR("v1/pods"))
+	ta.SetNamespace(client.NamespaceAll)
+	ta.isolated, ta.accessor = true, &partialAccessor{}
+	var l failedListener
+	ta.AddListener(&l)
+
+	ctx := context.WithValue(context.Background(), internal.KeyFactory, makeFactory())
+	for i := 0; i < 2; i++ {
+		assert.Nil(t, ta.refresh(ctx))
+	}
+	assert.Equal(t, 1, ta.Count())
+	assert.Equal(t, 1, len(l.errs))
+	assert.EqualError(t, l.errs[0], "listing failed in 1 namespace(s) -- ns2: denied")
+}
+
 func TestTableList(t *testing.T) {
 	ta := NewTable(client.NewGVR("v1/pods"))
 	ta.SetNamespace("blee")
@@ -48,6 +69,104 @@ func TestTableList(t *testing.T) {
 	assert.Equal(t, 1, len(rows))
 }
 
+func TestTableListPaging(t *testing.T) {
+	uu := map[string]struct {
+		size, total int
+		loads       int
+		e           int
+		more        bool
+	}{
+		"disabled": {size: 0, total: 10, e: 10},
+		"small":    {size: 5, total: 3, e: 3},
+		"exact":    {size: 5, total: 5, e: 5},
+		"large":    {size: 5, total: 12, e: 5, more: true},
+		"more":     {size: 5, total: 12, loads: 1, e: 10, more: true},
+		"all":      {size: 5, total: 12, loads: 2, e: 12},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ta := NewTable(client.NewGVR("v1/pods"))
+			ta.SetNamespace("blee")
+			ta.SetPageSize(int64(u.size))
+			acc := pagedAccessor{total: u.total}
+			ctx := context.WithValue(context.Background(), internal.KeyFactory, makeFactory())
+
+			rows, err := ta.list(ctx, &acc)
+			assert.Nil(t, err)
+			for i := 0; i < u.loads; i++ {
+				ta.loadMore = true
+				rows, err = ta.list(ctx, &acc)
+				assert.Nil(t, err)
+			}
+			assert.Equal(t, u.e, len(rows))
+			assert.Equal(t, u.more, ta.Page() != nil)
+			if u.size > 0 {
+				assert.Equal(t, u.e, acc.listed)
+			}
+		})
+	}
+}
+
+func TestTableListPagingRefresh(t *testing.T) {
+	ta := NewTable(client.NewGVR("v1/pods"))
+	ta.SetNamespace("blee")
+	ta.SetPageSize(5)
+	acc := pagedAccessor{total: 23}
+	ctx := context.WithValue(context.Background(), internal.KeyFactory, makeFactory())
+
+	_, err := ta.list(ctx, &acc)
+	assert.Nil(t, err)
+	ta.loadMore = true
+	rows, err := ta.list(ctx, &acc)
+	assert.Nil(t, err)
+	assert.Equal(t, 10, len(rows))
+	assert.Equal(t, 2, acc.calls)
+
+	rows, err = ta.list(ctx, &acc)
+	assert.Nil(t, err)
+	assert.Equal(t, 10, len(rows))
+	assert.Equal(t, 2, acc.calls)
+
+	ta.pagedAt = ta.pagedAt.Add(-rowsTTL)
+	acc.calls, acc.listed = 0, 0
+	rows, err = ta.list(ctx, &acc)
+	assert.Nil(t, err)
+	assert.Equal(t, 10, len(rows))
+	assert.Equal(t, 2, acc.calls)
+	assert.Equal(t, 10, acc.listed)
+
+	acc.expired = true
+	ta.loadMore = true
+	rows, err = ta.list(ctx, &acc)
+	assert.Nil(t, err)
+	assert.Equal(t, 15, len(rows))
+	assert.Equal(t, "15", ta.Page().Continue)
+}
+
+func TestTableMetadataOnly(t *testing.T) {
+	uu := map[string]struct {
+		re   Renderer
+		cols render.CustomColumns
+		e    bool
+	}{
+		"role":        {re: &render.Role{}, e: true},
+		"clusterRole": {re: &render.ClusterRole{}, e: true},
+		"pod":         {re: &render.Pod{}},
+		"custom":      {re: &render.Role{}, cols: render.CustomColumns{{Name: "BLEE"}}},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ta := NewTable(client.NewGVR("rbac.authorization.k8s.io/v1/roles"))
+			ta.SetCustomColumns(u.cols)
+			assert.Equal(t, u.e, ta.metadataOnly(u.re))
+		})
+	}
+}
+
 func TestTableGet(t *testing.T) {
 	ta := NewTable(client.NewGVR("v1/pods"))
 	ta.SetNamespace("blee")
@@ -105,7 +224,158 @@ func TestTableHydrate(t *testing.T) {
 
 	assert.Nil(t, hydrate("blee", oo, rr, render.Pod{}))
 	assert.Equal(t, 1, len(rr))
-	assert.Equal(t, 22, len(rr[0].Fields))
+	assert.Equal(t, 28, len(rr[0].Fields))
+}
+
+func TestTableRenderRows(t *testing.T) {
+	ta := NewTable(client.NewGVR("v1/pods"))
+	ta.SetNamespace("blee")
+	re := countingRenderer{Pod: render.Pod{}, count: new(int)}
+
+	p1 := load(t, "p1")
+	rr := make(render.Rows, 1)
+	assert.Nil(t, ta.renderRows([]runtime.Object{&render.PodWithMetrics{Raw: p1}}, rr, re))
+	assert.Equal(t, 1, *re.count)
+	assert.Equal(t, "nginx-7fb78fb6d8-2w75j", rr[0].Fields[1])
+
+	assert.Nil(t, ta.renderRows([]runtime.Object{&render.PodWithMetrics{Raw: p1}}, rr, re))
+	assert.Equal(t, 1, *re.count)
+	assert.Equal(t, "nginx-7fb78fb6d8-2w75j", rr[0].Fields[1])
+
+	p2 := p1.DeepCopy()
+	p2.SetResourceVersion("99")
+	assert.Nil(t, ta.renderRows([]runtime.Object{&render.PodWithMetrics{Raw: p2}}, rr, re))
+	assert.Equal(t, 2, *re.count)
+
+	ta.rendered = ta.rendered.Add(-rowsTTL)
+	assert.Nil(t, ta.renderRows([]runtime.Object{&render.PodWithMetrics{Raw: p2}}, rr, re))
+	assert.Equal(t, 3, *re.count)
+}
+
+func TestTableRenderRowsStable(t *testing.T) {
+	sa := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ServiceAccount",
+		"metadata": map[string]interface{}{
+			"namespace":       "ns1",
+			"name":            "sa1",
+			"resourceVersion": "1",
+		},
+	}}
+
+	uu := map[string]struct {
+		re Renderer
+		e  int
+	}{
+		"stable": {
+			re: countingStableRenderer{ServiceAccount: render.ServiceAccount{}, count: new(int), stable: true},
+			e:  1,
+		},
+		"derived": {
+			re: countingStableRenderer{ServiceAccount: render.ServiceAccount{}, count: new(int)},
+			e:  2,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ta := NewTable(client.NewGVR("v1/serviceaccounts"))
+			ta.SetNamespace("ns1")
+			rr := make(render.Rows, 1)
+			assert.Nil(t, ta.renderRows([]runtime.Object{sa}, rr, u.re))
+			assert.Nil(t, ta.renderRows([]runtime.Object{sa}, rr, u.re))
+			assert.Equal(t, u.e, *u.re.(countingStableRenderer).count)
+		})
+	}
+}
+
+func TestTableStable(t *testing.T) {
+	uu := map[string]struct {
+		gvr  string
+		cols render.CustomColumns
+		e    bool
+	}{
+		"pods":    {gvr: "v1/pods"},
+		"nodes":   {gvr: "v1/nodes"},
+		"jobs":    {gvr: "batch/v1/jobs"},
+		"sa":      {gvr: "v1/serviceaccounts", e: true},
+		"sa-cols": {gvr: "v1/serviceaccounts", cols: render.CustomColumns{{Name: "X", Label: "app"}}},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ta := NewTable(client.NewGVR(u.gvr))
+			ta.customCols = u.cols
+			assert.Equal(t, u.e, ta.stable())
+		})
+	}
+}
+
+func TestRowSignature(t *testing.T) {
+	p1 := mustLoad("p1")
+	noRV := p1.DeepCopy()
+	noRV.SetResourceVersion("")
+	util, cost := 42.5, 1.25
+
+	uu := map[string]struct {
+		o       runtime.Object
+		id, sig string
+		ok      bool
+	}{
+		"raw": {
+			o:   p1,
+			id:  "default/nginx-7fb78fb6d8-2w75j",
+			sig: "87290191",
+			ok:  true,
+		},
+		"metrics": {
+			o:   &render.PodWithMetrics{Raw: p1},
+			id:  "default/nginx-7fb78fb6d8-2w75j",
+			sig: "87290191:0x0:0",
+			ok:  true,
+		},
+		"restart-trend": {
+			o:   &render.PodWithMetrics{Raw: p1, RestartTrend: 2},
+			id:  "default/nginx-7fb78fb6d8-2w75j",
+			sig: "87290191:0x0:2",
+			ok:  true,
+		},
+		"node-extended": {
+			o: &render.NodeWithMetrics{
+				Raw:      p1,
+				PodCount: 2,
+				Requests: v1.ResourceList{"nvidia.com/gpu": resource.MustParse("2")},
+				GPUUtil:  &util,
+			},
+			id:  "default/nginx-7fb78fb6d8-2w75j",
+			sig: "87290191:0x0:2:nvidia.com/gpu=2000:42.5",
+			ok:  true,
+		},
+		"namespace-cost": {
+			o:   &render.NamespaceWithCost{Raw: p1, Cost: &cost},
+			id:  "default/nginx-7fb78fb6d8-2w75j",
+			sig: "87290191:1.2500",
+			ok:  true,
+		},
+		"no-version": {
+			o: noRV,
+		},
+		"table": {
+			o: &metav1beta1.Table{},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			id, sig, _, ok := rowSignature(u.o)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.id, id)
+			assert.Equal(t, u.sig, sig)
+		})
+	}
 }
 
 func TestTableGenericHydrate(t *testing.T) {
@@ -231,3 +501,78 @@ func (a *accessor) Init(_ dao.Factory, gvr client.GVR) {
 func (a *accessor) GVR() string {
 	return a.gvr.String()
 }
+
+type pagedAccessor struct {
+	accessor
+	total, calls, listed int
+	expired              bool
+}
+
+// List lists a chunk of pods using the continue token as the chunk offset.
+func (a *pagedAccessor) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	a.calls++
+	from, n := 0, a.total
+	if p, ok := ctx.Value(internal.KeyPage).(*dao.Page); ok && p.Limit > 0 {
+		if p.Continue != "" {
+			if a.expired {
+				a.expired = false
+				return nil, apierrors.NewResourceExpired("continue token expired")
+			}
+			from, _ = strconv.Atoi(p.Continue)
+		}
+		n, p.Continue, p.Remaining = a.total-from, "", -1
+		if from+int(p.Limit) < a.total {
+			n, p.Continue, p.Remaining = int(p.Limit), strconv.Itoa(from+int(p.Limit)), int64(a.total-from)-p.Limit
+		}
+	}
+	a.listed += n
+	oo := make([]runtime.Object, n)
+	for i := range oo {
+		oo[i] = &render.PodWithMetrics{Raw: mustLoad("p1")}
+	}
+
+	return oo, nil
+}
+
+type partialAccessor struct {
+	accessor
+}
+
+func (a *partialAccessor) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	return []runtime.Object{&render.PodWithMetrics{Raw: mustLoad("p1")}}, dao.PartialError{"ns2": errors.New("denied")}
+}
+
+type failedListener struct {
+	errs []error
+}
+
+func (*failedListener) TableDataChanged(*render.TableData) {}
+
+func (l *failedListener) TableLoadFailed(err error) {
+	l.errs = append(l.errs, err)
+}
+
+type countingRenderer struct {
+	render.Pod
+	count *int
+}
+
+type countingStableRenderer struct {
+	render.ServiceAccount
+	count  *int
+	stable bool
+}
+
+func (c countingStableRenderer) Stable() bool {
+	return c.stable
+}
+
+func (c countingStableRenderer) Render(o interface{}, ns string, row *render.Row) error {
+	*c.count++
+	return c.ServiceAccount.Render(o, ns, row)
+}
+
+func (c countingRenderer) Render(o interface{}, ns string, row *render.Row) error {
+	*c.count++
+	return c.Pod.Render(o, ns, row)
+}
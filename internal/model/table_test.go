@@ -33,13 +33,31 @@ func TestTableRefresh(t *testing.T) {
 	ctx = context.WithValue(ctx, internal.KeyWithMetrics, false)
 	assert.NoError(t, ta.Refresh(ctx))
 	data := ta.Peek()
-	assert.Equal(t, 22, len(data.Header))
+	assert.Equal(t, 28, len(data.Header))
 	assert.Equal(t, 1, len(data.RowEvents))
 	assert.Equal(t, client.NamespaceAll, data.Namespace)
 	assert.Equal(t, 1, l.count)
 	assert.Equal(t, 0, l.errs)
 }
 
+func TestTableRefreshIsolated(t *testing.T) {
+	ta := model.NewTable(client.NewGVR("v1/pods"))
+	ta.SetNamespace(client.NamespaceAll)
+	ta.Isolate()
+	p, ok := model.Registry["v1/pods"].DAO.(*dao.Pod)
+	assert.True(t, ok)
+	p.Init(makeFactory(), client.NewGVR("v1/pods"))
+
+	f := makeTableFactory()
+	f.rows = []runtime.Object{mustLoad("p1")}
+	ctx := context.WithValue(context.Background(), internal.KeyFactory, f)
+	ctx = context.WithValue(ctx, internal.KeyFields, "")
+	ctx = context.WithValue(ctx, internal.KeyWithMetrics, false)
+	assert.NoError(t, ta.Refresh(ctx))
+	assert.Equal(t, 1, len(ta.Peek().RowEvents))
+	assert.Equal(t, makeFactory(), p.GetFactory())
+}
+
 func TestTableNS(t *testing.T) {
 	ta := model.NewTable(client.NewGVR("v1/pods"))
 	ta.SetNamespace("blee")
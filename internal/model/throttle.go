@@ -0,0 +1,47 @@
+package model
+
+import (
+	"sync"
+	"time"
+)
+
+// throttle tracks the refresh rate applied to all models while the user is idle.
+type throttle struct {
+	rate time.Duration
+	wake chan struct{}
+	mx   sync.RWMutex
+}
+
+var throttler = throttle{wake: make(chan struct{})}
+
+// Throttle lowers all models refresh rate to at most once per rate. A zero
+// rate restores the models own refresh rate and wakes them up right away.
+func Throttle(rate time.Duration) {
+	throttler.mx.Lock()
+	defer throttler.mx.Unlock()
+	if throttler.rate > 0 && rate == 0 {
+		close(throttler.wake)
+		throttler.wake = make(chan struct{})
+	}
+	throttler.rate = rate
+}
+
+// Throttled checks if models are throttled.
+func Throttled() bool {
+	throttler.mx.RLock()
+	defer throttler.mx.RUnlock()
+
+	return throttler.rate > 0
+}
+
+// throttled returns the effective refresh rate and a channel closed once
+// the throttle is lifted.
+func throttled(rate time.Duration) (time.Duration, <-chan struct{}) {
+	throttler.mx.RLock()
+	defer throttler.mx.RUnlock()
+	if throttler.rate > rate {
+		return throttler.rate, throttler.wake
+	}
+
+	return rate, throttler.wake
+}
@@ -0,0 +1,41 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestThrottle(t *testing.T) {
+	defer Throttle(0)
+
+	r, _ := throttled(2 * time.Second)
+	assert.Equal(t, 2*time.Second, r)
+	assert.False(t, Throttled())
+
+	Throttle(30 * time.Second)
+	assert.True(t, Throttled())
+	r, wake := throttled(2 * time.Second)
+	assert.Equal(t, 30*time.Second, r)
+	r, _ = throttled(time.Minute)
+	assert.Equal(t, time.Minute, r)
+
+	Throttle(0)
+	assert.False(t, Throttled())
+	select {
+	case <-wake:
+	default:
+		assert.Fail(t, "expecting models to be woken up")
+	}
+}
+
+func TestTableSettling(t *testing.T) {
+	ta := NewTable(client.NewGVR("v1/pods"))
+	assert.Equal(t, time.Duration(0), ta.settling())
+
+	ta.lastEvent.Store(time.Now().UnixNano())
+	d := ta.settling()
+	assert.True(t, d > 0 && d <= eventQuiet)
+}
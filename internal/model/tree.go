@@ -14,6 +14,7 @@ import (
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/xray"
 	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
@@ -38,6 +39,7 @@ type Tree struct {
 	inUpdate    int32
 	refreshRate time.Duration
 	query       string
+	instance    string
 }
 
 // NewTree returns a new model.
@@ -103,6 +105,16 @@ func (t *Tree) SetNamespace(ns string) {
 	t.root.Clear()
 }
 
+// SetInstance scopes the model to a single resource instance.
+func (t *Tree) SetInstance(path string) {
+	t.instance = path
+}
+
+// GetInstance returns the model resource instance if any.
+func (t *Tree) GetInstance() string {
+	return t.instance
+}
+
 // SetRefreshRate sets model refresh duration.
 func (t *Tree) SetRefreshRate(d time.Duration) {
 	t.refreshRate = d
@@ -140,7 +152,7 @@ func (t *Tree) Describe(ctx context.Context, gvr, path string) (string, error) {
 		return "", fmt.Errorf("no describer for %q", meta.DAO.GVR())
 	}
 
-	return desc.Describe(path)
+	return desc.Describe(ctx, path)
 }
 
 // ToYAML returns a resource yaml.
@@ -155,7 +167,7 @@ func (t *Tree) ToYAML(ctx context.Context, gvr, path string) (string, error) {
 		return "", fmt.Errorf("no describer for %q", meta.DAO.GVR())
 	}
 
-	return desc.ToYAML(path, false)
+	return desc.ToYAML(ctx, path, false)
 }
 
 func (t *Tree) updater(ctx context.Context) {
@@ -204,6 +216,9 @@ func (t *Tree) reconcile(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	if t.instance != "" {
+		oo = instanceFilter(oo, t.instance)
+	}
 
 	ns := client.CleanseNamespace(t.namespace)
 	res := t.gvr.R()
@@ -286,6 +301,31 @@ func rxFilter(q, path string) bool {
 	return false
 }
 
+// instanceFilter returns the resources matching a given path.
+func instanceFilter(oo []runtime.Object, path string) []runtime.Object {
+	rr := make([]runtime.Object, 0, 1)
+	for _, o := range oo {
+		raw := o
+		switch v := o.(type) {
+		case *render.PodWithMetrics:
+			raw = v.Raw
+		case *render.StatefulSetWithOrdinals:
+			raw = v.Raw
+		case *render.APFWithStats:
+			raw = v.Raw
+		}
+		m, err := meta.Accessor(raw)
+		if err != nil {
+			continue
+		}
+		if client.FQN(m.GetNamespace(), m.GetName()) == path {
+			rr = append(rr, o)
+		}
+	}
+
+	return rr
+}
+
 func treeHydrate(ctx context.Context, ns string, oo []runtime.Object, re TreeRenderer) error {
 	if re == nil {
 		return fmt.Errorf("no tree renderer defined for this resource")
@@ -97,6 +97,19 @@ type Renderer interface {
 	ColorerFunc() render.ColorerFunc
 }
 
+// StableRenderer represents a renderer with no derived or external inputs.
+// Its rows may be reused until the resources change.
+type StableRenderer interface {
+	// Stable checks if rows only change when the resources change.
+	Stable() bool
+}
+
+// MetadataRenderer represents a renderer only requiring resources metadata.
+type MetadataRenderer interface {
+	// MetadataOnly checks if only the resources metadata are rendered.
+	MetadataOnly() bool
+}
+
 // Cruder performs crud operations.
 type Cruder interface {
 	// List returns a collection of resources.
@@ -40,6 +40,11 @@ func NewYAML(gvr client.GVR, path string) *YAML {
 	}
 }
 
+// GVR returns the resource gvr.
+func (y *YAML) GVR() client.GVR {
+	return y.gvr
+}
+
 // GetPath returns the active resource path.
 func (y *YAML) GetPath() string {
 	return y.path
@@ -168,7 +173,11 @@ func (y *YAML) refresh(ctx context.Context) error {
 }
 
 func (y *YAML) reconcile(ctx context.Context) error {
-	s, err := y.ToYAML(ctx, y.gvr, y.path, y.options[ManagedFieldsOpts])
+	var s string
+	err := withCallTimeout(ctx, y.gvr, func(ctx context.Context) (err error) {
+		s, err = y.ToYAML(ctx, y.gvr, y.path, y.options[ManagedFieldsOpts])
+		return
+	})
 	if err != nil {
 		return err
 	}
@@ -214,7 +223,7 @@ func (y *YAML) ToYAML(ctx context.Context, gvr client.GVR, path string, showMana
 		return "", fmt.Errorf("no describer for %q", meta.DAO.GVR())
 	}
 
-	return desc.ToYAML(path, showManaged)
+	return desc.ToYAML(ctx, path, showManaged)
 }
 
 func getMeta(ctx context.Context, gvr client.GVR) (ResourceMeta, error) {
@@ -0,0 +1,283 @@
+package model
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// FoldMarker indicates a folded yaml node.
+const FoldMarker = " ⋯"
+
+var pathSegRX = regexp.MustCompile(`^([^\[\]]*)((?:\[\d+\])*)$`)
+
+// Folds tracks folded yaml lines.
+type Folds map[int]struct{}
+
+// Toggle folds or unfolds a given line.
+func (f Folds) Toggle(i int) {
+	if _, ok := f[i]; ok {
+		delete(f, i)
+		return
+	}
+	f[i] = struct{}{}
+}
+
+// Reveal unfolds all the nodes hiding a given line.
+func (f Folds) Reveal(lines []string, i int) {
+	for l := range f {
+		if l < i && i < YAMLBlockEnd(lines, l) {
+			delete(f, l)
+		}
+	}
+}
+
+// Remap carries folds over to a new revision of the content. Folded lines are
+// matched by text so collapsed sections survive refreshes.
+func (f Folds) Remap(prev, lines []string) Folds {
+	ff := make(Folds, len(f))
+	for i := range f {
+		if j := matchLine(prev, lines, i); j >= 0 && IsFoldable(lines, j) {
+			ff[j] = struct{}{}
+		}
+	}
+
+	return ff
+}
+
+// TopLevelFolds returns folds for all top level yaml nodes.
+func TopLevelFolds(lines []string) Folds {
+	ff := make(Folds)
+	for i, l := range lines {
+		if yamlIndent(l) == 0 && IsFoldable(lines, i) {
+			ff[i] = struct{}{}
+		}
+	}
+
+	return ff
+}
+
+// IsFoldable checks if a line has nested yaml nodes.
+func IsFoldable(lines []string, i int) bool {
+	return YAMLBlockEnd(lines, i) > i+1
+}
+
+// YAMLParent returns the index of the node enclosing a given line or -1 if none.
+func YAMLParent(lines []string, i int) int {
+	for j := i - 1; j >= 0; j-- {
+		if YAMLBlockEnd(lines, j) > i {
+			return j
+		}
+	}
+
+	return -1
+}
+
+// FoldYAML returns the indices of the visible lines given a set of folds.
+func FoldYAML(lines []string, folds Folds) []int {
+	vv := make([]int, 0, len(lines))
+	for i := 0; i < len(lines); i++ {
+		vv = append(vv, i)
+		if _, ok := folds[i]; ok {
+			i = YAMLBlockEnd(lines, i) - 1
+		}
+	}
+
+	return vv
+}
+
+// YAMLBlockEnd returns the index past the last line nested under a given line.
+func YAMLBlockEnd(lines []string, i int) int {
+	if i < 0 || i >= len(lines) {
+		return i + 1
+	}
+	p := parseYAMLLine(lines[i])
+	j := i + 1
+	for ; j < len(lines); j++ {
+		if strings.TrimSpace(lines[j]) == "" {
+			break
+		}
+		c := parseYAMLLine(lines[j])
+		if p.dash && c.indent > p.indent {
+			continue
+		}
+		if !p.dash && p.key != "" && (c.indent > p.indent || (c.dash && c.indent == p.indent)) {
+			continue
+		}
+		break
+	}
+
+	return j
+}
+
+// YAMLPathLine returns the line index matching a path expression of the form
+// .spec.template.spec.containers[0].name.
+func YAMLPathLine(lines []string, path string) (int, error) {
+	segs, err := parseYAMLPath(path)
+	if err != nil {
+		return -1, err
+	}
+	start, end, cur := 0, len(lines), -1
+	for _, s := range segs {
+		if s.key != "" {
+			i := findYAMLKey(lines, start, end, s.key)
+			if i < 0 {
+				return -1, fmt.Errorf("no yaml node found for %q in path %q", s.key, path)
+			}
+			cur, start, end = i, i+1, YAMLBlockEnd(lines, i)
+			continue
+		}
+		i := findYAMLItem(lines, start, end, s.index)
+		if i < 0 {
+			return -1, fmt.Errorf("no yaml item found for index %d in path %q", s.index, path)
+		}
+		cur, start, end = i, i, YAMLBlockEnd(lines, i)
+	}
+	if cur < 0 {
+		return -1, fmt.Errorf("invalid yaml path %q", path)
+	}
+
+	return cur, nil
+}
+
+// YAMLFieldPath returns the field keys leading to a given line, ignoring
+// sequence indices ie spec.template.spec.containers.name.
+func YAMLFieldPath(lines []string, i int) []string {
+	var path []string
+	for j := i; j >= 0 && j < len(lines); j = YAMLParent(lines, j) {
+		p := parseYAMLLine(lines[j])
+		if p.key == "" || (p.dash && j != i) {
+			continue
+		}
+		path = append([]string{p.key}, path...)
+	}
+
+	return path
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+type yamlLine struct {
+	indent, keyIndent int
+	dash              bool
+	key               string
+}
+
+func parseYAMLLine(l string) yamlLine {
+	t := strings.TrimLeft(l, " ")
+	y := yamlLine{indent: len(l) - len(t)}
+	y.keyIndent = y.indent
+	if t == "-" || strings.HasPrefix(t, "- ") {
+		y.dash, y.keyIndent = true, y.indent+2
+		t = strings.TrimPrefix(strings.TrimPrefix(t, "-"), " ")
+	}
+	if idx := strings.Index(t, ":"); idx > 0 && (idx == len(t)-1 || t[idx+1] == ' ') {
+		y.key = strings.Trim(t[:idx], `"'`)
+	}
+
+	return y
+}
+
+// matchLine returns the index of the nth occurrence of a previous line in the
+// new lines, n being the occurrence rank in the previous lines or -1 if none.
+func matchLine(prev, lines []string, i int) int {
+	if i < 0 || i >= len(prev) {
+		return -1
+	}
+	var n int
+	for j := 0; j < i; j++ {
+		if prev[j] == prev[i] {
+			n++
+		}
+	}
+	for j, l := range lines {
+		if l != prev[i] {
+			continue
+		}
+		if n == 0 {
+			return j
+		}
+		n--
+	}
+
+	return -1
+}
+
+func yamlIndent(l string) int {
+	return len(l) - len(strings.TrimLeft(l, " "))
+}
+
+func findYAMLKey(lines []string, start, end int, key string) int {
+	indent := -1
+	for i := start; i < end && i < len(lines); i++ {
+		if strings.TrimSpace(lines[i]) == "" {
+			continue
+		}
+		if p := parseYAMLLine(lines[i]); indent < 0 || p.keyIndent < indent {
+			indent = p.keyIndent
+		}
+	}
+	for i := start; i < end && i < len(lines); i++ {
+		if p := parseYAMLLine(lines[i]); p.keyIndent == indent && p.key == key {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func findYAMLItem(lines []string, start, end, index int) int {
+	indent := -1
+	for i := start; i < end && i < len(lines); i++ {
+		if p := parseYAMLLine(lines[i]); p.dash && (indent < 0 || p.indent < indent) {
+			indent = p.indent
+		}
+	}
+	var n int
+	for i := start; i < end && i < len(lines); i++ {
+		if p := parseYAMLLine(lines[i]); p.dash && p.indent == indent {
+			if n == index {
+				return i
+			}
+			n++
+		}
+	}
+
+	return -1
+}
+
+type yamlPathSeg struct {
+	key   string
+	index int
+}
+
+func parseYAMLPath(path string) ([]yamlPathSeg, error) {
+	path = strings.TrimPrefix(strings.TrimSpace(path), ".")
+	if path == "" {
+		return nil, fmt.Errorf("invalid yaml path %q", path)
+	}
+	var segs []yamlPathSeg
+	for _, tok := range strings.Split(path, ".") {
+		m := pathSegRX.FindStringSubmatch(tok)
+		if m == nil || (m[1] == "" && m[2] == "") {
+			return nil, fmt.Errorf("invalid yaml path segment %q", tok)
+		}
+		if m[1] != "" {
+			segs = append(segs, yamlPathSeg{key: m[1]})
+		}
+		for _, idx := range strings.Split(strings.Trim(m[2], "[]"), "][") {
+			if idx == "" {
+				continue
+			}
+			n, err := strconv.Atoi(idx)
+			if err != nil {
+				return nil, err
+			}
+			segs = append(segs, yamlPathSeg{index: n})
+		}
+	}
+
+	return segs, nil
+}
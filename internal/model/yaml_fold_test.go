@@ -0,0 +1,193 @@
+package model_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/derailed/k9s/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+const podYAML = `apiVersion: v1
+kind: Pod
+metadata:
+  name: fred
+  labels:
+    app: blee
+spec:
+  containers:
+  - name: c1
+    image: nginx
+    ports:
+    - containerPort: 80
+  - name: c2
+    image: busybox
+status:
+  phase: Running`
+
+func TestYAMLPathLine(t *testing.T) {
+	uu := map[string]struct {
+		path string
+		e    int
+		err  bool
+	}{
+		"top":        {path: ".kind", e: 1},
+		"nested":     {path: ".metadata.labels.app", e: 5},
+		"no-dot":     {path: "spec.containers", e: 7},
+		"item":       {path: ".spec.containers[1]", e: 12},
+		"item-field": {path: ".spec.containers[1].image", e: 13},
+		"deep":       {path: ".spec.containers[0].ports[0].containerPort", e: 11},
+		"missing":    {path: ".spec.volumes", e: -1, err: true},
+		"bad-index":  {path: ".spec.containers[5]", e: -1, err: true},
+		"blank":      {path: "", e: -1, err: true},
+	}
+
+	lines := strings.Split(podYAML, "\n")
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			i, err := model.YAMLPathLine(lines, u.path)
+			assert.Equal(t, u.err, err != nil)
+			assert.Equal(t, u.e, i)
+		})
+	}
+}
+
+func TestYAMLFieldPath(t *testing.T) {
+	uu := map[string]struct {
+		line int
+		e    []string
+	}{
+		"top":        {line: 1, e: []string{"kind"}},
+		"nested":     {line: 5, e: []string{"metadata", "labels", "app"}},
+		"item":       {line: 8, e: []string{"spec", "containers", "name"}},
+		"item-field": {line: 9, e: []string{"spec", "containers", "image"}},
+		"deep":       {line: 11, e: []string{"spec", "containers", "ports", "containerPort"}},
+		"out":        {line: 42},
+	}
+
+	lines := strings.Split(podYAML, "\n")
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, model.YAMLFieldPath(lines, u.line))
+		})
+	}
+}
+
+func TestYAMLBlockEnd(t *testing.T) {
+	uu := map[string]struct {
+		line, e int
+	}{
+		"scalar":     {line: 0, e: 1},
+		"map":        {line: 2, e: 6},
+		"list":       {line: 7, e: 14},
+		"item":       {line: 8, e: 12},
+		"nested":     {line: 10, e: 12},
+		"last-block": {line: 14, e: 16},
+	}
+
+	lines := strings.Split(podYAML, "\n")
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, model.YAMLBlockEnd(lines, u.line))
+		})
+	}
+}
+
+func TestFoldYAML(t *testing.T) {
+	uu := map[string]struct {
+		folds model.Folds
+		e     []int
+	}{
+		"none": {
+			folds: model.Folds{},
+			e:     []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15},
+		},
+		"item": {
+			folds: model.Folds{8: {}},
+			e:     []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 12, 13, 14, 15},
+		},
+		"nested": {
+			folds: model.Folds{6: {}, 8: {}},
+			e:     []int{0, 1, 2, 3, 4, 5, 6, 14, 15},
+		},
+	}
+
+	lines := strings.Split(podYAML, "\n")
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, model.FoldYAML(lines, u.folds))
+		})
+	}
+}
+
+func TestFoldsReveal(t *testing.T) {
+	lines := strings.Split(podYAML, "\n")
+	ff := model.TopLevelFolds(lines)
+	assert.Equal(t, model.Folds{2: {}, 6: {}, 14: {}}, ff)
+
+	ff.Toggle(8)
+	ff.Reveal(lines, 9)
+	assert.Equal(t, model.Folds{2: {}, 14: {}}, ff)
+	assert.Equal(t, 8, model.YAMLParent(lines, 9))
+	assert.Equal(t, -1, model.YAMLParent(lines, 2))
+}
+
+const podDescribe = `Name:         fred
+Namespace:    default
+Labels:       app=blee
+              tier=web
+Containers:
+  c1:
+    Image:      nginx
+    Ports:      80/TCP
+Conditions:
+  Type              Status
+  Ready             True
+Events:
+  Type    Reason     Age   From     Message
+  ----    ------     ----  ----     -------
+  Normal  Scheduled  10s   default  Successfully assigned default/fred`
+
+func TestFoldDescribe(t *testing.T) {
+	lines := strings.Split(podDescribe, "\n")
+	ff := model.TopLevelFolds(lines)
+
+	assert.Equal(t, model.Folds{2: {}, 4: {}, 8: {}, 11: {}}, ff)
+	assert.Equal(t, []int{0, 1, 2, 4, 8, 11}, model.FoldYAML(lines, ff))
+}
+
+func TestFoldsRemap(t *testing.T) {
+	prev := strings.Split(podDescribe, "\n")
+	uu := map[string]struct {
+		folds model.Folds
+		lines []string
+		e     model.Folds
+	}{
+		"new-event": {
+			folds: model.Folds{4: {}, 11: {}},
+			lines: append(prev[:len(prev):len(prev)], "  Normal  Pulled  5s  kubelet  Image pulled"),
+			e:     model.Folds{4: {}, 11: {}},
+		},
+		"shifted": {
+			folds: model.Folds{8: {}},
+			lines: strings.Split(strings.Replace(podDescribe, "80/TCP", "80/TCP\n    State:      Running", 1), "\n"),
+			e:     model.Folds{9: {}},
+		},
+		"gone": {
+			folds: model.Folds{2: {}},
+			lines: strings.Split(strings.Replace(podDescribe, "\n              tier=web", "", 1), "\n"),
+			e:     model.Folds{},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, u.folds.Remap(prev, u.lines))
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"github.com/derailed/k9s/internal/dao"
 	"io"
+	"math"
 	"net/http"
 	"os"
 	"path/filepath"
@@ -37,6 +38,9 @@ type Benchmark struct {
 	config   config.BenchConfig
 	worker   *requester.Work
 	cancelFn context.CancelFunc
+	report   string
+	started  time.Time
+	stopOnce sync.Once
 	mx       sync.RWMutex
 }
 
@@ -51,7 +55,11 @@ func NewBenchmark(base, version string, cfg config.BenchConfig) (*Benchmark, err
 
 func (b *Benchmark) init(base, version string) error {
 	var ctx context.Context
-	ctx, b.cancelFn = context.WithTimeout(context.Background(), benchTimeout)
+	timeout := benchTimeout
+	if b.config.Duration > 0 {
+		timeout += b.config.Duration
+	}
+	ctx, b.cancelFn = context.WithTimeout(context.Background(), timeout)
 	req, err := http.NewRequestWithContext(ctx, b.config.HTTP.Method, base, nil)
 	if err != nil {
 		return err
@@ -76,13 +84,19 @@ func (b *Benchmark) init(base, version string) error {
 
 	log.Debug().Msgf("Using bench config N:%d--C:%d", b.config.N, b.config.C)
 
+	n := b.config.N
+	if b.config.Duration > 0 {
+		// Duration based runs are bound by time, not by request count.
+		n = math.MaxInt32
+	}
 	b.worker = &requester.Work{
 		Request:     req,
 		RequestBody: []byte(b.config.HTTP.Body),
-		N:           b.config.N,
+		N:           n,
 		C:           b.config.C,
 		H2:          b.config.HTTP.HTTP2,
 	}
+	b.worker.Init()
 
 	return nil
 }
@@ -96,6 +110,7 @@ func (b *Benchmark) Cancel() {
 	b.mx.Lock()
 	defer b.mx.Unlock()
 	b.canceled = true
+	b.stop()
 	if b.cancelFn != nil {
 		b.cancelFn()
 		b.cancelFn = nil
@@ -107,15 +122,48 @@ func (b *Benchmark) Canceled() bool {
 	return b.canceled
 }
 
+// Report returns the benchmark report once the run completed.
+func (b *Benchmark) Report() string {
+	b.mx.RLock()
+	defer b.mx.RUnlock()
+
+	return b.report
+}
+
+// Elapsed returns the time spent running the benchmark.
+func (b *Benchmark) Elapsed() time.Duration {
+	b.mx.RLock()
+	defer b.mx.RUnlock()
+
+	if b.started.IsZero() {
+		return 0
+	}
+
+	return time.Since(b.started)
+}
+
 // Run starts a benchmark,.
 func (b *Benchmark) Run(cluster string, done func()) {
 	log.Debug().Msgf("Running benchmark on cluster %s", cluster)
 	buff := new(bytes.Buffer)
 	b.worker.Writer = buff
+	b.mx.Lock()
+	b.started = time.Now()
+	b.mx.Unlock()
+	var timer *time.Timer
+	if b.config.Duration > 0 {
+		timer = time.AfterFunc(b.config.Duration, b.stop)
+	}
 	// this call will block until the benchmark is complete or times out.
 	b.worker.Run()
-	b.worker.Stop()
+	if timer != nil {
+		timer.Stop()
+	}
+	b.stop()
 	if len(buff.Bytes()) > 0 {
+		b.mx.Lock()
+		b.report = buff.String()
+		b.mx.Unlock()
 		if err := b.save(cluster, buff); err != nil {
 			log.Error().Err(err).Msg("Saving Benchmark")
 		}
@@ -123,6 +171,10 @@ func (b *Benchmark) Run(cluster string, done func()) {
 	done()
 }
 
+func (b *Benchmark) stop() {
+	b.stopOnce.Do(b.worker.Stop)
+}
+
 func (b *Benchmark) save(cluster string, r io.Reader) error {
 	dir := filepath.Join(K9sBenchDir, cluster)
 	if err := os.MkdirAll(dir, 0744); err != nil {
@@ -0,0 +1,70 @@
+package perf
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strconv"
+	"text/tabwriter"
+)
+
+var summaryRx = regexp.MustCompile(`(?m)^\s+(Total|Slowest|Fastest|Average|Requests/sec):\s+([0-9.]+)`)
+
+// SummaryMetrics tracks the benchmark summary metrics in report order.
+var SummaryMetrics = []string{"Total", "Slowest", "Fastest", "Average", "Requests/sec"}
+
+// Summary represents a benchmark report summary.
+type Summary map[string]float64
+
+// ParseSummary extracts the summary section of a benchmark report.
+func ParseSummary(report string) Summary {
+	s := make(Summary, len(SummaryMetrics))
+	for _, m := range summaryRx.FindAllStringSubmatch(report, -1) {
+		if _, ok := s[m[1]]; ok {
+			continue
+		}
+		if v, err := strconv.ParseFloat(m[2], 64); err == nil {
+			s[m[1]] = v
+		}
+	}
+
+	return s
+}
+
+// Compare renders benchmark summaries side by side using the first run as baseline.
+func Compare(names []string, ss []Summary) string {
+	buff := new(bytes.Buffer)
+	w := tabwriter.NewWriter(buff, 0, 0, 2, ' ', 0)
+	fmt.Fprint(w, "METRIC")
+	for _, n := range names {
+		fmt.Fprintf(w, "\t%s", n)
+	}
+	fmt.Fprintln(w)
+	for _, m := range SummaryMetrics {
+		fmt.Fprint(w, m)
+		for i, s := range ss {
+			v, ok := s[m]
+			if !ok {
+				fmt.Fprint(w, "\tn/a")
+				continue
+			}
+			if i == 0 {
+				fmt.Fprintf(w, "\t%.4f", v)
+				continue
+			}
+			fmt.Fprintf(w, "\t%.4f (%s)", v, delta(ss[0][m], v))
+		}
+		fmt.Fprintln(w)
+	}
+	_ = w.Flush()
+
+	return buff.String()
+}
+
+func delta(base, v float64) string {
+	if base == 0 {
+		return "n/a"
+	}
+
+	return fmt.Sprintf("%+.1f%%", (v-base)/base*100)
+}
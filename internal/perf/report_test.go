@@ -0,0 +1,44 @@
+package perf_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/perf"
+	"github.com/stretchr/testify/assert"
+)
+
+const heyReport = `
+Summary:
+  Total:	2.0000 secs
+  Slowest:	0.0200 secs
+  Fastest:	0.0010 secs
+  Average:	0.0050 secs
+  Requests/sec:	100.0000
+
+Response time histogram:
+  0.001 [1]	|
+`
+
+func TestParseSummary(t *testing.T) {
+	s := perf.ParseSummary(heyReport)
+
+	assert.Equal(t, perf.Summary{
+		"Total":        2,
+		"Slowest":      0.02,
+		"Fastest":      0.001,
+		"Average":      0.005,
+		"Requests/sec": 100,
+	}, s)
+}
+
+func TestCompare(t *testing.T) {
+	s1 := perf.ParseSummary(heyReport)
+	s2 := perf.Summary{"Total": 1, "Requests/sec": 200}
+
+	out := perf.Compare([]string{"r1", "r2"}, []perf.Summary{s1, s2})
+
+	assert.Contains(t, out, "METRIC")
+	assert.Contains(t, out, "1.0000 (-50.0%)")
+	assert.Contains(t, out, "200.0000 (+100.0%)")
+	assert.Contains(t, out, "n/a")
+}
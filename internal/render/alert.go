@@ -0,0 +1,85 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/derailed/tcell/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Alert renders cluster alerts to screen.
+type Alert struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (Alert) ColorerFunc() ColorerFunc {
+	return func(ns string, h Header, re RowEvent) tcell.Color {
+		return ErrColor
+	}
+}
+
+// Header returns a header row.
+func (Alert) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "RULE"},
+		HeaderColumn{Name: "KIND"},
+		HeaderColumn{Name: "RESOURCE"},
+		HeaderColumn{Name: "MESSAGE"},
+		HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (Alert) Render(o interface{}, ns string, r *Row) error {
+	a, ok := o.(AlertRes)
+	if !ok {
+		return fmt.Errorf("expected AlertRes, but got %T", o)
+	}
+
+	r.ID = a.ID()
+	r.Fields = append(r.Fields,
+		a.Rule,
+		a.Kind,
+		a.Resource,
+		a.Message,
+		toAge(metav1.NewTime(a.Since)),
+	)
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// AlertRes represents a raised cluster alert.
+type AlertRes struct {
+	Rule, Kind string
+	// RuleIndex the raising rule position as rule names may be duplicated.
+	RuleIndex int
+	// GVR the offending resource gvr.
+	GVR string
+	// Resource the offending resource path.
+	Resource string
+	Message  string
+	Since    time.Time
+}
+
+// ID returns the alert unique id.
+func (a AlertRes) ID() string {
+	return strconv.Itoa(a.RuleIndex) + "|" + a.Rule + "|" + a.Resource
+}
+
+// GetObjectKind returns a schema object.
+func (AlertRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (a AlertRes) DeepCopyObject() runtime.Object {
+	return a
+}
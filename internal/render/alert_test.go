@@ -0,0 +1,33 @@
+package render_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAlertRender(t *testing.T) {
+	o := render.AlertRes{
+		Rule:     "r1",
+		Kind:     "crashLoop",
+		GVR:      "v1/pods",
+		Resource: "ns1/p1",
+		Message:  "container c1 is crash looping (5 restarts)",
+		Since:    time.Now().Add(-2 * time.Minute),
+	}
+
+	var (
+		a render.Alert
+		r render.Row
+	)
+	assert.Nil(t, a.Render(o, "", &r))
+	assert.Equal(t, "0|r1|ns1/p1", r.ID)
+	assert.Equal(t, render.Fields{
+		"r1",
+		"crashLoop",
+		"ns1/p1",
+		"container c1 is crash looping (5 restarts)",
+	}, r.Fields[:4])
+}
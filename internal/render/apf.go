@@ -0,0 +1,325 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// APFSeatsSaturation flags priority levels using at least this share of their seats.
+const APFSeatsSaturation = 90
+
+// FlowSchema renders a K8s FlowSchema to screen.
+type FlowSchema struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (FlowSchema) ColorerFunc() ColorerFunc {
+	return apfColorer
+}
+
+// Header returns a header row.
+func (FlowSchema) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "PRIORITY LEVEL"},
+		HeaderColumn{Name: "PRECEDENCE", Align: tview.AlignRight},
+		HeaderColumn{Name: "DISTINGUISHER"},
+		HeaderColumn{Name: "WAITING", Align: tview.AlignRight},
+		HeaderColumn{Name: "SEATS", Align: tview.AlignRight},
+		HeaderColumn{Name: "REJECTED", Align: tview.AlignRight},
+		HeaderColumn{Name: "RULES", Align: tview.AlignRight, Wide: true},
+		HeaderColumn{Name: "LABELS", Wide: true},
+		HeaderColumn{Name: "VALID", Wide: true},
+		HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (FlowSchema) Render(o interface{}, ns string, r *Row) error {
+	a, err := apfObject(o)
+	if err != nil {
+		return err
+	}
+	raw, stats := a.Raw, a.Stats
+
+	pl, _, _ := unstructured.NestedString(raw.Object, "spec", "priorityLevelConfiguration", "name")
+	prec, _, _ := unstructured.NestedInt64(raw.Object, "spec", "matchingPrecedence")
+	dist, _, _ := unstructured.NestedString(raw.Object, "spec", "distinguisherMethod", "type")
+	rules, _, _ := unstructured.NestedSlice(raw.Object, "spec", "rules")
+
+	r.ID = client.FQN(client.ClusterScope, raw.GetName())
+	r.Fields = Fields{
+		apfName(a),
+		pl,
+		strconv.Itoa(int(prec)),
+		na(dist),
+		stats.waiting(),
+		stats.seats(),
+		stats.rejected(),
+		strconv.Itoa(len(rules)),
+		mapToStr(raw.GetLabels()),
+		asStatus(diagnoseFlowSchema(raw, stats)),
+		toAge(raw.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+// PriorityLevel renders a K8s PriorityLevelConfiguration to screen.
+type PriorityLevel struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (PriorityLevel) ColorerFunc() ColorerFunc {
+	return apfColorer
+}
+
+// Header returns a header row.
+func (PriorityLevel) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "TYPE"},
+		HeaderColumn{Name: "SHARES", Align: tview.AlignRight},
+		HeaderColumn{Name: "QUEUES", Align: tview.AlignRight},
+		HeaderColumn{Name: "HAND SIZE", Align: tview.AlignRight, Wide: true},
+		HeaderColumn{Name: "QUEUE LENGTH", Align: tview.AlignRight, Wide: true},
+		HeaderColumn{Name: "ACTIVE QUEUES", Align: tview.AlignRight},
+		HeaderColumn{Name: "WAITING", Align: tview.AlignRight},
+		HeaderColumn{Name: "EXECUTING", Align: tview.AlignRight},
+		HeaderColumn{Name: "SEATS", Align: tview.AlignRight},
+		HeaderColumn{Name: "%SEATS", Align: tview.AlignRight},
+		HeaderColumn{Name: "REJECTED", Align: tview.AlignRight},
+		HeaderColumn{Name: "TIMEDOUT", Align: tview.AlignRight, Wide: true},
+		HeaderColumn{Name: "LABELS", Wide: true},
+		HeaderColumn{Name: "VALID", Wide: true},
+		HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (PriorityLevel) Render(o interface{}, ns string, r *Row) error {
+	a, err := apfObject(o)
+	if err != nil {
+		return err
+	}
+	raw, stats := a.Raw, a.Stats
+
+	kind, _, _ := unstructured.NestedString(raw.Object, "spec", "type")
+	var queues, handSize, queueLen string
+	queuing, ok, _ := unstructured.NestedMap(raw.Object, "spec", "limited", "limitResponse", "queuing")
+	if ok {
+		queues, handSize, queueLen = nestedInt(queuing, "queues"), nestedInt(queuing, "handSize"), nestedInt(queuing, "queueLengthLimit")
+	}
+
+	r.ID = client.FQN(client.ClusterScope, raw.GetName())
+	r.Fields = Fields{
+		apfName(a),
+		kind,
+		na(plShares(raw)),
+		na(queues),
+		na(handSize),
+		na(queueLen),
+		stats.activeQueues(),
+		stats.waiting(),
+		stats.executing(),
+		stats.seats(),
+		stats.seatsPerc(),
+		stats.rejected(),
+		stats.timedOut(),
+		mapToStr(raw.GetLabels()),
+		asStatus(stats.diagnose()),
+		toAge(raw.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func apfObject(o interface{}) (*APFWithStats, error) {
+	switch v := o.(type) {
+	case *unstructured.Unstructured:
+		return &APFWithStats{Raw: v}, nil
+	case *APFWithStats:
+		return v, nil
+	default:
+		return nil, fmt.Errorf("expected APF resource, but got %T", o)
+	}
+}
+
+// apfName flags the resource classifying k9s own requests.
+func apfName(a *APFWithStats) string {
+	if a.Self {
+		return a.Raw.GetName() + "(*)"
+	}
+
+	return a.Raw.GetName()
+}
+
+func apfColorer(ns string, h Header, re RowEvent) tcell.Color {
+	c := DefaultColorer(ns, h, re)
+	if c == ErrColor {
+		return c
+	}
+	if strings.HasSuffix(strings.TrimSpace(re.Row.Fields[0]), "(*)") {
+		return HighlightColor
+	}
+
+	return c
+}
+
+// plShares returns a priority level concurrency shares. Prior to v1beta3 shares
+// were called assured shares.
+func plShares(raw *unstructured.Unstructured) string {
+	for _, f := range []string{"nominalConcurrencyShares", "assuredConcurrencyShares"} {
+		if s := nestedInt(raw.Object, "spec", "limited", f); s != "" {
+			return s
+		}
+	}
+
+	return ""
+}
+
+func nestedInt(m map[string]interface{}, fields ...string) string {
+	n, ok, _ := unstructured.NestedInt64(m, fields...)
+	if !ok {
+		return ""
+	}
+
+	return strconv.Itoa(int(n))
+}
+
+func diagnoseFlowSchema(raw *unstructured.Unstructured, stats *APFLevelStats) error {
+	cc, _, _ := unstructured.NestedSlice(raw.Object, "status", "conditions")
+	for _, c := range cc {
+		m, ok := c.(map[string]interface{})
+		if !ok || m["type"] != "Dangling" || m["status"] != "True" {
+			continue
+		}
+		msg, _ := m["message"].(string)
+		if msg == "" {
+			msg = "priority level not found"
+		}
+		return fmt.Errorf("%s", msg)
+	}
+
+	return stats.diagnose()
+}
+
+// APFWithStats represents a flowschema or priority level along with its
+// priority level live stats.
+type APFWithStats struct {
+	Raw   *unstructured.Unstructured
+	Stats *APFLevelStats
+	// Self flags the resource classifying k9s own requests.
+	Self bool
+}
+
+// GetObjectKind returns a schema object.
+func (a *APFWithStats) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (a *APFWithStats) DeepCopyObject() runtime.Object {
+	return a
+}
+
+// APFLevelStats represents a priority level live queuing and seats usage as
+// reported by the api server debug endpoints.
+type APFLevelStats struct {
+	ActiveQueues int
+	Waiting      int
+	Executing    int
+	Dispatched   int
+	Rejected     int
+	TimedOut     int
+	Cancelled    int
+	// HasSeats flags whether the api server reported seats metrics.
+	HasSeats       bool
+	ExecutingSeats int
+	SeatsLimit     int
+}
+
+// SeatsUsage returns the percentage of seats in use.
+func (s *APFLevelStats) SeatsUsage() int {
+	if s == nil || !s.HasSeats || s.SeatsLimit == 0 {
+		return 0
+	}
+
+	return s.ExecutingSeats * 100 / s.SeatsLimit
+}
+
+func (s *APFLevelStats) diagnose() error {
+	if s == nil {
+		return nil
+	}
+	if s.Waiting > 0 {
+		return fmt.Errorf("%d request(s) queued", s.Waiting)
+	}
+	if u := s.SeatsUsage(); u >= APFSeatsSaturation {
+		return fmt.Errorf("seats saturated at %d%%", u)
+	}
+
+	return nil
+}
+
+func (s *APFLevelStats) activeQueues() string {
+	if s == nil {
+		return NAValue
+	}
+	return strconv.Itoa(s.ActiveQueues)
+}
+
+func (s *APFLevelStats) waiting() string {
+	if s == nil {
+		return NAValue
+	}
+	return strconv.Itoa(s.Waiting)
+}
+
+func (s *APFLevelStats) executing() string {
+	if s == nil {
+		return NAValue
+	}
+	return strconv.Itoa(s.Executing)
+}
+
+func (s *APFLevelStats) rejected() string {
+	if s == nil {
+		return NAValue
+	}
+	return strconv.Itoa(s.Rejected)
+}
+
+func (s *APFLevelStats) timedOut() string {
+	if s == nil {
+		return NAValue
+	}
+	return strconv.Itoa(s.TimedOut)
+}
+
+func (s *APFLevelStats) seats() string {
+	if s == nil || !s.HasSeats {
+		return NAValue
+	}
+	return strconv.Itoa(s.ExecutingSeats) + "/" + strconv.Itoa(s.SeatsLimit)
+}
+
+func (s *APFLevelStats) seatsPerc() string {
+	if s == nil || !s.HasSeats || s.SeatsLimit == 0 {
+		return NAValue
+	}
+	return strconv.Itoa(s.SeatsUsage())
+}
@@ -0,0 +1,78 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlowSchemaRender(t *testing.T) {
+	uu := map[string]struct {
+		o interface{}
+		e render.Fields
+	}{
+		"plain": {
+			o: load(t, "fs"),
+			e: render.Fields{"workload-leader-election", "leader-election", "200", "ByUser", render.NAValue, render.NAValue, render.NAValue, "1"},
+		},
+		"stats": {
+			o: &render.APFWithStats{
+				Raw:   load(t, "fs"),
+				Stats: &render.APFLevelStats{Waiting: 2, Rejected: 5, HasSeats: true, ExecutingSeats: 3, SeatsLimit: 10},
+				Self:  true,
+			},
+			e: render.Fields{"workload-leader-election(*)", "leader-election", "200", "ByUser", "2", "3/10", "5", "1"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r render.Row
+			assert.NoError(t, render.FlowSchema{}.Render(u.o, "", &r))
+			assert.Equal(t, "-/workload-leader-election", r.ID)
+			assert.Equal(t, u.e, r.Fields[:8])
+		})
+	}
+}
+
+func TestPriorityLevelRender(t *testing.T) {
+	uu := map[string]struct {
+		stats *render.APFLevelStats
+		e     render.Fields
+		err   string
+	}{
+		"no-stats": {
+			e: render.Fields{"workload-low", "Limited", "100", "128", "6", "50", render.NAValue, render.NAValue, render.NAValue, render.NAValue, render.NAValue, render.NAValue, render.NAValue},
+		},
+		"no-seats": {
+			stats: &render.APFLevelStats{ActiveQueues: 1, Executing: 4, Rejected: 1, TimedOut: 2},
+			e:     render.Fields{"workload-low", "Limited", "100", "128", "6", "50", "1", "0", "4", render.NAValue, render.NAValue, "1", "2"},
+		},
+		"queued": {
+			stats: &render.APFLevelStats{ActiveQueues: 2, Waiting: 3, Executing: 10, HasSeats: true, ExecutingSeats: 10, SeatsLimit: 40},
+			e:     render.Fields{"workload-low", "Limited", "100", "128", "6", "50", "2", "3", "10", "10/40", "25", "0", "0"},
+			err:   "3 request(s) queued",
+		},
+		"saturated": {
+			stats: &render.APFLevelStats{Executing: 38, HasSeats: true, ExecutingSeats: 38, SeatsLimit: 40},
+			e:     render.Fields{"workload-low", "Limited", "100", "128", "6", "50", "0", "0", "38", "38/40", "95", "0", "0"},
+			err:   "seats saturated at 95%",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var (
+				r  render.Row
+				re render.PriorityLevel
+			)
+			assert.NoError(t, re.Render(&render.APFWithStats{Raw: load(t, "plc"), Stats: u.stats}, "", &r))
+			assert.Equal(t, "-/workload-low", r.ID)
+			assert.Equal(t, u.e, r.Fields[:13])
+			assert.Equal(t, u.err, r.Fields[re.Header("").IndexOf("VALID", true)])
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package render
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/derailed/tcell/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Audit renders audit log entries to screen.
+type Audit struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (Audit) ColorerFunc() ColorerFunc {
+	return func(ns string, h Header, re RowEvent) tcell.Color {
+		return tcell.ColorCadetBlue
+	}
+}
+
+// Header returns a header row.
+func (Audit) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "TIME"},
+		HeaderColumn{Name: "USER"},
+		HeaderColumn{Name: "CONTEXT"},
+		HeaderColumn{Name: "ACTION"},
+		HeaderColumn{Name: "GVR"},
+		HeaderColumn{Name: "PATH"},
+		HeaderColumn{Name: "PAYLOAD", Wide: true},
+		HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (Audit) Render(o interface{}, ns string, r *Row) error {
+	a, ok := o.(AuditRes)
+	if !ok {
+		return fmt.Errorf("expected AuditRes, but got %T", o)
+	}
+
+	r.ID = a.ID()
+	r.Fields = append(r.Fields,
+		a.Time.Format(time.RFC3339),
+		a.User,
+		a.Context,
+		a.Action,
+		a.GVR,
+		a.Path,
+		a.Payload,
+		toAge(metav1.NewTime(a.Time)),
+	)
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// AuditRes represents an audit log entry.
+type AuditRes struct {
+	Time    time.Time `json:"time"`
+	User    string    `json:"user,omitempty"`
+	Context string    `json:"context,omitempty"`
+	Action  string    `json:"action"`
+	GVR     string    `json:"gvr,omitempty"`
+	Path    string    `json:"path,omitempty"`
+	Payload string    `json:"payload,omitempty"`
+}
+
+// ID returns the entry unique id.
+func (a AuditRes) ID() string {
+	return a.Time.Format(time.RFC3339Nano) + "|" + a.Action + "|" + a.Path
+}
+
+// GetObjectKind returns a schema object.
+func (AuditRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (a AuditRes) DeepCopyObject() runtime.Object {
+	return a
+}
@@ -0,0 +1,37 @@
+package render_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuditRender(t *testing.T) {
+	o := render.AuditRes{
+		Time:    time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC),
+		User:    "fred",
+		Context: "c1",
+		Action:  "scale",
+		GVR:     "apps/v1/deployments",
+		Path:    "ns1/d1",
+		Payload: `{"replicas":3}`,
+	}
+
+	var (
+		a render.Audit
+		r render.Row
+	)
+	assert.Nil(t, a.Render(o, "", &r))
+	assert.Equal(t, "2023-01-01T10:00:00Z|scale|ns1/d1", r.ID)
+	assert.Equal(t, render.Fields{
+		"2023-01-01T10:00:00Z",
+		"fred",
+		"c1",
+		"scale",
+		"apps/v1/deployments",
+		"ns1/d1",
+		`{"replicas":3}`,
+	}, r.Fields[:7])
+}
@@ -0,0 +1,199 @@
+package render
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	mv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+)
+
+const (
+	barWidth    = 20
+	barFill     = "■"
+	barEmpty    = "·"
+	warnPerc    = 70
+	critPerc    = 90
+	noUsageMark = "n/a"
+)
+
+var capacityResources = []v1.ResourceName{
+	v1.ResourceCPU,
+	v1.ResourceMemory,
+	v1.ResourcePods,
+	v1.ResourceEphemeralStorage,
+}
+
+// ResourceCapacity tracks a node resource allocatable, requested and actual usage.
+type ResourceCapacity struct {
+	Name        v1.ResourceName
+	Allocatable int64
+	Requested   int64
+	Usage       int64
+	HasUsage    bool
+}
+
+// NodeCapacity represents a node resources capacity.
+type NodeCapacity struct {
+	Node      string
+	Resources []ResourceCapacity
+}
+
+// NewNodeCapacity computes a node capacity given its scheduled pods and metrics.
+func NewNodeCapacity(no *v1.Node, pods []*v1.Pod, mx *mv1beta1.NodeMetrics) NodeCapacity {
+	reqs, ext := make(v1.ResourceList), make(v1.ResourceList)
+	var active int64
+	for _, po := range pods {
+		if po.Status.Phase == v1.PodSucceeded || po.Status.Phase == v1.PodFailed {
+			continue
+		}
+		active++
+		for n, q := range EffectiveRequests(po.Spec) {
+			acc := reqs[n]
+			acc.Add(q)
+			reqs[n] = acc
+		}
+		for n, q := range ExtendedRequests(po.Spec) {
+			acc := ext[n]
+			acc.Add(q)
+			ext[n] = acc
+		}
+	}
+	for n, q := range ext {
+		reqs[n] = q
+	}
+
+	c := NodeCapacity{Node: no.Name, Resources: make([]ResourceCapacity, 0, len(capacityResources))}
+	for _, n := range append(capacityResources, extendedNames(no.Status.Allocatable)...) {
+		rc := ResourceCapacity{Name: n}
+		alloc, req := no.Status.Allocatable[n], reqs[n]
+		rc.Allocatable, rc.Requested = quantityFor(n, &alloc), quantityFor(n, &req)
+		switch n {
+		case v1.ResourcePods:
+			rc.Requested, rc.Usage, rc.HasUsage = active, active, true
+		case v1.ResourceCPU, v1.ResourceMemory:
+			if mx != nil {
+				u := mx.Usage[n]
+				rc.Usage, rc.HasUsage = quantityFor(n, &u), true
+			}
+		}
+		c.Resources = append(c.Resources, rc)
+	}
+
+	return c
+}
+
+// Overcommitted checks if any of the node resources requests exceed its allocatable.
+func (c NodeCapacity) Overcommitted() bool {
+	for _, r := range c.Resources {
+		if r.Allocatable > 0 && r.Requested > r.Allocatable {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Report returns a textual capacity report with bar graphs.
+func (c NodeCapacity) Report() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "[aqua::b]%-20s %12s %12s %-*s %6s %12s %-*s %6s[-::-]\n",
+		"RESOURCE", "ALLOCATABLE", "REQUESTED", barWidth, "", "%REQ", "USAGE", barWidth, "", "%USE",
+	)
+	for _, r := range c.Resources {
+		reqPerc := client.ToPercentage(r.Requested, r.Allocatable)
+		usage, usageBar, usagePerc := noUsageMark, strings.Repeat(" ", barWidth), noUsageMark
+		if r.HasUsage {
+			p := client.ToPercentage(r.Usage, r.Allocatable)
+			usage, usageBar, usagePerc = formatCapacity(r.Name, r.Usage), Bar(p, barWidth), PrintPerc(p)
+		}
+		fmt.Fprintf(&b, "%-20s %12s %12s %s %6s %12s %s %6s\n",
+			r.Name,
+			formatCapacity(r.Name, r.Allocatable),
+			formatCapacity(r.Name, r.Requested),
+			Bar(reqPerc, barWidth),
+			PrintPerc(reqPerc),
+			usage,
+			usageBar,
+			usagePerc,
+		)
+	}
+	if c.Overcommitted() {
+		b.WriteString("\n[red::b]Node is overcommitted![-::-]\n")
+	}
+
+	return b.String()
+}
+
+// Bar returns a colored bar graph for a given percentage.
+func Bar(perc, width int) string {
+	color := "green"
+	switch {
+	case perc >= critPerc:
+		color = "red"
+	case perc >= warnPerc:
+		color = "orange"
+	}
+	fill := perc * width / 100
+	if fill > width {
+		fill = width
+	}
+	if fill < 0 {
+		fill = 0
+	}
+
+	return "[" + color + "::]" + strings.Repeat(barFill, fill) + "[gray::]" + strings.Repeat(barEmpty, width-fill) + "[-::]"
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// EffectiveRequests returns a pod resources requests as seen by the scheduler.
+func EffectiveRequests(spec v1.PodSpec) v1.ResourceList {
+	reqs := make(v1.ResourceList)
+	for i := range spec.Containers {
+		for n, q := range containerRequests(&spec.Containers[i]) {
+			acc := reqs[n]
+			acc.Add(q)
+			reqs[n] = acc
+		}
+	}
+	for i := range spec.InitContainers {
+		for n, q := range containerRequests(&spec.InitContainers[i]) {
+			if acc, ok := reqs[n]; !ok || q.Cmp(acc) > 0 {
+				reqs[n] = q.DeepCopy()
+			}
+		}
+	}
+	for n, q := range spec.Overhead {
+		acc := reqs[n]
+		acc.Add(q)
+		reqs[n] = acc
+	}
+
+	return reqs
+}
+
+func quantityFor(n v1.ResourceName, q *resource.Quantity) int64 {
+	if n == v1.ResourceCPU {
+		return q.MilliValue()
+	}
+
+	return q.Value()
+}
+
+func formatCapacity(n v1.ResourceName, v int64) string {
+	if strings.HasPrefix(string(n), v1.ResourceHugePagesPrefix) {
+		return toMi(v) + "Mi"
+	}
+	switch n {
+	case v1.ResourceCPU:
+		return toMc(v) + "m"
+	case v1.ResourceMemory, v1.ResourceEphemeralStorage:
+		return toMi(v) + "Mi"
+	default:
+		return AsThousands(v)
+	}
+}
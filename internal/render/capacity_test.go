@@ -0,0 +1,128 @@
+package render
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	mv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+)
+
+func TestNewNodeCapacity(t *testing.T) {
+	no := v1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "n1"},
+		Status: v1.NodeStatus{
+			Allocatable: v1.ResourceList{
+				v1.ResourceCPU:    resource.MustParse("2"),
+				v1.ResourceMemory: resource.MustParse("4Gi"),
+				v1.ResourcePods:   resource.MustParse("110"),
+			},
+		},
+	}
+	pod := func(cpu, mem string, phase v1.PodPhase) *v1.Pod {
+		return &v1.Pod{
+			Spec: v1.PodSpec{
+				Containers: []v1.Container{{
+					Resources: v1.ResourceRequirements{
+						Requests: v1.ResourceList{
+							v1.ResourceCPU:    resource.MustParse(cpu),
+							v1.ResourceMemory: resource.MustParse(mem),
+						},
+					},
+				}},
+				InitContainers: []v1.Container{{
+					Resources: v1.ResourceRequirements{
+						Requests: v1.ResourceList{v1.ResourceCPU: resource.MustParse("1")},
+					},
+				}},
+			},
+			Status: v1.PodStatus{Phase: phase},
+		}
+	}
+	pp := []*v1.Pod{
+		pod("500m", "1Gi", v1.PodRunning),
+		pod("1500m", "1Gi", v1.PodRunning),
+		pod("2", "2Gi", v1.PodSucceeded),
+	}
+	mx := mv1beta1.NodeMetrics{
+		Usage: v1.ResourceList{
+			v1.ResourceCPU:    resource.MustParse("1"),
+			v1.ResourceMemory: resource.MustParse("1Gi"),
+		},
+	}
+
+	c := NewNodeCapacity(&no, pp, &mx)
+	assert.Equal(t, "n1", c.Node)
+	assert.Equal(t, []ResourceCapacity{
+		{Name: v1.ResourceCPU, Allocatable: 2000, Requested: 2500, Usage: 1000, HasUsage: true},
+		{Name: v1.ResourceMemory, Allocatable: 4 << 30, Requested: 2 << 30, Usage: 1 << 30, HasUsage: true},
+		{Name: v1.ResourcePods, Allocatable: 110, Requested: 2, Usage: 2, HasUsage: true},
+		{Name: v1.ResourceEphemeralStorage},
+	}, c.Resources)
+	assert.True(t, c.Overcommitted())
+	assert.Contains(t, c.Report(), "overcommitted")
+}
+
+func TestNewNodeCapacityExtended(t *testing.T) {
+	no := v1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "n1"},
+		Status: v1.NodeStatus{
+			Allocatable: v1.ResourceList{
+				v1.ResourceCPU:   resource.MustParse("2"),
+				"hugepages-2Mi":  resource.MustParse("0"),
+				"nvidia.com/gpu": resource.MustParse("4"),
+			},
+		},
+	}
+	pp := []*v1.Pod{{
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{{
+				Resources: v1.ResourceRequirements{
+					Limits: v1.ResourceList{"nvidia.com/gpu": resource.MustParse("3")},
+				},
+			}},
+		},
+		Status: v1.PodStatus{Phase: v1.PodRunning},
+	}}
+
+	c := NewNodeCapacity(&no, pp, nil)
+	assert.Equal(t, ResourceCapacity{Name: "nvidia.com/gpu", Allocatable: 4, Requested: 3}, c.Resources[len(c.Resources)-1])
+	assert.Contains(t, c.Report(), "nvidia.com/gpu")
+	assert.NotContains(t, c.Report(), "hugepages-2Mi")
+}
+
+func TestBar(t *testing.T) {
+	uu := map[string]struct {
+		perc, width int
+		e           string
+	}{
+		"empty": {
+			width: 4,
+			e:     "[green::][gray::]····[-::]",
+		},
+		"half": {
+			perc:  50,
+			width: 4,
+			e:     "[green::]■■[gray::]··[-::]",
+		},
+		"warn": {
+			perc:  75,
+			width: 4,
+			e:     "[orange::]■■■[gray::]·[-::]",
+		},
+		"over": {
+			perc:  150,
+			width: 4,
+			e:     "[red::]■■■■[gray::][-::]",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, Bar(u.perc, u.width))
+		})
+	}
+}
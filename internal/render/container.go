@@ -16,6 +16,17 @@ import (
 	mv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
 )
 
+// Container roles.
+const (
+	ContainerRoleInit      = "init"
+	ContainerRoleSidecar   = "sidecar"
+	ContainerRoleApp       = "app"
+	ContainerRoleEphemeral = "ephemeral"
+)
+
+// ContainerRoles tracks containers roles in pod startup order.
+var ContainerRoles = []string{ContainerRoleInit, ContainerRoleSidecar, ContainerRoleApp, ContainerRoleEphemeral}
+
 // ContainerWithMetrics represents a container and it's metrics.
 type ContainerWithMetrics interface {
 	// Container returns the container
@@ -76,6 +87,7 @@ func (Container) Header(ns string) Header {
 		HeaderColumn{Name: "READY"},
 		HeaderColumn{Name: "STATE"},
 		HeaderColumn{Name: "INIT"},
+		HeaderColumn{Name: "ROLE"},
 		HeaderColumn{Name: "RESTARTS", Align: tview.AlignRight},
 		HeaderColumn{Name: "PROBES(L:R)"},
 		HeaderColumn{Name: "CPU", Align: tview.AlignRight, MX: true},
@@ -87,6 +99,7 @@ func (Container) Header(ns string) Header {
 		HeaderColumn{Name: "%MEM/R", Align: tview.AlignRight, MX: true},
 		HeaderColumn{Name: "%MEM/L", Align: tview.AlignRight, MX: true},
 		HeaderColumn{Name: "PORTS"},
+		HeaderColumn{Name: "VULNS", Align: tview.AlignRight},
 		HeaderColumn{Name: "VALID", Wide: true},
 		HeaderColumn{Name: "AGE", Time: true},
 	}
@@ -113,6 +126,7 @@ func (c Container) Render(o interface{}, name string, r *Row) error {
 		ready,
 		state,
 		boolToStr(co.IsInit),
+		co.ContainerRole(),
 		restarts,
 		probe(co.Container.LivenessProbe) + ":" + probe(co.Container.ReadinessProbe),
 		toMc(cur.cpu),
@@ -124,6 +138,7 @@ func (c Container) Render(o interface{}, name string, r *Row) error {
 		client.ToPercentageStr(cur.mem, res.mem),
 		client.ToPercentageStr(cur.mem, res.lmem),
 		ToContainerPorts(co.Container.Ports),
+		toVulns(co.Scan),
 		asStatus(c.diagnose(state, ready)),
 		toAge(co.Age),
 	}
@@ -143,6 +158,57 @@ func (Container) diagnose(state, ready string) error {
 	return nil
 }
 
+// ContainerSubtotals returns a containers group heading fields along with its
+// restarts and resources subtotals.
+func ContainerSubtotals(role string, h Header, rr RowEvents) Fields {
+	ff := make(Fields, len(h))
+	if idx := h.IndexOf("NAME", true); idx >= 0 {
+		ff[idx] = fmt.Sprintf("%s (%d)", role, len(rr))
+	}
+	sums := make(map[string][2]int64, 5)
+	for _, col := range []string{"RESTARTS", "CPU", "MEM", "CPU/R:L", "MEM/R:L"} {
+		idx := h.IndexOf(col, true)
+		if idx < 0 {
+			continue
+		}
+		var sum [2]int64
+		for _, re := range rr {
+			vv := strings.Split(re.Row.Fields[idx], ":")
+			for i := 0; i < len(vv) && i < len(sum); i++ {
+				if n, err := strconv.ParseInt(strings.TrimSpace(vv[i]), 10, 64); err == nil {
+					sum[i] += n
+				}
+			}
+		}
+		sums[col] = sum
+		ff[idx] = strconv.FormatInt(sum[0], 10)
+		if strings.Contains(col, ":") {
+			ff[idx] += ":" + strconv.FormatInt(sum[1], 10)
+		}
+	}
+	percs := map[string][2]string{
+		"%CPU/R": {"CPU", "CPU/R:L"},
+		"%CPU/L": {"CPU", "CPU/R:L"},
+		"%MEM/R": {"MEM", "MEM/R:L"},
+		"%MEM/L": {"MEM", "MEM/R:L"},
+	}
+	for col, src := range percs {
+		idx := h.IndexOf(col, true)
+		cur, ok1 := sums[src[0]]
+		res, ok2 := sums[src[1]]
+		if idx < 0 || !ok1 || !ok2 {
+			continue
+		}
+		if strings.HasSuffix(col, "/R") {
+			ff[idx] = client.ToPercentageStr(cur[0], res[0])
+		} else {
+			ff[idx] = client.ToPercentageStr(cur[0], res[1])
+		}
+	}
+
+	return ff
+}
+
 // ----------------------------------------------------------------------------
 // Helpers...
 
@@ -227,7 +293,21 @@ type ContainerRes struct {
 	Status    *v1.ContainerStatus
 	MX        *mv1beta1.ContainerMetrics
 	IsInit    bool
+	Role      string
 	Age       metav1.Time
+	Scan      VulnScan
+}
+
+// ContainerRole returns the container role.
+func (c ContainerRes) ContainerRole() string {
+	switch {
+	case c.Role != "":
+		return c.Role
+	case c.IsInit:
+		return ContainerRoleInit
+	default:
+		return ContainerRoleApp
+	}
 }
 
 // GetObjectKind returns a schema object.
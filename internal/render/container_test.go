@@ -33,6 +33,7 @@ func TestContainer(t *testing.T) {
 		"false",
 		"Running",
 		"false",
+		"app",
 		"0",
 		"off:off",
 		"10",
@@ -44,12 +45,69 @@ func TestContainer(t *testing.T) {
 		"20",
 		"20",
 		"",
+		"",
 		"container is not ready",
 	},
 		r.Fields[:len(r.Fields)-1],
 	)
 }
 
+func TestContainerRole(t *testing.T) {
+	uu := map[string]struct {
+		co render.ContainerRes
+		e  string
+	}{
+		"app":       {co: render.ContainerRes{}, e: render.ContainerRoleApp},
+		"init":      {co: render.ContainerRes{IsInit: true}, e: render.ContainerRoleInit},
+		"sidecar":   {co: render.ContainerRes{IsInit: true, Role: render.ContainerRoleSidecar}, e: render.ContainerRoleSidecar},
+		"ephemeral": {co: render.ContainerRes{Role: render.ContainerRoleEphemeral}, e: render.ContainerRoleEphemeral},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, u.co.ContainerRole())
+		})
+	}
+}
+
+func TestContainerSubtotals(t *testing.T) {
+	var c render.Container
+	h := c.Header("")
+	field := func(name, v string) render.Fields {
+		ff := make(render.Fields, len(h))
+		ff[h.IndexOf("NAME", true)] = name
+		ff[h.IndexOf("RESTARTS", true)] = v
+		ff[h.IndexOf("CPU", true)] = "100"
+		ff[h.IndexOf("MEM", true)] = "n/a"
+		ff[h.IndexOf("CPU/R:L", true)] = "100:400"
+		ff[h.IndexOf("MEM/R:L", true)] = "0:0"
+		return ff
+	}
+	rr := render.RowEvents{
+		{Row: render.Row{ID: "c1", Fields: field("c1", "2")}},
+		{Row: render.Row{ID: "c2", Fields: field("c2", "3")}},
+	}
+
+	ff := render.ContainerSubtotals(render.ContainerRoleSidecar, h, rr)
+	assert.Equal(t, len(h), len(ff))
+	uu := map[string]string{
+		"NAME":     "sidecar (2)",
+		"RESTARTS": "5",
+		"CPU":      "200",
+		"MEM":      "0",
+		"CPU/R:L":  "200:800",
+		"MEM/R:L":  "0:0",
+		"%CPU/R":   "100",
+		"%CPU/L":   "25",
+		"%MEM/R":   "n/a",
+		"IMAGE":    "",
+	}
+	for col, e := range uu {
+		assert.Equal(t, e, ff[h.IndexOf(col, true)], col)
+	}
+}
+
 func BenchmarkContainerRender(b *testing.B) {
 	var c render.Container
 
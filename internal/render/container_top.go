@@ -0,0 +1,96 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/tview"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// ContainerTop renders live containers usage to screen.
+type ContainerTop struct {
+	Base
+}
+
+// Header returns a header row.
+func (ContainerTop) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "NAMESPACE"},
+		HeaderColumn{Name: "POD"},
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "CPU", Align: tview.AlignRight, MX: true},
+		HeaderColumn{Name: "MEM", Align: tview.AlignRight, MX: true},
+		HeaderColumn{Name: "%CPU/R", Align: tview.AlignRight, MX: true},
+		HeaderColumn{Name: "%CPU/L", Align: tview.AlignRight, MX: true},
+		HeaderColumn{Name: "%MEM/R", Align: tview.AlignRight, MX: true},
+		HeaderColumn{Name: "%MEM/L", Align: tview.AlignRight, MX: true},
+		HeaderColumn{Name: "RANK", Align: tview.AlignRight},
+		HeaderColumn{Name: "DELTA", Align: tview.AlignRight},
+		HeaderColumn{Name: "TREND"},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (c ContainerTop) Render(o interface{}, ns string, r *Row) error {
+	co, ok := o.(ContainerTopRes)
+	if !ok {
+		return fmt.Errorf("Expected ContainerTopRes, but got %T", o)
+	}
+
+	cur, res := gatherMetrics(co.Container, co.MX)
+	pns, pod := client.Namespaced(co.Pod)
+	r.ID = co.Pod + ":" + co.Container.Name
+	r.Fields = Fields{
+		pns,
+		pod,
+		co.Container.Name,
+		toMc(cur.cpu),
+		toMi(cur.mem),
+		client.ToPercentageStr(cur.cpu, res.cpu),
+		client.ToPercentageStr(cur.cpu, res.lcpu),
+		client.ToPercentageStr(cur.mem, res.mem),
+		client.ToPercentageStr(cur.mem, res.lmem),
+		strconv.Itoa(co.Rank),
+		RankDelta(co.PrevRank, co.Rank),
+		Sparkline(cpuSamples(co.History)),
+	}
+
+	return nil
+}
+
+// RankDelta returns a rank move indicator. Climbing up the ranks is up.
+func RankDelta(prev, cur int) string {
+	switch {
+	case prev == 0 || prev == cur:
+		return "-"
+	case cur < prev:
+		return "↑" + strconv.Itoa(prev-cur)
+	default:
+		return "↓" + strconv.Itoa(cur-prev)
+	}
+}
+
+// ----------------------------------------------------------------------------
+
+// ContainerTopRes represents a container live usage and rank.
+type ContainerTopRes struct {
+	ContainerRes
+
+	Pod      string
+	History  []client.MetricsSample
+	Rank     int
+	PrevRank int
+}
+
+// GetObjectKind returns a schema object.
+func (c ContainerTopRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (c ContainerTopRes) DeepCopyObject() runtime.Object {
+	return c
+}
@@ -0,0 +1,27 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRankDelta(t *testing.T) {
+	uu := map[string]struct {
+		prev, cur int
+		e         string
+	}{
+		"none": {cur: 3, e: "-"},
+		"same": {prev: 2, cur: 2, e: "-"},
+		"up":   {prev: 5, cur: 2, e: "↑3"},
+		"down": {prev: 1, cur: 4, e: "↓3"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, render.RankDelta(u.prev, u.cur))
+		})
+	}
+}
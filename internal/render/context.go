@@ -35,6 +35,7 @@ func (Context) Header(ns string) Header {
 		HeaderColumn{Name: "CLUSTER"},
 		HeaderColumn{Name: "AUTHINFO"},
 		HeaderColumn{Name: "NAMESPACE"},
+		HeaderColumn{Name: "FILE", Wide: true},
 	}
 }
 
@@ -56,6 +57,7 @@ func (c Context) Render(o interface{}, _ string, r *Row) error {
 		ctx.Context.Cluster,
 		ctx.Context.AuthInfo,
 		ctx.Context.Namespace,
+		ctx.Context.LocationOfOrigin,
 	}
 
 	return nil
@@ -23,6 +23,16 @@ func (ClusterRole) Header(string) Header {
 	}
 }
 
+// MetadataOnly checks if only the resources metadata are rendered.
+func (ClusterRole) MetadataOnly() bool {
+	return true
+}
+
+// Stable checks if rows only change when the resources change.
+func (ClusterRole) Stable() bool {
+	return true
+}
+
 // Render renders a K8s resource to screen.
 func (ClusterRole) Render(o interface{}, ns string, r *Row) error {
 	raw, ok := o.(*unstructured.Unstructured)
@@ -26,6 +26,11 @@ func (ClusterRoleBinding) Header(string) Header {
 	}
 }
 
+// Stable checks if rows only change when the resources change.
+func (ClusterRoleBinding) Stable() bool {
+	return true
+}
+
 // Render renders a K8s resource to screen.
 func (ClusterRoleBinding) Render(o interface{}, ns string, r *Row) error {
 	raw, ok := o.(*unstructured.Unstructured)
@@ -28,6 +28,11 @@ func (CustomResourceDefinition) Header(string) Header {
 	}
 }
 
+// Stable checks if rows only change when the resources change.
+func (CustomResourceDefinition) Stable() bool {
+	return true
+}
+
 // Render renders a K8s resource to screen.
 func (c CustomResourceDefinition) Render(o interface{}, ns string, r *Row) error {
 	raw, ok := o.(*unstructured.Unstructured)
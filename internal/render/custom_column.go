@@ -0,0 +1,175 @@
+package render
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/jsonpath"
+)
+
+const (
+	labelSpec      = "label:"
+	annotationSpec = "annotation:"
+)
+
+// CustomColumn represents a user defined column evaluated against a resource.
+type CustomColumn struct {
+	Name       string
+	JSONPath   string
+	Label      string
+	Annotation string
+
+	parser *jsonpath.JSONPath
+}
+
+// ParseCustomColumn parses a column definition of the form NAME:SPEC where
+// spec is either a JSONPath expression (.spec.x or {.spec.x}), label:key or
+// annotation:key. Plain column names are not custom columns.
+func ParseCustomColumn(s string) (CustomColumn, bool) {
+	tokens := strings.SplitN(s, ":", 2)
+	if len(tokens) != 2 || tokens[0] == "" {
+		return CustomColumn{}, false
+	}
+	c, spec := CustomColumn{Name: strings.ToUpper(tokens[0])}, strings.TrimSpace(tokens[1])
+	switch {
+	case strings.HasPrefix(spec, labelSpec):
+		c.Label = strings.TrimPrefix(spec, labelSpec)
+	case strings.HasPrefix(spec, annotationSpec):
+		c.Annotation = strings.TrimPrefix(spec, annotationSpec)
+	case strings.HasPrefix(spec, "."), strings.HasPrefix(spec, "{"):
+		if !strings.HasPrefix(spec, "{") {
+			spec = "{" + spec + "}"
+		}
+		c.JSONPath, c.parser = spec, jsonpath.New(c.Name).AllowMissingKeys(true)
+		if err := c.parser.Parse(spec); err != nil {
+			log.Warn().Err(err).Msgf("Invalid custom column %q", s)
+			return CustomColumn{}, false
+		}
+	default:
+		return CustomColumn{}, false
+	}
+
+	return c, true
+}
+
+// Eval returns the column value for a given resource.
+func (c CustomColumn) Eval(o map[string]interface{}) string {
+	switch {
+	case c.Label != "":
+		return c.metaValue(o, "labels", c.Label)
+	case c.Annotation != "":
+		return c.metaValue(o, "annotations", c.Annotation)
+	case c.parser != nil:
+		rr, err := c.parser.FindResults(o)
+		if err != nil {
+			return NAValue
+		}
+		var b bytes.Buffer
+		for _, r := range rr {
+			if len(r) == 0 {
+				continue
+			}
+			if b.Len() > 0 {
+				b.WriteString(",")
+			}
+			if err := c.parser.PrintResults(&b, r); err != nil {
+				return NAValue
+			}
+		}
+		return b.String()
+	default:
+		return ""
+	}
+}
+
+func (c CustomColumn) metaValue(o map[string]interface{}, field, key string) string {
+	v, _, _ := unstructured.NestedString(o, "metadata", field, key)
+
+	return v
+}
+
+// ----------------------------------------------------------------------------
+
+// CustomColumns represents a collection of custom columns.
+type CustomColumns []CustomColumn
+
+// NewCustomColumns returns the custom columns found in a column layout.
+func NewCustomColumns(cols []string) CustomColumns {
+	cc := make(CustomColumns, 0, len(cols))
+	for _, col := range cols {
+		if c, ok := ParseCustomColumn(col); ok {
+			cc = append(cc, c)
+		}
+	}
+
+	return cc
+}
+
+// NeedsObject checks if any of the columns require the full resource.
+func (cc CustomColumns) NeedsObject() bool {
+	for _, c := range cc {
+		if c.parser != nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Header returns the custom columns header.
+func (cc CustomColumns) Header() Header {
+	h := make(Header, 0, len(cc))
+	for _, c := range cc {
+		h = append(h, HeaderColumn{Name: c.Name})
+	}
+
+	return h
+}
+
+// Render appends custom columns values to a rendered row.
+func (cc CustomColumns) Render(o interface{}, r *Row) {
+	if len(cc) == 0 {
+		return
+	}
+	m, err := toObjectMap(o)
+	if err != nil {
+		log.Warn().Err(err).Msgf("Custom columns unavailable for %T", o)
+	}
+	for _, c := range cc {
+		if m == nil {
+			r.Fields = append(r.Fields, NAValue)
+			continue
+		}
+		r.Fields = append(r.Fields, c.Eval(m))
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func toObjectMap(o interface{}) (map[string]interface{}, error) {
+	switch v := o.(type) {
+	case *unstructured.Unstructured:
+		return v.Object, nil
+	case *PodWithMetrics:
+		return v.Raw.Object, nil
+	case *NodeWithMetrics:
+		return v.Raw.Object, nil
+	case metav1beta1.TableRow:
+		var m map[string]interface{}
+		if err := json.Unmarshal(v.Object.Raw, &m); err != nil {
+			return nil, err
+		}
+		return m, nil
+	case runtime.Object:
+		return runtime.DefaultUnstructuredConverter.ToUnstructured(v)
+	default:
+		return nil, fmt.Errorf("unsupported custom column resource %T", o)
+	}
+}
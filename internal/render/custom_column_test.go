@@ -0,0 +1,97 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestParseCustomColumn(t *testing.T) {
+	uu := map[string]struct {
+		col string
+		ok  bool
+		e   render.CustomColumn
+	}{
+		"plain": {
+			col: "NAME",
+		},
+		"plain-colon": {
+			col: "CPU/R:L",
+		},
+		"jsonpath": {
+			col: "image:.spec.containers[*].image",
+			ok:  true,
+			e:   render.CustomColumn{Name: "IMAGE", JSONPath: "{.spec.containers[*].image}"},
+		},
+		"braces": {
+			col: "NODE:{.spec.nodeName}",
+			ok:  true,
+			e:   render.CustomColumn{Name: "NODE", JSONPath: "{.spec.nodeName}"},
+		},
+		"label": {
+			col: "APP:label:app.kubernetes.io/name",
+			ok:  true,
+			e:   render.CustomColumn{Name: "APP", Label: "app.kubernetes.io/name"},
+		},
+		"annotation": {
+			col: "CAUSE:annotation:kubernetes.io/change-cause",
+			ok:  true,
+			e:   render.CustomColumn{Name: "CAUSE", Annotation: "kubernetes.io/change-cause"},
+		},
+		"bad-jsonpath": {
+			col: "BAD:{.spec[",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			c, ok := render.ParseCustomColumn(u.col)
+			assert.Equal(t, u.ok, ok)
+			if !ok {
+				return
+			}
+			assert.Equal(t, u.e.Name, c.Name)
+			assert.Equal(t, u.e.JSONPath, c.JSONPath)
+			assert.Equal(t, u.e.Label, c.Label)
+			assert.Equal(t, u.e.Annotation, c.Annotation)
+		})
+	}
+}
+
+func TestCustomColumnsRender(t *testing.T) {
+	cc := render.NewCustomColumns([]string{
+		"NAME",
+		"NODE:.spec.nodeName",
+		"IMAGES:.spec.containers[*].image",
+		"APP:label:app",
+		"MISSING:.spec.blee",
+	})
+	assert.Equal(t, 4, len(cc))
+	assert.True(t, cc.NeedsObject())
+	assert.Equal(t, render.Header{
+		render.HeaderColumn{Name: "NODE"},
+		render.HeaderColumn{Name: "IMAGES"},
+		render.HeaderColumn{Name: "APP"},
+		render.HeaderColumn{Name: "MISSING"},
+	}, cc.Header())
+
+	o := unstructured.Unstructured{Object: map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"name":   "fred",
+			"labels": map[string]interface{}{"app": "blee"},
+		},
+		"spec": map[string]interface{}{
+			"nodeName": "n1",
+			"containers": []interface{}{
+				map[string]interface{}{"image": "i1"},
+				map[string]interface{}{"image": "i2"},
+			},
+		},
+	}}
+	r := render.Row{Fields: render.Fields{"fred"}}
+	cc.Render(&o, &r)
+	assert.Equal(t, render.Fields{"fred", "n1", "i1 i2", "blee", ""}, r.Fields)
+}
@@ -0,0 +1,70 @@
+package render
+
+import (
+	"fmt"
+
+	"github.com/derailed/tcell/v2"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Debug renders k9s self metrics to screen.
+type Debug struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (Debug) ColorerFunc() ColorerFunc {
+	return func(ns string, h Header, re RowEvent) tcell.Color {
+		return tcell.ColorCadetBlue
+	}
+}
+
+// Header returns a header row.
+func (Debug) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "SECTION"},
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "VALUE"},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (Debug) Render(o interface{}, ns string, r *Row) error {
+	d, ok := o.(DebugRes)
+	if !ok {
+		return fmt.Errorf("expected DebugRes, but got %T", o)
+	}
+
+	r.ID = d.ID()
+	r.Fields = append(r.Fields,
+		d.Section,
+		d.Name,
+		d.Value,
+	)
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// DebugRes represents a k9s self metric.
+type DebugRes struct {
+	Section, Name, Value string
+}
+
+// ID returns the metric unique id.
+func (d DebugRes) ID() string {
+	return d.Section + "|" + d.Name
+}
+
+// GetObjectKind returns a schema object.
+func (DebugRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (d DebugRes) DeepCopyObject() runtime.Object {
+	return d
+}
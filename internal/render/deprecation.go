@@ -0,0 +1,115 @@
+package render
+
+import (
+	"fmt"
+
+	"github.com/derailed/tcell/v2"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Deprecation statuses given the cluster version.
+const (
+	DeprecationRemoved    = "REMOVED"
+	DeprecationNext       = "NEXT"
+	DeprecationDeprecated = "DEPRECATED"
+)
+
+// Deprecation renders deprecated api usages to screen.
+type Deprecation struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (Deprecation) ColorerFunc() ColorerFunc {
+	return func(ns string, h Header, re RowEvent) tcell.Color {
+		idx := h.IndexOf("STATUS", true)
+		if idx < 0 || idx >= len(re.Row.Fields) {
+			return DefaultColorer(ns, h, re)
+		}
+		switch re.Row.Fields[idx] {
+		case DeprecationRemoved:
+			return ErrColor
+		case DeprecationNext:
+			return HighlightColor
+		default:
+			return StdColor
+		}
+	}
+}
+
+// Header returns a header row.
+func (Deprecation) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "STATUS"},
+		HeaderColumn{Name: "KIND"},
+		HeaderColumn{Name: "RESOURCE"},
+		HeaderColumn{Name: "API"},
+		HeaderColumn{Name: "DEPRECATED"},
+		HeaderColumn{Name: "REMOVED"},
+		HeaderColumn{Name: "REPLACEMENT"},
+		HeaderColumn{Name: "GVR", Wide: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (Deprecation) Render(o interface{}, ns string, r *Row) error {
+	d, ok := o.(DeprecationRes)
+	if !ok {
+		return fmt.Errorf("expected DeprecationRes, but got %T", o)
+	}
+
+	r.ID = d.ID()
+	r.Fields = append(r.Fields,
+		d.Status,
+		d.Kind,
+		d.Path,
+		d.APIVersion,
+		k8sMinorFmt(d.Deprecated),
+		k8sMinorFmt(d.Removed),
+		d.Replacement,
+		d.GVR,
+	)
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func k8sMinorFmt(minor int) string {
+	if minor <= 0 {
+		return MissingValue
+	}
+
+	return fmt.Sprintf("v1.%d", minor)
+}
+
+// DeprecationRes represents a resource relying on a deprecated api.
+type DeprecationRes struct {
+	// GVR the resource gvr.
+	GVR string
+	// Path the resource path.
+	Path       string
+	Kind       string
+	APIVersion string
+	// Deprecated and Removed the Kubernetes minor versions.
+	Deprecated, Removed int
+	Replacement         string
+	Status              string
+}
+
+// ID returns the usage unique id.
+func (d DeprecationRes) ID() string {
+	return d.GVR + "|" + d.Path + "|" + d.APIVersion
+}
+
+// GetObjectKind returns a schema object.
+func (DeprecationRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (d DeprecationRes) DeepCopyObject() runtime.Object {
+	return d
+}
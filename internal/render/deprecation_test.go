@@ -0,0 +1,29 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeprecationRender(t *testing.T) {
+	var (
+		d render.Deprecation
+		r render.Row
+	)
+	o := render.DeprecationRes{
+		GVR:         "networking.k8s.io/v1/ingresses",
+		Path:        "default/i1",
+		Kind:        "Ingress",
+		APIVersion:  "extensions/v1beta1",
+		Deprecated:  14,
+		Removed:     22,
+		Replacement: "networking.k8s.io/v1",
+		Status:      render.DeprecationRemoved,
+	}
+
+	assert.Nil(t, d.Render(o, "", &r))
+	assert.Equal(t, "networking.k8s.io/v1/ingresses|default/i1|extensions/v1beta1", r.ID)
+	assert.Equal(t, render.Fields{"REMOVED", "Ingress", "default/i1", "extensions/v1beta1", "v1.14", "v1.22", "networking.k8s.io/v1", "networking.k8s.io/v1/ingresses"}, r.Fields)
+}
@@ -0,0 +1,240 @@
+package render
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/derailed/tview"
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+// DiffOp represents a diff line operation.
+type DiffOp int
+
+const (
+	// DiffEqual indicates an unchanged line.
+	DiffEqual DiffOp = iota
+	// DiffDelete indicates a line only present on the left.
+	DiffDelete
+	// DiffInsert indicates a line only present on the right.
+	DiffInsert
+)
+
+const (
+	diffDelColor = "red"
+	diffAddColor = "green"
+	diffGutter   = " │ "
+)
+
+// DiffLine represents a single diff line.
+type DiffLine struct {
+	Op   DiffOp
+	Text string
+}
+
+// Diff computes a line diff between two texts.
+func Diff(left, right string) []DiffLine {
+	a, b := splitLines(left), splitLines(right)
+	m := difflib.NewMatcherWithJunk(a, b, false, nil)
+	dd := make([]DiffLine, 0, len(a)+len(b))
+	for _, op := range m.GetOpCodes() {
+		if op.Tag == 'e' {
+			for _, l := range a[op.I1:op.I2] {
+				dd = append(dd, DiffLine{Op: DiffEqual, Text: l})
+			}
+			continue
+		}
+		for _, l := range a[op.I1:op.I2] {
+			dd = append(dd, DiffLine{Op: DiffDelete, Text: l})
+		}
+		for _, l := range b[op.J1:op.J2] {
+			dd = append(dd, DiffLine{Op: DiffInsert, Text: l})
+		}
+	}
+
+	return dd
+}
+
+// HasChanges checks if a diff contains any changes.
+func HasChanges(dd []DiffLine) bool {
+	for _, d := range dd {
+		if d.Op != DiffEqual {
+			return true
+		}
+	}
+
+	return false
+}
+
+// UnifiedDiff returns a colorized unified diff with intra-line highlights.
+func UnifiedDiff(dd []DiffLine, lName, rName string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "[%s::b]--- %s[-::-]\n", diffDelColor, tview.Escape(lName))
+	fmt.Fprintf(&b, "[%s::b]+++ %s[-::-]\n", diffAddColor, tview.Escape(rName))
+	for _, c := range diffChunks(dd) {
+		switch {
+		case c.equal != nil:
+			b.WriteString("  " + tview.Escape(c.equal.Text) + "\n")
+		default:
+			for i, l := range c.dels {
+				var r string
+				if i < len(c.adds) {
+					r = c.adds[i]
+				}
+				b.WriteString(highlight(diffDelColor, "- ", l, r, i < len(c.adds)) + "\n")
+			}
+			for i, r := range c.adds {
+				var l string
+				if i < len(c.dels) {
+					l = c.dels[i]
+				}
+				b.WriteString(highlight(diffAddColor, "+ ", r, l, i < len(c.dels)) + "\n")
+			}
+		}
+	}
+
+	return b.String()
+}
+
+// SideBySideDiff returns a colorized two columns diff with intra-line highlights.
+func SideBySideDiff(dd []DiffLine, lName, rName string, width int) string {
+	col := (width - utf8.RuneCountInString(diffGutter)) / 2
+	if col < 10 {
+		col = 10
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "[%s::b]%s[-::-]%s[%s::b]%s[-::-]\n",
+		diffDelColor, tview.Escape(pad(lName, col)), diffGutter, diffAddColor, tview.Escape(pad(rName, col)),
+	)
+	for _, c := range diffChunks(dd) {
+		if c.equal != nil {
+			t := tview.Escape(pad(c.equal.Text, col))
+			b.WriteString(t + diffGutter + t + "\n")
+			continue
+		}
+		n := len(c.dels)
+		if len(c.adds) > n {
+			n = len(c.adds)
+		}
+		for i := 0; i < n; i++ {
+			left, right := strings.Repeat(" ", col), ""
+			switch {
+			case i < len(c.dels) && i < len(c.adds):
+				l, r := trunc(c.dels[i], col), trunc(c.adds[i], col)
+				left = highlight(diffDelColor, "", l, r, true) + padding(l, col)
+				right = highlight(diffAddColor, "", r, l, true)
+			case i < len(c.dels):
+				l := trunc(c.dels[i], col)
+				left = highlight(diffDelColor, "", l, "", false) + padding(l, col)
+			default:
+				right = highlight(diffAddColor, "", trunc(c.adds[i], col), "", false)
+			}
+			b.WriteString(left + diffGutter + right + "\n")
+		}
+	}
+
+	return b.String()
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+type diffChunk struct {
+	equal      *DiffLine
+	dels, adds []string
+}
+
+// diffChunks groups consecutive changes so deletions can be paired with insertions.
+func diffChunks(dd []DiffLine) []diffChunk {
+	cc := make([]diffChunk, 0, len(dd))
+	var cur *diffChunk
+	for i := range dd {
+		switch dd[i].Op {
+		case DiffEqual:
+			cur = nil
+			cc = append(cc, diffChunk{equal: &dd[i]})
+		case DiffDelete:
+			if cur == nil || len(cur.adds) > 0 {
+				cc = append(cc, diffChunk{})
+				cur = &cc[len(cc)-1]
+			}
+			cur.dels = append(cur.dels, dd[i].Text)
+		case DiffInsert:
+			if cur == nil {
+				cc = append(cc, diffChunk{})
+				cur = &cc[len(cc)-1]
+			}
+			cur.adds = append(cur.adds, dd[i].Text)
+		}
+	}
+
+	return cc
+}
+
+// highlight colors a changed line, reversing the segment that differs from its counterpart.
+func highlight(color, prefix, s, other string, paired bool) string {
+	if !paired {
+		return "[" + color + "::]" + tview.Escape(prefix+s) + "[-::-]"
+	}
+	pre, suf := commonAffixes(s, other)
+	if pre+suf == 0 || pre+suf >= len(s) {
+		return "[" + color + "::]" + tview.Escape(prefix+s) + "[-::-]"
+	}
+
+	return "[" + color + "::]" + tview.Escape(prefix+s[:pre]) +
+		"[" + color + "::r]" + tview.Escape(s[pre:len(s)-suf]) +
+		"[" + color + "::-]" + tview.Escape(s[len(s)-suf:]) + "[-::-]"
+}
+
+// commonAffixes returns the byte lengths of the common prefix and suffix of two strings.
+func commonAffixes(a, b string) (int, int) {
+	var pre int
+	for pre < len(a) && pre < len(b) {
+		r1, n1 := utf8.DecodeRuneInString(a[pre:])
+		r2, _ := utf8.DecodeRuneInString(b[pre:])
+		if r1 != r2 {
+			break
+		}
+		pre += n1
+	}
+	var suf int
+	for suf < len(a)-pre && suf < len(b)-pre {
+		r1, n1 := utf8.DecodeLastRuneInString(a[:len(a)-suf])
+		r2, _ := utf8.DecodeLastRuneInString(b[:len(b)-suf])
+		if r1 != r2 {
+			break
+		}
+		suf += n1
+	}
+
+	return pre, suf
+}
+
+func splitLines(s string) []string {
+	s = strings.TrimRight(s, "\n")
+	if s == "" {
+		return nil
+	}
+
+	return strings.Split(s, "\n")
+}
+
+func trunc(s string, width int) string {
+	if utf8.RuneCountInString(s) <= width {
+		return s
+	}
+
+	return string([]rune(s)[:width-1]) + "…"
+}
+
+func pad(s string, width int) string {
+	s = trunc(s, width)
+
+	return s + padding(s, width)
+}
+
+func padding(s string, width int) string {
+	return strings.Repeat(" ", width-utf8.RuneCountInString(s))
+}
@@ -0,0 +1,83 @@
+package render_test
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiff(t *testing.T) {
+	uu := map[string]struct {
+		l, r string
+		e    []render.DiffLine
+	}{
+		"empty": {
+			e: []render.DiffLine{},
+		},
+		"same": {
+			l: "a\nb\n",
+			r: "a\nb\n",
+			e: []render.DiffLine{
+				{Op: render.DiffEqual, Text: "a"},
+				{Op: render.DiffEqual, Text: "b"},
+			},
+		},
+		"changed": {
+			l: "a\nb\nc",
+			r: "a\nB\nc\nd",
+			e: []render.DiffLine{
+				{Op: render.DiffEqual, Text: "a"},
+				{Op: render.DiffDelete, Text: "b"},
+				{Op: render.DiffInsert, Text: "B"},
+				{Op: render.DiffEqual, Text: "c"},
+				{Op: render.DiffInsert, Text: "d"},
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			dd := render.Diff(u.l, u.r)
+			assert.Equal(t, u.e, dd)
+			assert.Equal(t, u.l != u.r, render.HasChanges(dd))
+		})
+	}
+}
+
+func TestDiffLarge(t *testing.T) {
+	ll := make([]string, 20_000)
+	for i := range ll {
+		ll[i] = "  key" + strconv.Itoa(i) + ": value"
+	}
+	l := strings.Join(ll, "\n")
+	ll[10_000] = "  key10000: changed"
+
+	dd := render.Diff(l, strings.Join(ll, "\n"))
+	assert.Equal(t, 20_001, len(dd))
+	assert.Equal(t, render.DiffLine{Op: render.DiffDelete, Text: "  key10000: value"}, dd[10_000])
+	assert.Equal(t, render.DiffLine{Op: render.DiffInsert, Text: "  key10000: changed"}, dd[10_001])
+}
+
+func TestUnifiedDiff(t *testing.T) {
+	dd := render.Diff("image: nginx:1.0\nport: 80", "image: nginx:1.1\nport: 80")
+	e := "[red::b]--- l[-::-]\n" +
+		"[green::b]+++ r[-::-]\n" +
+		"[red::]- image: nginx:1.[red::r]0[red::-][-::-]\n" +
+		"[green::]+ image: nginx:1.[green::r]1[green::-][-::-]\n" +
+		"  port: 80\n"
+
+	assert.Equal(t, e, render.UnifiedDiff(dd, "l", "r"))
+}
+
+func TestSideBySideDiff(t *testing.T) {
+	dd := render.Diff("a: 1\nb: 2", "a: 1\nb: 3")
+	e := "[red::b]l           [-::-] │ [green::b]r           [-::-]\n" +
+		"a: 1         │ a: 1        \n" +
+		"[red::]b: [red::r]2[red::-][-::-]         │ [green::]b: [green::r]3[green::-][-::-]\n"
+
+	assert.Equal(t, e, render.SideBySideDiff(dd, "l", "r", 27))
+}
@@ -29,12 +29,18 @@ func (Deployment) Header(ns string) Header {
 		HeaderColumn{Name: "READY", Align: tview.AlignRight},
 		HeaderColumn{Name: "UP-TO-DATE", Align: tview.AlignRight},
 		HeaderColumn{Name: "AVAILABLE", Align: tview.AlignRight},
+		HeaderColumn{Name: "PAUSED"},
 		HeaderColumn{Name: "LABELS", Wide: true},
 		HeaderColumn{Name: "VALID", Wide: true},
 		HeaderColumn{Name: "AGE", Time: true},
 	}
 }
 
+// Stable checks if rows only change when the resources change.
+func (Deployment) Stable() bool {
+	return true
+}
+
 // Render renders a K8s resource to screen.
 func (d Deployment) Render(o interface{}, ns string, r *Row) error {
 	raw, ok := o.(*unstructured.Unstructured)
@@ -55,6 +61,7 @@ func (d Deployment) Render(o interface{}, ns string, r *Row) error {
 		strconv.Itoa(int(dp.Status.AvailableReplicas)) + "/" + strconv.Itoa(int(dp.Status.Replicas)),
 		strconv.Itoa(int(dp.Status.UpdatedReplicas)),
 		strconv.Itoa(int(dp.Status.AvailableReplicas)),
+		boolToStr(dp.Spec.Paused),
 		mapToStr(dp.Labels),
 		asStatus(d.diagnose(dp.Status.Replicas, dp.Status.AvailableReplicas)),
 		toAge(dp.GetCreationTimestamp()),
@@ -32,6 +32,11 @@ func (DaemonSet) Header(ns string) Header {
 	}
 }
 
+// Stable checks if rows only change when the resources change.
+func (DaemonSet) Stable() bool {
+	return true
+}
+
 // Render renders a K8s resource to screen.
 func (d DaemonSet) Render(o interface{}, ns string, r *Row) error {
 	raw, ok := o.(*unstructured.Unstructured)
@@ -26,6 +26,11 @@ func (Endpoints) Header(ns string) Header {
 	}
 }
 
+// Stable checks if rows only change when the resources change.
+func (Endpoints) Stable() bool {
+	return true
+}
+
 // Render renders a K8s resource to screen.
 func (e Endpoints) Render(o interface{}, ns string, r *Row) error {
 	raw, ok := o.(*unstructured.Unstructured)
@@ -25,6 +25,10 @@ func (e *Event) ColorerFunc() ColorerFunc {
 		if reasonCol >= 0 && strings.TrimSpace(re.Row.Fields[reasonCol]) == "Killing" {
 			return KillColor
 		}
+		typeCol := h.IndexOf("TYPE", true)
+		if typeCol >= 0 && strings.TrimSpace(re.Row.Fields[typeCol]) == "Warning" {
+			return ErrColor
+		}
 
 		return DefaultColorer(ns, h, re)
 	}
@@ -1,5 +1,13 @@
 package render_test
 
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/tcell/v2"
+	"github.com/stretchr/testify/assert"
+)
+
 // BOZO!!
 // func TestEventRender(t *testing.T) {
 // 	c := render.Event{}
@@ -21,3 +29,36 @@ package render_test
 // 		_ = re.Render(&ev, "", &r)
 // 	}
 // }
+
+func TestEventColorer(t *testing.T) {
+	var e render.Event
+	h := render.Header{
+		render.HeaderColumn{Name: "NAMESPACE"},
+		render.HeaderColumn{Name: "TYPE"},
+		render.HeaderColumn{Name: "REASON"},
+	}
+	uu := map[string]struct {
+		re render.RowEvent
+		e  tcell.Color
+	}{
+		"warning": {
+			re: render.RowEvent{Kind: render.EventAdd, Row: render.Row{Fields: render.Fields{"default", "Warning", "BackOff"}}},
+			e:  render.ErrColor,
+		},
+		"killing": {
+			re: render.RowEvent{Kind: render.EventAdd, Row: render.Row{Fields: render.Fields{"default", "Normal", "Killing"}}},
+			e:  render.KillColor,
+		},
+		"normal": {
+			re: render.RowEvent{Kind: render.EventAdd, Row: render.Row{Fields: render.Fields{"default", "Normal", "Pulled"}}},
+			e:  render.AddColor,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, e.ColorerFunc()("", h, u.re))
+		})
+	}
+}
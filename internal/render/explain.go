@@ -0,0 +1,94 @@
+package render
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/derailed/tcell/v2"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Explain renders resources schema fields to screen.
+type Explain struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (Explain) ColorerFunc() ColorerFunc {
+	return func(ns string, h Header, re RowEvent) tcell.Color {
+		idx := h.IndexOf("REQUIRED", true)
+		if idx >= 0 && idx < len(re.Row.Fields) && re.Row.Fields[idx] == "true" {
+			return HighlightColor
+		}
+
+		return StdColor
+	}
+}
+
+// Header returns a header row.
+func (Explain) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "FIELD"},
+		HeaderColumn{Name: "TYPE"},
+		HeaderColumn{Name: "REQUIRED"},
+		HeaderColumn{Name: "DESCRIPTION"},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (Explain) Render(o interface{}, ns string, r *Row) error {
+	e, ok := o.(ExplainRes)
+	if !ok {
+		return fmt.Errorf("expected ExplainRes, but got %T", o)
+	}
+
+	r.ID = e.ID()
+	r.Fields = append(r.Fields,
+		e.Field,
+		e.Type,
+		boolToStr(e.Required),
+		fieldSummary(e.Description),
+	)
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// fieldSummary returns the first sentence of a field description.
+func fieldSummary(s string) string {
+	s = strings.Join(strings.Fields(s), " ")
+	if idx := strings.Index(s, ". "); idx >= 0 {
+		return s[:idx+1]
+	}
+
+	return s
+}
+
+// ExplainRes represents a resource schema field.
+type ExplainRes struct {
+	// Path the field path from the resource root.
+	Path string
+	// Field the field path relative to the explained node.
+	Field       string
+	Type        string
+	Required    bool
+	Description string
+}
+
+// ID returns the field unique id.
+func (e ExplainRes) ID() string {
+	return e.Path
+}
+
+// GetObjectKind returns a schema object.
+func (ExplainRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (e ExplainRes) DeepCopyObject() runtime.Object {
+	return e
+}
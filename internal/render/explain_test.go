@@ -0,0 +1,25 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExplainRender(t *testing.T) {
+	var (
+		e render.Explain
+		r render.Row
+	)
+	o := render.ExplainRes{
+		Path:        "spec.replicas",
+		Field:       "replicas",
+		Type:        "integer",
+		Description: "Number of desired pods.  This is a pointer to distinguish\nbetween explicit zero and not specified. Defaults to 1.",
+	}
+
+	assert.Nil(t, e.Render(o, "", &r))
+	assert.Equal(t, "spec.replicas", r.ID)
+	assert.Equal(t, render.Fields{"replicas", "integer", "false", "Number of desired pods."}, r.Fields)
+}
@@ -0,0 +1,172 @@
+package render
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// gpuResources tracks the well known gpu device plugins resources.
+var gpuResources = map[v1.ResourceName]struct{}{
+	"nvidia.com/gpu":     {},
+	"amd.com/gpu":        {},
+	"gpu.intel.com/i915": {},
+	"gpu.intel.com/xe":   {},
+}
+
+// IsExtendedResource checks if a resource is an extended or hugepages
+// resource ie not natively managed by the kubelet.
+func IsExtendedResource(n v1.ResourceName) bool {
+	s := string(n)
+	switch {
+	case strings.HasPrefix(s, v1.ResourceHugePagesPrefix):
+		return true
+	case strings.HasPrefix(s, v1.DefaultResourceRequestsPrefix), !strings.Contains(s, "/"):
+		return false
+	default:
+		return !strings.Contains(s, "kubernetes.io/")
+	}
+}
+
+// IsGPUResource checks if a resource is a gpu device.
+func IsGPUResource(n v1.ResourceName) bool {
+	_, ok := gpuResources[n]
+
+	return ok
+}
+
+// ExtendedResources returns the extended resources of a resource list.
+func ExtendedResources(rl v1.ResourceList) v1.ResourceList {
+	ext := make(v1.ResourceList)
+	for n, q := range rl {
+		if IsExtendedResource(n) {
+			ext[n] = q.DeepCopy()
+		}
+	}
+
+	return ext
+}
+
+// ExtendedRequests returns a pod extended resources requests as seen by the
+// scheduler. Extended resources requests default to their limits.
+func ExtendedRequests(spec v1.PodSpec) v1.ResourceList {
+	reqs := make(v1.ResourceList)
+	for i := range spec.Containers {
+		for n, q := range extendedRequests(&spec.Containers[i]) {
+			acc := reqs[n]
+			acc.Add(q)
+			reqs[n] = acc
+		}
+	}
+	for i := range spec.InitContainers {
+		for n, q := range extendedRequests(&spec.InitContainers[i]) {
+			if acc, ok := reqs[n]; !ok || q.Cmp(acc) > 0 {
+				reqs[n] = q.DeepCopy()
+			}
+		}
+	}
+
+	return reqs
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// extendedNames returns the sorted non zero extended resources names of a
+// resource list.
+func extendedNames(rl v1.ResourceList) []v1.ResourceName {
+	nn := make([]v1.ResourceName, 0, len(rl))
+	for n, q := range rl {
+		if IsExtendedResource(n) && !q.IsZero() {
+			nn = append(nn, n)
+		}
+	}
+	sort.Slice(nn, func(i, j int) bool { return nn[i] < nn[j] })
+
+	return nn
+}
+
+func extendedRequests(co *v1.Container) v1.ResourceList {
+	rl := ExtendedResources(co.Resources.Limits)
+	for n, q := range ExtendedResources(co.Resources.Requests) {
+		rl[n] = q
+	}
+
+	return rl
+}
+
+// gpuCount returns the number of gpu devices in a resource list.
+func gpuCount(rl v1.ResourceList) (int64, bool) {
+	var (
+		count int64
+		found bool
+	)
+	for n, q := range rl {
+		if IsGPUResource(n) {
+			count, found = count+q.Value(), true
+		}
+	}
+
+	return count, found
+}
+
+// gpuRequests returns gpu devices requested vs allocatable.
+func gpuRequests(reqs, alloc v1.ResourceList) string {
+	a, ok1 := gpuCount(alloc)
+	r, ok2 := gpuCount(reqs)
+	if !ok1 && !ok2 {
+		return NAValue
+	}
+
+	return strconv.FormatInt(r, 10) + ":" + strconv.FormatInt(a, 10)
+}
+
+// extendedSummary lists non gpu extended resources as name=requested or
+// name=requested/allocatable when allocatable is known. Unused resources with
+// no allocatable are omitted.
+func extendedSummary(reqs, alloc v1.ResourceList) string {
+	names := make([]string, 0, len(reqs)+len(alloc))
+	seen := make(map[v1.ResourceName]struct{}, len(reqs)+len(alloc))
+	for _, rl := range []v1.ResourceList{alloc, reqs} {
+		for n := range rl {
+			if _, ok := seen[n]; ok || IsGPUResource(n) || !IsExtendedResource(n) {
+				continue
+			}
+			// Skip resources the node does not offer, ie hugepages set to zero.
+			if r, a := reqs[n], alloc[n]; r.IsZero() && a.IsZero() {
+				continue
+			}
+			seen[n] = struct{}{}
+			names = append(names, string(n))
+		}
+	}
+	if len(names) == 0 {
+		return NAValue
+	}
+	sort.Strings(names)
+
+	ss := make([]string, 0, len(names))
+	for _, n := range names {
+		rn := v1.ResourceName(n)
+		r := reqs[rn]
+		s := n + "=" + formatExtended(rn, &r)
+		if alloc != nil {
+			a := alloc[rn]
+			s += "/" + formatExtended(rn, &a)
+		}
+		ss = append(ss, s)
+	}
+
+	return strings.Join(ss, ",")
+}
+
+func formatExtended(n v1.ResourceName, q *resource.Quantity) string {
+	if strings.HasPrefix(string(n), v1.ResourceHugePagesPrefix) {
+		return toMi(q.Value()) + "Mi"
+	}
+
+	return strconv.FormatInt(q.Value(), 10)
+}
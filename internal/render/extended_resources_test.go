@@ -0,0 +1,164 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func TestIsExtendedResource(t *testing.T) {
+	uu := map[string]struct {
+		n   v1.ResourceName
+		ext bool
+		gpu bool
+	}{
+		"cpu":       {n: v1.ResourceCPU},
+		"storage":   {n: v1.ResourceEphemeralStorage},
+		"requests":  {n: "requests.nvidia.com/gpu"},
+		"native":    {n: "kubernetes.io/batch"},
+		"hugepages": {n: "hugepages-2Mi", ext: true},
+		"device":    {n: "example.com/foo", ext: true},
+		"nvidia":    {n: "nvidia.com/gpu", ext: true, gpu: true},
+		"amd":       {n: "amd.com/gpu", ext: true, gpu: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.ext, render.IsExtendedResource(u.n))
+			assert.Equal(t, u.gpu, render.IsGPUResource(u.n))
+		})
+	}
+}
+
+func TestExtendedRequests(t *testing.T) {
+	co := func(reqs, lims v1.ResourceList) v1.Container {
+		return v1.Container{Resources: v1.ResourceRequirements{Requests: reqs, Limits: lims}}
+	}
+
+	uu := map[string]struct {
+		spec v1.PodSpec
+		e    map[v1.ResourceName]string
+	}{
+		"none": {
+			spec: v1.PodSpec{Containers: []v1.Container{
+				co(v1.ResourceList{v1.ResourceCPU: resource.MustParse("1")}, nil),
+			}},
+			e: map[v1.ResourceName]string{},
+		},
+		"limitsOnly": {
+			spec: v1.PodSpec{Containers: []v1.Container{
+				co(nil, v1.ResourceList{"nvidia.com/gpu": resource.MustParse("2")}),
+				co(nil, v1.ResourceList{"nvidia.com/gpu": resource.MustParse("1")}),
+			}},
+			e: map[v1.ResourceName]string{"nvidia.com/gpu": "3"},
+		},
+		"requestsWin": {
+			spec: v1.PodSpec{Containers: []v1.Container{
+				co(
+					v1.ResourceList{"hugepages-2Mi": resource.MustParse("64Mi")},
+					v1.ResourceList{"hugepages-2Mi": resource.MustParse("128Mi")},
+				),
+			}},
+			e: map[v1.ResourceName]string{"hugepages-2Mi": "64Mi"},
+		},
+		"initMax": {
+			spec: v1.PodSpec{
+				InitContainers: []v1.Container{
+					co(nil, v1.ResourceList{"nvidia.com/gpu": resource.MustParse("4")}),
+					co(nil, v1.ResourceList{"example.com/foo": resource.MustParse("1")}),
+				},
+				Containers: []v1.Container{
+					co(nil, v1.ResourceList{"nvidia.com/gpu": resource.MustParse("1")}),
+					co(nil, v1.ResourceList{"example.com/foo": resource.MustParse("2")}),
+				},
+			},
+			e: map[v1.ResourceName]string{"nvidia.com/gpu": "4", "example.com/foo": "2"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			rl := render.ExtendedRequests(u.spec)
+			assert.Equal(t, len(u.e), len(rl))
+			for n, q := range rl {
+				assert.Equal(t, u.e[n], q.String())
+			}
+		})
+	}
+}
+
+func TestNodeRenderExtended(t *testing.T) {
+	raw := load(t, "no")
+	alloc := raw.Object["status"].(map[string]interface{})["allocatable"].(map[string]interface{})
+	alloc["nvidia.com/gpu"] = "4"
+	alloc["example.com/foo"] = "10"
+	util := 62.6
+
+	uu := map[string]struct {
+		no render.NodeWithMetrics
+		e  render.Fields
+	}{
+		"none": {
+			no: render.NodeWithMetrics{Raw: load(t, "no")},
+			e:  render.Fields{"n/a", "n/a", "n/a"},
+		},
+		"gpus": {
+			no: render.NodeWithMetrics{
+				Raw: raw,
+				Requests: v1.ResourceList{
+					"nvidia.com/gpu":  resource.MustParse("3"),
+					"example.com/foo": resource.MustParse("2"),
+				},
+				GPUUtil: &util,
+			},
+			e: render.Fields{"3:4", "63", "example.com/foo=2/10"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var no render.Node
+			r := render.NewRow(21)
+			assert.Nil(t, no.Render(&u.no, "", &r))
+			assert.Equal(t, u.e, r.Fields[15:18])
+		})
+	}
+}
+
+func TestPodRenderExtended(t *testing.T) {
+	raw := load(t, "po")
+	cc := raw.Object["spec"].(map[string]interface{})["containers"].([]interface{})
+	cc[0].(map[string]interface{})["resources"] = map[string]interface{}{
+		"limits": map[string]interface{}{"nvidia.com/gpu": "2", "hugepages-2Mi": "128Mi"},
+	}
+
+	uu := map[string]struct {
+		po render.PodWithMetrics
+		e  render.Fields
+	}{
+		"none": {
+			po: render.PodWithMetrics{Raw: load(t, "po")},
+			e:  render.Fields{"n/a", "n/a"},
+		},
+		"gpus": {
+			po: render.PodWithMetrics{Raw: raw},
+			e:  render.Fields{"2", "hugepages-2Mi=128Mi"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var po render.Pod
+			r := render.NewRow(21)
+			assert.Nil(t, po.Render(&u.po, "", &r))
+			assert.Equal(t, u.e, r.Fields[19:21])
+		})
+	}
+}
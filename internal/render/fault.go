@@ -0,0 +1,83 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Fault renders the cluster faults dashboard to screen.
+type Fault struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (Fault) ColorerFunc() ColorerFunc {
+	return func(ns string, h Header, re RowEvent) tcell.Color {
+		if idx := h.IndexOf("FAULTS", true); idx >= 0 && idx < len(re.Row.Fields) && re.Row.Fields[idx] != "0" {
+			return ErrColor
+		}
+
+		return StdColor
+	}
+}
+
+// Header returns a header row.
+func (Fault) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "RESOURCE"},
+		HeaderColumn{Name: "FAULTS", Align: tview.AlignRight, Numeric: true},
+		HeaderColumn{Name: "TOTAL", Align: tview.AlignRight, Numeric: true},
+		HeaderColumn{Name: "%FAULTS", Align: tview.AlignRight, Numeric: true},
+		HeaderColumn{Name: "TREND"},
+		HeaderColumn{Name: "DESCRIPTION"},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (Fault) Render(o interface{}, ns string, r *Row) error {
+	f, ok := o.(FaultRes)
+	if !ok {
+		return fmt.Errorf("expected FaultRes, but got %T", o)
+	}
+
+	r.ID = f.GVR
+	r.Fields = Fields{
+		client.NewGVR(f.GVR).R(),
+		strconv.Itoa(f.Faults),
+		strconv.Itoa(f.Total),
+		IntToStr(client.ToPercentage(int64(f.Faults), int64(f.Total))),
+		Sparkline(f.History),
+		f.Description,
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// FaultRes represents the faulty resources tally of a given resource kind.
+type FaultRes struct {
+	GVR         string
+	Description string
+	Faults      int
+	Total       int
+	// History the faults counts observed on previous refreshes.
+	History []int64
+}
+
+// GetObjectKind returns a schema object.
+func (FaultRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (f FaultRes) DeepCopyObject() runtime.Object {
+	return f
+}
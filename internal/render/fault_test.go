@@ -0,0 +1,35 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFaultRender(t *testing.T) {
+	uu := map[string]struct {
+		o render.FaultRes
+		e render.Fields
+	}{
+		"faulty": {
+			o: render.FaultRes{GVR: "v1/pods", Description: "pods failing to run", Faults: 2, Total: 8, History: []int64{0, 1, 2}},
+			e: render.Fields{"pods", "2", "8", "25", "▁▄█", "pods failing to run"},
+		},
+		"healthy": {
+			o: render.FaultRes{GVR: "apps/v1/deployments", Description: "deployments not available", Total: 3, History: []int64{0}},
+			e: render.Fields{"deployments", "0", "3", "0", "", "deployments not available"},
+		},
+	}
+
+	var f render.Fault
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r render.Row
+			assert.Nil(t, f.Render(u.o, "", &r))
+			assert.Equal(t, u.o.GVR, r.ID)
+			assert.Equal(t, u.e, r.Fields)
+		})
+	}
+}
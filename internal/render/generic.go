@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/tview"
 	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
 )
 
@@ -53,7 +54,7 @@ func (g *Generic) Header(ns string) Header {
 			g.ageIndex = i
 			continue
 		}
-		h = append(h, HeaderColumn{Name: strings.ToUpper(c.Name)})
+		h = append(h, printerColumn(c))
 	}
 	if g.ageIndex > 0 {
 		h = append(h, HeaderColumn{Name: "AGE", Time: true})
@@ -105,6 +106,24 @@ func (g *Generic) Render(o interface{}, ns string, r *Row) error {
 // ----------------------------------------------------------------------------
 // Helpers...
 
+// printerColumn converts a printer column definition to a header column.
+// Column types and priorities originate from the resource printer columns
+// ie a CRD additionalPrinterColumns.
+func printerColumn(c metav1beta1.TableColumnDefinition) HeaderColumn {
+	h := HeaderColumn{
+		Name: strings.ToUpper(c.Name),
+		Wide: c.Priority > 0,
+	}
+	switch c.Type {
+	case "integer", "number":
+		h.Numeric, h.Align = true, tview.AlignRight
+	case "date":
+		h.Time = true
+	}
+
+	return h
+}
+
 func resourceNS(raw []byte) (string, string, error) {
 	var obj map[string]interface{}
 	var ns, name string
@@ -5,6 +5,7 @@ import (
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/tview"
 	"github.com/stretchr/testify/assert"
 	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -76,6 +77,18 @@ func TestGenericRender(t *testing.T) {
 				render.HeaderColumn{Name: "AGE", Time: true},
 			},
 		},
+		"printerColumns": {
+			ns:      client.ClusterScope,
+			table:   makePrinterColsGeneric(),
+			eID:     "-/fred",
+			eFields: render.Fields{"fred", "3", "5m", "blee"},
+			eHeader: render.Header{
+				render.HeaderColumn{Name: "NAME"},
+				render.HeaderColumn{Name: "REPLICAS", Numeric: true, Align: tview.AlignRight},
+				render.HeaderColumn{Name: "LAST-SEEN", Time: true},
+				render.HeaderColumn{Name: "DETAILS", Wide: true},
+			},
+		},
 	}
 
 	for k := range uu {
@@ -177,3 +190,32 @@ func makeAgeGeneric() *metav1beta1.Table {
 		},
 	}
 }
+
+func makePrinterColsGeneric() *metav1beta1.Table {
+	return &metav1beta1.Table{
+		ColumnDefinitions: []metav1beta1.TableColumnDefinition{
+			{Name: "Name", Type: "string"},
+			{Name: "Replicas", Type: "integer"},
+			{Name: "Last-Seen", Type: "date"},
+			{Name: "Details", Type: "string", Priority: 1},
+		},
+		Rows: []metav1beta1.TableRow{
+			{
+				Object: runtime.RawExtension{
+					Raw: []byte(`{
+        "kind": "fred",
+        "apiVersion": "v1",
+        "metadata": {
+          "name": "fred"
+        }}`),
+				},
+				Cells: []interface{}{
+					"fred",
+					int64(3),
+					"5m",
+					"blee",
+				},
+			},
+		},
+	}
+}
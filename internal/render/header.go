@@ -17,6 +17,7 @@ type HeaderColumn struct {
 	Wide      bool
 	MX        bool
 	Time      bool
+	Numeric   bool
 }
 
 // Clone copies a header.
@@ -163,6 +164,15 @@ func (h Header) IsTimeCol(col int) bool {
 	return h[col].Time
 }
 
+// IsNumericCol checks if given column index represents a number.
+func (h Header) IsNumericCol(col int) bool {
+	if col < 0 || col >= len(h) {
+		return false
+	}
+
+	return h[col].Numeric
+}
+
 // ValidColIndex returns the valid col index or -1 if none.
 func (h Header) ValidColIndex() int {
 	return h.IndexOf("VALID", true)
@@ -0,0 +1,89 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/tcell/v2"
+	"helm.sh/helm/v3/pkg/release"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// HelmHistory renders a helm release revisions to screen.
+type HelmHistory struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (HelmHistory) ColorerFunc() ColorerFunc {
+	return func(ns string, h Header, re RowEvent) tcell.Color {
+		idx := h.IndexOf("STATUS", true)
+		if idx < 0 {
+			return DefaultColorer(ns, h, re)
+		}
+		switch re.Row.Fields[idx] {
+		case release.StatusDeployed.String():
+			return tcell.ColorMediumSpringGreen
+		case release.StatusFailed.String():
+			return ErrColor
+		case release.StatusSuperseded.String():
+			return StdColor
+		default:
+			return PendingColor
+		}
+	}
+}
+
+// Header returns a header row.
+func (HelmHistory) Header(_ string) Header {
+	return Header{
+		HeaderColumn{Name: "REVISION", Numeric: true},
+		HeaderColumn{Name: "STATUS"},
+		HeaderColumn{Name: "CHART"},
+		HeaderColumn{Name: "APP VERSION"},
+		HeaderColumn{Name: "DESCRIPTION"},
+		HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a release revision to screen.
+func (HelmHistory) Render(o interface{}, ns string, r *Row) error {
+	h, ok := o.(HelmHistoryRes)
+	if !ok {
+		return fmt.Errorf("expected HelmHistoryRes, but got %T", o)
+	}
+
+	rel := h.Release
+	r.ID = client.FQN(rel.Namespace, rel.Name) + ":" + strconv.Itoa(rel.Version)
+	r.Fields = Fields{
+		strconv.Itoa(rel.Version),
+		rel.Info.Status.String(),
+		rel.Chart.Metadata.Name + "-" + rel.Chart.Metadata.Version,
+		rel.Chart.Metadata.AppVersion,
+		rel.Info.Description,
+		toAge(metav1.Time{Time: rel.Info.LastDeployed.Time}),
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// HelmHistoryRes represents a helm release revision resource.
+type HelmHistoryRes struct {
+	Release *release.Release
+}
+
+// GetObjectKind returns a schema object.
+func (HelmHistoryRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (h HelmHistoryRes) DeepCopyObject() runtime.Object {
+	return h
+}
@@ -0,0 +1,45 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/release"
+)
+
+func TestHelmHistoryRender(t *testing.T) {
+	o := render.HelmHistoryRes{
+		Release: &release.Release{
+			Name:      "fred",
+			Namespace: "ns1",
+			Version:   3,
+			Info: &release.Info{
+				Status:      release.StatusSuperseded,
+				Description: "Upgrade complete",
+			},
+			Chart: &chart.Chart{
+				Metadata: &chart.Metadata{
+					Name:       "blee",
+					Version:    "1.2.0",
+					AppVersion: "2.0",
+				},
+			},
+		},
+	}
+
+	var (
+		h render.HelmHistory
+		r render.Row
+	)
+	assert.Nil(t, h.Render(o, "ns1", &r))
+	assert.Equal(t, "ns1/fred:3", r.ID)
+	assert.Equal(t, render.Fields{
+		"3",
+		"superseded",
+		"blee-1.2.0",
+		"2.0",
+		"Upgrade complete",
+	}, r.Fields[:5])
+}
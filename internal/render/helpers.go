@@ -186,6 +186,14 @@ func check(s, sub string) string {
 	return s
 }
 
+func toVulns(s VulnScan) string {
+	if !s.Scanned {
+		return ""
+	}
+
+	return strconv.Itoa(s.Critical)
+}
+
 func boolToStr(b bool) string {
 	switch b {
 	case true:
@@ -195,6 +203,11 @@ func boolToStr(b bool) string {
 	}
 }
 
+// ToAge returns a resource age given its creation timestamp.
+func ToAge(t metav1.Time) string {
+	return toAge(t)
+}
+
 func toAge(t metav1.Time) string {
 	if t.IsZero() {
 		return UnknownValue
@@ -308,17 +321,19 @@ func in(ll []string, s string) bool {
 	return false
 }
 
-// Pad a string up to the given length or truncates if greater than length.
+// Pad a string up to the given display width or truncates if wider.
+// Wide runes ie CJK count as two cells.
 func Pad(s string, width int) string {
-	if len(s) == width {
+	w := runewidth.StringWidth(s)
+	if w == width {
 		return s
 	}
 
-	if len(s) > width {
+	if w > width {
 		return Truncate(s, width)
 	}
 
-	return s + strings.Repeat(" ", width-len(s))
+	return s + strings.Repeat(" ", width-w)
 }
 
 // Converts labels string to map.
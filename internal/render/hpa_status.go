@@ -0,0 +1,265 @@
+package render
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const maxHPAEvents = 10
+
+// HPAMetric tracks an hpa metric current and target values.
+type HPAMetric struct {
+	Type     autoscalingv2.MetricSourceType
+	Name     string
+	Current  string
+	Target   string
+	Exceeded bool
+}
+
+// HPAStatus represents an hpa scaling status.
+type HPAStatus struct {
+	Path             string
+	ScaleTarget      string
+	Min, Max         int32
+	Current, Desired int32
+	LastScale        *metav1.Time
+	Metrics          []HPAMetric
+	Conditions       []autoscalingv2.HorizontalPodAutoscalerCondition
+	Events           []v1.Event
+}
+
+// NewHPAStatus computes an hpa metrics targets given its most recent events.
+func NewHPAStatus(hpa *autoscalingv2.HorizontalPodAutoscaler, ee []v1.Event) HPAStatus {
+	s := HPAStatus{
+		Path:        hpa.Namespace + "/" + hpa.Name,
+		ScaleTarget: hpa.Spec.ScaleTargetRef.Kind + "/" + hpa.Spec.ScaleTargetRef.Name,
+		Max:         hpa.Spec.MaxReplicas,
+		Current:     hpa.Status.CurrentReplicas,
+		Desired:     hpa.Status.DesiredReplicas,
+		LastScale:   hpa.Status.LastScaleTime,
+		Conditions:  hpa.Status.Conditions,
+		Min:         1,
+	}
+	if hpa.Spec.MinReplicas != nil {
+		s.Min = *hpa.Spec.MinReplicas
+	}
+	for _, m := range hpa.Spec.Metrics {
+		s.Metrics = append(s.Metrics, newHPAMetric(m, hpa.Status.CurrentMetrics))
+	}
+
+	s.Events = append(s.Events, ee...)
+	sort.Slice(s.Events, func(i, j int) bool {
+		return eventTime(s.Events[i]).After(eventTime(s.Events[j]).Time)
+	})
+	if len(s.Events) > maxHPAEvents {
+		s.Events = s.Events[:maxHPAEvents]
+	}
+
+	return s
+}
+
+// Report returns a textual hpa metrics, conditions and events report.
+func (s HPAStatus) Report() string {
+	var b strings.Builder
+	b.WriteString("[orange::b]Scaling[-::-]\n\n")
+	fmt.Fprintf(&b, "[aqua::b]%-14s[-::-] %s\n", "Target:", s.ScaleTarget)
+	fmt.Fprintf(&b, "[aqua::b]%-14s[-::-] %d/%d (min %d, max %d)\n", "Replicas:", s.Current, s.Desired, s.Min, s.Max)
+	last := "never"
+	if s.LastScale != nil {
+		last = toAge(*s.LastScale) + " ago"
+	}
+	fmt.Fprintf(&b, "[aqua::b]%-14s[-::-] %s\n", "Last Scale:", last)
+
+	b.WriteString("\n[orange::b]Metrics[-::-]\n\n")
+	if len(s.Metrics) == 0 {
+		b.WriteString("No metrics defined.\n")
+	} else {
+		fmt.Fprintf(&b, "[aqua::b]%-20s %-40s %15s %15s[-::-]\n", "TYPE", "METRIC", "CURRENT", "TARGET")
+		for _, m := range s.Metrics {
+			cur := fmt.Sprintf("%15s", m.Current)
+			if m.Exceeded {
+				cur = "[orangered::b]" + cur + "[-::-]"
+			}
+			fmt.Fprintf(&b, "%-20s %-40s %s %15s\n", m.Type, m.Name, cur, m.Target)
+		}
+	}
+
+	b.WriteString("\n[orange::b]Conditions[-::-]\n\n")
+	if len(s.Conditions) == 0 {
+		b.WriteString("No conditions reported.\n")
+	} else {
+		fmt.Fprintf(&b, "[aqua::b]%-16s %-8s %-30s %s[-::-]\n", "TYPE", "STATUS", "REASON", "MESSAGE")
+		for _, c := range s.Conditions {
+			fmt.Fprintf(&b, "%-16s %-8s %-30s %s\n", c.Type, c.Status, c.Reason, c.Message)
+		}
+	}
+
+	b.WriteString("\n[orange::b]Recent Events[-::-]\n\n")
+	if len(s.Events) == 0 {
+		b.WriteString("No events found.\n")
+		return b.String()
+	}
+	fmt.Fprintf(&b, "[aqua::b]%-10s %-8s %-30s %s[-::-]\n", "AGE", "TYPE", "REASON", "MESSAGE")
+	for _, e := range s.Events {
+		line := fmt.Sprintf("%-10s %-8s %-30s %s", toAge(eventTime(e)), e.Type, e.Reason, e.Message)
+		if e.Type == v1.EventTypeWarning {
+			line = "[orangered::]" + line + "[-::]"
+		}
+		b.WriteString(line + "\n")
+	}
+
+	return b.String()
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func newHPAMetric(spec autoscalingv2.MetricSpec, cc []autoscalingv2.MetricStatus) HPAMetric {
+	m := HPAMetric{Type: spec.Type, Name: hpaSpecName(spec), Current: UnknownValue}
+	var target autoscalingv2.MetricTarget
+	switch spec.Type {
+	case autoscalingv2.ResourceMetricSourceType:
+		if spec.Resource != nil {
+			target = spec.Resource.Target
+		}
+	case autoscalingv2.ContainerResourceMetricSourceType:
+		if spec.ContainerResource != nil {
+			target = spec.ContainerResource.Target
+		}
+	case autoscalingv2.PodsMetricSourceType:
+		if spec.Pods != nil {
+			target = spec.Pods.Target
+		}
+	case autoscalingv2.ObjectMetricSourceType:
+		if spec.Object != nil {
+			target = spec.Object.Target
+		}
+	case autoscalingv2.ExternalMetricSourceType:
+		if spec.External != nil {
+			target = spec.External.Target
+		}
+	}
+	m.Target = hpaTarget(target)
+
+	for _, c := range cc {
+		if c.Type != spec.Type || hpaStatusName(c) != m.Name {
+			continue
+		}
+		cur := hpaCurrent(c)
+		m.Current = hpaValue(cur)
+		m.Exceeded = hpaExceeded(cur, target)
+		break
+	}
+
+	return m
+}
+
+func hpaSpecName(m autoscalingv2.MetricSpec) string {
+	switch {
+	case m.Resource != nil:
+		return string(m.Resource.Name)
+	case m.ContainerResource != nil:
+		return m.ContainerResource.Container + "/" + string(m.ContainerResource.Name)
+	case m.Pods != nil:
+		return m.Pods.Metric.Name
+	case m.Object != nil:
+		return m.Object.DescribedObject.Kind + "/" + m.Object.DescribedObject.Name + ":" + m.Object.Metric.Name
+	case m.External != nil:
+		return m.External.Metric.Name
+	default:
+		return UnknownValue
+	}
+}
+
+func hpaStatusName(m autoscalingv2.MetricStatus) string {
+	switch {
+	case m.Resource != nil:
+		return string(m.Resource.Name)
+	case m.ContainerResource != nil:
+		return m.ContainerResource.Container + "/" + string(m.ContainerResource.Name)
+	case m.Pods != nil:
+		return m.Pods.Metric.Name
+	case m.Object != nil:
+		return m.Object.DescribedObject.Kind + "/" + m.Object.DescribedObject.Name + ":" + m.Object.Metric.Name
+	case m.External != nil:
+		return m.External.Metric.Name
+	default:
+		return ""
+	}
+}
+
+func hpaCurrent(m autoscalingv2.MetricStatus) autoscalingv2.MetricValueStatus {
+	switch {
+	case m.Resource != nil:
+		return m.Resource.Current
+	case m.ContainerResource != nil:
+		return m.ContainerResource.Current
+	case m.Pods != nil:
+		return m.Pods.Current
+	case m.Object != nil:
+		return m.Object.Current
+	case m.External != nil:
+		return m.External.Current
+	default:
+		return autoscalingv2.MetricValueStatus{}
+	}
+}
+
+func hpaTarget(t autoscalingv2.MetricTarget) string {
+	switch {
+	case t.AverageUtilization != nil:
+		return PrintPerc(int(*t.AverageUtilization))
+	case t.AverageValue != nil:
+		return t.AverageValue.String() + " (avg)"
+	case t.Value != nil:
+		return t.Value.String()
+	default:
+		return UnknownValue
+	}
+}
+
+func hpaValue(v autoscalingv2.MetricValueStatus) string {
+	switch {
+	case v.AverageUtilization != nil:
+		return PrintPerc(int(*v.AverageUtilization))
+	case v.AverageValue != nil:
+		return v.AverageValue.String() + " (avg)"
+	case v.Value != nil:
+		return v.Value.String()
+	default:
+		return UnknownValue
+	}
+}
+
+// hpaExceeded checks if a metric current value is above its target.
+func hpaExceeded(v autoscalingv2.MetricValueStatus, t autoscalingv2.MetricTarget) bool {
+	switch {
+	case t.AverageUtilization != nil && v.AverageUtilization != nil:
+		return *v.AverageUtilization > *t.AverageUtilization
+	case t.AverageValue != nil && v.AverageValue != nil:
+		return v.AverageValue.Cmp(*t.AverageValue) > 0
+	case t.Value != nil && v.Value != nil:
+		return v.Value.Cmp(*t.Value) > 0
+	default:
+		return false
+	}
+}
+
+// eventTime returns an event most recent occurrence.
+func eventTime(e v1.Event) metav1.Time {
+	switch {
+	case e.Series != nil && !e.Series.LastObservedTime.IsZero():
+		return metav1.Time{Time: e.Series.LastObservedTime.Time}
+	case !e.LastTimestamp.IsZero():
+		return e.LastTimestamp
+	case !e.EventTime.IsZero():
+		return metav1.Time{Time: e.EventTime.Time}
+	default:
+		return e.FirstTimestamp
+	}
+}
@@ -0,0 +1,121 @@
+package render_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestNewHPAStatus(t *testing.T) {
+	var (
+		min           = int32(2)
+		cpu, cpuAt    = int32(80), int32(95)
+		mem, memAt    = resource.MustParse("100Mi"), resource.MustParse("50Mi")
+		qps, qpsAt    = resource.MustParse("10"), resource.MustParse("12")
+		now           = time.Now()
+		lastScale     = metav1.NewTime(now.Add(-5 * time.Minute))
+		older, recent = metav1.NewTime(now.Add(-time.Hour)), metav1.NewTime(now.Add(-time.Minute))
+	)
+	hpa := autoscalingv2.HorizontalPodAutoscaler{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "web"},
+		Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{Kind: "Deployment", Name: "web"},
+			MinReplicas:    &min,
+			MaxReplicas:    10,
+			Metrics: []autoscalingv2.MetricSpec{
+				{
+					Type: autoscalingv2.ResourceMetricSourceType,
+					Resource: &autoscalingv2.ResourceMetricSource{
+						Name:   v1.ResourceCPU,
+						Target: autoscalingv2.MetricTarget{Type: autoscalingv2.UtilizationMetricType, AverageUtilization: &cpu},
+					},
+				},
+				{
+					Type: autoscalingv2.ResourceMetricSourceType,
+					Resource: &autoscalingv2.ResourceMetricSource{
+						Name:   v1.ResourceMemory,
+						Target: autoscalingv2.MetricTarget{Type: autoscalingv2.AverageValueMetricType, AverageValue: &mem},
+					},
+				},
+				{
+					Type: autoscalingv2.PodsMetricSourceType,
+					Pods: &autoscalingv2.PodsMetricSource{
+						Metric: autoscalingv2.MetricIdentifier{Name: "qps"},
+						Target: autoscalingv2.MetricTarget{Type: autoscalingv2.AverageValueMetricType, AverageValue: &qps},
+					},
+				},
+				{
+					Type: autoscalingv2.ExternalMetricSourceType,
+					External: &autoscalingv2.ExternalMetricSource{
+						Metric: autoscalingv2.MetricIdentifier{Name: "queue"},
+						Target: autoscalingv2.MetricTarget{Type: autoscalingv2.ValueMetricType, Value: &qps},
+					},
+				},
+			},
+		},
+		Status: autoscalingv2.HorizontalPodAutoscalerStatus{
+			CurrentReplicas: 3,
+			DesiredReplicas: 4,
+			LastScaleTime:   &lastScale,
+			CurrentMetrics: []autoscalingv2.MetricStatus{
+				{
+					Type: autoscalingv2.ResourceMetricSourceType,
+					Resource: &autoscalingv2.ResourceMetricStatus{
+						Name:    v1.ResourceMemory,
+						Current: autoscalingv2.MetricValueStatus{AverageValue: &memAt},
+					},
+				},
+				{
+					Type: autoscalingv2.ResourceMetricSourceType,
+					Resource: &autoscalingv2.ResourceMetricStatus{
+						Name:    v1.ResourceCPU,
+						Current: autoscalingv2.MetricValueStatus{AverageUtilization: &cpuAt},
+					},
+				},
+				{
+					Type: autoscalingv2.PodsMetricSourceType,
+					Pods: &autoscalingv2.PodsMetricStatus{
+						Metric:  autoscalingv2.MetricIdentifier{Name: "qps"},
+						Current: autoscalingv2.MetricValueStatus{AverageValue: &qpsAt},
+					},
+				},
+			},
+		},
+	}
+	ee := []v1.Event{
+		{Type: v1.EventTypeNormal, Reason: "SuccessfulRescale", LastTimestamp: older},
+		{Type: v1.EventTypeWarning, Reason: "FailedGetExternalMetric", LastTimestamp: recent},
+	}
+
+	s := render.NewHPAStatus(&hpa, ee)
+	assert.Equal(t, "Deployment/web", s.ScaleTarget)
+	assert.Equal(t, int32(2), s.Min)
+	assert.Equal(t, []render.HPAMetric{
+		{Type: autoscalingv2.ResourceMetricSourceType, Name: "cpu", Current: "95%", Target: "80%", Exceeded: true},
+		{Type: autoscalingv2.ResourceMetricSourceType, Name: "memory", Current: "50Mi (avg)", Target: "100Mi (avg)"},
+		{Type: autoscalingv2.PodsMetricSourceType, Name: "qps", Current: "12 (avg)", Target: "10 (avg)", Exceeded: true},
+		{Type: autoscalingv2.ExternalMetricSourceType, Name: "queue", Current: "<unknown>", Target: "10"},
+	}, s.Metrics)
+	assert.Equal(t, "FailedGetExternalMetric", s.Events[0].Reason)
+	assert.Equal(t, "SuccessfulRescale", s.Events[1].Reason)
+
+	r := s.Report()
+	assert.Contains(t, r, "3/4 (min 2, max 10)")
+	assert.Contains(t, r, "5m ago")
+	assert.Contains(t, r, "SuccessfulRescale")
+}
+
+func TestHPAStatusReportEmpty(t *testing.T) {
+	var hpa autoscalingv2.HorizontalPodAutoscaler
+	r := render.NewHPAStatus(&hpa, nil).Report()
+
+	assert.Contains(t, r, "never")
+	assert.Contains(t, r, "No metrics defined.")
+	assert.Contains(t, r, "No events found.")
+}
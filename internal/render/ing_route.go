@@ -0,0 +1,108 @@
+package render
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/derailed/tcell/v2"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// IngressRoute renders an ingress host/path routes to screen.
+type IngressRoute struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (IngressRoute) ColorerFunc() ColorerFunc {
+	return func(ns string, h Header, re RowEvent) tcell.Color {
+		c := DefaultColorer(ns, h, re)
+		idx := h.IndexOf("STATUS", true)
+		if idx < 0 {
+			return c
+		}
+		switch status := re.Row.Fields[idx]; {
+		case status == "":
+			return c
+		case strings.HasPrefix(status, "2"), strings.HasPrefix(status, "3"):
+			return CompletedColor
+		case strings.HasPrefix(status, "4"):
+			return PendingColor
+		default:
+			return ErrColor
+		}
+	}
+}
+
+// Header returns a header row.
+func (IngressRoute) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "HOST"},
+		HeaderColumn{Name: "PATH"},
+		HeaderColumn{Name: "TYPE"},
+		HeaderColumn{Name: "BACKEND"},
+		HeaderColumn{Name: "PORT"},
+		HeaderColumn{Name: "PODS"},
+		HeaderColumn{Name: "STATUS"},
+		HeaderColumn{Name: "LATENCY"},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (IngressRoute) Render(o interface{}, ns string, r *Row) error {
+	res, ok := o.(IngressRouteRes)
+	if !ok {
+		return fmt.Errorf("expected IngressRouteRes, but got %T", o)
+	}
+
+	backend := res.Service
+	if backend == "" {
+		backend = res.Resource
+	}
+	r.ID = res.ID()
+	r.Fields = append(r.Fields,
+		res.Host,
+		res.Path,
+		res.PathType,
+		backend,
+		res.Port,
+		res.Pods,
+		res.Status,
+		res.Latency,
+	)
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// IngressRouteRes represents an ingress host/path route.
+type IngressRouteRes struct {
+	Namespace string
+	Host      string
+	Path      string
+	PathType  string
+	Service   string
+	Resource  string
+	Port      string
+	Pods      string
+	Status    string
+	Latency   string
+}
+
+// ID returns the route unique identifier.
+func (r IngressRouteRes) ID() string {
+	return strings.Join([]string{r.Namespace, r.Host, r.Path, r.PathType}, "|")
+}
+
+// GetObjectKind returns a schema object.
+func (IngressRouteRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (r IngressRouteRes) DeepCopyObject() runtime.Object {
+	return r
+}
@@ -0,0 +1,39 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIngressRouteRender(t *testing.T) {
+	o := render.IngressRouteRes{
+		Namespace: "ns1",
+		Host:      "fred.com",
+		Path:      "/api",
+		PathType:  "Prefix",
+		Service:   "api",
+		Port:      "http",
+		Pods:      "1/2",
+		Status:    "200 OK",
+		Latency:   "12ms",
+	}
+
+	var (
+		ir render.IngressRoute
+		r  render.Row
+	)
+	assert.Nil(t, ir.Render(o, "ns1", &r))
+	assert.Equal(t, "ns1|fred.com|/api|Prefix", r.ID)
+	assert.Equal(t, render.Fields{
+		"fred.com",
+		"/api",
+		"Prefix",
+		"api",
+		"http",
+		"1/2",
+		"200 OK",
+		"12ms",
+	}, r.Fields)
+}
@@ -0,0 +1,173 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/derailed/tview"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/duration"
+)
+
+// Init containers steps states.
+const (
+	InitPending   = "Pending"
+	InitWaiting   = "Waiting"
+	InitRunning   = "Running"
+	InitCompleted = "Completed"
+	InitFailed    = "Failed"
+)
+
+// InitStep represents an init container execution step.
+type InitStep struct {
+	Name     string
+	State    string
+	Reason   string
+	Message  string
+	Restarts int32
+	ExitCode *int32
+	Started  time.Time
+	Finished time.Time
+}
+
+// Duration returns a step execution duration so far.
+func (s InitStep) Duration(now time.Time) (time.Duration, bool) {
+	if s.Started.IsZero() {
+		return 0, false
+	}
+	if s.Finished.IsZero() {
+		return now.Sub(s.Started), true
+	}
+
+	return s.Finished.Sub(s.Started), true
+}
+
+// InitSteps returns a pod init containers steps in execution order.
+func InitSteps(po *v1.Pod) []InitStep {
+	ss := make(map[string]v1.ContainerStatus, len(po.Status.InitContainerStatuses))
+	for _, s := range po.Status.InitContainerStatuses {
+		ss[s.Name] = s
+	}
+
+	steps := make([]InitStep, 0, len(po.Spec.InitContainers))
+	for _, co := range po.Spec.InitContainers {
+		step := InitStep{Name: co.Name, State: InitPending}
+		if s, ok := ss[co.Name]; ok {
+			step.Restarts = s.RestartCount
+			setInitState(&step, s)
+		}
+		steps = append(steps, step)
+	}
+
+	return steps
+}
+
+// InitProgressReport returns a textual pod init containers progress report.
+func InitProgressReport(po *v1.Pod, now time.Time) string {
+	steps := InitSteps(po)
+	if len(steps) == 0 {
+		return "No init containers defined.\n"
+	}
+
+	var done int
+	for _, s := range steps {
+		if s.State == InitCompleted {
+			done++
+		}
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "[aqua::b]%-10s[-::-] %s\n", "Status:", new(Pod).Phase(po))
+	fmt.Fprintf(&b, "[aqua::b]%-10s[-::-] %s %d/%d completed\n\n", "Progress:", progressBar(done, len(steps), barWidth), done, len(steps))
+
+	fmt.Fprintf(&b, "[aqua::b]%-3s %-30s %-10s %-10s %-8s %s[-::-]\n", "#", "CONTAINER", "STATE", "DURATION", "RESTARTS", "DETAILS")
+	for i, s := range steps {
+		elapsed := NAValue
+		if d, ok := s.Duration(now); ok {
+			elapsed = duration.HumanDuration(d)
+		}
+		fmt.Fprintf(&b, "%-3d %-30s [%s::]%-10s[-::] %-10s %-8d %s\n",
+			i+1,
+			s.Name,
+			initStateColor(s.State),
+			s.State,
+			elapsed,
+			s.Restarts,
+			tview.Escape(initDetails(s)),
+		)
+	}
+
+	return b.String()
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func setInitState(step *InitStep, s v1.ContainerStatus) {
+	switch {
+	case s.State.Terminated != nil:
+		t := s.State.Terminated
+		step.State, step.Reason, step.Message = InitCompleted, t.Reason, t.Message
+		step.Started, step.Finished = t.StartedAt.Time, t.FinishedAt.Time
+		if t.ExitCode != 0 {
+			step.State, step.ExitCode = InitFailed, &t.ExitCode
+		}
+	case s.State.Running != nil:
+		step.State, step.Started = InitRunning, s.State.Running.StartedAt.Time
+	case s.State.Waiting != nil:
+		w := s.State.Waiting
+		if w.Reason == "" || w.Reason == "PodInitializing" {
+			return
+		}
+		step.State, step.Reason, step.Message = InitWaiting, w.Reason, w.Message
+		// A backing off init container last run tells why it failed.
+		if t := s.LastTerminationState.Terminated; t != nil && t.ExitCode != 0 {
+			step.State, step.ExitCode = InitFailed, &t.ExitCode
+			step.Started, step.Finished = t.StartedAt.Time, t.FinishedAt.Time
+			step.Message = check(t.Message, w.Message)
+		}
+	}
+}
+
+// progressBar returns a bar graph for the number of completed steps.
+func progressBar(done, total, width int) string {
+	fill := done * width / total
+
+	return "[green::]" + strings.Repeat(barFill, fill) + "[gray::]" + strings.Repeat(barEmpty, width-fill) + "[-::]"
+}
+
+func initDetails(s InitStep) string {
+	dd := make([]string, 0, 3)
+	if s.Reason != "" && s.Reason != InitCompleted {
+		dd = append(dd, s.Reason)
+	}
+	if s.ExitCode != nil {
+		code := "exit " + strconv.Itoa(int(*s.ExitCode))
+		if h := ExitCodeHint(*s.ExitCode); h != "" {
+			code += " (" + h + ")"
+		}
+		dd = append(dd, code)
+	}
+	if s.Message != "" {
+		dd = append(dd, s.Message)
+	}
+
+	return strings.Join(dd, ", ")
+}
+
+func initStateColor(state string) string {
+	switch state {
+	case InitCompleted:
+		return "green"
+	case InitRunning:
+		return "aqua"
+	case InitFailed:
+		return "orangered"
+	case InitWaiting:
+		return "orange"
+	default:
+		return "-"
+	}
+}
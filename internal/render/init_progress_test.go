@@ -0,0 +1,95 @@
+package render_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestInitSteps(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	po := initPod(now)
+
+	ss := render.InitSteps(&po)
+	assert.Equal(t, 4, len(ss))
+
+	uu := []struct {
+		name, state string
+		elapsed     time.Duration
+		started     bool
+	}{
+		{name: "i1", state: render.InitCompleted, elapsed: 10 * time.Second, started: true},
+		{name: "i2", state: render.InitFailed, elapsed: 5 * time.Second, started: true},
+		{name: "i3", state: render.InitPending},
+		{name: "i4", state: render.InitPending},
+	}
+	for i, u := range uu {
+		assert.Equal(t, u.name, ss[i].Name)
+		assert.Equal(t, u.state, ss[i].State)
+		d, ok := ss[i].Duration(now)
+		assert.Equal(t, u.started, ok)
+		assert.Equal(t, u.elapsed, d)
+	}
+	assert.Equal(t, int32(3), ss[1].Restarts)
+	assert.Equal(t, int32(1), *ss[1].ExitCode)
+
+	po.Status.InitContainerStatuses[1] = v1.ContainerStatus{
+		Name:  "i2",
+		State: v1.ContainerState{Running: &v1.ContainerStateRunning{StartedAt: metav1.NewTime(now.Add(-time.Minute))}},
+	}
+	ss = render.InitSteps(&po)
+	assert.Equal(t, render.InitRunning, ss[1].State)
+	d, _ := ss[1].Duration(now)
+	assert.Equal(t, time.Minute, d)
+}
+
+func TestInitProgressReport(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	po := initPod(now)
+
+	r := render.InitProgressReport(&po, now)
+	assert.Contains(t, r, "Init:CrashLoopBackOff")
+	assert.Contains(t, r, "1/4 completed")
+	assert.Contains(t, r, "CrashLoopBackOff, exit 1 (application error), migration failed")
+	assert.Equal(t, "No init containers defined.\n", render.InitProgressReport(&v1.Pod{}, now))
+}
+
+func initPod(now time.Time) v1.Pod {
+	return v1.Pod{
+		Spec: v1.PodSpec{
+			InitContainers: []v1.Container{{Name: "i1"}, {Name: "i2"}, {Name: "i3"}, {Name: "i4"}},
+		},
+		Status: v1.PodStatus{
+			Phase: v1.PodPending,
+			InitContainerStatuses: []v1.ContainerStatus{
+				{
+					Name: "i1",
+					State: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{
+						Reason:     "Completed",
+						StartedAt:  metav1.NewTime(now.Add(-time.Minute)),
+						FinishedAt: metav1.NewTime(now.Add(-50 * time.Second)),
+					}},
+				},
+				{
+					Name:         "i2",
+					RestartCount: 3,
+					State:        v1.ContainerState{Waiting: &v1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}},
+					LastTerminationState: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{
+						ExitCode:   1,
+						Message:    "migration failed",
+						StartedAt:  metav1.NewTime(now.Add(-20 * time.Second)),
+						FinishedAt: metav1.NewTime(now.Add(-15 * time.Second)),
+					}},
+				},
+				{
+					Name:  "i3",
+					State: v1.ContainerState{Waiting: &v1.ContainerStateWaiting{Reason: "PodInitializing"}},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,101 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Lint issue levels.
+const (
+	LintInfo = iota + 1
+	LintWarn
+	LintError
+)
+
+// Lint renders cluster sanitization issues to screen.
+type Lint struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (Lint) ColorerFunc() ColorerFunc {
+	return func(ns string, h Header, re RowEvent) tcell.Color {
+		idx := h.IndexOf("LEVEL", true)
+		if idx < 0 || idx >= len(re.Row.Fields) {
+			return DefaultColorer(ns, h, re)
+		}
+		switch re.Row.Fields[idx] {
+		case strconv.Itoa(LintError):
+			return ErrColor
+		case strconv.Itoa(LintWarn):
+			return HighlightColor
+		default:
+			return StdColor
+		}
+	}
+}
+
+// Header returns a header row.
+func (Lint) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "LEVEL", Align: tview.AlignRight, Numeric: true},
+		HeaderColumn{Name: "CHECK"},
+		HeaderColumn{Name: "GVR"},
+		HeaderColumn{Name: "RESOURCE"},
+		HeaderColumn{Name: "MESSAGE"},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (Lint) Render(o interface{}, ns string, r *Row) error {
+	l, ok := o.(LintRes)
+	if !ok {
+		return fmt.Errorf("expected LintRes, but got %T", o)
+	}
+
+	r.ID = l.ID()
+	r.Fields = append(r.Fields,
+		strconv.Itoa(l.Level),
+		l.Check,
+		l.GVR,
+		l.Path,
+		l.Message,
+	)
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// LintRes represents a sanitization issue on a given resource.
+type LintRes struct {
+	// Level the issue level, ie 1 info, 2 warning or 3 error.
+	Level int
+	Check string
+	// GVR the offending resource gvr.
+	GVR string
+	// Path the offending resource path.
+	Path    string
+	Message string
+}
+
+// ID returns the issue unique id.
+func (l LintRes) ID() string {
+	return l.Check + "|" + l.Path + "|" + l.Message
+}
+
+// GetObjectKind returns a schema object.
+func (LintRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (l LintRes) DeepCopyObject() runtime.Object {
+	return l
+}
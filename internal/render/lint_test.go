@@ -0,0 +1,26 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLintRender(t *testing.T) {
+	var (
+		l render.Lint
+		r render.Row
+	)
+	o := render.LintRes{
+		Level:   render.LintWarn,
+		Check:   "missing-probes",
+		GVR:     "v1/pods",
+		Path:    "default/p1",
+		Message: "blee",
+	}
+
+	assert.Nil(t, l.Render(o, "", &r))
+	assert.Equal(t, "missing-probes|default/p1|blee", r.ID)
+	assert.Equal(t, render.Fields{"2", "missing-probes", "v1/pods", "default/p1", "blee"}, r.Fields)
+}
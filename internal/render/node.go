@@ -3,6 +3,7 @@ package render
 import (
 	"errors"
 	"fmt"
+	"math"
 	"sort"
 	"strconv"
 	"strings"
@@ -43,6 +44,11 @@ func (Node) Header(_ string) Header {
 		HeaderColumn{Name: "%MEM", Align: tview.AlignRight, MX: true},
 		HeaderColumn{Name: "CPU/A", Align: tview.AlignRight, MX: true},
 		HeaderColumn{Name: "MEM/A", Align: tview.AlignRight, MX: true},
+		HeaderColumn{Name: "TAINTS"},
+		HeaderColumn{Name: "GPU/R:A", Align: tview.AlignRight},
+		HeaderColumn{Name: "%GPU", Align: tview.AlignRight, Wide: true},
+		HeaderColumn{Name: "EXTENDED", Wide: true},
+		HeaderColumn{Name: "COST/H", Align: tview.AlignRight, Wide: true},
 		HeaderColumn{Name: "LABELS", Wide: true},
 		HeaderColumn{Name: "VALID", Wide: true},
 		HeaderColumn{Name: "AGE", Time: true},
@@ -93,6 +99,11 @@ func (n Node) Render(o interface{}, ns string, r *Row) error {
 		client.ToPercentageStr(c.mem, a.mem),
 		toMc(a.cpu),
 		toMi(a.mem),
+		missing(taintsToStr(no.Spec.Taints)),
+		gpuRequests(oo.Requests, no.Status.Allocatable),
+		gpuUtilization(oo.GPUUtil),
+		extendedSummary(oo.Requests, no.Status.Allocatable),
+		oo.Rates.nodeCost(&no),
 		mapToStr(no.Labels),
 		asStatus(n.diagnose(statuses)),
 		toAge(no.GetCreationTimestamp()),
@@ -101,6 +112,14 @@ func (n Node) Render(o interface{}, ns string, r *Row) error {
 	return nil
 }
 
+func gpuUtilization(u *float64) string {
+	if u == nil {
+		return NAValue
+	}
+
+	return strconv.Itoa(int(math.Round(*u)))
+}
+
 func (Node) diagnose(ss []string) error {
 	if len(ss) == 0 {
 		return nil
@@ -129,11 +148,36 @@ func (Node) diagnose(ss []string) error {
 // ----------------------------------------------------------------------------
 // Helpers...
 
+// TaintSpec returns a taint as key=value:effect.
+func TaintSpec(t v1.Taint) string {
+	s := t.Key
+	if t.Value != "" {
+		s += "=" + t.Value
+	}
+
+	return s + ":" + string(t.Effect)
+}
+
+func taintsToStr(tt []v1.Taint) string {
+	ss := make([]string, 0, len(tt))
+	for _, t := range tt {
+		ss = append(ss, TaintSpec(t))
+	}
+
+	return strings.Join(ss, ",")
+}
+
 // NodeWithMetrics represents a node with its associated metrics.
 type NodeWithMetrics struct {
 	Raw      *unstructured.Unstructured
 	MX       *mv1beta1.NodeMetrics
 	PodCount int
+	// Requests the node scheduled pods extended resources requests.
+	Requests v1.ResourceList
+	// GPUUtil the node gpus utilization percentage if known.
+	GPUUtil *float64
+	// Rates the resources prices if cost estimation is enabled.
+	Rates *Rates
 }
 
 // GetObjectKind returns a schema object.
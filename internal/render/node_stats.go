@@ -0,0 +1,197 @@
+package render
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/tview"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/duration"
+)
+
+const maxEphemeralPods = 10
+
+// kubeletConditions tracks the node conditions maintained by the kubelet.
+// Any other conditions are assumed to be reported by a node problem detector.
+var kubeletConditions = map[v1.NodeConditionType]struct{}{
+	v1.NodeReady:              {},
+	v1.NodeMemoryPressure:     {},
+	v1.NodeDiskPressure:       {},
+	v1.NodePIDPressure:        {},
+	v1.NodeNetworkUnavailable: {},
+}
+
+// FsStats represents a kubelet filesystem usage.
+type FsStats struct {
+	AvailableBytes *uint64 `json:"availableBytes,omitempty"`
+	CapacityBytes  *uint64 `json:"capacityBytes,omitempty"`
+	UsedBytes      *uint64 `json:"usedBytes,omitempty"`
+	InodesFree     *uint64 `json:"inodesFree,omitempty"`
+	Inodes         *uint64 `json:"inodes,omitempty"`
+	InodesUsed     *uint64 `json:"inodesUsed,omitempty"`
+}
+
+// KubeletSummary represents the kubelet stats summary fields of interest.
+type KubeletSummary struct {
+	Node struct {
+		NodeName string   `json:"nodeName"`
+		Fs       *FsStats `json:"fs,omitempty"`
+		Runtime  *struct {
+			ImageFs *FsStats `json:"imageFs,omitempty"`
+		} `json:"runtime,omitempty"`
+	} `json:"node"`
+	Pods []KubeletPodStats `json:"pods"`
+}
+
+// KubeletPodStats represents a kubelet pod stats.
+type KubeletPodStats struct {
+	PodRef struct {
+		Name      string `json:"name"`
+		Namespace string `json:"namespace"`
+	} `json:"podRef"`
+	EphemeralStorage *FsStats `json:"ephemeral-storage,omitempty"`
+}
+
+// NodeStats represents a node kubelet stats and conditions.
+type NodeStats struct {
+	Node       string
+	Summary    *KubeletSummary
+	SummaryErr error
+	Conditions []v1.NodeCondition
+}
+
+// Problems returns the node problem detector conditions currently raised.
+func (s NodeStats) Problems() []v1.NodeCondition {
+	var cc []v1.NodeCondition
+	for _, c := range s.Conditions {
+		if IsProblemCondition(c) && c.Status == v1.ConditionTrue {
+			cc = append(cc, c)
+		}
+	}
+
+	return cc
+}
+
+// Report returns a textual node kubelet stats and conditions report.
+func (s NodeStats) Report(now time.Time) string {
+	var b strings.Builder
+	b.WriteString("[orange::b]Filesystems[-::-]\n\n")
+	switch {
+	case s.SummaryErr != nil:
+		fmt.Fprintf(&b, "[orangered::b]Kubelet stats unavailable:[-::-] %s\n", s.SummaryErr)
+	case s.Summary != nil:
+		s.fsReport(&b)
+		b.WriteString("\n[orange::b]Pods Ephemeral Storage[-::-]\n\n")
+		s.ephemeralReport(&b)
+	}
+
+	b.WriteString("\n[orange::b]Conditions[-::-]\n\n")
+	s.conditionsReport(&b, now)
+
+	return b.String()
+}
+
+// IsProblemCondition checks if a node condition is reported by a node problem
+// detector.
+func IsProblemCondition(c v1.NodeCondition) bool {
+	_, ok := kubeletConditions[c.Type]
+
+	return !ok
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func (s NodeStats) fsReport(b *strings.Builder) {
+	fmt.Fprintf(b, "[aqua::b]%-12s %12s %12s %-*s %6s %8s[-::-]\n", "FS", "USED", "CAPACITY", barWidth, "", "%USE", "%INODES")
+	fsRow(b, "node", s.Summary.Node.Fs)
+	var imageFs *FsStats
+	if rt := s.Summary.Node.Runtime; rt != nil {
+		imageFs = rt.ImageFs
+	}
+	fsRow(b, "images", imageFs)
+}
+
+func fsRow(b *strings.Builder, name string, fs *FsStats) {
+	if fs == nil || fs.UsedBytes == nil || fs.CapacityBytes == nil {
+		fmt.Fprintf(b, "%-12s %12s %12s %-*s %6s %8s\n", name, NAValue, NAValue, barWidth, "", NAValue, NAValue)
+		return
+	}
+	used, capacity := int64(*fs.UsedBytes), int64(*fs.CapacityBytes)
+	perc, inodes := client.ToPercentage(used, capacity), NAValue
+	if fs.InodesUsed != nil && fs.Inodes != nil {
+		inodes = PrintPerc(client.ToPercentage(int64(*fs.InodesUsed), int64(*fs.Inodes)))
+	}
+	fmt.Fprintf(b, "%-12s %12s %12s %s %6s %8s\n",
+		name,
+		formatCapacity(v1.ResourceEphemeralStorage, used),
+		formatCapacity(v1.ResourceEphemeralStorage, capacity),
+		Bar(perc, barWidth),
+		PrintPerc(perc),
+		inodes,
+	)
+}
+
+func (s NodeStats) ephemeralReport(b *strings.Builder) {
+	pp := make([]KubeletPodStats, 0, len(s.Summary.Pods))
+	for _, p := range s.Summary.Pods {
+		if p.EphemeralStorage != nil && p.EphemeralStorage.UsedBytes != nil {
+			pp = append(pp, p)
+		}
+	}
+	if len(pp) == 0 {
+		b.WriteString("No pods ephemeral storage reported.\n")
+		return
+	}
+	sort.SliceStable(pp, func(i, j int) bool {
+		return *pp[i].EphemeralStorage.UsedBytes > *pp[j].EphemeralStorage.UsedBytes
+	})
+	fmt.Fprintf(b, "[aqua::b]%-60s %12s[-::-]\n", "POD", "USED")
+	for i, p := range pp {
+		if i == maxEphemeralPods {
+			fmt.Fprintf(b, "... %d more\n", len(pp)-maxEphemeralPods)
+			break
+		}
+		fmt.Fprintf(b, "%-60s %12s\n",
+			client.FQN(p.PodRef.Namespace, p.PodRef.Name),
+			formatCapacity(v1.ResourceEphemeralStorage, int64(*p.EphemeralStorage.UsedBytes)),
+		)
+	}
+}
+
+func (s NodeStats) conditionsReport(b *strings.Builder, now time.Time) {
+	if len(s.Conditions) == 0 {
+		b.WriteString("No conditions reported.\n")
+		return
+	}
+	fmt.Fprintf(b, "[aqua::b]%-28s %-8s %-16s %-28s %-8s %s[-::-]\n", "TYPE", "STATUS", "SOURCE", "REASON", "SINCE", "MESSAGE")
+	for _, c := range s.Conditions {
+		source, color := "kubelet", "-"
+		if IsProblemCondition(c) {
+			source = "problem detector"
+		}
+		if conditionRaised(c) {
+			color = "orangered"
+		}
+		since := NAValue
+		if !c.LastTransitionTime.IsZero() {
+			since = duration.HumanDuration(now.Sub(c.LastTransitionTime.Time))
+		}
+		fmt.Fprintf(b, "[%s::]%-28s %-8s %-16s %-28s %-8s %s[-::]\n", color, c.Type, c.Status, source, na(c.Reason), since, tview.Escape(c.Message))
+	}
+	if pp := s.Problems(); len(pp) > 0 {
+		fmt.Fprintf(b, "\n[orangered::b]%d node problem(s) detected![-::-]\n", len(pp))
+	}
+}
+
+// conditionRaised checks if a condition flags an unhealthy node.
+func conditionRaised(c v1.NodeCondition) bool {
+	if c.Type == v1.NodeReady {
+		return c.Status != v1.ConditionTrue
+	}
+
+	return c.Status == v1.ConditionTrue
+}
@@ -0,0 +1,77 @@
+package render_test
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const kubeletSummary = `{
+  "node": {
+    "nodeName": "n1",
+    "fs": {"availableBytes": 52428800, "capacityBytes": 104857600, "usedBytes": 52428800, "inodesFree": 75, "inodes": 100, "inodesUsed": 25},
+    "runtime": {"imageFs": {"capacityBytes": 104857600, "usedBytes": 94371840}}
+  },
+  "pods": [
+    {"podRef": {"name": "p1", "namespace": "default"}, "ephemeral-storage": {"usedBytes": 1048576}},
+    {"podRef": {"name": "p2", "namespace": "kube-system"}, "ephemeral-storage": {"usedBytes": 10485760}},
+    {"podRef": {"name": "p3", "namespace": "default"}}
+  ]
+}`
+
+func TestNodeStatsReport(t *testing.T) {
+	var s render.KubeletSummary
+	assert.NoError(t, json.Unmarshal([]byte(kubeletSummary), &s))
+
+	now := time.Now()
+	ns := render.NodeStats{
+		Node:    "n1",
+		Summary: &s,
+		Conditions: []v1.NodeCondition{
+			{Type: v1.NodeReady, Status: v1.ConditionTrue, Reason: "KubeletReady", LastTransitionTime: metav1.NewTime(now.Add(-time.Hour))},
+			{Type: v1.NodeDiskPressure, Status: v1.ConditionFalse},
+			{Type: "KernelDeadlock", Status: v1.ConditionFalse, Reason: "KernelHasNoDeadlock"},
+			{Type: "ReadonlyFilesystem", Status: v1.ConditionTrue, Reason: "FilesystemIsReadOnly"},
+		},
+	}
+	r := ns.Report(now)
+
+	assert.Contains(t, r, "50Mi")
+	assert.Contains(t, r, "90Mi")
+	assert.Regexp(t, `images .* 90%`, r)
+	assert.Less(t, strings.Index(r, "kube-system/p2"), strings.Index(r, "default/p1"))
+	assert.NotContains(t, r, "default/p3")
+	assert.Contains(t, r, "problem detector")
+	assert.Contains(t, r, "1 node problem(s) detected!")
+	assert.Equal(t, 1, len(ns.Problems()))
+	assert.Equal(t, v1.NodeConditionType("ReadonlyFilesystem"), ns.Problems()[0].Type)
+}
+
+func TestNodeStatsReportNoSummary(t *testing.T) {
+	ns := render.NodeStats{Node: "n1", SummaryErr: errors.New("forbidden")}
+	r := ns.Report(time.Now())
+
+	assert.Contains(t, r, "Kubelet stats unavailable:[-::-] forbidden")
+	assert.Contains(t, r, "No conditions reported.")
+}
+
+func TestIsProblemCondition(t *testing.T) {
+	uu := map[v1.NodeConditionType]bool{
+		v1.NodeReady:            false,
+		v1.NodeMemoryPressure:   false,
+		v1.NodePIDPressure:      false,
+		"KernelDeadlock":        true,
+		"FrequentDockerRestart": true,
+	}
+
+	for k, e := range uu {
+		assert.Equal(t, e, render.IsProblemCondition(v1.NodeCondition{Type: k}), string(k))
+	}
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/derailed/k9s/internal/render"
 	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	mv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
 )
@@ -21,8 +22,31 @@ func TestNodeRender(t *testing.T) {
 	assert.Nil(t, err)
 
 	assert.Equal(t, "minikube", r.ID)
-	e := render.Fields{"minikube", "Ready", "master", "v1.15.2", "4.15.0", "192.168.64.107", "<none>", "0", "10", "20", "0", "0", "4000", "7874"}
-	assert.Equal(t, e, r.Fields[:14])
+	e := render.Fields{"minikube", "Ready", "master", "v1.15.2", "4.15.0", "192.168.64.107", "<none>", "0", "10", "20", "0", "0", "4000", "7874", "<none>"}
+	assert.Equal(t, e, r.Fields[:15])
+}
+
+func TestTaintSpec(t *testing.T) {
+	uu := map[string]struct {
+		t v1.Taint
+		e string
+	}{
+		"value": {
+			t: v1.Taint{Key: "dedicated", Value: "gpu", Effect: v1.TaintEffectNoSchedule},
+			e: "dedicated=gpu:NoSchedule",
+		},
+		"no-value": {
+			t: v1.Taint{Key: "node.kubernetes.io/unreachable", Effect: v1.TaintEffectNoExecute},
+			e: "node.kubernetes.io/unreachable:NoExecute",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, render.TaintSpec(u.t))
+		})
+	}
 }
 
 func BenchmarkNodeRender(b *testing.B) {
@@ -33,6 +33,11 @@ func (NetworkPolicy) Header(ns string) Header {
 	}
 }
 
+// Stable checks if rows only change when the resources change.
+func (NetworkPolicy) Stable() bool {
+	return true
+}
+
 // Render renders a K8s resource to screen.
 func (n NetworkPolicy) Render(o interface{}, ns string, r *Row) error {
 	raw, ok := o.(*unstructured.Unstructured)
@@ -0,0 +1,90 @@
+package render
+
+import (
+	"fmt"
+
+	"github.com/derailed/tcell/v2"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	allowedVerdict = "allowed"
+	deniedVerdict  = "denied"
+)
+
+// NetworkPolicySim renders network policies verdicts to screen.
+type NetworkPolicySim struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (NetworkPolicySim) ColorerFunc() ColorerFunc {
+	return func(ns string, h Header, re RowEvent) tcell.Color {
+		idx := h.IndexOf("VERDICT", true)
+		if idx < 0 {
+			return DefaultColorer(ns, h, re)
+		}
+		if re.Row.Fields[idx] == deniedVerdict {
+			return ErrColor
+		}
+
+		return CompletedColor
+	}
+}
+
+// Header returns a header row.
+func (NetworkPolicySim) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "DIRECTION"},
+		HeaderColumn{Name: "NAMESPACE"},
+		HeaderColumn{Name: "POLICY"},
+		HeaderColumn{Name: "VERDICT"},
+		HeaderColumn{Name: "REASON"},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (NetworkPolicySim) Render(o interface{}, ns string, r *Row) error {
+	res, ok := o.(PolicyVerdictRes)
+	if !ok {
+		return fmt.Errorf("expected PolicyVerdictRes, but got %T", o)
+	}
+
+	verdict := deniedVerdict
+	if res.Allowed {
+		verdict = allowedVerdict
+	}
+	r.ID = res.Direction + "|" + res.Namespace + "|" + res.Policy
+	r.Fields = append(r.Fields,
+		res.Direction,
+		res.Namespace,
+		res.Policy,
+		verdict,
+		res.Reason,
+	)
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// PolicyVerdictRes represents a network policy verdict for a given traffic.
+type PolicyVerdictRes struct {
+	Direction string
+	Namespace string
+	Policy    string
+	Allowed   bool
+	Reason    string
+}
+
+// GetObjectKind returns a schema object.
+func (PolicyVerdictRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (p PolicyVerdictRes) DeepCopyObject() runtime.Object {
+	return p
+}
@@ -0,0 +1,31 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNetworkPolicySimRender(t *testing.T) {
+	o := render.PolicyVerdictRes{
+		Direction: "ingress",
+		Namespace: "ns1",
+		Policy:    "deny-all",
+		Reason:    "policy denies all ingress",
+	}
+
+	var (
+		n render.NetworkPolicySim
+		r render.Row
+	)
+	assert.Nil(t, n.Render(o, "ns1", &r))
+	assert.Equal(t, "ingress|ns1|deny-all", r.ID)
+	assert.Equal(t, render.Fields{
+		"ingress",
+		"ns1",
+		"deny-all",
+		"denied",
+		"policy denies all ingress",
+	}, r.Fields)
+}
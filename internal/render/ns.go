@@ -7,9 +7,11 @@ import (
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
 // Namespace renders a K8s Namespace to screen.
@@ -42,6 +44,7 @@ func (Namespace) Header(string) Header {
 	return Header{
 		HeaderColumn{Name: "NAME"},
 		HeaderColumn{Name: "STATUS"},
+		HeaderColumn{Name: "COST/H", Align: tview.AlignRight, Wide: true},
 		HeaderColumn{Name: "LABELS", Wide: true},
 		HeaderColumn{Name: "VALID", Wide: true},
 		HeaderColumn{Name: "AGE", Time: true},
@@ -50,7 +53,11 @@ func (Namespace) Header(string) Header {
 
 // Render renders a K8s resource to screen.
 func (n Namespace) Render(o interface{}, _ string, r *Row) error {
+	var cost *float64
 	raw, ok := o.(*unstructured.Unstructured)
+	if nwc, isCost := o.(*NamespaceWithCost); isCost {
+		raw, cost, ok = nwc.Raw, nwc.Cost, true
+	}
 	if !ok {
 		return fmt.Errorf("Expected Namespace, but got %T", o)
 	}
@@ -64,6 +71,7 @@ func (n Namespace) Render(o interface{}, _ string, r *Row) error {
 	r.Fields = Fields{
 		ns.Name,
 		string(ns.Status.Phase),
+		namespaceCost(cost),
 		mapToStr(ns.Labels),
 		asStatus(n.diagnose(ns.Status.Phase)),
 		toAge(ns.GetCreationTimestamp()),
@@ -72,9 +80,36 @@ func (n Namespace) Render(o interface{}, _ string, r *Row) error {
 	return nil
 }
 
+func namespaceCost(c *float64) string {
+	if c == nil {
+		return NAValue
+	}
+
+	return toCost(*c)
+}
+
 func (Namespace) diagnose(phase v1.NamespacePhase) error {
 	if phase != v1.NamespaceActive && phase != v1.NamespaceTerminating {
 		return errors.New("namespace not ready")
 	}
 	return nil
 }
+
+// ----------------------------------------------------------------------------
+
+// NamespaceWithCost represents a namespace with its workloads estimated cost.
+type NamespaceWithCost struct {
+	Raw *unstructured.Unstructured
+	// Cost the namespace active pods estimated hourly cost if known.
+	Cost *float64
+}
+
+// GetObjectKind returns a schema object.
+func (n *NamespaceWithCost) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (n *NamespaceWithCost) DeepCopyObject() runtime.Object {
+	return n
+}
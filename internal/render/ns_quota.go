@@ -0,0 +1,148 @@
+package render
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// QuotaUsage tracks a resource quota consumption for a given resource.
+type QuotaUsage struct {
+	Quota      string
+	Name       v1.ResourceName
+	Used, Hard resource.Quantity
+}
+
+// Perc returns the quota consumption percentage.
+func (q QuotaUsage) Perc() int {
+	return client.ToPercentage(q.Used.MilliValue(), q.Hard.MilliValue())
+}
+
+// NamespaceQuota represents a namespace quotas consumption and limit ranges.
+type NamespaceQuota struct {
+	Namespace   string
+	Usages      []QuotaUsage
+	LimitRanges []v1.LimitRange
+}
+
+// NewNamespaceQuota computes a namespace quotas consumption.
+func NewNamespaceQuota(ns string, qq []v1.ResourceQuota, ll []v1.LimitRange) NamespaceQuota {
+	q := NamespaceQuota{Namespace: ns, LimitRanges: ll}
+	for _, rq := range qq {
+		for n, hard := range rq.Status.Hard {
+			q.Usages = append(q.Usages, QuotaUsage{
+				Quota: rq.Name,
+				Name:  n,
+				Used:  rq.Status.Used[n],
+				Hard:  hard,
+			})
+		}
+	}
+	sort.Slice(q.Usages, func(i, j int) bool {
+		if q.Usages[i].Quota != q.Usages[j].Quota {
+			return q.Usages[i].Quota < q.Usages[j].Quota
+		}
+		return q.Usages[i].Name < q.Usages[j].Name
+	})
+	sort.Slice(q.LimitRanges, func(i, j int) bool {
+		return q.LimitRanges[i].Name < q.LimitRanges[j].Name
+	})
+
+	return q
+}
+
+// Exhausted checks if any of the namespace quotas is fully consumed.
+func (q NamespaceQuota) Exhausted() bool {
+	for _, u := range q.Usages {
+		if u.Used.Cmp(u.Hard) >= 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Report returns a textual quotas and limit ranges report with bar graphs.
+func (q NamespaceQuota) Report() string {
+	var b strings.Builder
+	b.WriteString("[orange::b]Resource Quotas[-::-]\n\n")
+	if len(q.Usages) == 0 {
+		b.WriteString("No resource quotas defined.\n")
+	} else {
+		fmt.Fprintf(&b, "[aqua::b]%-20s %-30s %12s %12s %-*s %6s[-::-]\n",
+			"QUOTA", "RESOURCE", "USED", "HARD", barWidth, "", "%USED",
+		)
+		for _, u := range q.Usages {
+			fmt.Fprintf(&b, "%-20s %-30s %12s %12s %s %6s\n",
+				u.Quota,
+				u.Name,
+				u.Used.String(),
+				u.Hard.String(),
+				Bar(u.Perc(), barWidth),
+				PrintPerc(u.Perc()),
+			)
+		}
+		if q.Exhausted() {
+			b.WriteString("\n[red::b]Some quotas are exhausted![-::-]\n")
+		}
+	}
+
+	b.WriteString("\n[orange::b]Limit Ranges[-::-]\n\n")
+	if len(q.LimitRanges) == 0 {
+		b.WriteString("No limit ranges defined.\n")
+		return b.String()
+	}
+	fmt.Fprintf(&b, "[aqua::b]%-20s %-22s %-20s %10s %10s %10s %10s %10s[-::-]\n",
+		"LIMITRANGE", "TYPE", "RESOURCE", "MIN", "MAX", "DEF-REQ", "DEF-LIM", "MAX-RATIO",
+	)
+	for _, lr := range q.LimitRanges {
+		for _, l := range lr.Spec.Limits {
+			for _, n := range limitResources(l) {
+				fmt.Fprintf(&b, "%-20s %-22s %-20s %10s %10s %10s %10s %10s\n",
+					lr.Name,
+					l.Type,
+					n,
+					limitFor(l.Min, n),
+					limitFor(l.Max, n),
+					limitFor(l.DefaultRequest, n),
+					limitFor(l.Default, n),
+					limitFor(l.MaxLimitRequestRatio, n),
+				)
+			}
+		}
+	}
+
+	return b.String()
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func limitResources(l v1.LimitRangeItem) []v1.ResourceName {
+	set := make(map[v1.ResourceName]struct{})
+	for _, rl := range []v1.ResourceList{l.Min, l.Max, l.DefaultRequest, l.Default, l.MaxLimitRequestRatio} {
+		for n := range rl {
+			set[n] = struct{}{}
+		}
+	}
+	nn := make([]v1.ResourceName, 0, len(set))
+	for n := range set {
+		nn = append(nn, n)
+	}
+	sort.Slice(nn, func(i, j int) bool { return nn[i] < nn[j] })
+
+	return nn
+}
+
+func limitFor(rl v1.ResourceList, n v1.ResourceName) string {
+	q, ok := rl[n]
+	if !ok {
+		return NAValue
+	}
+
+	return q.String()
+}
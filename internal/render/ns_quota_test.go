@@ -0,0 +1,66 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestNewNamespaceQuota(t *testing.T) {
+	qq := []v1.ResourceQuota{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "q1"},
+			Status: v1.ResourceQuotaStatus{
+				Hard: v1.ResourceList{
+					v1.ResourcePods:           resource.MustParse("10"),
+					v1.ResourceRequestsCPU:    resource.MustParse("2"),
+					v1.ResourceRequestsMemory: resource.MustParse("1Gi"),
+				},
+				Used: v1.ResourceList{
+					v1.ResourcePods:        resource.MustParse("10"),
+					v1.ResourceRequestsCPU: resource.MustParse("500m"),
+				},
+			},
+		},
+	}
+	ll := []v1.LimitRange{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "lr1"},
+			Spec: v1.LimitRangeSpec{
+				Limits: []v1.LimitRangeItem{
+					{
+						Type:    v1.LimitTypeContainer,
+						Default: v1.ResourceList{v1.ResourceCPU: resource.MustParse("200m")},
+						Max:     v1.ResourceList{v1.ResourceMemory: resource.MustParse("2Gi")},
+					},
+				},
+			},
+		},
+	}
+
+	q := render.NewNamespaceQuota("ns1", qq, ll)
+	assert.Equal(t, 3, len(q.Usages))
+	assert.Equal(t, v1.ResourcePods, q.Usages[0].Name)
+	assert.Equal(t, 100, q.Usages[0].Perc())
+	assert.Equal(t, v1.ResourceRequestsCPU, q.Usages[1].Name)
+	assert.Equal(t, 25, q.Usages[1].Perc())
+	assert.Equal(t, 0, q.Usages[2].Perc())
+	assert.True(t, q.Exhausted())
+
+	r := q.Report()
+	assert.Contains(t, r, "Some quotas are exhausted!")
+	assert.Contains(t, r, "requests.cpu")
+	assert.Contains(t, r, "lr1")
+	assert.Contains(t, r, "2Gi")
+}
+
+func TestNamespaceQuotaReportEmpty(t *testing.T) {
+	r := render.NewNamespaceQuota("ns1", nil, nil).Report()
+
+	assert.Contains(t, r, "No resource quotas defined.")
+	assert.Contains(t, r, "No limit ranges defined.")
+}
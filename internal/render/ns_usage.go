@@ -0,0 +1,173 @@
+package render
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	mv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+)
+
+// NamespaceUsage renders namespaces aggregated resources usage to screen.
+type NamespaceUsage struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (NamespaceUsage) ColorerFunc() ColorerFunc {
+	return func(ns string, h Header, re RowEvent) tcell.Color {
+		if idx := h.IndexOf("FAILING", true); idx >= 0 && idx < len(re.Row.Fields) && re.Row.Fields[idx] != "0" {
+			return ErrColor
+		}
+
+		return StdColor
+	}
+}
+
+// Header returns a header row.
+func (NamespaceUsage) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "PODS", Align: tview.AlignRight, Numeric: true},
+		HeaderColumn{Name: "CPU", Align: tview.AlignRight, Numeric: true, MX: true},
+		HeaderColumn{Name: "MEM", Align: tview.AlignRight, Numeric: true, MX: true},
+		HeaderColumn{Name: "CPU/R", Align: tview.AlignRight, Numeric: true},
+		HeaderColumn{Name: "CPU/L", Align: tview.AlignRight, Numeric: true},
+		HeaderColumn{Name: "MEM/R", Align: tview.AlignRight, Numeric: true},
+		HeaderColumn{Name: "MEM/L", Align: tview.AlignRight, Numeric: true},
+		HeaderColumn{Name: "%CPU/R", Align: tview.AlignRight, Numeric: true, MX: true},
+		HeaderColumn{Name: "%MEM/R", Align: tview.AlignRight, Numeric: true, MX: true},
+		HeaderColumn{Name: "FAILING", Align: tview.AlignRight, Numeric: true},
+		HeaderColumn{Name: "COST/H", Align: tview.AlignRight, Wide: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (NamespaceUsage) Render(o interface{}, ns string, r *Row) error {
+	u, ok := o.(NamespaceUsageRes)
+	if !ok {
+		return fmt.Errorf("expected NamespaceUsageRes, but got %T", o)
+	}
+
+	r.ID = u.Namespace
+	r.Fields = Fields{
+		u.Namespace,
+		strconv.Itoa(u.Pods),
+		toMc(u.CPU),
+		toMi(u.MEM),
+		toMc(u.CPUReq),
+		toMc(u.CPULim),
+		toMi(u.MEMReq),
+		toMi(u.MEMLim),
+		client.ToPercentageStr(u.CPU, u.CPUReq),
+		client.ToPercentageStr(u.MEM, u.MEMReq),
+		strconv.Itoa(u.Failing),
+		namespaceCost(u.Cost),
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// NamespaceUsageRes represents a namespace aggregated resources usage.
+type NamespaceUsageRes struct {
+	Namespace string
+	// Pods the namespace pods count.
+	Pods int
+	// CPU, MEM the namespace pods live usage in millicores and bytes.
+	CPU, MEM int64
+	// CPUReq, CPULim the namespace active pods cpu requests and limits in millicores.
+	CPUReq, CPULim int64
+	// MEMReq, MEMLim the namespace active pods memory requests and limits in bytes.
+	MEMReq, MEMLim int64
+	// Failing the number of workloads with failing pods.
+	Failing int
+	// Cost the namespace active pods estimated hourly cost if known.
+	Cost *float64
+}
+
+// GetObjectKind returns a schema object.
+func (NamespaceUsageRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (n NamespaceUsageRes) DeepCopyObject() runtime.Object {
+	return n
+}
+
+// NamespaceUsages aggregates pods resources usage per namespace. Listed
+// namespaces without pods are reported as well.
+func NamespaceUsages(nss []string, pods []*v1.Pod, pmx client.PodsMetricsMap, rates *Rates) []NamespaceUsageRes {
+	uu := make(map[string]*NamespaceUsageRes, len(nss))
+	for _, ns := range nss {
+		uu[ns] = &NamespaceUsageRes{Namespace: ns}
+	}
+	failing := make(map[string]struct{})
+	for _, po := range pods {
+		u, ok := uu[po.Namespace]
+		if !ok {
+			u = &NamespaceUsageRes{Namespace: po.Namespace}
+			uu[po.Namespace] = u
+		}
+		u.Pods++
+		if PodFailing(po) {
+			if _, ok := failing[workloadID(po)]; !ok {
+				failing[workloadID(po)] = struct{}{}
+				u.Failing++
+			}
+		}
+		if po.Status.Phase == v1.PodSucceeded || po.Status.Phase == v1.PodFailed {
+			continue
+		}
+		u.addPod(po, pmx[client.MetaFQN(po.ObjectMeta)], rates)
+	}
+
+	res := make([]NamespaceUsageRes, 0, len(uu))
+	for _, u := range uu {
+		res = append(res, *u)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Namespace < res[j].Namespace
+	})
+
+	return res
+}
+
+// PodFailing checks if a pod failed or has containers failing to run.
+func PodFailing(po *v1.Pod) bool {
+	return po.Status.Phase == v1.PodFailed || len(podFindings(po)) > 0
+}
+
+func (u *NamespaceUsageRes) addPod(po *v1.Pod, mx *mv1beta1.PodMetrics, rates *Rates) {
+	var p Pod
+	c, r := p.gatherPodMX(po, mx)
+	u.CPU, u.MEM = u.CPU+c.cpu, u.MEM+c.mem
+	u.CPUReq, u.CPULim = u.CPUReq+r.cpu, u.CPULim+r.lcpu
+	u.MEMReq, u.MEMLim = u.MEMReq+r.mem, u.MEMLim+r.lmem
+	if cost, ok := rates.PodCost(po.Spec); ok {
+		if u.Cost == nil {
+			u.Cost = new(float64)
+		}
+		*u.Cost += cost
+	}
+}
+
+// workloadID returns a pod controller id or the pod id for bare pods.
+func workloadID(po *v1.Pod) string {
+	for _, ref := range po.OwnerReferences {
+		if ref.Controller != nil && *ref.Controller {
+			return string(ref.UID)
+		}
+	}
+
+	return string(po.UID) + "|" + client.MetaFQN(po.ObjectMeta)
+}
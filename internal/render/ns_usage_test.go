@@ -0,0 +1,102 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	mv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+)
+
+func TestNamespaceUsages(t *testing.T) {
+	ctrl := true
+	pod := func(ns, n, owner string, phase v1.PodPhase, waiting string) *v1.Pod {
+		po := v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Namespace: ns, Name: n, UID: types.UID(n)},
+			Spec: v1.PodSpec{
+				Containers: []v1.Container{{
+					Name: "c1",
+					Resources: v1.ResourceRequirements{
+						Requests: v1.ResourceList{
+							v1.ResourceCPU:    resource.MustParse("100m"),
+							v1.ResourceMemory: resource.MustParse("64Mi"),
+						},
+						Limits: v1.ResourceList{
+							v1.ResourceCPU:    resource.MustParse("200m"),
+							v1.ResourceMemory: resource.MustParse("128Mi"),
+						},
+					},
+				}},
+			},
+			Status: v1.PodStatus{Phase: phase},
+		}
+		if owner != "" {
+			po.OwnerReferences = []metav1.OwnerReference{{UID: types.UID(owner), Controller: &ctrl}}
+		}
+		if waiting != "" {
+			po.Status.ContainerStatuses = []v1.ContainerStatus{{
+				Name:  "c1",
+				State: v1.ContainerState{Waiting: &v1.ContainerStateWaiting{Reason: waiting}},
+			}}
+		}
+		return &po
+	}
+	pods := []*v1.Pod{
+		pod("ns1", "p1", "rs1", v1.PodRunning, ""),
+		pod("ns1", "p2", "rs1", v1.PodRunning, "CrashLoopBackOff"),
+		pod("ns1", "p3", "rs1", v1.PodRunning, "CrashLoopBackOff"),
+		pod("ns1", "p4", "", v1.PodFailed, ""),
+		pod("ns2", "p5", "rs2", v1.PodRunning, ""),
+		pod("ns2", "p6", "job1", v1.PodSucceeded, ""),
+	}
+	pmx := client.PodsMetricsMap{
+		"ns1/p1": {Containers: []mv1beta1.ContainerMetrics{{Usage: makeRes("50m", "32Mi")}}},
+		"ns2/p5": {Containers: []mv1beta1.ContainerMetrics{{Usage: makeRes("150m", "96Mi")}}},
+	}
+
+	uu := render.NamespaceUsages([]string{"ns1", "ns2", "ns3"}, pods, pmx, &render.Rates{CPU: 1})
+	assert.Equal(t, 3, len(uu))
+
+	assert.Equal(t, "ns1", uu[0].Namespace)
+	assert.Equal(t, 4, uu[0].Pods)
+	assert.Equal(t, 2, uu[0].Failing)
+	assert.Equal(t, int64(50), uu[0].CPU)
+	assert.Equal(t, int64(300), uu[0].CPUReq)
+	assert.Equal(t, int64(600), uu[0].CPULim)
+	assert.Equal(t, int64(3*64<<20), uu[0].MEMReq)
+	assert.InDelta(t, 0.3, *uu[0].Cost, 1e-9)
+
+	assert.Equal(t, "ns2", uu[1].Namespace)
+	assert.Equal(t, 2, uu[1].Pods)
+	assert.Equal(t, 0, uu[1].Failing)
+	assert.Equal(t, int64(100), uu[1].CPUReq)
+
+	assert.Equal(t, render.NamespaceUsageRes{Namespace: "ns3"}, uu[2])
+}
+
+func TestNamespaceUsageRender(t *testing.T) {
+	cost := 0.5
+	u := render.NamespaceUsageRes{
+		Namespace: "ns1",
+		Pods:      3,
+		CPU:       50,
+		MEM:       32 << 20,
+		CPUReq:    200,
+		CPULim:    400,
+		MEMReq:    64 << 20,
+		MEMLim:    128 << 20,
+		Failing:   1,
+		Cost:      &cost,
+	}
+
+	var n render.NamespaceUsage
+	r := render.NewRow(12)
+	assert.Nil(t, n.Render(u, "", &r))
+	assert.Equal(t, "ns1", r.ID)
+	assert.Equal(t, render.Fields{"ns1", "3", "50", "32", "200", "400", "64", "128", "25", "50", "1", "0.5000"}, r.Fields)
+}
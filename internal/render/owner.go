@@ -0,0 +1,95 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/tcell/v2"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// OwnerRelation indicates a resource owning the subject.
+	OwnerRelation = "owner"
+	// SelfRelation indicates the subject itself.
+	SelfRelation = "self"
+	// ChildRelation indicates a resource owned by the subject.
+	ChildRelation = "child"
+)
+
+// Owner renders a resource ownership trail to screen.
+type Owner struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (Owner) ColorerFunc() ColorerFunc {
+	return func(ns string, h Header, re RowEvent) tcell.Color {
+		idx := h.IndexOf("RELATION", true)
+		if idx < 0 {
+			return tcell.ColorCadetBlue
+		}
+		switch re.Row.Fields[idx] {
+		case SelfRelation:
+			return tcell.ColorOrange
+		case ChildRelation:
+			return tcell.ColorMediumSpringGreen
+		default:
+			return tcell.ColorCadetBlue
+		}
+	}
+}
+
+// Header returns a header row.
+func (Owner) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "DEPTH"},
+		HeaderColumn{Name: "RELATION"},
+		HeaderColumn{Name: "NAMESPACE"},
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "GVR"},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (Owner) Render(o interface{}, ns string, r *Row) error {
+	res, ok := o.(OwnerRes)
+	if !ok {
+		return fmt.Errorf("expected OwnerRes, but got %T", o)
+	}
+
+	r.ID = client.FQN(res.Namespace, res.Name)
+	r.Fields = append(r.Fields,
+		strconv.Itoa(res.Depth),
+		res.Relation,
+		res.Namespace,
+		res.Name,
+		res.GVR,
+	)
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// OwnerRes represents an ownership trail entry.
+type OwnerRes struct {
+	Relation  string
+	Depth     int
+	Namespace string
+	Name      string
+	GVR       string
+}
+
+// GetObjectKind returns a schema object.
+func (OwnerRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (o OwnerRes) DeepCopyObject() runtime.Object {
+	return o
+}
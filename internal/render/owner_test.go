@@ -0,0 +1,32 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOwnerRender(t *testing.T) {
+	o := render.OwnerRes{
+		Relation:  render.ChildRelation,
+		Depth:     2,
+		Namespace: "ns1",
+		Name:      "blee-abc",
+		GVR:       "v1/pods",
+	}
+
+	var (
+		ow = render.Owner{}
+		r  render.Row
+	)
+	assert.Nil(t, ow.Render(o, "fred", &r))
+	assert.Equal(t, "ns1/blee-abc", r.ID)
+	assert.Equal(t, render.Fields{
+		"2",
+		"child",
+		"ns1",
+		"blee-abc",
+		"v1/pods",
+	}, r.Fields)
+}
@@ -34,6 +34,11 @@ func (PodDisruptionBudget) Header(ns string) Header {
 	}
 }
 
+// Stable checks if rows only change when the resources change.
+func (PodDisruptionBudget) Stable() bool {
+	return true
+}
+
 // Render renders a K8s resource to screen.
 func (p PodDisruptionBudget) Render(o interface{}, ns string, r *Row) error {
 	raw, ok := o.(*unstructured.Unstructured)
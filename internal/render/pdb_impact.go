@@ -0,0 +1,85 @@
+package render
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PDBPod represents a pod matched by a disruption budget.
+type PDBPod struct {
+	Name     string
+	Node     string
+	Phase    string
+	Ready    bool
+	Cordoned bool
+}
+
+// PDBImpact represents a disruption budget matched pods and the node drains
+// it holds up.
+type PDBImpact struct {
+	Path           string
+	MinAvailable   string
+	MaxUnavailable string
+	Allowed        int32
+	Healthy        int32
+	Desired        int32
+	Expected       int32
+	Pods           []PDBPod
+}
+
+// Draining returns the matched pods awaiting eviction from cordoned nodes.
+func (i PDBImpact) Draining() []PDBPod {
+	pp := make([]PDBPod, 0, len(i.Pods))
+	for _, p := range i.Pods {
+		if p.Cordoned {
+			pp = append(pp, p)
+		}
+	}
+
+	return pp
+}
+
+// Blocking checks if the budget holds up pending drains.
+func (i PDBImpact) Blocking() bool {
+	return len(i.Draining()) > int(i.Allowed)
+}
+
+// Report returns a textual disruption budget impact report.
+func (i PDBImpact) Report() string {
+	var b strings.Builder
+	b.WriteString("[orange::b]Budget[-::-]\n\n")
+	fmt.Fprintf(&b, "[aqua::b]%-22s[-::-] %s\n", "Min Available:", i.MinAvailable)
+	fmt.Fprintf(&b, "[aqua::b]%-22s[-::-] %s\n", "Max Unavailable:", i.MaxUnavailable)
+	fmt.Fprintf(&b, "[aqua::b]%-22s[-::-] %d/%d (expected %d)\n", "Healthy:", i.Healthy, i.Desired, i.Expected)
+	allowed := fmt.Sprintf("%d", i.Allowed)
+	if i.Allowed == 0 {
+		allowed = "[orangered::b]0[-::-]"
+	}
+	fmt.Fprintf(&b, "[aqua::b]%-22s[-::-] %s\n", "Allowed Disruptions:", allowed)
+
+	b.WriteString("\n[orange::b]Pending Drains[-::-]\n\n")
+	dd := i.Draining()
+	switch {
+	case len(dd) == 0:
+		b.WriteString("No matched pods on cordoned nodes.\n")
+	case i.Blocking():
+		fmt.Fprintf(&b, "[orangered::b]Blocking %d of %d eviction(s) on cordoned nodes![-::-]\n", len(dd)-int(i.Allowed), len(dd))
+	default:
+		fmt.Fprintf(&b, "Allowing %d eviction(s) on cordoned nodes.\n", len(dd))
+	}
+	for _, p := range dd {
+		fmt.Fprintf(&b, "  %-50s %s\n", p.Name, p.Node)
+	}
+
+	b.WriteString("\n[orange::b]Matched Pods[-::-]\n\n")
+	if len(i.Pods) == 0 {
+		b.WriteString("No pods matched.\n")
+		return b.String()
+	}
+	fmt.Fprintf(&b, "[aqua::b]%-50s %-30s %-12s %-6s %s[-::-]\n", "POD", "NODE", "PHASE", "READY", "CORDONED")
+	for _, p := range i.Pods {
+		fmt.Fprintf(&b, "%-50s %-30s %-12s %-6t %t\n", p.Name, na(p.Node), p.Phase, p.Ready, p.Cordoned)
+	}
+
+	return b.String()
+}
@@ -0,0 +1,55 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPDBImpactBlocking(t *testing.T) {
+	uu := map[string]struct {
+		allowed  int32
+		pods     []render.PDBPod
+		draining int
+		blocking bool
+		e        string
+	}{
+		"none": {
+			e: "No pods matched.",
+		},
+		"noDrain": {
+			pods: []render.PDBPod{{Name: "p1", Node: "n1"}},
+			e:    "No matched pods on cordoned nodes.",
+		},
+		"blocked": {
+			pods:     []render.PDBPod{{Name: "p1", Node: "n1", Cordoned: true}, {Name: "p2", Node: "n2"}},
+			draining: 1,
+			blocking: true,
+			e:        "Blocking 1 of 1 eviction(s) on cordoned nodes!",
+		},
+		"partial": {
+			allowed:  1,
+			pods:     []render.PDBPod{{Name: "p1", Node: "n1", Cordoned: true}, {Name: "p2", Node: "n1", Cordoned: true}},
+			draining: 2,
+			blocking: true,
+			e:        "Blocking 1 of 2 eviction(s) on cordoned nodes!",
+		},
+		"allowed": {
+			allowed:  1,
+			pods:     []render.PDBPod{{Name: "p1", Node: "n1", Cordoned: true}, {Name: "p2", Node: "n2"}},
+			draining: 1,
+			e:        "Allowing 1 eviction(s) on cordoned nodes.",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			i := render.PDBImpact{Path: "default/pdb", Allowed: u.allowed, Pods: u.pods}
+			assert.Equal(t, u.draining, len(i.Draining()))
+			assert.Equal(t, u.blocking, i.Blocking())
+			assert.Contains(t, i.Report(), u.e)
+		})
+	}
+}
@@ -73,13 +73,19 @@ func (Pod) Header(ns string) Header {
 		HeaderColumn{Name: "%CPU/L", Align: tview.AlignRight, MX: true},
 		HeaderColumn{Name: "%MEM/R", Align: tview.AlignRight, MX: true},
 		HeaderColumn{Name: "%MEM/L", Align: tview.AlignRight, MX: true},
+		HeaderColumn{Name: "CPU/TREND", MX: true},
+		HeaderColumn{Name: "MEM/TREND", MX: true},
 		HeaderColumn{Name: "IP"},
 		HeaderColumn{Name: "NODE"},
 		HeaderColumn{Name: "QOS", Wide: true},
+		HeaderColumn{Name: "GPU", Align: tview.AlignRight, Wide: true},
+		HeaderColumn{Name: "EXTENDED", Wide: true},
+		HeaderColumn{Name: "COST/H", Align: tview.AlignRight, Wide: true},
 		HeaderColumn{Name: "LABELS", Wide: true},
 		HeaderColumn{Name: "VALID", Wide: true},
 		HeaderColumn{Name: "NOMINATED NODE", Wide: true},
 		HeaderColumn{Name: "READINESS GATES", Wide: true},
+		HeaderColumn{Name: "VULNS", Align: tview.AlignRight},
 		HeaderColumn{Name: "AGE", Time: true},
 	}
 }
@@ -101,13 +107,14 @@ func (p Pod) Render(o interface{}, ns string, row *Row) error {
 
 	c, r := p.gatherPodMX(&po, pwm.MX)
 	phase := p.Phase(&po)
+	ext := ExtendedRequests(po.Spec)
 	row.ID = client.MetaFQN(po.ObjectMeta)
 	row.Fields = Fields{
 		po.Namespace,
 		po.ObjectMeta.Name,
 		"●",
 		strconv.Itoa(cr) + "/" + strconv.Itoa(len(ss)),
-		strconv.Itoa(rc),
+		strconv.Itoa(rc) + restartTrend(pwm.RestartTrend),
 		phase,
 		toMc(c.cpu),
 		toMi(c.mem),
@@ -117,13 +124,19 @@ func (p Pod) Render(o interface{}, ns string, row *Row) error {
 		client.ToPercentageStr(c.cpu, r.lcpu),
 		client.ToPercentageStr(c.mem, r.mem),
 		client.ToPercentageStr(c.mem, r.lmem),
+		Sparkline(cpuSamples(pwm.History)),
+		Sparkline(memSamples(pwm.History)),
 		na(po.Status.PodIP),
 		na(po.Spec.NodeName),
 		p.mapQOS(po.Status.QOSClass),
+		podGPUs(ext),
+		extendedSummary(ext, nil),
+		pwm.Rates.podCost(po.Spec),
 		mapToStr(po.Labels),
 		asStatus(p.diagnose(phase, cr, len(ss))),
 		asNominated(po.Status.NominatedNodeName),
 		asReadinessGate(po),
+		toVulns(pwm.Scan),
 		toAge(po.GetCreationTimestamp()),
 	}
 
@@ -144,6 +157,15 @@ func (p Pod) diagnose(phase string, cr, ct int) error {
 // ----------------------------------------------------------------------------
 // Helpers...
 
+func podGPUs(reqs v1.ResourceList) string {
+	n, ok := gpuCount(reqs)
+	if !ok {
+		return NAValue
+	}
+
+	return strconv.FormatInt(n, 10)
+}
+
 func asNominated(n string) string {
 	if n == "" {
 		return MissingValue
@@ -174,8 +196,23 @@ func asReadinessGate(pod v1.Pod) string {
 
 // PodWithMetrics represents a pod and its metrics.
 type PodWithMetrics struct {
-	Raw *unstructured.Unstructured
-	MX  *mv1beta1.PodMetrics
+	Raw     *unstructured.Unstructured
+	MX      *mv1beta1.PodMetrics
+	History []client.MetricsSample
+	// RestartTrend the pod restarts count over the recent past.
+	RestartTrend int
+	// Rates the resources prices if cost estimation is enabled.
+	Rates *Rates
+	// Scan the pod images critical vulnerabilities if scanned.
+	Scan VulnScan
+}
+
+// VulnScan represents an images vulnerability scan summary.
+type VulnScan struct {
+	// Scanned indicates the images were scanned.
+	Scanned bool
+	// Critical the number of critical vulnerabilities.
+	Critical int
 }
 
 // GetObjectKind returns a schema object.
@@ -0,0 +1,236 @@
+package render
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/tview"
+	v1 "k8s.io/api/core/v1"
+)
+
+const maxDiagnosisEvents = 10
+
+// podReasonCauses tracks the probable causes of common pod and container
+// status reasons.
+var podReasonCauses = map[string][]string{
+	"ImagePullBackOff": {
+		"The image name or tag is misspelled or does not exist",
+		"The registry requires credentials and no valid imagePullSecrets are set",
+		"The registry is unreachable from the node or is rate limiting pulls",
+	},
+	"ErrImagePull": {
+		"The image name or tag is misspelled or does not exist",
+		"The registry requires credentials and no valid imagePullSecrets are set",
+		"The registry is unreachable from the node or is rate limiting pulls",
+	},
+	"InvalidImageName": {
+		"The image reference is malformed",
+	},
+	"CrashLoopBackOff": {
+		"The application exits on startup, check its previous logs (Shift-K)",
+		"A liveness probe keeps failing and the kubelet restarts the container",
+		"A required configuration, env var or mounted file is missing or invalid",
+	},
+	"CreateContainerConfigError": {
+		"A referenced ConfigMap or Secret does not exist in the pod namespace",
+		"A referenced ConfigMap or Secret key does not exist",
+	},
+	"CreateContainerError": {
+		"The container runtime could not create the container, ie invalid command or mount",
+		"A container with the same name is still being removed on the node",
+	},
+	"RunContainerError": {
+		"The container entrypoint is missing or not executable",
+		"A volume could not be mounted into the container",
+	},
+	"ContainerCannotRun": {
+		"The container entrypoint is missing or not executable",
+	},
+	"OOMKilled": {
+		"The container memory limit is too low for its workload",
+		"The application leaks memory",
+	},
+	"Error": {
+		"The application exited with a non zero code, check its logs",
+	},
+	"Unschedulable": {
+		"No node has enough allocatable resources for the pod requests",
+		"The pod node selector, affinity or tolerations rule out all nodes, check Explain Scheduling (Shift-E)",
+		"A persistent volume claim is unbound or bound to a volume in another zone",
+	},
+	"Evicted": {
+		"The node ran out of memory, disk or pids and reclaimed resources",
+		"The pod exceeded its ephemeral storage limit",
+	},
+	"NodeLost": {
+		"The node hosting the pod is unreachable",
+	},
+	"ContainerCreating": {
+		"A volume is still being attached or mounted, check the pod events",
+		"The node network plugin failed to set up the pod sandbox",
+	},
+}
+
+// PodFinding represents a pod or container status issue.
+type PodFinding struct {
+	Container string
+	Reason    string
+	Message   string
+	ExitCode  *int32
+}
+
+// Causes returns a finding probable causes.
+func (f PodFinding) Causes() []string {
+	return podReasonCauses[f.Reason]
+}
+
+// PodDiagnosis represents a pod status diagnosis.
+type PodDiagnosis struct {
+	Pod      string
+	Status   string
+	Findings []PodFinding
+	Events   []v1.Event
+}
+
+// NewPodDiagnosis returns a pod status diagnosis given its events.
+func NewPodDiagnosis(path string, po *v1.Pod, ee []v1.Event) PodDiagnosis {
+	var p Pod
+	d := PodDiagnosis{
+		Pod:      path,
+		Status:   p.Phase(po),
+		Findings: podFindings(po),
+	}
+	for _, e := range ee {
+		if e.Type == v1.EventTypeWarning {
+			d.Events = append(d.Events, e)
+		}
+	}
+	sort.Slice(d.Events, func(i, j int) bool {
+		return eventTime(d.Events[i]).After(eventTime(d.Events[j]).Time)
+	})
+	if len(d.Events) > maxDiagnosisEvents {
+		d.Events = d.Events[:maxDiagnosisEvents]
+	}
+
+	return d
+}
+
+// Report returns a textual pod status diagnosis.
+func (d PodDiagnosis) Report() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "[aqua::b]%-8s[-::-] %s\n", "Pod:", d.Pod)
+	fmt.Fprintf(&b, "[aqua::b]%-8s[-::-] %s\n", "Status:", d.Status)
+
+	b.WriteString("\n[orange::b]Findings[-::-]\n\n")
+	if len(d.Findings) == 0 {
+		b.WriteString("[green::b]No issues detected.[-::-]\n")
+	}
+	for i, f := range d.Findings {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		subject := "pod"
+		if f.Container != "" {
+			subject = "container " + f.Container
+		}
+		fmt.Fprintf(&b, "[orangered::b]%s[-::-] (%s)\n", f.Reason, subject)
+		if f.ExitCode != nil {
+			code := strconv.Itoa(int(*f.ExitCode))
+			if h := ExitCodeHint(*f.ExitCode); h != "" {
+				code += " (" + h + ")"
+			}
+			fmt.Fprintf(&b, "  [aqua::]Exit Code:[-::] %s\n", code)
+		}
+		if f.Message != "" {
+			fmt.Fprintf(&b, "  [aqua::]Message:[-::] %s\n", tview.Escape(f.Message))
+		}
+		cc := f.Causes()
+		if len(cc) == 0 {
+			continue
+		}
+		b.WriteString("  [aqua::]Probable causes:[-::]\n")
+		for _, c := range cc {
+			fmt.Fprintf(&b, "    • %s\n", c)
+		}
+	}
+
+	b.WriteString("\n[orange::b]Warning Events[-::-]\n\n")
+	if len(d.Events) == 0 {
+		b.WriteString("No warning events found.\n")
+		return b.String()
+	}
+	fmt.Fprintf(&b, "[aqua::b]%-10s %-6s %-26s %s[-::-]\n", "AGE", "COUNT", "REASON", "MESSAGE")
+	for _, e := range d.Events {
+		fmt.Fprintf(&b, "%-10s %-6d %-26s %s\n", toAge(eventTime(e)), eventCount(e), e.Reason, tview.Escape(e.Message))
+	}
+
+	return b.String()
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func podFindings(po *v1.Pod) []PodFinding {
+	var ff []PodFinding
+	if po.Status.Reason != "" {
+		ff = append(ff, PodFinding{Reason: po.Status.Reason, Message: po.Status.Message})
+	}
+	for _, c := range po.Status.Conditions {
+		if c.Type == v1.PodScheduled && c.Status == v1.ConditionFalse {
+			ff = append(ff, PodFinding{Reason: check(c.Reason, "Unschedulable"), Message: c.Message})
+		}
+	}
+	ss := make([]v1.ContainerStatus, 0, len(po.Status.InitContainerStatuses)+len(po.Status.ContainerStatuses))
+	ss = append(ss, po.Status.InitContainerStatuses...)
+	ss = append(ss, po.Status.ContainerStatuses...)
+	for _, s := range ss {
+		if f, ok := containerFinding(s); ok {
+			ff = append(ff, f)
+		}
+	}
+
+	return ff
+}
+
+func containerFinding(s v1.ContainerStatus) (PodFinding, bool) {
+	f := PodFinding{Container: s.Name}
+	switch {
+	case s.State.Waiting != nil:
+		w := s.State.Waiting
+		if w.Reason == "" || w.Reason == "PodInitializing" || (w.Reason == "ContainerCreating" && w.Message == "") {
+			return f, false
+		}
+		f.Reason, f.Message = w.Reason, w.Message
+		// A crashing container last termination tells why it went down.
+		if t := s.LastTerminationState.Terminated; t != nil && w.Reason == "CrashLoopBackOff" {
+			f.ExitCode = &t.ExitCode
+			if t.Reason == "OOMKilled" {
+				f.Reason = t.Reason
+			}
+			f.Message = check(t.Message, f.Message)
+		}
+	case s.State.Terminated != nil:
+		t := s.State.Terminated
+		if t.ExitCode == 0 {
+			return f, false
+		}
+		f.Reason, f.Message, f.ExitCode = check(t.Reason, "Error"), t.Message, &t.ExitCode
+	default:
+		return f, false
+	}
+
+	return f, true
+}
+
+func eventCount(e v1.Event) int32 {
+	if e.Series != nil {
+		return e.Series.Count
+	}
+	if e.Count == 0 {
+		return 1
+	}
+
+	return e.Count
+}
@@ -0,0 +1,136 @@
+package render_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestPodDiagnosisFindings(t *testing.T) {
+	uu := map[string]struct {
+		status v1.PodStatus
+		e      []render.PodFinding
+	}{
+		"healthy": {
+			status: v1.PodStatus{
+				Phase: v1.PodRunning,
+				ContainerStatuses: []v1.ContainerStatus{
+					{Name: "c1", Ready: true, State: v1.ContainerState{Running: &v1.ContainerStateRunning{}}},
+				},
+			},
+		},
+		"image-pull": {
+			status: v1.PodStatus{
+				Phase: v1.PodPending,
+				ContainerStatuses: []v1.ContainerStatus{
+					{Name: "c1", State: v1.ContainerState{Waiting: &v1.ContainerStateWaiting{Reason: "ImagePullBackOff", Message: "Back-off pulling image"}}},
+				},
+			},
+			e: []render.PodFinding{
+				{Container: "c1", Reason: "ImagePullBackOff", Message: "Back-off pulling image"},
+			},
+		},
+		"oom-crash": {
+			status: v1.PodStatus{
+				Phase: v1.PodRunning,
+				ContainerStatuses: []v1.ContainerStatus{
+					{
+						Name:                 "c1",
+						State:                v1.ContainerState{Waiting: &v1.ContainerStateWaiting{Reason: "CrashLoopBackOff", Message: "back-off 5m0s"}},
+						LastTerminationState: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{Reason: "OOMKilled", ExitCode: 137}},
+					},
+				},
+			},
+			e: []render.PodFinding{
+				{Container: "c1", Reason: "OOMKilled", Message: "back-off 5m0s", ExitCode: int32Ptr(137)},
+			},
+		},
+		"creating": {
+			status: v1.PodStatus{
+				Phase: v1.PodPending,
+				ContainerStatuses: []v1.ContainerStatus{
+					{Name: "c1", State: v1.ContainerState{Waiting: &v1.ContainerStateWaiting{Reason: "ContainerCreating"}}},
+				},
+			},
+		},
+		"unschedulable": {
+			status: v1.PodStatus{
+				Phase: v1.PodPending,
+				Conditions: []v1.PodCondition{
+					{Type: v1.PodScheduled, Status: v1.ConditionFalse, Reason: "Unschedulable", Message: "0/3 nodes are available"},
+				},
+			},
+			e: []render.PodFinding{
+				{Reason: "Unschedulable", Message: "0/3 nodes are available"},
+			},
+		},
+		"evicted": {
+			status: v1.PodStatus{
+				Phase:   v1.PodFailed,
+				Reason:  "Evicted",
+				Message: "The node was low on resource: memory.",
+				InitContainerStatuses: []v1.ContainerStatus{
+					{Name: "i1", State: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{ExitCode: 0}}},
+				},
+				ContainerStatuses: []v1.ContainerStatus{
+					{Name: "c1", State: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{ExitCode: 1}}},
+				},
+			},
+			e: []render.PodFinding{
+				{Reason: "Evicted", Message: "The node was low on resource: memory."},
+				{Container: "c1", Reason: "Error", ExitCode: int32Ptr(1)},
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			po := v1.Pod{Status: u.status}
+			d := render.NewPodDiagnosis("default/p1", &po, nil)
+			assert.Equal(t, u.e, d.Findings)
+		})
+	}
+}
+
+func TestPodDiagnosisReport(t *testing.T) {
+	now := time.Now()
+	po := v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "p1", Namespace: "default"},
+		Spec:       v1.PodSpec{Containers: []v1.Container{{Name: "c1"}}},
+		Status: v1.PodStatus{
+			Phase: v1.PodPending,
+			ContainerStatuses: []v1.ContainerStatus{
+				{Name: "c1", State: v1.ContainerState{Waiting: &v1.ContainerStateWaiting{Reason: "CreateContainerConfigError", Message: `secret "db" not found`}}},
+			},
+		},
+	}
+	ee := []v1.Event{
+		{Type: v1.EventTypeNormal, Reason: "Pulled", LastTimestamp: metav1.NewTime(now)},
+		{Type: v1.EventTypeWarning, Reason: "Failed", Message: "Error: old", Count: 2, LastTimestamp: metav1.NewTime(now.Add(-time.Hour))},
+		{Type: v1.EventTypeWarning, Reason: "Failed", Message: `Error: secret "db" not found`, Count: 5, LastTimestamp: metav1.NewTime(now.Add(-time.Minute))},
+	}
+
+	d := render.NewPodDiagnosis("default/p1", &po, ee)
+	assert.Equal(t, "CreateContainerConfigError", d.Status)
+	assert.Equal(t, 2, len(d.Events))
+	assert.Equal(t, `Error: secret "db" not found`, d.Events[0].Message)
+
+	r := d.Report()
+	assert.Contains(t, r, "[orangered::b]CreateContainerConfigError[-::-] (container c1)")
+	assert.Contains(t, r, "A referenced ConfigMap or Secret does not exist in the pod namespace")
+	assert.Contains(t, r, "Failed")
+	assert.NotContains(t, r, "Pulled")
+
+	healthy := render.NewPodDiagnosis("default/p1", &v1.Pod{}, nil)
+	assert.Contains(t, healthy.Report(), "No issues detected.")
+	assert.Contains(t, healthy.Report(), "No warning events found.")
+}
+
+func int32Ptr(i int32) *int32 {
+	return &i
+}
@@ -0,0 +1,94 @@
+package render
+
+import (
+	"fmt"
+
+	"github.com/derailed/tcell/v2"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	passMark = "ok"
+	fitYes   = "yes"
+	fitNo    = "no"
+)
+
+// PodSchedule renders a pod per node scheduling verdicts to screen.
+type PodSchedule struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (PodSchedule) ColorerFunc() ColorerFunc {
+	return func(ns string, h Header, re RowEvent) tcell.Color {
+		idx := h.IndexOf("FITS", true)
+		if idx < 0 {
+			return DefaultColorer(ns, h, re)
+		}
+		if re.Row.Fields[idx] == fitNo {
+			return ErrColor
+		}
+
+		return CompletedColor
+	}
+}
+
+// Header returns a header row.
+func (PodSchedule) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "NODE"},
+		HeaderColumn{Name: "FITS"},
+		HeaderColumn{Name: "SCHEDULABLE"},
+		HeaderColumn{Name: "SELECTOR"},
+		HeaderColumn{Name: "AFFINITY"},
+		HeaderColumn{Name: "TAINTS"},
+		HeaderColumn{Name: "RESOURCES"},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (PodSchedule) Render(o interface{}, ns string, r *Row) error {
+	res, ok := o.(NodeFitRes)
+	if !ok {
+		return fmt.Errorf("expected NodeFitRes, but got %T", o)
+	}
+
+	fits := fitNo
+	if res.Fits {
+		fits = fitYes
+	}
+	r.ID = res.Node
+	r.Fields = append(r.Fields,
+		res.Node,
+		fits,
+		check(res.Schedulable, passMark),
+		check(res.Selector, passMark),
+		check(res.Affinity, passMark),
+		check(res.Taints, passMark),
+		check(res.Resources, passMark),
+	)
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// NodeFitRes represents a pod scheduling verdict on a given node.
+// Blank predicates pass, otherwise they hold the failure reason.
+type NodeFitRes struct {
+	Node                                               string
+	Fits                                               bool
+	Schedulable, Selector, Affinity, Taints, Resources string
+}
+
+// GetObjectKind returns a schema object.
+func (NodeFitRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (n NodeFitRes) DeepCopyObject() runtime.Object {
+	return n
+}
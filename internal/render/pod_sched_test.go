@@ -0,0 +1,31 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPodScheduleRender(t *testing.T) {
+	o := render.NodeFitRes{
+		Node:   "n1",
+		Taints: "untolerated dedicated=gpu:NoSchedule",
+	}
+
+	var (
+		p render.PodSchedule
+		r render.Row
+	)
+	assert.Nil(t, p.Render(o, "", &r))
+	assert.Equal(t, "n1", r.ID)
+	assert.Equal(t, render.Fields{
+		"n1",
+		"no",
+		"ok",
+		"ok",
+		"ok",
+		"untolerated dedicated=gpu:NoSchedule",
+		"ok",
+	}, r.Fields)
+}
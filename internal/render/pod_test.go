@@ -3,6 +3,7 @@ package render_test
 import (
 	"testing"
 
+	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/tcell/v2"
 	"github.com/stretchr/testify/assert"
@@ -159,8 +160,63 @@ func TestPodRender(t *testing.T) {
 	assert.Nil(t, err)
 
 	assert.Equal(t, "default/nginx", r.ID)
-	e := render.Fields{"default", "nginx", "●", "1/1", "0", "Running", "100", "50", "100:0", "70:170", "100", "n/a", "71", "29", "172.17.0.6", "minikube", "BE"}
-	assert.Equal(t, e, r.Fields[:17])
+	e := render.Fields{"default", "nginx", "●", "1/1", "0", "Running", "100", "50", "100:0", "70:170", "100", "n/a", "71", "29", "", "", "172.17.0.6", "minikube", "BE"}
+	assert.Equal(t, e, r.Fields[:19])
+}
+
+func TestPodRenderRestartTrend(t *testing.T) {
+	pom := render.PodWithMetrics{
+		Raw:          load(t, "po"),
+		MX:           makePodMX("nginx", "100m", "50Mi"),
+		RestartTrend: 2,
+	}
+
+	var po render.Pod
+	r := render.NewRow(14)
+	assert.Nil(t, po.Render(&pom, "", &r))
+	assert.Equal(t, "0 ↑2", r.Fields[4])
+}
+
+func TestPodRenderTrends(t *testing.T) {
+	pom := render.PodWithMetrics{
+		Raw:     load(t, "po"),
+		MX:      makePodMX("nginx", "100m", "50Mi"),
+		History: []client.MetricsSample{{CPU: 0, MEM: 10}, {CPU: 100, MEM: 10}},
+	}
+
+	var po render.Pod
+	r := render.NewRow(14)
+	assert.Nil(t, po.Render(&pom, "", &r))
+	assert.Equal(t, render.Fields{"100", "n/a", "71", "29", "▁█", "██"}, r.Fields[10:16])
+}
+
+func TestPodRenderVulns(t *testing.T) {
+	uu := map[string]struct {
+		scan render.VulnScan
+		e    string
+	}{
+		"unscanned": {},
+		"clean": {
+			scan: render.VulnScan{Scanned: true},
+			e:    "0",
+		},
+		"critical": {
+			scan: render.VulnScan{Scanned: true, Critical: 3},
+			e:    "3",
+		},
+	}
+
+	var po render.Pod
+	idx := po.Header("").IndexOf("VULNS", true)
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			pom := render.PodWithMetrics{Raw: load(t, "po"), Scan: u.scan}
+			r := render.NewRow(14)
+			assert.Nil(t, po.Render(&pom, "", &r))
+			assert.Equal(t, u.e, r.Fields[idx])
+		})
+	}
 }
 
 func BenchmarkPodRender(b *testing.B) {
@@ -190,8 +246,8 @@ func TestPodInitRender(t *testing.T) {
 	assert.Nil(t, err)
 
 	assert.Equal(t, "default/nginx", r.ID)
-	e := render.Fields{"default", "nginx", "●", "1/1", "0", "Init:0/1", "10", "10", "100:0", "70:170", "10", "n/a", "14", "5", "172.17.0.6", "minikube", "BE"}
-	assert.Equal(t, e, r.Fields[:17])
+	e := render.Fields{"default", "nginx", "●", "1/1", "0", "Init:0/1", "10", "10", "100:0", "70:170", "10", "n/a", "14", "5", "", "", "172.17.0.6", "minikube", "BE"}
+	assert.Equal(t, e, r.Fields[:19])
 }
 
 // ----------------------------------------------------------------------------
@@ -0,0 +1,102 @@
+package render
+
+import (
+	"strconv"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+const (
+	instanceTypeLabel     = "node.kubernetes.io/instance-type"
+	betaInstanceTypeLabel = "beta.kubernetes.io/instance-type"
+
+	gib = 1 << 30
+)
+
+// Rates represents resources estimated hourly prices.
+type Rates struct {
+	// Currency the prices currency.
+	Currency string `json:"currency"`
+
+	// InstanceTypes the nodes prices keyed by instance type.
+	InstanceTypes map[string]float64 `json:"instanceTypes"`
+
+	// CPU the price of a cpu core.
+	CPU float64 `json:"cpu"`
+
+	// Memory the price of a GiB of memory.
+	Memory float64 `json:"memory"`
+
+	// GPU the price of a gpu.
+	GPU float64 `json:"gpu"`
+}
+
+// NodeCost returns a node estimated hourly cost based on its instance type.
+// Nodes with an unknown instance type are priced by allocatable resources.
+func (r *Rates) NodeCost(no *v1.Node) (float64, bool) {
+	if r == nil {
+		return 0, false
+	}
+	if p, ok := r.InstanceTypes[InstanceType(no)]; ok {
+		return p, true
+	}
+
+	return r.resourcesCost(no.Status.Allocatable)
+}
+
+// PodCost returns a pod estimated hourly cost based on its requests.
+func (r *Rates) PodCost(spec v1.PodSpec) (float64, bool) {
+	if r == nil {
+		return 0, false
+	}
+	reqs := EffectiveRequests(spec)
+	for n, q := range ExtendedRequests(spec) {
+		reqs[n] = q
+	}
+
+	return r.resourcesCost(reqs)
+}
+
+// InstanceType returns a node instance type.
+func InstanceType(no *v1.Node) string {
+	if t, ok := no.Labels[instanceTypeLabel]; ok {
+		return t
+	}
+
+	return no.Labels[betaInstanceTypeLabel]
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func (r *Rates) resourcesCost(rl v1.ResourceList) (float64, bool) {
+	if r.CPU == 0 && r.Memory == 0 && r.GPU == 0 {
+		return 0, false
+	}
+	cpu, mem := rl[v1.ResourceCPU], rl[v1.ResourceMemory]
+	gpus, _ := gpuCount(rl)
+
+	return float64(cpu.MilliValue())/1000*r.CPU + float64(mem.Value())/gib*r.Memory + float64(gpus)*r.GPU, true
+}
+
+func (r *Rates) nodeCost(no *v1.Node) string {
+	c, ok := r.NodeCost(no)
+	if !ok {
+		return NAValue
+	}
+
+	return toCost(c)
+}
+
+func (r *Rates) podCost(spec v1.PodSpec) string {
+	c, ok := r.PodCost(spec)
+	if !ok {
+		return NAValue
+	}
+
+	return toCost(c)
+}
+
+func toCost(c float64) string {
+	return strconv.FormatFloat(c, 'f', 4, 64)
+}
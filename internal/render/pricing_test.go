@@ -0,0 +1,167 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestRatesNodeCost(t *testing.T) {
+	node := func(labels map[string]string) *v1.Node {
+		return &v1.Node{
+			ObjectMeta: metav1.ObjectMeta{Labels: labels},
+			Status: v1.NodeStatus{
+				Allocatable: v1.ResourceList{
+					v1.ResourceCPU:    resource.MustParse("4"),
+					v1.ResourceMemory: resource.MustParse("16Gi"),
+					"nvidia.com/gpu":  resource.MustParse("1"),
+				},
+			},
+		}
+	}
+
+	uu := map[string]struct {
+		r    *render.Rates
+		no   *v1.Node
+		cost float64
+		ok   bool
+	}{
+		"disabled": {
+			no: node(nil),
+		},
+		"instanceType": {
+			r:    &render.Rates{InstanceTypes: map[string]float64{"m5.xlarge": 0.192}},
+			no:   node(map[string]string{"node.kubernetes.io/instance-type": "m5.xlarge"}),
+			cost: 0.192,
+			ok:   true,
+		},
+		"betaInstanceType": {
+			r:    &render.Rates{InstanceTypes: map[string]float64{"m5.xlarge": 0.192}},
+			no:   node(map[string]string{"beta.kubernetes.io/instance-type": "m5.xlarge"}),
+			cost: 0.192,
+			ok:   true,
+		},
+		"unknownType": {
+			r:  &render.Rates{InstanceTypes: map[string]float64{"m5.xlarge": 0.192}},
+			no: node(map[string]string{"node.kubernetes.io/instance-type": "m5.large"}),
+		},
+		"resources": {
+			r:    &render.Rates{CPU: 0.03, Memory: 0.004, GPU: 2},
+			no:   node(nil),
+			cost: 4*0.03 + 16*0.004 + 2,
+			ok:   true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			c, ok := u.r.NodeCost(u.no)
+			assert.Equal(t, u.ok, ok)
+			assert.InDelta(t, u.cost, c, 1e-9)
+		})
+	}
+}
+
+func TestRatesPodCost(t *testing.T) {
+	spec := v1.PodSpec{
+		Containers: []v1.Container{
+			{Resources: v1.ResourceRequirements{
+				Requests: v1.ResourceList{
+					v1.ResourceCPU:    resource.MustParse("500m"),
+					v1.ResourceMemory: resource.MustParse("2Gi"),
+				},
+			}},
+			{Resources: v1.ResourceRequirements{
+				Limits: v1.ResourceList{"nvidia.com/gpu": resource.MustParse("2")},
+			}},
+		},
+	}
+
+	uu := map[string]struct {
+		r    *render.Rates
+		cost float64
+		ok   bool
+	}{
+		"disabled": {},
+		"instanceTypesOnly": {
+			r: &render.Rates{InstanceTypes: map[string]float64{"m5.xlarge": 0.192}},
+		},
+		"resources": {
+			r:    &render.Rates{CPU: 0.04, Memory: 0.005, GPU: 1.5},
+			cost: 0.5*0.04 + 2*0.005 + 2*1.5,
+			ok:   true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			c, ok := u.r.PodCost(spec)
+			assert.Equal(t, u.ok, ok)
+			assert.InDelta(t, u.cost, c, 1e-9)
+		})
+	}
+}
+
+func TestNamespaceRenderCost(t *testing.T) {
+	cost := 1.23456
+
+	uu := map[string]struct {
+		o interface{}
+		e string
+	}{
+		"raw": {
+			o: load(t, "ns"),
+			e: "n/a",
+		},
+		"noCost": {
+			o: &render.NamespaceWithCost{Raw: load(t, "ns")},
+			e: "n/a",
+		},
+		"cost": {
+			o: &render.NamespaceWithCost{Raw: load(t, "ns"), Cost: &cost},
+			e: "1.2346",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var n render.Namespace
+			r := render.NewRow(6)
+			assert.Nil(t, n.Render(u.o, "", &r))
+			assert.Equal(t, "kube-system", r.Fields[0])
+			assert.Equal(t, u.e, r.Fields[2])
+		})
+	}
+}
+
+func TestPodRenderCost(t *testing.T) {
+	uu := map[string]struct {
+		r *render.Rates
+		e string
+	}{
+		"disabled": {
+			e: "n/a",
+		},
+		"priced": {
+			r: &render.Rates{CPU: 0.04, Memory: 0.005},
+			e: "0.0043",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var po render.Pod
+			r := render.NewRow(22)
+			assert.Nil(t, po.Render(&render.PodWithMetrics{Raw: load(t, "po"), Rates: u.r}, "", &r))
+			assert.Equal(t, u.e, r.Fields[21])
+		})
+	}
+}
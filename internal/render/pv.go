@@ -63,6 +63,11 @@ func (PersistentVolume) Header(string) Header {
 	}
 }
 
+// Stable checks if rows only change when the resources change.
+func (PersistentVolume) Stable() bool {
+	return true
+}
+
 // Render renders a K8s resource to screen.
 func (p PersistentVolume) Render(o interface{}, ns string, r *Row) error {
 	raw, ok := o.(*unstructured.Unstructured)
@@ -30,6 +30,11 @@ func (PersistentVolumeClaim) Header(ns string) Header {
 	}
 }
 
+// Stable checks if rows only change when the resources change.
+func (PersistentVolumeClaim) Stable() bool {
+	return true
+}
+
 // Render renders a K8s resource to screen.
 func (p PersistentVolumeClaim) Render(o interface{}, ns string, r *Row) error {
 	raw, ok := o.(*unstructured.Unstructured)
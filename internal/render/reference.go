@@ -27,6 +27,7 @@ func (Reference) Header(ns string) Header {
 		HeaderColumn{Name: "NAMESPACE"},
 		HeaderColumn{Name: "NAME"},
 		HeaderColumn{Name: "GVR"},
+		HeaderColumn{Name: "USAGE"},
 	}
 }
 
@@ -43,6 +44,7 @@ func (Reference) Render(o interface{}, ns string, r *Row) error {
 		ref.Namespace,
 		ref.Name,
 		ref.GVR,
+		ref.Usage,
 	)
 
 	return nil
@@ -56,6 +58,7 @@ type ReferenceRes struct {
 	Namespace string
 	Name      string
 	GVR       string
+	Usage     string
 }
 
 // GetObjectKind returns a schema object.
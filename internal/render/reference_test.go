@@ -12,6 +12,7 @@ func TestReferenceRender(t *testing.T) {
 		Namespace: "ns1",
 		Name:      "blee",
 		GVR:       "v1/secrets",
+		Usage:     "envFrom,volume",
 	}
 
 	var (
@@ -24,5 +25,6 @@ func TestReferenceRender(t *testing.T) {
 		"ns1",
 		"blee",
 		"v1/secrets",
+		"envFrom,volume",
 	}, r.Fields)
 }
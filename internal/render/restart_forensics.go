@@ -0,0 +1,114 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/derailed/tview"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/duration"
+)
+
+// exitCodeHints tracks common container exit codes meanings.
+var exitCodeHints = map[int32]string{
+	1:   "application error",
+	126: "command cannot be invoked",
+	127: "command not found",
+	134: "SIGABRT, process aborted",
+	137: "SIGKILL, killed (OOM or liveness probe failure)",
+	139: "SIGSEGV, segmentation fault",
+	143: "SIGTERM, gracefully terminated",
+}
+
+// ContainerRestart represents a restarting container forensics.
+type ContainerRestart struct {
+	Pod       string
+	Container string
+	Restarts  int32
+	State     v1.ContainerState
+	LastState *v1.ContainerStateTerminated
+	Logs      []string
+	LogsErr   error
+}
+
+// ExitCodeHint returns a human readable container exit code meaning.
+func ExitCodeHint(code int32) string {
+	if h, ok := exitCodeHints[code]; ok {
+		return h
+	}
+	if code > 128 && code < 160 {
+		return fmt.Sprintf("terminated by signal %d", code-128)
+	}
+
+	return ""
+}
+
+// RestartForensicsReport returns a textual report of restarting containers
+// last termination and previous logs.
+func RestartForensicsReport(cc []ContainerRestart, now time.Time) string {
+	if len(cc) == 0 {
+		return "[green::b]No restarting containers.[-::-]\n"
+	}
+
+	var b strings.Builder
+	for i, c := range cc {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		fmt.Fprintf(&b, "[orange::b]%s/%s[-::-]\n\n", c.Pod, c.Container)
+		fmt.Fprintf(&b, "[aqua::b]%-14s[-::-] %d\n", "Restarts:", c.Restarts)
+		fmt.Fprintf(&b, "[aqua::b]%-14s[-::-] %s\n", "State:", containerState(c.State))
+		if t := c.LastState; t != nil {
+			reason := na(t.Reason)
+			if t.Reason == "OOMKilled" {
+				reason = "[orangered::b]" + reason + "[-::-]"
+			}
+			fmt.Fprintf(&b, "[aqua::b]%-14s[-::-] %s\n", "Last Reason:", reason)
+			code := strconv.Itoa(int(t.ExitCode))
+			if h := ExitCodeHint(t.ExitCode); h != "" {
+				code += " (" + h + ")"
+			}
+			fmt.Fprintf(&b, "[aqua::b]%-14s[-::-] %s\n", "Exit Code:", code)
+			if !t.FinishedAt.IsZero() {
+				fmt.Fprintf(&b, "[aqua::b]%-14s[-::-] %s ago\n", "Finished:", duration.HumanDuration(now.Sub(t.FinishedAt.Time)))
+			}
+			if t.Message != "" {
+				fmt.Fprintf(&b, "[aqua::b]%-14s[-::-] %s\n", "Message:", tview.Escape(t.Message))
+			}
+		} else {
+			fmt.Fprintf(&b, "[aqua::b]%-14s[-::-] %s\n", "Last State:", NAValue)
+		}
+
+		b.WriteString("\n[aqua::b]Previous Logs[-::-]\n")
+		switch {
+		case c.LogsErr != nil:
+			fmt.Fprintf(&b, "[orangered::]Previous logs unavailable: %s[-::]\n", tview.Escape(c.LogsErr.Error()))
+		case len(c.Logs) == 0:
+			b.WriteString("No previous logs.\n")
+		default:
+			for _, l := range c.Logs {
+				b.WriteString(tview.Escape(l) + "\n")
+			}
+		}
+	}
+
+	return b.String()
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func containerState(s v1.ContainerState) string {
+	switch {
+	case s.Running != nil:
+		return "Running"
+	case s.Waiting != nil:
+		return strings.TrimSpace("Waiting " + s.Waiting.Reason)
+	case s.Terminated != nil:
+		return strings.TrimSpace("Terminated " + s.Terminated.Reason)
+	default:
+		return NAValue
+	}
+}
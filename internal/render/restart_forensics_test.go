@@ -0,0 +1,66 @@
+package render_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestExitCodeHint(t *testing.T) {
+	uu := map[string]struct {
+		code int32
+		e    string
+	}{
+		"oom":     {code: 137, e: "SIGKILL, killed (OOM or liveness probe failure)"},
+		"sigterm": {code: 143, e: "SIGTERM, gracefully terminated"},
+		"signal":  {code: 130, e: "terminated by signal 2"},
+		"unknown": {code: 42},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, render.ExitCodeHint(u.code))
+		})
+	}
+}
+
+func TestRestartForensicsReport(t *testing.T) {
+	now := time.Now()
+	cc := []render.ContainerRestart{
+		{
+			Pod:       "default/p1",
+			Container: "c1",
+			Restarts:  3,
+			State:     v1.ContainerState{Waiting: &v1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}},
+			LastState: &v1.ContainerStateTerminated{
+				Reason:     "OOMKilled",
+				ExitCode:   137,
+				FinishedAt: metav1.NewTime(now.Add(-2 * time.Minute)),
+			},
+			Logs: []string{"starting [app]", "boom"},
+		},
+		{
+			Pod:       "default/p1",
+			Container: "c2",
+			Restarts:  1,
+			State:     v1.ContainerState{Running: &v1.ContainerStateRunning{}},
+			LogsErr:   errors.New("no previous container"),
+		},
+	}
+
+	r := render.RestartForensicsReport(cc, now)
+	assert.Contains(t, r, "default/p1/c1")
+	assert.Contains(t, r, "Waiting CrashLoopBackOff")
+	assert.Contains(t, r, "[orangered::b]OOMKilled[-::-]")
+	assert.Contains(t, r, "137 (SIGKILL, killed (OOM or liveness probe failure))")
+	assert.Contains(t, r, "2m ago")
+	assert.Contains(t, r, "starting [app[]")
+	assert.Contains(t, r, "Previous logs unavailable: no previous container")
+	assert.Equal(t, "[green::b]No restarting containers.[-::-]\n", render.RestartForensicsReport(nil, now))
+}
@@ -29,6 +29,16 @@ func (Role) Header(ns string) Header {
 	)
 }
 
+// MetadataOnly checks if only the resources metadata are rendered.
+func (Role) MetadataOnly() bool {
+	return true
+}
+
+// Stable checks if rows only change when the resources change.
+func (Role) Stable() bool {
+	return true
+}
+
 // Render renders a K8s resource to screen.
 func (r Role) Render(o interface{}, ns string, row *Row) error {
 	raw, ok := o.(*unstructured.Unstructured)
@@ -33,6 +33,11 @@ func (RoleBinding) Header(ns string) Header {
 	)
 }
 
+// Stable checks if rows only change when the resources change.
+func (RoleBinding) Stable() bool {
+	return true
+}
+
 // Render renders a K8s resource to screen.
 func (r RoleBinding) Render(o interface{}, ns string, row *Row) error {
 	raw, ok := o.(*unstructured.Unstructured)
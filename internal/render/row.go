@@ -3,6 +3,7 @@ package render
 import (
 	"reflect"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/fvbommel/sortorder"
@@ -193,7 +194,13 @@ func Less(isNumber, isDuration bool, id1, id2, v1, v2 string) bool {
 	switch {
 	case isNumber:
 		v1, v2 = strings.Replace(v1, ",", "", -1), strings.Replace(v2, ",", "", -1)
-		less = sortorder.NaturalLess(v1, v2)
+		f1, err1 := strconv.ParseFloat(v1, 64)
+		f2, err2 := strconv.ParseFloat(v2, 64)
+		if err1 == nil && err2 == nil {
+			less = f1 < f2
+		} else {
+			less = sortorder.NaturalLess(v1, v2)
+		}
 	case isDuration:
 		d1, d2 := durationToSeconds(v1), durationToSeconds(v2)
 		less = d1 <= d2
@@ -440,6 +440,21 @@ func TestLess(t *testing.T) {
 			v1:         "2y263d",
 			v2:         "19h",
 		},
+		"decimals": {
+			isNumber: true,
+			id1:      "id1",
+			id2:      "id2",
+			v1:       "1.5",
+			v2:       "1.25",
+		},
+		"negatives": {
+			isNumber: true,
+			id1:      "id1",
+			id2:      "id2",
+			v1:       "-10",
+			v2:       "2",
+			e:        true,
+		},
 	}
 
 	for k := range uu {
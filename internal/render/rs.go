@@ -35,6 +35,11 @@ func (ReplicaSet) Header(ns string) Header {
 	}
 }
 
+// Stable checks if rows only change when the resources change.
+func (ReplicaSet) Stable() bool {
+	return true
+}
+
 // Render renders a K8s resource to screen.
 func (r ReplicaSet) Render(o interface{}, ns string, row *Row) error {
 	raw, ok := o.(*unstructured.Unstructured)
@@ -27,6 +27,11 @@ func (ServiceAccount) Header(ns string) Header {
 	}
 }
 
+// Stable checks if rows only change when the resources change.
+func (ServiceAccount) Stable() bool {
+	return true
+}
+
 // Render renders a K8s resource to screen.
 func (s ServiceAccount) Render(o interface{}, ns string, r *Row) error {
 	raw, ok := o.(*unstructured.Unstructured)
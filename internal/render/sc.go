@@ -25,6 +25,11 @@ func (StorageClass) Header(ns string) Header {
 	}
 }
 
+// Stable checks if rows only change when the resources change.
+func (StorageClass) Stable() bool {
+	return true
+}
+
 // Render renders a K8s resource to screen.
 func (StorageClass) Render(o interface{}, ns string, r *Row) error {
 	raw, ok := o.(*unstructured.Unstructured)
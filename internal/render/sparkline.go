@@ -0,0 +1,62 @@
+package render
+
+import (
+	"strconv"
+
+	"github.com/derailed/k9s/internal/client"
+)
+
+const minSparkSamples = 2
+
+var sparkTicks = []rune("▁▂▃▄▅▆▇█")
+
+// Sparkline returns a mini graph of a series of values scaled to its max.
+func Sparkline(vv []int64) string {
+	if len(vv) < minSparkSamples {
+		return ""
+	}
+
+	var max int64
+	for _, v := range vv {
+		if v > max {
+			max = v
+		}
+	}
+	ss := make([]rune, 0, len(vv))
+	for _, v := range vv {
+		idx := 0
+		if max > 0 && v > 0 {
+			idx = int(v * int64(len(sparkTicks)-1) / max)
+		}
+		ss = append(ss, sparkTicks[idx])
+	}
+
+	return string(ss)
+}
+
+// restartTrend flags restarts that occurred over the recent past.
+func restartTrend(n int) string {
+	if n <= 0 {
+		return ""
+	}
+
+	return " ↑" + strconv.Itoa(n)
+}
+
+func cpuSamples(ss []client.MetricsSample) []int64 {
+	vv := make([]int64, 0, len(ss))
+	for _, s := range ss {
+		vv = append(vv, s.CPU)
+	}
+
+	return vv
+}
+
+func memSamples(ss []client.MetricsSample) []int64 {
+	vv := make([]int64, 0, len(ss))
+	for _, s := range ss {
+		vv = append(vv, s.MEM)
+	}
+
+	return vv
+}
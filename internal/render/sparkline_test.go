@@ -0,0 +1,35 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSparkline(t *testing.T) {
+	uu := map[string]struct {
+		vv []int64
+		e  string
+	}{
+		"none": {},
+		"single": {
+			vv: []int64{10},
+		},
+		"zeros": {
+			vv: []int64{0, 0, 0},
+			e:  "▁▁▁",
+		},
+		"trend": {
+			vv: []int64{0, 35, 70},
+			e:  "▁▄█",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, render.Sparkline(u.vv))
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Stats renders api calls telemetry to screen.
+type Stats struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (Stats) ColorerFunc() ColorerFunc {
+	return func(ns string, h Header, re RowEvent) tcell.Color {
+		if idx := h.IndexOf("ERRORS", true); idx >= 0 && idx < len(re.Row.Fields) && re.Row.Fields[idx] != "0" {
+			return ErrColor
+		}
+		if idx := h.IndexOf("THROTTLED", true); idx >= 0 && idx < len(re.Row.Fields) && re.Row.Fields[idx] != "0" {
+			return HighlightColor
+		}
+
+		return StdColor
+	}
+}
+
+// Header returns a header row.
+func (Stats) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "VERB"},
+		HeaderColumn{Name: "GVR"},
+		HeaderColumn{Name: "CALLS", Align: tview.AlignRight, Numeric: true},
+		HeaderColumn{Name: "ERRORS", Align: tview.AlignRight, Numeric: true},
+		HeaderColumn{Name: "RETRIES", Align: tview.AlignRight, Numeric: true},
+		HeaderColumn{Name: "THROTTLED", Align: tview.AlignRight, Numeric: true},
+		HeaderColumn{Name: "WAIT(ms)", Align: tview.AlignRight, Numeric: true},
+		HeaderColumn{Name: "LATENCY(ms)", Align: tview.AlignRight, Numeric: true},
+		HeaderColumn{Name: "MAX(ms)", Align: tview.AlignRight, Numeric: true},
+		HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (Stats) Render(o interface{}, ns string, r *Row) error {
+	s, ok := o.(StatsRes)
+	if !ok {
+		return fmt.Errorf("expected StatsRes, but got %T", o)
+	}
+
+	r.ID = s.ID()
+	r.Fields = append(r.Fields,
+		s.Verb,
+		s.GVR,
+		strconv.Itoa(s.Count),
+		strconv.Itoa(s.Errors),
+		strconv.Itoa(s.Retries),
+		strconv.Itoa(s.Throttled),
+		toLatency(s.AvgWait()),
+		toLatency(s.AvgLatency()),
+		toLatency(s.MaxLatency),
+		toAge(metav1.NewTime(s.Last)),
+	)
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// toLatency renders a latency in milliseconds.
+func toLatency(d time.Duration) string {
+	return strconv.FormatInt(d.Milliseconds(), 10)
+}
+
+// StatsRes represents api calls telemetry for a verb and resource.
+type StatsRes struct {
+	client.APIStat
+}
+
+// ID returns the stat unique id.
+func (s StatsRes) ID() string {
+	return s.Verb + "|" + s.GVR
+}
+
+// GetObjectKind returns a schema object.
+func (StatsRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (s StatsRes) DeepCopyObject() runtime.Object {
+	return s
+}
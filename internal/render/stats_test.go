@@ -0,0 +1,43 @@
+package render_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatsRender(t *testing.T) {
+	o := render.StatsRes{APIStat: client.APIStat{
+		Verb:       "list",
+		GVR:        "v1/pods",
+		Count:      4,
+		Errors:     1,
+		Retries:    1,
+		Throttled:  2,
+		Latency:    100 * time.Millisecond,
+		MaxLatency: 70 * time.Millisecond,
+		Wait:       300 * time.Millisecond,
+		Last:       time.Now(),
+	}}
+
+	var (
+		s render.Stats
+		r render.Row
+	)
+	assert.Nil(t, s.Render(o, "", &r))
+	assert.Equal(t, "list|v1/pods", r.ID)
+	assert.Equal(t, render.Fields{
+		"list",
+		"v1/pods",
+		"4",
+		"1",
+		"1",
+		"2",
+		"150",
+		"25",
+		"70",
+	}, r.Fields[:9])
+}
@@ -2,12 +2,15 @@ package render
 
 import (
 	"fmt"
+	"sort"
 	"strconv"
+	"strings"
 
 	"github.com/derailed/k9s/internal/client"
 	appsv1 "k8s.io/api/apps/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
 // StatefulSet renders a K8s StatefulSet to screen.
@@ -21,6 +24,7 @@ func (StatefulSet) Header(ns string) Header {
 		HeaderColumn{Name: "NAMESPACE"},
 		HeaderColumn{Name: "NAME"},
 		HeaderColumn{Name: "READY"},
+		HeaderColumn{Name: "ORDINALS"},
 		HeaderColumn{Name: "SELECTOR", Wide: true},
 		HeaderColumn{Name: "SERVICE"},
 		HeaderColumn{Name: "CONTAINERS", Wide: true},
@@ -33,8 +37,16 @@ func (StatefulSet) Header(ns string) Header {
 
 // Render renders a K8s resource to screen.
 func (s StatefulSet) Render(o interface{}, ns string, r *Row) error {
-	raw, ok := o.(*unstructured.Unstructured)
-	if !ok {
+	var (
+		raw   *unstructured.Unstructured
+		ready map[int]bool
+	)
+	switch v := o.(type) {
+	case *unstructured.Unstructured:
+		raw = v
+	case *StatefulSetWithOrdinals:
+		raw, ready = v.Raw, v.Ready
+	default:
 		return fmt.Errorf("Expected StatefulSet, but got %T", o)
 	}
 	var sts appsv1.StatefulSet
@@ -48,6 +60,7 @@ func (s StatefulSet) Render(o interface{}, ns string, r *Row) error {
 		sts.Namespace,
 		sts.Name,
 		strconv.Itoa(int(sts.Status.ReadyReplicas)) + "/" + strconv.Itoa(int(sts.Status.Replicas)),
+		ordinalsSummary(sts, ready),
 		asSelector(sts.Spec.Selector),
 		na(sts.Spec.ServiceName),
 		podContainerNames(sts.Spec.Template.Spec, true),
@@ -66,3 +79,98 @@ func (StatefulSet) diagnose(d, r int32) error {
 	}
 	return nil
 }
+
+// StatefulSetWithOrdinals represents a statefulset and its pods readiness
+// keyed by ordinal.
+type StatefulSetWithOrdinals struct {
+	Raw   *unstructured.Unstructured
+	Ready map[int]bool
+}
+
+// GetObjectKind returns a schema object.
+func (s *StatefulSetWithOrdinals) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (s *StatefulSetWithOrdinals) DeepCopyObject() runtime.Object {
+	return s
+}
+
+// StatefulSetOrdinals returns a statefulset desired ordinals.
+func StatefulSetOrdinals(sts *appsv1.StatefulSet, replicas int32) []int {
+	var start int
+	if sts.Spec.Ordinals != nil {
+		start = int(sts.Spec.Ordinals.Start)
+	}
+	ii := make([]int, 0, replicas)
+	for i := 0; i < int(replicas); i++ {
+		ii = append(ii, start+i)
+	}
+
+	return ii
+}
+
+// OrdinalRanges collapses ordinals into ranges ie 0-2,4.
+func OrdinalRanges(ii []int) string {
+	if len(ii) == 0 {
+		return ""
+	}
+	ii = append([]int(nil), ii...)
+	sort.Ints(ii)
+
+	rr := make([]string, 0, len(ii))
+	lo, hi := ii[0], ii[0]
+	flush := func() {
+		if lo == hi {
+			rr = append(rr, strconv.Itoa(lo))
+			return
+		}
+		rr = append(rr, strconv.Itoa(lo)+"-"+strconv.Itoa(hi))
+	}
+	for _, i := range ii[1:] {
+		if i == hi+1 {
+			hi = i
+			continue
+		}
+		flush()
+		lo, hi = i, i
+	}
+	flush()
+
+	return strings.Join(rr, ",")
+}
+
+// ordinalsSummary lists the ready ordinals followed by the desired ordinals
+// that are not ready.
+func ordinalsSummary(sts appsv1.StatefulSet, ready map[int]bool) string {
+	if ready == nil {
+		return NAValue
+	}
+	var replicas int32 = 1
+	if sts.Spec.Replicas != nil {
+		replicas = *sts.Spec.Replicas
+	}
+	up := make([]int, 0, len(ready))
+	for i, ok := range ready {
+		if ok {
+			up = append(up, i)
+		}
+	}
+	var down []int
+	for _, i := range StatefulSetOrdinals(&sts, replicas) {
+		if !ready[i] {
+			down = append(down, i)
+		}
+	}
+
+	s := OrdinalRanges(up)
+	if s == "" {
+		s = "none"
+	}
+	if len(down) > 0 {
+		s += " (down: " + OrdinalRanges(down) + ")"
+	}
+
+	return s
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/derailed/k9s/internal/render"
 	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
 )
 
 func TestStatefulSetRender(t *testing.T) {
@@ -13,5 +14,67 @@ func TestStatefulSetRender(t *testing.T) {
 
 	assert.Nil(t, c.Render(load(t, "sts"), "", &r))
 	assert.Equal(t, "default/nginx-sts", r.ID)
-	assert.Equal(t, render.Fields{"default", "nginx-sts", "4/4", "app=nginx-sts", "nginx-sts", "nginx", "k8s.gcr.io/nginx-slim:0.8", "app=nginx-sts", ""}, r.Fields[:len(r.Fields)-1])
+	assert.Equal(t, render.Fields{"default", "nginx-sts", "4/4", "n/a", "app=nginx-sts", "nginx-sts", "nginx", "k8s.gcr.io/nginx-slim:0.8", "app=nginx-sts", ""}, r.Fields[:len(r.Fields)-1])
+}
+
+func TestStatefulSetRenderOrdinals(t *testing.T) {
+	uu := map[string]struct {
+		ready map[int]bool
+		e     string
+	}{
+		"all": {
+			ready: map[int]bool{0: true, 1: true, 2: true, 3: true},
+			e:     "0-3",
+		},
+		"partial": {
+			ready: map[int]bool{0: true, 1: true, 2: false, 3: true},
+			e:     "0-1,3 (down: 2)",
+		},
+		"missing": {
+			ready: map[int]bool{0: true},
+			e:     "0 (down: 1-3)",
+		},
+		"none": {
+			ready: map[int]bool{},
+			e:     "none (down: 0-3)",
+		},
+	}
+
+	var c render.StatefulSet
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			r := render.NewRow(4)
+			o := render.StatefulSetWithOrdinals{Raw: load(t, "sts"), Ready: u.ready}
+			assert.Nil(t, c.Render(&o, "", &r))
+			assert.Equal(t, u.e, r.Fields[3])
+		})
+	}
+}
+
+func TestOrdinalRanges(t *testing.T) {
+	uu := map[string]struct {
+		ii []int
+		e  string
+	}{
+		"empty":  {},
+		"single": {ii: []int{2}, e: "2"},
+		"range":  {ii: []int{2, 0, 1}, e: "0-2"},
+		"gaps":   {ii: []int{0, 1, 2, 4, 6, 7}, e: "0-2,4,6-7"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, render.OrdinalRanges(u.ii))
+		})
+	}
+}
+
+func TestStatefulSetOrdinals(t *testing.T) {
+	var sts appsv1.StatefulSet
+	assert.Equal(t, []int{0, 1, 2}, render.StatefulSetOrdinals(&sts, 3))
+
+	sts.Spec.Ordinals = &appsv1.StatefulSetOrdinals{Start: 5}
+	assert.Equal(t, []int{5, 6}, render.StatefulSetOrdinals(&sts, 2))
 }
@@ -33,6 +33,11 @@ func (Service) Header(ns string) Header {
 	}
 }
 
+// Stable checks if rows only change when the resources change.
+func (Service) Stable() bool {
+	return true
+}
+
 // Render renders a K8s resource to screen.
 func (s Service) Render(o interface{}, ns string, r *Row) error {
 	raw, ok := o.(*unstructured.Unstructured)
@@ -0,0 +1,95 @@
+package render
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/derailed/tcell/v2"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// ServiceEndpoints renders a service endpoints readiness breakdown to screen.
+type ServiceEndpoints struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (ServiceEndpoints) ColorerFunc() ColorerFunc {
+	return func(ns string, h Header, re RowEvent) tcell.Color {
+		c := DefaultColorer(ns, h, re)
+		if idx := h.IndexOf("TERMINATING", true); idx >= 0 && re.Row.Fields[idx] == "true" {
+			return KillColor
+		}
+		if idx := h.IndexOf("READY", true); idx >= 0 && re.Row.Fields[idx] != "true" {
+			return ErrColor
+		}
+
+		return c
+	}
+}
+
+// Header returns a header row.
+func (ServiceEndpoints) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "SLICE"},
+		HeaderColumn{Name: "PORT"},
+		HeaderColumn{Name: "ADDRESS"},
+		HeaderColumn{Name: "POD"},
+		HeaderColumn{Name: "NODE"},
+		HeaderColumn{Name: "READY"},
+		HeaderColumn{Name: "SERVING"},
+		HeaderColumn{Name: "TERMINATING"},
+		HeaderColumn{Name: "REASON", Wide: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (ServiceEndpoints) Render(o interface{}, ns string, r *Row) error {
+	res, ok := o.(ServiceEndpointRes)
+	if !ok {
+		return fmt.Errorf("expected ServiceEndpointRes, but got %T", o)
+	}
+
+	r.ID = strings.Join([]string{res.Namespace, res.Slice, res.Port, res.Address}, "|")
+	r.Fields = append(r.Fields,
+		res.Slice,
+		res.Port,
+		res.Address,
+		res.Pod,
+		res.Node,
+		boolToStr(res.Ready),
+		boolToStr(res.Serving),
+		boolToStr(res.Terminating),
+		res.Reason,
+	)
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// ServiceEndpointRes represents a service endpoint address for a given port.
+type ServiceEndpointRes struct {
+	Namespace   string
+	Slice       string
+	Port        string
+	Address     string
+	Pod         string
+	Node        string
+	Ready       bool
+	Serving     bool
+	Terminating bool
+	Reason      string
+}
+
+// GetObjectKind returns a schema object.
+func (ServiceEndpointRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (s ServiceEndpointRes) DeepCopyObject() runtime.Object {
+	return s
+}
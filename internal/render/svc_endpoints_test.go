@@ -0,0 +1,39 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServiceEndpointsRender(t *testing.T) {
+	o := render.ServiceEndpointRes{
+		Namespace: "ns1",
+		Slice:     "svc1-abc",
+		Port:      "http:80/TCP",
+		Address:   "10.0.0.1",
+		Pod:       "ns1/p1",
+		Node:      "n1",
+		Serving:   true,
+		Reason:    "c1: readiness probe failing",
+	}
+
+	var (
+		s render.ServiceEndpoints
+		r render.Row
+	)
+	assert.Nil(t, s.Render(o, "ns1", &r))
+	assert.Equal(t, "ns1|svc1-abc|http:80/TCP|10.0.0.1", r.ID)
+	assert.Equal(t, render.Fields{
+		"svc1-abc",
+		"http:80/TCP",
+		"10.0.0.1",
+		"ns1/p1",
+		"n1",
+		"false",
+		"true",
+		"false",
+		"c1: readiness probe failing",
+	}, r.Fields)
+}
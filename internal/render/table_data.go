@@ -1,6 +1,8 @@
 package render
 
 import (
+	"fmt"
+	"hash/fnv"
 	"sync"
 
 	"github.com/derailed/k9s/internal/client"
@@ -82,6 +84,33 @@ func (t *TableData) Clone() *TableData {
 	}
 }
 
+// Hash returns a digest of the table content used to detect changes.
+func (t *TableData) Hash() uint64 {
+	t.mx.RLock()
+	defer t.mx.RUnlock()
+
+	h := fnv.New64a()
+	write := func(s string) {
+		_, _ = h.Write([]byte(s))
+		_, _ = h.Write([]byte{0})
+	}
+	write(t.Namespace)
+	for _, c := range t.Header {
+		write(fmt.Sprintf("%s:%t:%t", c.Name, c.Hide, c.Wide))
+	}
+	for _, re := range t.RowEvents {
+		write(fmt.Sprintf("%s:%d", re.Row.ID, re.Kind))
+		for _, f := range re.Row.Fields {
+			write(f)
+		}
+		for _, d := range re.Deltas {
+			write(d)
+		}
+	}
+
+	return h.Sum64()
+}
+
 // SetHeader sets table header.
 func (t *TableData) SetHeader(ns string, h Header) {
 	t.Namespace, t.Header = ns, h
@@ -94,22 +123,27 @@ func (t *TableData) Update(rows Rows) {
 	t.mx.Lock()
 	{
 		var blankDelta DeltaRow
+		index := make(map[string]int, len(t.RowEvents))
+		for i, re := range t.RowEvents {
+			index[re.Row.ID] = i
+		}
 		for _, row := range rows {
 			kk[row.ID] = struct{}{}
 			if empty {
 				t.RowEvents = append(t.RowEvents, NewRowEvent(EventAdd, row))
 				continue
 			}
-			if index, ok := t.RowEvents.FindIndex(row.ID); ok {
-				delta := NewDeltaRow(t.RowEvents[index].Row, row, t.Header)
+			if i, ok := index[row.ID]; ok {
+				delta := NewDeltaRow(t.RowEvents[i].Row, row, t.Header)
 				if delta.IsBlank() {
-					t.RowEvents[index].Kind, t.RowEvents[index].Deltas = EventUnchanged, blankDelta
-					t.RowEvents[index].Row = row
+					t.RowEvents[i].Kind, t.RowEvents[i].Deltas = EventUnchanged, blankDelta
+					t.RowEvents[i].Row = row
 				} else {
-					t.RowEvents[index] = NewRowEventWithDeltas(row, delta)
+					t.RowEvents[i] = NewRowEventWithDeltas(row, delta)
 				}
 				continue
 			}
+			index[row.ID] = len(t.RowEvents)
 			t.RowEvents = append(t.RowEvents, NewRowEvent(EventAdd, row))
 		}
 	}
@@ -124,15 +158,13 @@ func (t *TableData) Update(rows Rows) {
 func (t *TableData) Delete(newKeys map[string]struct{}) {
 	t.mx.Lock()
 	{
-		var victims []string
+		rr := t.RowEvents[:0]
 		for _, re := range t.RowEvents {
-			if _, ok := newKeys[re.Row.ID]; !ok {
-				victims = append(victims, re.Row.ID)
+			if _, ok := newKeys[re.Row.ID]; ok {
+				rr = append(rr, re)
 			}
 		}
-		for _, id := range victims {
-			t.RowEvents = t.RowEvents.Delete(id)
-		}
+		t.RowEvents = rr
 	}
 	t.mx.Unlock()
 }
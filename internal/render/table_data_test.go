@@ -391,3 +391,20 @@ func TestTableDataDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestTableDataHash(t *testing.T) {
+	newData := func(age string, kind render.ResEvent) *render.TableData {
+		return &render.TableData{
+			Namespace: "blee",
+			Header:    render.Header{{Name: "NAME"}, {Name: "AGE", Time: true}},
+			RowEvents: render.RowEvents{
+				{Kind: kind, Row: render.Row{ID: "blee/fred", Fields: render.Fields{"fred", age}}},
+			},
+		}
+	}
+
+	d := newData("1m", render.EventUnchanged)
+	assert.Equal(t, d.Hash(), newData("1m", render.EventUnchanged).Hash())
+	assert.NotEqual(t, d.Hash(), newData("2m", render.EventUnchanged).Hash())
+	assert.NotEqual(t, d.Hash(), newData("1m", render.EventUpdate).Hash())
+}
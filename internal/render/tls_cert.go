@@ -0,0 +1,159 @@
+package render
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/derailed/tcell/v2"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/duration"
+)
+
+// CertExpiringSoon flags certificates expiring within this window.
+const CertExpiringSoon = 30 * 24 * time.Hour
+
+// Certificate statuses given their expiry.
+const (
+	CertExpired  = "EXPIRED"
+	CertExpiring = "EXPIRING"
+	CertValid    = "OK"
+)
+
+// TLSCert renders tls certificates to screen.
+type TLSCert struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (TLSCert) ColorerFunc() ColorerFunc {
+	return func(ns string, h Header, re RowEvent) tcell.Color {
+		idx := h.IndexOf("STATUS", true)
+		if idx < 0 || idx >= len(re.Row.Fields) {
+			return DefaultColorer(ns, h, re)
+		}
+		switch re.Row.Fields[idx] {
+		case CertExpired:
+			return ErrColor
+		case CertExpiring:
+			return HighlightColor
+		case CertValid:
+			return StdColor
+		default:
+			return PendingColor
+		}
+	}
+}
+
+// Header returns a header row.
+func (TLSCert) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "STATUS"},
+		HeaderColumn{Name: "NAMESPACE"},
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "KIND"},
+		HeaderColumn{Name: "SUBJECT"},
+		HeaderColumn{Name: "ISSUER"},
+		HeaderColumn{Name: "EXPIRES"},
+		HeaderColumn{Name: "NOT AFTER"},
+		HeaderColumn{Name: "READY"},
+		HeaderColumn{Name: "DNS", Wide: true},
+		HeaderColumn{Name: "SECRET", Wide: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (TLSCert) Render(o interface{}, ns string, r *Row) error {
+	c, ok := o.(TLSCertRes)
+	if !ok {
+		return fmt.Errorf("expected TLSCertRes, but got %T", o)
+	}
+
+	now := time.Now()
+	r.ID = c.ID()
+	r.Fields = append(r.Fields,
+		CertStatus(c.NotAfter, now),
+		c.Namespace,
+		c.Name,
+		c.Kind,
+		na(c.Subject),
+		na(c.Issuer),
+		certCountdown(c.NotAfter, now),
+		certDate(c.NotAfter),
+		na(c.Ready),
+		na(strings.Join(c.DNSNames, ",")),
+		na(c.Secret),
+	)
+
+	return nil
+}
+
+// CertStatus returns a certificate status given its expiry.
+func CertStatus(notAfter, now time.Time) string {
+	switch {
+	case notAfter.IsZero():
+		return UnknownValue
+	case !notAfter.After(now):
+		return CertExpired
+	case notAfter.Sub(now) < CertExpiringSoon:
+		return CertExpiring
+	default:
+		return CertValid
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func certCountdown(notAfter, now time.Time) string {
+	if notAfter.IsZero() {
+		return UnknownValue
+	}
+	if !notAfter.After(now) {
+		return duration.HumanDuration(now.Sub(notAfter)) + " ago"
+	}
+
+	return duration.HumanDuration(notAfter.Sub(now))
+}
+
+func certDate(t time.Time) string {
+	if t.IsZero() {
+		return UnknownValue
+	}
+
+	return t.UTC().Format(time.RFC3339)
+}
+
+// TLSCertRes represents a tls certificate held by a secret or a cert-manager
+// certificate.
+type TLSCertRes struct {
+	// GVR the certificate holder gvr.
+	GVR       string
+	Namespace string
+	Name      string
+	Kind      string
+	Subject   string
+	Issuer    string
+	DNSNames  []string
+	NotAfter  time.Time
+	// Ready the cert-manager certificate readiness.
+	Ready string
+	// Secret the cert-manager certificate secret.
+	Secret string
+}
+
+// ID returns the certificate unique id.
+func (c TLSCertRes) ID() string {
+	return c.GVR + "|" + c.Namespace + "/" + c.Name
+}
+
+// GetObjectKind returns a schema object.
+func (TLSCertRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (c TLSCertRes) DeepCopyObject() runtime.Object {
+	return c
+}
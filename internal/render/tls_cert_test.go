@@ -0,0 +1,62 @@
+package render_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCertStatus(t *testing.T) {
+	now := time.Now()
+	uu := map[string]struct {
+		notAfter time.Time
+		e        string
+	}{
+		"unknown":  {e: "<unknown>"},
+		"expired":  {notAfter: now.Add(-time.Hour), e: render.CertExpired},
+		"expiring": {notAfter: now.Add(10 * 24 * time.Hour), e: render.CertExpiring},
+		"valid":    {notAfter: now.Add(90 * 24 * time.Hour), e: render.CertValid},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, render.CertStatus(u.notAfter, now))
+		})
+	}
+}
+
+func TestTLSCertRender(t *testing.T) {
+	c := render.TLSCertRes{
+		GVR:       "cert-manager.io/v1/certificates",
+		Namespace: "default",
+		Name:      "web",
+		Kind:      "Certificate",
+		Subject:   "web.example.com",
+		Issuer:    "ClusterIssuer/letsencrypt",
+		DNSNames:  []string{"web.example.com", "www.example.com"},
+		NotAfter:  time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC),
+		Ready:     "True",
+		Secret:    "web-tls",
+	}
+
+	var r render.Row
+	assert.Nil(t, render.TLSCert{}.Render(c, "", &r))
+	assert.Equal(t, "cert-manager.io/v1/certificates|default/web", r.ID)
+	assert.Equal(t, render.Fields{
+		render.CertExpired,
+		"default",
+		"web",
+		"Certificate",
+		"web.example.com",
+		"ClusterIssuer/letsencrypt",
+	}, r.Fields[:6])
+	assert.Equal(t, render.Fields{
+		"2020-01-02T03:04:05Z",
+		"True",
+		"web.example.com,www.example.com",
+		"web-tls",
+	}, r.Fields[7:])
+}
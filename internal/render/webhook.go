@@ -0,0 +1,312 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/tview"
+	admv1 "k8s.io/api/admissionregistration/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	mutatingWebhookKind = "MutatingWebhookConfiguration"
+	defaultWebhookPort  = 443
+)
+
+var webhookOps = []admv1.OperationType{admv1.Create, admv1.Update, admv1.Delete, admv1.Connect}
+
+// WebhookConfiguration renders a K8s validating or mutating webhook
+// configuration to screen.
+type WebhookConfiguration struct {
+	Base
+}
+
+// Header returns a header row.
+func (WebhookConfiguration) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "WEBHOOKS", Align: tview.AlignRight},
+		HeaderColumn{Name: "FAILURE POLICY"},
+		HeaderColumn{Name: "TARGETS"},
+		HeaderColumn{Name: "LABELS", Wide: true},
+		HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (WebhookConfiguration) Render(o interface{}, ns string, r *Row) error {
+	raw, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("expected WebhookConfiguration, but got %T", o)
+	}
+	ww, err := Webhooks(raw)
+	if err != nil {
+		return err
+	}
+
+	policies, targets := make([]string, 0, len(ww)), make([]string, 0, len(ww))
+	for _, w := range ww {
+		policies = appendUnique(policies, w.FailurePolicy)
+		targets = appendUnique(targets, WebhookTarget(w.ClientConfig))
+	}
+	r.ID = client.FQN(client.ClusterScope, raw.GetName())
+	r.Fields = Fields{
+		raw.GetName(),
+		strconv.Itoa(len(ww)),
+		naStrings(policies),
+		naStrings(targets),
+		mapToStr(raw.GetLabels()),
+		toAge(raw.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+// WebhookSpec represents a validating or mutating webhook.
+type WebhookSpec struct {
+	Name               string
+	FailurePolicy      string
+	MatchPolicy        string
+	SideEffects        string
+	ReinvocationPolicy string
+	TimeoutSeconds     int32
+	NamespaceSelector  string
+	ObjectSelector     string
+	Rules              []admv1.RuleWithOperations
+	ClientConfig       admv1.WebhookClientConfig
+}
+
+// Webhooks returns the webhooks of a validating or mutating configuration.
+func Webhooks(raw *unstructured.Unstructured) ([]WebhookSpec, error) {
+	if raw.GetKind() == mutatingWebhookKind {
+		var cfg admv1.MutatingWebhookConfiguration
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &cfg); err != nil {
+			return nil, err
+		}
+		ww := make([]WebhookSpec, 0, len(cfg.Webhooks))
+		for _, w := range cfg.Webhooks {
+			s := newWebhookSpec(w.Name, w.FailurePolicy, w.MatchPolicy, w.SideEffects, w.TimeoutSeconds, w.NamespaceSelector, w.ObjectSelector, w.Rules, w.ClientConfig)
+			if w.ReinvocationPolicy != nil {
+				s.ReinvocationPolicy = string(*w.ReinvocationPolicy)
+			}
+			ww = append(ww, s)
+		}
+		return ww, nil
+	}
+
+	var cfg admv1.ValidatingWebhookConfiguration
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &cfg); err != nil {
+		return nil, err
+	}
+	ww := make([]WebhookSpec, 0, len(cfg.Webhooks))
+	for _, w := range cfg.Webhooks {
+		ww = append(ww, newWebhookSpec(w.Name, w.FailurePolicy, w.MatchPolicy, w.SideEffects, w.TimeoutSeconds, w.NamespaceSelector, w.ObjectSelector, w.Rules, w.ClientConfig))
+	}
+
+	return ww, nil
+}
+
+// WebhookTarget returns a webhook backing service or url.
+func WebhookTarget(cc admv1.WebhookClientConfig) string {
+	if cc.URL != nil {
+		return *cc.URL
+	}
+	if cc.Service == nil {
+		return ""
+	}
+
+	return fmt.Sprintf("svc/%s:%d%s", client.FQN(cc.Service.Namespace, cc.Service.Name), WebhookPort(cc.Service), webhookPath(cc.Service))
+}
+
+// WebhookPort returns a webhook service port.
+func WebhookPort(svc *admv1.ServiceReference) int32 {
+	if svc.Port == nil {
+		return defaultWebhookPort
+	}
+
+	return *svc.Port
+}
+
+// WebhookReport returns a textual webhook configuration report listing each
+// webhook rules matrix, policies and target.
+func WebhookReport(ww []WebhookSpec) string {
+	var b strings.Builder
+	for i, w := range ww {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		fmt.Fprintf(&b, "[orange::b]%s[-::-]\n\n", w.Name)
+		fmt.Fprintf(&b, "[aqua::b]%-22s[-::-] %s\n", "Target:", na(WebhookTarget(w.ClientConfig)))
+		fmt.Fprintf(&b, "[aqua::b]%-22s[-::-] %s\n", "Failure Policy:", webhookPolicy(w.FailurePolicy))
+		fmt.Fprintf(&b, "[aqua::b]%-22s[-::-] %s\n", "Match Policy:", na(w.MatchPolicy))
+		fmt.Fprintf(&b, "[aqua::b]%-22s[-::-] %s\n", "Side Effects:", na(w.SideEffects))
+		if w.ReinvocationPolicy != "" {
+			fmt.Fprintf(&b, "[aqua::b]%-22s[-::-] %s\n", "Reinvocation Policy:", w.ReinvocationPolicy)
+		}
+		fmt.Fprintf(&b, "[aqua::b]%-22s[-::-] %ds\n", "Timeout:", w.TimeoutSeconds)
+		fmt.Fprintf(&b, "[aqua::b]%-22s[-::-] %s\n", "Namespace Selector:", check(w.NamespaceSelector, "all"))
+		fmt.Fprintf(&b, "[aqua::b]%-22s[-::-] %s\n", "Object Selector:", check(w.ObjectSelector, "all"))
+
+		b.WriteString("\n")
+		if len(w.Rules) == 0 {
+			b.WriteString("No rules defined.\n")
+			continue
+		}
+		fmt.Fprintf(&b, "[aqua::b]%-25s %-12s %-30s %-11s", "API GROUPS", "VERSIONS", "RESOURCES", "SCOPE")
+		for _, op := range webhookOps {
+			fmt.Fprintf(&b, " %-7s", op)
+		}
+		b.WriteString("[-::-]\n")
+		for _, r := range w.Rules {
+			scope := "*"
+			if r.Scope != nil {
+				scope = string(*r.Scope)
+			}
+			fmt.Fprintf(&b, "%-25s %-12s %-30s %-11s", ruleList(r.APIGroups), ruleList(r.APIVersions), ruleList(r.Resources), scope)
+			for _, op := range webhookOps {
+				fmt.Fprintf(&b, " %s    ", toVerbIcon(hasOperation(r.Operations, op)))
+			}
+			b.WriteString("\n")
+		}
+	}
+
+	return b.String()
+}
+
+// WebhookProbe represents a webhook health check outcome.
+type WebhookProbe struct {
+	Webhook string
+	Check   string
+	OK      bool
+	Message string
+}
+
+// WebhookProbesReport returns a textual webhook health checks report.
+func WebhookProbesReport(pp []WebhookProbe) string {
+	var (
+		b       strings.Builder
+		webhook string
+		failed  int
+	)
+	for _, p := range pp {
+		if p.Webhook != webhook {
+			if webhook != "" {
+				b.WriteString("\n")
+			}
+			webhook = p.Webhook
+			fmt.Fprintf(&b, "[orange::b]%s[-::-]\n\n", webhook)
+		}
+		if !p.OK {
+			failed++
+		}
+		fmt.Fprintf(&b, "%s [aqua::b]%-14s[-::-] %s\n", toVerbIcon(p.OK), p.Check, p.Message)
+	}
+	b.WriteString("\n")
+	if failed == 0 {
+		b.WriteString("[green::b]All checks passed.[-::-]\n")
+	} else {
+		fmt.Fprintf(&b, "[orangered::b]%d of %d check(s) failed![-::-]\n", failed, len(pp))
+	}
+
+	return b.String()
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func newWebhookSpec(
+	name string,
+	fp *admv1.FailurePolicyType,
+	mp *admv1.MatchPolicyType,
+	se *admv1.SideEffectClass,
+	timeout *int32,
+	nsSel, objSel *metav1.LabelSelector,
+	rules []admv1.RuleWithOperations,
+	cc admv1.WebhookClientConfig,
+) WebhookSpec {
+	s := WebhookSpec{
+		Name:          name,
+		FailurePolicy: string(admv1.Fail),
+		MatchPolicy:   string(admv1.Equivalent),
+		// v1 webhooks default to a 10s timeout.
+		TimeoutSeconds: 10,
+		Rules:          rules,
+		ClientConfig:   cc,
+	}
+	if fp != nil {
+		s.FailurePolicy = string(*fp)
+	}
+	if mp != nil {
+		s.MatchPolicy = string(*mp)
+	}
+	if se != nil {
+		s.SideEffects = string(*se)
+	}
+	if timeout != nil {
+		s.TimeoutSeconds = *timeout
+	}
+	if nsSel != nil {
+		s.NamespaceSelector = asSelector(nsSel)
+	}
+	if objSel != nil {
+		s.ObjectSelector = asSelector(objSel)
+	}
+
+	return s
+}
+
+func webhookPath(svc *admv1.ServiceReference) string {
+	if svc.Path == nil {
+		return ""
+	}
+
+	return *svc.Path
+}
+
+func webhookPolicy(p string) string {
+	if p == string(admv1.Fail) {
+		return "[orangered::b]" + p + "[-::-] (requests are rejected when the webhook is down)"
+	}
+
+	return p
+}
+
+func hasOperation(oo []admv1.OperationType, op admv1.OperationType) bool {
+	for _, o := range oo {
+		if o == op || o == admv1.OperationAll {
+			return true
+		}
+	}
+
+	return false
+}
+
+func ruleList(ss []string) string {
+	if len(ss) == 0 {
+		return NAValue
+	}
+	rr := make([]string, 0, len(ss))
+	for _, s := range ss {
+		rr = append(rr, check(s, "core"))
+	}
+
+	return strings.Join(rr, ",")
+}
+
+func appendUnique(ss []string, s string) []string {
+	if s == "" {
+		return ss
+	}
+	for _, v := range ss {
+		if v == s {
+			return ss
+		}
+	}
+
+	return append(ss, s)
+}
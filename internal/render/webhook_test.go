@@ -0,0 +1,113 @@
+package render_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	admv1 "k8s.io/api/admissionregistration/v1"
+)
+
+func TestWebhookConfigurationRender(t *testing.T) {
+	var r render.Row
+	assert.NoError(t, render.WebhookConfiguration{}.Render(load(t, "vwh"), "", &r))
+
+	assert.Equal(t, "-/gatekeeper", r.ID)
+	assert.Equal(t, render.Fields{
+		"gatekeeper",
+		"2",
+		"Ignore,Fail",
+		"svc/gatekeeper-system/gatekeeper-webhook:443/v1/admit,https://hooks.example.com/check",
+	}, r.Fields[:4])
+}
+
+func TestWebhooks(t *testing.T) {
+	o := load(t, "vwh")
+	ww, err := render.Webhooks(o)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(ww))
+	assert.Equal(t, "Ignore", ww[0].FailurePolicy)
+	assert.Equal(t, int32(3), ww[0].TimeoutSeconds)
+	assert.Equal(t, "admission=enabled", ww[0].NamespaceSelector)
+	assert.Equal(t, "", ww[0].ReinvocationPolicy)
+	assert.Equal(t, "Fail", ww[1].FailurePolicy)
+	assert.Equal(t, "Equivalent", ww[1].MatchPolicy)
+	assert.Equal(t, int32(10), ww[1].TimeoutSeconds)
+
+	o.SetKind("MutatingWebhookConfiguration")
+	hh := o.Object["webhooks"].([]interface{})
+	hh[0].(map[string]interface{})["reinvocationPolicy"] = "IfNeeded"
+	ww, err = render.Webhooks(o)
+	assert.NoError(t, err)
+	assert.Equal(t, "IfNeeded", ww[0].ReinvocationPolicy)
+}
+
+func TestWebhookTarget(t *testing.T) {
+	port, path, url := int32(8443), "/mutate", "https://hooks.example.com"
+	uu := map[string]struct {
+		cc admv1.WebhookClientConfig
+		e  string
+	}{
+		"none": {},
+		"url": {
+			cc: admv1.WebhookClientConfig{URL: &url},
+			e:  url,
+		},
+		"svc-default": {
+			cc: admv1.WebhookClientConfig{Service: &admv1.ServiceReference{Namespace: "ns1", Name: "hook"}},
+			e:  "svc/ns1/hook:443",
+		},
+		"svc": {
+			cc: admv1.WebhookClientConfig{Service: &admv1.ServiceReference{Namespace: "ns1", Name: "hook", Port: &port, Path: &path}},
+			e:  "svc/ns1/hook:8443/mutate",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, render.WebhookTarget(u.cc))
+		})
+	}
+}
+
+func TestWebhookReport(t *testing.T) {
+	ww, err := render.Webhooks(load(t, "vwh"))
+	assert.NoError(t, err)
+
+	s := render.WebhookReport(ww)
+	assert.Contains(t, s, "validation.gatekeeper.sh")
+	assert.Contains(t, s, "core,apps")
+	assert.Contains(t, s, "pods,deployments")
+	assert.Contains(t, s, "requests are rejected when the webhook is down")
+	// The wildcard rule allows all operations.
+	assert.Equal(t, 4+2, strings.Count(s, "✓"))
+}
+
+func TestWebhookProbesReport(t *testing.T) {
+	uu := map[string]struct {
+		pp []render.WebhookProbe
+		e  string
+	}{
+		"pass": {
+			pp: []render.WebhookProbe{{Webhook: "h1", Check: "service", OK: true}},
+			e:  "All checks passed.",
+		},
+		"fail": {
+			pp: []render.WebhookProbe{
+				{Webhook: "h1", Check: "service", OK: true},
+				{Webhook: "h1", Check: "endpoints", Message: "0 ready, 1 not ready"},
+				{Webhook: "h2", Check: "tls", OK: true},
+			},
+			e: "1 of 3 check(s) failed!",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Contains(t, render.WebhookProbesReport(u.pp), u.e)
+		})
+	}
+}
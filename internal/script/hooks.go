@@ -0,0 +1,338 @@
+package script
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	"go.starlark.net/starlark"
+)
+
+// AllScopes matches all resources.
+const AllScopes = "all"
+
+// Ext tracks script files extension.
+const Ext = ".star"
+
+// CallBudget tracks how long a script function may run before its hook gets disabled.
+var CallBudget = 500 * time.Millisecond
+
+// Action represents a script key action.
+type Action struct {
+	Scope, Key, Description string
+
+	fn *fn
+}
+
+type hook struct {
+	scope string
+	fn    *fn
+}
+
+// fn represents a script function. A function is disabled once it fails or
+// exceeds its budget so a broken script can't keep stalling the views.
+type fn struct {
+	name     string
+	callable starlark.Callable
+	disabled int32
+}
+
+func newFn(script string, c starlark.Callable) *fn {
+	return &fn{name: script + ":" + c.Name(), callable: c}
+}
+
+func (f *fn) isDisabled() bool {
+	return atomic.LoadInt32(&f.disabled) == 1
+}
+
+func (f *fn) call(header render.Header, row render.Row) (starlark.Value, error) {
+	if f.isDisabled() {
+		return nil, fmt.Errorf("script function %s is disabled", f.name)
+	}
+	v, err := callWithin(CallBudget, f.callable, header, row)
+	if err != nil && atomic.CompareAndSwapInt32(&f.disabled, 0, 1) {
+		log.Error().Err(err).Msgf("Script function %s disabled", f.name)
+	}
+
+	return v, err
+}
+
+type column struct {
+	hook
+
+	name string
+}
+
+// Hooks tracks script registered colorizers, computed columns and key actions.
+type Hooks struct {
+	colorizers []hook
+	columns    []column
+	actions    []Action
+	mx         sync.RWMutex
+}
+
+// NewHooks returns a new instance.
+func NewHooks() *Hooks {
+	return &Hooks{}
+}
+
+// LoadDir loads all scripts in a given directory. A missing directory is not an error.
+func (h *Hooks) LoadDir(dir string) error {
+	ff, err := filepath.Glob(filepath.Join(dir, "*"+Ext))
+	if err != nil {
+		return err
+	}
+	sort.Strings(ff)
+	errs := make([]string, 0, len(ff))
+	for _, f := range ff {
+		if err := h.Load(f); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "\n"))
+	}
+
+	return nil
+}
+
+// Load loads a given script file.
+func (h *Hooks) Load(path string) error {
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	return h.LoadSource(filepath.Base(path), src)
+}
+
+// LoadSource evaluates a script registering its hooks.
+func (h *Hooks) LoadSource(name string, src interface{}) error {
+	th := &starlark.Thread{
+		Name:  name,
+		Print: func(_ *starlark.Thread, msg string) { log.Debug().Msgf("[%s] %s", name, msg) },
+	}
+	globals, err := starlark.ExecFile(th, name, src, h.builtins(name))
+	if err != nil {
+		return fmt.Errorf("script %s failed: %w", name, err)
+	}
+	globals.Freeze()
+
+	return nil
+}
+
+// Empty returns true if no hooks are registered.
+func (h *Hooks) Empty() bool {
+	h.mx.RLock()
+	defer h.mx.RUnlock()
+
+	return len(h.colorizers) == 0 && len(h.columns) == 0 && len(h.actions) == 0
+}
+
+// Color returns the color name of a row if a colorizer is in scope.
+func (h *Hooks) Color(scopes []string, header render.Header, row render.Row) (string, bool) {
+	h.mx.RLock()
+	defer h.mx.RUnlock()
+
+	for _, c := range h.colorizers {
+		if !inScope(c.scope, scopes) || c.fn.isDisabled() {
+			continue
+		}
+		v, err := c.fn.call(header, row)
+		if err != nil {
+			continue
+		}
+		if s, ok := starlark.AsString(v); ok && s != "" {
+			return s, true
+		}
+	}
+
+	return "", false
+}
+
+// HasColumns checks if computed columns are in scope.
+func (h *Hooks) HasColumns(scopes []string) bool {
+	h.mx.RLock()
+	defer h.mx.RUnlock()
+
+	for _, c := range h.columns {
+		if inScope(c.scope, scopes) && !c.fn.isDisabled() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Transform adds computed columns in scope to the table data.
+// Columns are inserted before the AGE column if any.
+func (h *Hooks) Transform(scopes []string, data *render.TableData) {
+	h.mx.RLock()
+	defer h.mx.RUnlock()
+
+	for _, c := range h.columns {
+		if !inScope(c.scope, scopes) || c.fn.isDisabled() || data.Header.IndexOf(c.name, true) >= 0 {
+			continue
+		}
+		header := data.Header.Clone()
+		idx := header.IndexOf("AGE", true)
+		if idx < 0 {
+			idx = len(header)
+		}
+		for i, re := range data.RowEvents {
+			v, _ := c.fn.call(header, re.Row)
+			re.Row.Fields = insert(re.Row.Fields, idx, asString(v))
+			if len(re.Deltas) > 0 {
+				re.Deltas = insert(re.Deltas, idx, "")
+			}
+			data.RowEvents[i] = re
+		}
+		data.Header = append(header[:idx:idx], append(render.Header{{Name: c.name}}, header[idx:]...)...)
+	}
+}
+
+// Actions returns the key actions in scope.
+func (h *Hooks) Actions(scopes []string) []Action {
+	h.mx.RLock()
+	defer h.mx.RUnlock()
+
+	aa := make([]Action, 0, len(h.actions))
+	for _, a := range h.actions {
+		if inScope(a.Scope, scopes) {
+			aa = append(aa, a)
+		}
+	}
+
+	return aa
+}
+
+// Run runs an action against a given row. It returns the action result if any.
+func (a Action) Run(header render.Header, row render.Row) (string, error) {
+	v, err := a.fn.call(header, row)
+	if err != nil {
+		return "", err
+	}
+
+	return asString(v), nil
+}
+
+func (h *Hooks) builtins(script string) starlark.StringDict {
+	return starlark.StringDict{
+		"colorizer": starlark.NewBuiltin("colorizer", func(_ *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+			var (
+				scope string
+				fn    starlark.Callable
+			)
+			if err := starlark.UnpackArgs(b.Name(), args, kwargs, "scope", &scope, "fn", &fn); err != nil {
+				return nil, err
+			}
+			h.mx.Lock()
+			h.colorizers = append(h.colorizers, hook{scope: scope, fn: newFn(script, fn)})
+			h.mx.Unlock()
+			return starlark.None, nil
+		}),
+		"column": starlark.NewBuiltin("column", func(_ *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+			var (
+				scope, name string
+				fn          starlark.Callable
+			)
+			if err := starlark.UnpackArgs(b.Name(), args, kwargs, "scope", &scope, "name", &name, "fn", &fn); err != nil {
+				return nil, err
+			}
+			h.mx.Lock()
+			h.columns = append(h.columns, column{hook: hook{scope: scope, fn: newFn(script, fn)}, name: name})
+			h.mx.Unlock()
+			return starlark.None, nil
+		}),
+		"action": starlark.NewBuiltin("action", func(_ *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+			var (
+				scope, key, desc string
+				fn               starlark.Callable
+			)
+			if err := starlark.UnpackArgs(b.Name(), args, kwargs, "scope", &scope, "key", &key, "description", &desc, "fn", &fn); err != nil {
+				return nil, err
+			}
+			h.mx.Lock()
+			h.actions = append(h.actions, Action{Scope: scope, Key: key, Description: desc, fn: newFn(script, fn)})
+			h.mx.Unlock()
+			return starlark.None, nil
+		}),
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// callWithin calls a script function, giving up once the budget is exceeded.
+// The pinned starlark interpreter can't be cancelled so an overdue call is
+// left to complete in the background.
+func callWithin(budget time.Duration, fn starlark.Callable, header render.Header, row render.Row) (starlark.Value, error) {
+	d := starlark.NewDict(len(header))
+	for i, c := range header {
+		if i >= len(row.Fields) {
+			break
+		}
+		if err := d.SetKey(starlark.String(c.Name), starlark.String(row.Fields[i])); err != nil {
+			return nil, err
+		}
+	}
+
+	type result struct {
+		v   starlark.Value
+		err error
+	}
+	c := make(chan result, 1)
+	go func() {
+		v, err := starlark.Call(&starlark.Thread{Name: "k9s"}, fn, starlark.Tuple{d}, nil)
+		c <- result{v: v, err: err}
+	}()
+	select {
+	case r := <-c:
+		return r.v, r.err
+	case <-time.After(budget):
+		return nil, fmt.Errorf("call exceeded its %v budget", budget)
+	}
+}
+
+func asString(v starlark.Value) string {
+	if v == nil || v == starlark.None {
+		return ""
+	}
+	if s, ok := starlark.AsString(v); ok {
+		return s
+	}
+
+	return v.String()
+}
+
+func insert(ss []string, idx int, s string) []string {
+	if idx > len(ss) {
+		idx = len(ss)
+	}
+	res := make([]string, 0, len(ss)+1)
+	res = append(res, ss[:idx]...)
+	res = append(res, s)
+
+	return append(res, ss[idx:]...)
+}
+
+func inScope(scope string, scopes []string) bool {
+	if scope == AllScopes {
+		return true
+	}
+	for _, s := range scopes {
+		if s == scope {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,164 @@
+package script_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/script"
+	"github.com/stretchr/testify/assert"
+)
+
+const testScript = `
+def crash_color(row):
+    if row["STATUS"] == "CrashLoopBackOff":
+        return "red"
+
+colorizer("pods", crash_color)
+
+def owner(row):
+    return row["NAME"].split("-")[0]
+
+column("pods", "OWNER", owner)
+
+def describe(row):
+    return ":describe " + row["NAME"]
+
+action("all", "shift-x", "Describe", describe)
+`
+
+func TestHooksLoadSource(t *testing.T) {
+	h := script.NewHooks()
+	assert.True(t, h.Empty())
+	assert.Nil(t, h.LoadSource("test.star", testScript))
+	assert.False(t, h.Empty())
+
+	assert.NotNil(t, h.LoadSource("bad.star", "colorizer(1)"))
+}
+
+func TestHooksColor(t *testing.T) {
+	h := script.NewHooks()
+	assert.Nil(t, h.LoadSource("test.star", testScript))
+	header := render.Header{{Name: "NAME"}, {Name: "STATUS"}}
+
+	uu := map[string]struct {
+		scopes []string
+		row    render.Row
+		e      string
+		ok     bool
+	}{
+		"crash": {
+			scopes: []string{"po", "pods"},
+			row:    render.Row{Fields: render.Fields{"fred-1", "CrashLoopBackOff"}},
+			e:      "red",
+			ok:     true,
+		},
+		"running": {
+			scopes: []string{"po", "pods"},
+			row:    render.Row{Fields: render.Fields{"fred-1", "Running"}},
+		},
+		"out-of-scope": {
+			scopes: []string{"svc"},
+			row:    render.Row{Fields: render.Fields{"fred-1", "CrashLoopBackOff"}},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			c, ok := h.Color(u.scopes, header, u.row)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.e, c)
+		})
+	}
+}
+
+func TestHooksTransform(t *testing.T) {
+	h := script.NewHooks()
+	assert.Nil(t, h.LoadSource("test.star", testScript))
+
+	data := render.TableData{
+		Header: render.Header{{Name: "NAME"}, {Name: "STATUS"}, {Name: "AGE"}},
+		RowEvents: render.RowEvents{
+			{Row: render.Row{ID: "a", Fields: render.Fields{"fred-1", "Running", "1m"}}},
+			{Row: render.Row{ID: "b", Fields: render.Fields{"blee-2", "Running", "2m"}}, Deltas: render.DeltaRow{"", "", ""}},
+		},
+	}
+	assert.True(t, h.HasColumns([]string{"pods"}))
+	h.Transform([]string{"pods"}, &data)
+
+	assert.Equal(t, "OWNER", data.Header[2].Name)
+	assert.Equal(t, "AGE", data.Header[3].Name)
+	assert.Equal(t, render.Fields{"fred-1", "Running", "fred", "1m"}, data.RowEvents[0].Row.Fields)
+	assert.Equal(t, render.Fields{"blee-2", "Running", "blee", "2m"}, data.RowEvents[1].Row.Fields)
+	assert.Equal(t, 4, len(data.RowEvents[1].Deltas))
+
+	h.Transform([]string{"pods"}, &data)
+	assert.Equal(t, 4, len(data.Header))
+}
+
+func TestHooksActions(t *testing.T) {
+	h := script.NewHooks()
+	assert.Nil(t, h.LoadSource("test.star", testScript))
+
+	aa := h.Actions([]string{"svc"})
+	assert.Equal(t, 1, len(aa))
+	assert.Equal(t, "shift-x", aa[0].Key)
+	assert.Equal(t, "Describe", aa[0].Description)
+
+	res, err := aa[0].Run(render.Header{{Name: "NAME"}}, render.Row{Fields: render.Fields{"fred"}})
+	assert.Nil(t, err)
+	assert.Equal(t, ":describe fred", res)
+}
+
+func TestHooksDisableOnError(t *testing.T) {
+	h := script.NewHooks()
+	assert.Nil(t, h.LoadSource("err.star", `
+def status_color(row):
+    if row["STATUS"] == "Failed":
+        return "red"
+
+colorizer("all", status_color)
+`))
+
+	c, ok := h.Color([]string{"pods"}, render.Header{{Name: "NAME"}}, render.Row{Fields: render.Fields{"fred"}})
+	assert.False(t, ok)
+	assert.Equal(t, "", c)
+
+	header := render.Header{{Name: "NAME"}, {Name: "STATUS"}}
+	_, ok = h.Color([]string{"pods"}, header, render.Row{Fields: render.Fields{"fred", "Failed"}})
+	assert.False(t, ok)
+}
+
+func TestHooksDisableOnBudget(t *testing.T) {
+	b := script.CallBudget
+	script.CallBudget = 10 * time.Millisecond
+	defer func() { script.CallBudget = b }()
+
+	h := script.NewHooks()
+	assert.Nil(t, h.LoadSource("slow.star", `
+def slow(row):
+    n = 0
+    for i in range(100000000):
+        n += i
+    return str(n)
+
+column("pods", "SLOW", slow)
+action("pods", "shift-s", "Slow", slow)
+`))
+	assert.True(t, h.HasColumns([]string{"pods"}))
+	data := render.TableData{
+		Header:    render.Header{{Name: "NAME"}},
+		RowEvents: render.RowEvents{{Row: render.Row{Fields: render.Fields{"fred"}}}},
+	}
+	h.Transform([]string{"pods"}, &data)
+	assert.Equal(t, render.Fields{"fred", ""}, data.RowEvents[0].Row.Fields)
+	assert.False(t, h.HasColumns([]string{"pods"}))
+
+	aa := h.Actions([]string{"pods"})
+	assert.Equal(t, 1, len(aa))
+	_, err := aa[0].Run(render.Header{{Name: "NAME"}}, render.Row{Fields: render.Fields{"fred"}})
+	assert.NotNil(t, err)
+	_, err = aa[0].Run(render.Header{{Name: "NAME"}}, render.Row{Fields: render.Fields{"fred"}})
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,64 @@
+package server
+
+import (
+	"expvar"
+	"net"
+	"net/http"
+	"net/http/pprof"
+	"sync"
+	"sync/atomic"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/rs/zerolog/log"
+)
+
+var (
+	publishOnce  sync.Once
+	debugFactory atomic.Value
+)
+
+// ServeDebug exposes pprof profiles and expvar self metrics on a given
+// address until k9s exits.
+func ServeDebug(addr string, f dao.Factory) error {
+	l, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	go func() {
+		if err := http.Serve(l, DebugHandler(f)); err != nil {
+			log.Error().Err(err).Msgf("Debug endpoint %q failed", addr)
+		}
+	}()
+	log.Info().Msgf("Debug endpoint listening on %q", l.Addr())
+
+	return nil
+}
+
+// DebugHandler returns a handler serving pprof profiles under /debug/pprof/
+// and k9s self metrics under /debug/vars.
+func DebugHandler(f dao.Factory) http.Handler {
+	if f != nil {
+		debugFactory.Store(factoryHolder{f})
+	}
+	publishOnce.Do(func() {
+		expvar.Publish("k9s", expvar.Func(func() interface{} {
+			h, _ := debugFactory.Load().(factoryHolder)
+			return dao.ReadSelfStats(h.Factory)
+		}))
+	})
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	mux.Handle("/debug/vars", expvar.Handler())
+
+	return mux
+}
+
+// factoryHolder keeps atomic values of a consistent concrete type.
+type factoryHolder struct {
+	dao.Factory
+}
@@ -0,0 +1,547 @@
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/watch"
+	"github.com/rs/zerolog/log"
+	"golang.org/x/net/websocket"
+)
+
+const (
+	apiPrefix       = "/api/v1/"
+	defaultTailLogs = 100
+	shutdownTimeout = 5 * time.Second
+	bearerScheme    = "Bearer "
+)
+
+// Server exposes K9s resources over a local REST and WebSocket API.
+type Server struct {
+	config  *config.Config
+	factory *watch.Factory
+	alias   *dao.Alias
+	token   string
+	host    string
+}
+
+// NewServer returns a new API server. Clients must present the token as a
+// bearer token. A random token is generated when none is given.
+func NewServer(cfg *config.Config, token string) (*Server, error) {
+	if token == "" {
+		var err error
+		if token, err = randomToken(); err != nil {
+			return nil, err
+		}
+	}
+
+	return &Server{
+		config: cfg,
+		token:  token,
+	}, nil
+}
+
+// Token returns the token clients must present.
+func (s *Server) Token() string {
+	return s.token
+}
+
+// Init connects to the cluster and loads the resources metadata.
+func (s *Server) Init() error {
+	conn := s.config.GetConnection()
+	if conn == nil || !conn.ConnectionOK() {
+		return errors.New("no cluster connection")
+	}
+	s.factory = watch.NewFactory(conn)
+	s.factory.Start(s.config.ActiveNamespace())
+	s.alias = dao.NewAlias(s.factory)
+	if _, err := s.alias.Ensure(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ListenAndServe serves the API until the context is canceled.
+func (s *Server) ListenAndServe(ctx context.Context, addr string) error {
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		s.host = host
+	}
+	srv := http.Server{
+		Addr:              addr,
+		Handler:           s.Handler(),
+		ReadHeaderTimeout: 10 * time.Second,
+		BaseContext:       func(net.Listener) context.Context { return ctx },
+	}
+	go func() {
+		<-ctx.Done()
+		c, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		if err := srv.Shutdown(c); err != nil {
+			log.Error().Err(err).Msgf("API server shutdown failed")
+		}
+		s.factory.Terminate()
+	}()
+	log.Info().Msgf("K9s API server listening on %s", addr)
+	if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+
+	return nil
+}
+
+// Handler returns the API routes.
+//
+//	GET  /api/v1/resources/RESOURCE?ns=NS   table snapshot
+//	GET  /api/v1/yaml/RESOURCE?path=NS/NAME resource manifest
+//	WS   /api/v1/watch/RESOURCE?ns=NS       table snapshots as they change
+//	WS   /api/v1/logs?path=NS/POD&container=CO&tail=N
+//	POST /api/v1/actions/ACTION?resource=RESOURCE&path=NS/NAME ie delete&force=true, restart, scale&replicas=N
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc(apiPrefix+"resources/", s.resourcesHandler)
+	mux.HandleFunc(apiPrefix+"yaml/", s.yamlHandler)
+	mux.Handle(apiPrefix+"watch/", websocket.Server{Handler: s.watchHandler, Handshake: checkOrigin})
+	mux.Handle(apiPrefix+"logs", websocket.Server{Handler: s.logsHandler, Handshake: checkOrigin})
+	mux.HandleFunc(apiPrefix+"actions/", s.actionsHandler)
+
+	return s.authorize(mux)
+}
+
+// authorize rejects requests from browser pages, DNS rebinding attempts and
+// clients not presenting the token.
+func (s *Server) authorize(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !s.allowedHost(r.Host) {
+			writeErr(w, http.StatusForbidden, fmt.Errorf("host %q not allowed", r.Host))
+			return
+		}
+		if o := r.Header.Get("Origin"); o != "" && !isLoopbackOrigin(o) {
+			writeErr(w, http.StatusForbidden, fmt.Errorf("origin %q not allowed", o))
+			return
+		}
+		auth := r.Header.Get("Authorization")
+		if !strings.HasPrefix(auth, bearerScheme) ||
+			subtle.ConstantTimeCompare([]byte(strings.TrimPrefix(auth, bearerScheme)), []byte(s.token)) != 1 {
+			writeErr(w, http.StatusUnauthorized, errors.New("invalid token"))
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// allowedHost checks a request host is an ip, localhost or the listen host.
+// Other names may resolve to loopback via DNS rebinding.
+func (s *Server) allowedHost(hostport string) bool {
+	host := hostport
+	if h, _, err := net.SplitHostPort(hostport); err == nil {
+		host = h
+	}
+	host = strings.Trim(host, "[]")
+	if net.ParseIP(host) != nil || strings.EqualFold(host, "localhost") {
+		return true
+	}
+
+	return s.host != "" && strings.EqualFold(host, s.host)
+}
+
+func isLoopbackOrigin(origin string) bool {
+	u, err := url.Parse(origin)
+	if err != nil {
+		return false
+	}
+	if strings.EqualFold(u.Hostname(), "localhost") {
+		return true
+	}
+	ip := net.ParseIP(u.Hostname())
+
+	return ip != nil && ip.IsLoopback()
+}
+
+// checkOrigin rejects websocket handshakes from non loopback pages.
+func checkOrigin(cfg *websocket.Config, r *http.Request) error {
+	if o := r.Header.Get("Origin"); o != "" && !isLoopbackOrigin(o) {
+		return fmt.Errorf("origin %q not allowed", o)
+	}
+
+	return nil
+}
+
+func randomToken() (string, error) {
+	b := make([]byte, 24)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("unable to generate an api token: %w", err)
+	}
+
+	return hex.EncodeToString(b), nil
+}
+
+func (s *Server) resourcesHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeErr(w, http.StatusMethodNotAllowed, fmt.Errorf("method %s not allowed", r.Method))
+		return
+	}
+	gvr, err := s.resolve(strings.TrimPrefix(r.URL.Path, apiPrefix+"resources/"))
+	if err != nil {
+		writeErr(w, http.StatusNotFound, err)
+		return
+	}
+	m := s.tableFor(gvr, r.URL.Query().Get("ns"))
+	if err := m.Refresh(s.tableContext(r.Context(), gvr)); err != nil {
+		writeErr(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeJSON(w, newTableSnapshot(gvr, m.Peek()))
+}
+
+func (s *Server) yamlHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeErr(w, http.StatusMethodNotAllowed, fmt.Errorf("method %s not allowed", r.Method))
+		return
+	}
+	gvr, err := s.resolve(strings.TrimPrefix(r.URL.Path, apiPrefix+"yaml/"))
+	if err != nil {
+		writeErr(w, http.StatusNotFound, err)
+		return
+	}
+	path := r.URL.Query().Get("path")
+	ctx := context.WithValue(r.Context(), internal.KeyFactory, s.factory)
+	raw, err := model.NewYAML(gvr, path).ToYAML(ctx, gvr, path, false)
+	if err != nil {
+		writeErr(w, http.StatusInternalServerError, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/yaml")
+	_, _ = w.Write([]byte(raw))
+}
+
+func (s *Server) watchHandler(ws *websocket.Conn) {
+	defer ws.Close()
+	r := ws.Request()
+	gvr, err := s.resolve(strings.TrimPrefix(r.URL.Path, apiPrefix+"watch/"))
+	if err != nil {
+		_ = websocket.JSON.Send(ws, apiError{Error: err.Error()})
+		return
+	}
+
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+	l := newTableStream(gvr)
+	m := s.tableFor(gvr, r.URL.Query().Get("ns"))
+	m.AddListener(l)
+	defer m.RemoveListener(l)
+	if err := m.Watch(s.tableContext(ctx, gvr)); err != nil {
+		_ = websocket.JSON.Send(ws, apiError{Error: err.Error()})
+		return
+	}
+	go drain(ws, cancel)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case msg := <-l.out:
+			if err := websocket.JSON.Send(ws, msg); err != nil {
+				return
+			}
+		}
+	}
+}
+
+func (s *Server) logsHandler(ws *websocket.Conn) {
+	defer ws.Close()
+	q := ws.Request().URL.Query()
+	tail, err := strconv.Atoi(q.Get("tail"))
+	if err != nil {
+		tail = defaultTailLogs
+	}
+	opts := dao.LogOptions{
+		Path:          q.Get("path"),
+		Container:     q.Get("container"),
+		Lines:         int64(tail),
+		AllContainers: q.Get("container") == "",
+	}
+	gvr := client.NewGVR("v1/pods")
+	acc, err := dao.AccessorFor(s.factory, gvr)
+	if err != nil {
+		_ = websocket.JSON.Send(ws, apiError{Error: err.Error()})
+		return
+	}
+	logger, ok := acc.(dao.Loggable)
+	if !ok {
+		_ = websocket.JSON.Send(ws, apiError{Error: fmt.Sprintf("%s is not loggable", gvr)})
+		return
+	}
+	ctx, cancel := context.WithCancel(context.WithValue(ws.Request().Context(), internal.KeyFactory, s.factory))
+	defer cancel()
+	cc, err := logger.TailLogs(ctx, &opts)
+	if err != nil {
+		_ = websocket.JSON.Send(ws, apiError{Error: err.Error()})
+		return
+	}
+	go drain(ws, cancel)
+
+	var (
+		mx sync.Mutex
+		wg sync.WaitGroup
+	)
+	wg.Add(len(cc))
+	for _, c := range cc {
+		go func(c dao.LogChan) {
+			defer wg.Done()
+			for item := range c {
+				if item == dao.ItemEOF {
+					return
+				}
+				mx.Lock()
+				err := websocket.JSON.Send(ws, logLine{Pod: item.Pod, Container: item.Container, Line: string(item.Bytes)})
+				mx.Unlock()
+				if err != nil {
+					cancel()
+					return
+				}
+			}
+		}(c)
+	}
+	wg.Wait()
+}
+
+func (s *Server) actionsHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeErr(w, http.StatusMethodNotAllowed, fmt.Errorf("method %s not allowed", r.Method))
+		return
+	}
+	if s.config.K9s.IsReadOnly() {
+		writeErr(w, http.StatusForbidden, errors.New("k9s is in read only mode"))
+		return
+	}
+	q := r.URL.Query()
+	path, action := q.Get("path"), strings.TrimPrefix(r.URL.Path, apiPrefix+"actions/")
+	force := q.Get("force") == "true"
+	if action == "delete" {
+		if err := s.checkDeleteGuards(path, force); err != nil {
+			writeErr(w, http.StatusForbidden, err)
+			return
+		}
+	}
+	gvr, err := s.resolve(q.Get("resource"))
+	if err != nil {
+		writeErr(w, http.StatusNotFound, err)
+		return
+	}
+	if action == "delete" && s.config.K9s.GuardsConfig().ConfirmClusterScoped {
+		if meta, err := dao.MetaAccess.MetaFor(gvr); err == nil && !meta.Namespaced {
+			writeErr(w, http.StatusForbidden, fmt.Errorf("cluster scoped deletes of %s require a confirmation", gvr))
+			return
+		}
+	}
+	acc, err := dao.AccessorFor(s.factory, gvr)
+	if err != nil {
+		writeErr(w, http.StatusNotFound, err)
+		return
+	}
+	if err := runAction(r.Context(), acc, action, path, q.Get("replicas"), force); err != nil {
+		writeErr(w, http.StatusBadRequest, err)
+		return
+	}
+	writeJSON(w, map[string]string{"status": "ok", "action": action, "path": path})
+}
+
+// checkDeleteGuards refuses deletes the UI would only allow after typing the
+// resource name, since the API can not prompt for it.
+func (s *Server) checkDeleteGuards(path string, force bool) error {
+	g := s.config.K9s.GuardsConfig()
+	if force && g.DisableForceDelete {
+		return errors.New("force deletes are disabled")
+	}
+	ns, _ := client.Namespaced(path)
+	if g.NameRequired(s.config.K9s.CurrentContext, ns) {
+		return fmt.Errorf("deletes of %s require a name confirmation", path)
+	}
+
+	return nil
+}
+
+func runAction(ctx context.Context, acc dao.Accessor, action, path, replicas string, force bool) error {
+	switch action {
+	case "delete":
+		n, ok := acc.(dao.Nuker)
+		if !ok {
+			return fmt.Errorf("%s can not be deleted", acc.GVR())
+		}
+		grace := dao.DefaultGrace
+		if force {
+			grace = dao.ForceGrace
+		}
+		return n.Delete(ctx, path, nil, grace)
+	case "restart":
+		rr, ok := acc.(dao.Restartable)
+		if !ok {
+			return fmt.Errorf("%s can not be restarted", acc.GVR())
+		}
+		return rr.Restart(ctx, path)
+	case "scale":
+		sc, ok := acc.(dao.Scalable)
+		if !ok {
+			return fmt.Errorf("%s can not be scaled", acc.GVR())
+		}
+		n, err := strconv.Atoi(replicas)
+		if err != nil {
+			return fmt.Errorf("invalid replicas %q", replicas)
+		}
+		return sc.Scale(ctx, path, int32(n))
+	default:
+		return fmt.Errorf("unknown action %q", action)
+	}
+}
+
+func (s *Server) resolve(cmd string) (client.GVR, error) {
+	if s.alias == nil {
+		return client.GVR{}, errors.New("server not initialized")
+	}
+	gvr, ok := s.alias.AsGVR(strings.Trim(cmd, "/"))
+	if !ok {
+		return client.GVR{}, fmt.Errorf("unknown resource %q", cmd)
+	}
+
+	return gvr, nil
+}
+
+func (s *Server) tableFor(gvr client.GVR, ns string) *model.Table {
+	if ns == "" {
+		ns = s.config.ActiveNamespace()
+	}
+	ns = client.CleanseNamespace(ns)
+	if meta, err := dao.MetaAccess.MetaFor(gvr); err == nil && !meta.Namespaced {
+		ns = client.ClusterScope
+	}
+	m := model.NewTable(gvr)
+	m.Isolate()
+	m.SetNamespace(ns)
+	m.SetRefreshRate(time.Duration(s.config.K9s.GetRefreshRate()) * time.Second)
+
+	return m
+}
+
+func (s *Server) tableContext(ctx context.Context, gvr client.GVR) context.Context {
+	ctx = context.WithValue(ctx, internal.KeyFactory, s.factory)
+	ctx = context.WithValue(ctx, internal.KeyGVR, gvr.String())
+
+	return context.WithValue(ctx, internal.KeyWithMetrics, false)
+}
+
+// drain reads the client messages until the connection closes.
+func drain(ws *websocket.Conn, cancel context.CancelFunc) {
+	defer cancel()
+	var msg string
+	for {
+		if err := websocket.Message.Receive(ws, &msg); err != nil {
+			return
+		}
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+type apiError struct {
+	Error string `json:"error"`
+}
+
+type logLine struct {
+	Pod       string `json:"pod,omitempty"`
+	Container string `json:"container,omitempty"`
+	Line      string `json:"line"`
+}
+
+// tableSnapshot represents a rendered resource table.
+type tableSnapshot struct {
+	Resource  string     `json:"resource"`
+	Namespace string     `json:"namespace"`
+	Header    []string   `json:"header"`
+	Rows      []tableRow `json:"rows"`
+}
+
+type tableRow struct {
+	ID     string   `json:"id"`
+	Fields []string `json:"fields"`
+}
+
+func newTableSnapshot(gvr client.GVR, data *render.TableData) tableSnapshot {
+	t := tableSnapshot{
+		Resource:  gvr.String(),
+		Namespace: data.Namespace,
+		Header:    make([]string, 0, len(data.Header)),
+		Rows:      make([]tableRow, 0, len(data.RowEvents)),
+	}
+	for _, h := range data.Header {
+		t.Header = append(t.Header, h.Name)
+	}
+	for _, re := range data.RowEvents {
+		t.Rows = append(t.Rows, tableRow{ID: re.Row.ID, Fields: re.Row.Fields})
+	}
+
+	return t
+}
+
+// tableStream forwards table updates to a websocket.
+type tableStream struct {
+	gvr client.GVR
+	out chan interface{}
+}
+
+func newTableStream(gvr client.GVR) *tableStream {
+	return &tableStream{gvr: gvr, out: make(chan interface{}, 1)}
+}
+
+// TableDataChanged notifies the model data changed.
+func (t *tableStream) TableDataChanged(data *render.TableData) {
+	t.send(newTableSnapshot(t.gvr, data))
+}
+
+// TableLoadFailed notifies the load failed.
+func (t *tableStream) TableLoadFailed(err error) {
+	t.send(apiError{Error: err.Error()})
+}
+
+// send replaces any pending update so slow clients only get the latest.
+func (t *tableStream) send(msg interface{}) {
+	select {
+	case <-t.out:
+	default:
+	}
+	select {
+	case t.out <- msg:
+	default:
+	}
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.Error().Err(err).Msgf("API response encoding failed")
+	}
+}
+
+func writeErr(w http.ResponseWriter, code int, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(apiError{Error: err.Error()})
+}
@@ -0,0 +1,182 @@
+package server_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/server"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServerHandler(t *testing.T) {
+	uu := map[string]struct {
+		auth, origin, host string
+		readOnly           bool
+		guards             *config.Guards
+		method, path       string
+		e                  int
+	}{
+		"no-token": {
+			method: http.MethodGet,
+			path:   "/api/v1/resources/po",
+			e:      http.StatusUnauthorized,
+		},
+		"bad-token": {
+			auth:   "Bearer blee",
+			method: http.MethodGet,
+			path:   "/api/v1/resources/po",
+			e:      http.StatusUnauthorized,
+		},
+		"bare-token": {
+			auth:   "fred",
+			method: http.MethodGet,
+			path:   "/api/v2/blee",
+			e:      http.StatusUnauthorized,
+		},
+		"guarded-delete": {
+			auth:   "Bearer fred",
+			guards: &config.Guards{Namespaces: []string{"prod-*"}},
+			method: http.MethodPost,
+			path:   "/api/v1/actions/delete?resource=po&path=prod-1/fred",
+			e:      http.StatusForbidden,
+		},
+		"no-force-delete": {
+			auth:   "Bearer fred",
+			guards: &config.Guards{DisableForceDelete: true},
+			method: http.MethodPost,
+			path:   "/api/v1/actions/delete?resource=po&path=default/fred&force=true",
+			e:      http.StatusForbidden,
+		},
+		"bad-method": {
+			auth:   "Bearer fred",
+			method: http.MethodDelete,
+			path:   "/api/v1/resources/po",
+			e:      http.StatusMethodNotAllowed,
+		},
+		"query-token": {
+			method: http.MethodPut,
+			path:   "/api/v1/yaml/po?token=fred",
+			e:      http.StatusUnauthorized,
+		},
+		"action-get": {
+			auth:   "Bearer fred",
+			method: http.MethodGet,
+			path:   "/api/v1/actions/delete",
+			e:      http.StatusMethodNotAllowed,
+		},
+		"read-only": {
+			auth:     "Bearer fred",
+			readOnly: true,
+			method:   http.MethodPost,
+			path:     "/api/v1/actions/delete?resource=po&path=default/fred",
+			e:        http.StatusForbidden,
+		},
+		"no-route": {
+			auth:   "Bearer fred",
+			method: http.MethodGet,
+			path:   "/api/v2/blee",
+			e:      http.StatusNotFound,
+		},
+		"loopback-origin": {
+			auth:   "Bearer fred",
+			origin: "http://localhost:3000",
+			method: http.MethodGet,
+			path:   "/api/v2/blee",
+			e:      http.StatusNotFound,
+		},
+		"remote-origin": {
+			auth:   "Bearer fred",
+			origin: "https://evil.example.com",
+			method: http.MethodPost,
+			path:   "/api/v1/actions/delete?resource=po&path=default/fred",
+			e:      http.StatusForbidden,
+		},
+		"rebinding-host": {
+			auth:   "Bearer fred",
+			host:   "evil.example.com:7007",
+			method: http.MethodGet,
+			path:   "/api/v1/resources/po",
+			e:      http.StatusForbidden,
+		},
+		"ip-host": {
+			auth:   "Bearer fred",
+			host:   "127.0.0.1:7007",
+			method: http.MethodGet,
+			path:   "/api/v2/blee",
+			e:      http.StatusNotFound,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			cfg := config.NewConfig(nil)
+			cfg.K9s.ReadOnly = u.readOnly
+			cfg.K9s.Guards = u.guards
+			s, err := server.NewServer(cfg, "fred")
+			assert.NoError(t, err)
+			h := s.Handler()
+
+			r := httptest.NewRequest(u.method, u.path, nil)
+			r.Host = "localhost:7007"
+			if u.host != "" {
+				r.Host = u.host
+			}
+			if u.auth != "" {
+				r.Header.Set("Authorization", u.auth)
+			}
+			if u.origin != "" {
+				r.Header.Set("Origin", u.origin)
+			}
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, r)
+
+			assert.Equal(t, u.e, w.Code)
+		})
+	}
+}
+
+func TestServerGeneratedToken(t *testing.T) {
+	s1, err := server.NewServer(config.NewConfig(nil), "")
+	assert.NoError(t, err)
+	s2, err := server.NewServer(config.NewConfig(nil), "")
+	assert.NoError(t, err)
+	s3, err := server.NewServer(config.NewConfig(nil), "fred")
+	assert.NoError(t, err)
+
+	assert.Equal(t, 48, len(s1.Token()))
+	assert.NotEqual(t, s1.Token(), s2.Token())
+	assert.Equal(t, "fred", s3.Token())
+}
+
+func TestDebugHandler(t *testing.T) {
+	uu := map[string]struct {
+		path string
+		e    int
+	}{
+		"vars": {
+			path: "/debug/vars",
+			e:    http.StatusOK,
+		},
+		"pprof": {
+			path: "/debug/pprof/",
+			e:    http.StatusOK,
+		},
+		"no-route": {
+			path: "/api/v1/resources/po",
+			e:    http.StatusNotFound,
+		},
+	}
+
+	h := server.DebugHandler(nil)
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, u.path, nil))
+			assert.Equal(t, u.e, w.Code)
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package ui
+
+import (
+	"github.com/derailed/tview"
+)
+
+// SetAccessible swaps box drawings and symbols for plain ASCII so that screen
+// readers and restricted terminals can make sense of the output.
+func SetAccessible(b bool) {
+	if !b {
+		return
+	}
+
+	descIndicator, ascIndicator = "v", "^"
+	DeltaSign, PlusSign, MinusSign = "~", "[red::b]+", "[green::b]-"
+
+	tview.Borders.Horizontal, tview.Borders.Vertical = '-', '|'
+	tview.Borders.TopLeft, tview.Borders.TopRight = '+', '+'
+	tview.Borders.BottomLeft, tview.Borders.BottomRight = '+', '+'
+	tview.Borders.LeftT, tview.Borders.RightT = '+', '+'
+	tview.Borders.TopT, tview.Borders.BottomT, tview.Borders.Cross = '+', '+', '+'
+	tview.Borders.HorizontalFocus, tview.Borders.VerticalFocus = '=', '|'
+	tview.Borders.TopLeftFocus, tview.Borders.TopRightFocus = '+', '+'
+	tview.Borders.BottomLeftFocus, tview.Borders.BottomRightFocus = '+', '+'
+}
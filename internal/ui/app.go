@@ -36,12 +36,13 @@ func NewApp(cfg *config.Config, context string) *App {
 		flash:        model.NewFlash(model.DefaultFlashDelay),
 		cmdBuff:      model.NewFishBuff(':', model.CommandBuffer),
 	}
+	SetAccessible(cfg.K9s.Accessible)
 	a.ReloadStyles(context)
 
 	a.views = map[string]tview.Primitive{
 		"menu":   NewMenu(a.Styles),
 		"logo":   NewLogo(a.Styles),
-		"prompt": NewPrompt(&a, a.Config.K9s.NoIcons, a.Styles),
+		"prompt": NewPrompt(&a, a.Config.K9s.IsNoIcons(), a.Styles),
 		"crumbs": NewCrumbs(a.Styles),
 	}
 
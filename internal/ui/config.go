@@ -24,6 +24,8 @@ type Configurator struct {
 	CustomView *config.CustomView
 	BenchFile  string
 	skinFile   string
+
+	skinWatcher *fsnotify.Watcher
 }
 
 // HasSkin returns true if a skin file was located.
@@ -78,26 +80,25 @@ func (c *Configurator) RefreshCustomViews() {
 	}
 }
 
-// StylesWatcher watches for skin file changes.
+// StylesWatcher watches for skin files changes. Skins are reloaded whenever
+// one of the current context candidate skin files is created or updated.
 func (c *Configurator) StylesWatcher(ctx context.Context, s synchronizer) error {
-	if !c.HasSkin() {
-		return nil
-	}
-
 	w, err := fsnotify.NewWatcher()
 	if err != nil {
 		return err
 	}
+	c.skinWatcher = w
 
 	go func() {
 		for {
 			select {
 			case evt := <-w.Events:
-				if evt.Op != fsnotify.Chmod {
-					s.QueueUpdateDraw(func() {
-						c.RefreshStyles(c.Config.K9s.CurrentCluster)
-					})
+				if evt.Op == fsnotify.Chmod || !c.isSkinFile(evt.Name) {
+					continue
 				}
+				s.QueueUpdateDraw(func() {
+					c.RefreshStyles(c.Config.K9s.CurrentContext)
+				})
 			case err := <-w.Errors:
 				log.Info().Err(err).Msg("Skin watcher failed")
 				return
@@ -110,9 +111,50 @@ func (c *Configurator) StylesWatcher(ctx context.Context, s synchronizer) error
 			}
 		}
 	}()
+	c.watchSkins(c.Config.K9s.CurrentContext)
+
+	return nil
+}
+
+// watchSkins watches the directories holding the context skin files.
+func (c *Configurator) watchSkins(context string) {
+	if c.skinWatcher == nil {
+		return
+	}
+	for _, f := range c.skinFiles(context) {
+		dir := filepath.Dir(f)
+		if err := c.skinWatcher.Add(dir); err != nil {
+			log.Debug().Err(err).Msgf("SkinWatcher unable to watch `%s", dir)
+			continue
+		}
+		log.Debug().Msgf("SkinWatcher watching `%s", dir)
+	}
+}
+
+func (c *Configurator) isSkinFile(path string) bool {
+	if c.Config == nil || c.Config.K9s == nil {
+		return false
+	}
+	for _, f := range c.skinFiles(c.Config.K9s.CurrentContext) {
+		if filepath.Clean(f) == filepath.Clean(path) {
+			return true
+		}
+	}
+
+	return false
+}
 
-	log.Debug().Msgf("SkinWatcher watching `%s", c.skinFile)
-	return w.Add(c.skinFile)
+// skinFiles returns the skin files for a context in order of precedence ie the
+// skin bound to the context, the context skin and the global skin.
+func (c *Configurator) skinFiles(context string) []string {
+	ff := make([]string, 0, 3)
+	if c.Config != nil && c.Config.K9s != nil {
+		if skin := c.Config.K9s.ContextsConfig().Skin(context); skin != "" {
+			ff = append(ff, skin)
+		}
+	}
+
+	return append(ff, filepath.Join(config.K9sHome(), fmt.Sprintf("%s_skin.yml", context)), config.K9sStylesFile)
 }
 
 // BenchConfig location of the benchmarks configuration file.
@@ -124,25 +166,32 @@ func BenchConfig(context string) string {
 func (c *Configurator) RefreshStyles(context string) {
 	c.BenchFile = BenchConfig(context)
 
-	clusterSkins := filepath.Join(config.K9sHome(), fmt.Sprintf("%s_skin.yml", context))
 	if c.Styles == nil {
 		c.Styles = config.NewStyles()
-	} else {
-		c.Styles.Reset()
 	}
-	if err := c.Styles.Load(clusterSkins); err != nil {
-		log.Warn().Msgf("No context specific skin file found -- %s", clusterSkins)
-	} else {
-		c.updateStyles(clusterSkins)
-		return
+	if c.Config != nil && c.Config.K9s != nil {
+		c.Styles.SetTheme(c.Config.K9s.Theme)
 	}
-
-	if err := c.Styles.Load(config.K9sStylesFile); err != nil {
-		log.Warn().Msgf("No skin file found -- %s. Loading stock skins.", config.K9sStylesFile)
+	if config.NoColor() {
+		c.Styles.SetTheme(config.ThemeMono)
+		c.Styles.Reset()
 		c.updateStyles("")
 		return
 	}
-	c.updateStyles(config.K9sStylesFile)
+	c.Styles.Reset()
+	defer c.watchSkins(context)
+
+	for _, f := range c.skinFiles(context) {
+		if err := c.Styles.Load(f); err != nil {
+			log.Debug().Msgf("No skin file found -- %s", f)
+			c.Styles.Reset()
+			continue
+		}
+		c.updateStyles(f)
+		return
+	}
+	log.Warn().Msgf("No skin file found -- %s. Loading stock skins.", config.K9sStylesFile)
+	c.updateStyles("")
 }
 
 func (c *Configurator) updateStyles(f string) {
@@ -27,3 +27,18 @@ func TestConfiguratorRefreshStyle(t *testing.T) {
 	assert.Equal(t, tcell.ColorGhostWhite.TrueColor(), render.StdColor)
 	assert.Equal(t, tcell.ColorWhiteSmoke.TrueColor(), render.ErrColor)
 }
+
+func TestConfiguratorContextSkin(t *testing.T) {
+	config.K9sStylesFile = filepath.Join("..", "config", "testdata", "empty_skin.yml")
+
+	cfg := ui.Configurator{Config: config.NewConfig(nil)}
+	cfg.Config.K9s.Contexts = &config.Contexts{
+		Skins: map[string]string{
+			"prod-*": filepath.Join("..", "config", "testdata", "black_and_wtf.yml"),
+		},
+	}
+	cfg.RefreshStyles("prod-eu")
+
+	assert.True(t, cfg.HasSkin())
+	assert.Equal(t, tcell.ColorGhostWhite.TrueColor(), render.StdColor)
+}
@@ -55,6 +55,15 @@ func (c *Crumbs) StackPopped(_, _ model.Component) {
 // StackTop indicates the top of the stack.
 func (c *Crumbs) StackTop(top model.Component) {}
 
+// Reset replaces the crumbs with the given components.
+func (c *Crumbs) Reset(cc []model.Component) {
+	c.stack.Clear()
+	for _, comp := range cc {
+		c.stack.Push(comp)
+	}
+	c.refresh(c.stack.Flatten())
+}
+
 // Refresh updates view with new crumbs.
 func (c *Crumbs) refresh(crumbs []string) {
 	c.Clear()
@@ -10,7 +10,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 )
 
-const (
+var (
 	// DeltaSign signals a diff.
 	DeltaSign = "Δ"
 	// PlusSign signals inc.
@@ -1,6 +1,9 @@
 package dialog
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/tview"
@@ -17,6 +20,96 @@ type (
 	cancelFunc func()
 )
 
+// DeletePolicy tracks the guards enforced by the deletion dialogs.
+type DeletePolicy struct {
+	// ConfirmName when set must be typed in to confirm the deletion.
+	ConfirmName string
+
+	// NoForce disables force deletes.
+	NoForce bool
+
+	// DoubleConfirm requires a second confirmation.
+	DoubleConfirm bool
+}
+
+func (p DeletePolicy) addConfirmField(f *tview.Form, typed *string) {
+	if p.ConfirmName == "" {
+		return
+	}
+	f.AddInputField("Confirm:", "", 30, nil, func(s string) {
+		*typed = s
+	})
+}
+
+func (p DeletePolicy) message(msg string) string {
+	if p.ConfirmName == "" {
+		return msg
+	}
+
+	return fmt.Sprintf("%s\nType %q to confirm.", msg, p.ConfirmName)
+}
+
+func (p DeletePolicy) confirmed(typed string) bool {
+	return p.ConfirmName == "" || strings.TrimSpace(typed) == p.ConfirmName
+}
+
+// proceed runs the deletion once the policy guards are satisfied.
+func (p DeletePolicy) proceed(styles config.Dialog, pages *ui.Pages, ack func(), cancel cancelFunc) {
+	if !p.DoubleConfirm {
+		ack()
+		dismiss(pages)
+		cancel()
+		return
+	}
+	dismiss(pages)
+	ShowConfirm(styles, pages, "Confirm Cluster Delete", "Deleting cluster scoped resource(s). Are you really sure?", ack, cancel)
+}
+
+// ShowConfirmDelete pops a deletion confirmation dialog honoring the policy
+// for resources with no deletion options.
+func ShowConfirmDelete(styles config.Dialog, pages *ui.Pages, msg string, policy DeletePolicy, ack confirmFunc, cancel cancelFunc) {
+	typed := ""
+	f := tview.NewForm()
+	f.SetItemPadding(0)
+	f.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(styles.ButtonBgColor.Color()).
+		SetButtonTextColor(styles.ButtonFgColor.Color()).
+		SetLabelColor(styles.LabelFgColor.Color()).
+		SetFieldTextColor(styles.FieldFgColor.Color())
+	policy.addConfirmField(f, &typed)
+	f.AddButton("Cancel", func() {
+		dismiss(pages)
+		cancel()
+	})
+	var confirm *tview.ModalForm
+	f.AddButton("OK", func() {
+		if !policy.confirmed(typed) {
+			confirm.SetText(policy.message(msg) + "\n[red::b]Name mismatch!")
+			return
+		}
+		policy.proceed(styles, pages, ack, cancel)
+	})
+	for i := 0; i < 2; i++ {
+		b := f.GetButton(i)
+		if b == nil {
+			continue
+		}
+		b.SetBackgroundColorActivated(styles.ButtonFocusBgColor.Color())
+		b.SetLabelColorActivated(styles.ButtonFocusFgColor.Color())
+	}
+	f.SetFocus(0)
+
+	confirm = tview.NewModalForm("<Confirm Delete>", f)
+	confirm.SetText(policy.message(msg))
+	confirm.SetTextColor(styles.FgColor.Color())
+	confirm.SetDoneFunc(func(int, string) {
+		dismiss(pages)
+		cancel()
+	})
+	pages.AddPage(dialogKey, confirm, false, false)
+	pages.ShowPage(dialogKey)
+}
+
 var propagationOptions []string = []string{
 	string(metav1.DeletePropagationBackground),
 	string(metav1.DeletePropagationForeground),
@@ -25,8 +118,8 @@ var propagationOptions []string = []string{
 }
 
 // ShowDelete pops a resource deletion dialog.
-func ShowDelete(styles config.Dialog, pages *ui.Pages, msg string, ok okFunc, cancel cancelFunc) {
-	propagation, force := "", false
+func ShowDelete(styles config.Dialog, pages *ui.Pages, msg string, policy DeletePolicy, ok okFunc, cancel cancelFunc) {
+	propagation, force, typed := "", false, ""
 	f := tview.NewForm()
 	f.SetItemPadding(0)
 	f.SetButtonsAlign(tview.AlignCenter).
@@ -42,23 +135,31 @@ func ShowDelete(styles config.Dialog, pages *ui.Pages, msg string, ok okFunc, ca
 		styles.FgColor.Color(), styles.BgColor.Color(),
 		styles.ButtonFocusFgColor.Color(), styles.ButtonFocusBgColor.Color(),
 	)
-	f.AddCheckbox("Force:", force, func(_ string, checked bool) {
-		force = checked
-	})
+	if !policy.NoForce {
+		f.AddCheckbox("Force:", force, func(_ string, checked bool) {
+			force = checked
+		})
+	}
+	policy.addConfirmField(f, &typed)
 	f.AddButton("Cancel", func() {
 		dismiss(pages)
 		cancel()
 	})
+	var confirm *tview.ModalForm
 	f.AddButton("OK", func() {
-		switch propagation {
-		case noDeletePropagation:
-			ok(nil, force)
-		default:
-			p := metav1.DeletionPropagation(propagation)
-			ok(&p, force)
+		if !policy.confirmed(typed) {
+			confirm.SetText(policy.message(msg) + "\n[red::b]Name mismatch!")
+			return
 		}
-		dismiss(pages)
-		cancel()
+		policy.proceed(styles, pages, func() {
+			switch propagation {
+			case noDeletePropagation:
+				ok(nil, force)
+			default:
+				p := metav1.DeletionPropagation(propagation)
+				ok(&p, force)
+			}
+		}, cancel)
 	})
 	for i := 0; i < 2; i++ {
 		b := f.GetButton(i)
@@ -68,10 +169,10 @@ func ShowDelete(styles config.Dialog, pages *ui.Pages, msg string, ok okFunc, ca
 		b.SetBackgroundColorActivated(styles.ButtonFocusBgColor.Color())
 		b.SetLabelColorActivated(styles.ButtonFocusFgColor.Color())
 	}
-	f.SetFocus(2)
+	f.SetFocus(f.GetFormItemCount())
 
-	confirm := tview.NewModalForm("<Delete>", f)
-	confirm.SetText(msg)
+	confirm = tview.NewModalForm("<Delete>", f)
+	confirm.SetText(policy.message(msg))
 	confirm.SetDoneFunc(func(int, string) {
 		dismiss(pages)
 		cancel()
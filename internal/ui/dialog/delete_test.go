@@ -20,7 +20,7 @@ func TestDeleteDialog(t *testing.T) {
 	caFunc := func() {
 		assert.True(t, true)
 	}
-	ShowDelete(config.Dialog{}, p, "Yo", okFunc, caFunc)
+	ShowDelete(config.Dialog{}, p, "Yo", DeletePolicy{}, okFunc, caFunc)
 
 	d := p.GetPrimitive(dialogKey).(*tview.ModalForm)
 	assert.NotNil(t, d)
@@ -28,3 +28,111 @@ func TestDeleteDialog(t *testing.T) {
 	dismiss(p)
 	assert.Nil(t, p.GetPrimitive(dialogKey))
 }
+
+func TestPodDeleteDialog(t *testing.T) {
+	p := ui.NewPages()
+
+	okFunc := func(mode string, grace int64) {
+		assert.Equal(t, GracefulDelete, mode)
+		assert.Equal(t, NoGracePeriod, grace)
+	}
+	caFunc := func() {
+		assert.True(t, true)
+	}
+	ShowPodDelete(config.Dialog{}, p, "Yo", DeletePolicy{}, okFunc, caFunc)
+
+	d := p.GetPrimitive(dialogKey).(*tview.ModalForm)
+	assert.NotNil(t, d)
+
+	dismiss(p)
+	assert.Nil(t, p.GetPrimitive(dialogKey))
+}
+
+func TestParseGrace(t *testing.T) {
+	uu := map[string]struct {
+		mode, grace string
+		policy      DeletePolicy
+		e           int64
+		err         bool
+	}{
+		"default":         {mode: GracefulDelete, e: NoGracePeriod},
+		"graceful":        {mode: GracefulDelete, grace: "30", e: 30},
+		"force":           {mode: ForceDelete, grace: "30", e: 0},
+		"evict":           {mode: EvictDelete, grace: "10", e: 10},
+		"zero":            {mode: GracefulDelete, grace: "0", e: 0},
+		"zero-noForce":    {mode: GracefulDelete, grace: "0", policy: DeletePolicy{NoForce: true}, err: true},
+		"evict-noForce":   {mode: EvictDelete, grace: "0", policy: DeletePolicy{NoForce: true}, err: true},
+		"default-noForce": {mode: GracefulDelete, policy: DeletePolicy{NoForce: true}, e: NoGracePeriod},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			g, err := parseGrace(u.mode, u.grace, u.policy)
+			if u.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, g)
+		})
+	}
+}
+
+func TestConfirmDeleteDialog(t *testing.T) {
+	p := ui.NewPages()
+
+	ShowConfirmDelete(config.Dialog{}, p, "Yo", DeletePolicy{ConfirmName: "fred"}, func() {}, func() {})
+	d := p.GetPrimitive(dialogKey).(*tview.ModalForm)
+	assert.NotNil(t, d)
+
+	dismiss(p)
+	assert.Nil(t, p.GetPrimitive(dialogKey))
+}
+
+func TestDeletePolicy(t *testing.T) {
+	uu := map[string]struct {
+		policy DeletePolicy
+		typed  string
+		ok     bool
+		msg    string
+	}{
+		"none": {
+			ok:  true,
+			msg: "Yo",
+		},
+		"mismatch": {
+			policy: DeletePolicy{ConfirmName: "fred"},
+			typed:  "blee",
+			msg:    "Yo\nType \"fred\" to confirm.",
+		},
+		"match": {
+			policy: DeletePolicy{ConfirmName: "fred", NoForce: true},
+			typed:  " fred ",
+			ok:     true,
+			msg:    "Yo\nType \"fred\" to confirm.",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.ok, u.policy.confirmed(u.typed))
+			assert.Equal(t, u.msg, u.policy.message("Yo"))
+
+			p := ui.NewPages()
+			ShowDelete(config.Dialog{}, p, "Yo", u.policy, func(*metav1.DeletionPropagation, bool) {}, func() {})
+			d := p.GetPrimitive(dialogKey).(*tview.ModalForm)
+			assert.NotNil(t, d)
+		})
+	}
+}
+
+func TestDeletePolicyDoubleConfirm(t *testing.T) {
+	p := ui.NewPages()
+
+	var acked bool
+	DeletePolicy{DoubleConfirm: true}.proceed(config.Dialog{}, p, func() { acked = true }, func() {})
+	assert.False(t, acked)
+	assert.NotNil(t, p.GetPrimitive(dialogKey))
+}
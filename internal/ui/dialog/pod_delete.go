@@ -0,0 +1,113 @@
+package dialog
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+)
+
+// Pod deletion modes.
+const (
+	// GracefulDelete deletes pods honoring a grace period.
+	GracefulDelete = "Graceful"
+	// ForceDelete deletes pods immediately and orphans their dependents.
+	ForceDelete = "Force"
+	// EvictDelete evicts pods via the eviction API, honoring disruption budgets.
+	EvictDelete = "Evict"
+
+	// NoGracePeriod indicates the pod default grace period should be used.
+	NoGracePeriod int64 = -1
+)
+
+type podDeleteFunc func(mode string, grace int64)
+
+var podDeleteModes = []string{GracefulDelete, ForceDelete, EvictDelete}
+
+// ShowPodDelete pops a pod deletion dialog.
+func ShowPodDelete(styles config.Dialog, pages *ui.Pages, msg string, policy DeletePolicy, ok podDeleteFunc, cancel cancelFunc) {
+	mode, grace, typed := GracefulDelete, "", ""
+	modes := podDeleteModes
+	if policy.NoForce {
+		modes = []string{GracefulDelete, EvictDelete}
+	}
+	f := tview.NewForm()
+	f.SetItemPadding(0)
+	f.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(styles.ButtonBgColor.Color()).
+		SetButtonTextColor(styles.ButtonFgColor.Color()).
+		SetLabelColor(styles.LabelFgColor.Color()).
+		SetFieldTextColor(styles.FieldFgColor.Color())
+	f.AddDropDown("Mode:", modes, 0, func(_ string, optionIndex int) {
+		mode = modes[optionIndex]
+	})
+	modeField := f.GetFormItemByLabel("Mode:").(*tview.DropDown)
+	modeField.SetListStyles(
+		styles.FgColor.Color(), styles.BgColor.Color(),
+		styles.ButtonFocusFgColor.Color(), styles.ButtonFocusBgColor.Color(),
+	)
+	f.AddInputField("GracePeriod:", grace, 6, func(s string, _ rune) bool {
+		_, err := strconv.ParseUint(s, 10, 32)
+		return s == "" || err == nil
+	}, func(s string) {
+		grace = s
+	})
+	policy.addConfirmField(f, &typed)
+	f.AddButton("Cancel", func() {
+		dismiss(pages)
+		cancel()
+	})
+	var confirm *tview.ModalForm
+	f.AddButton("OK", func() {
+		if !policy.confirmed(typed) {
+			confirm.SetText(policy.message(msg) + "\n[red::b]Name mismatch!")
+			return
+		}
+		g, err := parseGrace(mode, grace, policy)
+		if err != nil {
+			confirm.SetText(policy.message(msg) + "\n[red::b]" + err.Error())
+			return
+		}
+		policy.proceed(styles, pages, func() {
+			ok(mode, g)
+		}, cancel)
+	})
+	for i := 0; i < 2; i++ {
+		b := f.GetButton(i)
+		if b == nil {
+			continue
+		}
+		b.SetBackgroundColorActivated(styles.ButtonFocusBgColor.Color())
+		b.SetLabelColorActivated(styles.ButtonFocusFgColor.Color())
+	}
+	f.SetFocus(f.GetFormItemCount())
+
+	confirm = tview.NewModalForm("<Delete>", f)
+	confirm.SetText(policy.message(msg))
+	confirm.SetDoneFunc(func(int, string) {
+		dismiss(pages)
+		cancel()
+	})
+	pages.AddPage(dialogKey, confirm, false, false)
+	pages.ShowPage(dialogKey)
+}
+
+// parseGrace returns the deletion grace period. Immediate deletions are
+// rejected when force deletes are disabled.
+func parseGrace(mode, s string, policy DeletePolicy) (int64, error) {
+	if mode == ForceDelete {
+		return 0, nil
+	}
+	g, err := strconv.ParseInt(strings.TrimSpace(s), 10, 64)
+	if err != nil {
+		return NoGracePeriod, nil
+	}
+	if g <= 0 && policy.NoForce {
+		return 0, errors.New("zero grace period is disabled")
+	}
+
+	return g, nil
+}
@@ -82,7 +82,7 @@ func (f *Flash) SetMessage(m model.LevelMessage) {
 }
 
 func (f *Flash) flashEmoji(l model.FlashLevel) string {
-	if f.app.Config.K9s.NoIcons {
+	if f.app.Config.K9s.IsNoIcons() {
 		return ""
 	}
 	// nolint:exhaustive
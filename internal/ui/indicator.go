@@ -3,13 +3,17 @@ package ui
 import (
 	"context"
 	"fmt"
+	"strings"
+	"text/template"
 	"time"
 
+	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/tcell/v2"
 	"github.com/derailed/tview"
+	"github.com/rs/zerolog/log"
 )
 
 // StatusIndicator represents a status indicator when main header is collapsed.
@@ -47,18 +51,92 @@ func (s *StatusIndicator) StylesChanged(styles *config.Styles) {
 
 const statusIndicatorFmt = "[orange::b]K9s [aqua::]%s [white::]%s:%s:%s [lawngreen::]%s[white::]::[darkturquoise::]%s"
 
+// StatusVars represents the variables available to status bar templates.
+type StatusVars struct {
+	Context, Cluster, Namespace, User string
+	K9sVer, K8sVer                    string
+	CPU, MEM                          string
+	Latency                           string
+	Watches                           int
+	Time                              string
+}
+
+func newStatusVars(data model.ClusterMeta, cpu, mem string) StatusVars {
+	latency := client.NA
+	if data.Latency > 0 {
+		latency = data.Latency.Round(time.Millisecond).String()
+	}
+
+	return StatusVars{
+		Context:   data.Context,
+		Cluster:   data.Cluster,
+		Namespace: data.Namespace,
+		User:      data.User,
+		K9sVer:    data.K9sVer,
+		K8sVer:    data.K8sVer,
+		CPU:       cpu,
+		MEM:       mem,
+		Latency:   latency,
+		Watches:   data.Watches,
+		Time:      time.Now().Format("15:04:05"),
+	}
+}
+
+var statusSections = map[string]func(StatusVars) string{
+	"k9s":       func(v StatusVars) string { return "[orange::b]K9s [aqua::]" + v.K9sVer },
+	"context":   func(v StatusVars) string { return "[aqua::b]" + v.Context },
+	"cluster":   func(v StatusVars) string { return "[white::]" + v.Cluster },
+	"namespace": func(v StatusVars) string { return "[fuchsia::]" + v.Namespace },
+	"user":      func(v StatusVars) string { return "[white::]" + v.User },
+	"k8s":       func(v StatusVars) string { return "[white::]" + v.K8sVer },
+	"cpu":       func(v StatusVars) string { return "[lawngreen::]" + v.CPU },
+	"mem":       func(v StatusVars) string { return "[darkturquoise::]" + v.MEM },
+	"latency":   func(v StatusVars) string { return "[khaki::]" + v.Latency },
+	"watches":   func(v StatusVars) string { return fmt.Sprintf("[white::]%d watches", v.Watches) },
+	"time":      func(v StatusVars) string { return "[gray::]" + v.Time },
+}
+
+// RenderStatusBar renders the status bar content given the user settings.
+func RenderStatusBar(sb *config.StatusBar, v StatusVars) string {
+	if sb.Template != "" {
+		tpl, err := template.New("statusBar").Parse(sb.Template)
+		if err == nil {
+			var buff strings.Builder
+			if err = tpl.Execute(&buff, v); err == nil {
+				return buff.String()
+			}
+		}
+		log.Error().Err(err).Msgf("Invalid status bar template")
+	}
+	if len(sb.Sections) > 0 {
+		ss := make([]string, 0, len(sb.Sections))
+		for _, n := range sb.Sections {
+			f, ok := statusSections[strings.ToLower(n)]
+			if !ok {
+				log.Warn().Msgf("Unknown status bar section %q", n)
+				continue
+			}
+			ss = append(ss, f(v))
+		}
+		return strings.Join(ss, " ")
+	}
+
+	return fmt.Sprintf(statusIndicatorFmt, v.K9sVer, v.Cluster, v.User, v.K8sVer, v.CPU, v.MEM)
+}
+
+func (s *StatusIndicator) statusBar() *config.StatusBar {
+	if s.app.Config == nil {
+		return config.NewStatusBar()
+	}
+
+	return s.app.Config.K9s.StatusBarConfig()
+}
+
 // ClusterInfoUpdated notifies the cluster meta was updated.
 func (s *StatusIndicator) ClusterInfoUpdated(data model.ClusterMeta) {
+	v := newStatusVars(data, render.PrintPerc(data.Cpu), render.PrintPerc(data.Mem))
 	s.app.QueueUpdateDraw(func() {
-		s.SetPermanent(fmt.Sprintf(
-			statusIndicatorFmt,
-			data.K9sVer,
-			data.Cluster,
-			data.User,
-			data.K8sVer,
-			render.PrintPerc(data.Cpu),
-			render.PrintPerc(data.Mem),
-		))
+		s.SetPermanent(RenderStatusBar(s.statusBar(), v))
 	})
 }
 
@@ -67,16 +145,9 @@ func (s *StatusIndicator) ClusterInfoChanged(prev, cur model.ClusterMeta) {
 	if !s.app.IsRunning() {
 		return
 	}
+	v := newStatusVars(cur, AsPercDelta(prev.Cpu, cur.Cpu), AsPercDelta(prev.Mem, cur.Mem))
 	s.app.QueueUpdateDraw(func() {
-		s.SetPermanent(fmt.Sprintf(
-			statusIndicatorFmt,
-			cur.K9sVer,
-			cur.Cluster,
-			cur.User,
-			cur.K8sVer,
-			AsPercDelta(prev.Cpu, cur.Cpu),
-			AsPercDelta(prev.Cpu, cur.Mem),
-		))
+		s.SetPermanent(RenderStatusBar(s.statusBar(), v))
 	})
 }
 
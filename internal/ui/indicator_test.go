@@ -37,3 +37,47 @@ func TestIndicatorErr(t *testing.T) {
 
 	assert.Equal(t, "[orangered::b] <Blee> \n", i.GetText(false))
 }
+
+func TestRenderStatusBar(t *testing.T) {
+	v := ui.StatusVars{
+		Context:   "ctx1",
+		Cluster:   "cl1",
+		Namespace: "ns1",
+		User:      "fred",
+		K9sVer:    "0.1.0",
+		K8sVer:    "1.26",
+		CPU:       "10%",
+		MEM:       "20%",
+		Latency:   "12ms",
+		Watches:   3,
+		Time:      "10:00:00",
+	}
+	uu := map[string]struct {
+		sb *config.StatusBar
+		e  string
+	}{
+		"default": {
+			sb: config.NewStatusBar(),
+			e:  "[orange::b]K9s [aqua::]0.1.0 [white::]cl1:fred:1.26 [lawngreen::]10%[white::]::[darkturquoise::]20%",
+		},
+		"template": {
+			sb: &config.StatusBar{Template: "{{.Context}}/{{.Namespace}} {{.Latency}} {{.Watches}} {{.Time}}"},
+			e:  "ctx1/ns1 12ms 3 10:00:00",
+		},
+		"bad-template": {
+			sb: &config.StatusBar{Template: "{{.Blee"},
+			e:  "[orange::b]K9s [aqua::]0.1.0 [white::]cl1:fred:1.26 [lawngreen::]10%[white::]::[darkturquoise::]20%",
+		},
+		"sections": {
+			sb: &config.StatusBar{Sections: []string{"context", "namespace", "blee", "watches"}},
+			e:  "[aqua::b]ctx1 [fuchsia::]ns1 [white::]3 watches",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, ui.RenderStatusBar(u.sb, v))
+		})
+	}
+}
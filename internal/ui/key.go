@@ -1,6 +1,10 @@
 package ui
 
-import "github.com/derailed/tcell/v2"
+import (
+	"fmt"
+
+	"github.com/derailed/tcell/v2"
+)
 
 func init() {
 	initKeys()
@@ -15,6 +19,7 @@ func initKeys() {
 	initStdKeys()
 	initShiftKeys()
 	initShiftNumKeys()
+	initAltNumKeys()
 }
 
 // Defines numeric keys for container actions.
@@ -109,6 +114,9 @@ const (
 	KeyShiftZ
 )
 
+// AltNumKeys tracks alt number keys as reported by AsKey.
+var AltNumKeys = map[int]tcell.Key{}
+
 // NumKeys tracks number keys.
 var NumKeys = map[int]tcell.Key{
 	0: Key0,
@@ -165,6 +173,14 @@ func initStdKeys() {
 	tcell.KeyNames[KeyZ] = "z"
 }
 
+func initAltNumKeys() {
+	for i := 0; i <= 9; i++ {
+		k := tcell.Key(int16('0'+i) * int16(tcell.ModAlt))
+		AltNumKeys[i] = k
+		tcell.KeyNames[k] = fmt.Sprintf("Alt-%d", i)
+	}
+}
+
 func initShiftNumKeys() {
 	tcell.KeyNames[KeyShift0] = "Shift-0"
 	tcell.KeyNames[KeyShift1] = "Shift-1"
@@ -126,8 +126,8 @@ func (m *Menu) buildMenuTable(hh model.MenuHints, table []model.MenuHints, colCo
 				col = 0
 			}
 		}
-		if maxKeys[col] < len(h.Mnemonic) {
-			maxKeys[col] = len(h.Mnemonic)
+		if w := runewidth.StringWidth(h.Mnemonic); maxKeys[col] < w {
+			maxKeys[col] = w
 		}
 		table[row][col] = h
 		row++
@@ -201,9 +201,9 @@ func formatNSMenu(i int, name string, styles config.Frame) string {
 }
 
 func formatPlainMenu(h model.MenuHint, size int, styles config.Frame) string {
-	menuFmt := " [key:-:b]%-" + strconv.Itoa(size+2) + "s [fg:-:d]%s "
+	menuFmt := " [key:-:b]%s [fg:-:d]%s "
 	fmat := strings.Replace(menuFmt, "[key", "["+styles.Menu.KeyColor.String(), 1)
 	fmat = strings.Replace(fmat, "[fg", "["+styles.Menu.FgColor.String(), 1)
 	fmat = strings.Replace(fmat, ":bg:", ":"+styles.Title.BgColor.String()+":", -1)
-	return fmt.Sprintf(fmat, toMnemonic(h.Mnemonic), h.Description)
+	return fmt.Sprintf(fmat, Pad(toMnemonic(h.Mnemonic), size+2), h.Description)
 }
@@ -1,10 +1,10 @@
 package ui
 
 import (
-	"strings"
 	"unicode"
 
 	"github.com/derailed/k9s/internal/render"
+	runewidth "github.com/mattn/go-runewidth"
 )
 
 // MaxyPad tracks uniform column padding.
@@ -15,16 +15,16 @@ func ComputeMaxColumns(pads MaxyPad, sortColName string, header render.Header, e
 	const colPadding = 1
 
 	for index, h := range header {
-		pads[index] = len(h.Name)
+		pads[index] = runewidth.StringWidth(h.Name)
 		if h.Name == sortColName {
-			pads[index] = len(h.Name) + 2
+			pads[index] += 2
 		}
 	}
 
 	var row int
 	for _, e := range ee {
 		for index, field := range e.Row.Fields {
-			width := len(field) + colPadding
+			width := runewidth.StringWidth(field) + colPadding
 			if index < len(pads) && width > pads[index] {
 				pads[index] = width
 			}
@@ -43,13 +43,7 @@ func IsASCII(s string) bool {
 	return true
 }
 
-// Pad a string up to the given length or truncates if greater than length.
+// Pad a string up to the given display width or truncates if wider.
 func Pad(s string, width int) string {
-	if len(s) == width {
-		return s
-	}
-	if len(s) > width {
-		return render.Truncate(s, width)
-	}
-	return s + strings.Repeat(" ", width-len(s))
+	return render.Pad(s, width)
 }
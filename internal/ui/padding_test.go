@@ -68,7 +68,21 @@ func TestMaxColumn(t *testing.T) {
 				},
 			},
 			"A",
-			MaxyPad{32, 6},
+			MaxyPad{30, 6},
+		},
+		"cjk": {
+			&render.TableData{
+				Header: render.Header{render.HeaderColumn{Name: "A"}, render.HeaderColumn{Name: "B"}},
+				RowEvents: render.RowEvents{
+					render.RowEvent{
+						Row: render.Row{
+							Fields: render.Fields{"你好世界", "world"},
+						},
+					},
+				},
+			},
+			"B",
+			MaxyPad{9, 6},
 		},
 	}
 
@@ -108,6 +122,9 @@ func TestPad(t *testing.T) {
 		{"fred", 10, "fred      "},
 		{"fred", 6, "fred  "},
 		{"fred", 4, "fred"},
+		{"你好", 6, "你好  "},
+		{"你好世界", 4, "你…"},
+		{"你好", 4, "你好"},
 	}
 
 	for _, u := range uu {
@@ -29,7 +29,7 @@ func NewPages() *Pages {
 func (p *Pages) IsTopDialog() bool {
 	_, pa := p.GetFrontPage()
 	switch pa.(type) {
-	case *tview.ModalForm:
+	case *tview.ModalForm, *Palette:
 		return true
 	default:
 		return false
@@ -0,0 +1,218 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+)
+
+const (
+	paletteWidth  = 80
+	paletteHeight = 20
+	paletteTitle  = " Commands "
+)
+
+// PaletteFunc represents a palette selection callback.
+type PaletteFunc func(cmd string)
+
+// Palette represents a fuzzy command finder.
+type Palette struct {
+	*tview.Flex
+
+	input    *tview.InputField
+	list     *tview.Table
+	frame    *tview.Flex
+	title    string
+	entries  model.PaletteEntries
+	matches  model.PaletteEntries
+	history  *model.History
+	search   string
+	hit      int
+	styles   *config.Styles
+	onSelect PaletteFunc
+	onCancel func()
+}
+
+// NewPalette returns a new command palette.
+func NewPalette(styles *config.Styles, entries model.PaletteEntries, history *model.History) *Palette {
+	p := Palette{
+		Flex:    tview.NewFlex(),
+		input:   tview.NewInputField(),
+		list:    tview.NewTable(),
+		entries: entries,
+		history: history,
+		hit:     -1,
+		title:   paletteTitle,
+		styles:  styles,
+	}
+	p.build()
+
+	return &p
+}
+
+// SetSelectedFunc sets the callback invoked when a command is picked.
+func (p *Palette) SetSelectedFunc(f PaletteFunc) {
+	p.onSelect = f
+}
+
+// SetCancelFunc sets the callback invoked when the palette is dismissed.
+func (p *Palette) SetCancelFunc(f func()) {
+	p.onCancel = f
+}
+
+// SetHeading sets the palette title.
+func (p *Palette) SetHeading(title string) {
+	p.title = " " + title + " "
+	p.frame.SetTitle(p.title)
+}
+
+// Input returns the palette input field.
+func (p *Palette) Input() *tview.InputField {
+	return p.input
+}
+
+// Matches returns the currently ranked entries.
+func (p *Palette) Matches() model.PaletteEntries {
+	return p.matches
+}
+
+// Focus delegates focus to the input field.
+func (p *Palette) Focus(delegate func(p tview.Primitive)) {
+	delegate(p.input)
+}
+
+// Filter ranks the entries for a given input.
+func (p *Palette) Filter(text string) {
+	q := text
+	if tokens := strings.Fields(text); len(tokens) > 0 {
+		q = tokens[0]
+	}
+	p.matches = p.entries.Rank(q, p.history.List())
+	p.refresh()
+}
+
+// Command returns the command to run for a given input. The first token is
+// replaced by the selected entry, any arguments are preserved. A command
+// recalled from history is used as is.
+func (p *Palette) Command(text string) string {
+	row, _ := p.list.GetSelection()
+	if p.hit >= 0 || row < 0 || row >= len(p.matches) {
+		return strings.TrimSpace(text)
+	}
+	tokens := strings.Fields(text)
+	if len(tokens) == 0 {
+		return p.matches[row].Command
+	}
+	tokens[0] = p.matches[row].Command
+
+	return strings.Join(tokens, " ")
+}
+
+func (p *Palette) build() {
+	d, t := p.styles.Dialog(), p.styles.Table()
+
+	p.input.SetLabel("> ")
+	p.input.SetLabelColor(d.LabelFgColor.Color())
+	p.input.SetFieldTextColor(d.FieldFgColor.Color())
+	p.input.SetFieldBackgroundColor(d.BgColor.Color())
+	p.input.SetBackgroundColor(d.BgColor.Color())
+	p.input.SetChangedFunc(p.Filter)
+	p.input.SetInputCapture(p.keyboard)
+
+	p.list.SetSelectable(true, false)
+	p.list.SetBackgroundColor(d.BgColor.Color())
+	p.list.SetSelectedStyle(tcell.StyleDefault.
+		Foreground(t.CursorFgColor.Color()).
+		Background(t.CursorBgColor.Color()))
+
+	frame := tview.NewFlex().SetDirection(tview.FlexRow)
+	frame.SetBorder(true)
+	frame.SetTitle(paletteTitle)
+	frame.SetBackgroundColor(d.BgColor.Color())
+	frame.SetBorderColor(p.styles.Frame().Border.FocusColor.Color())
+	frame.SetTitleColor(p.styles.Frame().Title.FgColor.Color())
+	frame.AddItem(p.input, 1, 1, true)
+	frame.AddItem(p.list, 0, 1, false)
+	p.frame = frame
+
+	row := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(nil, 0, 1, false).
+		AddItem(frame, paletteHeight, 1, true).
+		AddItem(nil, 0, 1, false)
+	p.AddItem(nil, 0, 1, false)
+	p.AddItem(row, paletteWidth, 1, true)
+	p.AddItem(nil, 0, 1, false)
+
+	p.Filter("")
+}
+
+func (p *Palette) refresh() {
+	fg, help := p.styles.Table().FgColor.Color(), p.styles.Dialog().FgColor.Color()
+	p.list.Clear()
+	for i, e := range p.matches {
+		p.list.SetCell(i, 0, tview.NewTableCell(e.Command).SetTextColor(fg).SetExpansion(1))
+		p.list.SetCell(i, 1, tview.NewTableCell(e.Kind).SetTextColor(help))
+		p.list.SetCell(i, 2, tview.NewTableCell(e.Help).SetTextColor(help).SetExpansion(2))
+	}
+	p.list.ScrollToBeginning()
+	p.list.Select(0, 0)
+}
+
+// ReverseSearch cycles through past commands matching the input, most recent first.
+func (p *Palette) ReverseSearch() bool {
+	if p.hit < 0 {
+		p.search = p.input.GetText()
+	}
+	i := p.history.Search(p.search, p.hit)
+	if i < 0 {
+		return false
+	}
+	p.hit = i
+	p.input.SetText(p.history.At(i))
+	p.frame.SetTitle(fmt.Sprintf("%s(reverse-i-search `%s`) ", p.title, p.search))
+
+	return true
+}
+
+func (p *Palette) keyboard(evt *tcell.EventKey) *tcell.EventKey {
+	if evt.Key() == tcell.KeyCtrlR {
+		p.ReverseSearch()
+		return nil
+	}
+	if evt.Key() != tcell.KeyEnter && p.hit >= 0 {
+		p.hit = -1
+		p.frame.SetTitle(p.title)
+	}
+	row, _ := p.list.GetSelection()
+	switch evt.Key() {
+	case tcell.KeyUp, tcell.KeyCtrlP:
+		if row > 0 {
+			p.list.Select(row-1, 0)
+		}
+		return nil
+	case tcell.KeyDown, tcell.KeyCtrlN:
+		if row < len(p.matches)-1 {
+			p.list.Select(row+1, 0)
+		}
+		return nil
+	case tcell.KeyTab:
+		p.input.SetText(p.Command(p.input.GetText()) + " ")
+		return nil
+	case tcell.KeyEnter:
+		if cmd := p.Command(p.input.GetText()); cmd != "" && p.onSelect != nil {
+			p.onSelect(cmd)
+		}
+		return nil
+	case tcell.KeyEscape:
+		if p.onCancel != nil {
+			p.onCancel()
+		}
+		return nil
+	}
+
+	return evt
+}
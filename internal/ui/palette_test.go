@@ -0,0 +1,72 @@
+package ui_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPaletteCommand(t *testing.T) {
+	ee := model.PaletteEntries{
+		{Command: "deploy", Kind: model.PaletteResource, Help: "apps/v1/deployments"},
+		{Command: "pod", Kind: model.PaletteResource, Help: "v1/pods"},
+		{Command: "help", Kind: model.PaletteCommand},
+	}
+
+	uu := map[string]struct {
+		text, e string
+		count   int
+	}{
+		"empty": {
+			e:     "pod",
+			count: 3,
+		},
+		"fuzzy": {
+			text:  "dpl",
+			e:     "deploy",
+			count: 1,
+		},
+		"args": {
+			text:  "dpl kube-system",
+			e:     "deploy kube-system",
+			count: 1,
+		},
+		"raw": {
+			text: "zorg fred",
+			e:    "zorg fred",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			h := model.NewHistory(model.MaxHistory)
+			h.Push("pod")
+			p := ui.NewPalette(config.NewStyles(), ee, h)
+			p.Filter(u.text)
+			assert.Equal(t, u.count, len(p.Matches()))
+			assert.Equal(t, u.e, p.Command(u.text))
+		})
+	}
+}
+
+func TestPaletteReverseSearch(t *testing.T) {
+	h := model.NewHistory(model.MaxHistory)
+	h.Set([]string{"po kube-system", "dp", "po default"})
+	ee := model.PaletteEntries{
+		{Command: "pod", Kind: model.PaletteResource, Help: "v1/pods"},
+	}
+	p := ui.NewPalette(config.NewStyles(), ee, h)
+	p.Input().SetText("po")
+
+	assert.True(t, p.ReverseSearch())
+	assert.Equal(t, "po kube-system", p.Input().GetText())
+	assert.Equal(t, "po kube-system", p.Command(p.Input().GetText()))
+	assert.True(t, p.ReverseSearch())
+	assert.Equal(t, "po default", p.Input().GetText())
+	assert.False(t, p.ReverseSearch())
+	assert.Equal(t, "po default", p.Input().GetText())
+}
@@ -0,0 +1,66 @@
+package ui
+
+import (
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+)
+
+const scrollModalMargin = 2
+
+// ScrollModal represents a modal form capped to the screen height. Forms
+// taller than the screen scroll to the focused item.
+type ScrollModal struct {
+	*tview.Frame
+
+	form *tview.Form
+	text string
+	done func()
+}
+
+// NewScrollModal returns a new modal.
+func NewScrollModal(title, text string, f *tview.Form) *ScrollModal {
+	f.SetBackgroundColor(tview.Styles.ContrastBackgroundColor).SetBorderPadding(0, 0, 0, 0)
+	m := ScrollModal{
+		Frame: tview.NewFrame(f).SetBorders(0, 0, 1, 0, 0, 0),
+		form:  f,
+		text:  text,
+	}
+	m.SetBorder(true).
+		SetBackgroundColor(tview.Styles.ContrastBackgroundColor).
+		SetBorderPadding(1, 1, 1, 1)
+	m.SetTitle(title)
+	m.SetTitleColor(tcell.ColorAqua)
+	f.SetCancelFunc(func() {
+		if m.done != nil {
+			m.done()
+		}
+	})
+
+	return &m
+}
+
+// SetDoneFunc sets a callback fired when the form is canceled.
+func (m *ScrollModal) SetDoneFunc(f func()) *ScrollModal {
+	m.done = f
+
+	return m
+}
+
+// Draw draws the modal centered on screen.
+func (m *ScrollModal) Draw(screen tcell.Screen) {
+	sw, sh := screen.Size()
+	width := sw / 3
+
+	m.Clear()
+	lines := tview.WordWrap(m.text, width)
+	for _, l := range lines {
+		m.AddText(l, true, tview.AlignCenter, tview.Styles.PrimaryTextColor)
+	}
+	height := len(lines) + m.form.GetFormItemCount() + m.form.GetButtonCount() + 5
+	if max := sh - scrollModalMargin; height > max {
+		height = max
+	}
+	width += 4
+	m.SetRect((sw-width)/2, (sh-height)/2, width, height)
+	m.Frame.Draw(screen)
+}
@@ -0,0 +1,47 @@
+package ui_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScrollModalDraw(t *testing.T) {
+	uu := map[string]struct {
+		items, h int
+	}{
+		"fits": {
+			items: 3,
+			h:     10,
+		},
+		"capped": {
+			items: 50,
+			h:     22,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			s := tcell.NewSimulationScreen("UTF-8")
+			assert.Nil(t, s.Init())
+			s.SetSize(90, 24)
+
+			f := tview.NewForm()
+			for i := 0; i < u.items; i++ {
+				f.AddInputField("v"+strconv.Itoa(i), "", 0, nil, nil)
+			}
+			f.AddButton("OK", nil)
+			m := ui.NewScrollModal("<Test>", "blee", f)
+			m.Draw(s)
+
+			_, _, w, h := m.GetRect()
+			assert.Equal(t, 34, w)
+			assert.Equal(t, u.h, h)
+		})
+	}
+}
@@ -1,6 +1,9 @@
 package ui
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/derailed/tcell/v2"
 	"github.com/derailed/tview"
 )
@@ -13,6 +16,11 @@ type SelectTable struct {
 	selectedFn func(string) string
 	marks      map[string]struct{}
 	fgColor    tcell.Color
+	announceFn func(string)
+	announced  string
+	rowFn      func(string)
+	// groupRows the number of group heading rows.
+	groupRows int
 }
 
 // SetModel sets the table model.
@@ -34,8 +42,21 @@ func (s *SelectTable) ClearSelection() {
 // SelectFirstRow select first data row if any.
 func (s *SelectTable) SelectFirstRow() {
 	if s.GetRowCount() > 0 {
-		s.Select(1, 0)
+		s.Select(s.selectableRow(1), 0)
+	}
+}
+
+// selectableRow returns the first selectable row at or past a given row ie
+// skipping group heading rows.
+func (s *SelectTable) selectableRow(r int) int {
+	for r > 0 && r < s.GetRowCount()-1 {
+		if cell := s.GetCell(r, 0); cell == nil || !cell.NotSelectable {
+			break
+		}
+		r++
 	}
+
+	return r
 }
 
 // GetSelectedItems return currently marked or selected items names.
@@ -103,11 +124,11 @@ func (s *SelectTable) SelectRow(r int, broadcast bool) {
 	if !broadcast {
 		s.SetSelectionChangedFunc(nil)
 	}
-	if c := s.model.Count(); c > 0 && r-1 > c {
+	if c := s.model.Count() + s.groupRows; c > 0 && r-1 > c {
 		r = c + 1
 	}
 	defer s.SetSelectionChangedFunc(s.selectionChanged)
-	s.Select(r, 0)
+	s.Select(s.selectableRow(r), 0)
 }
 
 // UpdateSelection refresh selected row.
@@ -121,8 +142,50 @@ func (s *SelectTable) selectionChanged(r, c int) {
 		return
 	}
 	if cell := s.GetCell(r, c); cell != nil {
-		s.SetSelectedStyle(tcell.StyleDefault.Foreground(s.fgColor).Background(cell.Color).Attributes(tcell.AttrBold))
+		s.SetSelectedStyle(cursorStyle(s.fgColor, cell.Color))
+	}
+	if s.announceFn != nil && r > 0 {
+		if id := fmt.Sprintf("%d:%v", r, s.GetCell(r, 0).GetReference()); id != s.announced {
+			s.announced = id
+			s.announceFn(s.describeRow(r))
+		}
+	}
+	if s.rowFn != nil && r > 0 {
+		s.rowFn(s.GetSelectedItem())
+	}
+}
+
+// SetRowChangedFn sets a function called with the selected item as the cursor moves.
+func (s *SelectTable) SetRowChangedFn(f func(string)) {
+	s.rowFn = f
+}
+
+// SetAnnounceFn sets a function to announce selection changes ie for screen readers.
+func (s *SelectTable) SetAnnounceFn(f func(string)) {
+	s.announceFn = f
+}
+
+func (s *SelectTable) describeRow(r int) string {
+	ff := make([]string, 0, s.GetColumnCount())
+	for c := 0; c < s.GetColumnCount(); c++ {
+		h, v := TrimCell(s, 0, c), TrimCell(s, r, c)
+		if idx := strings.Index(h, "["); idx > 0 {
+			h = h[:idx]
+		}
+		ff = append(ff, h+" "+v)
+	}
+
+	return fmt.Sprintf("Row %d of %d: %s", r, s.GetRowCount()-1, strings.Join(ff, ", "))
+}
+
+// cursorStyle returns the selected row style. Without colors, the row is
+// displayed in reverse video.
+func cursorStyle(fg, bg tcell.Color) tcell.Style {
+	if fg == tcell.ColorDefault && bg == tcell.ColorDefault {
+		return tcell.StyleDefault.Attributes(tcell.AttrReverse | tcell.AttrBold)
 	}
+
+	return tcell.StyleDefault.Foreground(fg).Background(bg).Attributes(tcell.AttrBold)
 }
 
 // ClearMarks delete all marked items.
@@ -0,0 +1,198 @@
+package ui
+
+import (
+	"fmt"
+	"html"
+	"strings"
+
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+)
+
+// snapCell represents a rendered screen cell.
+type snapCell struct {
+	text  string
+	style tcell.Style
+	skip  bool
+}
+
+// Snapshot represents the rendered content of a view.
+type Snapshot struct {
+	Title  string
+	Width  int
+	Height int
+	Fg, Bg tcell.Color
+	cells  []snapCell
+}
+
+// CaptureView renders a primitive offscreen given a size.
+func CaptureView(title string, p tview.Primitive, width, height int) (*Snapshot, error) {
+	screen := tcell.NewSimulationScreen("UTF-8")
+	if err := screen.Init(); err != nil {
+		return nil, err
+	}
+	defer screen.Fini()
+	screen.SetSize(width, height)
+
+	x, y, w, h := p.GetRect()
+	p.SetRect(0, 0, width, height)
+	p.Draw(screen)
+	p.SetRect(x, y, w, h)
+
+	s := Snapshot{
+		Title:  title,
+		Width:  width,
+		Height: height,
+		Fg:     tcell.ColorDefault,
+		Bg:     tcell.ColorDefault,
+		cells:  make([]snapCell, width*height),
+	}
+	for row := 0; row < height; row++ {
+		for col := 0; col < width; col++ {
+			c := &s.cells[row*width+col]
+			if c.skip {
+				continue
+			}
+			mainc, combc, style, cw := screen.GetContent(col, row)
+			if mainc == 0 {
+				mainc = ' '
+			}
+			c.text, c.style = string(append([]rune{mainc}, combc...)), style
+			for i := 1; i < cw && col+i < width; i++ {
+				s.cells[row*width+col+i].skip = true
+			}
+		}
+	}
+
+	return &s, nil
+}
+
+// HTML renders the snapshot as a styled html page.
+func (s *Snapshot) HTML() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "<!DOCTYPE html>\n<html>\n<head>\n<meta charset=\"utf-8\">\n<title>%s</title>\n</head>\n", html.EscapeString(s.Title))
+	fmt.Fprintf(&b, "<body style=\"margin:0;%s\">\n<pre style=\"margin:0;font-family:monospace;line-height:1.2\">\n", cssColors(s.Fg, s.Bg))
+	for row := 0; row < s.Height; row++ {
+		s.runs(row, func(text string, style tcell.Style) {
+			css := s.css(style)
+			if css == "" {
+				b.WriteString(html.EscapeString(text))
+				return
+			}
+			fmt.Fprintf(&b, "<span style=\"%s\">%s</span>", css, html.EscapeString(text))
+		})
+		b.WriteString("\n")
+	}
+	b.WriteString("</pre>\n</body>\n</html>\n")
+
+	return b.String()
+}
+
+// ANSI renders the snapshot as text with ANSI escape sequences.
+func (s *Snapshot) ANSI() string {
+	var b strings.Builder
+	for row := 0; row < s.Height; row++ {
+		s.runs(row, func(text string, style tcell.Style) {
+			b.WriteString(ansiStyle(style))
+			b.WriteString(text)
+		})
+		b.WriteString("\x1b[0m\n")
+	}
+
+	return b.String()
+}
+
+// runs groups the cells of a row sharing the same style.
+func (s *Snapshot) runs(row int, fn func(string, tcell.Style)) {
+	var (
+		text  strings.Builder
+		style tcell.Style
+	)
+	for col := 0; col < s.Width; col++ {
+		c := s.cells[row*s.Width+col]
+		if c.skip {
+			continue
+		}
+		if text.Len() > 0 && c.style != style {
+			fn(text.String(), style)
+			text.Reset()
+		}
+		style = c.style
+		text.WriteString(c.text)
+	}
+	if text.Len() > 0 {
+		fn(text.String(), style)
+	}
+}
+
+func (s *Snapshot) css(style tcell.Style) string {
+	fg, bg, attrs := style.Decompose()
+	if attrs&tcell.AttrReverse != 0 {
+		if fg == tcell.ColorDefault {
+			fg = s.Fg
+		}
+		if bg == tcell.ColorDefault {
+			bg = s.Bg
+		}
+		fg, bg = bg, fg
+	}
+	css := cssColors(fg, bg)
+	if attrs&tcell.AttrBold != 0 {
+		css += "font-weight:bold;"
+	}
+	if attrs&tcell.AttrDim != 0 {
+		css += "opacity:0.6;"
+	}
+	if attrs&tcell.AttrItalic != 0 {
+		css += "font-style:italic;"
+	}
+	switch {
+	case attrs&tcell.AttrUnderline != 0:
+		css += "text-decoration:underline;"
+	case attrs&tcell.AttrStrikeThrough != 0:
+		css += "text-decoration:line-through;"
+	}
+
+	return css
+}
+
+func cssColors(fg, bg tcell.Color) string {
+	var css string
+	if v := fg.Hex(); v >= 0 {
+		css += fmt.Sprintf("color:#%06x;", v)
+	}
+	if v := bg.Hex(); v >= 0 {
+		css += fmt.Sprintf("background-color:#%06x;", v)
+	}
+
+	return css
+}
+
+func ansiStyle(style tcell.Style) string {
+	fg, bg, attrs := style.Decompose()
+	codes := []string{"0"}
+	for _, a := range []struct {
+		mask tcell.AttrMask
+		code string
+	}{
+		{tcell.AttrBold, "1"},
+		{tcell.AttrDim, "2"},
+		{tcell.AttrItalic, "3"},
+		{tcell.AttrUnderline, "4"},
+		{tcell.AttrBlink, "5"},
+		{tcell.AttrReverse, "7"},
+		{tcell.AttrStrikeThrough, "9"},
+	} {
+		if attrs&a.mask != 0 {
+			codes = append(codes, a.code)
+		}
+	}
+	if r, g, b := fg.RGB(); r >= 0 {
+		codes = append(codes, fmt.Sprintf("38;2;%d;%d;%d", r, g, b))
+	}
+	if r, g, b := bg.RGB(); r >= 0 {
+		codes = append(codes, fmt.Sprintf("48;2;%d;%d;%d", r, g, b))
+	}
+
+	return "\x1b[" + strings.Join(codes, ";") + "m"
+}
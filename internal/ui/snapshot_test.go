@@ -0,0 +1,34 @@
+package ui_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCaptureView(t *testing.T) {
+	v := tview.NewTextView()
+	v.SetDynamicColors(true)
+	v.SetText("[red::b]fred[-::-] <b>")
+
+	s, err := ui.CaptureView("pods", v, 10, 2)
+	assert.Nil(t, err)
+	s.Bg = tcell.ColorBlack
+
+	h := s.HTML()
+	assert.Contains(t, h, "<title>pods</title>")
+	assert.Contains(t, h, "background-color:#000000;")
+	assert.Contains(t, h, `<span style="color:#ff0000;`)
+	assert.Contains(t, h, `font-weight:bold;">fred</span>`)
+	assert.Contains(t, h, "&lt;b&gt;")
+	assert.Equal(t, 2, strings.Count(h, "</span>\n"))
+
+	a := s.ANSI()
+	assert.Contains(t, a, "\x1b[0;1;38;2;255;0;0;")
+	assert.Contains(t, a, "mfred\x1b[")
+	assert.Equal(t, 2, strings.Count(a, "\x1b[0m\n"))
+}
@@ -5,10 +5,12 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/tcell/v2"
@@ -25,10 +27,32 @@ type (
 	// DecorateFunc represents a row decorator.
 	DecorateFunc func(*render.TableData)
 
+	// ColorOverrideFunc returns a row color if it should be overridden.
+	ColorOverrideFunc func(ns string, h render.Header, re render.RowEvent) (tcell.Color, bool)
+
 	// SelectedRowFunc a table selection callback.
 	SelectedRowFunc func(r int)
+
+	// GroupFunc partitions sorted rows into headed groups.
+	GroupFunc func(render.Header, render.RowEvents) []RowGroup
+
+	// moreRow references the row loading the next chunk of a partial listing.
+	moreRow struct{}
+
+	// groupRow references a group heading row.
+	groupRow struct{}
 )
 
+// RowGroup represents rows listed under a common heading row.
+type RowGroup struct {
+	// Heading the heading row fields, aligned on the table header. No heading
+	// row is shown when empty.
+	Heading render.Fields
+
+	// Rows the group rows.
+	Rows render.RowEvents
+}
+
 // Table represents tabular data.
 type Table struct {
 	gvr     client.GVR
@@ -43,9 +67,16 @@ type Table struct {
 	viewSetting *config.ViewSetting
 	colorerFn   render.ColorerFunc
 	decorateFn  DecorateFunc
+	transformFn DecorateFunc
+	groupFn     GroupFunc
+	overrideFn  ColorOverrideFunc
+	rendered    *render.TableData
 	wide        bool
 	toast       bool
+	fuzzy       bool
 	hasMetrics  bool
+	page        *dao.Page
+	stale       time.Time
 }
 
 // NewTable returns a new table view.
@@ -71,6 +102,7 @@ func (t *Table) Init(ctx context.Context) {
 	t.SetBorderPadding(0, 0, 1, 1)
 	t.SetSelectable(true, false)
 	t.SetSelectionChangedFunc(t.selectionChanged)
+	t.SetMouseCapture(t.mouse)
 	t.SetBackgroundColor(tcell.ColorDefault)
 	t.Select(1, 0)
 	if cfg, ok := ctx.Value(internal.KeyViewConfig).(*config.CustomView); ok && cfg != nil {
@@ -86,15 +118,21 @@ func (t *Table) GVR() client.GVR { return t.gvr }
 // ViewSettingsChanged notifies listener the view configuration changed.
 func (t *Table) ViewSettingsChanged(settings config.ViewSetting) {
 	t.viewSetting = &settings
+	t.GetModel().SetCustomColumns(render.NewCustomColumns(settings.Columns))
 	t.Refresh()
 }
 
+// ViewSetting returns the current view setting if any.
+func (t *Table) ViewSetting() *config.ViewSetting {
+	return t.viewSetting
+}
+
 // StylesChanged notifies the skin changed.
 func (t *Table) StylesChanged(s *config.Styles) {
 	t.SetBackgroundColor(s.Table().BgColor.Color())
 	t.SetBorderColor(s.Frame().Border.FgColor.Color())
 	t.SetBorderFocusColor(s.Frame().Border.FocusColor.Color())
-	t.SetSelectedStyle(tcell.StyleDefault.Foreground(t.styles.Table().CursorFgColor.Color()).Background(t.styles.Table().CursorBgColor.Color()).Attributes(tcell.AttrBold))
+	t.SetSelectedStyle(cursorStyle(t.styles.Table().CursorFgColor.Color(), t.styles.Table().CursorBgColor.Color()))
 	t.fgColor = s.Table().CursorFgColor.Color()
 	t.Refresh()
 }
@@ -169,22 +207,58 @@ func (t *Table) SetDecorateFn(f DecorateFunc) {
 	t.decorateFn = f
 }
 
+// SetTransformFn specifies a function customizing the data once decorated.
+func (t *Table) SetTransformFn(f DecorateFunc) {
+	t.transformFn = f
+}
+
+// SetGroupFn specifies a function grouping rows under heading rows.
+func (t *Table) SetGroupFn(f GroupFunc) {
+	t.groupFn = f
+}
+
+// SetColorOverrideFn specifies a function overriding rows colors.
+func (t *Table) SetColorOverrideFn(f ColorOverrideFunc) {
+	t.overrideFn = f
+}
+
 // SetColorerFn specifies the default colorer.
 func (t *Table) SetColorerFn(f render.ColorerFunc) {
 	t.colorerFn = f
 }
 
+// SetFuzzyFilter makes plain filters fuzzy matches instead of regular expressions.
+func (t *Table) SetFuzzyFilter(b bool) {
+	t.fuzzy = b
+}
+
 // SetSortCol sets in sort column index and order.
 func (t *Table) SetSortCol(name string, asc bool) {
 	t.sortCol.name, t.sortCol.asc = name, asc
 }
 
+// SortColumn returns the current sort column, ie AGE:desc.
+func (t *Table) SortColumn() string {
+	if t.sortCol.name == "" {
+		return ""
+	}
+	if t.sortCol.asc {
+		return t.sortCol.name + ":asc"
+	}
+
+	return t.sortCol.name + ":desc"
+}
+
 // Update table content.
 func (t *Table) Update(data *render.TableData, hasMetrics bool) {
 	t.header = data.Header
 	if t.decorateFn != nil {
 		t.decorateFn(data)
 	}
+	if t.transformFn != nil {
+		t.transformFn(data)
+		t.header = data.Header
+	}
 	t.hasMetrics = hasMetrics
 	t.doUpdate(t.filtered(data))
 	t.UpdateTitle()
@@ -199,7 +273,9 @@ func (t *Table) doUpdate(data *render.TableData) {
 
 	cols := t.header.Columns(t.wide)
 	if t.viewSetting != nil && len(t.viewSetting.Columns) > 0 {
-		cols = t.viewSetting.Columns
+		if cc, ok := layoutColumns(t.viewSetting.Columns); ok {
+			cols = cc
+		}
 	}
 	custData := data.Customize(cols, t.wide)
 	if t.viewSetting != nil && t.viewSetting.SortColumn != "" {
@@ -229,10 +305,7 @@ func (t *Table) doUpdate(data *render.TableData) {
 
 	var col int
 	for _, h := range custData.Header {
-		if h.Name == "NAMESPACE" && !t.GetModel().ClusterWide() {
-			continue
-		}
-		if h.MX && !t.hasMetrics {
+		if !t.isVisible(h) {
 			continue
 		}
 		t.AddHeaderCell(col, h)
@@ -246,24 +319,104 @@ func (t *Table) doUpdate(data *render.TableData) {
 		custData.Namespace,
 		colIndex,
 		custData.Header.IsTimeCol(colIndex),
-		custData.Header.IsMetricsCol(colIndex),
+		custData.Header.IsMetricsCol(colIndex) || custData.Header.IsNumericCol(colIndex),
 		t.sortCol.asc,
 	)
 
+	t.rendered = custData
+
 	pads := make(MaxyPad, len(custData.Header))
 	ComputeMaxColumns(pads, t.sortCol.name, custData.Header, custData.RowEvents)
-	for row, re := range custData.RowEvents {
-		idx, _ := data.RowEvents.FindIndex(re.Row.ID)
-		t.buildRow(row+1, re, data.RowEvents[idx], custData.Header, pads)
+	groups := []RowGroup{{Rows: custData.RowEvents}}
+	if t.groupFn != nil {
+		groups = t.groupFn(custData.Header, custData.RowEvents)
+	}
+	row := 1
+	t.groupRows = 0
+	for _, g := range groups {
+		if len(g.Heading) > 0 {
+			t.buildGroupRow(row, g.Heading, custData.Header)
+			row, t.groupRows = row+1, t.groupRows+1
+		}
+		for _, re := range g.Rows {
+			idx, _ := data.RowEvents.FindIndex(re.Row.ID)
+			t.buildRow(row, re, data.RowEvents[idx], custData.Header, pads)
+			row++
+		}
+	}
+	t.page = nil
+	if p, ok := t.GetModel().(Pager); ok {
+		if t.page = p.Page(); t.page != nil {
+			t.addMoreRow(row, t.GetModel().Count())
+		}
 	}
 	t.updateSelection(true)
 }
 
+// addMoreRow appends a row loading the next chunk of a partial listing.
+func (t *Table) addMoreRow(r, loaded int) {
+	msg := fmt.Sprintf("<enter> to load more... (%d loaded", loaded)
+	if t.page.Remaining >= 0 {
+		msg += fmt.Sprintf(", ~%d remaining", t.page.Remaining)
+	}
+	c := tview.NewTableCell(msg + ")")
+	c.SetReference(moreRow{})
+	c.SetTextColor(t.styles.Table().FgColor.Color())
+	c.SetBackgroundColor(t.styles.Table().BgColor.Color())
+	c.SetAttributes(tcell.AttrDim)
+	c.SetExpansion(1)
+	t.SetCell(r, 0, c)
+}
+
+// buildGroupRow adds a non selectable group heading row.
+func (t *Table) buildGroupRow(r int, ff render.Fields, h render.Header) {
+	var col int
+	for c, field := range ff {
+		if c >= len(h) || !t.isVisible(h[c]) {
+			continue
+		}
+		cell := tview.NewTableCell(field)
+		cell.SetExpansion(1)
+		cell.SetAlign(h[c].Align)
+		cell.SetTextColor(render.HighlightColor)
+		cell.SetAttributes(tcell.AttrBold)
+		cell.SetSelectable(false)
+		if col == 0 {
+			cell.SetReference(groupRow{})
+		}
+		t.SetCell(r, col, cell)
+		col++
+	}
+}
+
+// SetStale marks the table content as cached since a given time. A zero
+// time denotes live content.
+func (t *Table) SetStale(since time.Time) {
+	t.stale = since
+	t.UpdateTitle()
+}
+
+// IsMoreSelected checks if the row loading more resources is selected.
+func (t *Table) IsMoreSelected() bool {
+	if t.page == nil {
+		return false
+	}
+	c := t.GetCell(t.GetSelectedRowIndex(), 0)
+	_, ok := c.GetReference().(moreRow)
+
+	return ok
+}
+
 func (t *Table) buildRow(r int, re, ore render.RowEvent, h render.Header, pads MaxyPad) {
 	color := render.DefaultColorer
 	if t.colorerFn != nil {
 		color = t.colorerFn
 	}
+	if t.overrideFn != nil {
+		if c, ok := t.overrideFn(t.GetModel().GetNamespace(), t.header, ore); ok {
+			color = func(string, render.Header, render.RowEvent) tcell.Color { return c }
+		}
+	}
 
 	marked := t.IsMarked(re.Row.ID)
 	var col int
@@ -307,6 +460,43 @@ func (t *Table) buildRow(r int, re, ore render.RowEvent, h render.Header, pads M
 	}
 }
 
+// RenderedData returns the table as currently displayed ie filtered, sorted
+// and restricted to the visible columns.
+func (t *Table) RenderedData() *render.TableData {
+	data := render.NewTableData()
+	if t.rendered == nil {
+		return data
+	}
+	data.Namespace = t.rendered.Namespace
+	idx := make([]int, 0, len(t.rendered.Header))
+	for i, h := range t.rendered.Header {
+		if t.isVisible(h) {
+			idx = append(idx, i)
+			data.Header = append(data.Header, h)
+		}
+	}
+	data.RowEvents = make(render.RowEvents, 0, len(t.rendered.RowEvents))
+	for _, re := range t.rendered.RowEvents {
+		ff := make(render.Fields, 0, len(idx))
+		for _, i := range idx {
+			if i < len(re.Row.Fields) {
+				ff = append(ff, re.Row.Fields[i])
+			}
+		}
+		data.RowEvents = append(data.RowEvents, render.RowEvent{Kind: re.Kind, Row: render.Row{ID: re.Row.ID, Fields: ff}})
+	}
+
+	return data
+}
+
+func (t *Table) isVisible(h render.HeaderColumn) bool {
+	if h.Name == "NAMESPACE" && !t.GetModel().ClusterWide() {
+		return false
+	}
+
+	return !h.MX || t.hasMetrics
+}
+
 // SortColCmd designates a sorted column.
 func (t *Table) SortColCmd(name string, asc bool) func(evt *tcell.EventKey) *tcell.EventKey {
 	return func(evt *tcell.EventKey) *tcell.EventKey {
@@ -372,15 +562,39 @@ func (t *Table) AddHeaderCell(col int, h render.HeaderColumn) {
 	c := tview.NewTableCell(sortIndicator(sortCol, t.sortCol.asc, t.styles.Table(), h.Name))
 	c.SetExpansion(1)
 	c.SetAlign(h.Align)
+	c.SetClickedFunc(func() bool {
+		t.SortColCmd(h.Name, true)(nil)
+		return true
+	})
 	t.SetCell(0, col, c)
 }
 
+// mouse runs the default enter action on a row double click.
+func (t *Table) mouse(action tview.MouseAction, evt *tcell.EventMouse) (tview.MouseAction, *tcell.EventMouse) {
+	if action != tview.MouseLeftDoubleClick || !t.InRect(evt.Position()) {
+		return action, evt
+	}
+	if _, y := evt.Position(); y <= t.headerY() {
+		return action, evt
+	}
+	if a, ok := t.actions[tcell.KeyEnter]; ok {
+		a.Action(tcell.NewEventKey(tcell.KeyEnter, 0, tcell.ModNone))
+	}
+
+	return action, nil
+}
+
+func (t *Table) headerY() int {
+	_, y, _, _ := t.GetInnerRect()
+	return y
+}
+
 func (t *Table) filtered(data *render.TableData) *render.TableData {
 	filtered := data
 	if t.toast {
 		filtered = filterToast(data)
 	}
-	if t.cmdBuff.Empty() || IsLabelSelector(t.cmdBuff.GetText()) {
+	if t.cmdBuff.Empty() || IsSelector(t.cmdBuff.GetText()) {
 		return filtered
 	}
 
@@ -388,6 +602,9 @@ func (t *Table) filtered(data *render.TableData) *render.TableData {
 	if IsFuzzySelector(q) {
 		return fuzzyFilter(q[2:], filtered)
 	}
+	if t.fuzzy && !IsInverseSelector(q) {
+		return fuzzyFilter(q, filtered)
+	}
 
 	filtered, err := rxFilter(q, IsInverseSelector(q), filtered)
 	if err != nil {
@@ -422,6 +639,10 @@ func (t *Table) styleTitle() string {
 	if rc > 0 {
 		rc--
 	}
+	if t.page != nil && rc > 0 {
+		rc--
+	}
+	rc -= t.groupRows
 
 	base := cases.Title(language.Und, cases.NoLower).String(t.gvr.R())
 	ns := t.GetModel().GetNamespace()
@@ -447,13 +668,43 @@ func (t *Table) styleTitle() string {
 		title = SkinTitle(fmt.Sprintf(NSTitleFmt, base, ns, rc), t.styles.Frame())
 	}
 
+	if t.page != nil {
+		more := "?"
+		if t.page.Remaining >= 0 {
+			more = fmt.Sprintf("~%d", t.page.Remaining)
+		}
+		title += SkinTitle(fmt.Sprintf(PartialFmt, more), t.styles.Frame())
+	}
+	if !t.stale.IsZero() {
+		title += SkinTitle(fmt.Sprintf(StaleFmt, t.stale.Format(time.Kitchen)), t.styles.Frame())
+	}
+
 	buff := t.cmdBuff.GetText()
 	if buff == "" {
 		return title
 	}
-	if IsLabelSelector(buff) {
+	switch {
+	case IsLabelSelector(buff):
 		buff = TrimLabelSelector(buff)
+	case IsFieldSelector(buff):
+		buff = TrimFieldSelector(buff)
 	}
 
 	return title + SkinTitle(fmt.Sprintf(SearchFmt, buff), t.styles.Frame())
 }
+
+// layoutColumns returns the column names of a custom layout. When the layout
+// only defines custom columns, these are appended to the default ones.
+func layoutColumns(layout []string) ([]string, bool) {
+	cols := make([]string, 0, len(layout))
+	var plain bool
+	for _, l := range layout {
+		if c, ok := render.ParseCustomColumn(l); ok {
+			cols = append(cols, c.Name)
+			continue
+		}
+		cols, plain = append(cols, l), true
+	}
+
+	return cols, plain
+}
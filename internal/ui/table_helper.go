@@ -20,15 +20,18 @@ const (
 	// SearchFmt represents a filter view title.
 	SearchFmt = "<[filter:bg:r]/%s[fg:bg:-]> "
 
+	// PartialFmt represents a partial listing title.
+	PartialFmt = "<[count:bg:b]%s[fg:bg:-] more> "
+
+	// StaleFmt represents a cached content title.
+	StaleFmt = "<[hilite:bg:b]stale since %s[fg:bg:-]> "
+
 	// NSTitleFmt represents a namespaced view title.
 	NSTitleFmt = "[fg:bg:b] %s([hilite:bg:b]%s[fg:bg:-])[fg:bg:-][[count:bg:b]%d[fg:bg:-]][fg:bg:-] "
 
 	// TitleFmt represents a standard view title.
 	TitleFmt = "[fg:bg:b] %s[fg:bg:-][[count:bg:b]%d[fg:bg:-]][fg:bg:-] "
 
-	descIndicator = "↓"
-	ascIndicator  = "↑"
-
 	// FullFmat specifies a namespaced dump file name.
 	FullFmat = "%s-%s-%d.csv"
 
@@ -37,12 +40,18 @@ const (
 )
 
 var (
+	descIndicator = "↓"
+	ascIndicator  = "↑"
+
 	// LabelRx identifies a label query.
 	LabelRx = regexp.MustCompile(`\A\-l`)
 
 	inverseRx = regexp.MustCompile(`\A\!`)
 
 	fuzzyRx = regexp.MustCompile(`\A\-f`)
+
+	// fieldRx identifies a field query ie -f spec.nodeName=n1,status.phase!=Running.
+	fieldRx = regexp.MustCompile(`\A\-f\s*[\w-]+(\.[\w-]+)+\s*(==|!=|=)`)
 )
 
 func mustExtractStyles(ctx context.Context) *config.Styles {
@@ -71,12 +80,25 @@ func IsLabelSelector(s string) bool {
 	return LabelRx.MatchString(s)
 }
 
+// IsFieldSelector checks if query is a field query.
+func IsFieldSelector(s string) bool {
+	if s == "" {
+		return false
+	}
+	return fieldRx.MatchString(s)
+}
+
+// IsSelector checks if query is resolved by the api server.
+func IsSelector(s string) bool {
+	return IsLabelSelector(s) || IsFieldSelector(s)
+}
+
 // IsFuzzySelector checks if query is fuzzy.
 func IsFuzzySelector(s string) bool {
 	if s == "" {
 		return false
 	}
-	return fuzzyRx.MatchString(s)
+	return fuzzyRx.MatchString(s) && !IsFieldSelector(s)
 }
 
 // IsInverseSelector checks if inverse char has been provided.
@@ -92,6 +114,11 @@ func TrimLabelSelector(s string) string {
 	return strings.TrimSpace(s[2:])
 }
 
+// TrimFieldSelector extracts field query.
+func TrimFieldSelector(s string) string {
+	return strings.Join(strings.Fields(s[2:]), "")
+}
+
 // SkinTitle decorates a title.
 func SkinTitle(fmat string, style config.Frame) string {
 	bgColor := style.Title.BgColor
@@ -121,11 +148,7 @@ func sortIndicator(sort, asc bool, style config.Table, name string) string {
 }
 
 func formatCell(field string, padding int) string {
-	if IsASCII(field) {
-		return Pad(field, padding)
-	}
-
-	return field
+	return Pad(field, padding)
 }
 
 func filterToast(data *render.TableData) *render.TableData {
@@ -148,6 +171,19 @@ func filterToast(data *render.TableData) *render.TableData {
 	return &toast
 }
 
+// FilterTable filters table rows given a regex, inverse or fuzzy query.
+// Label and field selectors are resolved by the model and left untouched.
+func FilterTable(q string, data *render.TableData) (*render.TableData, error) {
+	if q == "" || IsSelector(q) {
+		return data, nil
+	}
+	if IsFuzzySelector(q) {
+		return fuzzyFilter(q[2:], data), nil
+	}
+
+	return rxFilter(q, IsInverseSelector(q), data)
+}
+
 func rxFilter(q string, inverse bool, data *render.TableData) (*render.TableData, error) {
 	if inverse {
 		q = q[1:]
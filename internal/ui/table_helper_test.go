@@ -3,6 +3,7 @@ package ui
 import (
 	"testing"
 
+	"github.com/derailed/k9s/internal/render"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -40,3 +41,98 @@ func TestTrimLabelSelector(t *testing.T) {
 		})
 	}
 }
+
+func TestIsFieldSelector(t *testing.T) {
+	uu := map[string]struct {
+		sel string
+		e   bool
+	}{
+		"cool":     {"-f spec.nodeName=n1", true},
+		"noSpace":  {"-fstatus.phase!=Running", true},
+		"equals":   {"-f metadata.name==fred", true},
+		"noDot":    {"-f fred", false},
+		"noOp":     {"-f spec.nodeName", false},
+		"noMode":   {"spec.nodeName=n1", false},
+		"labelSel": {"-l app=fred", false},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, IsFieldSelector(u.sel))
+		})
+	}
+}
+
+func TestIsFuzzySelector(t *testing.T) {
+	uu := map[string]struct {
+		sel string
+		e   bool
+	}{
+		"cool":     {"-f fred", true},
+		"noSpace":  {"-ffred", true},
+		"fieldSel": {"-f spec.nodeName=n1", false},
+		"noMode":   {"fred", false},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, IsFuzzySelector(u.sel))
+		})
+	}
+}
+
+func TestTrimFieldSelector(t *testing.T) {
+	uu := map[string]struct {
+		sel, e string
+	}{
+		"cool":     {"-f spec.nodeName=n1", "spec.nodeName=n1"},
+		"noSpace":  {"-fstatus.phase!=Running", "status.phase!=Running"},
+		"multiple": {"-f spec.nodeName = n1, status.phase=Running", "spec.nodeName=n1,status.phase=Running"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, TrimFieldSelector(u.sel))
+		})
+	}
+}
+
+func TestFilterTable(t *testing.T) {
+	data := render.TableData{
+		Header: render.Header{{Name: "NAME"}, {Name: "STATUS"}},
+		RowEvents: render.RowEvents{
+			{Row: render.Row{ID: "default/fred", Fields: render.Fields{"fred", "Running"}}},
+			{Row: render.Row{ID: "default/blee", Fields: render.Fields{"blee", "Pending"}}},
+			{Row: render.Row{ID: "default/zorg", Fields: render.Fields{"zorg", "Running"}}},
+		},
+	}
+
+	uu := map[string]struct {
+		q   string
+		e   []string
+		err bool
+	}{
+		"none":    {q: "", e: []string{"fred", "blee", "zorg"}},
+		"labels":  {q: "-l app=fred", e: []string{"fred", "blee", "zorg"}},
+		"rx":      {q: "running", e: []string{"fred", "zorg"}},
+		"inverse": {q: "!running", e: []string{"blee"}},
+		"fuzzy":   {q: "-f zrg", e: []string{"zorg"}},
+		"bad":     {q: "fred(", e: []string{"fred", "blee", "zorg"}, err: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			d, err := FilterTable(u.q, &data)
+			assert.Equal(t, u.err, err != nil)
+			nn := make([]string, 0, len(d.RowEvents))
+			for _, re := range d.RowEvents {
+				nn = append(nn, re.Row.Fields[0])
+			}
+			assert.Equal(t, u.e, nn)
+		})
+	}
+}
@@ -12,6 +12,8 @@ import (
 	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
 	"github.com/stretchr/testify/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -35,6 +37,19 @@ func TestTableUpdate(t *testing.T) {
 	assert.Equal(t, len(data.Header), v.GetColumnCount())
 }
 
+func TestTableStale(t *testing.T) {
+	v := ui.NewTable(client.NewGVR("fred"))
+	v.Init(makeContext())
+	v.Update(makeTableData(), false)
+
+	since := time.Date(2023, 1, 1, 10, 30, 0, 0, time.Local)
+	v.SetStale(since)
+	assert.Contains(t, v.GetTitle(), "stale since 10:30AM")
+
+	v.SetStale(time.Time{})
+	assert.NotContains(t, v.GetTitle(), "stale since")
+}
+
 func TestTableSelection(t *testing.T) {
 	v := ui.NewTable(client.NewGVR("fred"))
 	v.Init(makeContext())
@@ -56,6 +71,127 @@ func TestTableSelection(t *testing.T) {
 	assert.Equal(t, 1, v.GetSelectedRowIndex())
 }
 
+func TestTableGroups(t *testing.T) {
+	v := ui.NewTable(client.NewGVR("fred"))
+	v.Init(makeContext())
+	m := &mockModel{}
+	v.SetModel(m)
+	v.SetGroupFn(func(h render.Header, rr render.RowEvents) []ui.RowGroup {
+		gg := make([]ui.RowGroup, 0, len(rr))
+		for _, re := range rr {
+			gg = append(gg, ui.RowGroup{
+				Heading: render.Fields{"group " + re.Row.ID, "", ""},
+				Rows:    render.RowEvents{re},
+			})
+		}
+		return gg
+	})
+	v.Update(m.Peek(), false)
+
+	assert.Equal(t, 5, v.GetRowCount())
+	assert.Equal(t, "group r1", ui.TrimCell(v.SelectTable, 1, 0))
+	assert.Equal(t, "group r2", ui.TrimCell(v.SelectTable, 3, 0))
+	assert.Contains(t, v.GetTitle(), ":b]2[")
+
+	v.SelectFirstRow()
+	assert.Equal(t, 2, v.GetSelectedRowIndex())
+	assert.Equal(t, "r1", v.GetSelectedItem())
+
+	v.SelectRow(3, true)
+	assert.Equal(t, 4, v.GetSelectedRowIndex())
+	assert.Equal(t, "r2", v.GetSelectedItem())
+}
+
+func TestTableMouse(t *testing.T) {
+	v := ui.NewTable(client.NewGVR("fred"))
+	v.Init(makeContext())
+	m := &mockModel{}
+	v.SetModel(m)
+	v.Update(m.Peek(), false)
+	v.SetRect(0, 0, 80, 10)
+	scr := tcell.NewSimulationScreen("")
+	assert.Nil(t, scr.Init())
+	v.Draw(scr)
+
+	var entered bool
+	v.Actions().Add(ui.KeyActions{
+		tcell.KeyEnter: ui.NewKeyAction("View", func(*tcell.EventKey) *tcell.EventKey {
+			entered = true
+			return nil
+		}, false),
+	})
+	handler := v.MouseHandler()
+
+	handler(tview.MouseLeftDoubleClick, tcell.NewEventMouse(5, 1, tcell.ButtonPrimary, tcell.ModNone), func(tview.Primitive) {})
+	assert.False(t, entered)
+	handler(tview.MouseLeftDoubleClick, tcell.NewEventMouse(5, 2, tcell.ButtonPrimary, tcell.ModNone), func(tview.Primitive) {})
+	assert.True(t, entered)
+
+	handler(tview.MouseLeftClick, tcell.NewEventMouse(3, 1, tcell.ButtonPrimary, tcell.ModNone), func(tview.Primitive) {})
+	assert.Equal(t, "A:asc", v.SortColumn())
+}
+
+func TestTableRenderedData(t *testing.T) {
+	v := ui.NewTable(client.NewGVR("fred"))
+	v.Init(makeContext())
+	m := &mockModel{}
+	v.SetModel(m)
+	v.SetSortCol("C", false)
+	v.Update(m.Peek(), false)
+
+	data := v.RenderedData()
+	assert.Equal(t, 3, len(data.Header))
+	assert.Equal(t, 2, len(data.RowEvents))
+	assert.Equal(t, render.Fields{"blee", "duh", "zorg"}, data.RowEvents[0].Row.Fields)
+}
+
+func TestTableAnnounce(t *testing.T) {
+	v := ui.NewTable(client.NewGVR("fred"))
+	v.Init(makeContext())
+	m := &mockModel{}
+	v.SetModel(m)
+	var msgs []string
+	v.SetAnnounceFn(func(msg string) {
+		msgs = append(msgs, msg)
+	})
+	v.Update(m.Peek(), false)
+	v.Select(2, 0)
+	v.Update(m.Peek(), false)
+
+	assert.Equal(t, 2, len(msgs))
+	assert.Equal(t, "Row 2 of 2: A blee, B duh, C zorg", msgs[1])
+}
+
+func TestTableRowChanged(t *testing.T) {
+	v := ui.NewTable(client.NewGVR("fred"))
+	v.Init(makeContext())
+	m := &mockModel{}
+	v.SetModel(m)
+	var items []string
+	v.SetRowChangedFn(func(item string) {
+		items = append(items, item)
+	})
+	v.Update(m.Peek(), false)
+	v.Select(2, 0)
+
+	assert.Equal(t, []string{"r2"}, items[len(items)-1:])
+}
+
+func TestTableFuzzyFilter(t *testing.T) {
+	v := ui.NewTable(client.NewGVR("fred"))
+	v.Init(makeContext())
+	v.SetModel(&mockModel{})
+	v.SetFuzzyFilter(true)
+
+	v.CmdBuff().SetText("r2", "")
+	data := v.GetFilteredData()
+	assert.Equal(t, 1, len(data.RowEvents))
+	assert.Equal(t, "r2", data.RowEvents[0].Row.ID)
+
+	v.CmdBuff().SetText("!zorg", "")
+	assert.Equal(t, 1, len(v.GetFilteredData().RowEvents))
+}
+
 // ----------------------------------------------------------------------------
 // Helpers...
 
@@ -63,20 +199,22 @@ type mockModel struct{}
 
 var _ ui.Tabular = &mockModel{}
 
-func (t *mockModel) SetInstance(string)                 {}
-func (t *mockModel) SetLabelFilter(string)              {}
-func (t *mockModel) Empty() bool                        { return false }
-func (t *mockModel) Count() int                         { return 1 }
-func (t *mockModel) HasMetrics() bool                   { return true }
-func (t *mockModel) Peek() *render.TableData            { return makeTableData() }
-func (t *mockModel) Refresh(context.Context) error      { return nil }
-func (t *mockModel) ClusterWide() bool                  { return false }
-func (t *mockModel) GetNamespace() string               { return "blee" }
-func (t *mockModel) SetNamespace(string)                {}
-func (t *mockModel) ToggleToast()                       {}
-func (t *mockModel) AddListener(model.TableListener)    {}
-func (t *mockModel) RemoveListener(model.TableListener) {}
-func (t *mockModel) Watch(context.Context) error        { return nil }
+func (t *mockModel) SetInstance(string)                    {}
+func (t *mockModel) SetLabelFilter(string)                 {}
+func (t *mockModel) SetFieldFilter(string)                 {}
+func (t *mockModel) SetCustomColumns(render.CustomColumns) {}
+func (t *mockModel) Empty() bool                           { return false }
+func (t *mockModel) Count() int                            { return 1 }
+func (t *mockModel) HasMetrics() bool                      { return true }
+func (t *mockModel) Peek() *render.TableData               { return makeTableData() }
+func (t *mockModel) Refresh(context.Context) error         { return nil }
+func (t *mockModel) ClusterWide() bool                     { return false }
+func (t *mockModel) GetNamespace() string                  { return "blee" }
+func (t *mockModel) SetNamespace(string)                   {}
+func (t *mockModel) ToggleToast()                          {}
+func (t *mockModel) AddListener(model.TableListener)       {}
+func (t *mockModel) RemoveListener(model.TableListener)    {}
+func (t *mockModel) Watch(context.Context) error           { return nil }
 func (t *mockModel) Get(ctx context.Context, path string) (runtime.Object, error) {
 	return nil, nil
 }
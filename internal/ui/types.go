@@ -54,6 +54,12 @@ type Tabular interface {
 	// SetLabelFilter sets the label filter.
 	SetLabelFilter(string)
 
+	// SetFieldFilter sets the field filter.
+	SetFieldFilter(string)
+
+	// SetCustomColumns sets user defined columns.
+	SetCustomColumns(render.CustomColumns)
+
 	// Empty returns true if model has no data.
 	Empty() bool
 
@@ -81,3 +87,15 @@ type Tabular interface {
 	// Delete a resource.
 	Delete(context.Context, string, *metav1.DeletionPropagation, dao.Grace) error
 }
+
+// Pager represents a model listing large resource sets in chunks.
+type Pager interface {
+	// SetPageSize sets the listing chunk size. Zero lists all resources.
+	SetPageSize(int64)
+
+	// Page returns the current partial listing or nil if all resources are listed.
+	Page() *dao.Page
+
+	// LoadMore lists the next chunk of resources.
+	LoadMore(context.Context) error
+}
@@ -2,6 +2,7 @@ package view
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/derailed/k9s/internal/config"
@@ -53,27 +54,107 @@ func inScope(scopes, aliases []string) bool {
 	return false
 }
 
-func hotKeyActions(r Runner, aa ui.KeyActions) {
+func hotKeyActions(r Runner, aa ui.KeyActions) []tcell.Key {
 	hh := config.NewHotKeys()
 	if err := hh.Load(); err != nil {
-		return
+		return nil
 	}
 
-	for k, hk := range hh.HotKey {
-		key, err := asKey(hk.ShortCut)
+	unbound := unbindKeys(r, hh.Unbind, aa)
+	chords := make(map[tcell.Key]ui.KeyActions)
+	bound := make(map[tcell.Key]bool)
+	for _, k := range hotKeyNames(hh) {
+		hk := hh.HotKey[k]
+		if hk.IsScoped() && !inScope(hk.Scopes, r.Aliases()) {
+			continue
+		}
+		kk, err := hotKeyKeys(hk)
 		if err != nil {
 			log.Warn().Err(err).Msg("HOT-KEY Unable to map hotkey shortcut to a key")
 			continue
 		}
-		_, ok := aa[key]
-		if ok {
+		action := ui.NewSharedKeyAction(hk.Description, gotoCmd(r, hk.Command, ""), false)
+		if len(kk) == 2 {
+			if _, ok := aa[kk[0]]; ok && chords[kk[0]] == nil {
+				log.Warn().Err(fmt.Errorf("HOT-KEY Doh! you are trying to override an existing command `%s", k)).Msg("Invalid chord")
+				continue
+			}
+			if chords[kk[0]] == nil {
+				chords[kk[0]] = make(ui.KeyActions)
+				aa[kk[0]] = ui.NewSharedKeyAction("Chord", chordCmd(r, hk.Keys()[0], chords[kk[0]]), false)
+			}
+			chords[kk[0]][kk[1]] = action
+			continue
+		}
+		if _, ok := aa[kk[0]]; ok && !bound[kk[0]] {
 			log.Warn().Err(fmt.Errorf("HOT-KEY Doh! you are trying to override an existing command `%s", k)).Msg("Invalid shortcut")
 			continue
 		}
-		aa[key] = ui.NewSharedKeyAction(
-			hk.Description,
-			gotoCmd(r, hk.Command, ""),
-			false)
+		// Scoped hotkeys are visited last and win over global ones.
+		aa[kk[0]], bound[kk[0]] = action, true
+	}
+
+	return unbound
+}
+
+// hotKeyNames returns hotkey names with global hotkeys first.
+func hotKeyNames(hh config.HotKeys) []string {
+	kk := make([]string, 0, len(hh.HotKey))
+	for k := range hh.HotKey {
+		kk = append(kk, k)
+	}
+	sort.Slice(kk, func(i, j int) bool {
+		si, sj := hh.HotKey[kk[i]].IsScoped(), hh.HotKey[kk[j]].IsScoped()
+		if si != sj {
+			return sj
+		}
+		return kk[i] < kk[j]
+	})
+
+	return kk
+}
+
+func hotKeyKeys(hk config.HotKey) ([]tcell.Key, error) {
+	ss := hk.Keys()
+	if len(ss) == 0 || len(ss) > 2 {
+		return nil, fmt.Errorf("invalid shortcut %q", hk.ShortCut)
+	}
+	kk := make([]tcell.Key, 0, len(ss))
+	for _, s := range ss {
+		key, err := asKey(s)
+		if err != nil {
+			return nil, err
+		}
+		kk = append(kk, key)
+	}
+
+	return kk, nil
+}
+
+// unbindKeys removes built-in keys unbound for the current view.
+func unbindKeys(r Runner, uu []config.KeyUnbind, aa ui.KeyActions) []tcell.Key {
+	kk := make([]tcell.Key, 0, len(uu))
+	for _, u := range uu {
+		if len(u.Scopes) > 0 && !inScope(u.Scopes, r.Aliases()) {
+			continue
+		}
+		key, err := asKey(u.Key)
+		if err != nil {
+			log.Warn().Err(err).Msg("HOT-KEY Unable to map unbind key")
+			continue
+		}
+		delete(aa, key)
+		kk = append(kk, key)
+	}
+
+	return kk
+}
+
+func chordCmd(r Runner, prefix string, aa ui.KeyActions) ui.ActionHandler {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		r.App().armChord(aa)
+		r.App().Flash().Infof("%s-", prefix)
+		return nil
 	}
 }
 
@@ -121,37 +202,36 @@ func pluginAction(r Runner, p config.Plugin) ui.ActionHandler {
 			return nil
 		}
 
-		args := make([]string, len(p.Args))
-		for i, a := range p.Args {
-			arg, err := r.EnvFn()().Substitute(a)
-			if err != nil {
-				log.Error().Err(err).Msg("Plugin Args match failed")
-				return nil
-			}
-			args[i] = arg
-		}
-
-		cb := func() {
-			opts := shellOpts{
-				clear:      true,
-				binary:     p.Command,
-				background: p.Background,
-				pipes:      p.Pipes,
-				args:       args,
-			}
-			if run(r.App(), opts) {
-				r.App().Flash().Info("Plugin command launched successfully!")
-				return
-			}
-			r.App().Flash().Info("Plugin command failed!")
-		}
-		if p.Confirm {
-			msg := fmt.Sprintf("Run?\n%s %s", p.Command, strings.Join(args, " "))
-			dialog.ShowConfirm(r.App().Styles.Dialog(), r.App().Content.Pages, "Confirm "+p.Description, msg, cb, func() {})
+		env := r.EnvFn()()
+		if len(p.Inputs) == 0 {
+			launchPlugin(r.App(), p, env)
 			return nil
 		}
-		cb()
+		ShowPluginInputs(r.App(), p, env, func(vals map[string]string) {
+			for k, v := range vals {
+				env[strings.ToUpper(k)] = v
+			}
+			launchPlugin(r.App(), p, env)
+		})
 
 		return nil
 	}
 }
+
+func launchPlugin(a *App, p config.Plugin, env Env) {
+	args, err := pluginArgs(env, p.Args)
+	if err != nil {
+		log.Error().Err(err).Msg("Plugin Args match failed")
+		return
+	}
+
+	cb := func() {
+		runPlugin(a, p, args)
+	}
+	if p.Confirm {
+		msg := fmt.Sprintf("Run?\n%s %s", p.Command, strings.Join(args, " "))
+		dialog.ShowConfirm(a.Styles.Dialog(), a.Content.Pages, "Confirm "+p.Description, msg, cb, func() {})
+		return
+	}
+	cb()
+}
@@ -0,0 +1,75 @@
+package view
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+const alertsGVR = "alerts"
+
+var alertResources = map[string]string{
+	config.CrashLoopAlert:    "pods",
+	config.NodeNotReadyAlert: "nodes",
+	config.PVCPendingAlert:   "persistentvolumeclaims",
+}
+
+// Alert represents the cluster alerts viewer.
+type Alert struct {
+	ResourceViewer
+}
+
+// NewAlert returns a new alerts view.
+func NewAlert(gvr client.GVR) ResourceViewer {
+	a := Alert{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	a.GetTable().SetBorderFocusColor(tcell.ColorMediumSpringGreen)
+	a.GetTable().SetSelectedStyle(tcell.StyleDefault.Foreground(tcell.ColorWhite).Background(tcell.ColorMediumSpringGreen).Attributes(tcell.AttrNone))
+	a.GetTable().SetSortCol("AGE", true)
+	a.AddBindKeysFn(a.bindKeys)
+
+	return &a
+}
+
+// Init initializes the view.
+func (a *Alert) Init(ctx context.Context) error {
+	if err := a.ResourceViewer.Init(ctx); err != nil {
+		return err
+	}
+	a.GetTable().GetModel().SetNamespace(client.AllNamespaces)
+	a.SetContextFn(func(ctx context.Context) context.Context {
+		return context.WithValue(ctx, internal.KeyAlerts, a.App().alerts)
+	})
+
+	return nil
+}
+
+func (a *Alert) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Delete(tcell.KeyCtrlW, tcell.KeyCtrlL, tcell.KeyCtrlZ, tcell.KeyCtrlD)
+	aa.Add(ui.KeyActions{
+		tcell.KeyEnter: ui.NewKeyAction("Goto", a.gotoCmd, true),
+		ui.KeyShiftR:   ui.NewKeyAction("Sort Rule", a.GetTable().SortColCmd("RULE", true), false),
+		ui.KeyShiftK:   ui.NewKeyAction("Sort Kind", a.GetTable().SortColCmd("KIND", true), false),
+	})
+}
+
+func (a *Alert) gotoCmd(evt *tcell.EventKey) *tcell.EventKey {
+	row, _ := a.GetTable().GetSelection()
+	if row == 0 {
+		return evt
+	}
+	kind, path := ui.TrimCell(a.GetTable().SelectTable, row, 1), ui.TrimCell(a.GetTable().SelectTable, row, 2)
+	res, ok := alertResources[kind]
+	if !ok || path == "" {
+		return nil
+	}
+	a.App().gotoResource(res, path, false)
+
+	return nil
+}
@@ -0,0 +1,17 @@
+package view_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/view"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAlertNew(t *testing.T) {
+	v := view.NewAlert(client.NewGVR("alerts"))
+
+	assert.Nil(t, v.Init(makeCtx()))
+	assert.Equal(t, "Alerts", v.Name())
+	assert.Equal(t, 6, len(v.Hints()))
+}
@@ -25,7 +25,7 @@ func TestAliasNew(t *testing.T) {
 
 	assert.Nil(t, v.Init(makeContext()))
 	assert.Equal(t, "Aliases", v.Name())
-	assert.Equal(t, 6, len(v.Hints()))
+	assert.Equal(t, 7, len(v.Hints()))
 }
 
 func TestAliasSearch(t *testing.T) {
@@ -105,24 +105,26 @@ var (
 	_ ui.Suggester = (*mockModel)(nil)
 )
 
-func (t *mockModel) CurrentSuggestion() (string, bool)  { return "", false }
-func (t *mockModel) NextSuggestion() (string, bool)     { return "", false }
-func (t *mockModel) PrevSuggestion() (string, bool)     { return "", false }
-func (t *mockModel) ClearSuggestions()                  {}
-func (t *mockModel) SetInstance(string)                 {}
-func (t *mockModel) SetLabelFilter(string)              {}
-func (t *mockModel) Empty() bool                        { return false }
-func (t *mockModel) Count() int                         { return 1 }
-func (t *mockModel) HasMetrics() bool                   { return true }
-func (t *mockModel) Peek() *render.TableData            { return makeTableData() }
-func (t *mockModel) ClusterWide() bool                  { return false }
-func (t *mockModel) GetNamespace() string               { return "blee" }
-func (t *mockModel) SetNamespace(string)                {}
-func (t *mockModel) ToggleToast()                       {}
-func (t *mockModel) AddListener(model.TableListener)    {}
-func (t *mockModel) RemoveListener(model.TableListener) {}
-func (t *mockModel) Watch(context.Context) error        { return nil }
-func (t *mockModel) Refresh(context.Context) error      { return nil }
+func (t *mockModel) CurrentSuggestion() (string, bool)     { return "", false }
+func (t *mockModel) NextSuggestion() (string, bool)        { return "", false }
+func (t *mockModel) PrevSuggestion() (string, bool)        { return "", false }
+func (t *mockModel) ClearSuggestions()                     {}
+func (t *mockModel) SetInstance(string)                    {}
+func (t *mockModel) SetLabelFilter(string)                 {}
+func (t *mockModel) SetFieldFilter(string)                 {}
+func (t *mockModel) SetCustomColumns(render.CustomColumns) {}
+func (t *mockModel) Empty() bool                           { return false }
+func (t *mockModel) Count() int                            { return 1 }
+func (t *mockModel) HasMetrics() bool                      { return true }
+func (t *mockModel) Peek() *render.TableData               { return makeTableData() }
+func (t *mockModel) ClusterWide() bool                     { return false }
+func (t *mockModel) GetNamespace() string                  { return "blee" }
+func (t *mockModel) SetNamespace(string)                   {}
+func (t *mockModel) ToggleToast()                          {}
+func (t *mockModel) AddListener(model.TableListener)       {}
+func (t *mockModel) RemoveListener(model.TableListener)    {}
+func (t *mockModel) Watch(context.Context) error           { return nil }
+func (t *mockModel) Refresh(context.Context) error         { return nil }
 func (t *mockModel) Get(context.Context, string) (runtime.Object, error) {
 	return nil, nil
 }
@@ -0,0 +1,73 @@
+package view
+
+import (
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+// FlowSchema represents an api priority and fairness flowschema viewer.
+type FlowSchema struct {
+	ResourceViewer
+}
+
+// NewFlowSchema returns a new viewer.
+func NewFlowSchema(gvr client.GVR) ResourceViewer {
+	f := FlowSchema{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	f.GetTable().SetSortCol("PRECEDENCE", true)
+	f.AddBindKeysFn(f.bindKeys)
+
+	return &f
+}
+
+func (f *FlowSchema) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyL:      ui.NewKeyAction("Priority Level", f.levelCmd, true),
+		ui.KeyShiftP: ui.NewKeyAction("Sort Precedence", f.GetTable().SortColCmd("PRECEDENCE", true), false),
+		ui.KeyShiftW: ui.NewKeyAction("Sort Waiting", f.GetTable().SortColCmd("WAITING", false), false),
+	})
+}
+
+func (f *FlowSchema) levelCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := f.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	row, ok := f.GetTable().GetSelectedRow(path)
+	if !ok {
+		return nil
+	}
+	idx := f.GetTable().GetModel().Peek().Header.IndexOf("PRIORITY LEVEL", true)
+	if idx < 0 || idx >= len(row.Fields) {
+		return nil
+	}
+	gvr := f.GVR().G() + "/" + f.GVR().V() + "/prioritylevelconfigurations"
+	f.App().gotoResource(gvr, client.FQN(client.ClusterScope, row.Fields[idx]), false)
+
+	return nil
+}
+
+// PriorityLevel represents an api priority and fairness priority level viewer.
+type PriorityLevel struct {
+	ResourceViewer
+}
+
+// NewPriorityLevel returns a new viewer.
+func NewPriorityLevel(gvr client.GVR) ResourceViewer {
+	p := PriorityLevel{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	p.AddBindKeysFn(p.bindKeys)
+
+	return &p
+}
+
+func (p *PriorityLevel) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftW: ui.NewKeyAction("Sort Waiting", p.GetTable().SortColCmd("WAITING", false), false),
+		ui.KeyShiftE: ui.NewKeyAction("Sort Executing", p.GetTable().SortColCmd("EXECUTING", false), false),
+		ui.KeyShiftU: ui.NewKeyAction("Sort Seats", p.GetTable().SortColCmd("%SEATS", false), false),
+	})
+}
@@ -13,11 +13,13 @@ import (
 	"syscall"
 	"time"
 
-	"github.com/cenkalti/backoff/v4"
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/script"
+	"github.com/derailed/k9s/internal/server"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/k9s/internal/ui/dialog"
 	"github.com/derailed/k9s/internal/watch"
@@ -33,8 +35,11 @@ var ExitStatus = ""
 const (
 	splashDelay      = 1 * time.Second
 	clusterRefresh   = 15 * time.Second
+	alertsRefresh    = 10 * time.Second
 	clusterInfoWidth = 50
 	clusterInfoPad   = 15
+	paletteKey       = "palette"
+	chordTimeout     = 2 * time.Second
 )
 
 // App represents an application view.
@@ -46,12 +51,27 @@ type App struct {
 	factory       *watch.Factory
 	cancelFn      context.CancelFunc
 	clusterModel  *model.ClusterInfo
+	alerts        *dao.Alerts
+	gpuMetrics    *dao.GPUMetrics
+	pricing       dao.PricingProvider
 	cmdHistory    *model.History
 	filterHistory *model.History
+	history       *config.History
+	sessions      *config.Sessions
+	scripts       *script.Hooks
+	chord         ui.KeyActions
+	chordAt       time.Time
 	conRetry      int32
 	showHeader    bool
 	showLogo      bool
 	showCrumbs    bool
+	zen           bool
+	preview       *Preview
+	workspaces    []*PageStack
+	activeWS      int
+	inputAt       atomic.Int64
+	calls         calls
+	staleAt       atomic.Int64
 }
 
 // NewApp returns a K9s app instance.
@@ -60,6 +80,9 @@ func NewApp(cfg *config.Config) *App {
 		App:           ui.NewApp(cfg, cfg.K9s.CurrentContext),
 		cmdHistory:    model.NewHistory(model.MaxHistory),
 		filterHistory: model.NewHistory(model.MaxHistory),
+		history:       config.NewHistory(),
+		sessions:      config.NewSessions(),
+		scripts:       script.NewHooks(),
 		Content:       NewPageStack(),
 	}
 
@@ -87,6 +110,8 @@ func (a *App) Init(version string, rate int) error {
 
 	a.App.Init()
 	a.SetInputCapture(a.keyboard)
+	a.SetMouseCapture(a.mouseActivity)
+	a.markActive()
 	a.bindKeys()
 	if a.Conn() == nil {
 		return errors.New("No client connection detected")
@@ -99,6 +124,23 @@ func (a *App) Init(version string, rate int) error {
 		return fmt.Errorf("Invalid namespace %s", ns)
 	}
 	a.initFactory(ns)
+	if addr := a.Config.K9s.DebugAddr(); addr != "" {
+		if err := server.ServeDebug(addr, a.factory); err != nil {
+			log.Error().Err(err).Msgf("Debug endpoint failed on %q", addr)
+		}
+	}
+
+	if cfg := a.Config.K9s.AlertsConfig(); cfg.Enable {
+		a.alerts = dao.NewAlerts(cfg.Rules)
+	}
+	if cfg := a.Config.K9s.GPUMetricsConfig(); cfg.URL != "" {
+		a.gpuMetrics = dao.NewGPUMetrics(cfg)
+	}
+	if cfg := a.Config.K9s.PricingConfig(); cfg.Enabled() {
+		if a.pricing, err = dao.NewPricingProvider(cfg); err != nil {
+			log.Warn().Err(err).Msgf("Cost estimation disabled")
+		}
+	}
 
 	a.clusterModel = model.NewClusterInfo(a.factory, a.version, a.Config.K9s.SkipLatestRevCheck)
 	a.clusterModel.AddListener(a.clusterInfo())
@@ -113,6 +155,16 @@ func (a *App) Init(version string, rate int) error {
 		return err
 	}
 	a.CmdBuff().SetSuggestionFn(a.suggestCommand())
+	if err := a.history.Load(config.K9sHistoryFile); err != nil {
+		log.Warn().Err(err).Msgf("Unable to load history")
+	}
+	a.loadHistory(a.Config.K9s.CurrentContext)
+	if err := a.sessions.Load(config.K9sSessionsFile); err != nil {
+		log.Warn().Err(err).Msgf("Unable to load sessions")
+	}
+	if err := a.scripts.LoadDir(config.K9sScriptsDir); err != nil {
+		log.Warn().Err(err).Msgf("Unable to load scripts")
+	}
 
 	a.layout(ctx)
 	a.initSignals()
@@ -127,7 +179,8 @@ func (a *App) layout(ctx context.Context) {
 	main := tview.NewFlex().SetDirection(tview.FlexRow)
 	main.AddItem(a.statusIndicator(), 1, 1, false)
 	main.AddItem(a.Content, 0, 10, true)
-	if !a.Config.K9s.IsCrumbsless() {
+	a.showCrumbs = !a.Config.K9s.IsCrumbsless()
+	if a.showCrumbs {
 		main.AddItem(a.Crumbs(), 1, 1, false)
 	}
 	main.AddItem(flash, 1, 1, false)
@@ -135,6 +188,9 @@ func (a *App) layout(ctx context.Context) {
 	a.Main.AddPage("main", main, true, false)
 	a.Main.AddPage("splash", ui.NewSplash(a.Styles, a.version), true, true)
 	a.toggleHeader(!a.Config.K9s.IsHeadless(), !a.Config.K9s.IsLogoless())
+	if a.Config.K9s.StatusBarConfig().Zen {
+		a.toggleZen(true)
+	}
 }
 
 func (a *App) initSignals() {
@@ -174,6 +230,20 @@ func (a *App) suggestCommand() model.SuggestionFunc {
 }
 
 func (a *App) keyboard(evt *tcell.EventKey) *tcell.EventKey {
+	a.markActive()
+	if evt.Key() == tcell.KeyEscape && !a.InCmdMode() && a.cancelCall() {
+		return nil
+	}
+	if aa := a.chord; aa != nil {
+		a.chord = nil
+		if time.Since(a.chordAt) < chordTimeout {
+			if k, ok := aa[ui.AsKey(evt)]; ok {
+				return k.Action(evt)
+			}
+			a.Flash().Clear()
+			return nil
+		}
+	}
 	if k, ok := a.HasAction(ui.AsKey(evt)); ok && !a.Content.IsTopDialog() {
 		return k.Action(evt)
 	}
@@ -181,6 +251,11 @@ func (a *App) keyboard(evt *tcell.EventKey) *tcell.EventKey {
 	return evt
 }
 
+// armChord waits for the second key of a hotkey chord.
+func (a *App) armChord(aa ui.KeyActions) {
+	a.chord, a.chordAt = aa, time.Now()
+}
+
 func (a *App) bindKeys() {
 	a.AddActions(ui.KeyActions{
 		ui.KeyShift9:   ui.NewSharedKeyAction("DumpGOR", a.dumpGOR, false),
@@ -189,7 +264,9 @@ func (a *App) bindKeys() {
 		ui.KeyHelp:     ui.NewSharedKeyAction("Help", a.helpCmd, false),
 		tcell.KeyCtrlA: ui.NewSharedKeyAction("Aliases", a.aliasCmd, false),
 		tcell.KeyEnter: ui.NewKeyAction("Goto", a.gotoCmd, false),
+		ui.KeyColon:    ui.NewKeyAction("Cmd", a.paletteCmd, false),
 	})
+	a.AddActions(a.workspaceActions())
 }
 
 func (a *App) dumpGOR(evt *tcell.EventKey) *tcell.EventKey {
@@ -227,15 +304,82 @@ func (a *App) toggleCrumbs(flag bool) {
 	if !ok {
 		log.Fatal().Msg("Expecting valid flex view")
 	}
+	_, visible := flex.ItemAt(2).(*ui.Crumbs)
 	if a.showCrumbs {
-		if _, ok := flex.ItemAt(2).(*ui.Crumbs); !ok {
+		if !visible {
 			flex.AddItemAtIndex(2, a.Crumbs(), 1, 1, false)
 		}
-	} else {
+	} else if visible {
 		flex.RemoveItemAtIndex(2)
 	}
 }
 
+// togglePreview shows or hides a detail pane tracking the selected row.
+// An empty mode hides the pane.
+func (a *App) togglePreview(mode, dir string) {
+	flex, ok := a.Main.GetPrimitive("main").(*tview.Flex)
+	if !ok {
+		log.Fatal().Msg("Expecting valid flex view")
+	}
+	if a.preview != nil {
+		a.Content.Stack.RemoveListener(a.preview)
+		a.Styles.RemoveListener(a.preview)
+		a.preview.Stop()
+		a.preview = nil
+	}
+	flex.RemoveItemAtIndex(1)
+	if mode == "" {
+		flex.AddItemAtIndex(1, a.Content, 0, 10, true)
+		return
+	}
+
+	a.preview = NewPreview(a, mode)
+	a.preview.dir = dir
+	split := tview.NewFlex().SetDirection(tview.FlexColumn)
+	if dir == previewBottom {
+		split.SetDirection(tview.FlexRow)
+	}
+	split.AddItem(a.Content, 0, 1, true)
+	split.AddItem(a.preview, 0, 1, false)
+	flex.AddItemAtIndex(1, split, 0, 10, true)
+	a.Content.Stack.AddListener(a.preview)
+	a.Styles.AddListener(a.preview)
+	a.preview.follow(a.Content.Top())
+}
+
+// previewCmd toggles the detail pane given a mode and a direction.
+func (a *App) previewCmd(args []string) error {
+	mode, dir := previewDescribe, previewRight
+	for _, arg := range args {
+		switch arg {
+		case previewDescribe, previewYAML, previewEvents:
+			mode = arg
+		case previewRight, previewBottom:
+			dir = arg
+		default:
+			return fmt.Errorf("invalid preview option %q", arg)
+		}
+	}
+	if a.preview != nil && len(args) == 0 {
+		mode = ""
+	}
+	a.togglePreview(mode, dir)
+
+	return nil
+}
+
+// toggleZen collapses the header and crumbs leaving only the table and status bar.
+func (a *App) toggleZen(flag bool) {
+	a.zen = flag
+	if a.zen {
+		a.toggleHeader(false, a.showLogo)
+		a.toggleCrumbs(false)
+		return
+	}
+	a.toggleHeader(!a.Config.K9s.IsHeadless(), a.showLogo)
+	a.toggleCrumbs(!a.Config.K9s.IsCrumbsless())
+}
+
 func (a *App) buildHeader() tview.Primitive {
 	header := tview.NewFlex()
 	header.SetBackgroundColor(a.Styles.BgColor())
@@ -276,6 +420,10 @@ func (a *App) Resume() {
 	ctx, a.cancelFn = context.WithCancel(context.Background())
 
 	go a.clusterUpdater(ctx)
+	go a.idleWatcher(ctx)
+	if a.alerts != nil {
+		go a.alertsWatcher(ctx)
+	}
 	if err := a.StylesWatcher(ctx, a); err != nil {
 		log.Warn().Err(err).Msgf("Styles watcher failed")
 	}
@@ -284,14 +432,15 @@ func (a *App) Resume() {
 	}
 }
 
+// clusterUpdater probes the cluster connectivity. Lost connections are
+// retried with a jittered backoff until connectivity returns.
 func (a *App) clusterUpdater(ctx context.Context) {
+	bf := newReconnectBackOff(ctx)
+	delay := clusterRefresh
 	if err := a.refreshCluster(); err != nil {
 		log.Error().Err(err).Msgf("Cluster updater failed!")
-		return
+		delay = bf.NextBackOff()
 	}
-
-	bf := model.NewExpBackOff(ctx, clusterRefresh, 2*time.Minute)
-	delay := clusterRefresh
 	for {
 		select {
 		case <-ctx.Done():
@@ -300,10 +449,7 @@ func (a *App) clusterUpdater(ctx context.Context) {
 		case <-time.After(delay):
 			if err := a.refreshCluster(); err != nil {
 				log.Error().Err(err).Msgf("ClusterUpdater failed")
-				if delay = bf.NextBackOff(); delay == backoff.Stop {
-					a.BailOut()
-					return
-				}
+				delay = bf.NextBackOff()
 			} else {
 				bf.Reset()
 				delay = clusterRefresh
@@ -312,32 +458,73 @@ func (a *App) clusterUpdater(ctx context.Context) {
 	}
 }
 
+func (a *App) alertsWatcher(ctx context.Context) {
+	go a.alerts.Notifier(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			log.Debug().Msg("Alerts watcher canceled!")
+			return
+		case <-time.After(alertsRefresh):
+			a.checkAlerts(ctx)
+		}
+	}
+}
+
+func (a *App) checkAlerts(ctx context.Context) {
+	if atomic.LoadInt32(&a.conRetry) > 0 {
+		return
+	}
+	raised, err := a.alerts.Check(ctx, a.factory)
+	if err != nil {
+		log.Error().Err(err).Msgf("Alerts check failed")
+		return
+	}
+	if len(raised) == 0 {
+		return
+	}
+	a.QueueUpdateDraw(func() {
+		a.Status(model.FlashWarn, fmt.Sprintf("%d new alert(s)! %s: %s", len(raised), raised[0].Resource, raised[0].Message))
+	})
+	for _, r := range raised {
+		log.Warn().Msgf("Alert %s raised on %s: %s", r.Rule, r.Resource, r.Message)
+		if !a.alerts.Dispatch(r) {
+			log.Warn().Msgf("Alert %s notification dropped. Too many pending notifications", r.Rule)
+		}
+	}
+}
+
 func (a *App) refreshCluster() error {
 	c := a.Content.Top()
 	if ok := a.Conn().CheckConnectivity(); ok {
 		if atomic.LoadInt32(&a.conRetry) > 0 {
 			atomic.StoreInt32(&a.conRetry, 0)
-			a.Status(model.FlashInfo, "K8s connectivity OK")
+			a.clearStale()
+			a.Status(model.FlashInfo, "K8s connectivity OK. Reconciling...")
 			if c != nil {
+				a.showStale(c, time.Time{})
 				c.Start()
 			}
+		} else if reason := client.APITelemetry().Degraded(); reason != "" {
+			a.Status(model.FlashWarn, "API server degraded: "+reason+". See :stats")
 		} else {
 			a.ClearStatus(true)
 		}
 		a.factory.ValidatePortForwards()
 	} else if c != nil {
 		atomic.AddInt32(&a.conRetry, 1)
+		a.showStale(c, a.markStale())
 		c.Stop()
 	}
 
 	count, maxConnRetry := atomic.LoadInt32(&a.conRetry), int32(a.Config.K9s.MaxConnRetry)
 	if count >= maxConnRetry {
-		log.Error().Msgf("Conn check failed (%d/%d). Bailing out!", count, maxConnRetry)
-		ExitStatus = fmt.Sprintf("Lost K8s connection (%d). Bailing out!", count)
-		a.BailOut()
+		log.Error().Msgf("Conn check failed (%d/%d). Context %q unreachable", count, maxConnRetry, a.Config.K9s.CurrentContext)
+		a.Status(model.FlashErr, fmt.Sprintf("Context %s unreachable! Reconnecting... Use :ctx to switch", a.Config.K9s.CurrentContext))
+		return fmt.Errorf("Conn check failed (%d/%d)", count, maxConnRetry)
 	}
 	if count > 0 {
-		a.Status(model.FlashWarn, fmt.Sprintf("Dial K8s Toast [%d/%d]", count, maxConnRetry))
+		a.Status(model.FlashWarn, fmt.Sprintf("Connection lost! Serving cached data stale since %s. Retrying [%d/%d]", a.staleSince().Format(time.Kitchen), count, maxConnRetry))
 		return fmt.Errorf("Conn check failed (%d/%d)", count, maxConnRetry)
 	}
 
@@ -372,6 +559,7 @@ func (a *App) switchNS(ns string) error {
 	if err := a.Config.SetActiveNamespace(ns); err != nil {
 		return err
 	}
+	a.Config.K9s.RememberNamespace(a.Config.K9s.CurrentContext, ns)
 	if err := a.Config.Save(); err != nil {
 		return err
 	}
@@ -403,11 +591,17 @@ func (a *App) switchContext(name string, loadPods bool) error {
 	a.Halt()
 	defer a.Resume()
 	{
+		a.saveSession()
 		ns, err := a.Conn().Config().CurrentNamespaceName()
 		if err != nil {
 			log.Warn().Msg("No namespace specified in context. Using K9s config")
 		}
+		if n, ok := a.Config.K9s.ContextsConfig().Namespace(name); ok {
+			ns = n
+		}
 		a.initFactory(ns)
+		atomic.StoreInt32(&a.conRetry, 0)
+		a.ClearStatus(false)
 
 		if e := a.command.Reset(true); e != nil {
 			return e
@@ -418,7 +612,9 @@ func (a *App) switchContext(name string, loadPods bool) error {
 			a.Config.SetActiveView(v)
 		}
 		a.Config.Reset()
+		a.saveHistory()
 		a.Config.K9s.CurrentContext = name
+		a.loadHistory(name)
 		cluster, err := a.Conn().Config().CurrentClusterName()
 		if err != nil {
 			return err
@@ -432,6 +628,7 @@ func (a *App) switchContext(name string, loadPods bool) error {
 		}
 
 		a.Flash().Infof("Switching context to %s", name)
+		a.resetWorkspaces()
 		a.ReloadStyles(name)
 		a.gotoResource(v, "", true)
 		a.clusterModel.Reset(a.factory)
@@ -440,9 +637,52 @@ func (a *App) switchContext(name string, loadPods bool) error {
 	return nil
 }
 
+// impersonate reconnects to the current context acting as the given user and groups.
+// An empty user and no groups reverts to the kubeconfig identity.
+func (a *App) impersonate(user string, groups []string) error {
+	if a.Content.Top() != nil {
+		a.Content.Top().Stop()
+	}
+	a.Conn().Config().Impersonate(user, groups)
+	name := a.Config.K9s.CurrentContext
+	if err := a.Conn().SwitchContext(name); err != nil {
+		return err
+	}
+	if err := a.switchContext(name, false); err != nil {
+		return err
+	}
+	if user == "" && len(groups) == 0 {
+		a.Flash().Info("Impersonation cleared")
+		return nil
+	}
+	a.Flash().Infof("Acting as user %q groups %v", user, groups)
+
+	return nil
+}
+
+// recordCmd adds a command to the history and persists it.
+func (a *App) recordCmd(cmd string) {
+	a.cmdHistory.Push(cmd)
+	a.saveHistory()
+}
+
+// loadHistory restores the commands and filters history of a given context.
+func (a *App) loadHistory(context string) {
+	h := a.history.For(context)
+	a.cmdHistory.Set(h.Commands)
+	a.filterHistory.Set(h.Filters)
+}
+
+// saveHistory persists the commands and filters history of the current context.
+func (a *App) saveHistory() {
+	a.history.Set(a.Config.K9s.CurrentContext, a.cmdHistory.List(), a.filterHistory.List())
+	if err := a.history.Save(config.K9sHistoryFile); err != nil {
+		log.Error().Err(err).Msgf("Unable to save history")
+	}
+}
+
 func (a *App) initFactory(ns string) {
-	a.factory.Terminate()
-	a.factory.Start(ns)
+	a.factory.Restart(ns)
 }
 
 // BailOut exists the application.
@@ -456,6 +696,8 @@ func (a *App) BailOut() {
 	if err := nukeK9sShell(a); err != nil {
 		log.Error().Err(err).Msgf("nuking k9s shell pod")
 	}
+	a.saveHistory()
+	a.saveSession()
 	a.factory.Terminate()
 	a.App.BailOut()
 }
@@ -474,6 +716,7 @@ func (a *App) Run() error {
 	if err := a.command.defaultCmd(); err != nil {
 		return err
 	}
+	a.offerSession()
 	a.SetRunning(true)
 	if err := a.Application.Run(); err != nil {
 		return err
@@ -595,7 +838,7 @@ func (a *App) dirCmd(path string) error {
 			path = dir
 		}
 	}
-	a.cmdHistory.Push("dir " + path)
+	a.recordCmd("dir " + path)
 
 	return a.inject(NewDir(path), true)
 }
@@ -636,6 +879,38 @@ func (a *App) aliasCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (a *App) paletteCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if a.InCmdMode() {
+		return evt
+	}
+	if top := a.Content.Top(); top != nil && top.InCmdMode() {
+		return evt
+	}
+
+	a.showPalette(ui.NewPalette(a.Styles, a.command.paletteEntries(), a.cmdHistory), func(cmd string) {
+		a.gotoResource(cmd, "", true)
+	})
+
+	return nil
+}
+
+func (a *App) showPalette(p *ui.Palette, ok ui.PaletteFunc) {
+	dismiss := func() {
+		a.Content.RemovePage(paletteKey)
+		if top := a.Content.Top(); top != nil {
+			a.SetFocus(top)
+		}
+	}
+	p.SetCancelFunc(dismiss)
+	p.SetSelectedFunc(func(cmd string) {
+		dismiss()
+		ok(cmd)
+	})
+	a.Content.AddPage(paletteKey, p, true, false)
+	a.Content.ShowPage(paletteKey)
+	a.SetFocus(p)
+}
+
 func (a *App) gotoResource(cmd, path string, clearStack bool) {
 	err := a.command.run(cmd, path, clearStack)
 	if err != nil {
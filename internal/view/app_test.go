@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/k9s/internal/view"
 	"github.com/stretchr/testify/assert"
 )
@@ -12,5 +13,7 @@ func TestAppNew(t *testing.T) {
 	a := view.NewApp(config.NewConfig(ks{}))
 	_ = a.Init("blee", 10)
 
-	assert.Equal(t, 11, len(a.GetActions()))
+	assert.Equal(t, 20, len(a.GetActions()))
+	_, ok := a.GetActions()[ui.AltNumKeys[1]]
+	assert.True(t, ok)
 }
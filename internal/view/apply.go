@@ -0,0 +1,173 @@
+package view
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tcell/v2"
+)
+
+// kubectl diff exits with 1 when differences were found.
+const diffFoundExitCode = 1
+
+func (a *App) applyCmd(path string) error {
+	if a.Config.K9s.IsReadOnly() {
+		return errors.New("apply is not allowed in read-only mode")
+	}
+	if _, err := os.Stat(path); err != nil {
+		return err
+	}
+	if abs, err := filepath.Abs(path); err == nil {
+		path = abs
+	}
+	a.recordCmd("apply " + path)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := a.trackCall("Diffing "+path, cancel)
+	go func() {
+		defer cancel()
+		defer done()
+		diff, err := applyDiff(ctx, a, path)
+		if ctx.Err() != nil {
+			return
+		}
+		a.QueueUpdateDraw(func() {
+			if err != nil {
+				a.Flash().Err(err)
+				return
+			}
+			showApplyPreview(a, path, diff)
+		})
+	}()
+
+	return nil
+}
+
+func showApplyPreview(a *App, path, diff string) {
+	if diff == "" {
+		diff = "No changes detected."
+	}
+	details := NewDetails(a, "Apply Preview", path, true).Update(diff)
+	details.actions.Add(ui.KeyActions{
+		ui.KeyA: ui.NewKeyAction("Apply", func(*tcell.EventKey) *tcell.EventKey {
+			confirmApply(a, path)
+			return nil
+		}, true),
+	})
+	if err := a.inject(details, false); err != nil {
+		a.Flash().Err(err)
+	}
+}
+
+func applyDiff(ctx context.Context, a *App, path string) (string, error) {
+	args := append([]string{"diff", "--server-side"}, manifestOpts(path)...)
+	out, err := runKuCtx(ctx, a, shellOpts{args: append(args, path)})
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) && exitErr.ExitCode() == diffFoundExitCode {
+		return out, nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("diff failed: %w\n%s", err, out)
+	}
+
+	return out, nil
+}
+
+func confirmApply(a *App, path string) {
+	msg := fmt.Sprintf("Server-side apply manifest(s) in %s?", path)
+	dialog.ShowConfirm(a.Styles.Dialog(), a.Content.Pages, "Confirm Apply", msg, func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		done := a.trackCall("Applying "+path, cancel)
+		go func() {
+			defer cancel()
+			defer done()
+			args := append([]string{"apply", "--server-side"}, manifestOpts(path)...)
+			out, err := runKuCtx(ctx, a, shellOpts{args: append(args, path)})
+			if err == nil {
+				dao.RecordAudit(a.factory, "apply", "", path, nil)
+			}
+			a.QueueUpdateDraw(func() {
+				details := NewDetails(a, "Apply Summary", path, true).Update(applySummary(out, err))
+				if err := a.inject(details, false); err != nil {
+					a.Flash().Err(err)
+				}
+			})
+		}()
+	}, func() {})
+}
+
+// manifestOpts returns kubectl flags to load manifests from a file or directory.
+func manifestOpts(sel string) []string {
+	if isKustomized(sel) {
+		return []string{"-k"}
+	}
+	opts := []string{"-f"}
+	if containsDir(sel) {
+		opts = append(opts, "-R")
+	}
+
+	return opts
+}
+
+// applySummary reports per object apply outcomes.
+func applySummary(out string, err error) string {
+	var (
+		objs   []string
+		counts = make(map[string]int)
+		errs   []string
+	)
+	for _, l := range strings.Split(strings.TrimSpace(out), "\n") {
+		l = strings.TrimSpace(l)
+		if l == "" {
+			continue
+		}
+		ff := strings.Fields(l)
+		if len(ff) == 2 && strings.Contains(ff[0], "/") {
+			objs = append(objs, ff[0]+": "+ff[1])
+			counts[ff[1]]++
+			continue
+		}
+		errs = append(errs, l)
+	}
+	if len(errs) > 0 {
+		counts["failed"] = len(errs)
+	}
+
+	var b strings.Builder
+	b.WriteString("summary:\n")
+	if err != nil {
+		b.WriteString("  status: " + err.Error() + "\n")
+	}
+	kk := make([]string, 0, len(counts))
+	for k := range counts {
+		kk = append(kk, k)
+	}
+	sort.Strings(kk)
+	for _, k := range kk {
+		fmt.Fprintf(&b, "  %s: %d\n", k, counts[k])
+	}
+	if len(objs) > 0 {
+		sort.Strings(objs)
+		b.WriteString("objects:\n")
+		for _, o := range objs {
+			b.WriteString("  " + o + "\n")
+		}
+	}
+	if len(errs) > 0 {
+		b.WriteString("errors:\n")
+		for _, e := range errs {
+			fmt.Fprintf(&b, "  - %s\n", e)
+		}
+	}
+
+	return strings.TrimSuffix(b.String(), "\n")
+}
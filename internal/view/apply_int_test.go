@@ -0,0 +1,66 @@
+package view
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestManifestOpts(t *testing.T) {
+	uu := map[string]struct {
+		path string
+		e    []string
+	}{
+		"file":      {path: "testdata/fred.yaml", e: []string{"-f"}},
+		"kustomize": {path: "testdata/kmanifests", e: []string{"-k"}},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, manifestOpts(u.path))
+		})
+	}
+}
+
+func TestApplySummary(t *testing.T) {
+	uu := map[string]struct {
+		out string
+		err error
+		e   string
+	}{
+		"empty": {
+			e: "summary:",
+		},
+		"applied": {
+			out: "service/fred serverside-applied\ndeployment.apps/fred serverside-applied\nconfigmap/blee unchanged",
+			e: `summary:
+  serverside-applied: 2
+  unchanged: 1
+objects:
+  configmap/blee: unchanged
+  deployment.apps/fred: serverside-applied
+  service/fred: serverside-applied`,
+		},
+		"errors": {
+			out: "service/fred serverside-applied\nError from server (Forbidden): boom",
+			err: errors.New("exit status 1"),
+			e: `summary:
+  status: exit status 1
+  failed: 1
+  serverside-applied: 1
+objects:
+  service/fred: serverside-applied
+errors:
+  - Error from server (Forbidden): boom`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, applySummary(u.out, u.err))
+		})
+	}
+}
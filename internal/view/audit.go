@@ -0,0 +1,82 @@
+package view
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+// Audit represents the mutating actions audit log viewer.
+type Audit struct {
+	ResourceViewer
+}
+
+// NewAudit returns a new audit log view.
+func NewAudit(gvr client.GVR) ResourceViewer {
+	a := Audit{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	a.GetTable().SetBorderFocusColor(tcell.ColorMediumSpringGreen)
+	a.GetTable().SetSelectedStyle(tcell.StyleDefault.Foreground(tcell.ColorWhite).Background(tcell.ColorMediumSpringGreen).Attributes(tcell.AttrNone))
+	a.GetTable().SetSortCol("TIME", false)
+	a.AddBindKeysFn(a.bindKeys)
+
+	return &a
+}
+
+// Init initializes the view.
+func (a *Audit) Init(ctx context.Context) error {
+	if err := a.ResourceViewer.Init(ctx); err != nil {
+		return err
+	}
+	a.GetTable().GetModel().SetNamespace(client.AllNamespaces)
+
+	return nil
+}
+
+func (a *Audit) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Delete(tcell.KeyCtrlW, tcell.KeyCtrlL, tcell.KeyCtrlZ, tcell.KeyCtrlD)
+	aa.Add(ui.KeyActions{
+		tcell.KeyEnter: ui.NewKeyAction("Payload", a.payloadCmd, true),
+		ui.KeyShiftT:   ui.NewKeyAction("Sort Time", a.GetTable().SortColCmd("TIME", false), false),
+		ui.KeyShiftU:   ui.NewKeyAction("Sort User", a.GetTable().SortColCmd("USER", true), false),
+		ui.KeyShiftC:   ui.NewKeyAction("Sort Action", a.GetTable().SortColCmd("ACTION", true), false),
+	})
+}
+
+func (a *Audit) payloadCmd(evt *tcell.EventKey) *tcell.EventKey {
+	id := a.GetTable().GetSelectedItem()
+	if id == "" {
+		return evt
+	}
+	ee, err := dao.ReadAudit(dao.AuditFile)
+	if err != nil {
+		a.App().Flash().Err(err)
+		return nil
+	}
+	var e render.AuditRes
+	for _, ae := range ee {
+		if ae.ID() == id {
+			e = ae
+			break
+		}
+	}
+	var buff bytes.Buffer
+	if err := json.Indent(&buff, []byte(e.Payload), "", "  "); err != nil {
+		buff.Reset()
+		buff.WriteString(e.Payload)
+	}
+	details := NewDetails(a.App(), "Payload", e.Path, true).Update(buff.String())
+	if err := a.App().inject(details, false); err != nil {
+		a.App().Flash().Err(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,254 @@
+package view
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/watch"
+	"github.com/rs/zerolog/log"
+)
+
+const batchStdout = "-"
+
+// batchArity tracks the supported batch commands and their arguments count.
+// A negative arity denotes optional trailing arguments.
+var batchArity = map[string]int{
+	"ns":       1,
+	"filter":   -1,
+	"export":   2,
+	"yaml":     3,
+	"describe": 3,
+}
+
+type batchCmd struct {
+	line int
+	verb string
+	args []string
+}
+
+// Batch runs a k9s script without the terminal UI.
+//
+//	ns NAMESPACE|all               switch namespace
+//	filter [QUERY]                 filter the exported tables (regex, !inverse, -f fuzzy, -l labels)
+//	export RESOURCE FILE           dump a table as csv, json or yaml given the file extension
+//	yaml RESOURCE NS/NAME FILE     dump a resource manifest
+//	describe RESOURCE NS/NAME FILE dump a resource description
+//
+// Blank lines and lines starting with # are ignored. A FILE of - denotes stdout.
+type Batch struct {
+	config  *config.Config
+	factory *watch.Factory
+	alias   *dao.Alias
+	out     io.Writer
+	ns      string
+	filter  string
+}
+
+// NewBatch returns a new batch runner.
+func NewBatch(cfg *config.Config, out io.Writer) *Batch {
+	return &Batch{
+		config: cfg,
+		out:    out,
+		ns:     cfg.ActiveNamespace(),
+	}
+}
+
+// Init connects to the cluster and loads the resources metadata.
+func (b *Batch) Init() error {
+	conn := b.config.GetConnection()
+	if conn == nil || !conn.ConnectionOK() {
+		return errors.New("no cluster connection")
+	}
+	b.factory = watch.NewFactory(conn)
+	b.factory.Start(b.ns)
+	b.alias = dao.NewAlias(b.factory)
+	if _, err := b.alias.Ensure(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// RunFile runs the commands of a given script file.
+func (b *Batch) RunFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.Error().Err(err).Msgf("Closing batch script %s", path)
+		}
+	}()
+
+	return b.Run(f)
+}
+
+// Run parses a script and runs its commands, stopping on the first failure.
+func (b *Batch) Run(r io.Reader) error {
+	cmds, err := parseBatch(r)
+	if err != nil {
+		return err
+	}
+	defer b.factory.Terminate()
+	for _, c := range cmds {
+		if err := b.exec(c); err != nil {
+			return fmt.Errorf("line %d: %s failed -- %w", c.line, c.verb, err)
+		}
+	}
+
+	return nil
+}
+
+func (b *Batch) exec(c batchCmd) error {
+	log.Debug().Msgf("Batch %s %v", c.verb, c.args)
+	switch c.verb {
+	case "ns":
+		b.ns = client.CleanseNamespace(c.args[0])
+		return nil
+	case "filter":
+		b.filter = strings.Join(c.args, " ")
+		return nil
+	case "export":
+		return b.export(c.args[0], c.args[1])
+	default:
+		return b.dump(c.verb, c.args[0], c.args[1], c.args[2])
+	}
+}
+
+func (b *Batch) export(cmd, file string) error {
+	gvr, err := b.resolve(cmd)
+	if err != nil {
+		return err
+	}
+	ns := b.ns
+	if meta, err := dao.MetaAccess.MetaFor(gvr); err == nil && !meta.Namespaced {
+		ns = client.ClusterScope
+	}
+	m := model.NewTable(gvr)
+	m.Isolate()
+	m.SetNamespace(ns)
+	switch {
+	case ui.IsLabelSelector(b.filter):
+		m.SetLabelFilter(ui.TrimLabelSelector(b.filter))
+	case ui.IsFieldSelector(b.filter):
+		m.SetFieldFilter(ui.TrimFieldSelector(b.filter))
+	}
+
+	ctx := context.WithValue(context.Background(), internal.KeyFactory, b.factory)
+	ctx = context.WithValue(ctx, internal.KeyGVR, gvr.String())
+	ctx = context.WithValue(ctx, internal.KeyWithMetrics, false)
+	if err := m.Refresh(ctx); err != nil {
+		return err
+	}
+	data, err := ui.FilterTable(b.filter, m.Peek())
+	if err != nil {
+		return err
+	}
+
+	format := strings.TrimPrefix(filepath.Ext(file), ".")
+	switch format {
+	case exportJSON:
+		return b.write(file, func(w io.Writer) error { return writeJSON(w, data) })
+	case exportYAML, "yml":
+		return b.write(file, func(w io.Writer) error { return writeYAML(w, data) })
+	default:
+		return b.write(file, func(w io.Writer) error { return writeCSV(w, data) })
+	}
+}
+
+func (b *Batch) dump(verb, cmd, path, file string) error {
+	gvr, err := b.resolve(cmd)
+	if err != nil {
+		return err
+	}
+	if ns, _ := client.Namespaced(path); ns == "" && !client.IsAllNamespaces(b.ns) {
+		if meta, err := dao.MetaAccess.MetaFor(gvr); err == nil && meta.Namespaced {
+			path = client.FQN(b.ns, path)
+		}
+	}
+	ctx := context.WithValue(context.Background(), internal.KeyFactory, b.factory)
+
+	var raw string
+	if verb == "yaml" {
+		raw, err = model.NewYAML(gvr, path).ToYAML(ctx, gvr, path, false)
+	} else {
+		raw, err = model.DescribeFor(ctx, gvr, path)
+	}
+	if err != nil {
+		return err
+	}
+
+	return b.write(file, func(w io.Writer) error {
+		_, err := io.WriteString(w, raw)
+		return err
+	})
+}
+
+func (b *Batch) resolve(cmd string) (client.GVR, error) {
+	gvr, ok := b.alias.AsGVR(cmd)
+	if !ok {
+		return client.GVR{}, fmt.Errorf("unknown resource %q", cmd)
+	}
+
+	return gvr, nil
+}
+
+func (b *Batch) write(file string, fn func(io.Writer) error) error {
+	if file == batchStdout {
+		return fn(b.out)
+	}
+	if err := config.EnsureDirPath(file, config.DefaultDirMod); err != nil {
+		return err
+	}
+	out, err := os.OpenFile(file, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, config.DefaultFileMod)
+	if err != nil {
+		return err
+	}
+	if err := fn(out); err != nil {
+		_ = out.Close()
+		return err
+	}
+	fmt.Fprintf(b.out, "Wrote %s\n", file)
+
+	return out.Close()
+}
+
+// parseBatch reads a batch script and validates its commands.
+func parseBatch(r io.Reader) ([]batchCmd, error) {
+	var (
+		cmds []batchCmd
+		line int
+	)
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line++
+		ff := strings.Fields(scanner.Text())
+		if len(ff) == 0 || strings.HasPrefix(ff[0], "#") {
+			continue
+		}
+		verb, args := strings.ToLower(ff[0]), ff[1:]
+		arity, ok := batchArity[verb]
+		if !ok {
+			return nil, fmt.Errorf("line %d: unknown command %q", line, ff[0])
+		}
+		if arity >= 0 && len(args) != arity {
+			return nil, fmt.Errorf("line %d: %s expects %d arguments but got %d", line, verb, arity, len(args))
+		}
+		cmds = append(cmds, batchCmd{line: line, verb: verb, args: args})
+	}
+
+	return cmds, scanner.Err()
+}
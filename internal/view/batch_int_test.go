@@ -0,0 +1,60 @@
+package view
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseBatch(t *testing.T) {
+	uu := map[string]struct {
+		script string
+		e      []batchCmd
+		err    string
+	}{
+		"empty": {},
+		"comments": {
+			script: "# Incident runbook\n\n  # indented\n",
+		},
+		"full": {
+			script: `
+ns kube-system
+filter -l k8s-app=kube-dns
+EXPORT po /tmp/dns.json
+filter
+yaml dp coredns -
+describe no/fred n1 /tmp/n1.txt
+`,
+			e: []batchCmd{
+				{line: 2, verb: "ns", args: []string{"kube-system"}},
+				{line: 3, verb: "filter", args: []string{"-l", "k8s-app=kube-dns"}},
+				{line: 4, verb: "export", args: []string{"po", "/tmp/dns.json"}},
+				{line: 5, verb: "filter", args: []string{}},
+				{line: 6, verb: "yaml", args: []string{"dp", "coredns", "-"}},
+				{line: 7, verb: "describe", args: []string{"no/fred", "n1", "/tmp/n1.txt"}},
+			},
+		},
+		"unknown": {
+			script: "ns default\nblee po\n",
+			err:    `line 2: unknown command "blee"`,
+		},
+		"arity": {
+			script: "export po\n",
+			err:    "line 1: export expects 2 arguments but got 1",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			cmds, err := parseBatch(strings.NewReader(u.script))
+			if u.err != "" {
+				assert.EqualError(t, err, u.err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, cmds)
+		})
+	}
+}
@@ -2,8 +2,11 @@ package view
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/derailed/k9s/internal"
@@ -29,10 +32,48 @@ func NewBenchmark(gvr client.GVR) ResourceViewer {
 	b.GetTable().SetSortCol(ageCol, true)
 	b.SetContextFn(b.benchContext)
 	b.GetTable().SetEnterFn(b.viewBench)
+	b.AddBindKeysFn(b.bindKeys)
 
 	return &b
 }
 
+func (b *Benchmark) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftC: ui.NewKeyAction("Compare Marked", b.compareCmd, true),
+	})
+}
+
+func (b *Benchmark) compareCmd(evt *tcell.EventKey) *tcell.EventKey {
+	paths := b.GetTable().GetSelectedItems()
+	if len(paths) < 2 {
+		b.App().Flash().Warn("Mark at least 2 benchmarks to compare")
+		return nil
+	}
+
+	// Oldest run first so it serves as the comparison baseline.
+	sort.SliceStable(paths, func(i, j int) bool {
+		return benchTime(paths[i]) < benchTime(paths[j])
+	})
+	names, ss := make([]string, 0, len(paths)), make([]perf.Summary, 0, len(paths))
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			b.App().Flash().Errf("Unable to load bench file %s", err)
+			return nil
+		}
+		names = append(names, filepath.Base(path))
+		ss = append(ss, perf.ParseSummary(string(data)))
+	}
+
+	subject := fmt.Sprintf("%d runs", len(paths))
+	details := NewDetails(b.App(), "Compare", subject, false).Update(perf.Compare(names, ss))
+	if err := b.App().inject(details, false); err != nil {
+		b.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
 func (b *Benchmark) benchContext(ctx context.Context) context.Context {
 	return context.WithValue(ctx, internal.KeyDir, benchDir(b.App().Config))
 }
@@ -64,6 +105,22 @@ func fileToSubject(path string) string {
 	return ee[0] + "/" + ee[1]
 }
 
+// benchTime returns a bench file run time from its unix nano suffix,
+// falling back to the file modification time.
+func benchTime(path string) int64 {
+	n := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	if i := strings.LastIndex(n, "_"); i >= 0 {
+		if t, err := strconv.ParseInt(n[i+1:], 10, 64); err == nil {
+			return t
+		}
+	}
+	if fi, err := os.Stat(path); err == nil {
+		return fi.ModTime().UnixNano()
+	}
+
+	return 0
+}
+
 func benchDir(cfg *config.Config) string {
 	return filepath.Join(perf.K9sBenchDir, cfg.K9s.CurrentContextDir())
 }
@@ -2,12 +2,12 @@ package view
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"sort"
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/derailed/tcell/v2"
@@ -34,6 +34,7 @@ type Browser struct {
 	contextFn  ContextFunc
 	cancelFn   context.CancelFunc
 	mx         sync.RWMutex
+	rendered   atomic.Uint64
 }
 
 // NewBrowser returns a new browser.
@@ -59,9 +60,10 @@ func (b *Browser) Init(ctx context.Context) error {
 	if err = b.Table.Init(ctx); err != nil {
 		return err
 	}
+	b.bindScripts()
 	ns := client.CleanseNamespace(b.app.Config.ActiveNamespace())
 	if dao.IsK8sMeta(b.meta) && b.app.ConOK() {
-		if _, e := b.app.factory.CanForResource(ns, b.GVR().String(), client.MonitorAccess); e != nil {
+		if e := b.checkAccess(ns); e != nil {
 			return e
 		}
 	}
@@ -84,12 +86,38 @@ func (b *Browser) Init(ctx context.Context) error {
 		b.Select(1, 0)
 	}
 	b.GetModel().SetRefreshRate(time.Duration(b.App().Config.K9s.GetRefreshRate()) * time.Second)
+	if p, ok := b.GetModel().(ui.Pager); ok {
+		p.SetPageSize(int64(b.App().Config.K9s.ListPageSize))
+	}
 
 	b.CmdBuff().SetSuggestionFn(b.suggestFilter())
 
 	return nil
 }
 
+// checkAccess ensures the resource can be monitored. Resources are only
+// cached upfront when large resource sets are not listed in chunks and
+// rendering requires more than their metadata.
+func (b *Browser) checkAccess(ns string) error {
+	precache := b.App().Config.K9s.ListPageSize <= 0
+	if r, ok := model.Registry[b.GVR().String()].Renderer.(model.MetadataRenderer); ok && r.MetadataOnly() {
+		precache = false
+	}
+	if precache {
+		_, err := b.app.factory.CanForResource(ns, b.GVR().String(), client.MonitorAccess)
+		return err
+	}
+	auth, err := b.app.factory.Client().CanI(ns, b.GVR().String(), client.MonitorAccess)
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("%v access denied on resource %q:%q", client.MonitorAccess, ns, b.GVR())
+	}
+
+	return nil
+}
+
 // InCmdMode checks if prompt is active.
 func (b *Browser) InCmdMode() bool {
 	return b.CmdBuff().InCmdMode()
@@ -143,12 +171,18 @@ func (b *Browser) Start() {
 	}
 
 	b.Stop()
+	b.rendered.Store(0)
 	b.GetModel().AddListener(b)
 	b.Table.Start()
 	b.CmdBuff().AddListener(b)
-	if err := b.GetModel().Watch(b.prepareContext()); err != nil {
-		b.App().Flash().Err(fmt.Errorf("Watcher failed for %s -- %w", b.GVR(), err))
-	}
+	ctx := b.prepareContext()
+	done := b.app.trackCall("Loading "+b.GVR().R(), b.cancelFn)
+	go func() {
+		defer done()
+		if err := b.GetModel().Watch(ctx); err != nil && ctx.Err() == nil {
+			b.App().Flash().Err(fmt.Errorf("Watcher failed for %s -- %w", b.GVR(), err))
+		}
+	}()
 }
 
 // Stop terminates browser updates.
@@ -171,10 +205,16 @@ func (b *Browser) BufferChanged(_, _ string) {}
 
 // BufferCompleted indicates input was accepted.
 func (b *Browser) BufferCompleted(text, _ string) {
-	if ui.IsLabelSelector(text) {
+	switch {
+	case ui.IsLabelSelector(text):
 		b.GetModel().SetLabelFilter(ui.TrimLabelSelector(text))
-	} else {
+		b.GetModel().SetFieldFilter("")
+	case ui.IsFieldSelector(text):
 		b.GetModel().SetLabelFilter("")
+		b.GetModel().SetFieldFilter(ui.TrimFieldSelector(text))
+	default:
+		b.GetModel().SetLabelFilter("")
+		b.GetModel().SetFieldFilter("")
 	}
 }
 
@@ -235,12 +275,18 @@ func (b *Browser) TableDataChanged(data *render.TableData) {
 	cancel = b.cancelFn
 	b.mx.RUnlock()
 
-	if !b.app.ConOK() || cancel == nil || !b.app.IsRunning() {
+	// Cached content is still rendered while offline and flagged as stale.
+	if cancel == nil || !b.app.IsRunning() {
+		return
+	}
+	// Skip repaints when the content did not change.
+	if h := data.Hash(); b.rendered.Swap(h) == h {
 		return
 	}
 
 	b.app.QueueUpdateDraw(func() {
 		b.refreshActions()
+		b.GetTable().SetStale(b.app.staleSince())
 		b.Update(data, b.app.Conn().HasMetrics())
 	})
 }
@@ -284,7 +330,7 @@ func (b *Browser) resetCmd(evt *tcell.EventKey) *tcell.EventKey {
 	}
 
 	b.CmdBuff().Reset()
-	if ui.IsLabelSelector(b.CmdBuff().GetText()) {
+	if ui.IsSelector(b.CmdBuff().GetText()) {
 		b.Start()
 	}
 	b.Refresh()
@@ -298,7 +344,7 @@ func (b *Browser) filterCmd(evt *tcell.EventKey) *tcell.EventKey {
 	}
 
 	b.CmdBuff().SetActive(false)
-	if ui.IsLabelSelector(b.CmdBuff().GetText()) {
+	if ui.IsSelector(b.CmdBuff().GetText()) {
 		b.Start()
 		return nil
 	}
@@ -309,7 +355,14 @@ func (b *Browser) filterCmd(evt *tcell.EventKey) *tcell.EventKey {
 
 func (b *Browser) enterCmd(evt *tcell.EventKey) *tcell.EventKey {
 	path := b.GetSelectedItem()
-	if b.filterCmd(evt) == nil || path == "" {
+	if b.filterCmd(evt) == nil {
+		return nil
+	}
+	if b.IsMoreSelected() {
+		b.loadMore()
+		return nil
+	}
+	if path == "" {
 		return nil
 	}
 
@@ -322,6 +375,24 @@ func (b *Browser) enterCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+// loadMore lists the next chunk of a partial listing.
+func (b *Browser) loadMore() {
+	p, ok := b.GetModel().(ui.Pager)
+	if !ok {
+		return
+	}
+	ctx := b.defaultContext()
+	if b.contextFn != nil {
+		ctx = b.contextFn(ctx)
+	}
+	b.app.Flash().Info("Loading more resources...")
+	go func() {
+		if err := p.LoadMore(ctx); err != nil {
+			b.app.Flash().Err(err)
+		}
+	}()
+}
+
 func (b *Browser) refreshCmd(*tcell.EventKey) *tcell.EventKey {
 	b.app.Flash().Info("Refreshing...")
 	b.refresh()
@@ -401,21 +472,9 @@ func (b *Browser) editCmd(evt *tcell.EventKey) *tcell.EventKey {
 		return nil
 	}
 
-	b.Stop()
-	defer b.Start()
-	{
-		args := make([]string, 0, 10)
-		args = append(args, "edit")
-		args = append(args, b.GVR().FQN(n))
-		if ns != client.AllNamespaces {
-			args = append(args, "-n", ns)
-		}
-		if !runK(b.app, shellOpts{clear: true, args: args}) {
-			b.app.Flash().Err(errors.New("Edit exec failed"))
-		}
-	}
+	b.editResource(path)
 
-	return evt
+	return nil
 }
 
 func (b *Browser) switchNamespaceCmd(evt *tcell.EventKey) *tcell.EventKey {
@@ -483,6 +542,10 @@ func (b *Browser) defaultContext() context.Context {
 		ctx = context.WithValue(ctx, internal.KeyLabels, ui.TrimLabelSelector(b.CmdBuff().GetText()))
 	}
 	ctx = context.WithValue(ctx, internal.KeyNamespace, client.CleanseNamespace(b.App().Config.ActiveNamespace()))
+	ctx = context.WithValue(ctx, internal.KeyNamespaces, b.App().Config.FavNamespaces())
+	if b.App().pricing != nil {
+		ctx = context.WithValue(ctx, internal.KeyPricing, b.App().pricing)
+	}
 
 	return ctx
 }
@@ -493,6 +556,7 @@ func (b *Browser) refreshActions() {
 	}
 	aa := ui.KeyActions{
 		ui.KeyC:        ui.NewKeyAction("Copy", b.cpCmd, false),
+		tcell.KeyCtrlY: ui.NewKeyAction("Copy...", b.copyCmd, false),
 		tcell.KeyEnter: ui.NewKeyAction("View", b.enterCmd, false),
 		tcell.KeyCtrlR: ui.NewKeyAction("Refresh", b.refreshCmd, false),
 	}
@@ -514,11 +578,17 @@ func (b *Browser) refreshActions() {
 		aa[ui.KeyD] = ui.NewKeyAction("Describe", b.describeCmd, true)
 	}
 
-	pluginActions(b, aa)
-	hotKeyActions(b, aa)
 	for _, f := range b.bindKeysFn {
 		f(aa)
 	}
+	pluginActions(b, aa)
+	unbound := hotKeyActions(b, aa)
+	scriptActions(b, aa)
+	for _, k := range unbound {
+		if _, ok := aa[k]; !ok {
+			b.Actions().Delete(k)
+		}
+	}
 	b.Actions().Add(aa)
 	b.app.Menu().HydrateMenu(b.Hints())
 }
@@ -542,50 +612,37 @@ func (b *Browser) namespaceActions(aa ui.KeyActions) {
 }
 
 func (b *Browser) simpleDelete(selections []string, msg string) {
-	dialog.ShowConfirm(b.app.Styles.Dialog(), b.app.Content.Pages, "Confirm Delete", msg, func() {
-		b.ShowDeleted()
-		if len(selections) > 1 {
-			b.app.Flash().Infof("Delete %d marked %s", len(selections), b.GVR())
-		} else {
-			b.app.Flash().Infof("Delete resource %s %s", b.GVR(), selections[0])
+	dialog.ShowConfirmDelete(b.app.Styles.Dialog(), b.app.Content.Pages, msg, deletePolicy(b.app, b.GVR(), selections), func() {
+		nuker, ok := b.accessor.(dao.Nuker)
+		if !ok {
+			b.app.Flash().Errf("Invalid nuker %T", b.accessor)
+			return
 		}
-		for _, sel := range selections {
-			nuker, ok := b.accessor.(dao.Nuker)
-			if !ok {
-				b.app.Flash().Errf("Invalid nuker %T", b.accessor)
-				continue
-			}
-			if err := nuker.Delete(context.Background(), sel, nil, dao.DefaultGrace); err != nil {
-				b.app.Flash().Errf("Delete failed with `%s", err)
-			} else {
-				b.app.factory.DeleteForwarder(sel)
+		b.ShowDeleted()
+		runBulk(b, "Delete", selections, func(ctx context.Context, sel string) error {
+			if err := nuker.Delete(ctx, sel, nil, dao.DefaultGrace); err != nil {
+				return err
 			}
-			b.GetTable().DeleteMark(sel)
-		}
-		b.refresh()
+			b.app.factory.DeleteForwarder(sel)
+			return nil
+		})
 	}, func() {})
 }
 
 func (b *Browser) resourceDelete(selections []string, msg string) {
-	dialog.ShowDelete(b.app.Styles.Dialog(), b.app.Content.Pages, msg, func(propagation *metav1.DeletionPropagation, force bool) {
-		b.ShowDeleted()
-		if len(selections) > 1 {
-			b.app.Flash().Infof("Delete %d marked %s", len(selections), b.GVR())
-		} else {
-			b.app.Flash().Infof("Delete resource %s %s", b.GVR(), selections[0])
+	dialog.ShowDelete(b.app.Styles.Dialog(), b.app.Content.Pages, msg, deletePolicy(b.app, b.GVR(), selections), func(propagation *metav1.DeletionPropagation, force bool) {
+		grace := dao.DefaultGrace
+		if force {
+			grace = dao.ForceGrace
 		}
-		for _, sel := range selections {
-			grace := dao.DefaultGrace
-			if force {
-				grace = dao.ForceGrace
-			}
-			if err := b.GetModel().Delete(b.defaultContext(), sel, propagation, grace); err != nil {
-				b.app.Flash().Errf("Delete failed with `%s", err)
-			} else {
-				b.app.factory.DeleteForwarder(sel)
+		b.ShowDeleted()
+		ctx := b.defaultContext()
+		runBulk(b, "Delete", selections, func(_ context.Context, sel string) error {
+			if err := b.GetModel().Delete(ctx, sel, propagation, grace); err != nil {
+				return err
 			}
-			b.GetTable().DeleteMark(sel)
-		}
-		b.refresh()
+			b.app.factory.DeleteForwarder(sel)
+			return nil
+		})
 	}, func() {})
 }
@@ -0,0 +1,93 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+)
+
+// bulkFunc applies an action to a single resource.
+type bulkFunc func(ctx context.Context, path string) error
+
+// runBulk applies an action to a collection of resources. A single resource
+// reports via flash, multiple resources are processed in the background with
+// per item outcomes streamed to a progress pane.
+func runBulk(v ResourceViewer, action string, paths []string, fn bulkFunc) {
+	app := v.App()
+	timeout := app.Conn().Config().CallTimeout()
+	if len(paths) == 1 {
+		errs := applyBulk(action, paths, timeout, fn, nil)
+		flashBulk(app, action, v.GVR().R(), paths, errs)
+		v.GetTable().DeleteMark(paths[0])
+		v.Refresh()
+		return
+	}
+
+	d := NewDetails(app, action+" Progress", fmt.Sprintf("%d %s", len(paths), v.GVR().R()), true)
+	if err := app.inject(d, false); err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	w := progressWriter{app: app, w: d.GetWriter()}
+	go func() {
+		errs := applyBulk(action, paths, timeout, fn, &w)
+		app.QueueUpdateDraw(func() {
+			for _, path := range paths {
+				v.GetTable().DeleteMark(path)
+			}
+			flashBulk(app, action, v.GVR().R(), paths, errs)
+			v.Refresh()
+		})
+	}()
+}
+
+// applyBulk applies an action to each resource in turn and returns the
+// failures keyed by path. Per item outcomes and a summary are written to w
+// when set.
+func applyBulk(action string, paths []string, timeout time.Duration, fn bulkFunc, w io.Writer) map[string]error {
+	errs := make(map[string]error)
+	for i, path := range paths {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		err := fn(ctx, path)
+		cancel()
+		status := "OK"
+		if err != nil {
+			errs[path] = err
+			status = "FAILED " + err.Error()
+		}
+		if w != nil {
+			fmt.Fprintf(w, "[%d/%d] %s %s\n", i+1, len(paths), path, status)
+		}
+	}
+	if w != nil {
+		fmt.Fprintf(w, "\n%s completed: %d succeeded, %d failed\n", action, len(paths)-len(errs), len(errs))
+	}
+
+	return errs
+}
+
+func flashBulk(app *App, action, res string, paths []string, errs map[string]error) {
+	msg, ok := bulkSummary(action, res, paths, errs)
+	if ok {
+		app.Flash().Info(msg)
+	} else {
+		app.Flash().Errf("%s", msg)
+	}
+}
+
+// bulkSummary returns a bulk action outcome message and whether all
+// resources succeeded.
+func bulkSummary(action, res string, paths []string, errs map[string]error) (string, bool) {
+	if len(paths) == 1 {
+		if err, ok := errs[paths[0]]; ok {
+			return fmt.Sprintf("%s failed for %s: %s", action, paths[0], err), false
+		}
+		return fmt.Sprintf("%s succeeded for %s", action, paths[0]), true
+	}
+	if len(errs) > 0 {
+		return fmt.Sprintf("%s failed for %d/%d %s", action, len(errs), len(paths), res), false
+	}
+
+	return fmt.Sprintf("%s succeeded for %d %s", action, len(paths), res), true
+}
@@ -0,0 +1,85 @@
+package view
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplyBulk(t *testing.T) {
+	uu := map[string]struct {
+		paths    []string
+		fail     map[string]bool
+		progress bool
+		errs     []string
+		out      string
+		msg      string
+		ok       bool
+	}{
+		"single-ok": {
+			paths: []string{"ns1/p1"},
+			msg:   "Delete succeeded for ns1/p1",
+			ok:    true,
+		},
+		"single-fail": {
+			paths: []string{"ns1/p1"},
+			fail:  map[string]bool{"ns1/p1": true},
+			errs:  []string{"ns1/p1"},
+			msg:   "Delete failed for ns1/p1: boom ns1/p1",
+		},
+		"all-ok": {
+			paths:    []string{"ns1/p1", "ns1/p2"},
+			progress: true,
+			out:      "[1/2] ns1/p1 OK\n[2/2] ns1/p2 OK\n\nDelete completed: 2 succeeded, 0 failed\n",
+			msg:      "Delete succeeded for 2 pods",
+			ok:       true,
+		},
+		"some-fail": {
+			paths:    []string{"ns1/p1", "ns1/p2", "ns1/p3"},
+			fail:     map[string]bool{"ns1/p2": true},
+			progress: true,
+			errs:     []string{"ns1/p2"},
+			out:      "[1/3] ns1/p1 OK\n[2/3] ns1/p2 FAILED boom ns1/p2\n[3/3] ns1/p3 OK\n\nDelete completed: 2 succeeded, 1 failed\n",
+			msg:      "Delete failed for 1/3 pods",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var seen []string
+			fn := func(ctx context.Context, path string) error {
+				_, ok := ctx.Deadline()
+				assert.True(t, ok)
+				seen = append(seen, path)
+				if u.fail[path] {
+					return errors.New("boom " + path)
+				}
+				return nil
+			}
+			var (
+				buff strings.Builder
+				w    io.Writer
+			)
+			if u.progress {
+				w = &buff
+			}
+			errs := applyBulk("Delete", u.paths, time.Second, fn, w)
+
+			assert.Equal(t, u.paths, seen)
+			assert.Equal(t, len(u.errs), len(errs))
+			for _, p := range u.errs {
+				assert.Contains(t, errs, p)
+			}
+			assert.Equal(t, u.out, buff.String())
+			msg, ok := bulkSummary("Delete", "pods", u.paths, errs)
+			assert.Equal(t, u.msg, msg)
+			assert.Equal(t, u.ok, ok)
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package view
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/atotto/clipboard"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	copyName = "name"
+	copyPath = "path"
+	copyYAML = "yaml"
+	copyCell = "cell:"
+	copyKind = "copy"
+)
+
+// clipboardWrite copies text to the system clipboard. Over SSH or when no
+// clipboard is available, an OSC52 sequence is emitted so the local terminal
+// picks up the content.
+func clipboardWrite(text string) error {
+	if !isSSH() {
+		err := clipboard.WriteAll(text)
+		if err == nil {
+			return nil
+		}
+		log.Warn().Err(err).Msg("System clipboard unavailable. Falling back to OSC52")
+	}
+	tty, err := os.OpenFile("/dev/tty", os.O_WRONLY, 0)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := tty.Close(); err != nil {
+			log.Error().Err(err).Msg("Closing tty")
+		}
+	}()
+
+	return osc52(tty, text, os.Getenv("TMUX") != "")
+}
+
+func isSSH() bool {
+	return os.Getenv("SSH_TTY") != "" || os.Getenv("SSH_CONNECTION") != ""
+}
+
+// osc52 writes a clipboard escape sequence, wrapped for tmux passthrough if needed.
+func osc52(w io.Writer, text string, tmux bool) error {
+	seq := "\x1b]52;c;" + base64.StdEncoding.EncodeToString([]byte(text)) + "\a"
+	if tmux {
+		seq = "\x1bPtmux;\x1b" + seq + "\x1b\\"
+	}
+	_, err := io.WriteString(w, seq)
+
+	return err
+}
+
+func (b *Browser) copyCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := b.GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	ee := model.PaletteEntries{
+		{Command: copyName, Kind: copyKind, Help: "Resource name"},
+		{Command: copyPath, Kind: copyKind, Help: "Resource namespace/name"},
+		{Command: copyYAML, Kind: copyKind, Help: "Resource manifest"},
+	}
+	for i := 0; i < b.GetColumnCount(); i++ {
+		if col := b.columnName(i); col != "" {
+			ee = append(ee, model.PaletteEntry{Command: copyCell + col, Kind: copyKind, Help: b.GetSelectedCell(i)})
+		}
+	}
+	p := ui.NewPalette(b.app.Styles, ee, model.NewHistory(0))
+	p.SetHeading("Copy")
+	b.app.showPalette(p, func(cmd string) {
+		text, err := b.copyText(path, cmd)
+		if err != nil {
+			b.app.Flash().Err(err)
+			return
+		}
+		if err := clipboardWrite(text); err != nil {
+			b.app.Flash().Err(err)
+			return
+		}
+		b.app.Flash().Infof("Copied %s to clipboard...", cmd)
+	})
+
+	return nil
+}
+
+func (b *Browser) copyText(path, what string) (string, error) {
+	switch {
+	case what == copyName:
+		_, n := client.Namespaced(path)
+		return n, nil
+	case what == copyPath:
+		return path, nil
+	case what == copyYAML:
+		return model.NewYAML(b.GVR(), path).ToYAML(b.defaultContext(), b.GVR(), path, false)
+	case strings.HasPrefix(what, copyCell):
+		if idx, ok := b.HeaderIndex(strings.TrimPrefix(what, copyCell)); ok {
+			return b.GetSelectedCell(idx), nil
+		}
+	}
+
+	return "", fmt.Errorf("nothing to copy for %q", what)
+}
@@ -0,0 +1,32 @@
+package view
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOSC52(t *testing.T) {
+	uu := map[string]struct {
+		tmux bool
+		e    string
+	}{
+		"plain": {
+			e: "\x1b]52;c;ZnJlZA==\a",
+		},
+		"tmux": {
+			tmux: true,
+			e:    "\x1bPtmux;\x1b\x1b]52;c;ZnJlZA==\a\x1b\\",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var buff bytes.Buffer
+			assert.Nil(t, osc52(&buff, "fred", u.tmux))
+			assert.Equal(t, u.e, buff.String())
+		})
+	}
+}
@@ -102,9 +102,17 @@ func (c *ClusterInfo) ClusterInfoChanged(prev, curr model.ClusterMeta) {
 	c.app.QueueUpdateDraw(func() {
 		c.Clear()
 		c.layout()
-		row := c.setCell(0, curr.Context)
+		ctx := curr.Context
+		if color := c.app.Config.K9s.ContextsConfig().Color(ctx); color != "" {
+			ctx = fmt.Sprintf("[%s::b]%s", color, ctx)
+		}
+		row := c.setCell(0, ctx)
 		row = c.setCell(row, curr.Cluster)
-		row = c.setCell(row, curr.User)
+		if curr.Impersonated {
+			row = c.setCell(row, fmt.Sprintf("%s [orangered::b](as)", curr.User))
+		} else {
+			row = c.setCell(row, curr.User)
+		}
 		if curr.K9sLatest != "" {
 			row = c.setCell(row, fmt.Sprintf("%s ⚡️[cadetblue::b]%s", curr.K9sVer, curr.K9sLatest))
 		} else {
@@ -0,0 +1,238 @@
+package view
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+)
+
+const (
+	columnsTitle    = "Columns"
+	columnsTitleFmt = "[fg:bg:b] %s([hilite:bg:b]%s[fg:bg:-]) "
+)
+
+type columnItem struct {
+	spec, name string
+	visible    bool
+}
+
+// ColumnChooser represents a table columns layout editor.
+type ColumnChooser struct {
+	*tview.List
+
+	app     *App
+	table   *Table
+	actions ui.KeyActions
+	items   []columnItem
+}
+
+// NewColumnChooser returns a new columns chooser for a given table.
+func NewColumnChooser(app *App, t *Table) *ColumnChooser {
+	return &ColumnChooser{
+		List:    tview.NewList(),
+		app:     app,
+		table:   t,
+		actions: make(ui.KeyActions),
+		items:   tableColumns(t),
+	}
+}
+
+// Init initializes the view.
+func (c *ColumnChooser) Init(_ context.Context) error {
+	c.SetBorder(true)
+	c.SetBorderPadding(0, 0, 1, 1)
+	c.ShowSecondaryText(false)
+	c.app.Styles.AddListener(c)
+	c.StylesChanged(c.app.Styles)
+	c.actions.Add(ui.KeyActions{
+		tcell.KeyEscape: ui.NewKeyAction("Back", c.app.PrevCmd, true),
+		ui.KeySpace:     ui.NewKeyAction("Show/Hide", c.toggleCmd, true),
+		ui.KeyShiftK:    ui.NewKeyAction("Move Up", c.moveCmd(-1), true),
+		ui.KeyShiftJ:    ui.NewKeyAction("Move Down", c.moveCmd(1), true),
+		tcell.KeyCtrlS:  ui.NewKeyAction("Save", c.saveCmd, true),
+		tcell.KeyCtrlR:  ui.NewKeyAction("Reset", c.resetCmd, true),
+	})
+	c.SetInputCapture(func(evt *tcell.EventKey) *tcell.EventKey {
+		if a, ok := c.actions[ui.AsKey(evt)]; ok {
+			return a.Action(evt)
+		}
+		return evt
+	})
+	c.populate(0)
+
+	return nil
+}
+
+// InCmdMode checks if prompt is active.
+func (*ColumnChooser) InCmdMode() bool {
+	return false
+}
+
+// Start starts the view.
+func (c *ColumnChooser) Start() {}
+
+// Stop stops the view.
+func (c *ColumnChooser) Stop() {
+	c.app.Styles.RemoveListener(c)
+}
+
+// StylesChanged notifies the skin changed.
+func (c *ColumnChooser) StylesChanged(s *config.Styles) {
+	c.SetBackgroundColor(s.BgColor())
+	c.SetMainTextColor(s.Table().FgColor.Color())
+	c.SetSelectedTextColor(s.Table().CursorFgColor.Color())
+	c.SetSelectedBackgroundColor(s.Table().CursorBgColor.Color())
+	c.SetBorderColor(s.Frame().Border.FgColor.Color())
+	c.SetBorderFocusColor(s.Frame().Border.FocusColor.Color())
+	c.SetTitle(ui.SkinTitle(fmt.Sprintf(columnsTitleFmt, columnsTitle, c.table.GVR()), s.Frame()))
+}
+
+// Name returns the component name.
+func (c *ColumnChooser) Name() string { return columnsTitle }
+
+// Hints returns the view hints.
+func (c *ColumnChooser) Hints() model.MenuHints {
+	return c.actions.Hints()
+}
+
+// ExtraHints returns additional hints.
+func (c *ColumnChooser) ExtraHints() map[string]string {
+	return nil
+}
+
+// Layout returns the current columns layout.
+func (c *ColumnChooser) Layout() []string {
+	cols := make([]string, 0, len(c.items))
+	for _, it := range c.items {
+		if it.visible {
+			cols = append(cols, it.spec)
+		}
+	}
+
+	return cols
+}
+
+func (c *ColumnChooser) toggleCmd(evt *tcell.EventKey) *tcell.EventKey {
+	idx := c.GetCurrentItem()
+	if idx < 0 || idx >= len(c.items) {
+		return nil
+	}
+	c.items[idx].visible = !c.items[idx].visible
+	c.populate(idx)
+
+	return nil
+}
+
+func (c *ColumnChooser) moveCmd(dir int) func(evt *tcell.EventKey) *tcell.EventKey {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		idx := c.GetCurrentItem()
+		to := idx + dir
+		if idx < 0 || to < 0 || to >= len(c.items) {
+			return nil
+		}
+		c.items[idx], c.items[to] = c.items[to], c.items[idx]
+		c.populate(to)
+
+		return nil
+	}
+}
+
+func (c *ColumnChooser) saveCmd(evt *tcell.EventKey) *tcell.EventKey {
+	cols := c.Layout()
+	if len(cols) == 0 {
+		c.app.Flash().Warn("At least one column must be visible")
+		return nil
+	}
+	c.save(cols)
+
+	return nil
+}
+
+func (c *ColumnChooser) resetCmd(evt *tcell.EventKey) *tcell.EventKey {
+	c.save(nil)
+
+	return nil
+}
+
+func (c *ColumnChooser) save(cols []string) {
+	if c.app.CustomView == nil {
+		c.app.CustomView = config.NewCustomView()
+	}
+	c.app.CustomView.SetColumns(c.table.GVR().String(), cols)
+	if err := c.app.CustomView.Save(config.K9sViewConfigFile); err != nil {
+		c.app.Flash().Err(err)
+		return
+	}
+	c.app.Flash().Infof("Columns layout saved for %s", c.table.GVR())
+	c.app.PrevCmd(nil)
+}
+
+func (c *ColumnChooser) populate(sel int) {
+	c.Clear()
+	for _, it := range c.items {
+		mark := "◯"
+		if it.visible {
+			mark = "◉"
+		}
+		c.AddItem(mark+" "+it.name, "", 0, nil)
+	}
+	c.SetCurrentItem(sel)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// tableColumns returns the visible columns in order followed by the hidden ones.
+func tableColumns(t *Table) []columnItem {
+	header := t.GetModel().Peek().Header
+	var (
+		layout []string
+		plain  bool
+	)
+	specs := make(map[string]string)
+	if vs := t.ViewSetting(); vs != nil {
+		for _, l := range vs.Columns {
+			if cc, ok := render.ParseCustomColumn(l); ok {
+				specs[cc.Name] = l
+				continue
+			}
+			plain = true
+		}
+		if plain {
+			layout = vs.Columns
+		}
+	}
+	if !plain {
+		layout = header.Columns(false)
+	}
+
+	items := make([]columnItem, 0, len(header)+len(layout))
+	seen := make(map[string]struct{}, len(header))
+	for _, l := range layout {
+		it := columnItem{spec: l, name: l, visible: true}
+		if cc, ok := render.ParseCustomColumn(l); ok {
+			it.name = cc.Name
+		} else if s, ok := specs[l]; ok {
+			it.spec = s
+		}
+		items, seen[it.name] = append(items, it), struct{}{}
+	}
+	for _, h := range header {
+		if _, ok := seen[h.Name]; ok || h.Hide {
+			continue
+		}
+		it := columnItem{spec: h.Name, name: h.Name}
+		if s, ok := specs[h.Name]; ok {
+			it.spec = s
+		}
+		items = append(items, it)
+	}
+
+	return items
+}
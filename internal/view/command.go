@@ -18,6 +18,22 @@ var (
 	customViewers MetaViewers
 
 	canRX = regexp.MustCompile(`\Acan\s([u|g|s]):([\w-:]+)\b`)
+
+	paletteCommands = model.PaletteEntries{
+		{Command: "alias", Kind: model.PaletteCommand, Help: "List all available aliases"},
+		{Command: "apply", Kind: model.PaletteCommand, Help: "apply PATH -- Apply a manifest file or directory"},
+		{Command: "as", Kind: model.PaletteCommand, Help: "as USER [GROUP,...] -- Impersonate a user"},
+		{Command: "export", Kind: model.PaletteCommand, Help: "export [csv|json|yaml] -- Export the current table to the screen dump directory"},
+		{Command: "help", Kind: model.PaletteCommand, Help: "Show key bindings"},
+		{Command: "keys", Kind: model.PaletteCommand, Help: "Show the effective key bindings for the current view"},
+		{Command: "preview", Kind: model.PaletteCommand, Help: "preview [describe|yaml|events] [right|bottom] -- Toggle a detail pane following the selected row"},
+		{Command: "quit", Kind: model.PaletteCommand, Help: "Exit k9s"},
+		{Command: "snapshot", Kind: model.PaletteCommand, Help: "snapshot [html|ansi] [all] -- Save the rendered screen, and other workspaces with all, to the screen dump directory"},
+		{Command: "split", Kind: model.PaletteCommand, Help: "split CONTEXT [RESOURCE] -- Compare two contexts side by side"},
+		{Command: "view", Kind: model.PaletteCommand, Help: "view [NAME | save NAME | delete NAME] -- Manage saved views"},
+		{Command: "xray", Kind: model.PaletteCommand, Help: "xray RESOURCE [NAMESPACE] -- Show resources relationships"},
+		{Command: "zen", Kind: model.PaletteCommand, Help: "Toggle the minimal layout showing only the table and status bar"},
+	}
 )
 
 // Command represents a user command.
@@ -62,6 +78,24 @@ func (c *Command) Reset(clear bool) error {
 	return nil
 }
 
+// paletteEntries returns the command palette entries.
+func (c *Command) paletteEntries() model.PaletteEntries {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	ee := make(model.PaletteEntries, 0, len(paletteCommands)+100)
+	ee = append(ee, paletteCommands...)
+	for _, k := range c.alias.Keys() {
+		gvr, ok := c.alias.Aliases.Get(k)
+		if !ok || gvr == k {
+			continue
+		}
+		ee = append(ee, model.PaletteEntry{Command: k, Kind: model.PaletteResource, Help: gvr})
+	}
+
+	return ee
+}
+
 func allowedXRay(gvr client.GVR) bool {
 	gg := []string{
 		"v1/pods",
@@ -193,6 +227,79 @@ func (c *Command) specialCmd(cmd, path string) bool {
 			c.app.Flash().Err(err)
 		}
 		return true
+	case "as":
+		var groups []string
+		if len(cmds) > 2 {
+			groups = strings.Split(cmds[2], ",")
+		}
+		user := ""
+		if len(cmds) > 1 {
+			user = cmds[1]
+		}
+		if err := c.app.impersonate(user, groups); err != nil {
+			c.app.Flash().Err(err)
+		}
+		return true
+	case "split":
+		if len(cmds) < 2 {
+			c.app.Flash().Err(errors.New("You must specify a context to split with"))
+			return true
+		}
+		if err := c.app.inject(NewSplit(c.app, cmds[1], strings.Join(cmds[2:], " ")), false); err != nil {
+			c.app.Flash().Err(err)
+		}
+		return true
+	case "export":
+		format := exportCSV
+		if len(cmds) > 1 {
+			format = strings.ToLower(cmds[1])
+		}
+		if err := c.app.exportCmd(format); err != nil {
+			c.app.Flash().Err(err)
+		}
+		return true
+	case "keys":
+		if err := c.app.keysCmd(); err != nil {
+			c.app.Flash().Err(err)
+		}
+		return true
+	case "preview":
+		if err := c.app.previewCmd(cmds[1:]); err != nil {
+			c.app.Flash().Err(err)
+		}
+		return true
+	case "snapshot":
+		if err := c.app.snapshotCmd(cmds[1:]); err != nil {
+			c.app.Flash().Err(err)
+		}
+		return true
+	case "zen":
+		c.app.toggleZen(!c.app.zen)
+		return true
+	case "view":
+		if err := c.app.viewCmd(cmds[1:]); err != nil {
+			c.app.Flash().Err(err)
+		}
+		return true
+	case "new":
+		if err := c.app.newCmd(cmds[1:]); err != nil {
+			c.app.Flash().Err(err)
+		}
+		return true
+	case "explain":
+		if err := c.app.explainCmd(cmds[1:]); err != nil {
+			c.app.Flash().Err(err)
+		}
+		return true
+	case "apply":
+		if len(cmds) != 2 {
+			c.app.Flash().Err(errors.New("You must specify a manifest file or directory"))
+			return true
+		}
+		if err := c.app.applyCmd(cmds[1]); err != nil {
+			c.app.Flash().Err(err)
+		}
+		return true
 	default:
 		if !canRX.MatchString(cmd) {
 			return false
@@ -231,6 +338,9 @@ func (c *Command) componentFor(gvr, path string, v *MetaViewer) ResourceViewer {
 		view = NewBrowser(client.NewGVR(gvr))
 	}
 
+	if meta, err := dao.MetaAccess.MetaFor(client.NewGVR(gvr)); err == nil && gvr != eventsGVR && dao.IsK8sMeta(meta) {
+		view = NewMetaExtender(NewOwnerExtender(NewDiffExtender(NewEventsExtender(view))))
+	}
 	view.SetInstance(path)
 	if v.enterFn != nil {
 		view.GetTable().SetEnterFn(v.enterFn)
@@ -272,7 +382,7 @@ func (c *Command) exec(cmd, gvr string, comp model.Component, clearStack bool) (
 		return err
 	}
 
-	c.app.cmdHistory.Push(cmd)
+	c.app.recordCmd(cmd)
 
 	return
 }
@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
@@ -26,16 +28,48 @@ type Container struct {
 // NewContainer returns a new container view.
 func NewContainer(gvr client.GVR) ResourceViewer {
 	c := Container{}
-	c.ResourceViewer = NewLogsExtender(NewBrowser(gvr), c.logOptions)
+	c.ResourceViewer = NewScanExtender(NewFileTransferExtender(NewLogsExtender(NewBrowser(gvr), c.logOptions)))
 	c.SetEnvFn(c.k9sEnv)
 	c.GetTable().SetEnterFn(c.viewLogs)
 	c.GetTable().SetDecorateFn(c.decorateRows)
 	c.AddBindKeysFn(c.bindKeys)
 	c.GetTable().SetDecorateFn(c.portForwardIndicator)
+	c.GetTable().SetGroupFn(groupByRole)
 
 	return &c
 }
 
+// groupByRole groups containers by role in pod startup order.
+func groupByRole(h render.Header, rr render.RowEvents) []ui.RowGroup {
+	col := h.IndexOf("ROLE", true)
+	if col < 0 {
+		return []ui.RowGroup{{Rows: rr}}
+	}
+	roles := make(map[string]render.RowEvents, len(render.ContainerRoles))
+	for _, re := range rr {
+		role := strings.TrimSpace(re.Row.Fields[col])
+		roles[role] = append(roles[role], re)
+	}
+	gg := make([]ui.RowGroup, 0, len(roles))
+	for _, role := range render.ContainerRoles {
+		if ee, ok := roles[role]; ok {
+			gg = append(gg, ui.RowGroup{Heading: render.ContainerSubtotals(role, h, ee), Rows: ee})
+			delete(roles, role)
+		}
+	}
+	others := make([]string, 0, len(roles))
+	for role := range roles {
+		others = append(others, role)
+	}
+	sort.Strings(others)
+	for _, role := range others {
+		ee := roles[role]
+		gg = append(gg, ui.RowGroup{Heading: render.ContainerSubtotals(role, h, ee), Rows: ee})
+	}
+
+	return gg
+}
+
 func (c *Container) portForwardIndicator(data *render.TableData) {
 	ff := c.App().factory.Forwarders()
 	col := data.IndexOfHeader("PF")
@@ -0,0 +1,67 @@
+package view
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+const containerTopTitle = "Top"
+
+// ContainerTop represents a live containers usage view.
+type ContainerTop struct {
+	ResourceViewer
+}
+
+// NewContainerTop returns a new view.
+func NewContainerTop(gvr client.GVR) ResourceViewer {
+	c := ContainerTop{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	c.GetTable().SetSortCol(cpuCol, false)
+	c.GetTable().SetEnterFn(c.showContainers)
+	c.AddBindKeysFn(c.bindKeys)
+
+	return &c
+}
+
+// Init initializes the view.
+func (c *ContainerTop) Init(ctx context.Context) error {
+	if err := c.ResourceViewer.Init(ctx); err != nil {
+		return err
+	}
+	c.GetTable().GetModel().SetRefreshRate(time.Duration(c.App().Config.K9s.GetTopRefreshRate()) * time.Second)
+
+	return nil
+}
+
+// Name returns the component name.
+func (c *ContainerTop) Name() string { return containerTopTitle }
+
+func (c *ContainerTop) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftK: ui.NewKeyAction("Sort Rank", c.GetTable().SortColCmd("RANK", true), false),
+		ui.KeyShiftP: ui.NewKeyAction("Sort Pod", c.GetTable().SortColCmd("POD", true), false),
+	})
+	aa.Add(resourceSorters(c.GetTable()))
+}
+
+func (c *ContainerTop) showContainers(app *App, _ ui.Tabular, _, path string) {
+	pod := path
+	if i := strings.LastIndex(path, ":"); i > 0 {
+		pod = path[:i]
+	}
+	co := NewContainer(client.NewGVR("containers"))
+	co.SetContextFn(func(ctx context.Context) context.Context {
+		return context.WithValue(ctx, internal.KeyPath, pod)
+	})
+	if err := app.inject(co, false); err != nil {
+		app.Flash().Err(err)
+	}
+}
@@ -0,0 +1,17 @@
+package view_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/view"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContainerTopNew(t *testing.T) {
+	c := view.NewContainerTop(client.NewGVR("top"))
+
+	assert.Nil(t, c.Init(makeCtx()))
+	assert.Equal(t, "Top", c.Name())
+	assert.Equal(t, 13, len(c.Hints()))
+}
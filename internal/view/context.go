@@ -1,10 +1,13 @@
 package view
 
 import (
+	"context"
 	"errors"
 
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/tcell/v2"
 	"github.com/rs/zerolog/log"
@@ -26,6 +29,28 @@ func NewContext(gvr client.GVR) ResourceViewer {
 	return &c
 }
 
+// Init initializes the view.
+func (c *Context) Init(ctx context.Context) error {
+	if err := c.ResourceViewer.Init(ctx); err != nil {
+		return err
+	}
+	c.GetTable().SetFuzzyFilter(true)
+	c.GetTable().SetColorerFn(c.colorerFn(render.Context{}.ColorerFunc()))
+
+	return nil
+}
+
+// colorerFn colors contexts rows using their configured badge color.
+func (c *Context) colorerFn(def render.ColorerFunc) render.ColorerFunc {
+	return func(ns string, h render.Header, re render.RowEvent) tcell.Color {
+		if color := c.App().Config.K9s.ContextsConfig().Color(re.Row.ID); color != "" {
+			return config.NewColor(color).Color()
+		}
+
+		return def(ns, h, re)
+	}
+}
+
 func (c *Context) bindKeys(aa ui.KeyActions) {
 	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlSpace, ui.KeySpace)
 }
@@ -140,7 +140,7 @@ func (c *CronJob) showSuspendDialog(sel string) {
 }
 
 func (c *CronJob) makeSuspendForm(sel string, suspend bool) *tview.Form {
-	f := c.makeStyledForm()
+	f := newStyledForm(c.App().Styles.Dialog())
 	action := "suspended"
 	if !suspend {
 		action = "resumed"
@@ -178,18 +178,6 @@ func (c *CronJob) toggleSuspend(ctx context.Context, path string) error {
 	return cronJob.ToggleSuspend(ctx, path)
 }
 
-func (c *CronJob) makeStyledForm() *tview.Form {
-	f := tview.NewForm()
-	f.SetItemPadding(0)
-	f.SetButtonsAlign(tview.AlignCenter).
-		SetButtonBackgroundColor(tview.Styles.PrimitiveBackgroundColor).
-		SetButtonTextColor(tview.Styles.PrimaryTextColor).
-		SetLabelColor(tcell.ColorAqua).
-		SetFieldTextColor(tcell.ColorOrange)
-
-	return f
-}
-
 func (c *CronJob) dismissDialog() {
 	c.App().Content.RemovePage(suspendDialogKey)
 }
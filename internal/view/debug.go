@@ -0,0 +1,46 @@
+package view
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+// Debug represents the k9s self metrics viewer.
+type Debug struct {
+	ResourceViewer
+}
+
+// NewDebug returns a new self metrics view.
+func NewDebug(gvr client.GVR) ResourceViewer {
+	d := Debug{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	d.GetTable().SetBorderFocusColor(tcell.ColorMediumSpringGreen)
+	d.GetTable().SetSelectedStyle(tcell.StyleDefault.Foreground(tcell.ColorWhite).Background(tcell.ColorMediumSpringGreen).Attributes(tcell.AttrNone))
+	d.GetTable().SetSortCol("SECTION", true)
+	d.AddBindKeysFn(d.bindKeys)
+
+	return &d
+}
+
+// Init initializes the view.
+func (d *Debug) Init(ctx context.Context) error {
+	if err := d.ResourceViewer.Init(ctx); err != nil {
+		return err
+	}
+	d.GetTable().GetModel().SetNamespace(client.AllNamespaces)
+
+	return nil
+}
+
+func (d *Debug) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Delete(tcell.KeyCtrlW, tcell.KeyCtrlL, tcell.KeyCtrlZ, tcell.KeyCtrlD)
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftS: ui.NewKeyAction("Sort Section", d.GetTable().SortColCmd("SECTION", true), false),
+		ui.KeyShiftN: ui.NewKeyAction("Sort Name", d.GetTable().SortColCmd("NAME", true), false),
+	})
+}
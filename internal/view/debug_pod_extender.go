@@ -0,0 +1,235 @@
+package view
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+	"github.com/fatih/color"
+	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	k9sDebug           = "k9s-debug"
+	debugPodPollDelay  = time.Second
+	debugPodNukeWindow = 5 * time.Second
+)
+
+// DebugPodExtender launches troubleshooting pods.
+type DebugPodExtender struct {
+	ResourceViewer
+}
+
+// NewDebugPodExtender returns a new extender.
+func NewDebugPodExtender(r ResourceViewer) ResourceViewer {
+	d := DebugPodExtender{ResourceViewer: r}
+	d.AddBindKeysFn(d.bindKeys)
+
+	return &d
+}
+
+func (d *DebugPodExtender) bindKeys(aa ui.KeyActions) {
+	if d.App().Config.K9s.IsReadOnly() {
+		return
+	}
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftB: ui.NewKeyAction("Debug Pod", d.debugPodCmd, true),
+	})
+}
+
+func (d *DebugPodExtender) debugPodCmd(evt *tcell.EventKey) *tcell.EventKey {
+	cfg := d.App().Config.K9s.ActiveCluster().DebugPodConfig()
+	ns, node := d.target(cfg)
+	if err := launchDebugPod(d.App(), d, ns, node, cfg); err != nil {
+		d.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+// target returns the debug pod namespace and node given the selection.
+func (d *DebugPodExtender) target(cfg *config.DebugPod) (string, string) {
+	ns, node := cfg.Namespace, cfg.Node
+	sel := d.GetTable().GetSelectedItem()
+	switch d.GVR().String() {
+	case "v1/nodes":
+		if sel != "" {
+			_, node = client.Namespaced(sel)
+		}
+	case "v1/namespaces":
+		if sel != "" {
+			_, ns = client.Namespaced(sel)
+		}
+	case "v1/pods":
+		if ns == "" && sel != "" && client.IsAllNamespaces(d.App().Config.ActiveNamespace()) {
+			ns, _ = client.Namespaced(sel)
+		}
+	}
+	if ns == "" {
+		ns = d.App().Config.ActiveNamespace()
+	}
+	if client.IsAllNamespaces(ns) {
+		ns = client.DefaultNamespace
+	}
+
+	return ns, node
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// launchDebugPod creates a troubleshooting pod, shells into it once ready and
+// deletes it when the shell exits.
+func launchDebugPod(a *App, c ResourceViewer, ns, node string, cfg *config.DebugPod) error {
+	dial, err := a.Conn().Dial()
+	if err != nil {
+		return err
+	}
+	spec := k9sDebugPod(ns, node, cfg)
+	ctx, cancel := context.WithTimeout(context.Background(), a.Conn().Config().CallTimeout())
+	defer cancel()
+	if _, err := dial.CoreV1().Pods(ns).Create(ctx, &spec, metav1.CreateOptions{}); err != nil {
+		return err
+	}
+	fqn := client.FQN(ns, spec.Name)
+	dao.RecordAudit(a.factory, "create", "v1/pods", fqn, nil)
+
+	ctx, cancel = context.WithTimeout(context.Background(), cfg.ReadyTimeoutDuration())
+	done := a.trackCall(fmt.Sprintf("Waiting for debug pod %s", fqn), cancel)
+	go func() {
+		defer done()
+		defer cancel()
+		err := waitPodReady(ctx, a, fqn)
+		a.QueueUpdateDraw(func() {
+			if err != nil {
+				a.Flash().Errf("Debug pod %s not ready: %s", fqn, err)
+				nukeDebugPod(a, fqn)
+				return
+			}
+			c.Stop()
+			defer c.Start()
+			bc := color.New(color.BgGreen).Add(color.FgBlack).Add(color.Bold)
+			args := append(buildShellArgs("exec", fqn, k9sDebug, a.Conn().Config().Flags().KubeConfig), "--", "sh", "-c", shellCheck)
+			if !runK(a, shellOpts{clear: true, banner: bc.Sprintf(bannerFmt, fqn, k9sDebug), args: args}) {
+				a.Flash().Err(errors.New("Shell exec failed"))
+			}
+			nukeDebugPod(a, fqn)
+		})
+	}()
+
+	return nil
+}
+
+// waitPodReady polls a pod until it is ready, terminated or the context is done.
+func waitPodReady(ctx context.Context, a *App, fqn string) error {
+	dial, err := a.Conn().Dial()
+	if err != nil {
+		return err
+	}
+	ns, n := client.Namespaced(fqn)
+	for {
+		po, err := dial.CoreV1().Pods(ns).Get(ctx, n, metav1.GetOptions{})
+		if err != nil && ctx.Err() == nil {
+			log.Warn().Err(err).Msgf("Debug pod %s check failed", fqn)
+		}
+		if err == nil {
+			if ok, err := podReady(po); ok || err != nil {
+				return err
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(debugPodPollDelay):
+		}
+	}
+}
+
+// podReady checks if a pod is ready. Terminated pods are reported as errors.
+func podReady(po *v1.Pod) (bool, error) {
+	switch po.Status.Phase {
+	case v1.PodFailed, v1.PodSucceeded:
+		return false, fmt.Errorf("pod terminated with phase %s", po.Status.Phase)
+	}
+	for _, c := range po.Status.Conditions {
+		if c.Type == v1.PodReady {
+			return c.Status == v1.ConditionTrue, nil
+		}
+	}
+
+	return false, nil
+}
+
+func nukeDebugPod(a *App, fqn string) {
+	dial, err := a.Conn().Dial()
+	if err != nil {
+		a.Flash().Err(err)
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), debugPodNukeWindow)
+	defer cancel()
+	var grace int64
+	ns, n := client.Namespaced(fqn)
+	err = dial.CoreV1().Pods(ns).Delete(ctx, n, metav1.DeleteOptions{GracePeriodSeconds: &grace})
+	if err != nil && !kerrors.IsNotFound(err) {
+		a.Flash().Errf("Debug pod %s teardown failed: %s", fqn, err)
+		return
+	}
+	dao.RecordAudit(a.factory, "delete", "v1/pods", fqn, nil)
+	a.Flash().Infof("Debug pod %s deleted", fqn)
+}
+
+func k9sDebugPodName() string {
+	return fmt.Sprintf("%s-%d-%d", k9sDebug, os.Getpid(), time.Now().Unix())
+}
+
+func k9sDebugPod(ns, node string, cfg *config.DebugPod) v1.Pod {
+	var grace int64
+	c := v1.Container{
+		Name:      k9sDebug,
+		Image:     cfg.Image,
+		Command:   cfg.Command,
+		Args:      cfg.Args,
+		Resources: asResource(cfg.Limits),
+		Stdin:     true,
+		TTY:       true,
+	}
+	if cfg.Privileged {
+		priv := true
+		c.SecurityContext = &v1.SecurityContext{Privileged: &priv}
+	}
+	labels := map[string]string{"app.kubernetes.io/managed-by": "k9s"}
+	for k, v := range cfg.Labels {
+		labels[k] = v
+	}
+	po := v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      k9sDebugPodName(),
+			Namespace: ns,
+			Labels:    labels,
+		},
+		Spec: v1.PodSpec{
+			NodeName:                      node,
+			RestartPolicy:                 v1.RestartPolicyNever,
+			HostNetwork:                   cfg.HostNetwork,
+			HostPID:                       cfg.HostPID,
+			TerminationGracePeriodSeconds: &grace,
+			Containers:                    []v1.Container{c},
+		},
+	}
+	if node != "" {
+		po.Spec.Tolerations = []v1.Toleration{{Operator: v1.TolerationOpExists}}
+	}
+
+	return po
+}
@@ -0,0 +1,63 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+)
+
+func TestK9sDebugPod(t *testing.T) {
+	cfg := config.NewDebugPod()
+	cfg.HostNetwork, cfg.Privileged = true, true
+	cfg.Labels = map[string]string{"team": "sre"}
+
+	po := k9sDebugPod("fred", "n1", cfg)
+	assert.Equal(t, "fred", po.Namespace)
+	assert.Equal(t, map[string]string{"app.kubernetes.io/managed-by": "k9s", "team": "sre"}, po.Labels)
+	assert.Equal(t, "n1", po.Spec.NodeName)
+	assert.True(t, po.Spec.HostNetwork)
+	assert.False(t, po.Spec.HostPID)
+	assert.Equal(t, []v1.Toleration{{Operator: v1.TolerationOpExists}}, po.Spec.Tolerations)
+	assert.Equal(t, 1, len(po.Spec.Containers))
+	co := po.Spec.Containers[0]
+	assert.Equal(t, "nicolaka/netshoot", co.Image)
+	assert.Equal(t, []string{"sleep", "86400"}, co.Command)
+	assert.True(t, *co.SecurityContext.Privileged)
+
+	po = k9sDebugPod("fred", "", config.NewDebugPod())
+	assert.Empty(t, po.Spec.Tolerations)
+	assert.Nil(t, po.Spec.Containers[0].SecurityContext)
+}
+
+func TestPodReady(t *testing.T) {
+	uu := map[string]struct {
+		status v1.PodStatus
+		e, err bool
+	}{
+		"pending": {
+			status: v1.PodStatus{Phase: v1.PodPending},
+		},
+		"ready": {
+			status: v1.PodStatus{Phase: v1.PodRunning, Conditions: []v1.PodCondition{{Type: v1.PodReady, Status: v1.ConditionTrue}}},
+			e:      true,
+		},
+		"not-ready": {
+			status: v1.PodStatus{Phase: v1.PodRunning, Conditions: []v1.PodCondition{{Type: v1.PodReady, Status: v1.ConditionFalse}}},
+		},
+		"failed": {
+			status: v1.PodStatus{Phase: v1.PodFailed},
+			err:    true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ok, err := podReady(&v1.Pod{Status: u.status})
+			assert.Equal(t, u.e, ok)
+			assert.Equal(t, u.err, err != nil)
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package view
+
+import (
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+// Deprecation represents a deprecated api usages viewer.
+type Deprecation struct {
+	ResourceViewer
+}
+
+// NewDeprecation returns a new deprecated api usages view.
+func NewDeprecation(gvr client.GVR) ResourceViewer {
+	d := Deprecation{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	d.GetTable().SetBorderFocusColor(tcell.ColorMediumSpringGreen)
+	d.GetTable().SetSelectedStyle(tcell.StyleDefault.Foreground(tcell.ColorWhite).Background(tcell.ColorMediumSpringGreen).Attributes(tcell.AttrNone))
+	d.GetTable().SetSortCol("REMOVED", true)
+	d.AddBindKeysFn(d.bindKeys)
+
+	return &d
+}
+
+func (d *Deprecation) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Delete(tcell.KeyCtrlW, tcell.KeyCtrlL, tcell.KeyCtrlZ, tcell.KeyCtrlD)
+	aa.Add(ui.KeyActions{
+		tcell.KeyEnter: ui.NewKeyAction("Goto", d.gotoCmd, true),
+		ui.KeyShiftS:   ui.NewKeyAction("Sort Status", d.GetTable().SortColCmd("STATUS", true), false),
+		ui.KeyShiftK:   ui.NewKeyAction("Sort Kind", d.GetTable().SortColCmd("KIND", true), false),
+		ui.KeyShiftR:   ui.NewKeyAction("Sort Removed", d.GetTable().SortColCmd("REMOVED", true), false),
+	})
+}
+
+func (d *Deprecation) gotoCmd(evt *tcell.EventKey) *tcell.EventKey {
+	sel := d.GetTable().GetSelectedItem()
+	if sel == "" {
+		return evt
+	}
+	tokens := strings.Split(sel, "|")
+	if len(tokens) < 2 {
+		return nil
+	}
+	d.App().gotoResource(tokens[0], tokens[1], false)
+
+	return nil
+}
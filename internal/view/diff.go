@@ -0,0 +1,113 @@
+package view
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+)
+
+const diffTitle = "Diff"
+
+// Diff presents a yaml diff between two resources.
+type Diff struct {
+	*tview.TextView
+
+	app          *App
+	actions      ui.KeyActions
+	lName, rName string
+	lines        []render.DiffLine
+	sideBySide   bool
+}
+
+// NewDiff returns a new diff view.
+func NewDiff(app *App, lName, left, rName, right string) *Diff {
+	return &Diff{
+		TextView: tview.NewTextView(),
+		app:      app,
+		actions:  make(ui.KeyActions),
+		lName:    lName,
+		rName:    rName,
+		lines:    render.Diff(left, right),
+	}
+}
+
+// Init initializes the view.
+func (d *Diff) Init(_ context.Context) error {
+	d.SetBorder(true)
+	d.SetBorderPadding(0, 0, 1, 1)
+	d.SetDynamicColors(true)
+	d.SetScrollable(true)
+	d.SetWrap(false)
+	d.SetTitle(fmt.Sprintf(" [aqua::b]%s([fuchsia::b]%s[aqua::b] ↔ [fuchsia::b]%s[aqua::b]) ", diffTitle, d.lName, d.rName))
+	d.actions.Add(ui.KeyActions{
+		tcell.KeyEscape: ui.NewKeyAction("Back", d.app.PrevCmd, true),
+		ui.KeyT:         ui.NewKeyAction("Toggle Layout", d.toggleCmd, true),
+	})
+	d.SetInputCapture(func(evt *tcell.EventKey) *tcell.EventKey {
+		if a, ok := d.actions[ui.AsKey(evt)]; ok {
+			return a.Action(evt)
+		}
+		return evt
+	})
+	d.refresh()
+
+	return nil
+}
+
+// AddActions adds additional key bindings.
+func (d *Diff) AddActions(aa ui.KeyActions) {
+	d.actions.Add(aa)
+}
+
+// InCmdMode checks if prompt is active.
+func (*Diff) InCmdMode() bool {
+	return false
+}
+
+// Start starts the view.
+func (d *Diff) Start() {}
+
+// Stop stops the view.
+func (d *Diff) Stop() {}
+
+// Name returns the component name.
+func (d *Diff) Name() string { return diffTitle }
+
+// Hints returns the view hints.
+func (d *Diff) Hints() model.MenuHints {
+	return d.actions.Hints()
+}
+
+// ExtraHints returns additional hints.
+func (d *Diff) ExtraHints() map[string]string {
+	return nil
+}
+
+func (d *Diff) toggleCmd(evt *tcell.EventKey) *tcell.EventKey {
+	d.sideBySide = !d.sideBySide
+	d.refresh()
+
+	return nil
+}
+
+func (d *Diff) refresh() {
+	if !render.HasChanges(d.lines) {
+		d.SetText("[green::b]No differences found.")
+		return
+	}
+	if !d.sideBySide {
+		d.SetText(render.UnifiedDiff(d.lines, d.lName, d.rName))
+		return
+	}
+	_, _, w, _ := d.GetInnerRect()
+	if w <= 0 {
+		_, _, w, _ = d.app.Main.GetRect()
+	}
+	d.SetText(render.SideBySideDiff(d.lines, d.lName, d.rName, w))
+	d.ScrollToBeginning()
+}
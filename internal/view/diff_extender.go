@@ -0,0 +1,76 @@
+package view
+
+import (
+	"sort"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+// DiffExtender provides for diffing resources.
+type DiffExtender struct {
+	ResourceViewer
+}
+
+// NewDiffExtender returns a new extender.
+func NewDiffExtender(r ResourceViewer) ResourceViewer {
+	d := DiffExtender{ResourceViewer: r}
+	d.AddBindKeysFn(d.bindKeys)
+
+	return &d
+}
+
+func (d *DiffExtender) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftY: ui.NewKeyAction("Diff", d.diffCmd, true),
+	})
+}
+
+// diffCmd diffs two marked resources or the selected resource against its
+// last applied configuration.
+func (d *DiffExtender) diffCmd(evt *tcell.EventKey) *tcell.EventKey {
+	sels := d.GetTable().GetSelectedItems()
+	switch len(sels) {
+	case 0:
+		return evt
+	case 1:
+		d.diffLastApplied(sels[0])
+	case 2:
+		sort.Strings(sels)
+		d.diffMarked(sels[0], sels[1])
+	default:
+		d.App().Flash().Warn("Mark exactly two resources to diff")
+	}
+
+	return nil
+}
+
+func (d *DiffExtender) diffMarked(left, right string) {
+	l, err := dao.ResourceYAML(d.App().factory, d.GVR(), left)
+	if err != nil {
+		d.App().Flash().Err(err)
+		return
+	}
+	r, err := dao.ResourceYAML(d.App().factory, d.GVR(), right)
+	if err != nil {
+		d.App().Flash().Err(err)
+		return
+	}
+	d.showDiff(left, l, right, r)
+}
+
+func (d *DiffExtender) diffLastApplied(path string) {
+	live, applied, err := dao.LastAppliedYAML(d.App().factory, d.GVR(), path)
+	if err != nil {
+		d.App().Flash().Err(err)
+		return
+	}
+	d.showDiff(path+" (last-applied)", applied, path+" (live)", live)
+}
+
+func (d *DiffExtender) showDiff(lName, left, rName, right string) {
+	if err := d.App().inject(NewDiff(d.App(), lName, left, rName, right), false); err != nil {
+		d.App().Flash().Err(err)
+	}
+}
@@ -193,13 +193,7 @@ func (d *Dir) applyCmd(evt *tcell.EventKey) *tcell.EventKey {
 		return evt
 	}
 
-	opts := []string{"-f"}
-	if containsDir(sel) {
-		opts = append(opts, "-R")
-	}
-	if isKustomized(sel) {
-		opts = []string{"-k"}
-	}
+	opts := manifestOpts(sel)
 	d.Stop()
 	defer d.Start()
 	{
@@ -21,10 +21,16 @@ type Deploy struct {
 func NewDeploy(gvr client.GVR) ResourceViewer {
 	var d Deploy
 	d.ResourceViewer = NewPortForwardExtender(
-		NewRestartExtender(
-			NewScaleExtender(
-				NewImageExtender(
-					NewLogsExtender(NewBrowser(gvr), d.logOptions),
+		NewRolloutExtender(
+			NewRestartExtender(
+				NewScaleExtender(
+					NewEnvExtender(
+						NewResourcesExtender(
+							NewImageExtender(
+								NewLogsExtender(NewXrayExtender(NewBrowser(gvr)), d.logOptions),
+							),
+						),
+					),
 				),
 			),
 		),
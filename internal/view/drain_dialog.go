@@ -26,7 +26,7 @@ func ShowDrain(view ResourceViewer, path string, defaults dao.DrainOptions, okFn
 		SetLabelColor(styles.K9s.Info.FgColor.Color()).
 		SetFieldTextColor(styles.K9s.Info.SectionColor.Color())
 
-	var opts dao.DrainOptions
+	opts := defaults
 	f.AddInputField("GracePeriod:", strconv.Itoa(defaults.GracePeriodSeconds), 0, nil, func(v string) {
 		a, err := asIntOpt(v)
 		if err != nil {
@@ -54,6 +54,9 @@ func ShowDrain(view ResourceViewer, path string, defaults dao.DrainOptions, okFn
 	f.AddCheckbox("Force:", defaults.Force, func(_ string, v bool) {
 		opts.Force = v
 	})
+	f.AddCheckbox("Disable Eviction:", defaults.DisableEviction, func(_ string, v bool) {
+		opts.DisableEviction = v
+	})
 
 	pages := view.App().Content.Pages
 	f.AddButton("Cancel", func() {
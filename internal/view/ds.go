@@ -15,9 +15,15 @@ type DaemonSet struct {
 func NewDaemonSet(gvr client.GVR) ResourceViewer {
 	d := DaemonSet{
 		ResourceViewer: NewPortForwardExtender(
-			NewRestartExtender(
-				NewImageExtender(
-					NewLogsExtender(NewBrowser(gvr), nil),
+			NewRolloutExtender(
+				NewRestartExtender(
+					NewEnvExtender(
+						NewResourcesExtender(
+							NewImageExtender(
+								NewLogsExtender(NewBrowser(gvr), nil),
+							),
+						),
+					),
 				),
 			),
 		),
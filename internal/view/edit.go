@@ -0,0 +1,106 @@
+package view
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tcell/v2"
+)
+
+// editResource edits a resource manifest and shows a diff of the changes
+// prior to applying them.
+func (b *Browser) editResource(path string) {
+	var g dao.Generic
+	g.Init(b.app.factory, b.GVR())
+	ctx, cancel := context.WithTimeout(context.Background(), b.app.Conn().Config().CallTimeout())
+	original, err := g.EditYAML(ctx, path)
+	cancel()
+	if err != nil {
+		b.app.Flash().Err(err)
+		return
+	}
+
+	edited, err := b.editManifest(original)
+	if err != nil {
+		b.app.Flash().Err(err)
+		return
+	}
+	if edited == original {
+		b.app.Flash().Infof("Edit cancelled, no changes made to %s", path)
+		return
+	}
+
+	d := NewDiff(b.app, path+" (live)", original, path+" (edited)", edited)
+	d.AddActions(ui.KeyActions{
+		tcell.KeyCtrlS: ui.NewKeyAction("Apply", b.applyEditCmd(&g, path, original, edited), true),
+		ui.KeyV:        ui.NewKeyAction("Dry Run", b.dryRunEditCmd(&g, path, original, edited), true),
+	})
+	if err := b.app.inject(d, false); err != nil {
+		b.app.Flash().Err(err)
+	}
+}
+
+func (b *Browser) editManifest(manifest string) (string, error) {
+	f, err := os.CreateTemp("", "k9s-edit-*.yaml")
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(f.Name())
+	if _, err := f.WriteString(manifest); err != nil {
+		f.Close()
+		return "", err
+	}
+	if err := f.Close(); err != nil {
+		return "", err
+	}
+
+	b.Stop()
+	ok := edit(b.app, shellOpts{clear: true, args: []string{f.Name()}})
+	b.Start()
+	if !ok {
+		return "", errors.New("Edit exec failed")
+	}
+	raw, err := os.ReadFile(f.Name())
+	if err != nil {
+		return "", err
+	}
+
+	return string(raw), nil
+}
+
+func (b *Browser) applyEditCmd(g *dao.Generic, path, original, edited string) ui.ActionHandler {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		msg := fmt.Sprintf("Apply changes to %s %s?", singularize(b.GVR().R()), path)
+		dialog.ShowConfirm(b.app.Styles.Dialog(), b.app.Content.Pages, "Confirm Apply", msg, func() {
+			ctx, cancel := context.WithTimeout(context.Background(), b.app.Conn().Config().CallTimeout())
+			defer cancel()
+			if err := g.ApplyEdit(ctx, path, original, edited, false); err != nil {
+				b.app.Flash().Err(err)
+				return
+			}
+			b.app.Flash().Infof("%s edited successfully", path)
+			b.app.PrevCmd(nil)
+		}, func() {})
+
+		return nil
+	}
+}
+
+func (b *Browser) dryRunEditCmd(g *dao.Generic, path, original, edited string) ui.ActionHandler {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		ctx, cancel := context.WithTimeout(context.Background(), b.app.Conn().Config().CallTimeout())
+		defer cancel()
+		if err := g.ApplyEdit(ctx, path, original, edited, true); err != nil {
+			b.app.Flash().Err(err)
+			return nil
+		}
+		b.app.Flash().Infof("Dry run for %s succeeded", path)
+
+		return nil
+	}
+}
@@ -0,0 +1,242 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+)
+
+const envKey = "setEnv"
+
+type envFormSpec struct {
+	name, value, newValue string
+	source, newSource     dao.EnvSource
+	delete                bool
+}
+
+func (e *envFormSpec) modified() bool {
+	return !e.delete && (e.value != e.newValue || e.source != e.newSource)
+}
+
+// EnvExtender provides for editing containers environment variables.
+type EnvExtender struct {
+	ResourceViewer
+}
+
+// NewEnvExtender returns a new extender.
+func NewEnvExtender(r ResourceViewer) ResourceViewer {
+	s := EnvExtender{ResourceViewer: r}
+	s.AddBindKeysFn(s.bindKeys)
+
+	return &s
+}
+
+func (s *EnvExtender) bindKeys(aa ui.KeyActions) {
+	if s.App().Config.K9s.IsReadOnly() {
+		return
+	}
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftE: ui.NewKeyAction("Set Env", s.setEnvCmd, true),
+	})
+}
+
+func (s *EnvExtender) setEnvCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := s.GetTable().GetSelectedItem()
+	if path == "" {
+		return nil
+	}
+
+	podSpec, err := podSpecFor(s.App(), s.GVR(), path)
+	if err != nil {
+		s.App().Flash().Err(err)
+		return nil
+	}
+	cc := fetchContainers(*podSpec, false)
+	for _, co := range podSpec.InitContainers {
+		cc = append(cc, co.Name)
+	}
+	if len(cc) == 1 {
+		s.showEnvDialog(path, podSpec, cc[0])
+		return nil
+	}
+	picker := NewPicker()
+	picker.populate(cc)
+	picker.SetSelectedFunc(func(_ int, co, _ string, _ rune) {
+		s.App().Content.Pop()
+		s.showEnvDialog(path, podSpec, co)
+	})
+	if err := s.App().inject(picker, false); err != nil {
+		s.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (s *EnvExtender) showEnvDialog(path string, spec *v1.PodSpec, co string) {
+	form, err := s.makeSetEnvForm(path, spec, co)
+	if err != nil {
+		s.App().Flash().Err(err)
+		return
+	}
+
+	s.Stop()
+	defer s.Start()
+	confirm := ui.NewScrollModal("<Set env>", fmt.Sprintf("Set env %s %s [%s]", s.GVR(), path, co), form)
+	confirm.SetDoneFunc(func() {
+		s.dismissDialog()
+	})
+	s.App().Content.AddPage(envKey, confirm, false, false)
+	s.App().Content.ShowPage(envKey)
+}
+
+func (s *EnvExtender) makeSetEnvForm(path string, spec *v1.PodSpec, co string) (*tview.Form, error) {
+	container, init := findContainer(spec, co)
+	if container == nil {
+		return nil, fmt.Errorf("unable to locate container %q", co)
+	}
+
+	f := newStyledForm(s.App().Styles.Dialog())
+	specs := make([]*envFormSpec, 0, len(container.Env))
+	for _, e := range container.Env {
+		src, val := dao.EnvVarToString(e)
+		if !src.Editable() {
+			f.AddInputField(fmt.Sprintf("%s (%s):", e.Name, src), val, 0, readOnlyField, nil)
+			continue
+		}
+		specs = append(specs, &envFormSpec{name: e.Name, value: val, newValue: val, source: src, newSource: src})
+	}
+	for i := range specs {
+		spec := specs[i]
+		f.AddInputField(spec.name+":", spec.value, 0, nil, func(changed string) {
+			spec.newValue = changed
+		})
+		f.AddDropDown("  from", envSources(), envSourceIndex(spec.source), func(option string, _ int) {
+			spec.newSource = dao.EnvSource(option)
+		})
+		f.AddCheckbox("  delete", false, func(_ string, checked bool) {
+			spec.delete = checked
+		})
+	}
+	var newName, newValue string
+	newSource := dao.EnvValue
+	f.AddInputField("New var:", "", 0, nil, func(changed string) {
+		newName = strings.TrimSpace(changed)
+	})
+	f.AddInputField("New value:", "", 0, nil, func(changed string) {
+		newValue = changed
+	})
+	f.AddDropDown("  from", envSources(), 0, func(option string, _ int) {
+		newSource = dao.EnvSource(option)
+	})
+
+	f.AddButton("OK", func() {
+		defer s.dismissDialog()
+		update := dao.EnvSpec{Container: co, Init: init}
+		for _, spec := range specs {
+			if spec.delete {
+				update.Delete = append(update.Delete, spec.name)
+				continue
+			}
+			if !spec.modified() {
+				continue
+			}
+			e, err := dao.EnvVarFromString(spec.name, spec.newSource, spec.newValue)
+			if err != nil {
+				s.App().Flash().Err(err)
+				return
+			}
+			update.Set = append(update.Set, e)
+		}
+		if newName != "" {
+			e, err := dao.EnvVarFromString(newName, newSource, newValue)
+			if err != nil {
+				s.App().Flash().Err(err)
+				return
+			}
+			update.Set = append(update.Set, e)
+		}
+		if update.IsEmpty() {
+			s.App().Flash().Info("No env changes detected")
+			return
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), s.App().Conn().Config().CallTimeout())
+		defer cancel()
+		if err := s.setEnv(ctx, path, update); err != nil {
+			log.Error().Err(err).Msgf("PodSpec %s env update failed", path)
+			s.App().Flash().Err(err)
+			return
+		}
+		s.App().Flash().Infof("Resource %s:%s env updated successfully", s.GVR(), path)
+	})
+	f.AddButton("Cancel", func() {
+		s.dismissDialog()
+	})
+
+	return f, nil
+}
+
+func (s *EnvExtender) dismissDialog() {
+	s.App().Content.RemovePage(envKey)
+}
+
+func (s *EnvExtender) setEnv(ctx context.Context, path string, spec dao.EnvSpec) error {
+	res, err := dao.AccessorFor(s.App().factory, s.GVR())
+	if err != nil {
+		return err
+	}
+	e, ok := res.(dao.EnvEditor)
+	if !ok {
+		return fmt.Errorf("expecting an EnvEditor for %q but got %T", s.GVR(), res)
+	}
+
+	return e.SetEnv(ctx, path, spec)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func readOnlyField(string, rune) bool {
+	return false
+}
+
+func envSources() []string {
+	ss := make([]string, 0, len(dao.EditableEnvSources))
+	for _, s := range dao.EditableEnvSources {
+		ss = append(ss, string(s))
+	}
+
+	return ss
+}
+
+func envSourceIndex(src dao.EnvSource) int {
+	for i, s := range dao.EditableEnvSources {
+		if s == src {
+			return i
+		}
+	}
+
+	return 0
+}
+
+func findContainer(spec *v1.PodSpec, co string) (*v1.Container, bool) {
+	for i := range spec.Containers {
+		if spec.Containers[i].Name == co {
+			return &spec.Containers[i], false
+		}
+	}
+	for i := range spec.InitContainers {
+		if spec.InitContainers[i].Name == co {
+			return &spec.InitContainers[i], true
+		}
+	}
+
+	return nil, false
+}
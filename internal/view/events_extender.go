@@ -0,0 +1,53 @@
+package view
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+const eventsGVR = "v1/events"
+
+// EventsExtender provides for viewing events related to a resource.
+type EventsExtender struct {
+	ResourceViewer
+}
+
+// NewEventsExtender returns a new extender.
+func NewEventsExtender(r ResourceViewer) ResourceViewer {
+	e := EventsExtender{ResourceViewer: r}
+	e.AddBindKeysFn(e.bindKeys)
+
+	return &e
+}
+
+func (e *EventsExtender) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		tcell.KeyCtrlV: ui.NewKeyAction("Events", e.eventsCmd, true),
+	})
+}
+
+func (e *EventsExtender) eventsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := e.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	v := NewEvent(client.NewGVR(eventsGVR))
+	v.SetContextFn(eventsCtx(e.GVR(), path))
+	if err := e.App().inject(v, false); err != nil {
+		e.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+func eventsCtx(gvr client.GVR, path string) ContextFunc {
+	return func(ctx context.Context) context.Context {
+		ctx = context.WithValue(ctx, internal.KeyPath, path)
+		return context.WithValue(ctx, internal.KeyInvolved, gvr.String())
+	}
+}
@@ -28,6 +28,7 @@ import (
 
 const (
 	shellCheck = `command -v bash >/dev/null && exec bash || exec sh`
+	nsenterCmd = "nsenter"
 	bannerFmt  = "<<K9s-Shell>> Pod: %s | Container: %s \n"
 )
 
@@ -140,6 +141,11 @@ func execute(opts shellOpts) error {
 }
 
 func runKu(a *App, opts shellOpts) (string, error) {
+	return runKuCtx(context.Background(), a, opts)
+}
+
+// runKuCtx runs a kubectl command until it completes or the context is done.
+func runKuCtx(ctx context.Context, a *App, opts shellOpts) (string, error) {
 	bin, err := exec.LookPath("kubectl")
 	if errors.Is(err, exec.ErrDot) {
 		log.Error().Err(err).Msgf("kubectl command must not be in the current working directory")
@@ -165,16 +171,16 @@ func runKu(a *App, opts shellOpts) (string, error) {
 	}
 	opts.binary, opts.background = bin, false
 
-	return oneShoot(opts)
+	return oneShoot(ctx, opts)
 }
 
-func oneShoot(opts shellOpts) (string, error) {
+func oneShoot(ctx context.Context, opts shellOpts) (string, error) {
 	if opts.clear {
 		clearScreen()
 	}
 
 	log.Debug().Msgf("Running command> %s %s", opts.binary, strings.Join(opts.args, " "))
-	cmd := exec.Command(opts.binary, opts.args...)
+	cmd := exec.CommandContext(ctx, opts.binary, opts.args...)
 
 	var err error
 	buff := bytes.NewBufferString("")
@@ -232,6 +238,9 @@ func sshIn(a *App, fqn, co string) {
 		if os == windowsOS {
 			args = append(args, "--", powerShell)
 		}
+		if cfg.NSEnter && co == k9sShell {
+			args = append(args, nsenterArgs()...)
+		}
 		args = append(args, "sh", "-c", shellCheck)
 	}
 	log.Debug().Msgf("ARGS %#v", args)
@@ -242,6 +251,11 @@ func sshIn(a *App, fqn, co string) {
 	}
 }
 
+// nsenterArgs enters the host namespaces of the node init process.
+func nsenterArgs() []string {
+	return []string{nsenterCmd, "--target", "1", "--mount", "--uts", "--ipc", "--net", "--pid", "--"}
+}
+
 func nukeK9sShell(a *App) error {
 	clName := a.Config.K9s.CurrentCluster
 	if !a.Config.K9s.Clusters[clName].FeatureGates.NodeShell {
@@ -309,7 +323,6 @@ func k9sShellPodName() string {
 
 func k9sShellPod(node string, cfg *config.ShellPod) v1.Pod {
 	var grace int64
-	var priv bool = true
 
 	log.Debug().Msgf("Shell Config %#v", cfg)
 	c := v1.Container{
@@ -322,11 +335,9 @@ func k9sShellPod(node string, cfg *config.ShellPod) v1.Pod {
 				ReadOnly:  true,
 			},
 		},
-		Resources: asResource(cfg.Limits),
-		Stdin:     true,
-		SecurityContext: &v1.SecurityContext{
-			Privileged: &priv,
-		},
+		Resources:       asResource(cfg.Limits),
+		Stdin:           true,
+		SecurityContext: cfg.PodSecurityContext(),
 	}
 	if len(cfg.Command) != 0 {
 		c.Command = cfg.Command
@@ -357,12 +368,8 @@ func k9sShellPod(node string, cfg *config.ShellPod) v1.Pod {
 					},
 				},
 			},
-			Containers: []v1.Container{c},
-			Tolerations: []v1.Toleration{
-				{
-					Operator: v1.TolerationOperator("Exists"),
-				},
-			},
+			Containers:  []v1.Container{c},
+			Tolerations: cfg.PodTolerations(),
 		},
 	}
 }
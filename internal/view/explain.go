@@ -0,0 +1,144 @@
+package view
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+const explainGVR = "explain"
+
+// Explain represents a resource schema fields viewer.
+type Explain struct {
+	ResourceViewer
+
+	path      string
+	recursive bool
+}
+
+// NewExplain returns a new schema fields view for a given gvr[.field.path].
+func NewExplain(path string) ResourceViewer {
+	e := Explain{
+		ResourceViewer: NewBrowser(client.NewGVR(explainGVR)),
+		path:           path,
+	}
+	e.GetTable().SetBorderFocusColor(tcell.ColorMediumSpringGreen)
+	e.GetTable().SetSelectedStyle(tcell.StyleDefault.Foreground(tcell.ColorWhite).Background(tcell.ColorMediumSpringGreen).Attributes(tcell.AttrNone))
+	e.GetTable().SetSortCol("FIELD", true)
+	e.GetTable().Extras = path
+	e.SetContextFn(e.explainContext)
+	e.AddBindKeysFn(e.bindKeys)
+
+	return &e
+}
+
+func (e *Explain) explainContext(ctx context.Context) context.Context {
+	ctx = context.WithValue(ctx, internal.KeyPath, e.path)
+	return context.WithValue(ctx, internal.KeyRecursive, e.recursive)
+}
+
+func (e *Explain) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, ui.KeyShiftN, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Delete(tcell.KeyCtrlW, tcell.KeyCtrlL, tcell.KeyCtrlZ, tcell.KeyCtrlD)
+	aa.Add(ui.KeyActions{
+		tcell.KeyEnter: ui.NewKeyAction("Explain", e.explainCmd, true),
+		ui.KeyD:        ui.NewKeyAction("Describe", e.describeCmd, true),
+		ui.KeyR:        ui.NewKeyAction("Toggle Recursive", e.toggleRecursiveCmd, true),
+		ui.KeyShiftF:   ui.NewKeyAction("Sort Field", e.GetTable().SortColCmd("FIELD", true), false),
+		ui.KeyShiftT:   ui.NewKeyAction("Sort Type", e.GetTable().SortColCmd("TYPE", true), false),
+	})
+}
+
+func (e *Explain) selectedPath() string {
+	sel := e.GetTable().GetSelectedItem()
+	if sel == "" {
+		return ""
+	}
+	res, _ := dao.SplitExplainPath(e.path)
+
+	return res + "." + sel
+}
+
+// explainCmd drills into the selected field or describes it when it has no
+// nested fields.
+func (e *Explain) explainCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := e.selectedPath()
+	if path == "" {
+		return evt
+	}
+	nested, err := dao.ExplainNested(e.App().Conn(), path)
+	if err != nil {
+		e.App().Flash().Err(err)
+		return nil
+	}
+	if !nested {
+		return e.describeCmd(evt)
+	}
+	if err := e.App().inject(NewExplain(path), false); err != nil {
+		e.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (e *Explain) describeCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := e.selectedPath()
+	if path == "" {
+		return evt
+	}
+	if err := showExplainDoc(e.App(), path); err != nil {
+		e.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (e *Explain) toggleRecursiveCmd(evt *tcell.EventKey) *tcell.EventKey {
+	e.recursive = !e.recursive
+	if e.recursive {
+		e.App().Flash().Info("Listing all nested fields...")
+	} else {
+		e.App().Flash().Info("Listing direct fields...")
+	}
+	e.Start()
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func (a *App) explainCmd(args []string) error {
+	if len(args) != 1 {
+		return errors.New("You must specify a resource ie deploy.spec.template")
+	}
+	res, fields := dao.SplitExplainPath(args[0])
+	gvr, ok := a.command.alias.AsGVR(res)
+	if !ok {
+		return fmt.Errorf("`%s` resource not found", res)
+	}
+	path := strings.Join(append([]string{gvr.String()}, fields...), ".")
+	a.recordCmd("explain " + path)
+
+	return a.inject(NewExplain(path), false)
+}
+
+func showExplainDoc(app *App, path string) error {
+	doc, err := dao.ExplainDoc(app.Conn(), path)
+	if err != nil {
+		return err
+	}
+	if doc == "" {
+		doc = render.MissingValue
+	}
+
+	return app.inject(NewDetails(app, "Explain", path, true).Update(doc), false)
+}
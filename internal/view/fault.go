@@ -0,0 +1,51 @@
+package view
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+// Fault represents the cluster faults dashboard viewer.
+type Fault struct {
+	ResourceViewer
+}
+
+// NewFault returns a new faults view.
+func NewFault(gvr client.GVR) ResourceViewer {
+	f := Fault{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	f.GetTable().SetBorderFocusColor(tcell.ColorMediumSpringGreen)
+	f.GetTable().SetSelectedStyle(tcell.StyleDefault.Foreground(tcell.ColorWhite).Background(tcell.ColorMediumSpringGreen).Attributes(tcell.AttrNone))
+	f.GetTable().SetSortCol("FAULTS", false)
+	f.GetTable().SetEnterFn(f.showFaults)
+	f.AddBindKeysFn(f.bindKeys)
+
+	return &f
+}
+
+// Init initializes the view.
+func (f *Fault) Init(ctx context.Context) error {
+	if err := f.ResourceViewer.Init(ctx); err != nil {
+		return err
+	}
+	f.GetTable().GetModel().SetNamespace(client.AllNamespaces)
+
+	return nil
+}
+
+func (f *Fault) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Delete(tcell.KeyCtrlW, tcell.KeyCtrlL, tcell.KeyCtrlZ, tcell.KeyCtrlD)
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftF: ui.NewKeyAction("Sort Faults", f.GetTable().SortColCmd("FAULTS", false), false),
+		ui.KeyShiftT: ui.NewKeyAction("Sort Total", f.GetTable().SortColCmd("TOTAL", false), false),
+	})
+}
+
+func (f *Fault) showFaults(app *App, _ ui.Tabular, _, gvr string) {
+	gotoFaults(app, client.NewGVR(gvr))
+}
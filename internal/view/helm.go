@@ -42,9 +42,20 @@ func (c *Helm) bindKeys(aa ui.KeyActions) {
 		ui.KeyShiftS: ui.NewKeyAction("Sort Status", c.GetTable().SortColCmd(statusCol, true), false),
 		ui.KeyShiftA: ui.NewKeyAction("Sort Age", c.GetTable().SortColCmd(ageCol, true), false),
 		ui.KeyV:      ui.NewKeyAction("Values", c.getValsCmd(), true),
+		ui.KeyH:      ui.NewKeyAction("History", c.historyCmd, true),
 	})
 }
 
+func (c *Helm) historyCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := c.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	showHelmHistory(c.App(), path)
+
+	return nil
+}
+
 func (c *Helm) getValsCmd() func(evt *tcell.EventKey) *tcell.EventKey {
 	return func(evt *tcell.EventKey) *tcell.EventKey {
 		path := c.GetTable().GetSelectedItem()
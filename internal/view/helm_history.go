@@ -0,0 +1,129 @@
+package view
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tcell/v2"
+)
+
+const helmHistoryGVR = "helmhistory"
+
+// HelmHistory represents a helm release revisions view.
+type HelmHistory struct {
+	ResourceViewer
+}
+
+// NewHelmHistory returns a new helm history view.
+func NewHelmHistory(gvr client.GVR) ResourceViewer {
+	h := HelmHistory{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	h.GetTable().SetBorderFocusColor(tcell.ColorMediumSpringGreen)
+	h.GetTable().SetSelectedStyle(tcell.StyleDefault.Foreground(tcell.ColorWhite).Background(tcell.ColorMediumSpringGreen).Attributes(tcell.AttrNone))
+	h.GetTable().SetSortCol("REVISION", false)
+	h.AddBindKeysFn(h.bindKeys)
+
+	return &h
+}
+
+// Init initializes the view.
+func (h *HelmHistory) Init(ctx context.Context) error {
+	if err := h.ResourceViewer.Init(ctx); err != nil {
+		return err
+	}
+	h.GetTable().GetModel().SetNamespace(client.AllNamespaces)
+
+	return nil
+}
+
+func (h *HelmHistory) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Delete(tcell.KeyCtrlW, tcell.KeyCtrlL, tcell.KeyCtrlZ, tcell.KeyCtrlD)
+	aa.Add(ui.KeyActions{
+		ui.KeyV:      ui.NewKeyAction("Values", h.valuesCmd, true),
+		ui.KeyShiftS: ui.NewKeyAction("Sort Status", h.GetTable().SortColCmd(statusCol, true), false),
+		ui.KeyShiftR: ui.NewKeyAction("Sort Revision", h.GetTable().SortColCmd("REVISION", false), false),
+	})
+	if h.App().Config.K9s.IsReadOnly() {
+		return
+	}
+	aa.Add(ui.KeyActions{
+		ui.KeyR: ui.NewKeyAction("Rollback", h.rollbackCmd, true),
+	})
+}
+
+func (h *HelmHistory) valuesCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := h.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	var hh dao.HelmHistory
+	hh.Init(h.App().factory, h.GVR())
+	vals, err := hh.Values(path)
+	if err != nil {
+		h.App().Flash().Err(err)
+		return nil
+	}
+	details := NewDetails(h.App(), "Values", path, true).Update(string(vals))
+	if err := h.App().inject(details, false); err != nil {
+		h.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (h *HelmHistory) rollbackCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := h.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	ns, n, rev, err := dao.ParseHelmRevision(path)
+	if err != nil {
+		h.App().Flash().Err(err)
+		return nil
+	}
+
+	msg := fmt.Sprintf("Rollback release %s to revision %d?", client.FQN(ns, n), rev)
+	app := h.App()
+	dialog.ShowConfirm(app.Styles.Dialog(), app.Content.Pages, "Confirm Rollback", msg, func() {
+		ctx, cancel := context.WithTimeout(context.Background(), app.Conn().Config().CallTimeout())
+		done := app.trackCall(fmt.Sprintf("Rolling back %s", n), cancel)
+		go func() {
+			defer cancel()
+			defer done()
+			var hh dao.HelmHistory
+			hh.Init(app.factory, h.GVR())
+			err := hh.Rollback(ctx, path)
+			app.QueueUpdateDraw(func() {
+				if err != nil {
+					app.Flash().Err(err)
+					return
+				}
+				app.Flash().Infof("Release %s rolled back to revision %d", n, rev)
+				h.Refresh()
+			})
+		}()
+	}, func() {})
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func showHelmHistory(app *App, path string) {
+	v := NewHelmHistory(client.NewGVR(helmHistoryGVR))
+	v.GetTable().Extras = path
+	v.SetContextFn(func(ctx context.Context) context.Context {
+		return context.WithValue(ctx, internal.KeyPath, path)
+	})
+	if err := app.inject(v, false); err != nil {
+		app.Flash().Err(err)
+	}
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/tcell/v2"
 	"github.com/derailed/tview"
+	runewidth "github.com/mattn/go-runewidth"
 )
 
 const (
@@ -83,11 +84,11 @@ func (h *Help) bindKeys() {
 func (h *Help) computeMaxes(hh model.MenuHints) {
 	h.maxKey, h.maxDesc = 0, 0
 	for _, hint := range hh {
-		if len(hint.Mnemonic) > h.maxKey {
-			h.maxKey = len(hint.Mnemonic)
+		if w := runewidth.StringWidth(hint.Mnemonic); w > h.maxKey {
+			h.maxKey = w
 		}
-		if len(hint.Description) > h.maxDesc {
-			h.maxDesc = len(hint.Description)
+		if w := runewidth.StringWidth(hint.Description); w > h.maxDesc {
+			h.maxDesc = w
 		}
 	}
 	h.maxKey += 2
@@ -96,8 +97,8 @@ func (h *Help) computeMaxes(hh model.MenuHints) {
 func (h *Help) computeExtraMaxes(ee map[string]string) {
 	h.maxDesc = 0
 	for k := range ee {
-		if len(k) > h.maxDesc {
-			h.maxDesc = len(k)
+		if w := runewidth.StringWidth(k); w > h.maxDesc {
+			h.maxDesc = w
 		}
 	}
 }
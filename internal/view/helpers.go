@@ -7,22 +7,20 @@ import (
 	"strconv"
 	"strings"
 
-	"github.com/atotto/clipboard"
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
 	"github.com/derailed/tcell/v2"
 	"github.com/derailed/tview"
 	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
 )
 
-func clipboardWrite(text string) error {
-	return clipboard.WriteAll(text)
-}
-
 func cpCmd(flash *model.Flash, v *tview.TextView) func(*tcell.EventKey) *tcell.EventKey {
 	return func(evt *tcell.EventKey) *tcell.EventKey {
 		if err := clipboardWrite(v.GetText(true)); err != nil {
@@ -230,3 +228,81 @@ func decorateCpuMemHeaderRows(app *App, data *render.TableData) {
 		}
 	}
 }
+
+// deletePolicy returns the deletion dialog guards for a collection of resources.
+func deletePolicy(app *App, gvr client.GVR, selections []string) dialog.DeletePolicy {
+	g := app.Config.K9s.GuardsConfig()
+	p := dialog.DeletePolicy{NoForce: g.DisableForceDelete}
+	for _, sel := range selections {
+		ns, n := client.Namespaced(sel)
+		if !g.NameRequired(app.Config.K9s.CurrentContext, ns) {
+			continue
+		}
+		p.ConfirmName = n
+		if len(selections) > 1 {
+			p.ConfirmName = strconv.Itoa(len(selections))
+		}
+		break
+	}
+	if meta, err := dao.MetaAccess.MetaFor(gvr); err == nil && !meta.Namespaced {
+		p.DoubleConfirm = g.ConfirmClusterScoped
+	}
+
+	return p
+}
+
+// podSpecAccessor returns the accessor of a resource holding a pod spec.
+func podSpecAccessor(app *App, gvr client.GVR) (dao.ContainsPodSpec, error) {
+	res, err := dao.AccessorFor(app.factory, gvr)
+	if err != nil {
+		return nil, err
+	}
+	ps, ok := res.(dao.ContainsPodSpec)
+	if !ok {
+		return nil, fmt.Errorf("expecting a ContainsPodSpec for %q but got %T", gvr, res)
+	}
+
+	return ps, nil
+}
+
+// podSpecFor returns the pod spec of a given resource.
+func podSpecFor(app *App, gvr client.GVR, path string) (*v1.PodSpec, error) {
+	ps, err := podSpecAccessor(app, gvr)
+	if err != nil {
+		return nil, err
+	}
+
+	return ps.GetPodSpec(path)
+}
+
+// newStyledForm returns a dialog form styled per the skin.
+func newStyledForm(styles config.Dialog) *tview.Form {
+	f := tview.NewForm()
+	f.SetItemPadding(0)
+	f.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(styles.ButtonBgColor.Color()).
+		SetButtonTextColor(styles.ButtonFgColor.Color()).
+		SetLabelColor(styles.LabelFgColor.Color()).
+		SetFieldTextColor(styles.FieldFgColor.Color())
+
+	return f
+}
+
+// gotoFaults lists a resource faulty instances. Events are filtered on
+// warnings as they do not carry a validity status.
+func gotoFaults(app *App, gvr client.GVR) {
+	res := gvr.R()
+	if res == "cpu" || res == "mem" {
+		res, gvr = "pod", client.NewGVR("v1/pods")
+	}
+	app.gotoResource(res+" all", "", false)
+	v, ok := app.Content.Top().(ResourceViewer)
+	if !ok || v.GetTable() == nil || v.GVR().String() != gvr.String() {
+		return
+	}
+	if gvr.String() == "v1/events" {
+		v.GetTable().CmdBuff().SetText(string(v1.EventTypeWarning), "")
+		return
+	}
+	v.GetTable().ToggleToast()
+}
@@ -8,10 +8,13 @@ import (
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui/dialog"
 	"github.com/derailed/tcell/v2"
 	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 )
 
@@ -263,3 +266,53 @@ func TestContainerID(t *testing.T) {
 		})
 	}
 }
+
+func TestDeletePolicy(t *testing.T) {
+	dao.MetaAccess.RegisterMeta("v1/nodes", metav1.APIResource{Name: "nodes", Namespaced: false})
+	app := NewApp(config.NewConfig(nil))
+	app.Config.K9s.Guards = &config.Guards{
+		Contexts:             []string{"prod-*"},
+		Namespaces:           []string{"payments"},
+		DisableForceDelete:   true,
+		ConfirmClusterScoped: true,
+	}
+
+	uu := map[string]struct {
+		ctx, gvr string
+		sels     []string
+		e        dialog.DeletePolicy
+	}{
+		"single": {
+			ctx:  "prod-eu",
+			gvr:  "v1/pods",
+			sels: []string{"default/p1"},
+			e:    dialog.DeletePolicy{ConfirmName: "p1", NoForce: true},
+		},
+		"multi": {
+			ctx:  "dev",
+			gvr:  "v1/pods",
+			sels: []string{"default/p1", "payments/p2"},
+			e:    dialog.DeletePolicy{ConfirmName: "2", NoForce: true},
+		},
+		"unguarded": {
+			ctx:  "dev",
+			gvr:  "v1/pods",
+			sels: []string{"default/p1"},
+			e:    dialog.DeletePolicy{NoForce: true},
+		},
+		"cluster": {
+			ctx:  "dev",
+			gvr:  "v1/nodes",
+			sels: []string{"n1"},
+			e:    dialog.DeletePolicy{NoForce: true, DoubleConfirm: true},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			app.Config.K9s.CurrentContext = u.ctx
+			assert.Equal(t, u.e, deletePolicy(app, client.NewGVR(u.gvr), u.sels))
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package view
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+)
+
+const hpaStatusTitle = "HPA"
+
+// HorizontalPodAutoscaler represents an hpa viewer.
+type HorizontalPodAutoscaler struct {
+	ResourceViewer
+}
+
+// NewHorizontalPodAutoscaler returns a new viewer.
+func NewHorizontalPodAutoscaler(gvr client.GVR) ResourceViewer {
+	h := HorizontalPodAutoscaler{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	h.GetTable().SetEnterFn(h.showStatus)
+
+	return &h
+}
+
+func (h *HorizontalPodAutoscaler) showStatus(app *App, _ ui.Tabular, _, path string) {
+	if err := app.inject(NewHPAStatus(app, path), false); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+// NewHPAStatus returns a live hpa metrics targets and scaling events view.
+func NewHPAStatus(app *App, path string) *LiveReport {
+	return NewLiveReport(app, hpaStatusTitle, path, func(ctx context.Context) (string, error) {
+		var hpa dao.HorizontalPodAutoscaler
+		hpa.Init(app.factory, client.NewGVR("autoscaling/v2/horizontalpodautoscalers"))
+		st, err := hpa.Status(ctx, path)
+		if err != nil {
+			return "", err
+		}
+
+		return st.Report(), nil
+	})
+}
@@ -0,0 +1,50 @@
+package view
+
+import (
+	"context"
+	"time"
+
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	"github.com/rs/zerolog/log"
+)
+
+const idleCheckRate = 5 * time.Second
+
+// idleWatcher lowers the models refresh rate once the user is inactive.
+func (a *App) idleWatcher(ctx context.Context) {
+	cfg := a.Config.K9s.IdleConfig()
+	if !cfg.Enabled() {
+		return
+	}
+	defer model.Throttle(0)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(idleCheckRate):
+			if model.Throttled() || time.Since(time.Unix(0, a.inputAt.Load())) < cfg.TimeoutDuration() {
+				continue
+			}
+			log.Debug().Msgf("Idle! Refreshing every %v", cfg.RefreshDuration())
+			model.Throttle(cfg.RefreshDuration())
+		}
+	}
+}
+
+// markActive records user input and restores the models refresh rate.
+func (a *App) markActive() {
+	a.inputAt.Store(time.Now().UnixNano())
+	if model.Throttled() {
+		log.Debug().Msgf("Active! Restoring refresh rate")
+		model.Throttle(0)
+	}
+}
+
+func (a *App) mouseActivity(evt *tcell.EventMouse, action tview.MouseAction) (*tcell.EventMouse, tview.MouseAction) {
+	a.markActive()
+
+	return evt, action
+}
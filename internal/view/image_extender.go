@@ -7,12 +7,12 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/derailed/tcell/v2"
 	"github.com/derailed/tview"
-	"github.com/rs/zerolog/log"
 	corev1 "k8s.io/api/core/v1"
 
 	"github.com/derailed/k9s/internal/client"
@@ -95,27 +95,31 @@ func (s *ImageExtender) bindKeys(aa ui.KeyActions) {
 }
 
 func (s *ImageExtender) setImageCmd(evt *tcell.EventKey) *tcell.EventKey {
-	path := s.GetTable().GetSelectedItem()
-	if path == "" {
+	paths := s.GetTable().GetSelectedItems()
+	if len(paths) == 0 || paths[0] == "" {
 		return nil
 	}
 
 	s.Stop()
 	defer s.Start()
-	if err := s.showImageDialog(path); err != nil {
+	if err := s.showImageDialog(paths); err != nil {
 		s.App().Flash().Err(err)
 	}
 
 	return nil
 }
 
-func (s *ImageExtender) showImageDialog(path string) error {
-	form, err := s.makeSetImageForm(path)
+func (s *ImageExtender) showImageDialog(paths []string) error {
+	form, err := s.makeSetImageForm(paths)
 	if err != nil {
 		return err
 	}
+	msg := fmt.Sprintf("Set image %s %s", s.GVR(), paths[0])
+	if len(paths) > 1 {
+		msg = fmt.Sprintf("Set image on %d marked %s", len(paths), s.GVR())
+	}
 	confirm := tview.NewModalForm("<Set image>", form)
-	confirm.SetText(fmt.Sprintf("Set image %s %s", s.GVR(), path))
+	confirm.SetText(msg)
 	confirm.SetDoneFunc(func(int, string) {
 		s.dismissDialog()
 	})
@@ -125,12 +129,21 @@ func (s *ImageExtender) showImageDialog(path string) error {
 	return nil
 }
 
-func (s *ImageExtender) makeSetImageForm(sel string) (*tview.Form, error) {
-	f := s.makeStyledForm()
-	podSpec, err := s.getPodSpec(sel)
+func (s *ImageExtender) makeSetImageForm(sels []string) (*tview.Form, error) {
+	f := newStyledForm(s.App().Styles.Dialog())
+	podSpec, err := podSpecFor(s.App(), s.GVR(), sels[0])
 	if err != nil {
 		return nil, err
 	}
+	for _, sel := range sels[1:] {
+		spec, err := podSpecFor(s.App(), s.GVR(), sel)
+		if err != nil {
+			return nil, err
+		}
+		if !sameContainers(podSpec, spec) {
+			return nil, fmt.Errorf("unable to set image on marked %s: %s and %s do not share the same containers", s.GVR(), sels[0], sel)
+		}
+	}
 	formContainerLines := make([]*imageFormSpec, 0, len(podSpec.InitContainers)+len(podSpec.Containers))
 	for _, spec := range podSpec.InitContainers {
 		formContainerLines = append(formContainerLines, &imageFormSpec{init: true, name: spec.Name, dockerImage: spec.Image})
@@ -153,14 +166,9 @@ func (s *ImageExtender) makeSetImageForm(sel string) (*tview.Form, error) {
 				imageSpecsModified = append(imageSpecsModified, v.imageSpec())
 			}
 		}
-		ctx, cancel := context.WithTimeout(context.Background(), s.App().Conn().Config().CallTimeout())
-		defer cancel()
-		if err := s.setImages(ctx, sel, imageSpecsModified); err != nil {
-			log.Error().Err(err).Msgf("PodSpec %s image update failed", sel)
-			s.App().Flash().Err(err)
-			return
-		}
-		s.App().Flash().Infof("Resource %s:%s image updated successfully", s.GVR(), sel)
+		runBulk(s, "Set Image", sels, func(ctx context.Context, sel string) error {
+			return s.setImages(ctx, sel, imageSpecsModified)
+		})
 	})
 	f.AddButton("Cancel", func() {
 		s.dismissDialog()
@@ -173,42 +181,29 @@ func (s *ImageExtender) dismissDialog() {
 	s.App().Content.RemovePage(imageKey)
 }
 
-func (s *ImageExtender) makeStyledForm() *tview.Form {
-	f := tview.NewForm()
-	f.SetItemPadding(0)
-	f.SetButtonsAlign(tview.AlignCenter).
-		SetButtonBackgroundColor(tview.Styles.PrimitiveBackgroundColor).
-		SetButtonTextColor(tview.Styles.PrimaryTextColor).
-		SetLabelColor(tcell.ColorAqua).
-		SetFieldTextColor(tcell.ColorOrange)
-	return f
+// sameContainers checks if two pod specs define the same init and regular containers.
+func sameContainers(a, b *corev1.PodSpec) bool {
+	return containerNames(a.InitContainers) == containerNames(b.InitContainers) &&
+		containerNames(a.Containers) == containerNames(b.Containers)
 }
 
-func (s *ImageExtender) getPodSpec(path string) (*corev1.PodSpec, error) {
-	res, err := dao.AccessorFor(s.App().factory, s.GVR())
-	if err != nil {
-		return nil, err
-	}
-	resourceWPodSpec, ok := res.(dao.ContainsPodSpec)
-	if !ok {
-		return nil, fmt.Errorf("expecting a ContainsPodSpec for %q but got %T", s.GVR(), res)
+func containerNames(cc []corev1.Container) string {
+	nn := make([]string, 0, len(cc))
+	for _, c := range cc {
+		nn = append(nn, c.Name)
 	}
+	sort.Strings(nn)
 
-	return resourceWPodSpec.GetPodSpec(path)
+	return strings.Join(nn, ",")
 }
 
 func (s *ImageExtender) setImages(ctx context.Context, path string, imageSpecs dao.ImageSpecs) error {
-	res, err := dao.AccessorFor(s.App().factory, s.GVR())
+	ps, err := podSpecAccessor(s.App(), s.GVR())
 	if err != nil {
 		return err
 	}
 
-	resourceWPodSpec, ok := res.(dao.ContainsPodSpec)
-	if !ok {
-		return fmt.Errorf("expecting a scalable resource for %q", s.GVR())
-	}
-
-	return resourceWPodSpec.SetImages(ctx, path, imageSpecs)
+	return ps.SetImages(ctx, path, imageSpecs)
 }
 
 func (s *ImageExtender) setTraceLogsCmd(evt *tcell.EventKey) *tcell.EventKey {
@@ -304,7 +299,7 @@ func (s *ImageExtender) FindTraceLogScript() (path string) {
 
 // ❌✔️ ✅ 🚫
 func (s *ImageExtender) makeSetTraceLogsForm(path string) (*tview.Form, error) {
-	f := s.makeStyledForm()
+	f := newStyledForm(s.App().Styles.Dialog())
 	ns, _ := client.Namespaced(path)
 	podLabel := ""
 	podname := ""
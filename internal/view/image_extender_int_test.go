@@ -0,0 +1,40 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+)
+
+func TestSameContainers(t *testing.T) {
+	uu := map[string]struct {
+		a, b v1.PodSpec
+		e    bool
+	}{
+		"same": {
+			a: v1.PodSpec{Containers: []v1.Container{{Name: "c1"}, {Name: "c2"}}},
+			b: v1.PodSpec{Containers: []v1.Container{{Name: "c2"}, {Name: "c1"}}},
+			e: true,
+		},
+		"missing": {
+			a: v1.PodSpec{Containers: []v1.Container{{Name: "c1"}, {Name: "c2"}}},
+			b: v1.PodSpec{Containers: []v1.Container{{Name: "c1"}}},
+		},
+		"init": {
+			a: v1.PodSpec{Containers: []v1.Container{{Name: "c1"}}},
+			b: v1.PodSpec{InitContainers: []v1.Container{{Name: "i1"}}, Containers: []v1.Container{{Name: "c1"}}},
+		},
+		"init-swapped": {
+			a: v1.PodSpec{InitContainers: []v1.Container{{Name: "c1"}}},
+			b: v1.PodSpec{Containers: []v1.Container{{Name: "c1"}}},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, sameContainers(&u.a, &u.b))
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package view
+
+import (
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+// Ingress represents an ingress viewer.
+type Ingress struct {
+	ResourceViewer
+}
+
+// NewIngress returns a new viewer.
+func NewIngress(gvr client.GVR) ResourceViewer {
+	i := Ingress{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	i.AddBindKeysFn(i.bindKeys)
+
+	return &i
+}
+
+func (i *Ingress) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftR: ui.NewKeyAction("Routes", i.routesCmd, true),
+	})
+}
+
+func (i *Ingress) routesCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := i.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	showIngressRoutes(i.App(), path)
+
+	return nil
+}
@@ -0,0 +1,179 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+const (
+	ingRoutesGVR      = "ingroutes"
+	routeProbeTimeout = 10 * time.Second
+)
+
+// IngressRoute represents an ingress routes viewer and tester.
+type IngressRoute struct {
+	ResourceViewer
+
+	probes *dao.RouteProbes
+}
+
+// NewIngressRoute returns a new ingress routes view.
+func NewIngressRoute(gvr client.GVR) ResourceViewer {
+	r := IngressRoute{
+		ResourceViewer: NewBrowser(gvr),
+		probes:         dao.NewRouteProbes(),
+	}
+	r.GetTable().SetBorderFocusColor(tcell.ColorMediumSpringGreen)
+	r.GetTable().SetSelectedStyle(tcell.StyleDefault.Foreground(tcell.ColorWhite).Background(tcell.ColorMediumSpringGreen).Attributes(tcell.AttrNone))
+	r.GetTable().SetSortCol("HOST", true)
+	r.AddBindKeysFn(r.bindKeys)
+
+	return &r
+}
+
+// Init initializes the view.
+func (r *IngressRoute) Init(ctx context.Context) error {
+	if err := r.ResourceViewer.Init(ctx); err != nil {
+		return err
+	}
+	r.GetTable().GetModel().SetNamespace(client.AllNamespaces)
+
+	return nil
+}
+
+func (r *IngressRoute) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Delete(tcell.KeyCtrlW, tcell.KeyCtrlL, tcell.KeyCtrlZ)
+	aa.Add(ui.KeyActions{
+		tcell.KeyEnter: ui.NewKeyAction("Goto Service", r.gotoCmd, true),
+		ui.KeyT:        ui.NewKeyAction("Test Route", r.testCmd, true),
+		ui.KeyShiftT:   ui.NewKeyAction("Test All", r.testAllCmd, true),
+		ui.KeyShiftH:   ui.NewKeyAction("Sort Host", r.GetTable().SortColCmd("HOST", true), false),
+	})
+}
+
+func (r *IngressRoute) gotoCmd(evt *tcell.EventKey) *tcell.EventKey {
+	rt, ok := r.selectedRoute()
+	if !ok {
+		return evt
+	}
+	if rt.Service == "" {
+		r.App().Flash().Warn("Route has no backend service")
+		return nil
+	}
+	r.App().gotoResource("services", client.FQN(rt.Namespace, rt.Service), false)
+
+	return nil
+}
+
+func (r *IngressRoute) testCmd(evt *tcell.EventKey) *tcell.EventKey {
+	rt, ok := r.selectedRoute()
+	if !ok {
+		return evt
+	}
+	r.probe([]render.IngressRouteRes{rt})
+
+	return nil
+}
+
+func (r *IngressRoute) testAllCmd(evt *tcell.EventKey) *tcell.EventKey {
+	data := r.GetTable().GetModel().Peek()
+	rr := make([]render.IngressRouteRes, 0, len(data.RowEvents))
+	for _, re := range data.RowEvents {
+		if rt, ok := r.route(re.Row.ID); ok {
+			rr = append(rr, rt)
+		}
+	}
+	r.probe(rr)
+
+	return nil
+}
+
+func (r *IngressRoute) probe(rr []render.IngressRouteRes) {
+	acc, err := dao.AccessorFor(r.App().factory, client.NewGVR(ingRoutesGVR))
+	if err != nil {
+		r.App().Flash().Err(err)
+		return
+	}
+	res, ok := acc.(*dao.IngressRoute)
+	if !ok {
+		r.App().Flash().Err(fmt.Errorf("expecting an ingress route accessor but got %T", acc))
+		return
+	}
+
+	r.App().Flash().Infof("Testing %d route(s)...", len(rr))
+	go func() {
+		for _, rt := range rr {
+			ctx, cancel := context.WithTimeout(context.Background(), routeProbeTimeout)
+			r.probes.Set(rt.ID(), res.Probe(ctx, rt))
+			cancel()
+		}
+		r.App().QueueUpdateDraw(func() {
+			r.App().Flash().Infof("Tested %d route(s)", len(rr))
+			if top := r.App().Content.Top(); top != nil && top.Name() == r.Name() {
+				r.Start()
+			}
+		})
+	}()
+}
+
+func (r *IngressRoute) selectedRoute() (render.IngressRouteRes, bool) {
+	id := r.GetTable().GetSelectedItem()
+	if id == "" {
+		return render.IngressRouteRes{}, false
+	}
+
+	return r.route(id)
+}
+
+func (r *IngressRoute) route(id string) (render.IngressRouteRes, bool) {
+	data := r.GetTable().GetModel().Peek()
+	for _, re := range data.RowEvents {
+		if re.Row.ID != id {
+			continue
+		}
+		ff := re.Row.Fields
+		ns, _ := client.Namespaced(r.GetTable().Path)
+		rt := render.IngressRouteRes{
+			Namespace: ns,
+			Host:      ff[0],
+			Path:      ff[1],
+			PathType:  ff[2],
+			Service:   ff[3],
+			Port:      ff[4],
+		}
+		if rt.Port == "" {
+			rt.Service, rt.Resource = "", ff[3]
+		}
+		return rt, true
+	}
+
+	return render.IngressRouteRes{}, false
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func showIngressRoutes(app *App, path string) {
+	v := NewIngressRoute(client.NewGVR(ingRoutesGVR))
+	v.GetTable().Extras = path
+	v.SetContextFn(ingressRouteCtx(path, v.(*IngressRoute).probes))
+	if err := app.inject(v, false); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+func ingressRouteCtx(path string, probes *dao.RouteProbes) ContextFunc {
+	return func(ctx context.Context) context.Context {
+		ctx = context.WithValue(ctx, internal.KeyPath, path)
+		return context.WithValue(ctx, internal.KeyProbes, probes)
+	}
+}
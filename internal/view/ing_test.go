@@ -0,0 +1,25 @@
+package view_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/view"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIngressNew(t *testing.T) {
+	v := view.NewIngress(client.NewGVR("networking.k8s.io/v1/ingresses"))
+
+	assert.Nil(t, v.Init(makeCtx()))
+	assert.Equal(t, "Ingresses", v.Name())
+	assert.Equal(t, 7, len(v.Hints()))
+}
+
+func TestIngressRouteNew(t *testing.T) {
+	v := view.NewIngressRoute(client.NewGVR("ingroutes"))
+
+	assert.Nil(t, v.Init(makeCtx()))
+	assert.Equal(t, "IngressRoutes", v.Name())
+	assert.Equal(t, 7, len(v.Hints()))
+}
@@ -1,8 +1,13 @@
 package view
 
 import (
+	"fmt"
+
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tcell/v2"
 	batchv1 "k8s.io/api/batch/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
@@ -19,10 +24,20 @@ func NewJob(gvr client.GVR) ResourceViewer {
 	j := Job{ResourceViewer: NewLogsExtender(NewBrowser(gvr), nil)}
 	j.GetTable().SetEnterFn(j.showPods)
 	j.GetTable().SetSortCol("AGE", true)
+	j.AddBindKeysFn(j.bindKeys)
 
 	return &j
 }
 
+func (j *Job) bindKeys(aa ui.KeyActions) {
+	if j.App().Config.K9s.IsReadOnly() {
+		return
+	}
+	aa.Add(ui.KeyActions{
+		ui.KeyR: ui.NewKeyAction("Re-Run", j.rerunCmd, true),
+	})
+}
+
 func (*Job) showPods(app *App, model ui.Tabular, gvr, path string) {
 	o, err := app.factory.Get(gvr, path, true, labels.Everything())
 	if err != nil {
@@ -39,3 +54,32 @@ func (*Job) showPods(app *App, model ui.Tabular, gvr, path string) {
 
 	showPodsFromSelector(app, path, job.Spec.Selector)
 }
+
+func (j *Job) rerunCmd(evt *tcell.EventKey) *tcell.EventKey {
+	fqn := j.GetTable().GetSelectedItem()
+	if fqn == "" {
+		return evt
+	}
+
+	msg := fmt.Sprintf("Re-run Job %s?", fqn)
+	dialog.ShowConfirm(j.App().Styles.Dialog(), j.App().Content.Pages, "Confirm Job Re-Run", msg, func() {
+		res, err := dao.AccessorFor(j.App().factory, j.GVR())
+		if err != nil {
+			j.App().Flash().Err(fmt.Errorf("no accessor for %q", j.GVR()))
+			return
+		}
+		runner, ok := res.(dao.Runnable)
+		if !ok {
+			j.App().Flash().Err(fmt.Errorf("expecting a job runner resource for %q", j.GVR()))
+			return
+		}
+
+		if err := runner.Run(fqn); err != nil {
+			j.App().Flash().Errf("Job re-run failed %v", err)
+			return
+		}
+		j.App().Flash().Infof("Re-running Job %s", fqn)
+	}, func() {})
+
+	return nil
+}
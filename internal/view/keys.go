@@ -0,0 +1,87 @@
+package view
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+type actioner interface {
+	Actions() ui.KeyActions
+}
+
+func (a *App) keysCmd() error {
+	top := a.Content.Top()
+	if top == nil {
+		return fmt.Errorf("no active view")
+	}
+	var aa ui.KeyActions
+	if v, ok := top.(actioner); ok {
+		aa = v.Actions()
+	}
+	var aliases []string
+	if r, ok := top.(Runner); ok {
+		aliases = r.Aliases()
+	}
+	hh := config.NewHotKeys()
+	_ = hh.Load()
+	km := keyMap(a.GetActions(), aa, hh, aliases)
+
+	return a.inject(NewDetails(a, "Keys", top.Name(), true).Update(km), false)
+}
+
+// keyMap renders the effective key bindings for a view.
+func keyMap(global, view ui.KeyActions, hh config.HotKeys, aliases []string) string {
+	var b strings.Builder
+	writeKeys(&b, "VIEW", view)
+	writeKeys(&b, "GLOBAL", global)
+
+	var chords []string
+	for _, k := range hotKeyNames(hh) {
+		hk := hh.HotKey[k]
+		if !hk.IsChord() || (hk.IsScoped() && !inScope(hk.Scopes, aliases)) {
+			continue
+		}
+		chords = append(chords, fmt.Sprintf("  %-15s %s", hk.ShortCut, hk.Description))
+	}
+	if len(chords) > 0 {
+		b.WriteString("CHORDS\n" + strings.Join(chords, "\n") + "\n\n")
+	}
+
+	var unbound []string
+	for _, u := range hh.Unbind {
+		if len(u.Scopes) == 0 || inScope(u.Scopes, aliases) {
+			unbound = append(unbound, "  "+u.Key)
+		}
+	}
+	if len(unbound) > 0 {
+		b.WriteString("UNBOUND\n" + strings.Join(unbound, "\n") + "\n")
+	}
+
+	return b.String()
+}
+
+func writeKeys(b *strings.Builder, title string, aa ui.KeyActions) {
+	if len(aa) == 0 {
+		return
+	}
+	kk := make([]int, 0, len(aa))
+	for k := range aa {
+		kk = append(kk, int(k))
+	}
+	sort.Ints(kk)
+	b.WriteString(title + "\n")
+	for _, k := range kk {
+		key := tcell.Key(int16(k))
+		name, ok := tcell.KeyNames[key]
+		if !ok {
+			continue
+		}
+		fmt.Fprintf(b, "  %-15s %s\n", name, aa[key].Description)
+	}
+	b.WriteString("\n")
+}
@@ -0,0 +1,34 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeyMap(t *testing.T) {
+	hh := config.NewHotKeys()
+	hh.HotKey["dp"] = config.HotKey{ShortCut: "g d", Description: "Goto deployments", Command: "dp"}
+	hh.HotKey["sec"] = config.HotKey{ShortCut: "g s", Description: "Goto secrets", Command: "sec", Scopes: []string{"cm"}}
+	hh.Unbind = []config.KeyUnbind{{Key: "Ctrl-D", Scopes: []string{"po"}}}
+
+	view := ui.KeyActions{ui.KeyY: ui.NewKeyAction("YAML", nil, true)}
+	km := keyMap(nil, view, hh, []string{"po"})
+
+	assert.Contains(t, km, "VIEW\n  y")
+	assert.NotContains(t, km, "GLOBAL")
+	assert.Contains(t, km, "g d             Goto deployments")
+	assert.NotContains(t, km, "Goto secrets")
+	assert.Contains(t, km, "UNBOUND\n  Ctrl-D")
+}
+
+func TestHotKeyNames(t *testing.T) {
+	hh := config.NewHotKeys()
+	hh.HotKey["b"] = config.HotKey{ShortCut: "shift-1", Scopes: []string{"po"}}
+	hh.HotKey["c"] = config.HotKey{ShortCut: "shift-1"}
+	hh.HotKey["a"] = config.HotKey{ShortCut: "shift-2"}
+
+	assert.Equal(t, []string{"a", "c", "b"}, hotKeyNames(hh))
+}
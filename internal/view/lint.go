@@ -0,0 +1,68 @@
+package view
+
+import (
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+// Lint represents the cluster sanitizer viewer.
+type Lint struct {
+	ResourceViewer
+}
+
+// NewLint returns a new cluster sanitizer view.
+func NewLint(gvr client.GVR) ResourceViewer {
+	l := Lint{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	l.GetTable().SetBorderFocusColor(tcell.ColorMediumSpringGreen)
+	l.GetTable().SetSelectedStyle(tcell.StyleDefault.Foreground(tcell.ColorWhite).Background(tcell.ColorMediumSpringGreen).Attributes(tcell.AttrNone))
+	l.GetTable().SetSortCol("LEVEL", false)
+	l.GetTable().SetDecorateFn(l.decorateScore)
+	l.AddBindKeysFn(l.bindKeys)
+
+	return &l
+}
+
+func (l *Lint) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Delete(tcell.KeyCtrlW, tcell.KeyCtrlL, tcell.KeyCtrlZ, tcell.KeyCtrlD)
+	aa.Add(ui.KeyActions{
+		tcell.KeyEnter: ui.NewKeyAction("Goto", l.gotoCmd, true),
+		ui.KeyShiftL:   ui.NewKeyAction("Sort Level", l.GetTable().SortColCmd("LEVEL", false), false),
+		ui.KeyShiftC:   ui.NewKeyAction("Sort Check", l.GetTable().SortColCmd("CHECK", true), false),
+		ui.KeyShiftR:   ui.NewKeyAction("Sort Resource", l.GetTable().SortColCmd("RESOURCE", true), false),
+	})
+}
+
+// decorateScore shows the sanitization score in the view title.
+func (l *Lint) decorateScore(data *render.TableData) {
+	r, ok := dao.LintScore(data.Namespace)
+	if !ok {
+		return
+	}
+	ns := data.Namespace
+	if client.IsAllNamespaces(ns) {
+		ns = client.NamespaceAll
+	}
+	l.GetTable().Extras = fmt.Sprintf("%s score %d%% %s", ns, r.Score(), r.Grade())
+}
+
+func (l *Lint) gotoCmd(evt *tcell.EventKey) *tcell.EventKey {
+	row, _ := l.GetTable().GetSelection()
+	if row == 0 {
+		return evt
+	}
+	gvr, path := ui.TrimCell(l.GetTable().SelectTable, row, 2), ui.TrimCell(l.GetTable().SelectTable, row, 3)
+	if gvr == "" || path == "" {
+		return nil
+	}
+	l.App().gotoResource(gvr, path, false)
+
+	return nil
+}
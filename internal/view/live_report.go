@@ -0,0 +1,126 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+)
+
+// ReportFunc generates a textual report.
+type ReportFunc func(context.Context) (string, error)
+
+// LiveReport presents a textual report refreshed at the k9s refresh rate.
+type LiveReport struct {
+	*tview.TextView
+
+	app      *App
+	actions  ui.KeyActions
+	title    string
+	path     string
+	reportFn ReportFunc
+	cancelFn context.CancelFunc
+}
+
+// NewLiveReport returns a new live report view.
+func NewLiveReport(app *App, title, path string, f ReportFunc) *LiveReport {
+	return &LiveReport{
+		TextView: tview.NewTextView(),
+		app:      app,
+		actions:  make(ui.KeyActions),
+		title:    title,
+		path:     path,
+		reportFn: f,
+	}
+}
+
+// Init initializes the view.
+func (r *LiveReport) Init(_ context.Context) error {
+	r.SetBorder(true)
+	r.SetBorderPadding(1, 0, 1, 1)
+	r.SetDynamicColors(true)
+	r.SetTitle(fmt.Sprintf(" [aqua::b]%s([fuchsia::b]%s[aqua::b]) ", r.title, r.path))
+	r.actions.Add(ui.KeyActions{
+		tcell.KeyEscape: ui.NewKeyAction("Back", r.app.PrevCmd, true),
+		tcell.KeyCtrlR:  ui.NewKeyAction("Refresh", r.refreshCmd, true),
+	})
+	r.SetInputCapture(func(evt *tcell.EventKey) *tcell.EventKey {
+		if a, ok := r.actions[ui.AsKey(evt)]; ok {
+			return a.Action(evt)
+		}
+		return evt
+	})
+
+	return nil
+}
+
+// InCmdMode checks if prompt is active.
+func (*LiveReport) InCmdMode() bool {
+	return false
+}
+
+// Start starts the view.
+func (r *LiveReport) Start() {
+	r.Stop()
+	var ctx context.Context
+	ctx, r.cancelFn = context.WithCancel(context.Background())
+	go r.refresh(ctx)
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(time.Duration(r.app.Config.K9s.GetRefreshRate()) * time.Second):
+				r.refresh(ctx)
+			}
+		}
+	}()
+}
+
+// Stop stops the view.
+func (r *LiveReport) Stop() {
+	if r.cancelFn != nil {
+		r.cancelFn()
+		r.cancelFn = nil
+	}
+}
+
+// Name returns the component name.
+func (r *LiveReport) Name() string { return r.title }
+
+// Hints returns the view hints.
+func (r *LiveReport) Hints() model.MenuHints {
+	return r.actions.Hints()
+}
+
+// ExtraHints returns additional hints.
+func (r *LiveReport) ExtraHints() map[string]string {
+	return nil
+}
+
+func (r *LiveReport) refreshCmd(evt *tcell.EventKey) *tcell.EventKey {
+	go r.refresh(context.Background())
+
+	return nil
+}
+
+func (r *LiveReport) refresh(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, r.app.Conn().Config().CallTimeout())
+	defer cancel()
+
+	report, err := r.reportFn(ctx)
+	if ctx.Err() == context.Canceled {
+		return
+	}
+	r.app.QueueUpdateDraw(func() {
+		if err != nil {
+			r.app.Flash().Err(err)
+			return
+		}
+		r.SetText(report)
+	})
+}
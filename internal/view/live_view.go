@@ -17,7 +17,11 @@ import (
 	"github.com/sahilm/fuzzy"
 )
 
-const liveViewTitleFmt = "[fg:bg:b] %s([hilite:bg:b]%s[fg:bg:-])[fg:bg:-] "
+const (
+	liveViewTitleFmt = "[fg:bg:b] %s([hilite:bg:b]%s[fg:bg:-])[fg:bg:-] "
+	jumpDialogKey    = "jump"
+	jumpRegion       = "jump"
+)
 
 // LiveView represents a live text viewer.
 type LiveView struct {
@@ -34,6 +38,11 @@ type LiveView struct {
 	fullScreen                bool
 	managedField              bool
 	autoRefresh               bool
+	lines                     []string
+	matches                   fuzzy.Matches
+	folds                     model.Folds
+	visible                   []int
+	jumpLine                  int
 }
 
 // NewLiveView returns a live viewer.
@@ -48,6 +57,8 @@ func NewLiveView(app *App, title string, m model.ResourceViewer) *LiveView {
 		maxRegions:    0,
 		cmdBuff:       model.NewFishBuff('/', model.FilterBuffer),
 		model:         m,
+		folds:         make(model.Folds),
+		jumpLine:      -1,
 	}
 	v.AddItem(v.text, 0, 1, true)
 
@@ -100,28 +111,44 @@ func (v *LiveView) ResourceChanged(lines []string, matches fuzzy.Matches) {
 		}(time.Now())
 
 		v.text.SetTextAlign(tview.AlignLeft)
+		if len(lines) != len(v.lines) {
+			v.folds, v.jumpLine = v.folds.Remap(v.lines, lines), -1
+		}
+		v.lines, v.matches = lines, matches
 		v.maxRegions = len(matches)
-		var ll []string
-		if len(matches) == 0 {
-			ll = lines
-		} else {
-			ll = make([]string, len(lines))
-			copy(ll, lines)
-			for i, m := range matches {
-				loc, line := m.MatchedIndexes, ll[m.Index]
-				ll[m.Index] = line[:loc[0]] + `<<<"search_` + strconv.Itoa(i) + `">>>` + line[loc[0]:loc[1]] + `<<<"">>>` + line[loc[1]:]
+		ll := make([]string, len(lines))
+		copy(ll, lines)
+		for i, m := range matches {
+			v.folds.Reveal(lines, m.Index)
+			loc, line := m.MatchedIndexes, ll[m.Index]
+			ll[m.Index] = line[:loc[0]] + `<<<"search_` + strconv.Itoa(i) + `">>>` + line[loc[0]:loc[1]] + `<<<"">>>` + line[loc[1]:]
+		}
+		if len(matches) == 0 && v.jumpLine >= 0 && v.jumpLine < len(ll) {
+			ll[v.jumpLine] = `<<<"` + jumpRegion + `">>>` + ll[v.jumpLine] + `<<<"">>>`
+		}
+		v.visible = model.FoldYAML(lines, v.folds)
+		vv := make([]string, 0, len(v.visible))
+		for _, i := range v.visible {
+			if _, ok := v.folds[i]; ok {
+				vv = append(vv, ll[i]+model.FoldMarker)
+				continue
 			}
+			vv = append(vv, ll[i])
 		}
 
 		if v.text.GetText(true) == "" {
 			v.text.ScrollToBeginning()
 		}
 
-		v.text.SetText(colorizeYAML(v.app.Styles.Views().Yaml, strings.Join(ll, "\n")))
+		v.text.SetText(colorizeYAML(v.app.Styles.Views().Yaml, strings.Join(vv, "\n")))
 		v.text.Highlight()
-		if v.currentRegion < v.maxRegions {
+		switch {
+		case v.currentRegion < v.maxRegions:
 			v.text.Highlight("search_" + strconv.Itoa(v.currentRegion))
 			v.text.ScrollToHighlight()
+		case len(matches) == 0 && v.jumpLine >= 0:
+			v.text.Highlight(jumpRegion)
+			v.text.ScrollToHighlight()
 		}
 		v.updateTitle()
 	})
@@ -152,12 +179,20 @@ func (v *LiveView) bindKeys() {
 		ui.KeyShiftN:    ui.NewKeyAction("Prev Match", v.prevCmd, true),
 		ui.KeySlash:     ui.NewSharedKeyAction("Filter Mode", v.activateCmd, false),
 		tcell.KeyDelete: ui.NewSharedKeyAction("Erase", v.eraseCmd, false),
+		ui.KeyZ:         ui.NewKeyAction("Toggle Fold", v.toggleFoldCmd, true),
+		ui.KeyShiftZ:    ui.NewKeyAction("Toggle Fold All", v.toggleFoldAllCmd, true),
 	})
 
 	if v.title == "YAML" {
 		v.actions.Add(ui.KeyActions{
 			ui.KeyM: ui.NewKeyAction("Toggle ManagedFields", v.toggleManagedCmd, true),
+			ui.KeyP: ui.NewKeyAction("Jump To Path", v.jumpCmd, true),
 		})
+		if _, ok := v.model.(gvrModel); ok {
+			v.actions.Add(ui.KeyActions{
+				ui.KeyX: ui.NewKeyAction("Explain Field", v.explainCmd, true),
+			})
+		}
 	}
 }
 
@@ -201,18 +236,21 @@ func (v *LiveView) Name() string { return v.title }
 
 // Start starts the view updater.
 func (v *LiveView) Start() {
-	if v.autoRefresh {
-		var ctx context.Context
-		ctx, v.cancel = context.WithCancel(v.defaultCtx())
-
-		if err := v.model.Watch(ctx); err != nil {
-			log.Error().Err(err).Msgf("LiveView watcher failed")
+	var ctx context.Context
+	ctx, v.cancel = context.WithCancel(v.defaultCtx())
+	done := v.app.trackCall("Loading "+v.model.GetPath(), v.cancel)
+	go func() {
+		defer done()
+		if v.autoRefresh {
+			if err := v.model.Watch(ctx); err != nil && ctx.Err() == nil {
+				log.Error().Err(err).Msgf("LiveView watcher failed")
+			}
+			return
 		}
-		return
-	}
-	if err := v.model.Refresh(v.defaultCtx()); err != nil {
-		log.Error().Err(err).Msgf("refresh failed")
-	}
+		if err := v.model.Refresh(ctx); err != nil && ctx.Err() == nil {
+			log.Error().Err(err).Msgf("refresh failed")
+		}
+	}()
 }
 
 func (v *LiveView) defaultCtx() context.Context {
@@ -266,6 +304,134 @@ func (v *LiveView) toggleFullScreenCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+// foldAnchor returns the line fold operations apply to, ie the current match,
+// the last jump location or the first visible line.
+func (v *LiveView) foldAnchor() int {
+	if v.currentRegion < len(v.matches) {
+		return v.matches[v.currentRegion].Index
+	}
+	if v.jumpLine >= 0 {
+		return v.jumpLine
+	}
+	row, _ := v.text.GetScrollOffset()
+	if row < len(v.visible) {
+		return v.visible[row]
+	}
+
+	return -1
+}
+
+func (v *LiveView) toggleFoldCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if v.app.InCmdMode() {
+		return evt
+	}
+
+	i := v.foldAnchor()
+	if i < 0 || i >= len(v.lines) {
+		return nil
+	}
+	if _, ok := v.folds[i]; !ok && !model.IsFoldable(v.lines, i) {
+		if i = model.YAMLParent(v.lines, i); i < 0 {
+			return nil
+		}
+	}
+	v.folds.Toggle(i)
+	v.jumpLine = i
+	v.ResourceChanged(v.lines, v.matches)
+
+	return nil
+}
+
+func (v *LiveView) toggleFoldAllCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if v.app.InCmdMode() {
+		return evt
+	}
+
+	if len(v.folds) > 0 {
+		v.folds = make(model.Folds)
+	} else {
+		v.folds = model.TopLevelFolds(v.lines)
+	}
+	v.jumpLine = -1
+	v.text.ScrollToBeginning()
+	v.ResourceChanged(v.lines, v.matches)
+
+	return nil
+}
+
+func (v *LiveView) jumpCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if v.app.InCmdMode() {
+		return evt
+	}
+
+	var path string
+	f := newStyledForm(v.app.Styles.Dialog())
+	f.AddInputField("Path:", "", 50, nil, func(changed string) {
+		path = changed
+	})
+	f.AddButton("OK", func() {
+		v.dismissJump()
+		if err := v.jumpTo(path); err != nil {
+			v.app.Flash().Err(err)
+		}
+	})
+	f.AddButton("Cancel", func() {
+		v.dismissJump()
+	})
+
+	modal := tview.NewModalForm("<Jump>", f)
+	modal.SetText("Jump to path (ie .spec.containers[0])")
+	modal.SetDoneFunc(func(int, string) {
+		v.dismissJump()
+	})
+	v.app.Content.AddPage(jumpDialogKey, modal, false, false)
+	v.app.Content.ShowPage(jumpDialogKey)
+
+	return nil
+}
+
+// explainCmd shows the schema documentation of the field at the fold anchor.
+func (v *LiveView) explainCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if v.app.InCmdMode() {
+		return evt
+	}
+	m, ok := v.model.(gvrModel)
+	if !ok {
+		return nil
+	}
+	path := model.YAMLFieldPath(v.lines, v.foldAnchor())
+	if len(path) == 0 {
+		v.app.Flash().Warn("No field to explain")
+		return nil
+	}
+	if err := showExplainDoc(v.app, m.GVR().String()+"."+strings.Join(path, ".")); err != nil {
+		v.app.Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (v *LiveView) dismissJump() {
+	v.app.Content.RemovePage(jumpDialogKey)
+}
+
+func (v *LiveView) jumpTo(path string) error {
+	i, err := model.YAMLPathLine(v.lines, path)
+	if err != nil {
+		return err
+	}
+	v.folds.Reveal(v.lines, i)
+	v.jumpLine = i
+	if len(v.matches) > 0 {
+		v.model.ClearFilter()
+		v.cmdBuff.Reset()
+		return nil
+	}
+	v.ResourceChanged(v.lines, v.matches)
+
+	return nil
+}
+
 func (v *LiveView) nextCmd(evt *tcell.EventKey) *tcell.EventKey {
 	if v.cmdBuff.Empty() {
 		return evt
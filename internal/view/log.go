@@ -84,6 +84,7 @@ func (l *Log) Init(ctx context.Context) (err error) {
 	l.logs.SetMaxLines(l.app.Config.K9s.Logger.BufferSize)
 
 	l.ansiWriter = tview.ANSIWriter(l.logs, l.app.Styles.Views().Log.FgColor.String(), l.app.Styles.Views().Log.BgColor.String())
+	l.logs.SetMouseCapture(l.mouse)
 	l.AddItem(l.logs, 0, 1, true)
 	l.bindKeys()
 
@@ -487,6 +488,17 @@ func (l *Log) toggleAutoScrollCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+// mouse suspends autoscroll while scrolling back thru the logs.
+func (l *Log) mouse(action tview.MouseAction, evt *tcell.EventMouse) (tview.MouseAction, *tcell.EventMouse) {
+	if action == tview.MouseScrollUp && l.indicator.AutoScroll() {
+		l.indicator.ToggleAutoScroll()
+		l.follow = false
+		l.indicator.Refresh()
+	}
+
+	return action, evt
+}
+
 func (l *Log) toggleFullScreenCmd(evt *tcell.EventKey) *tcell.EventKey {
 	if l.app.InCmdMode() {
 		return evt
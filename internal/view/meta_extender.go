@@ -0,0 +1,223 @@
+package view
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	metaDialogKey = "meta"
+	// Larger annotations are left alone as they do not fit a form field.
+	maxMetaValueSize = 256
+)
+
+// MetaExtender provides for editing resource labels and annotations.
+type MetaExtender struct {
+	ResourceViewer
+}
+
+// NewMetaExtender returns a new extender.
+func NewMetaExtender(r ResourceViewer) ResourceViewer {
+	m := MetaExtender{ResourceViewer: r}
+	m.AddBindKeysFn(m.bindKeys)
+
+	return &m
+}
+
+func (m *MetaExtender) bindKeys(aa ui.KeyActions) {
+	if m.App().Config.K9s.IsReadOnly() {
+		return
+	}
+	aa.Add(ui.KeyActions{
+		tcell.KeyCtrlT: ui.NewKeyAction("Labels/Annotations", m.editCmd, true),
+	})
+}
+
+func (m *MetaExtender) editCmd(evt *tcell.EventKey) *tcell.EventKey {
+	paths := m.GetTable().GetSelectedItems()
+	if len(paths) == 0 || paths[0] == "" {
+		return evt
+	}
+	o, err := m.App().factory.Get(m.GVR().String(), paths[0], true, labels.Everything())
+	if err != nil {
+		m.App().Flash().Err(err)
+		return nil
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		m.App().Flash().Errf("expecting unstructured but got %T", o)
+		return nil
+	}
+
+	m.Stop()
+	defer m.Start()
+	m.showMetaDialog(paths, u.GetLabels(), u.GetAnnotations())
+
+	return nil
+}
+
+func (m *MetaExtender) showMetaDialog(paths []string, ll, aa map[string]string) {
+	msg := fmt.Sprintf("Edit %s %s labels/annotations", singularize(m.GVR().R()), paths[0])
+	if len(paths) > 1 {
+		msg = fmt.Sprintf("Edit labels/annotations on %d marked %s", len(paths), m.GVR().R())
+	}
+	confirm := tview.NewModalForm("<Labels/Annotations>", m.makeMetaForm(paths, ll, aa))
+	confirm.SetText(msg)
+	confirm.SetDoneFunc(func(int, string) {
+		m.dismissDialog()
+	})
+	m.App().Content.AddPage(metaDialogKey, confirm, false, false)
+	m.App().Content.ShowPage(metaDialogKey)
+}
+
+func (m *MetaExtender) makeMetaForm(paths []string, ll, aa map[string]string) *tview.Form {
+	f := newStyledForm(m.App().Styles.Dialog())
+
+	rows := append(metaRows(dao.LabelsField, ll), metaRows(dao.AnnotationsField, aa)...)
+	for _, r := range rows {
+		r := r
+		label := "Label:"
+		if r.field == dao.AnnotationsField {
+			label = "Annotation:"
+		}
+		f.AddInputField(label, r.text, 0, nil, func(s string) {
+			r.text = s
+		})
+	}
+	var newLabels, newAnnotations string
+	f.AddInputField("New Labels:", "", 0, nil, func(s string) {
+		newLabels = s
+	})
+	f.AddInputField("New Annotations:", "", 0, nil, func(s string) {
+		newAnnotations = s
+	})
+
+	f.AddButton("OK", func() {
+		defer m.dismissDialog()
+		lc, err := metaChanges(dao.LabelsField, rows, newLabels)
+		if err != nil {
+			m.App().Flash().Err(err)
+			return
+		}
+		ac, err := metaChanges(dao.AnnotationsField, rows, newAnnotations)
+		if err != nil {
+			m.App().Flash().Err(err)
+			return
+		}
+		if lc.IsEmpty() && ac.IsEmpty() {
+			m.App().Flash().Info("No labels/annotations changes")
+			return
+		}
+		runBulk(m, "Edit Metadata", paths, func(ctx context.Context, path string) error {
+			return m.editMeta(ctx, path, lc, ac)
+		})
+	})
+	f.AddButton("Cancel", func() {
+		m.dismissDialog()
+	})
+
+	return f
+}
+
+func (m *MetaExtender) editMeta(ctx context.Context, path string, lc, ac dao.MetaChanges) error {
+	res, err := dao.AccessorFor(m.App().factory, m.GVR())
+	if err != nil {
+		return err
+	}
+	e, ok := res.(dao.MetaEditor)
+	if !ok {
+		return fmt.Errorf("expecting a meta editor for %q", m.GVR())
+	}
+	if !lc.IsEmpty() {
+		if err := e.EditMeta(ctx, path, dao.LabelsField, lc); err != nil {
+			return err
+		}
+	}
+	if ac.IsEmpty() {
+		return nil
+	}
+
+	return e.EditMeta(ctx, path, dao.AnnotationsField, ac)
+}
+
+func (m *MetaExtender) dismissDialog() {
+	m.App().Content.RemovePage(metaDialogKey)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// metaRow tracks an editable label or annotation.
+type metaRow struct {
+	field, key, orig, text string
+}
+
+func metaRows(field string, mm map[string]string) []*metaRow {
+	kk := make([]string, 0, len(mm))
+	for k, v := range mm {
+		if len(v) > maxMetaValueSize || strings.Contains(v, "\n") {
+			continue
+		}
+		kk = append(kk, k)
+	}
+	sort.Strings(kk)
+
+	rows := make([]*metaRow, 0, len(kk))
+	for _, k := range kk {
+		t := k + "=" + mm[k]
+		rows = append(rows, &metaRow{field: field, key: k, orig: t, text: t})
+	}
+
+	return rows
+}
+
+// metaChanges computes a field edits from the form rows and new key=value entries.
+// A cleared row removes its key.
+func metaChanges(field string, rows []*metaRow, added string) (dao.MetaChanges, error) {
+	changes := dao.MetaChanges{Set: make(map[string]string)}
+	for _, r := range rows {
+		if r.field != field || r.text == r.orig {
+			continue
+		}
+		if strings.TrimSpace(r.text) == "" {
+			changes.Remove = append(changes.Remove, r.key)
+			continue
+		}
+		k, v, err := parseMetaEntry(r.text)
+		if err != nil {
+			return dao.MetaChanges{}, err
+		}
+		if k != r.key {
+			changes.Remove = append(changes.Remove, r.key)
+		}
+		changes.Set[k] = v
+	}
+	for _, e := range strings.Fields(added) {
+		k, v, err := parseMetaEntry(e)
+		if err != nil {
+			return dao.MetaChanges{}, err
+		}
+		changes.Set[k] = v
+	}
+
+	return changes, nil
+}
+
+func parseMetaEntry(s string) (string, string, error) {
+	k, v, ok := strings.Cut(strings.TrimSpace(s), "=")
+	if !ok || k == "" {
+		return "", "", errors.New("invalid entry " + s + ", expecting key=value")
+	}
+
+	return k, v, nil
+}
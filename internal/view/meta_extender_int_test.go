@@ -0,0 +1,61 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetaChanges(t *testing.T) {
+	uu := map[string]struct {
+		edits map[string]string
+		added string
+		e     dao.MetaChanges
+		err   bool
+	}{
+		"none": {
+			e: dao.MetaChanges{Set: map[string]string{}},
+		},
+		"edit": {
+			edits: map[string]string{"app": "app=blee"},
+			added: "tier=web env=",
+			e: dao.MetaChanges{Set: map[string]string{
+				"app":  "blee",
+				"tier": "web",
+				"env":  "",
+			}},
+		},
+		"remove": {
+			edits: map[string]string{"app": "", "team": "owner=bozo"},
+			e: dao.MetaChanges{
+				Set:    map[string]string{"owner": "bozo"},
+				Remove: []string{"app", "team"},
+			},
+		},
+		"invalid": {
+			added: "blee",
+			err:   true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			rows := metaRows(dao.LabelsField, map[string]string{"app": "fred", "team": "bozo"})
+			rows = append(rows, metaRows(dao.AnnotationsField, map[string]string{"app": "zorg"})...)
+			for _, r := range rows {
+				if v, ok := u.edits[r.key]; ok && r.field == dao.LabelsField {
+					r.text = v
+				}
+			}
+			c, err := metaChanges(dao.LabelsField, rows, u.added)
+			if u.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, c)
+		})
+	}
+}
@@ -3,14 +3,17 @@ package view
 import (
 	"context"
 	"fmt"
+	"io"
 	"time"
 
+	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/k9s/internal/ui/dialog"
 	"github.com/derailed/tcell/v2"
 	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -22,19 +25,25 @@ type Node struct {
 // NewNode returns a new node view.
 func NewNode(gvr client.GVR) ResourceViewer {
 	n := Node{
-		ResourceViewer: NewBrowser(gvr),
+		ResourceViewer: NewDebugPodExtender(NewBrowser(gvr)),
 	}
 	n.AddBindKeysFn(n.bindKeys)
 	n.GetTable().SetEnterFn(n.showPods)
+	n.SetContextFn(n.nodeContext)
 
 	return &n
 }
 
+func (n *Node) nodeContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, internal.KeyGPUMetrics, n.App().gpuMetrics)
+}
+
 func (n *Node) bindDangerousKeys(aa ui.KeyActions) {
 	aa.Add(ui.KeyActions{
 		ui.KeyC: ui.NewKeyAction("Cordon", n.toggleCordonCmd(true), true),
 		ui.KeyU: ui.NewKeyAction("Uncordon", n.toggleCordonCmd(false), true),
 		ui.KeyR: ui.NewKeyAction("Drain", n.drainCmd, true),
+		ui.KeyT: ui.NewKeyAction("Taints", n.taintsCmd, true),
 	})
 	cl := n.App().Config.K9s.CurrentCluster
 	if n.App().Config.K9s.Clusters[cl].FeatureGates.NodeShell {
@@ -53,6 +62,8 @@ func (n *Node) bindKeys(aa ui.KeyActions) {
 
 	aa.Add(ui.KeyActions{
 		ui.KeyY:      ui.NewKeyAction("YAML", n.yamlCmd, true),
+		ui.KeyI:      ui.NewKeyAction("Capacity", n.capacityCmd, true),
+		ui.KeyShiftK: ui.NewKeyAction("Kubelet Stats", n.statsCmd, true),
 		ui.KeyShiftC: ui.NewKeyAction("Sort CPU", n.GetTable().SortColCmd(cpuCol, false), false),
 		ui.KeyShiftM: ui.NewKeyAction("Sort MEM", n.GetTable().SortColCmd(memCol, false), false),
 		ui.KeyShift0: ui.NewKeyAction("Sort Pods", n.GetTable().SortColCmd("PODS", false), false),
@@ -78,7 +89,25 @@ func (n *Node) drainCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
-func drainNode(v ResourceViewer, path string, opts dao.DrainOptions) {
+func (n *Node) taintsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := n.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	no, err := dao.FetchNode(context.Background(), n.App().factory, path)
+	if err != nil {
+		n.App().Flash().Err(err)
+		return nil
+	}
+	ShowTaints(n, path, no.Spec.Taints, func(v ResourceViewer, path string, taints []v1.Taint) {
+		setTaints(v, path, no.Spec.Taints, taints)
+	})
+
+	return nil
+}
+
+func setTaints(v ResourceViewer, path string, original, edited []v1.Taint) {
 	res, err := dao.AccessorFor(v.App().factory, v.GVR())
 	if err != nil {
 		v.App().Flash().Err(err)
@@ -89,21 +118,58 @@ func drainNode(v ResourceViewer, path string, opts dao.DrainOptions) {
 		v.App().Flash().Err(fmt.Errorf("expecting a maintainer for %q", v.GVR()))
 		return
 	}
+	ctx, cancel := context.WithTimeout(context.Background(), v.App().Conn().Config().CallTimeout())
+	defer cancel()
+	if err := m.SetTaints(ctx, path, original, edited); err != nil {
+		v.App().Flash().Err(err)
+		return
+	}
+	v.App().Flash().Infof("Node %s taints updated", path)
+	v.Refresh()
+}
 
-	v.Stop()
-	defer v.Start()
-	{
-		d := NewDetails(v.App(), "Drain Progress", path, true)
-		if err := v.App().inject(d, false); err != nil {
-			v.App().Flash().Err(err)
-		}
+func drainNode(v ResourceViewer, path string, opts dao.DrainOptions) {
+	res, err := dao.AccessorFor(v.App().factory, v.GVR())
+	if err != nil {
+		v.App().Flash().Err(err)
+		return
+	}
+	m, ok := res.(dao.NodeMaintainer)
+	if !ok {
+		v.App().Flash().Err(fmt.Errorf("expecting a maintainer for %q", v.GVR()))
+		return
+	}
 
-		if err := m.Drain(path, opts, d.GetWriter()); err != nil {
-			v.App().Flash().Err(err)
-			return
-		}
-		v.Refresh()
+	d := NewDetails(v.App(), "Drain Progress", path, true)
+	if err := v.App().inject(d, false); err != nil {
+		v.App().Flash().Err(err)
+		return
 	}
+	w := progressWriter{app: v.App(), w: d.GetWriter()}
+	go func() {
+		err := m.Drain(path, opts, &w)
+		v.App().QueueUpdateDraw(func() {
+			if err != nil {
+				v.App().Flash().Err(err)
+				return
+			}
+			v.App().Flash().Infof("Node %s drained", path)
+			v.Refresh()
+		})
+	}()
+}
+
+// progressWriter refreshes a progress pane as output comes in.
+type progressWriter struct {
+	app *App
+	w   io.Writer
+}
+
+func (d *progressWriter) Write(b []byte) (int, error) {
+	n, err := d.w.Write(b)
+	d.app.QueueUpdateDraw(func() {})
+
+	return n, err
 }
 
 func (n *Node) toggleCordonCmd(cordon bool) func(evt *tcell.EventKey) *tcell.EventKey {
@@ -157,6 +223,32 @@ func (n *Node) sshCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (n *Node) capacityCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := n.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	if err := n.App().inject(NewNodeCapacity(n.App(), path), false); err != nil {
+		n.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (n *Node) statsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := n.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	if err := n.App().inject(NewNodeStats(n.App(), path), false); err != nil {
+		n.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
 func (n *Node) yamlCmd(evt *tcell.EventKey) *tcell.EventKey {
 	path := n.GetTable().GetSelectedItem()
 	if path == "" {
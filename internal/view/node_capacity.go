@@ -0,0 +1,42 @@
+package view
+
+import (
+	"context"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+)
+
+const (
+	capacityTitle  = "Capacity"
+	nodeStatsTitle = "Kubelet"
+)
+
+// NewNodeCapacity returns a live node allocatable vs requested vs usage view.
+func NewNodeCapacity(app *App, path string) *LiveReport {
+	return NewLiveReport(app, capacityTitle, path, func(ctx context.Context) (string, error) {
+		var no dao.Node
+		no.Init(app.factory, client.NewGVR("v1/nodes"))
+		c, err := no.Capacity(ctx, path)
+		if err != nil {
+			return "", err
+		}
+
+		return c.Report(), nil
+	})
+}
+
+// NewNodeStats returns a live node kubelet stats and problems view.
+func NewNodeStats(app *App, path string) *LiveReport {
+	return NewLiveReport(app, nodeStatsTitle, path, func(ctx context.Context) (string, error) {
+		var no dao.Node
+		no.Init(app.factory, client.NewGVR("v1/nodes"))
+		s, err := no.Stats(ctx, path)
+		if err != nil {
+			return "", err
+		}
+
+		return s.Report(time.Now()), nil
+	})
+}
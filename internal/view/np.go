@@ -0,0 +1,89 @@
+package view
+
+import (
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+)
+
+const npSimDialogKey = "npsim"
+
+var npSimProtocols = []string{"TCP", "UDP", "SCTP"}
+
+// NetworkPolicy represents a network policy viewer.
+type NetworkPolicy struct {
+	ResourceViewer
+}
+
+// NewNetworkPolicy returns a new viewer.
+func NewNetworkPolicy(gvr client.GVR) ResourceViewer {
+	n := NetworkPolicy{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	n.AddBindKeysFn(n.bindKeys)
+
+	return &n
+}
+
+func (n *NetworkPolicy) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftS: ui.NewKeyAction("Simulate", n.simulateCmd, true),
+	})
+}
+
+func (n *NetworkPolicy) simulateCmd(evt *tcell.EventKey) *tcell.EventKey {
+	ns := n.App().Config.ActiveNamespace()
+	if path := n.GetTable().GetSelectedItem(); path != "" {
+		ns, _ = client.Namespaced(path)
+	}
+	if client.IsAllNamespaces(ns) {
+		ns = ""
+	}
+	n.showSimDialog(ns)
+
+	return nil
+}
+
+func (n *NetworkPolicy) showSimDialog(ns string) {
+	f := newStyledForm(n.App().Styles.Dialog())
+
+	var prefix string
+	if ns != "" {
+		prefix = ns + "/"
+	}
+	src, dst, port, proto := prefix, prefix, "", npSimProtocols[0]
+	f.AddInputField("Source Pod:", src, 50, nil, func(s string) {
+		src = s
+	})
+	f.AddInputField("Destination Pod:", dst, 50, nil, func(s string) {
+		dst = s
+	})
+	f.AddInputField("Port:", port, 6, tview.InputFieldInteger, func(s string) {
+		port = s
+	})
+	f.AddDropDown("Protocol:", npSimProtocols, 0, func(s string, _ int) {
+		proto = s
+	})
+	f.AddButton("OK", func() {
+		t, err := dao.ParseTraffic(src, dst, port, proto)
+		if err != nil {
+			n.App().Flash().Err(err)
+			return
+		}
+		n.App().Content.RemovePage(npSimDialogKey)
+		showNetworkPolicySim(n.App(), t)
+	})
+	f.AddButton("Cancel", func() {
+		n.App().Content.RemovePage(npSimDialogKey)
+	})
+
+	modal := tview.NewModalForm("<Simulate>", f)
+	modal.SetText("Simulate network policies traffic (pods as namespace/name)")
+	modal.SetDoneFunc(func(int, string) {
+		n.App().Content.RemovePage(npSimDialogKey)
+	})
+	n.App().Content.AddPage(npSimDialogKey, modal, false, false)
+	n.App().Content.ShowPage(npSimDialogKey)
+}
@@ -0,0 +1,78 @@
+package view
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+const npSimGVR = "npsim"
+
+// NetworkPolicySim represents a network policies simulation viewer.
+type NetworkPolicySim struct {
+	ResourceViewer
+}
+
+// NewNetworkPolicySim returns a new network policies simulation view.
+func NewNetworkPolicySim(gvr client.GVR) ResourceViewer {
+	n := NetworkPolicySim{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	n.GetTable().SetBorderFocusColor(tcell.ColorMediumSpringGreen)
+	n.GetTable().SetSelectedStyle(tcell.StyleDefault.Foreground(tcell.ColorWhite).Background(tcell.ColorMediumSpringGreen).Attributes(tcell.AttrNone))
+	n.AddBindKeysFn(n.bindKeys)
+
+	return &n
+}
+
+// Init initializes the view.
+func (n *NetworkPolicySim) Init(ctx context.Context) error {
+	if err := n.ResourceViewer.Init(ctx); err != nil {
+		return err
+	}
+	n.GetTable().GetModel().SetNamespace(client.AllNamespaces)
+
+	return nil
+}
+
+func (n *NetworkPolicySim) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Delete(tcell.KeyCtrlW, tcell.KeyCtrlL, tcell.KeyCtrlZ)
+	aa.Add(ui.KeyActions{
+		tcell.KeyEnter: ui.NewKeyAction("Goto Policy", n.gotoCmd, true),
+	})
+}
+
+func (n *NetworkPolicySim) gotoCmd(evt *tcell.EventKey) *tcell.EventKey {
+	row, _ := n.GetTable().GetSelection()
+	if row == 0 {
+		return evt
+	}
+	ns, pol := ui.TrimCell(n.GetTable().SelectTable, row, 1), ui.TrimCell(n.GetTable().SelectTable, row, 2)
+	if ns == "" || pol == "" || pol == render.NAValue {
+		return nil
+	}
+	n.App().gotoResource("networkpolicies", client.FQN(ns, pol), false)
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func showNetworkPolicySim(app *App, t dao.Traffic) {
+	v := NewNetworkPolicySim(client.NewGVR(npSimGVR))
+	v.GetTable().Extras = fmt.Sprintf("%s -> %s:%d/%s", t.Source, t.Destination, t.Port, t.Protocol)
+	v.SetContextFn(func(ctx context.Context) context.Context {
+		return context.WithValue(ctx, internal.KeyTraffic, t)
+	})
+	if err := app.inject(v, false); err != nil {
+		app.Flash().Err(err)
+	}
+}
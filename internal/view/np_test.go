@@ -0,0 +1,25 @@
+package view_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/view"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNetworkPolicyNew(t *testing.T) {
+	v := view.NewNetworkPolicy(client.NewGVR("networking.k8s.io/v1/networkpolicies"))
+
+	assert.Nil(t, v.Init(makeCtx()))
+	assert.Equal(t, "NetworkPolicies", v.Name())
+	assert.Equal(t, 7, len(v.Hints()))
+}
+
+func TestNetworkPolicySimNew(t *testing.T) {
+	v := view.NewNetworkPolicySim(client.NewGVR("npsim"))
+
+	assert.Nil(t, v.Init(makeCtx()))
+	assert.Equal(t, "NetworkPolicySimulation", v.Name())
+	assert.Equal(t, 4, len(v.Hints()))
+}
@@ -11,6 +11,7 @@ import (
 
 const (
 	favNSIndicator     = "+"
+	pinnedNSIndicator  = "^"
 	defaultNSIndicator = "(*)"
 )
 
@@ -22,7 +23,7 @@ type Namespace struct {
 // NewNamespace returns a new viewer.
 func NewNamespace(gvr client.GVR) ResourceViewer {
 	n := Namespace{
-		ResourceViewer: NewBrowser(gvr),
+		ResourceViewer: NewDebugPodExtender(NewBrowser(gvr)),
 	}
 	n.GetTable().SetDecorateFn(n.decorate)
 	n.GetTable().SetEnterFn(n.switchNs)
@@ -34,6 +35,8 @@ func NewNamespace(gvr client.GVR) ResourceViewer {
 func (n *Namespace) bindKeys(aa ui.KeyActions) {
 	aa.Add(ui.KeyActions{
 		ui.KeyU:      ui.NewKeyAction("Use", n.useNsCmd, true),
+		ui.KeyQ:      ui.NewKeyAction("Quotas", n.quotaCmd, true),
+		ui.KeyP:      ui.NewKeyAction("Pin", n.pinCmd, true),
 		ui.KeyShiftS: ui.NewKeyAction("Sort Status", n.GetTable().SortColCmd(statusCol, true), false),
 	})
 }
@@ -53,6 +56,44 @@ func (n *Namespace) useNsCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (n *Namespace) pinCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := n.GetTable().GetSelectedItem()
+	if path == "" || path == client.NamespaceAll {
+		return evt
+	}
+
+	_, ns := client.Namespaced(path)
+	pinned, err := n.App().Config.TogglePinNamespace(ns)
+	if err != nil {
+		n.App().Flash().Err(err)
+		return nil
+	}
+	if err := n.App().Config.Save(); err != nil {
+		log.Error().Err(err).Msg("Config file save failed!")
+	}
+	if pinned {
+		n.App().Flash().Infof("Namespace %s pinned", ns)
+	} else {
+		n.App().Flash().Infof("Namespace %s unpinned", ns)
+	}
+	n.Refresh()
+
+	return nil
+}
+
+func (n *Namespace) quotaCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := n.GetTable().GetSelectedItem()
+	if path == "" || path == client.NamespaceAll {
+		return evt
+	}
+
+	if err := n.App().inject(NewNamespaceQuota(n.App(), path), false); err != nil {
+		n.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
 func (n *Namespace) useNamespace(fqn string) {
 	_, ns := client.Namespaced(fqn)
 	if err := n.App().switchNS(ns); err != nil {
@@ -82,14 +123,17 @@ func (n *Namespace) decorate(data *render.TableData) {
 				Kind: render.EventUnchanged,
 				Row: render.Row{
 					ID:     client.NamespaceAll,
-					Fields: render.Fields{client.NamespaceAll, "Active", "", "", ""},
+					Fields: render.Fields{client.NamespaceAll, "Active", "", "", "", ""},
 				},
 			},
 		)
 	}
 
 	for _, re := range data.RowEvents {
-		if config.InList(n.App().Config.FavNamespaces(), re.Row.ID) {
+		if n.App().Config.IsPinnedNamespace(re.Row.ID) {
+			re.Row.Fields[0] += pinnedNSIndicator
+			re.Kind = render.EventUnchanged
+		} else if config.InList(n.App().Config.FavNamespaces(), re.Row.ID) {
 			re.Row.Fields[0] += favNSIndicator
 			re.Kind = render.EventUnchanged
 		}
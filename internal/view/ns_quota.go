@@ -0,0 +1,24 @@
+package view
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+)
+
+const quotaTitle = "Quotas"
+
+// NewNamespaceQuota returns a live namespace quotas consumption and limit ranges view.
+func NewNamespaceQuota(app *App, path string) *LiveReport {
+	return NewLiveReport(app, quotaTitle, path, func(ctx context.Context) (string, error) {
+		var ns dao.Namespace
+		ns.Init(app.factory, client.NewGVR("v1/namespaces"))
+		q, err := ns.Quota(ctx, path)
+		if err != nil {
+			return "", err
+		}
+
+		return q.Report(), nil
+	})
+}
@@ -0,0 +1,63 @@
+package view
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+// NamespaceUsage represents the namespaces aggregated resources usage viewer.
+type NamespaceUsage struct {
+	ResourceViewer
+}
+
+// NewNamespaceUsage returns a new namespaces usage view.
+func NewNamespaceUsage(gvr client.GVR) ResourceViewer {
+	n := NamespaceUsage{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	n.GetTable().SetBorderFocusColor(tcell.ColorMediumSpringGreen)
+	n.GetTable().SetSelectedStyle(tcell.StyleDefault.Foreground(tcell.ColorWhite).Background(tcell.ColorMediumSpringGreen).Attributes(tcell.AttrNone))
+	n.GetTable().SetSortCol("CPU/R", false)
+	n.GetTable().SetEnterFn(n.showPods)
+	n.AddBindKeysFn(n.bindKeys)
+
+	return &n
+}
+
+// Init initializes the view.
+func (n *NamespaceUsage) Init(ctx context.Context) error {
+	if err := n.ResourceViewer.Init(ctx); err != nil {
+		return err
+	}
+	n.GetTable().GetModel().SetNamespace(client.AllNamespaces)
+
+	return nil
+}
+
+func (n *NamespaceUsage) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Delete(tcell.KeyCtrlW, tcell.KeyCtrlL, tcell.KeyCtrlZ, tcell.KeyCtrlD)
+	aa.Add(ui.KeyActions{
+		ui.KeyShift0: ui.NewKeyAction("Sort Pods", n.GetTable().SortColCmd("PODS", false), false),
+		ui.KeyShiftC: ui.NewKeyAction("Sort CPU", n.GetTable().SortColCmd(cpuCol, false), false),
+		ui.KeyShiftM: ui.NewKeyAction("Sort MEM", n.GetTable().SortColCmd(memCol, false), false),
+		ui.KeyShiftR: ui.NewKeyAction("Sort CPU/R", n.GetTable().SortColCmd("CPU/R", false), false),
+		ui.KeyShiftE: ui.NewKeyAction("Sort MEM/R", n.GetTable().SortColCmd("MEM/R", false), false),
+		ui.KeyShiftF: ui.NewKeyAction("Sort Failing", n.GetTable().SortColCmd("FAILING", false), false),
+	})
+}
+
+func (n *NamespaceUsage) showPods(app *App, _ ui.Tabular, _, ns string) {
+	if err := app.switchNS(ns); err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	if err := app.Config.SetActiveNamespace(ns); err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	app.gotoResource("pods", "", false)
+}
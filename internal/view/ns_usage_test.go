@@ -0,0 +1,17 @@
+package view_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/view"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNamespaceUsageNew(t *testing.T) {
+	v := view.NewNamespaceUsage(client.NewGVR("nsusage"))
+
+	assert.Nil(t, v.Init(makeCtx()))
+	assert.Equal(t, "NamespaceUsage", v.Name())
+	assert.Equal(t, 9, len(v.Hints()))
+}
@@ -0,0 +1,58 @@
+package view
+
+import (
+	"context"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/derailed/k9s/internal/model"
+)
+
+const (
+	reconnectInitial = time.Second
+	reconnectMax     = 30 * time.Second
+	reconnectJitter  = 0.5
+)
+
+// newReconnectBackOff returns a jittered backoff probing the api server
+// until connectivity returns. Jitter avoids many clients retrying in lockstep.
+func newReconnectBackOff(ctx context.Context) backoff.BackOffContext {
+	bf := backoff.NewExponentialBackOff()
+	bf.InitialInterval, bf.MaxInterval, bf.MaxElapsedTime = reconnectInitial, reconnectMax, 0
+	bf.RandomizationFactor = reconnectJitter
+
+	return backoff.WithContext(bf, ctx)
+}
+
+// markStale records when the cached content went stale. Returns the stale time.
+func (a *App) markStale() time.Time {
+	a.staleAt.CompareAndSwap(0, time.Now().UnixNano())
+
+	return a.staleSince()
+}
+
+// clearStale flags the content as live again.
+func (a *App) clearStale() {
+	a.staleAt.Store(0)
+}
+
+// staleSince returns when the content went stale or a zero time if live.
+func (a *App) staleSince() time.Time {
+	at := a.staleAt.Load()
+	if at == 0 {
+		return time.Time{}
+	}
+
+	return time.Unix(0, at)
+}
+
+// showStale flags a view content as cached since a given time.
+func (a *App) showStale(c model.Component, since time.Time) {
+	v, ok := c.(ResourceViewer)
+	if !ok {
+		return
+	}
+	a.QueueUpdateDraw(func() {
+		v.GetTable().SetStale(since)
+	})
+}
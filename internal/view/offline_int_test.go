@@ -0,0 +1,36 @@
+package view
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReconnectBackOff(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	bf := newReconnectBackOff(ctx)
+
+	for i := 0; i < 20; i++ {
+		d := bf.NextBackOff()
+		assert.True(t, d > 0 && d <= time.Duration(float64(reconnectMax)*(1+reconnectJitter)))
+	}
+	bf.Reset()
+	assert.True(t, bf.NextBackOff() <= time.Duration(float64(reconnectInitial)*(1+reconnectJitter)))
+
+	cancel()
+	assert.Equal(t, backoff.Stop, bf.NextBackOff())
+}
+
+func TestAppStale(t *testing.T) {
+	var a App
+
+	assert.True(t, a.staleSince().IsZero())
+	since := a.markStale()
+	assert.False(t, since.IsZero())
+	assert.Equal(t, since, a.markStale())
+	a.clearStale()
+	assert.True(t, a.staleSince().IsZero())
+}
@@ -0,0 +1,110 @@
+package view
+
+import (
+	"context"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+const (
+	ownersGVR      = "owners"
+	ownerTrailSep  = " › "
+	ownerGVRColumn = 4
+)
+
+// Owner represents a resource ownership trail.
+type Owner struct {
+	ResourceViewer
+}
+
+// NewOwner returns a new ownership view.
+func NewOwner(gvr client.GVR) ResourceViewer {
+	o := Owner{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	o.GetTable().SetBorderFocusColor(tcell.ColorMediumSpringGreen)
+	o.GetTable().SetSelectedStyle(tcell.StyleDefault.Foreground(tcell.ColorWhite).Background(tcell.ColorMediumSpringGreen).Attributes(tcell.AttrNone))
+	o.GetTable().SetSortCol("DEPTH", true)
+	o.AddBindKeysFn(o.bindKeys)
+
+	return &o
+}
+
+// Init initializes the view.
+func (o *Owner) Init(ctx context.Context) error {
+	if err := o.ResourceViewer.Init(ctx); err != nil {
+		return err
+	}
+	o.GetTable().GetModel().SetNamespace(client.AllNamespaces)
+
+	return nil
+}
+
+func (o *Owner) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Delete(tcell.KeyCtrlW, tcell.KeyCtrlL, tcell.KeyCtrlZ)
+	aa.Add(ui.KeyActions{
+		tcell.KeyEnter: ui.NewKeyAction("Goto", o.gotoCmd, true),
+		ui.KeyShiftH:   ui.NewKeyAction("Ownership", o.ownersCmd, true),
+		ui.KeyShiftV:   ui.NewKeyAction("Sort GVR", o.GetTable().SortColCmd("GVR", true), false),
+	})
+}
+
+func (o *Owner) selection() (client.GVR, string, bool) {
+	row, _ := o.GetTable().GetSelection()
+	if row == 0 {
+		return client.GVR{}, "", false
+	}
+
+	return client.NewGVR(ui.TrimCell(o.GetTable().SelectTable, row, ownerGVRColumn)), o.GetTable().GetSelectedItem(), true
+}
+
+func (o *Owner) gotoCmd(evt *tcell.EventKey) *tcell.EventKey {
+	gvr, path, ok := o.selection()
+	if !ok {
+		return evt
+	}
+	o.App().gotoResource(gvr.R(), path, false)
+
+	return nil
+}
+
+func (o *Owner) ownersCmd(evt *tcell.EventKey) *tcell.EventKey {
+	gvr, path, ok := o.selection()
+	if !ok {
+		return evt
+	}
+	showOwners(o.App(), gvr, path)
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func showOwners(app *App, gvr client.GVR, path string) {
+	refs, err := dao.Owners(app.factory, gvr, path)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+
+	v := NewOwner(client.NewGVR(ownersGVR))
+	v.GetTable().Extras = strings.Join(dao.OwnerTrail(refs), ownerTrailSep)
+	v.SetContextFn(ownerCtx(gvr, path))
+	if err := app.inject(v, false); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+func ownerCtx(gvr client.GVR, path string) ContextFunc {
+	return func(ctx context.Context) context.Context {
+		ctx = context.WithValue(ctx, internal.KeyPath, path)
+		return context.WithValue(ctx, internal.KeyGVR, gvr.String())
+	}
+}
@@ -0,0 +1,35 @@
+package view
+
+import (
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+// OwnerExtender provides for navigating a resource ownership trail.
+type OwnerExtender struct {
+	ResourceViewer
+}
+
+// NewOwnerExtender returns a new extender.
+func NewOwnerExtender(r ResourceViewer) ResourceViewer {
+	o := OwnerExtender{ResourceViewer: r}
+	o.AddBindKeysFn(o.bindKeys)
+
+	return &o
+}
+
+func (o *OwnerExtender) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftH: ui.NewKeyAction("Ownership", o.ownersCmd, true),
+	})
+}
+
+func (o *OwnerExtender) ownersCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := o.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	showOwners(o.App(), o.GVR(), path)
+
+	return nil
+}
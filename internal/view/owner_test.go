@@ -0,0 +1,17 @@
+package view_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/view"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOwnerNew(t *testing.T) {
+	s := view.NewOwner(client.NewGVR("owners"))
+
+	assert.Nil(t, s.Init(makeCtx()))
+	assert.Equal(t, "Owners", s.Name())
+	assert.Equal(t, 6, len(s.Hints()))
+}
@@ -0,0 +1,70 @@
+package view
+
+import (
+	"context"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+)
+
+const pdbImpactTitle = "Disruptions"
+
+// PodDisruptionBudget represents a pdb viewer.
+type PodDisruptionBudget struct {
+	ResourceViewer
+}
+
+// NewPodDisruptionBudget returns a new viewer.
+func NewPodDisruptionBudget(gvr client.GVR) ResourceViewer {
+	p := PodDisruptionBudget{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	p.GetTable().SetEnterFn(p.showImpact)
+
+	return &p
+}
+
+func (p *PodDisruptionBudget) showImpact(app *App, _ ui.Tabular, _, path string) {
+	if err := app.inject(NewPDBImpact(app, path), false); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+// NewPDBImpact returns a live disruption budget matched pods and blocked
+// drains view.
+func NewPDBImpact(app *App, path string) *LiveReport {
+	return NewLiveReport(app, pdbImpactTitle, path, func(ctx context.Context) (string, error) {
+		var pdb dao.PodDisruptionBudget
+		pdb.Init(app.factory, client.NewGVR("policy/v1/poddisruptionbudgets"))
+		impact, err := pdb.Impact(ctx, path)
+		if err != nil {
+			return "", err
+		}
+
+		return impact.Report(), nil
+	})
+}
+
+// showPodPDB shows the disruption budget protecting a given pod.
+func showPodPDB(app *App, path string) {
+	ctx, cancel := context.WithTimeout(context.Background(), app.Conn().Config().CallTimeout())
+	defer cancel()
+	nn, err := dao.PodPDBs(ctx, app.factory, path)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	switch len(nn) {
+	case 0:
+		app.Flash().Warnf("Pod %s is not protected by a disruption budget", path)
+		return
+	case 1:
+	default:
+		app.Flash().Infof("Pod %s is protected by %d disruption budgets: %s", path, len(nn), strings.Join(nn, ", "))
+	}
+	if err := app.inject(NewPDBImpact(app, nn[0]), false); err != nil {
+		app.Flash().Err(err)
+	}
+}
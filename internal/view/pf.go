@@ -3,11 +3,15 @@ package view
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"regexp"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/k9s/internal/perf"
@@ -17,7 +21,10 @@ import (
 	"github.com/rs/zerolog/log"
 )
 
-const promptPage = "prompt"
+const (
+	promptPage = "prompt"
+	benchKey   = "bench"
+)
 
 // PortForward presents active portforward viewer.
 type PortForward struct {
@@ -96,31 +103,115 @@ func (p *PortForward) toggleBenchCmd(evt *tcell.EventKey) *tcell.EventKey {
 		col = 4
 	}
 	base := ui.TrimCell(p.GetTable().SelectTable, r, col)
+	p.showBenchDialog(base, cfg)
+
+	return nil
+}
+
+func (p *PortForward) showBenchDialog(base string, cfg config.BenchConfig) {
+	u, err := url.Parse(base)
+	if err != nil {
+		p.App().Flash().Err(err)
+		return
+	}
+	c, n, d, path := strconv.Itoa(cfg.C), strconv.Itoa(cfg.N), "", u.Path
+	if cfg.Duration > 0 {
+		d = cfg.Duration.String()
+	}
+
+	f := newStyledForm(p.App().Styles.Dialog())
+	f.AddInputField("Concurrency:", c, 5, nil, func(changed string) {
+		c = changed
+	})
+	f.AddInputField("Requests:", n, 8, nil, func(changed string) {
+		n = changed
+	})
+	f.AddInputField("Duration:", d, 8, nil, func(changed string) {
+		d = changed
+	})
+	f.AddInputField("Path:", path, 0, nil, func(changed string) {
+		path = changed
+	})
+	f.AddButton("OK", func() {
+		p.App().Content.RemovePage(benchKey)
+		var err error
+		if cfg.C, err = strconv.Atoi(strings.TrimSpace(c)); err != nil || cfg.C <= 0 {
+			p.App().Flash().Errf("Invalid concurrency %q", c)
+			return
+		}
+		if cfg.N, err = strconv.Atoi(strings.TrimSpace(n)); err != nil || cfg.N < cfg.C {
+			p.App().Flash().Errf("Invalid requests count %q. Must be at least the concurrency", n)
+			return
+		}
+		cfg.Duration = 0
+		if d = strings.TrimSpace(d); d != "" {
+			if cfg.Duration, err = time.ParseDuration(d); err != nil {
+				p.App().Flash().Errf("Invalid duration %q", d)
+				return
+			}
+		}
+		u.Path = strings.TrimSpace(path)
+		p.runBench(u.String(), cfg)
+	})
+	f.AddButton("Cancel", func() {
+		p.App().Content.RemovePage(benchKey)
+	})
+
+	modal := tview.NewModalForm("<Benchmark>", f)
+	modal.SetText(fmt.Sprintf("Benchmark %s", base))
+	modal.SetDoneFunc(func(int, string) {
+		p.App().Content.RemovePage(benchKey)
+	})
+	p.App().Content.AddPage(benchKey, modal, false, false)
+	p.App().Content.ShowPage(benchKey)
+}
+
+func (p *PortForward) runBench(base string, cfg config.BenchConfig) {
 	var err error
 	p.bench, err = perf.NewBenchmark(base, p.App().version, cfg)
 	if err != nil {
 		p.App().Flash().Errf("Bench failed %v", err)
 		p.App().ClearStatus(false)
-		return nil
+		return
 	}
 
 	p.App().Status(model.FlashWarn, "Benchmark in progress...")
-	go p.runBenchmark()
+	done := make(chan struct{})
+	go p.runBenchmark(done)
+	go p.benchProgress(p.bench, done)
+}
 
-	return nil
+func (p *PortForward) benchProgress(b *perf.Benchmark, done <-chan struct{}) {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			msg := fmt.Sprintf("Benchmark in progress... %s", b.Elapsed().Round(time.Second))
+			p.App().QueueUpdateDraw(func() {
+				if p.bench == b {
+					p.App().Status(model.FlashWarn, msg)
+				}
+			})
+		}
+	}
 }
 
-func (p *PortForward) runBenchmark() {
+func (p *PortForward) runBenchmark(done chan<- struct{}) {
 	log.Debug().Msg("Bench starting...")
 
 	p.bench.Run(p.App().Config.K9s.CurrentCluster, func() {
 		log.Debug().Msg("Bench Completed!")
+		close(done)
 		p.App().QueueUpdate(func() {
 			if p.bench.Canceled() {
 				p.App().Status(model.FlashInfo, "Benchmark canceled")
 			} else {
 				p.App().Status(model.FlashInfo, "Benchmark Completed!")
 				p.bench.Cancel()
+				p.showReport(p.bench.Report())
 			}
 			p.bench = nil
 			go func() {
@@ -131,6 +222,16 @@ func (p *PortForward) runBenchmark() {
 	})
 }
 
+func (p *PortForward) showReport(report string) {
+	if report == "" {
+		return
+	}
+	details := NewDetails(p.App(), "Results", p.GetTable().GetSelectedItem(), false).Update(report)
+	if err := p.App().inject(details, false); err != nil {
+		p.App().Flash().Err(err)
+	}
+}
+
 func (p *PortForward) deleteCmd(evt *tcell.EventKey) *tcell.EventKey {
 	if !p.GetTable().CmdBuff().Empty() {
 		p.GetTable().CmdBuff().Reset()
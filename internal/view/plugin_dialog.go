@@ -0,0 +1,79 @@
+package view
+
+import (
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+)
+
+const pluginInputsKey = "pluginInputs"
+
+// PluginInputsFunc represents a plugin inputs callback function.
+type PluginInputsFunc func(vals map[string]string)
+
+// ShowPluginInputs pops a dialog collecting a plugin inputs.
+func ShowPluginInputs(a *App, p config.Plugin, env Env, okFn PluginInputsFunc) {
+	ShowInputs(a, p.Description, "Plugin Arguments", p.Inputs, env, okFn)
+}
+
+// ShowInputs pops a dialog collecting inputs values.
+func ShowInputs(a *App, title, msg string, ii []config.PluginInput, env Env, okFn PluginInputsFunc) {
+	styles := a.Styles
+
+	f := newStyledForm(styles.Dialog())
+
+	vals := make(map[string]string, len(ii))
+	for _, in := range ii {
+		name := in.Name
+		def, err := env.Substitute(in.Default)
+		if err != nil {
+			def = in.Default
+		}
+		vals[name] = def
+		f.AddInputField(in.Label()+":", def, 0, nil, func(v string) {
+			vals[name] = v
+		})
+	}
+
+	pages := a.Content.Pages
+	f.AddButton("Cancel", func() {
+		DismissPluginInputs(a, pages)
+	})
+	f.AddButton("OK", func() {
+		if err := validateInputs(ii, vals); err != nil {
+			a.Flash().Err(err)
+			return
+		}
+		DismissPluginInputs(a, pages)
+		okFn(vals)
+	})
+
+	modal := tview.NewModalForm("<"+title+">", f)
+	modal.SetText(msg)
+	modal.SetDoneFunc(func(_ int, b string) {
+		DismissPluginInputs(a, pages)
+	})
+
+	pages.AddPage(pluginInputsKey, modal, false, true)
+	pages.ShowPage(pluginInputsKey)
+	a.SetFocus(pages.GetPrimitive(pluginInputsKey))
+}
+
+// DismissPluginInputs dismiss the plugin inputs dialog.
+func DismissPluginInputs(a *App, p *ui.Pages) {
+	p.RemovePage(pluginInputsKey)
+	a.SetFocus(p.CurrentPage().Item)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func validateInputs(ii []config.PluginInput, vals map[string]string) error {
+	for _, in := range ii {
+		if err := in.Validate(vals[in.Name]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
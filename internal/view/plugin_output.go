@@ -0,0 +1,157 @@
+package view
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os/exec"
+	"strings"
+	"sync"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/rs/zerolog/log"
+)
+
+// selectionsArg expands into one argument per selected resource.
+const selectionsArg = "$SELECTIONS"
+
+// pluginOutput streams a plugin output into a details pane.
+type pluginOutput struct {
+	*Details
+
+	cancelFn context.CancelFunc
+}
+
+// Stop terminates the plugin command.
+func (p *pluginOutput) Stop() {
+	if p.cancelFn != nil {
+		p.cancelFn()
+	}
+	p.Details.Stop()
+}
+
+// paneWriter appends command output to a details pane.
+type paneWriter struct {
+	app     *App
+	details *Details
+	buff    bytes.Buffer
+	mx      sync.Mutex
+}
+
+// Write appends output and refreshes the pane.
+func (w *paneWriter) Write(b []byte) (int, error) {
+	w.mx.Lock()
+	w.buff.Write(b)
+	text := w.buff.String()
+	w.mx.Unlock()
+	w.app.QueueUpdateDraw(func() {
+		w.details.Update(text)
+	})
+
+	return len(b), nil
+}
+
+// runPlugin runs a plugin command honoring its output mode.
+func runPlugin(a *App, p config.Plugin, args []string) {
+	mode, err := p.OutputMode()
+	if err != nil {
+		log.Warn().Err(err).Msgf("Plugin %q falling back to screen output", p.Description)
+	}
+	switch mode {
+	case config.PluginOutputPane:
+		if err := streamPlugin(a, p, args); err != nil {
+			a.Flash().Err(err)
+		}
+	case config.PluginOutputYAML:
+		var buff bytes.Buffer
+		if err := capture(context.Background(), p.Command, args, &buff); err != nil {
+			a.Flash().Errf("Plugin command failed: %s", err)
+			return
+		}
+		details := NewDetails(a, "YAML", p.Description, true).Update(buff.String())
+		if err := a.inject(details, false); err != nil {
+			a.Flash().Err(err)
+		}
+	case config.PluginOutputBackground:
+		a.Flash().Infof("Plugin %s running...", p.Description)
+		go func() {
+			var buff bytes.Buffer
+			err := capture(context.Background(), p.Command, args, &buff)
+			a.QueueUpdateDraw(func() {
+				if err != nil {
+					a.Flash().Errf("Plugin %s failed: %s", p.Description, err)
+					return
+				}
+				a.Flash().Infof("Plugin %s completed: %s", p.Description, lastLine(buff.String()))
+			})
+		}()
+	default:
+		opts := shellOpts{
+			clear:      true,
+			binary:     p.Command,
+			background: p.Background,
+			pipes:      p.Pipes,
+			args:       args,
+		}
+		if run(a, opts) {
+			a.Flash().Info("Plugin command launched successfully!")
+			return
+		}
+		a.Flash().Info("Plugin command failed!")
+	}
+}
+
+func streamPlugin(a *App, p config.Plugin, args []string) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	out := pluginOutput{
+		Details:  NewDetails(a, "Plugin", p.Description, true),
+		cancelFn: cancel,
+	}
+	if err := a.inject(&out, false); err != nil {
+		cancel()
+		return err
+	}
+	w := paneWriter{app: a, details: out.Details}
+	go func() {
+		if err := capture(ctx, p.Command, args, &w); err != nil && ctx.Err() == nil {
+			_, _ = fmt.Fprintf(&w, "\n<<Plugin exited: %s>>\n", err)
+		}
+	}()
+
+	return nil
+}
+
+// capture runs a command sending its combined output to a writer.
+func capture(ctx context.Context, bin string, args []string, w io.Writer) error {
+	cmd := exec.CommandContext(ctx, bin, args...)
+	log.Debug().Msgf("Running plugin> %s", cmd)
+	cmd.Stdout, cmd.Stderr = w, w
+
+	return cmd.Run()
+}
+
+func lastLine(s string) string {
+	ll := strings.Split(strings.TrimSpace(s), "\n")
+
+	return ll[len(ll)-1]
+}
+
+// pluginArgs substitutes env variables in plugin args. A $SELECTIONS arg expands
+// into one argument per selected resource.
+func pluginArgs(env Env, aa []string) ([]string, error) {
+	args := make([]string, 0, len(aa))
+	for _, a := range aa {
+		if a == selectionsArg {
+			args = append(args, strings.Fields(env["SELECTIONS"])...)
+			continue
+		}
+		arg, err := env.Substitute(a)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, arg)
+	}
+
+	return args, nil
+}
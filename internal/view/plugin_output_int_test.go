@@ -0,0 +1,57 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPluginArgs(t *testing.T) {
+	env := Env{
+		"NAMESPACE":  "fred",
+		"SELECTIONS": "p1 p2",
+	}
+
+	uu := map[string]struct {
+		args, e []string
+	}{
+		"none": {
+			args: []string{"get", "pods", "-n", "$NAMESPACE"},
+			e:    []string{"get", "pods", "-n", "fred"},
+		},
+		"selections": {
+			args: []string{"delete", "pods", "$SELECTIONS", "-n", "$NAMESPACE"},
+			e:    []string{"delete", "pods", "p1", "p2", "-n", "fred"},
+		},
+		"embedded": {
+			args: []string{"-c", "echo $SELECTIONS"},
+			e:    []string{"-c", "echo p1 p2"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			args, err := pluginArgs(env, u.args)
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, args)
+		})
+	}
+}
+
+func TestLastLine(t *testing.T) {
+	assert.Equal(t, "done", lastLine("blee\nduh\ndone\n"))
+	assert.Equal(t, "", lastLine(""))
+}
+
+func TestValidateInputs(t *testing.T) {
+	ii := []config.PluginInput{
+		{Name: "replicas", Pattern: `^\d+$`, Required: true},
+		{Name: "reason"},
+	}
+
+	assert.Nil(t, validateInputs(ii, map[string]string{"replicas": "2"}))
+	assert.NotNil(t, validateInputs(ii, map[string]string{"reason": "fred"}))
+	assert.NotNil(t, validateInputs(ii, map[string]string{"replicas": "two"}))
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
@@ -11,10 +13,13 @@ import (
 	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
 	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
 	"github.com/fatih/color"
 	"github.com/rs/zerolog/log"
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -25,6 +30,12 @@ const (
 	powerShell     = "powershell"
 	osBetaSelector = "beta.kubernetes.io/os"
 	osSelector     = "kubernetes.io/os"
+	debugKey       = "debug"
+	debugRetry     = 30
+	debugDelay     = time.Second
+
+	// restartLogsTail the number of previous log lines shown per restarting container.
+	restartLogsTail = 20
 )
 
 // Pod represents a pod viewer.
@@ -36,8 +47,16 @@ type Pod struct {
 func NewPod(gvr client.GVR) ResourceViewer {
 	var p Pod
 	p.ResourceViewer = NewPortForwardExtender(
-		NewImageExtender(
-			NewLogsExtender(NewBrowser(gvr), p.logOptions),
+		NewSniffExtender(
+			NewFileTransferExtender(
+				NewScanExtender(
+					NewDebugPodExtender(
+						NewImageExtender(
+							NewLogsExtender(NewBrowser(gvr), p.logOptions),
+						),
+					),
+				),
+			),
 		),
 	)
 	p.AddBindKeysFn(p.bindKeys)
@@ -60,11 +79,21 @@ func (p *Pod) portForwardIndicator(data *render.TableData) {
 }
 
 func (p *Pod) bindDangerousKeys(aa ui.KeyActions) {
+	if !p.App().Config.K9s.GuardsConfig().DisableForceDelete {
+		aa.Add(ui.KeyActions{
+			tcell.KeyCtrlK: ui.NewKeyAction("Kill", p.killCmd, true),
+		})
+	}
 	aa.Add(ui.KeyActions{
-		tcell.KeyCtrlK: ui.NewKeyAction("Kill", p.killCmd, true),
-		ui.KeyS:        ui.NewKeyAction("Shell", p.shellCmd, true),
-		ui.KeyA:        ui.NewKeyAction("Attach", p.attachCmd, true),
+		ui.KeyS:      ui.NewKeyAction("Shell", p.shellCmd, true),
+		ui.KeyA:      ui.NewKeyAction("Attach", p.attachCmd, true),
+		ui.KeyShiftD: ui.NewKeyAction("Debug", p.debugCmd, true),
 	})
+	if _, ok := aa[tcell.KeyCtrlD]; ok {
+		aa.Add(ui.KeyActions{
+			tcell.KeyCtrlD: ui.NewKeyAction("Delete", p.deleteCmd, true),
+		})
+	}
 }
 
 func (p *Pod) bindKeys(aa ui.KeyActions) {
@@ -75,6 +104,11 @@ func (p *Pod) bindKeys(aa ui.KeyActions) {
 	aa.Add(ui.KeyActions{
 		ui.KeyN:      ui.NewKeyAction("Show Node", p.showNode, true),
 		ui.KeyF:      ui.NewKeyAction("Show PortForward", p.showPFCmd, true),
+		ui.KeyShiftE: ui.NewKeyAction("Explain Scheduling", p.explainSchedCmd, true),
+		ui.KeyShiftU: ui.NewKeyAction("Disruption Budget", p.showPDBCmd, true),
+		ui.KeyShiftK: ui.NewKeyAction("Restart Forensics", p.forensicsCmd, true),
+		ui.KeyShiftW: ui.NewKeyAction("Why", p.diagnoseCmd, true),
+		ui.KeyShiftG: ui.NewKeyAction("Init Progress", p.initProgressCmd, true),
 		ui.KeyShiftR: ui.NewKeyAction("Sort Ready", p.GetTable().SortColCmd(readyCol, true), false),
 		ui.KeyShiftT: ui.NewKeyAction("Sort Restart", p.GetTable().SortColCmd("RESTARTS", false), false),
 		ui.KeyShiftS: ui.NewKeyAction("Sort Status", p.GetTable().SortColCmd(statusCol, true), false),
@@ -129,6 +163,105 @@ func (p *Pod) coContext(ctx context.Context) context.Context {
 
 // Handlers...
 
+func (p *Pod) explainSchedCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := p.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	showPodSchedule(p.App(), path)
+
+	return nil
+}
+
+func (p *Pod) showPDBCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := p.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	showPodPDB(p.App(), path)
+
+	return nil
+}
+
+func (p *Pod) forensicsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := p.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	var res dao.Pod
+	res.Init(p.App().factory, p.GVR())
+	p.App().Flash().Infof("Collecting restart forensics %s...", path)
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), p.App().Conn().Config().CallTimeout())
+		defer cancel()
+		cc, err := res.RestartForensics(ctx, path, restartLogsTail)
+		p.App().QueueUpdateDraw(func() {
+			if err != nil {
+				p.App().Flash().Err(err)
+				return
+			}
+			p.App().Flash().Infof("Found %d restarting container(s) in %s", len(cc), path)
+			details := NewDetails(p.App(), "Restart Forensics", path, true).Update(render.RestartForensicsReport(cc, time.Now()))
+			if err := p.App().inject(details, false); err != nil {
+				p.App().Flash().Err(err)
+			}
+		})
+	}()
+
+	return nil
+}
+
+func (p *Pod) diagnoseCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := p.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	var res dao.Pod
+	res.Init(p.App().factory, p.GVR())
+	ctx, cancel := context.WithTimeout(context.Background(), p.App().Conn().Config().CallTimeout())
+	defer cancel()
+	d, err := res.Diagnose(ctx, path)
+	if err != nil {
+		p.App().Flash().Err(err)
+		return nil
+	}
+	details := NewDetails(p.App(), "Why", path, true).Update(d.Report())
+	if err := p.App().inject(details, false); err != nil {
+		p.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (p *Pod) initProgressCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := p.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	if err := p.App().inject(NewInitProgress(p.App(), path), false); err != nil {
+		p.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+// NewInitProgress returns a live pod init containers progress view.
+func NewInitProgress(app *App, path string) *LiveReport {
+	return NewLiveReport(app, "Init Progress", path, func(context.Context) (string, error) {
+		var po dao.Pod
+		po.Init(app.factory, client.NewGVR("v1/pods"))
+		pod, err := po.GetInstance(path)
+		if err != nil {
+			return "", err
+		}
+
+		return render.InitProgressReport(pod, time.Now()), nil
+	})
+}
+
 func (p *Pod) showNode(evt *tcell.EventKey) *tcell.EventKey {
 	path := p.GetTable().GetSelectedItem()
 	if path == "" {
@@ -193,6 +326,23 @@ func (p *Pod) killCmd(evt *tcell.EventKey) *tcell.EventKey {
 		p.App().Flash().Err(fmt.Errorf("expecting a nuker for %q", p.GVR()))
 		return nil
 	}
+	policy := deletePolicy(p.App(), p.GVR(), selections)
+	if policy.ConfirmName == "" {
+		p.kill(nuker, selections)
+		return nil
+	}
+	msg := fmt.Sprintf("Kill %s %s?", p.GVR().R(), selections[0])
+	if len(selections) > 1 {
+		msg = fmt.Sprintf("Kill %d marked %s?", len(selections), p.GVR())
+	}
+	dialog.ShowConfirmDelete(p.App().Styles.Dialog(), p.App().Content.Pages, msg, policy, func() {
+		p.kill(nuker, selections)
+	}, func() {})
+
+	return nil
+}
+
+func (p *Pod) kill(nuker dao.Nuker, selections []string) {
 	if len(selections) > 1 {
 		p.App().Flash().Infof("Delete %d marked %s", len(selections), p.GVR())
 	} else {
@@ -208,10 +358,61 @@ func (p *Pod) killCmd(evt *tcell.EventKey) *tcell.EventKey {
 		p.GetTable().DeleteMark(path)
 	}
 	p.Refresh()
+}
+
+func (p *Pod) deleteCmd(evt *tcell.EventKey) *tcell.EventKey {
+	selections := p.GetTable().GetSelectedItems()
+	if len(selections) == 0 {
+		return evt
+	}
+
+	msg := fmt.Sprintf("Delete %s %s?", p.GVR().R(), selections[0])
+	if len(selections) > 1 {
+		msg = fmt.Sprintf("Delete %d marked %s?", len(selections), p.GVR())
+	}
+	dialog.ShowPodDelete(p.App().Styles.Dialog(), p.App().Content.Pages, msg, deletePolicy(p.App(), p.GVR(), selections), func(mode string, grace int64) {
+		p.deletePods(selections, mode, dao.Grace(grace))
+	}, func() {})
 
 	return nil
 }
 
+func (p *Pod) deletePods(selections []string, mode string, grace dao.Grace) {
+	res, err := dao.AccessorFor(p.App().factory, p.GVR())
+	if err != nil {
+		p.App().Flash().Err(err)
+		return
+	}
+	nuker, ok := res.(dao.Nuker)
+	if !ok {
+		p.App().Flash().Err(fmt.Errorf("expecting a nuker for %q", p.GVR()))
+		return
+	}
+	evictor, ok := res.(dao.Evictable)
+	if !ok {
+		p.App().Flash().Err(fmt.Errorf("expecting an evictable for %q", p.GVR()))
+		return
+	}
+	p.GetTable().ShowDeleted()
+	orphan := metav1.DeletePropagationOrphan
+	runBulk(p, mode+" Delete", selections, func(ctx context.Context, path string) error {
+		var err error
+		switch mode {
+		case dialog.EvictDelete:
+			err = evictor.Evict(ctx, path, grace)
+		case dialog.ForceDelete:
+			err = nuker.Delete(ctx, path, &orphan, dao.ForceGrace)
+		default:
+			err = nuker.Delete(ctx, path, nil, grace)
+		}
+		if err != nil {
+			return err
+		}
+		p.App().factory.DeleteForwarder(path)
+		return nil
+	})
+}
+
 func (p *Pod) shellCmd(evt *tcell.EventKey) *tcell.EventKey {
 	path := p.GetTable().GetSelectedItem()
 	if path == "" {
@@ -248,9 +449,109 @@ func (p *Pod) attachCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (p *Pod) debugCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := p.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	if !podIsRunning(p.App().factory, path) {
+		p.App().Flash().Errf("%s is not in a running state", path)
+		return nil
+	}
+	pod, err := fetchPod(p.App().factory, path)
+	if err != nil {
+		p.App().Flash().Err(err)
+		return nil
+	}
+	cc := fetchContainers(pod.Spec, false)
+	if len(cc) == 1 {
+		p.showDebugDialog(path, cc[0])
+		return nil
+	}
+	picker := NewPicker()
+	picker.populate(cc)
+	picker.SetSelectedFunc(func(_ int, co, _ string, _ rune) {
+		p.App().Content.Pop()
+		p.showDebugDialog(path, co)
+	})
+	if err := p.App().inject(picker, false); err != nil {
+		p.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (p *Pod) showDebugDialog(path, target string) {
+	image := p.App().Config.K9s.ActiveCluster().ShellPod.Image
+	f := newStyledForm(p.App().Styles.Dialog())
+	f.AddInputField("Image:", image, 0, nil, func(changed string) {
+		image = strings.TrimSpace(changed)
+	})
+	f.AddInputField("Target:", target, 0, nil, func(changed string) {
+		target = strings.TrimSpace(changed)
+	})
+	f.AddButton("OK", func() {
+		p.App().Content.RemovePage(debugKey)
+		launchDebugContainer(p.App(), path, dao.DebugOptions{Image: image, Target: target}, func(co string) {
+			resumeAttachIn(p.App(), p, path, co)
+		})
+	})
+	f.AddButton("Cancel", func() {
+		p.App().Content.RemovePage(debugKey)
+	})
+
+	confirm := tview.NewModalForm("<Debug>", f)
+	confirm.SetText(fmt.Sprintf("Debug pod %s", path))
+	confirm.SetDoneFunc(func(int, string) {
+		p.App().Content.RemovePage(debugKey)
+	})
+	p.App().Content.AddPage(debugKey, confirm, false, false)
+	p.App().Content.ShowPage(debugKey)
+}
+
 // ----------------------------------------------------------------------------
 // Helpers...
 
+// launchDebugContainer adds an ephemeral container to a pod and waits for it
+// to run in the background. The done callback runs on the ui thread.
+func launchDebugContainer(a *App, path string, opts dao.DebugOptions, done func(co string)) {
+	var po dao.Pod
+	po.Init(a.factory, client.NewGVR("v1/pods"))
+	go func() {
+		co, err := waitDebugContainer(a, &po, path, opts)
+		a.QueueUpdateDraw(func() {
+			if err != nil {
+				a.Flash().Err(err)
+				return
+			}
+			done(co)
+		})
+	}()
+}
+
+func waitDebugContainer(a *App, po *dao.Pod, path string, opts dao.DebugOptions) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), a.Conn().Config().CallTimeout())
+	defer cancel()
+	co, err := po.Debug(ctx, path, opts)
+	if err != nil {
+		return "", err
+	}
+	a.Flash().Infof("Launching debug container %s on %s...", co, path)
+	for i := 0; i < debugRetry; i++ {
+		ok, err := po.IsEphemeralRunning(path, co)
+		if err != nil {
+			return "", err
+		}
+		if ok {
+			return co, nil
+		}
+		time.Sleep(debugDelay)
+	}
+
+	return "", fmt.Errorf("debug container %s failed to start on %s", co, path)
+}
+
 func containerShellin(a *App, comp model.Component, path, co string) error {
 	if co != "" {
 		resumeShellIn(a, comp, path, co)
@@ -330,7 +631,16 @@ func resumeAttachIn(a *App, c model.Component, path, co string) {
 }
 
 func attachIn(a *App, path, co string) {
-	args := buildShellArgs("attach", path, co, a.Conn().Config().Flags().KubeConfig)
+	tty := true
+	if pod, err := fetchPod(a.factory, path); err == nil {
+		stdin, t, ok := containerStdio(pod.Spec, co)
+		if ok && !stdin {
+			a.Flash().Errf("Container %s does not accept stdin. Use logs to view its output", co)
+			return
+		}
+		tty = t || !ok
+	}
+	args := buildAttachArgs(path, co, a.Conn().Config().Flags().KubeConfig, tty)
 	c := color.New(color.BgGreen).Add(color.FgBlack).Add(color.Bold)
 	if !runK(a, shellOpts{clear: true, banner: c.Sprintf(bannerFmt, path, co), args: args}) {
 		a.Flash().Err(errors.New("Attach exec failed"))
@@ -345,6 +655,33 @@ func computeShellArgs(path, co string, kcfg *string, os string) []string {
 	return append(args, "--", "sh", "-c", shellCheck)
 }
 
+// buildAttachArgs connects to the container main process. No TTY is requested
+// unless the container allocates one.
+func buildAttachArgs(path, co string, kcfg *string, tty bool) []string {
+	args := buildShellArgs("attach", path, co, kcfg)
+	if !tty {
+		args[1] = "-i"
+	}
+
+	return args
+}
+
+// containerStdio returns whether a container keeps stdin open and allocates a TTY.
+func containerStdio(spec v1.PodSpec, co string) (stdin, tty, ok bool) {
+	for _, c := range spec.Containers {
+		if c.Name == co {
+			return c.Stdin, c.TTY, true
+		}
+	}
+	for _, c := range spec.EphemeralContainers {
+		if c.Name == co {
+			return c.Stdin, c.TTY, true
+		}
+	}
+
+	return false, false, false
+}
+
 func buildShellArgs(cmd, path, co string, kcfg *string) []string {
 	args := make([]string, 0, 15)
 	args = append(args, cmd, "-it")
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
 )
 
 func TestComputeShellArgs(t *testing.T) {
@@ -103,3 +104,59 @@ func TestComputeShellArgs(t *testing.T) {
 // 		})
 // 	}
 // }
+
+func TestBuildAttachArgs(t *testing.T) {
+	config := "coolConfig"
+	uu := map[string]struct {
+		fqn, co string
+		cfg     *string
+		tty     bool
+		e       string
+	}{
+		"tty": {
+			"fred/blee",
+			"c1",
+			&config,
+			true,
+			"attach -it -n fred blee --kubeconfig coolConfig -c c1",
+		},
+		"no-tty": {
+			"fred/blee",
+			"c1",
+			nil,
+			false,
+			"attach -i -n fred blee -c c1",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			args := buildAttachArgs(u.fqn, u.co, u.cfg, u.tty)
+			assert.Equal(t, u.e, strings.Join(args, " "))
+		})
+	}
+}
+
+func TestContainerStdio(t *testing.T) {
+	spec := v1.PodSpec{
+		Containers: []v1.Container{
+			{Name: "c1", Stdin: true, TTY: true},
+			{Name: "c2"},
+		},
+		EphemeralContainers: []v1.EphemeralContainer{
+			{EphemeralContainerCommon: v1.EphemeralContainerCommon{Name: "debugger-0", Stdin: true}},
+		},
+	}
+
+	stdin, tty, ok := containerStdio(spec, "c1")
+	assert.True(t, stdin && tty && ok)
+	stdin, tty, ok = containerStdio(spec, "c2")
+	assert.True(t, ok)
+	assert.False(t, stdin || tty)
+	stdin, tty, ok = containerStdio(spec, "debugger-0")
+	assert.True(t, stdin && ok)
+	assert.False(t, tty)
+	_, _, ok = containerStdio(spec, "zorg")
+	assert.False(t, ok)
+}
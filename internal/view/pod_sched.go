@@ -0,0 +1,73 @@
+package view
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+const podSchedGVR = "podsched"
+
+// PodSchedule represents a pod scheduling explainer view.
+type PodSchedule struct {
+	ResourceViewer
+}
+
+// NewPodSchedule returns a new pod scheduling explainer view.
+func NewPodSchedule(gvr client.GVR) ResourceViewer {
+	p := PodSchedule{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	p.GetTable().SetBorderFocusColor(tcell.ColorMediumSpringGreen)
+	p.GetTable().SetSelectedStyle(tcell.StyleDefault.Foreground(tcell.ColorWhite).Background(tcell.ColorMediumSpringGreen).Attributes(tcell.AttrNone))
+	p.GetTable().SetSortCol("FITS", false)
+	p.AddBindKeysFn(p.bindKeys)
+
+	return &p
+}
+
+// Init initializes the view.
+func (p *PodSchedule) Init(ctx context.Context) error {
+	if err := p.ResourceViewer.Init(ctx); err != nil {
+		return err
+	}
+	p.GetTable().GetModel().SetNamespace(client.AllNamespaces)
+
+	return nil
+}
+
+func (p *PodSchedule) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Delete(tcell.KeyCtrlW, tcell.KeyCtrlL, tcell.KeyCtrlZ, tcell.KeyCtrlD)
+	aa.Add(ui.KeyActions{
+		tcell.KeyEnter: ui.NewKeyAction("Goto Node", p.gotoCmd, true),
+		ui.KeyShiftF:   ui.NewKeyAction("Sort Fits", p.GetTable().SortColCmd("FITS", false), false),
+	})
+}
+
+func (p *PodSchedule) gotoCmd(evt *tcell.EventKey) *tcell.EventKey {
+	node := p.GetTable().GetSelectedItem()
+	if node == "" {
+		return evt
+	}
+	p.App().gotoResource("nodes", node, false)
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func showPodSchedule(app *App, path string) {
+	v := NewPodSchedule(client.NewGVR(podSchedGVR))
+	v.GetTable().Extras = path
+	v.SetContextFn(func(ctx context.Context) context.Context {
+		return context.WithValue(ctx, internal.KeyPath, path)
+	})
+	if err := app.inject(v, false); err != nil {
+		app.Flash().Err(err)
+	}
+}
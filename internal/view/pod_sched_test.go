@@ -0,0 +1,17 @@
+package view_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/view"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPodScheduleNew(t *testing.T) {
+	v := view.NewPodSchedule(client.NewGVR("podsched"))
+
+	assert.Nil(t, v.Init(makeCtx()))
+	assert.Equal(t, "PodSchedule", v.Name())
+	assert.Equal(t, 5, len(v.Hints()))
+}
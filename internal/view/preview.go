@@ -0,0 +1,225 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/tview"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	previewDescribe = "describe"
+	previewYAML     = "yaml"
+	previewEvents   = "events"
+	previewRight    = "right"
+	previewBottom   = "bottom"
+	previewDelay    = 250 * time.Millisecond
+)
+
+// Preview represents a detail pane tracking the selected row of the active view.
+type Preview struct {
+	*tview.TextView
+
+	app      *App
+	mode     string
+	dir      string
+	table    *Table
+	gvr      client.GVR
+	cancelFn context.CancelFunc
+	mx       sync.Mutex
+}
+
+// NewPreview returns a new detail pane.
+func NewPreview(app *App, mode string) *Preview {
+	p := Preview{
+		TextView: tview.NewTextView(),
+		app:      app,
+		mode:     mode,
+	}
+	p.SetDynamicColors(true)
+	p.SetWrap(false)
+	p.SetBorder(true)
+	p.SetBorderPadding(0, 0, 1, 1)
+	p.StylesChanged(app.Styles)
+
+	return &p
+}
+
+// StylesChanged notifies the skin changed.
+func (p *Preview) StylesChanged(s *config.Styles) {
+	p.SetBackgroundColor(s.BgColor())
+	p.SetTextColor(s.FgColor())
+	p.SetBorderColor(s.Frame().Border.FgColor.Color())
+}
+
+// StackPushed notifies a new component was pushed.
+func (p *Preview) StackPushed(c model.Component) {
+	p.follow(c)
+}
+
+// StackPopped notifies a component was popped.
+func (p *Preview) StackPopped(_, top model.Component) {
+	p.follow(top)
+}
+
+// StackTop notifies the top component.
+func (p *Preview) StackTop(top model.Component) {
+	p.follow(top)
+}
+
+// SetMode changes the content kind of the pane.
+func (p *Preview) SetMode(mode string) {
+	p.mode = mode
+	if p.table != nil {
+		p.load(p.table.GetSelectedItem())
+	}
+}
+
+// Stop releases the tracked view.
+func (p *Preview) Stop() {
+	p.cancel()
+	if p.table != nil {
+		p.table.SetRowChangedFn(nil)
+		p.table = nil
+	}
+}
+
+func (p *Preview) follow(c model.Component) {
+	p.Stop()
+	r, ok := c.(ResourceViewer)
+	if !ok {
+		p.SetTitle("")
+		p.SetText("")
+		return
+	}
+	p.table, p.gvr = r.GetTable(), r.GVR()
+	p.table.SetRowChangedFn(p.load)
+	p.load(p.table.GetSelectedItem())
+}
+
+func (p *Preview) cancel() {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+	if p.cancelFn != nil {
+		p.cancelFn()
+		p.cancelFn = nil
+	}
+}
+
+// load fetches the selected item details once the cursor settles.
+func (p *Preview) load(path string) {
+	p.cancel()
+	p.SetTitle(fmt.Sprintf(" %s([::b]%s[::-]) ", p.mode, path))
+	if path == "" {
+		p.SetText("")
+		return
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	p.mx.Lock()
+	p.cancelFn = cancel
+	p.mx.Unlock()
+
+	gvr, mode := p.gvr, p.mode
+	go func() {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(previewDelay):
+		}
+		text, err := p.fetch(mode, gvr, path)
+		if err != nil {
+			text = "[red::]" + tview.Escape(err.Error())
+		}
+		p.app.QueueUpdateDraw(func() {
+			if ctx.Err() != nil {
+				return
+			}
+			p.SetText(text)
+			p.ScrollToBeginning()
+		})
+	}()
+}
+
+func (p *Preview) fetch(mode string, gvr client.GVR, path string) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), p.app.Conn().Config().CallTimeout())
+	defer cancel()
+	ctx = context.WithValue(ctx, internal.KeyFactory, p.app.factory)
+
+	switch mode {
+	case previewYAML:
+		raw, err := model.NewYAML(gvr, path).ToYAML(ctx, gvr, path, false)
+		if err != nil {
+			return "", err
+		}
+		return colorizeYAML(p.app.Styles.Views().Yaml, raw), nil
+	case previewEvents:
+		return involvedEvents(ctx, p.app.factory, gvr, path)
+	default:
+		raw, err := model.DescribeFor(ctx, gvr, path)
+		if err != nil {
+			return "", err
+		}
+		return tview.Escape(raw), nil
+	}
+}
+
+// involvedEvents lists the events pertaining to a given resource.
+func involvedEvents(ctx context.Context, f dao.Factory, gvr client.GVR, path string) (string, error) {
+	var e dao.Event
+	e.Init(f, client.NewGVR(eventsGVR))
+	ctx = context.WithValue(ctx, internal.KeyInvolved, gvr.String())
+	ctx = context.WithValue(ctx, internal.KeyPath, path)
+	ns, _ := client.Namespaced(path)
+	oo, err := e.List(ctx, ns)
+	if err != nil {
+		return "", err
+	}
+	if len(oo) == 0 {
+		return "No events found.", nil
+	}
+	t, ok := oo[0].(*metav1.Table)
+	if !ok {
+		return "", fmt.Errorf("expecting a meta table but got %T", oo[0])
+	}
+
+	return tview.Escape(eventsText(t)), nil
+}
+
+func eventsText(t *metav1.Table) string {
+	if len(t.Rows) == 0 {
+		return "No events found."
+	}
+	var b strings.Builder
+	w := tabwriter.NewWriter(&b, 0, 0, 2, ' ', 0)
+	hh := make([]string, 0, len(t.ColumnDefinitions))
+	for _, c := range t.ColumnDefinitions {
+		if c.Priority != 0 {
+			continue
+		}
+		hh = append(hh, strings.ToUpper(c.Name))
+	}
+	fmt.Fprintln(w, strings.Join(hh, "\t"))
+	for _, r := range t.Rows {
+		ff := make([]string, 0, len(r.Cells))
+		for i, c := range r.Cells {
+			if i < len(t.ColumnDefinitions) && t.ColumnDefinitions[i].Priority != 0 {
+				continue
+			}
+			ff = append(ff, fmt.Sprintf("%v", c))
+		}
+		fmt.Fprintln(w, strings.Join(ff, "\t"))
+	}
+	_ = w.Flush()
+
+	return b.String()
+}
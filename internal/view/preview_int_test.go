@@ -0,0 +1,42 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestEventsText(t *testing.T) {
+	uu := map[string]struct {
+		t *metav1.Table
+		e string
+	}{
+		"empty": {
+			t: &metav1.Table{},
+			e: "No events found.",
+		},
+		"rows": {
+			t: &metav1.Table{
+				ColumnDefinitions: []metav1.TableColumnDefinition{
+					{Name: "Type"},
+					{Name: "Reason"},
+					{Name: "Source", Priority: 1},
+					{Name: "Message"},
+				},
+				Rows: []metav1.TableRow{
+					{Cells: []interface{}{"Normal", "Pulled", "kubelet", "Image pulled"}},
+					{Cells: []interface{}{"Warning", "BackOff", "kubelet", "Back-off restarting"}},
+				},
+			},
+			e: "TYPE     REASON   MESSAGE\nNormal   Pulled   Image pulled\nWarning  BackOff  Back-off restarting\n",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, eventsText(u.t))
+		})
+	}
+}
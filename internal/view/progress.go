@@ -0,0 +1,89 @@
+package view
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/model"
+)
+
+// slowCallDelay delays the progress indicator so fast calls do not flicker.
+const slowCallDelay = 500 * time.Millisecond
+
+// slowCall represents a view call in progress.
+type slowCall struct {
+	msg    string
+	cancel context.CancelFunc
+	done   bool
+}
+
+// calls tracks the slow call currently reported to the user.
+type calls struct {
+	current *slowCall
+	mx      sync.Mutex
+}
+
+// trackCall shows a cancellable progress indicator once a call is slow. The
+// returned func must be called when the call completes.
+func (a *App) trackCall(msg string, cancel context.CancelFunc) func() {
+	c := slowCall{msg: msg, cancel: cancel}
+	t := time.AfterFunc(slowCallDelay, func() {
+		a.calls.mx.Lock()
+		if c.done {
+			a.calls.mx.Unlock()
+			return
+		}
+		a.calls.current = &c
+		a.calls.mx.Unlock()
+
+		a.QueueUpdateDraw(func() {
+			if !a.isPending(&c) {
+				return
+			}
+			msg := c.msg + "... <esc> to cancel"
+			if a.showHeader {
+				a.setLogo(model.FlashInfo, msg)
+			} else {
+				a.setIndicator(model.FlashInfo, msg)
+			}
+		})
+	})
+
+	return func() {
+		t.Stop()
+		a.calls.mx.Lock()
+		c.done = true
+		shown := a.calls.current == &c
+		if shown {
+			a.calls.current = nil
+		}
+		a.calls.mx.Unlock()
+		if shown {
+			a.ClearStatus(false)
+		}
+	}
+}
+
+// cancelCall cancels the slow call in progress. Returns false if none.
+func (a *App) cancelCall() bool {
+	a.calls.mx.Lock()
+	c := a.calls.current
+	a.calls.current = nil
+	a.calls.mx.Unlock()
+	if c == nil {
+		return false
+	}
+	c.cancel()
+	a.ClearStatus(false)
+	a.Flash().Warnf("%s canceled", c.msg)
+
+	return true
+}
+
+func (a *App) isPending(c *slowCall) bool {
+	a.calls.mx.Lock()
+	defer a.calls.mx.Unlock()
+
+	return a.calls.current == c
+}
@@ -90,15 +90,16 @@ func (p *Pulse) Init(ctx context.Context) error {
 		p.makeGA(image.Point{X: 0, Y: 2}, image.Point{X: 2, Y: 2}, "apps/v1/replicasets"),
 		p.makeGA(image.Point{X: 0, Y: 4}, image.Point{X: 2, Y: 2}, "apps/v1/statefulsets"),
 		p.makeGA(image.Point{X: 0, Y: 6}, image.Point{X: 2, Y: 2}, "apps/v1/daemonsets"),
+		p.makeGA(image.Point{X: 0, Y: 8}, image.Point{X: 2, Y: 2}, "v1/nodes"),
 		p.makeSP(image.Point{X: 2, Y: 0}, image.Point{X: 3, Y: 2}, "v1/pods"),
-		p.makeSP(image.Point{X: 2, Y: 2}, image.Point{X: 3, Y: 2}, "v1/events"),
-		p.makeSP(image.Point{X: 2, Y: 4}, image.Point{X: 3, Y: 2}, "batch/v1/jobs"),
-		p.makeSP(image.Point{X: 2, Y: 6}, image.Point{X: 3, Y: 2}, "v1/persistentvolumes"),
+		p.makeSP(image.Point{X: 2, Y: 2}, image.Point{X: 3, Y: 4}, "v1/events"),
+		p.makeSP(image.Point{X: 2, Y: 6}, image.Point{X: 3, Y: 2}, "batch/v1/jobs"),
+		p.makeSP(image.Point{X: 2, Y: 8}, image.Point{X: 3, Y: 2}, "v1/persistentvolumes"),
 	}
 	if p.app.Conn().HasMetrics() {
 		p.charts = append(p.charts,
-			p.makeSP(image.Point{X: 5, Y: 0}, image.Point{X: 2, Y: 4}, "cpu"),
-			p.makeSP(image.Point{X: 5, Y: 4}, image.Point{X: 2, Y: 4}, "mem"),
+			p.makeSP(image.Point{X: 5, Y: 0}, image.Point{X: 2, Y: 5}, "cpu"),
+			p.makeSP(image.Point{X: 5, Y: 5}, image.Point{X: 2, Y: 5}, "mem"),
 		)
 	}
 	p.bindKeys()
@@ -203,13 +204,18 @@ func (p *Pulse) PulseFailed(err error) {
 func (p *Pulse) bindKeys() {
 	p.actions.Add(ui.KeyActions{
 		tcell.KeyEnter:   ui.NewKeyAction("Goto", p.enterCmd, true),
+		ui.KeyF:          ui.NewKeyAction("Goto Faults", p.faultsCmd, true),
 		tcell.KeyTab:     ui.NewKeyAction("Next", p.nextFocusCmd(1), true),
 		tcell.KeyBacktab: ui.NewKeyAction("Prev", p.nextFocusCmd(-1), true),
 	})
 
 	for i, v := range p.charts {
+		key, ok := ui.NumKeys[i]
+		if !ok {
+			break
+		}
 		t := cases.Title(language.Und, cases.NoLower).String(client.NewGVR(v.ID()).R())
-		p.actions[ui.NumKeys[i]] = ui.NewKeyAction(t, p.sparkFocusCmd(i), true)
+		p.actions[key] = ui.NewKeyAction(t, p.sparkFocusCmd(i), true)
 	}
 }
 
@@ -319,6 +325,16 @@ func (p *Pulse) enterCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (p *Pulse) faultsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	s, ok := p.App().GetFocus().(Graphable)
+	if !ok {
+		return nil
+	}
+	gotoFaults(p.App(), client.NewGVR(s.ID()))
+
+	return nil
+}
+
 func (p *Pulse) nextFocusCmd(direction int) func(evt *tcell.EventKey) *tcell.EventKey {
 	return func(evt *tcell.EventKey) *tcell.EventKey {
 		v := p.app.GetFocus()
@@ -13,5 +13,5 @@ func TestReferenceNew(t *testing.T) {
 
 	assert.Nil(t, s.Init(makeCtx()))
 	assert.Equal(t, "References", s.Name())
-	assert.Equal(t, 4, len(s.Hints()))
+	assert.Equal(t, 5, len(s.Hints()))
 }
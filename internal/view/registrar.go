@@ -2,6 +2,7 @@ package view
 
 import (
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/ui"
 )
 
@@ -10,6 +11,10 @@ func loadCustomViewers() MetaViewers {
 	coreViewers(m)
 	miscViewers(m)
 	appsViewers(m)
+	autoscalingViewers(m)
+	policyViewers(m)
+	flowControlViewers(m)
+	admissionViewers(m)
 	rbacViewers(m)
 	batchViewers(m)
 	extViewers(m)
@@ -22,6 +27,39 @@ func helmViewers(vv MetaViewers) {
 	vv[client.NewGVR("helm")] = MetaViewer{
 		viewerFn: NewHelm,
 	}
+	vv[client.NewGVR("helmhistory")] = MetaViewer{
+		viewerFn: NewHelmHistory,
+	}
+	vv[client.NewGVR("podsched")] = MetaViewer{
+		viewerFn: NewPodSchedule,
+	}
+	vv[client.NewGVR("alerts")] = MetaViewer{
+		viewerFn: NewAlert,
+	}
+	vv[client.NewGVR("audit")] = MetaViewer{
+		viewerFn: NewAudit,
+	}
+	vv[client.NewGVR("lint")] = MetaViewer{
+		viewerFn: NewLint,
+	}
+	vv[client.NewGVR("deprecations")] = MetaViewer{
+		viewerFn: NewDeprecation,
+	}
+	vv[client.NewGVR("tlscerts")] = MetaViewer{
+		viewerFn: NewTLSCert,
+	}
+	vv[client.NewGVR("stats")] = MetaViewer{
+		viewerFn: NewStats,
+	}
+	vv[client.NewGVR("nsusage")] = MetaViewer{
+		viewerFn: NewNamespaceUsage,
+	}
+	vv[client.NewGVR("faults")] = MetaViewer{
+		viewerFn: NewFault,
+	}
+	vv[client.NewGVR("debug")] = MetaViewer{
+		viewerFn: NewDebug,
+	}
 }
 
 func coreViewers(vv MetaViewers) {
@@ -55,6 +93,12 @@ func coreViewers(vv MetaViewers) {
 	vv[client.NewGVR("v1/persistentvolumeclaims")] = MetaViewer{
 		viewerFn: NewPersistentVolumeClaim,
 	}
+	vv[client.NewGVR("networking.k8s.io/v1/ingresses")] = MetaViewer{
+		viewerFn: NewIngress,
+	}
+	vv[client.NewGVR("networking.k8s.io/v1/networkpolicies")] = MetaViewer{
+		viewerFn: NewNetworkPolicy,
+	}
 }
 
 func miscViewers(vv MetaViewers) {
@@ -68,6 +112,9 @@ func miscViewers(vv MetaViewers) {
 	vv[client.NewGVR("containers")] = MetaViewer{
 		viewerFn: NewContainer,
 	}
+	vv[client.NewGVR("top")] = MetaViewer{
+		viewerFn: NewContainerTop,
+	}
 	vv[client.NewGVR("portforwards")] = MetaViewer{
 		viewerFn: NewPortForward,
 	}
@@ -83,6 +130,18 @@ func miscViewers(vv MetaViewers) {
 	vv[client.NewGVR("references")] = MetaViewer{
 		viewerFn: NewReference,
 	}
+	vv[client.NewGVR("owners")] = MetaViewer{
+		viewerFn: NewOwner,
+	}
+	vv[client.NewGVR("svcendpoints")] = MetaViewer{
+		viewerFn: NewServiceEndpoints,
+	}
+	vv[client.NewGVR("ingroutes")] = MetaViewer{
+		viewerFn: NewIngressRoute,
+	}
+	vv[client.NewGVR("npsim")] = MetaViewer{
+		viewerFn: NewNetworkPolicySim,
+	}
 	vv[client.NewGVR("pulses")] = MetaViewer{
 		viewerFn: NewPulse,
 	}
@@ -112,6 +171,42 @@ func appsViewers(vv MetaViewers) {
 	}
 }
 
+func autoscalingViewers(vv MetaViewers) {
+	for _, gv := range []string{"autoscaling/v1", "autoscaling/v2", "autoscaling/v2beta1", "autoscaling/v2beta2"} {
+		vv[client.NewGVR(gv+"/horizontalpodautoscalers")] = MetaViewer{
+			viewerFn: NewHorizontalPodAutoscaler,
+		}
+	}
+}
+
+func policyViewers(vv MetaViewers) {
+	for _, gv := range []string{"policy/v1", "policy/v1beta1"} {
+		vv[client.NewGVR(gv+"/poddisruptionbudgets")] = MetaViewer{
+			viewerFn: NewPodDisruptionBudget,
+		}
+	}
+}
+
+func flowControlViewers(vv MetaViewers) {
+	for _, gv := range dao.FlowControlGroupVersions {
+		vv[client.NewGVR(gv+"/flowschemas")] = MetaViewer{
+			viewerFn: NewFlowSchema,
+		}
+		vv[client.NewGVR(gv+"/prioritylevelconfigurations")] = MetaViewer{
+			viewerFn: NewPriorityLevel,
+		}
+	}
+}
+
+func admissionViewers(vv MetaViewers) {
+	vv[client.NewGVR("admissionregistration.k8s.io/v1/validatingwebhookconfigurations")] = MetaViewer{
+		viewerFn: NewWebhook,
+	}
+	vv[client.NewGVR("admissionregistration.k8s.io/v1/mutatingwebhookconfigurations")] = MetaViewer{
+		viewerFn: NewWebhook,
+	}
+}
+
 func rbacViewers(vv MetaViewers) {
 	vv[client.NewGVR("rbac")] = MetaViewer{
 		enterFn: showRules,
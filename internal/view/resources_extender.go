@@ -0,0 +1,211 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+)
+
+const resourcesKey = "setResources"
+
+var editableResources = []v1.ResourceName{v1.ResourceCPU, v1.ResourceMemory}
+
+type resourcesFormSpec struct {
+	name             string
+	init             bool
+	requests, limits map[v1.ResourceName]string
+	newRequests      map[v1.ResourceName]string
+	newLimits        map[v1.ResourceName]string
+}
+
+func newResourcesFormSpec(co v1.Container, init bool) *resourcesFormSpec {
+	s := resourcesFormSpec{
+		name:        co.Name,
+		init:        init,
+		requests:    make(map[v1.ResourceName]string, len(editableResources)),
+		limits:      make(map[v1.ResourceName]string, len(editableResources)),
+		newRequests: make(map[v1.ResourceName]string, len(editableResources)),
+		newLimits:   make(map[v1.ResourceName]string, len(editableResources)),
+	}
+	for _, r := range editableResources {
+		if q, ok := co.Resources.Requests[r]; ok {
+			s.requests[r] = q.String()
+		}
+		if q, ok := co.Resources.Limits[r]; ok {
+			s.limits[r] = q.String()
+		}
+		s.newRequests[r], s.newLimits[r] = s.requests[r], s.limits[r]
+	}
+
+	return &s
+}
+
+func (s *resourcesFormSpec) modified() bool {
+	for _, r := range editableResources {
+		if strings.TrimSpace(s.newRequests[r]) != s.requests[r] {
+			return true
+		}
+		if strings.TrimSpace(s.newLimits[r]) != s.limits[r] {
+			return true
+		}
+	}
+
+	return false
+}
+
+// resourceSpec returns the changed resources. Both the request and limit of
+// a changed resource are included so they can be validated against each other.
+func (s *resourcesFormSpec) resourceSpec() dao.ResourceSpec {
+	spec := dao.ResourceSpec{
+		Name:     s.name,
+		Init:     s.init,
+		Requests: make(map[v1.ResourceName]string),
+		Limits:   make(map[v1.ResourceName]string),
+	}
+	for _, r := range editableResources {
+		req, lim := strings.TrimSpace(s.newRequests[r]), strings.TrimSpace(s.newLimits[r])
+		if req == s.requests[r] && lim == s.limits[r] {
+			continue
+		}
+		if req != "" || s.requests[r] != "" {
+			spec.Requests[r] = req
+		}
+		if lim != "" || s.limits[r] != "" {
+			spec.Limits[r] = lim
+		}
+	}
+
+	return spec
+}
+
+// ResourcesExtender provides for editing containers requests and limits.
+type ResourcesExtender struct {
+	ResourceViewer
+}
+
+// NewResourcesExtender returns a new extender.
+func NewResourcesExtender(r ResourceViewer) ResourceViewer {
+	s := ResourcesExtender{ResourceViewer: r}
+	s.AddBindKeysFn(s.bindKeys)
+
+	return &s
+}
+
+func (s *ResourcesExtender) bindKeys(aa ui.KeyActions) {
+	if s.App().Config.K9s.IsReadOnly() {
+		return
+	}
+	aa.Add(ui.KeyActions{
+		ui.KeyZ: ui.NewKeyAction("Set Resources", s.setResourcesCmd, true),
+	})
+}
+
+func (s *ResourcesExtender) setResourcesCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := s.GetTable().GetSelectedItem()
+	if path == "" {
+		return nil
+	}
+
+	s.Stop()
+	defer s.Start()
+	if err := s.showResourcesDialog(path); err != nil {
+		s.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (s *ResourcesExtender) showResourcesDialog(path string) error {
+	form, err := s.makeSetResourcesForm(path)
+	if err != nil {
+		return err
+	}
+	confirm := tview.NewModalForm("<Set resources>", form)
+	confirm.SetText(fmt.Sprintf("Set resources %s %s", s.GVR(), path))
+	confirm.SetDoneFunc(func(int, string) {
+		s.dismissDialog()
+	})
+	s.App().Content.AddPage(resourcesKey, confirm, false, false)
+	s.App().Content.ShowPage(resourcesKey)
+
+	return nil
+}
+
+func (s *ResourcesExtender) makeSetResourcesForm(sel string) (*tview.Form, error) {
+	f := newStyledForm(s.App().Styles.Dialog())
+	podSpec, err := podSpecFor(s.App(), s.GVR(), sel)
+	if err != nil {
+		return nil, err
+	}
+	specs := make([]*resourcesFormSpec, 0, len(podSpec.InitContainers)+len(podSpec.Containers))
+	for _, co := range podSpec.InitContainers {
+		specs = append(specs, newResourcesFormSpec(co, true))
+	}
+	for _, co := range podSpec.Containers {
+		specs = append(specs, newResourcesFormSpec(co, false))
+	}
+	for i := range specs {
+		spec := specs[i]
+		for _, r := range editableResources {
+			r := r
+			f.AddInputField(fmt.Sprintf("%s %s request:", spec.name, r), spec.requests[r], 0, nil, func(changed string) {
+				spec.newRequests[r] = changed
+			})
+			f.AddInputField(fmt.Sprintf("%s %s limit:", spec.name, r), spec.limits[r], 0, nil, func(changed string) {
+				spec.newLimits[r] = changed
+			})
+		}
+	}
+
+	f.AddButton("OK", func() {
+		defer s.dismissDialog()
+		var modified dao.ResourceSpecs
+		for _, spec := range specs {
+			if spec.modified() {
+				modified = append(modified, spec.resourceSpec())
+			}
+		}
+		if len(modified) == 0 {
+			s.App().Flash().Info("No resources changes detected")
+			return
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), s.App().Conn().Config().CallTimeout())
+		defer cancel()
+		if err := s.setResources(ctx, sel, modified); err != nil {
+			log.Error().Err(err).Msgf("PodSpec %s resources update failed", sel)
+			s.App().Flash().Err(err)
+			return
+		}
+		s.App().Flash().Infof("Resource %s:%s resources updated successfully", s.GVR(), sel)
+	})
+	f.AddButton("Cancel", func() {
+		s.dismissDialog()
+	})
+
+	return f, nil
+}
+
+func (s *ResourcesExtender) dismissDialog() {
+	s.App().Content.RemovePage(resourcesKey)
+}
+
+func (s *ResourcesExtender) setResources(ctx context.Context, path string, specs dao.ResourceSpecs) error {
+	res, err := dao.AccessorFor(s.App().factory, s.GVR())
+	if err != nil {
+		return err
+	}
+	e, ok := res.(dao.ResourcesEditor)
+	if !ok {
+		return fmt.Errorf("expecting a ResourcesEditor for %q but got %T", s.GVR(), res)
+	}
+
+	return e.SetResources(ctx, path, specs)
+}
@@ -48,15 +48,7 @@ func (r *RestartExtender) restartCmd(evt *tcell.EventKey) *tcell.EventKey {
 		msg = fmt.Sprintf("Restart %d %s?", len(paths), r.GVR().R())
 	}
 	dialog.ShowConfirm(r.App().Styles.Dialog(), r.App().Content.Pages, "Confirm Restart", msg, func() {
-		ctx, cancel := context.WithTimeout(context.Background(), r.App().Conn().Config().CallTimeout())
-		defer cancel()
-		for _, path := range paths {
-			if err := r.restartRollout(ctx, path); err != nil {
-				r.App().Flash().Err(err)
-			} else {
-				r.App().Flash().Infof("Restart in progress for `%s...", path)
-			}
-		}
+		runBulk(r, "Restart", paths, r.restartRollout)
 	}, func() {})
 
 	return nil
@@ -0,0 +1,215 @@
+package view
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+)
+
+const undoKey = "undo"
+
+// RolloutExtender provides for managing workloads rollouts.
+type RolloutExtender struct {
+	ResourceViewer
+}
+
+// NewRolloutExtender returns a new extender.
+func NewRolloutExtender(v ResourceViewer) ResourceViewer {
+	r := RolloutExtender{ResourceViewer: v}
+	r.AddBindKeysFn(r.bindKeys)
+
+	return &r
+}
+
+func (r *RolloutExtender) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyH: ui.NewKeyAction("History", r.historyCmd, true),
+	})
+	if r.App().Config.K9s.IsReadOnly() {
+		return
+	}
+	aa.Add(ui.KeyActions{
+		ui.KeyU: ui.NewKeyAction("Undo", r.undoCmd, true),
+	})
+	if r.GVR().String() == "apps/v1/deployments" {
+		aa.Add(ui.KeyActions{
+			tcell.KeyCtrlP: ui.NewKeyAction("Pause/Resume", r.pauseCmd, true),
+		})
+	}
+}
+
+func (r *RolloutExtender) historyCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := r.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	rr, err := r.history(path)
+	if err != nil {
+		r.App().Flash().Err(err)
+		return nil
+	}
+	details := NewDetails(r.App(), "History", path, false).Update(formatRevisions(rr))
+	if err := r.App().inject(details, false); err != nil {
+		r.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (r *RolloutExtender) undoCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := r.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	rr, err := r.history(path)
+	if err != nil {
+		r.App().Flash().Err(err)
+		return nil
+	}
+	if len(rr) < 2 {
+		r.App().Flash().Warnf("No previous revisions found for %s", path)
+		return nil
+	}
+	r.showUndoDialog(path, rr[1:])
+
+	return nil
+}
+
+func (r *RolloutExtender) showUndoDialog(path string, rr dao.Revisions) {
+	f := newStyledForm(r.App().Styles.Dialog())
+
+	opts := make([]string, 0, len(rr))
+	for _, rev := range rr {
+		opts = append(opts, revisionLabel(rev))
+	}
+	sel := rr[0].Number
+	f.AddDropDown("Revision:", opts, 0, func(_ string, idx int) {
+		if idx >= 0 && idx < len(rr) {
+			sel = rr[idx].Number
+		}
+	})
+	f.AddButton("OK", func() {
+		r.App().Content.RemovePage(undoKey)
+		ctx, cancel := context.WithTimeout(context.Background(), r.App().Conn().Config().CallTimeout())
+		defer cancel()
+		if err := r.undo(ctx, path, sel); err != nil {
+			r.App().Flash().Err(err)
+			return
+		}
+		r.App().Flash().Infof("%s rolled back to revision %d", path, sel)
+	})
+	f.AddButton("Cancel", func() {
+		r.App().Content.RemovePage(undoKey)
+	})
+
+	modal := tview.NewModalForm("<Undo>", f)
+	modal.SetText(fmt.Sprintf("Rollback %s %s", singularize(r.GVR().R()), path))
+	modal.SetDoneFunc(func(int, string) {
+		r.App().Content.RemovePage(undoKey)
+	})
+	r.App().Content.AddPage(undoKey, modal, false, false)
+	r.App().Content.ShowPage(undoKey)
+}
+
+func (r *RolloutExtender) pauseCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := r.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	var d dao.Deployment
+	d.Init(r.App().factory, r.GVR())
+	dp, err := d.Load(r.App().factory, path)
+	if err != nil {
+		r.App().Flash().Err(err)
+		return nil
+	}
+	action, actionFn := "Pause", d.Pause
+	if dp.Spec.Paused {
+		action, actionFn = "Resume", d.Resume
+	}
+	msg := fmt.Sprintf("%s rollout for deployment %s?", action, path)
+	dialog.ShowConfirm(r.App().Styles.Dialog(), r.App().Content.Pages, "Confirm "+action, msg, func() {
+		ctx, cancel := context.WithTimeout(context.Background(), r.App().Conn().Config().CallTimeout())
+		defer cancel()
+		if err := actionFn(ctx, path); err != nil {
+			r.App().Flash().Err(err)
+			return
+		}
+		r.App().Flash().Infof("%s rollout for %s succeeded", action, path)
+	}, func() {})
+
+	return nil
+}
+
+func (r *RolloutExtender) rollbackable() (dao.Rollbackable, error) {
+	res, err := dao.AccessorFor(r.App().factory, r.GVR())
+	if err != nil {
+		return nil, err
+	}
+	rb, ok := res.(dao.Rollbackable)
+	if !ok {
+		return nil, errors.New("resource does not support rollouts")
+	}
+
+	return rb, nil
+}
+
+func (r *RolloutExtender) history(path string) (dao.Revisions, error) {
+	rb, err := r.rollbackable()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), r.App().Conn().Config().CallTimeout())
+	defer cancel()
+
+	return rb.History(ctx, path)
+}
+
+func (r *RolloutExtender) undo(ctx context.Context, path string, revision int64) error {
+	rb, err := r.rollbackable()
+	if err != nil {
+		return err
+	}
+
+	return rb.Undo(ctx, path, revision)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func revisionLabel(r dao.Revision) string {
+	label := fmt.Sprintf("%d %s", r.Number, strings.Join(r.Images, ","))
+	if r.ChangeCause != "" {
+		label += " (" + r.ChangeCause + ")"
+	}
+
+	return label
+}
+
+func formatRevisions(rr dao.Revisions) string {
+	var b bytes.Buffer
+	w := tabwriter.NewWriter(&b, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "REVISION\tCHANGE-CAUSE\tIMAGES")
+	for _, r := range rr {
+		cause := r.ChangeCause
+		if cause == "" {
+			cause = "<none>"
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\n", r.Number, cause, strings.Join(r.Images, ","))
+	}
+	_ = w.Flush()
+
+	return b.String()
+}
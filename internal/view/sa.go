@@ -31,6 +31,21 @@ func (s *ServiceAccount) bindKeys(aa ui.KeyActions) {
 		ui.KeyU:        ui.NewKeyAction("UsedBy", s.refCmd, true),
 		tcell.KeyEnter: ui.NewKeyAction("Rules", s.policyCmd, true),
 	})
+	if !s.App().Config.K9s.IsReadOnly() {
+		aa.Add(ui.KeyActions{
+			ui.KeyT: ui.NewKeyAction("Token", s.tokenCmd, true),
+		})
+	}
+}
+
+func (s *ServiceAccount) tokenCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := s.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	showTokenDialog(s.App(), path)
+
+	return nil
 }
 
 func (s *ServiceAccount) subjectCtx(ctx context.Context) context.Context {
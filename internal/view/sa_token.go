@@ -0,0 +1,124 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/tview"
+)
+
+const (
+	saTokenDialogKey = "sa-token"
+	defaultTokenTTL  = "1h"
+	minTokenTTL      = 10 * time.Minute
+)
+
+const (
+	tokenToClipboard = iota
+	kubeConfigToClipboard
+	kubeConfigToFile
+)
+
+var tokenOutputs = []string{"Token to clipboard", "Kubeconfig to clipboard", "Kubeconfig to file"}
+
+func showTokenDialog(app *App, path string) {
+	f := newStyledForm(app.Styles.Dialog())
+
+	ttl, audiences, output := defaultTokenTTL, "", tokenToClipboard
+	f.AddInputField("Expiry:", ttl, 10, nil, func(s string) {
+		ttl = s
+	})
+	f.AddInputField("Audiences:", audiences, 40, nil, func(s string) {
+		audiences = s
+	})
+	f.AddDropDown("Output:", tokenOutputs, output, func(_ string, idx int) {
+		output = idx
+	})
+	f.AddButton("OK", func() {
+		d, err := time.ParseDuration(ttl)
+		if err != nil {
+			app.Flash().Errf("Invalid expiry %q", ttl)
+			return
+		}
+		if d < minTokenTTL {
+			app.Flash().Errf("Token expiry must be at least %s", minTokenTTL)
+			return
+		}
+		app.Content.RemovePage(saTokenDialogKey)
+		if err := mintToken(app, path, d, splitAudiences(audiences), output); err != nil {
+			app.Flash().Err(err)
+		}
+	})
+	f.AddButton("Cancel", func() {
+		app.Content.RemovePage(saTokenDialogKey)
+	})
+
+	modal := tview.NewModalForm("<Token>", f)
+	modal.SetText(fmt.Sprintf("Request token for service account %s", path))
+	modal.SetDoneFunc(func(int, string) {
+		app.Content.RemovePage(saTokenDialogKey)
+	})
+	app.Content.AddPage(saTokenDialogKey, modal, false, false)
+	app.Content.ShowPage(saTokenDialogKey)
+}
+
+func mintToken(app *App, path string, ttl time.Duration, audiences []string, output int) error {
+	res, err := dao.AccessorFor(app.factory, client.NewGVR("v1/serviceaccounts"))
+	if err != nil {
+		return err
+	}
+	t, ok := res.(dao.Tokener)
+	if !ok {
+		return fmt.Errorf("expecting a tokener for service accounts but got %T", res)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), app.Conn().Config().CallTimeout())
+	defer cancel()
+	token, expiry, err := t.Token(ctx, path, ttl, audiences)
+	if err != nil {
+		return err
+	}
+	expires := expiry.Local().Format(time.RFC3339)
+	if output == tokenToClipboard {
+		if err := clipboardWrite(token); err != nil {
+			return err
+		}
+		app.Flash().Infof("Token copied to clipboard (expires %s)", expires)
+		return nil
+	}
+
+	raw, err := t.KubeConfig(path, token)
+	if err != nil {
+		return err
+	}
+	if output == kubeConfigToClipboard {
+		if err := clipboardWrite(string(raw)); err != nil {
+			return err
+		}
+		app.Flash().Infof("Kubeconfig copied to clipboard (expires %s)", expires)
+		return nil
+	}
+	_, n := client.Namespaced(path)
+	fPath, err := saveYAML(app.Config.K9s.GetScreenDumpDir(), app.Config.K9s.CurrentContextDir(), n+"-kubeconfig", string(raw))
+	if err != nil {
+		return err
+	}
+	app.Flash().Infof("Kubeconfig saved to %s (expires %s)", fPath, expires)
+
+	return nil
+}
+
+func splitAudiences(s string) []string {
+	var aa []string
+	for _, a := range strings.Split(s, ",") {
+		if a = strings.TrimSpace(a); a != "" {
+			aa = append(aa, a)
+		}
+	}
+
+	return aa
+}
@@ -0,0 +1,147 @@
+package view
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/ui"
+)
+
+const savedViewKind = "view"
+
+// viewCmd manages saved views. `view` lists them, `view save NAME` saves the
+// current view, `view delete NAME` removes one and `view NAME` recalls it.
+func (a *App) viewCmd(args []string) error {
+	switch {
+	case len(args) == 0:
+		return a.showSavedViews()
+	case args[0] == "save" && len(args) == 2:
+		return a.saveView(args[1])
+	case args[0] == "delete" && len(args) == 2:
+		if !a.Config.K9s.DeleteView(args[1]) {
+			return fmt.Errorf("no saved view named %q", args[1])
+		}
+		if err := a.Config.Save(); err != nil {
+			return err
+		}
+		a.Flash().Infof("View %q deleted", args[1])
+		return nil
+	case len(args) == 1:
+		return a.recallView(args[0])
+	default:
+		return errors.New("usage: view [NAME | save NAME | delete NAME]")
+	}
+}
+
+func (a *App) saveView(name string) error {
+	v, ok := a.Content.Top().(ResourceViewer)
+	if !ok {
+		return errors.New("current view can not be saved")
+	}
+	a.Config.K9s.SaveView(name, viewState(v))
+	if err := a.Config.Save(); err != nil {
+		return err
+	}
+	a.Flash().Infof("View %q saved", name)
+
+	return nil
+}
+
+func (a *App) recallView(name string) error {
+	sv, ok := a.Config.K9s.SavedView(name)
+	if !ok {
+		return fmt.Errorf("no saved view named %q", name)
+	}
+
+	return a.applyView(sv)
+}
+
+// viewState captures a resource view state.
+func viewState(v ResourceViewer) config.SavedView {
+	t := v.GetTable()
+	sv := config.SavedView{
+		Command:    v.GVR().String(),
+		Namespace:  t.GetModel().GetNamespace(),
+		Filter:     t.CmdBuff().GetText(),
+		SortColumn: t.SortColumn(),
+	}
+	switch {
+	case client.IsAllNamespaces(sv.Namespace):
+		sv.Namespace = client.NamespaceAll
+	case client.IsClusterScoped(sv.Namespace):
+		sv.Namespace = ""
+	}
+	if vs := t.ViewSetting(); vs != nil {
+		sv.Columns = vs.Columns
+	}
+
+	return sv
+}
+
+// applyView runs a view command and restores its state.
+func (a *App) applyView(sv config.SavedView) error {
+	cmd := sv.Command
+	if sv.Namespace != "" {
+		cmd += " " + sv.Namespace
+	}
+	if err := a.command.run(cmd, "", true); err != nil {
+		return err
+	}
+	v, ok := a.Content.Top().(ResourceViewer)
+	if !ok {
+		return nil
+	}
+	t := v.GetTable()
+	if len(sv.Columns) > 0 || sv.SortColumn != "" {
+		t.ViewSettingsChanged(config.ViewSetting{Columns: sv.Columns, SortColumn: sv.SortColumn})
+	}
+	if sv.Filter != "" {
+		t.CmdBuff().SetText(sv.Filter, "")
+	}
+	t.Refresh()
+
+	return nil
+}
+
+func (a *App) showSavedViews() error {
+	names := a.Config.K9s.SavedViews.Names()
+	if len(names) == 0 {
+		return errors.New("no saved views. Use `view save NAME` to save the current view")
+	}
+	ee := make(model.PaletteEntries, 0, len(names))
+	for _, n := range names {
+		ee = append(ee, model.PaletteEntry{
+			Command: n,
+			Kind:    savedViewKind,
+			Help:    describeView(a.Config.K9s.SavedViews[n]),
+		})
+	}
+	p := ui.NewPalette(a.Styles, ee, model.NewHistory(0))
+	p.SetHeading("Views")
+	a.showPalette(p, func(name string) {
+		if err := a.recallView(strings.Fields(name)[0]); err != nil {
+			a.Flash().Err(err)
+		}
+	})
+
+	return nil
+}
+
+func describeView(sv config.SavedView) string {
+	ss := []string{sv.Command}
+	if sv.Namespace != "" {
+		ss = append(ss, "ns:"+sv.Namespace)
+	}
+	if sv.Filter != "" {
+		ss = append(ss, "/"+sv.Filter)
+	}
+	if sv.SortColumn != "" {
+		ss = append(ss, "sort:"+sv.SortColumn)
+	}
+
+	return strings.Join(ss, " ")
+}
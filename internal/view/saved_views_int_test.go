@@ -0,0 +1,31 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDescribeView(t *testing.T) {
+	uu := map[string]struct {
+		sv config.SavedView
+		e  string
+	}{
+		"plain": {
+			sv: config.SavedView{Command: "v1/pods"},
+			e:  "v1/pods",
+		},
+		"full": {
+			sv: config.SavedView{Command: "v1/pods", Namespace: "all", Filter: "nginx", SortColumn: "AGE:desc"},
+			e:  "v1/pods ns:all /nginx sort:AGE:desc",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, describeView(u.sv))
+		})
+	}
+}
@@ -10,7 +10,6 @@ import (
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/tcell/v2"
 	"github.com/derailed/tview"
-	"github.com/rs/zerolog/log"
 )
 
 // ScaleExtender adds scaling extensions.
@@ -49,17 +48,18 @@ func (s *ScaleExtender) scaleCmd(evt *tcell.EventKey) *tcell.EventKey {
 }
 
 func (s *ScaleExtender) showScaleDialog(paths []string) {
-	form, err := s.makeScaleForm(paths)
+	var confirm *tview.ModalForm
+	form, err := s.makeScaleForm(paths, func(factor string) {
+		if confirm != nil {
+			confirm.SetText(s.scaleMsg(paths, factor))
+		}
+	})
 	if err != nil {
 		s.App().Flash().Err(err)
 		return
 	}
-	confirm := tview.NewModalForm("<Scale>", form)
-	msg := fmt.Sprintf("Scale %s %s?", singularize(s.GVR().R()), paths[0])
-	if len(paths) > 1 {
-		msg = fmt.Sprintf("Scale [%d] %s?", len(paths), s.GVR().R())
-	}
-	confirm.SetText(msg)
+	confirm = tview.NewModalForm("<Scale>", form)
+	confirm.SetText(s.scaleMsg(paths, ""))
 	confirm.SetDoneFunc(func(int, string) {
 		s.dismissDialog()
 	})
@@ -67,6 +67,32 @@ func (s *ScaleExtender) showScaleDialog(paths []string) {
 	s.App().Content.ShowPage(scaleDialogKey)
 }
 
+// scaleMsg returns the scale prompt. Statefulsets list the ordinals impacted
+// by the new replica count.
+func (s *ScaleExtender) scaleMsg(paths []string, factor string) string {
+	if len(paths) > 1 {
+		return fmt.Sprintf("Scale [%d] %s?", len(paths), s.GVR().R())
+	}
+	msg := fmt.Sprintf("Scale %s %s?", singularize(s.GVR().R()), paths[0])
+	if s.GVR().String() != "apps/v1/statefulsets" {
+		return msg
+	}
+	count, err := strconv.Atoi(factor)
+	if err != nil {
+		return msg
+	}
+	var res dao.StatefulSet
+	sts, err := res.Load(s.App().factory, paths[0])
+	if err != nil {
+		return msg
+	}
+	if impact := dao.OrdinalScaleImpact(sts, int32(count)); impact != "" {
+		msg += "\n" + impact
+	}
+
+	return msg
+}
+
 func (s *ScaleExtender) valueOf(col string) (string, error) {
 	colIdx, ok := s.GetTable().HeaderIndex(col)
 	if !ok {
@@ -75,8 +101,8 @@ func (s *ScaleExtender) valueOf(col string) (string, error) {
 	return s.GetTable().GetSelectedCell(colIdx), nil
 }
 
-func (s *ScaleExtender) makeScaleForm(sels []string) (*tview.Form, error) {
-	f := s.makeStyledForm()
+func (s *ScaleExtender) makeScaleForm(sels []string, changed func(string)) (*tview.Form, error) {
+	f := newStyledForm(s.App().Styles.Dialog())
 
 	factor := "0"
 	if len(sels) == 1 {
@@ -93,8 +119,9 @@ func (s *ScaleExtender) makeScaleForm(sels []string) (*tview.Form, error) {
 	f.AddInputField("Replicas:", factor, 4, func(textToCheck string, lastChar rune) bool {
 		_, err := strconv.Atoi(textToCheck)
 		return err == nil
-	}, func(changed string) {
-		factor = changed
+	}, func(v string) {
+		factor = v
+		changed(v)
 	})
 
 	f.AddButton("OK", func() {
@@ -104,20 +131,9 @@ func (s *ScaleExtender) makeScaleForm(sels []string) (*tview.Form, error) {
 			s.App().Flash().Err(err)
 			return
 		}
-		ctx, cancel := context.WithTimeout(context.Background(), s.App().Conn().Config().CallTimeout())
-		defer cancel()
-		for _, sel := range sels {
-			if err := s.scale(ctx, sel, count); err != nil {
-				log.Error().Err(err).Msgf("DP %s scaling failed", sel)
-				s.App().Flash().Err(err)
-				return
-			}
-		}
-		if len(sels) == 1 {
-			s.App().Flash().Infof("[%d] %s scaled successfully", len(sels), singularize(s.GVR().R()))
-		} else {
-			s.App().Flash().Infof("%s %s scaled successfully", s.GVR().R(), sels[0])
-		}
+		runBulk(s, "Scale", sels, func(ctx context.Context, sel string) error {
+			return s.scale(ctx, sel, count)
+		})
 	})
 
 	f.AddButton("Cancel", func() {
@@ -131,18 +147,6 @@ func (s *ScaleExtender) dismissDialog() {
 	s.App().Content.RemovePage(scaleDialogKey)
 }
 
-func (s *ScaleExtender) makeStyledForm() *tview.Form {
-	f := tview.NewForm()
-	f.SetItemPadding(0)
-	f.SetButtonsAlign(tview.AlignCenter).
-		SetButtonBackgroundColor(tview.Styles.PrimitiveBackgroundColor).
-		SetButtonTextColor(tview.Styles.PrimaryTextColor).
-		SetLabelColor(tcell.ColorAqua).
-		SetFieldTextColor(tcell.ColorOrange)
-
-	return f
-}
-
 func (s *ScaleExtender) scale(ctx context.Context, path string, replicas int) error {
 	res, err := dao.AccessorFor(s.App().factory, s.GVR())
 	if err != nil {
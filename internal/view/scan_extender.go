@@ -0,0 +1,156 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+	v1 "k8s.io/api/core/v1"
+)
+
+// ScanExtender scans pods or containers images for vulnerabilities.
+type ScanExtender struct {
+	ResourceViewer
+}
+
+// NewScanExtender returns a new extender.
+func NewScanExtender(r ResourceViewer) ResourceViewer {
+	s := ScanExtender{ResourceViewer: r}
+	s.AddBindKeysFn(s.bindKeys)
+
+	return &s
+}
+
+func (s *ScanExtender) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftV: ui.NewKeyAction("Scan Vulnerabilities", s.scanCmd, true),
+	})
+}
+
+func (s *ScanExtender) scanCmd(evt *tcell.EventKey) *tcell.EventKey {
+	sel := s.GetTable().GetSelectedItem()
+	if sel == "" {
+		return evt
+	}
+
+	path, images, err := s.images(sel)
+	if err != nil {
+		s.App().Flash().Err(err)
+		return nil
+	}
+	scanner, err := dao.NewImageScanner(s.App().Config.K9s.ImageScansConfig())
+	if err != nil {
+		s.App().Flash().Err(err)
+		return nil
+	}
+
+	app := s.App()
+	ctx, cancel := context.WithCancel(context.Background())
+	done := app.trackCall(fmt.Sprintf("Scanning %d image(s)", len(images)), cancel)
+	go func() {
+		defer done()
+		rr := dao.ScanImages(ctx, scanner, path, images)
+		if ctx.Err() != nil {
+			app.QueueUpdateDraw(func() {
+				app.Flash().Warn("Image scan canceled")
+			})
+			return
+		}
+		app.QueueUpdateDraw(func() {
+			details := NewDetails(app, "Vulnerabilities", sel, true).Update(scanReport(rr))
+			if err := app.inject(details, false); err != nil {
+				app.Flash().Err(err)
+			}
+		})
+	}()
+
+	return nil
+}
+
+// images returns the images to scan for the selection and the owning pod path
+// when the whole pod is scanned.
+func (s *ScanExtender) images(sel string) (string, []string, error) {
+	if s.GVR().String() == "containers" {
+		pod, err := fetchPod(s.App().factory, s.GetTable().Path)
+		if err != nil {
+			return "", nil, err
+		}
+		for _, co := range podImages(pod.Spec) {
+			if co.Name == sel {
+				return "", []string{co.Image}, nil
+			}
+		}
+		return "", nil, fmt.Errorf("no container %q found", sel)
+	}
+
+	pod, err := fetchPod(s.App().factory, sel)
+	if err != nil {
+		return "", nil, err
+	}
+	var (
+		ii   []string
+		seen = make(map[string]struct{})
+	)
+	for _, co := range podImages(pod.Spec) {
+		if _, ok := seen[co.Image]; ok {
+			continue
+		}
+		seen[co.Image] = struct{}{}
+		ii = append(ii, co.Image)
+	}
+
+	return sel, ii, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func podImages(spec v1.PodSpec) []v1.Container {
+	cc := make([]v1.Container, 0, len(spec.InitContainers)+len(spec.Containers))
+	cc = append(cc, spec.InitContainers...)
+
+	return append(cc, spec.Containers...)
+}
+
+// scanReport renders scan reports grouped by severity.
+func scanReport(rr []*dao.ScanReport) string {
+	var b strings.Builder
+	b.WriteString("Summary:\n")
+	for _, r := range rr {
+		if r.Err != nil {
+			fmt.Fprintf(&b, "  %s: scan failed - %s\n", r.Image, r.Err)
+			continue
+		}
+		counts := make([]string, 0, len(dao.Severities))
+		for _, sev := range dao.Severities {
+			counts = append(counts, strconv.Itoa(r.Count(sev))+" "+strings.ToLower(sev))
+		}
+		fmt.Fprintf(&b, "  %s: %s\n", r.Image, strings.Join(counts, ", "))
+	}
+	for _, sev := range dao.Severities {
+		var vv []string
+		for _, r := range rr {
+			for _, v := range r.BySeverity(sev) {
+				fixed := v.Fixed
+				if fixed == "" {
+					fixed = render.MissingValue
+				}
+				vv = append(vv, fmt.Sprintf("  - %s %s %s (fixed: %s) %s", v.ID, v.Package, v.Installed, fixed, r.Image))
+				if v.Title != "" {
+					vv = append(vv, "    "+v.Title)
+				}
+			}
+		}
+		if len(vv) == 0 {
+			continue
+		}
+		fmt.Fprintf(&b, "%s:\n%s\n", sev, strings.Join(vv, "\n"))
+	}
+
+	return strings.TrimSuffix(b.String(), "\n")
+}
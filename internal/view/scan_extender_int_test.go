@@ -0,0 +1,32 @@
+package view
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScanReport(t *testing.T) {
+	rr := []*dao.ScanReport{
+		{
+			Image: "nginx:1.0",
+			Vulns: []dao.Vulnerability{
+				{ID: "CVE-2", Package: "zlib", Installed: "1.0", Severity: dao.SeverityHigh},
+				{ID: "CVE-1", Package: "openssl", Installed: "1.1", Fixed: "1.2", Severity: dao.SeverityCritical, Title: "boom"},
+			},
+		},
+		{Image: "busybox", Err: errors.New("denied")},
+	}
+
+	e := `Summary:
+  nginx:1.0: 1 critical, 1 high, 0 medium, 0 low, 0 unknown
+  busybox: scan failed - denied
+CRITICAL:
+  - CVE-1 openssl 1.1 (fixed: 1.2) nginx:1.0
+    boom
+HIGH:
+  - CVE-2 zlib 1.0 (fixed: <none>) nginx:1.0`
+	assert.Equal(t, e, scanReport(rr))
+}
@@ -0,0 +1,85 @@
+package view
+
+import (
+	"strings"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/script"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+	"github.com/rs/zerolog/log"
+)
+
+// scriptScopes returns the resource names scripts can be scoped to.
+func (b *Browser) scriptScopes() []string {
+	return append(b.Aliases(), b.GVR().String())
+}
+
+// bindScripts hooks up script colorizers and computed columns.
+func (b *Browser) bindScripts() {
+	hh := b.app.scripts
+	if hh == nil || hh.Empty() {
+		return
+	}
+	scopes := b.scriptScopes()
+	if hh.HasColumns(scopes) {
+		b.GetTable().SetTransformFn(func(data *render.TableData) {
+			hh.Transform(scopes, data)
+		})
+	}
+	b.GetTable().SetColorOverrideFn(func(_ string, h render.Header, re render.RowEvent) (tcell.Color, bool) {
+		c, ok := hh.Color(scopes, h, re.Row)
+		if !ok {
+			return tcell.ColorDefault, false
+		}
+		return config.NewColor(c).Color(), true
+	})
+}
+
+func scriptActions(b *Browser, aa ui.KeyActions) {
+	hh := b.app.scripts
+	if hh == nil || hh.Empty() {
+		return
+	}
+	for _, a := range hh.Actions(b.scriptScopes()) {
+		key, err := asKey(a.Key)
+		if err != nil {
+			log.Warn().Err(err).Msg("Unable to map script action shortcut to a key")
+			continue
+		}
+		if _, ok := aa[key]; ok {
+			log.Warn().Msgf("Script action %q overrides an existing command", a.Description)
+			continue
+		}
+		aa[key] = ui.NewKeyAction(a.Description, scriptAction(b, a), true)
+	}
+}
+
+// scriptAction runs a script action on the selected row. A result prefixed
+// with a colon is run as a command, any other result is flashed.
+func scriptAction(b *Browser, a script.Action) ui.ActionHandler {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		path := b.GetSelectedItem()
+		if path == "" {
+			return evt
+		}
+		row, ok := b.GetSelectedRow(path)
+		if !ok {
+			return nil
+		}
+		res, err := a.Run(b.GetModel().Peek().Header, row)
+		if err != nil {
+			b.app.Flash().Err(err)
+			return nil
+		}
+		switch {
+		case strings.HasPrefix(res, ":"):
+			b.app.gotoResource(strings.TrimPrefix(res, ":"), "", false)
+		case res != "":
+			b.app.Flash().Info(res)
+		}
+
+		return nil
+	}
+}
@@ -4,11 +4,6 @@ import (
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/tcell/v2"
-	v1 "k8s.io/api/core/v1"
-	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
-	"k8s.io/apimachinery/pkg/labels"
-	"k8s.io/apimachinery/pkg/runtime"
-	"sigs.k8s.io/yaml"
 )
 
 // Secret presents a secret viewer.
@@ -43,31 +38,7 @@ func (s *Secret) decodeCmd(evt *tcell.EventKey) *tcell.EventKey {
 		return evt
 	}
 
-	o, err := s.App().factory.Get(s.GVR().String(), path, true, labels.Everything())
-	if err != nil {
-		s.App().Flash().Err(err)
-		return nil
-	}
-
-	var secret v1.Secret
-	err = runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &secret)
-	if err != nil {
-		s.App().Flash().Err(err)
-		return nil
-	}
-
-	d := make(map[string]string, len(secret.Data))
-	for k, val := range secret.Data {
-		d[k] = string(val)
-	}
-	raw, err := yaml.Marshal(d)
-	if err != nil {
-		s.App().Flash().Errf("Error decoding secret %s", err)
-		return nil
-	}
-
-	details := NewDetails(s.App(), "Secret Decoder", path, true).Update(string(raw))
-	if err := s.App().inject(details, false); err != nil {
+	if err := s.App().inject(NewSecretData(s.App(), path), false); err != nil {
 		s.App().Flash().Err(err)
 	}
 
@@ -0,0 +1,249 @@
+package view
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+)
+
+const (
+	secretDataTitle     = "Secret Data"
+	secretEditDialogKey = "secret-edit"
+	secretMask          = "••••••••"
+)
+
+// SecretData presents a secret data keys viewer and editor.
+type SecretData struct {
+	*tview.Table
+
+	app     *App
+	path    string
+	actions ui.KeyActions
+	data    map[string][]byte
+	keys    []string
+	decoded bool
+	reveal  bool
+}
+
+// NewSecretData returns a new secret data viewer.
+func NewSecretData(app *App, path string) *SecretData {
+	return &SecretData{
+		Table:   tview.NewTable(),
+		app:     app,
+		path:    path,
+		actions: make(ui.KeyActions),
+	}
+}
+
+// Init initializes the view.
+func (s *SecretData) Init(ctx context.Context) error {
+	s.SetBorder(true)
+	s.SetBorderPadding(0, 0, 1, 1)
+	s.SetSelectable(true, false)
+	s.SetFixed(1, 0)
+	s.SetSelectedStyle(tcell.StyleDefault.Foreground(tcell.ColorBlack).Background(tcell.ColorAqua))
+	s.actions.Add(ui.KeyActions{
+		tcell.KeyEscape: ui.NewKeyAction("Back", s.app.PrevCmd, true),
+		ui.KeyX:         ui.NewKeyAction("Toggle Decode", s.toggleDecodeCmd, true),
+		ui.KeyV:         ui.NewKeyAction("Toggle Reveal", s.toggleRevealCmd, true),
+	})
+	if !s.app.Config.K9s.IsReadOnly() {
+		s.actions.Add(ui.KeyActions{
+			ui.KeyE: ui.NewKeyAction("Edit", s.editCmd, true),
+		})
+	}
+	s.SetInputCapture(func(evt *tcell.EventKey) *tcell.EventKey {
+		if a, ok := s.actions[ui.AsKey(evt)]; ok {
+			return a.Action(evt)
+		}
+		return evt
+	})
+
+	return s.reload(ctx)
+}
+
+// InCmdMode checks if prompt is active.
+func (*SecretData) InCmdMode() bool {
+	return false
+}
+
+// Start starts the view.
+func (s *SecretData) Start() {}
+
+// Stop stops the view.
+func (s *SecretData) Stop() {}
+
+// Name returns the component name.
+func (s *SecretData) Name() string { return secretDataTitle }
+
+// Hints returns the view hints.
+func (s *SecretData) Hints() model.MenuHints {
+	return s.actions.Hints()
+}
+
+// ExtraHints returns additional hints.
+func (s *SecretData) ExtraHints() map[string]string {
+	return nil
+}
+
+func (s *SecretData) accessor() (dao.DataPatcher, error) {
+	res, err := dao.AccessorFor(s.app.factory, client.NewGVR("v1/secrets"))
+	if err != nil {
+		return nil, err
+	}
+	p, ok := res.(dao.DataPatcher)
+	if !ok {
+		return nil, fmt.Errorf("expecting a data patcher for secrets but got %T", res)
+	}
+
+	return p, nil
+}
+
+func (s *SecretData) reload(ctx context.Context) error {
+	p, err := s.accessor()
+	if err != nil {
+		return err
+	}
+	data, err := p.Data(ctx, s.path)
+	if err != nil {
+		return err
+	}
+	s.data, s.keys = data, dao.SortedKeys(data)
+	s.refresh()
+
+	return nil
+}
+
+func (s *SecretData) toggleDecodeCmd(evt *tcell.EventKey) *tcell.EventKey {
+	s.decoded = !s.decoded
+	s.refresh()
+
+	return nil
+}
+
+func (s *SecretData) toggleRevealCmd(evt *tcell.EventKey) *tcell.EventKey {
+	s.reveal = !s.reveal
+	s.refresh()
+
+	return nil
+}
+
+func (s *SecretData) editCmd(evt *tcell.EventKey) *tcell.EventKey {
+	row, _ := s.GetSelection()
+	if row < 1 || row > len(s.keys) {
+		return nil
+	}
+	key := s.keys[row-1]
+	if strings.Contains(string(s.data[key]), "\n") {
+		s.app.Flash().Warnf("Multi-line key %s can not be edited inline", key)
+		return nil
+	}
+	s.showEditDialog(key)
+
+	return nil
+}
+
+func (s *SecretData) showEditDialog(key string) {
+	f := newStyledForm(s.app.Styles.Dialog())
+	var value string
+	if s.reveal {
+		value = string(s.data[key])
+		f.AddInputField("Value:", value, 50, nil, func(changed string) {
+			value = changed
+		})
+	} else {
+		f.AddPasswordField("Value:", "", 50, '•', func(changed string) {
+			value = changed
+		})
+	}
+	f.AddButton("OK", func() {
+		defer s.dismissDialog()
+		if !s.reveal && value == "" {
+			s.app.Flash().Info("No secret changes detected")
+			return
+		}
+		if err := s.setKey(key, value); err != nil {
+			s.app.Flash().Err(err)
+			return
+		}
+		s.app.Flash().Infof("Secret key %s updated successfully", key)
+	})
+	f.AddButton("Cancel", func() {
+		s.dismissDialog()
+	})
+
+	modal := tview.NewModalForm("<Edit>", f)
+	modal.SetText(fmt.Sprintf("Edit secret %s key %s", s.path, key))
+	modal.SetDoneFunc(func(int, string) {
+		s.dismissDialog()
+	})
+	s.app.Content.AddPage(secretEditDialogKey, modal, false, false)
+	s.app.Content.ShowPage(secretEditDialogKey)
+}
+
+func (s *SecretData) dismissDialog() {
+	s.app.Content.RemovePage(secretEditDialogKey)
+}
+
+func (s *SecretData) setKey(key, value string) error {
+	p, err := s.accessor()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), s.app.Conn().Config().CallTimeout())
+	defer cancel()
+	if err := p.SetKey(ctx, s.path, key, []byte(value)); err != nil {
+		return err
+	}
+	s.data[key] = []byte(value)
+	s.refresh()
+
+	return nil
+}
+
+func (s *SecretData) refresh() {
+	mode := "encoded"
+	if s.decoded {
+		mode = "decoded"
+	}
+	if !s.reveal {
+		mode += ",masked"
+	}
+	s.SetTitle(fmt.Sprintf(" [aqua::b]%s([fuchsia::b]%s[aqua::b])[[fuchsia::b]%s[aqua::b]] ", secretDataTitle, s.path, mode))
+
+	row, _ := s.GetSelection()
+	s.Clear()
+	for i, h := range []string{"KEY", "VALUE"} {
+		s.SetCell(0, i, tview.NewTableCell(h).SetTextColor(tcell.ColorAqua).SetSelectable(false).SetAttributes(tcell.AttrBold))
+	}
+	for i, k := range s.keys {
+		s.SetCell(i+1, 0, tview.NewTableCell(tview.Escape(k)).SetTextColor(tcell.ColorFuchsia))
+		s.SetCell(i+1, 1, tview.NewTableCell(tview.Escape(secretValue(s.data[k], s.decoded, s.reveal))).SetExpansion(1))
+	}
+	if row < 1 {
+		row = 1
+	}
+	s.Select(row, 0)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func secretValue(v []byte, decoded, reveal bool) string {
+	if !reveal {
+		return secretMask
+	}
+	if !decoded {
+		return base64.StdEncoding.EncodeToString(v)
+	}
+
+	return strings.ReplaceAll(string(v), "\n", "⏎")
+}
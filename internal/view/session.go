@@ -0,0 +1,179 @@
+package view
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/port"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/rs/zerolog/log"
+)
+
+// saveSession persists the navigation state of the current context.
+func (a *App) saveSession() {
+	a.sessions.Set(a.Config.K9s.CurrentContext, a.captureSession())
+	if err := a.sessions.Save(config.K9sSessionsFile); err != nil {
+		log.Error().Err(err).Msgf("Unable to save session")
+	}
+}
+
+// offerSession prompts to restore the last session of the current context.
+func (a *App) offerSession() {
+	ctx := a.Config.K9s.CurrentContext
+	ss, ok := a.sessions.For(ctx)
+	if !ok {
+		return
+	}
+	msg := fmt.Sprintf("Restore your previous session on context %q?", ctx)
+	dialog.ShowConfirm(a.Styles.Dialog(), a.Content.Pages, "Restore Session", msg, func() {
+		if err := a.restoreSession(ss); err != nil {
+			a.Flash().Err(err)
+		}
+	}, func() {})
+}
+
+// captureSession snapshots the workspaces views, log tails and port-forwards.
+func (a *App) captureSession() config.Session {
+	stacks, active := a.workspaces, a.activeWS
+	if stacks == nil {
+		stacks, active = []*PageStack{a.Content}, 0
+	}
+
+	var ss config.Session
+	for i, s := range stacks {
+		if s == nil {
+			continue
+		}
+		w, ok := workspaceState(s)
+		if !ok {
+			continue
+		}
+		if i == active {
+			ss.Active = len(ss.Workspaces)
+		}
+		ss.Workspaces = append(ss.Workspaces, w)
+	}
+	if a.factory == nil {
+		return ss
+	}
+	for _, f := range a.factory.Forwarders() {
+		pf, ok := f.(*dao.PortForwarder)
+		if !ok {
+			continue
+		}
+		t := pf.Tunnel()
+		ss.PortForwards = append(ss.PortForwards, config.SessionPortForward{
+			Path:          pf.Path(),
+			Container:     t.Container,
+			Address:       t.Address,
+			LocalPort:     t.LocalPort,
+			ContainerPort: t.ContainerPort,
+		})
+	}
+	sort.Slice(ss.PortForwards, func(i, j int) bool {
+		return ss.PortForwards[i].LocalPort < ss.PortForwards[j].LocalPort
+	})
+
+	return ss
+}
+
+// workspaceState captures the top most resource view of a stack and the log
+// tail opened on top of it if any.
+func workspaceState(s *PageStack) (config.SessionWorkspace, bool) {
+	var w config.SessionWorkspace
+	cc := s.Stack.Peek()
+	for i := len(cc) - 1; i >= 0; i-- {
+		switch v := cc[i].(type) {
+		case *Log:
+			if w.Log == nil {
+				m := v.GetModel()
+				w.Log = &config.SessionLog{
+					Command:   m.GVR().String(),
+					Path:      m.GetPath(),
+					Container: m.GetContainer(),
+				}
+			}
+		case ResourceViewer:
+			w.SavedView = viewState(v)
+			return w, true
+		}
+	}
+
+	return w, false
+}
+
+// restoreSession reopens a session workspaces, log tails and port-forwards.
+func (a *App) restoreSession(ss config.Session) error {
+	for i, w := range ss.Workspaces {
+		if i >= maxWorkspaces {
+			break
+		}
+		if err := a.switchWorkspace(i); err != nil {
+			return err
+		}
+		if err := a.applyView(w.SavedView); err != nil {
+			return err
+		}
+		if w.Log != nil {
+			a.restoreLog(*w.Log)
+		}
+	}
+	if len(ss.Workspaces) > 1 {
+		if err := a.switchWorkspace(ss.Active); err != nil {
+			return err
+		}
+	}
+	for _, pf := range ss.PortForwards {
+		if err := a.restoreForward(pf); err != nil {
+			a.Flash().Errf("PortForward %s:%s failed -- %s", pf.Path, pf.LocalPort, err)
+		}
+	}
+
+	return nil
+}
+
+func (a *App) restoreLog(l config.SessionLog) {
+	cfg := a.Config.K9s.Logger
+	opts := dao.LogOptions{
+		Path:          l.Path,
+		Container:     l.Container,
+		Lines:         int64(cfg.TailCount),
+		ShowTimestamp: cfg.ShowTime,
+		AllContainers: l.Container == "",
+	}
+	if err := a.inject(NewLog(client.NewGVR(l.Command), &opts), false); err != nil {
+		a.Flash().Err(err)
+	}
+}
+
+func (a *App) restoreForward(s config.SessionPortForward) error {
+	pt := port.NewPortTunnel(s.Address, s.Container, s.LocalPort, s.ContainerPort)
+	if err := (port.PortTunnels{pt}).CheckAvailable(); err != nil {
+		return err
+	}
+	if _, ok := a.factory.ForwarderFor(dao.PortForwardID(s.Path, s.Container, pt.PortMap())); ok {
+		return nil
+	}
+	pf := dao.NewPortForwarder(a.factory)
+	fwd, err := pf.Start(s.Path, pt)
+	if err != nil {
+		return err
+	}
+	go func() {
+		a.factory.AddForwarder(pf)
+		pf.SetActive(true)
+		if err := fwd.ForwardPorts(); err != nil {
+			a.Flash().Err(err)
+			return
+		}
+		a.QueueUpdateDraw(func() {
+			a.factory.DeleteForwarder(pf.FQN())
+			pf.SetActive(false)
+		})
+	}()
+
+	return nil
+}
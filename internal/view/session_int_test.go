@@ -0,0 +1,26 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWorkspaceStateNoView(t *testing.T) {
+	s := NewPageStack()
+	_, ok := workspaceState(s)
+	assert.False(t, ok)
+
+	s.Stack.Push(NewLog(client.NewGVR("v1/pods"), &dao.LogOptions{Path: "default/p1"}))
+	_, ok = workspaceState(s)
+	assert.False(t, ok)
+}
+
+func TestCaptureSessionEmpty(t *testing.T) {
+	a := NewApp(config.NewConfig(nil))
+
+	assert.True(t, a.captureSession().IsEmpty())
+}
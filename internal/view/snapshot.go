@@ -0,0 +1,123 @@
+package view
+
+import (
+	"fmt"
+	"html"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/ui"
+)
+
+const (
+	snapshotHTML = "html"
+	snapshotANSI = "ansi"
+	snapshotAll  = "all"
+)
+
+// snapshotCmd dumps the rendered screen as styled html or ansi text. With
+// all, the top view of the other workspaces is also dumped.
+func (a *App) snapshotCmd(args []string) error {
+	format, all := snapshotHTML, false
+	for _, arg := range args {
+		switch arg = strings.ToLower(arg); arg {
+		case snapshotHTML, snapshotANSI:
+			format = arg
+		case snapshotAll:
+			all = true
+		default:
+			return fmt.Errorf("invalid snapshot option %q. Must be html, ansi or all", arg)
+		}
+	}
+
+	_, _, w, h := a.Main.GetRect()
+	if w <= 0 || h <= 0 {
+		return fmt.Errorf("nothing to snapshot")
+	}
+	title := "screen"
+	if top := a.Content.Top(); top != nil {
+		title = top.Name()
+	}
+	s, err := ui.CaptureView(title, a.Main, w, h)
+	if err != nil {
+		return err
+	}
+	ss := []*ui.Snapshot{s}
+	if all {
+		for i, ws := range a.workspaces {
+			if ws == nil || i == a.activeWS || ws.Top() == nil {
+				continue
+			}
+			top := ws.Top()
+			s, err := ui.CaptureView(fmt.Sprintf("workspace %d %s", i+1, top.Name()), top, w, h)
+			if err != nil {
+				return err
+			}
+			ss = append(ss, s)
+		}
+	}
+	for _, s := range ss {
+		s.Fg, s.Bg = a.Styles.FgColor(), a.Styles.BgColor()
+	}
+
+	dir := filepath.Join(a.Config.K9s.GetScreenDumpDir(), a.Config.K9s.CurrentContextDir())
+	path, err := saveSnapshots(dir, format, time.Now(), ss)
+	if err != nil {
+		return err
+	}
+	a.Flash().Infof("Snapshot saved to %s", path)
+
+	return nil
+}
+
+// saveSnapshots writes the snapshots to a directory. An html index page
+// linking all snapshots is generated when multiple views are dumped.
+// Returns the path of the index or of the single snapshot.
+func saveSnapshots(dir, format string, at time.Time, ss []*ui.Snapshot) (string, error) {
+	if err := ensureDir(dir); err != nil {
+		return "", err
+	}
+	ext := "html"
+	if format == snapshotANSI {
+		ext = "ans"
+	}
+	prefix := fmt.Sprintf("snapshot-%d", at.UnixNano())
+
+	files := make([]string, 0, len(ss))
+	for i, s := range ss {
+		name := fmt.Sprintf("%s-%d-%s.%s", prefix, i+1, config.SanitizeFilename(strings.ReplaceAll(s.Title, " ", "-")), ext)
+		data := s.HTML()
+		if format == snapshotANSI {
+			data = s.ANSI()
+		}
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(data), 0600); err != nil {
+			return "", err
+		}
+		files = append(files, name)
+	}
+	if len(files) == 1 {
+		return filepath.Join(dir, files[0]), nil
+	}
+
+	index := filepath.Join(dir, prefix+"-index.html")
+	if err := os.WriteFile(index, []byte(snapshotIndex(at, ss, files)), 0600); err != nil {
+		return "", err
+	}
+
+	return index, nil
+}
+
+func snapshotIndex(at time.Time, ss []*ui.Snapshot, files []string) string {
+	var b strings.Builder
+	title := "K9s Snapshot " + at.Format(time.RFC3339)
+	fmt.Fprintf(&b, "<!DOCTYPE html>\n<html>\n<head>\n<meta charset=\"utf-8\">\n<title>%s</title>\n</head>\n<body>\n<h1>%s</h1>\n<ol>\n", title, title)
+	for i, f := range files {
+		fmt.Fprintf(&b, "<li><a href=\"%s\">%s</a></li>\n", html.EscapeString(f), html.EscapeString(ss[i].Title))
+	}
+	b.WriteString("</ol>\n</body>\n</html>\n")
+
+	return b.String()
+}
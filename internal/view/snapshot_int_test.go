@@ -0,0 +1,64 @@
+package view
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSaveSnapshots(t *testing.T) {
+	at := time.Unix(0, 10)
+	s1, err := ui.CaptureView("pods", tview.NewTextView().SetText("fred"), 5, 1)
+	assert.Nil(t, err)
+	s2, err := ui.CaptureView("workspace 2 deploy", tview.NewTextView().SetText("blee"), 5, 1)
+	assert.Nil(t, err)
+
+	uu := map[string]struct {
+		format string
+		ss     []*ui.Snapshot
+		e      string
+		ff     []string
+	}{
+		"single": {
+			format: snapshotHTML,
+			ss:     []*ui.Snapshot{s1},
+			e:      "snapshot-10-1-pods.html",
+			ff:     []string{"snapshot-10-1-pods.html"},
+		},
+		"ansi": {
+			format: snapshotANSI,
+			ss:     []*ui.Snapshot{s1},
+			e:      "snapshot-10-1-pods.ans",
+			ff:     []string{"snapshot-10-1-pods.ans"},
+		},
+		"multi": {
+			format: snapshotHTML,
+			ss:     []*ui.Snapshot{s1, s2},
+			e:      "snapshot-10-index.html",
+			ff:     []string{"snapshot-10-1-pods.html", "snapshot-10-2-workspace-2-deploy.html", "snapshot-10-index.html"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			dir := t.TempDir()
+			path, err := saveSnapshots(dir, u.format, at, u.ss)
+			assert.Nil(t, err)
+			assert.Equal(t, filepath.Join(dir, u.e), path)
+
+			ee, err := os.ReadDir(dir)
+			assert.Nil(t, err)
+			ff := make([]string, 0, len(ee))
+			for _, e := range ee {
+				ff = append(ff, e.Name())
+			}
+			assert.Equal(t, u.ff, ff)
+		})
+	}
+}
@@ -0,0 +1,186 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	"github.com/rs/zerolog/log"
+)
+
+const sniffKey = "sniff"
+
+// SniffExtender provides for capturing pods network traffic.
+type SniffExtender struct {
+	ResourceViewer
+
+	capture *sniffCapture
+}
+
+// sniffCapture tracks an inflight capture.
+type sniffCapture struct {
+	cancel context.CancelFunc
+}
+
+// NewSniffExtender returns a new extender.
+func NewSniffExtender(r ResourceViewer) ResourceViewer {
+	s := SniffExtender{ResourceViewer: r}
+	s.AddBindKeysFn(s.bindKeys)
+
+	return &s
+}
+
+func (s *SniffExtender) bindKeys(aa ui.KeyActions) {
+	if s.App().Config.K9s.IsReadOnly() {
+		return
+	}
+	aa.Add(ui.KeyActions{
+		ui.KeyW: ui.NewKeyAction("Sniff Start/Stop", s.sniffCmd, true),
+	})
+}
+
+func (s *SniffExtender) sniffCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if s.capture != nil {
+		s.capture.cancel()
+		s.capture = nil
+		return nil
+	}
+
+	path := s.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	if !podIsRunning(s.App().factory, path) {
+		s.App().Flash().Errf("%s is not in a running state", path)
+		return nil
+	}
+	s.showSniffDialog(path)
+
+	return nil
+}
+
+func (s *SniffExtender) showSniffDialog(path string) {
+	cfg := s.App().Config.K9s.SnifferConfig()
+	_, n := client.Namespaced(path)
+	image, iface, filter := cfg.Image, cfg.Interface, ""
+	file := filepath.Join(cfg.Dir, fmt.Sprintf("%s-%d.pcap", n, time.Now().Unix()))
+
+	f := newStyledForm(s.App().Styles.Dialog())
+	f.AddInputField("Image:", image, 0, nil, func(changed string) {
+		image = strings.TrimSpace(changed)
+	})
+	f.AddInputField("Interface:", iface, 0, nil, func(changed string) {
+		iface = strings.TrimSpace(changed)
+	})
+	f.AddInputField("BPF Filter:", filter, 0, nil, func(changed string) {
+		filter = strings.TrimSpace(changed)
+	})
+	f.AddInputField("Output:", file, 0, nil, func(changed string) {
+		file = strings.TrimSpace(changed)
+	})
+	f.AddButton("OK", func() {
+		s.App().Content.RemovePage(sniffKey)
+		opts := dao.DebugOptions{
+			Image:        image,
+			Command:      []string{"sleep", "infinity"},
+			Capabilities: dao.SniffCapabilities,
+		}
+		s.sniffer(path, opts, func(co string) {
+			if err := s.startSniff(path, co, file, dao.SniffOptions{Interface: iface, Filter: filter}); err != nil {
+				s.App().Flash().Err(err)
+			}
+		})
+	})
+	f.AddButton("Cancel", func() {
+		s.App().Content.RemovePage(sniffKey)
+	})
+
+	modal := tview.NewModalForm("<Sniff>", f)
+	modal.SetText(fmt.Sprintf("Capture traffic on pod %s", path))
+	modal.SetDoneFunc(func(int, string) {
+		s.App().Content.RemovePage(sniffKey)
+	})
+	s.App().Content.AddPage(sniffKey, modal, false, false)
+	s.App().Content.ShowPage(sniffKey)
+}
+
+func (s *SniffExtender) startSniff(path, co, file string, opts dao.SniffOptions) error {
+	if err := os.MkdirAll(filepath.Dir(file), 0744); err != nil {
+		return err
+	}
+	out, err := os.Create(file)
+	if err != nil {
+		return err
+	}
+
+	var po dao.Pod
+	po.Init(s.App().factory, client.NewGVR("v1/pods"))
+	ctx, cancel := context.WithCancel(context.Background())
+	capture := sniffCapture{cancel: cancel}
+	s.capture = &capture
+	s.App().Flash().Infof("Capturing %s traffic to %s. Press `w` to stop...", path, file)
+	go func() {
+		err := po.Sniff(ctx, path, co, opts, out)
+		stopped := ctx.Err() != nil
+		cancel()
+		if e := out.Close(); e != nil {
+			log.Error().Err(e).Msgf("closing capture %s", file)
+		}
+		s.App().QueueUpdateDraw(func() {
+			if s.capture == &capture {
+				s.capture = nil
+			}
+			if err != nil && !stopped {
+				s.App().Flash().Errf("Capture failed: %s", err)
+				return
+			}
+			s.App().Flash().Infof("Capture saved to %s", file)
+			s.analyze(file)
+		})
+	}()
+
+	return nil
+}
+
+// sniffer reuses a running sniffer container on the pod or launches a new one.
+func (s *SniffExtender) sniffer(path string, opts dao.DebugOptions, done func(co string)) {
+	var po dao.Pod
+	po.Init(s.App().factory, client.NewGVR("v1/pods"))
+	co, err := po.SnifferContainer(path, opts.Image)
+	if err != nil {
+		s.App().Flash().Err(err)
+		return
+	}
+	if co != "" {
+		done(co)
+		return
+	}
+	launchDebugContainer(s.App(), path, opts, done)
+}
+
+func (s *SniffExtender) analyze(file string) {
+	analyzer := s.App().Config.K9s.SnifferConfig().Analyzer
+	if analyzer == "" {
+		return
+	}
+	args := strings.Fields(analyzer)
+	cmd := exec.Command(args[0], append(args[1:], file)...)
+	if err := cmd.Start(); err != nil {
+		s.App().Flash().Errf("Unable to launch analyzer %q: %s", analyzer, err)
+		return
+	}
+	go func() {
+		if err := cmd.Wait(); err != nil {
+			log.Warn().Err(err).Msgf("Analyzer %q exited", analyzer)
+		}
+	}()
+}
@@ -0,0 +1,389 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/watch"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+)
+
+const splitTitle = "Split"
+
+// Split presents resources of two cluster contexts side by side.
+type Split struct {
+	*tview.Flex
+
+	app     *App
+	context string
+	cmd     string
+	actions ui.KeyActions
+	panes   []*splitPane
+	active  int
+}
+
+// NewSplit returns a new viewer comparing the current context with another one.
+func NewSplit(app *App, context, cmd string) *Split {
+	return &Split{
+		Flex:    tview.NewFlex(),
+		app:     app,
+		context: context,
+		cmd:     cmd,
+		actions: make(ui.KeyActions),
+	}
+}
+
+// Init initializes the viewer.
+func (s *Split) Init(ctx context.Context) error {
+	gvr, ns, err := s.resolve(s.cmd)
+	if err != nil {
+		return err
+	}
+	f, err := dialContext(s.app, s.context)
+	if err != nil {
+		return err
+	}
+	s.panes = []*splitPane{
+		newSplitPane(s.app, s.app.Config.K9s.CurrentContext, s.app.factory, false),
+		newSplitPane(s.app, s.context, f, true),
+	}
+	for _, p := range s.panes {
+		if err := p.setResource(gvr, ns); err != nil {
+			return err
+		}
+	}
+	s.SetDirection(tview.FlexColumn)
+	s.layout()
+	s.bindKeys()
+	s.SetInputCapture(s.keyboard)
+
+	return nil
+}
+
+// Name returns the component name.
+func (s *Split) Name() string { return splitTitle }
+
+// Start starts the panes updates.
+func (s *Split) Start() {
+	for _, p := range s.panes {
+		p.start()
+	}
+	s.focus()
+}
+
+// Stop terminates the panes updates.
+func (s *Split) Stop() {
+	for _, p := range s.panes {
+		p.stop()
+	}
+}
+
+// Focus delegates focus to the active pane.
+func (s *Split) Focus(delegate func(p tview.Primitive)) {
+	if len(s.panes) == 0 {
+		return
+	}
+	delegate(s.panes[s.active].table)
+}
+
+// Hints returns menu hints.
+func (s *Split) Hints() model.MenuHints {
+	return s.actions.Hints()
+}
+
+// ExtraHints returns additional hints.
+func (s *Split) ExtraHints() map[string]string {
+	return nil
+}
+
+// InCmdMode checks if prompt is active.
+func (s *Split) InCmdMode() bool {
+	for _, p := range s.panes {
+		if p.cmdBuff.InCmdMode() || p.table.CmdBuff().InCmdMode() {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *Split) bindKeys() {
+	s.actions.Add(ui.KeyActions{
+		tcell.KeyTab:    ui.NewKeyAction("Switch Pane", s.switchCmd, true),
+		ui.KeyC:         ui.NewKeyAction("Command", s.commandCmd, true),
+		tcell.KeyEnter:  ui.NewKeyAction("YAML", s.yamlCmd, true),
+		tcell.KeyEscape: ui.NewKeyAction("Back", s.app.PrevCmd, false),
+	})
+}
+
+func (s *Split) keyboard(evt *tcell.EventKey) *tcell.EventKey {
+	if s.InCmdMode() {
+		return evt
+	}
+	if a, ok := s.actions[ui.AsKey(evt)]; ok && !s.app.Content.IsTopDialog() {
+		return a.Action(evt)
+	}
+
+	return evt
+}
+
+func (s *Split) layout() {
+	s.Clear()
+	for _, p := range s.panes {
+		s.AddItem(p.table, 0, 1, false)
+	}
+}
+
+func (s *Split) focus() {
+	if len(s.panes) == 0 {
+		return
+	}
+	s.app.SetFocus(s.panes[s.active].table)
+}
+
+func (s *Split) switchCmd(*tcell.EventKey) *tcell.EventKey {
+	s.active = (s.active + 1) % len(s.panes)
+	s.focus()
+
+	return nil
+}
+
+func (s *Split) commandCmd(*tcell.EventKey) *tcell.EventKey {
+	p := s.panes[s.active]
+	p.onCommand = func(cmd string) {
+		gvr, ns, err := s.resolve(cmd)
+		if err != nil {
+			s.app.Flash().Err(err)
+			return
+		}
+		p.stop()
+		if err := p.setResource(gvr, ns); err != nil {
+			s.app.Flash().Err(err)
+			return
+		}
+		s.layout()
+		p.start()
+		s.focus()
+	}
+	s.app.ResetPrompt(p.cmdBuff)
+	p.cmdBuff.ClearText(true)
+
+	return nil
+}
+
+func (s *Split) yamlCmd(evt *tcell.EventKey) *tcell.EventKey {
+	p := s.panes[s.active]
+	path := p.table.GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	raw, err := p.yaml(path)
+	if err != nil {
+		s.app.Flash().Err(err)
+		return nil
+	}
+	details := NewDetails(s.app, "YAML", p.context+":"+path, true).Update(raw)
+	if err := s.app.inject(details, false); err != nil {
+		s.app.Flash().Err(err)
+	}
+
+	return nil
+}
+
+// resolve converts a command into a resource and namespace.
+func (s *Split) resolve(cmd string) (client.GVR, string, error) {
+	tokens := strings.Fields(cmd)
+	if len(tokens) == 0 {
+		tokens = []string{"pod"}
+	}
+	gvr, ok := s.app.command.alias.AsGVR(tokens[0])
+	if !ok {
+		return client.GVR{}, "", fmt.Errorf("`%s` command not found", tokens[0])
+	}
+	ns := s.app.Config.ActiveNamespace()
+	if len(tokens) > 1 {
+		ns = tokens[1]
+	}
+
+	return gvr, client.CleanseNamespace(ns), nil
+}
+
+// dialContext connects to a given context with its own informers factory.
+func dialContext(app *App, name string) (*watch.Factory, error) {
+	cfg := client.NewConfig(app.Conn().Config().Flags())
+	if err := cfg.SwitchContext(name); err != nil {
+		return nil, err
+	}
+	conn, err := client.InitConnection(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if !conn.CheckConnectivity() {
+		return nil, fmt.Errorf("unable to connect to context %q", name)
+	}
+
+	return watch.NewFactory(conn), nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// splitPane tracks a context resources table.
+type splitPane struct {
+	app       *App
+	context   string
+	factory   *watch.Factory
+	owned     bool
+	table     *Table
+	cmdBuff   *model.FishBuff
+	onCommand func(string)
+	cancelFn  context.CancelFunc
+	mx        sync.Mutex
+}
+
+func newSplitPane(app *App, context string, f *watch.Factory, owned bool) *splitPane {
+	p := splitPane{
+		app:     app,
+		context: context,
+		factory: f,
+		owned:   owned,
+		cmdBuff: model.NewFishBuff(':', model.CommandBuffer),
+	}
+	p.cmdBuff.AddListener(&p)
+
+	return &p
+}
+
+func (p *splitPane) setResource(gvr client.GVR, ns string) error {
+	if _, err := dao.MetaAccess.MetaFor(gvr); err != nil {
+		return err
+	}
+	m := model.NewTable(gvr)
+	m.Isolate()
+	m.SetNamespace(ns)
+	m.SetRefreshRate(p.refreshRate())
+
+	t := NewTable(gvr)
+	t.SetModel(m)
+	if err := t.Init(context.WithValue(context.Background(), internal.KeyApp, p.app)); err != nil {
+		return err
+	}
+	colorerFn := render.DefaultColorer
+	if r, ok := model.Registry[gvr.String()]; ok {
+		colorerFn = r.Renderer.ColorerFunc()
+	}
+	t.SetColorerFn(colorerFn)
+	t.Extras = p.context + ":" + ns
+	if client.IsAllNamespaces(ns) {
+		t.Extras = p.context + ":" + client.NamespaceAll
+	}
+	p.table = t
+
+	return nil
+}
+
+func (p *splitPane) refreshRate() time.Duration {
+	return time.Duration(p.app.Config.K9s.GetRefreshRate()) * time.Second
+}
+
+func (p *splitPane) start() {
+	p.stop()
+	if p.owned {
+		p.factory.Start(p.table.GetModel().GetNamespace())
+	}
+	p.table.Start()
+	p.table.GetModel().AddListener(p)
+
+	ctx := context.WithValue(context.Background(), internal.KeyFactory, p.factory)
+	ctx = context.WithValue(ctx, internal.KeyGVR, p.table.GVR().String())
+	ctx = context.WithValue(ctx, internal.KeyWithMetrics, false)
+	p.mx.Lock()
+	ctx, p.cancelFn = context.WithCancel(ctx)
+	p.mx.Unlock()
+	if err := p.table.GetModel().Watch(ctx); err != nil {
+		p.app.Flash().Err(fmt.Errorf("Watcher failed for %s on %s -- %w", p.table.GVR(), p.context, err))
+	}
+}
+
+func (p *splitPane) stop() {
+	p.mx.Lock()
+	{
+		if p.cancelFn != nil {
+			p.cancelFn()
+			p.cancelFn = nil
+		}
+	}
+	p.mx.Unlock()
+	p.table.GetModel().RemoveListener(p)
+	p.table.Stop()
+	if p.owned {
+		p.factory.Terminate()
+	}
+}
+
+func (p *splitPane) yaml(path string) (string, error) {
+	acc, err := dao.AccessorFor(p.factory, p.table.GVR())
+	if err != nil {
+		return "", err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), p.factory.Client().Config().CallTimeout())
+	defer cancel()
+	o, err := acc.Get(ctx, path)
+	if err != nil {
+		return "", err
+	}
+
+	return dao.ToYAML(o, false)
+}
+
+// TableDataChanged notifies view new data is available.
+func (p *splitPane) TableDataChanged(data *render.TableData) {
+	p.mx.Lock()
+	cancel := p.cancelFn
+	p.mx.Unlock()
+	if cancel == nil || !p.app.IsRunning() {
+		return
+	}
+
+	p.app.QueueUpdateDraw(func() {
+		p.table.Update(data, false)
+	})
+}
+
+// TableLoadFailed notifies view something went south.
+func (p *splitPane) TableLoadFailed(err error) {
+	p.app.QueueUpdateDraw(func() {
+		p.app.Flash().Errf("%s -- %s", p.context, err)
+	})
+}
+
+// BufferChanged indicates the buffer was changed.
+func (p *splitPane) BufferChanged(_, _ string) {}
+
+// BufferCompleted indicates input was accepted.
+func (p *splitPane) BufferCompleted(text, _ string) {
+	if p.onCommand == nil || strings.TrimSpace(text) == "" {
+		return
+	}
+	p.app.QueueUpdateDraw(func() {
+		p.onCommand(text)
+	})
+}
+
+// BufferActive indicates the buff activity changed.
+func (p *splitPane) BufferActive(state bool, k model.BufferKind) {
+	p.app.BufferActive(state, k)
+	if !state {
+		p.app.SetFocus(p.table)
+	}
+}
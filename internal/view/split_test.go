@@ -0,0 +1,17 @@
+package view_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/view"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitNew(t *testing.T) {
+	s := view.NewSplit(view.NewApp(config.NewConfig(nil)), "fred", "dp")
+
+	assert.Equal(t, "Split", s.Name())
+	assert.False(t, s.InCmdMode())
+	assert.Equal(t, 0, len(s.Hints()))
+}
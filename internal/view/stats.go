@@ -0,0 +1,50 @@
+package view
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+// Stats represents the api calls telemetry viewer.
+type Stats struct {
+	ResourceViewer
+}
+
+// NewStats returns a new api calls telemetry view.
+func NewStats(gvr client.GVR) ResourceViewer {
+	s := Stats{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	s.GetTable().SetBorderFocusColor(tcell.ColorMediumSpringGreen)
+	s.GetTable().SetSelectedStyle(tcell.StyleDefault.Foreground(tcell.ColorWhite).Background(tcell.ColorMediumSpringGreen).Attributes(tcell.AttrNone))
+	s.GetTable().SetSortCol("LATENCY(ms)", false)
+	s.AddBindKeysFn(s.bindKeys)
+
+	return &s
+}
+
+// Init initializes the view.
+func (s *Stats) Init(ctx context.Context) error {
+	if err := s.ResourceViewer.Init(ctx); err != nil {
+		return err
+	}
+	s.GetTable().GetModel().SetNamespace(client.AllNamespaces)
+
+	return nil
+}
+
+func (s *Stats) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Delete(tcell.KeyCtrlW, tcell.KeyCtrlL, tcell.KeyCtrlZ, tcell.KeyCtrlD)
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftV: ui.NewKeyAction("Sort Verb", s.GetTable().SortColCmd("VERB", true), false),
+		ui.KeyShiftG: ui.NewKeyAction("Sort GVR", s.GetTable().SortColCmd("GVR", true), false),
+		ui.KeyShiftC: ui.NewKeyAction("Sort Calls", s.GetTable().SortColCmd("CALLS", false), false),
+		ui.KeyShiftE: ui.NewKeyAction("Sort Errors", s.GetTable().SortColCmd("ERRORS", false), false),
+		ui.KeyShiftT: ui.NewKeyAction("Sort Throttled", s.GetTable().SortColCmd("THROTTLED", false), false),
+		ui.KeyShiftL: ui.NewKeyAction("Sort Latency", s.GetTable().SortColCmd("LATENCY(ms)", false), false),
+	})
+}
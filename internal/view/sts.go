@@ -1,12 +1,19 @@
 package view
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"strconv"
 
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tcell/v2"
 	appsv1 "k8s.io/api/apps/v1"
+	"sigs.k8s.io/yaml"
 )
 
 // StatefulSet represents a statefulset viewer.
@@ -18,10 +25,16 @@ type StatefulSet struct {
 func NewStatefulSet(gvr client.GVR) ResourceViewer {
 	var s StatefulSet
 	s.ResourceViewer = NewPortForwardExtender(
-		NewRestartExtender(
-			NewScaleExtender(
-				NewImageExtender(
-					NewLogsExtender(NewBrowser(gvr), s.logOptions),
+		NewRolloutExtender(
+			NewRestartExtender(
+				NewScaleExtender(
+					NewEnvExtender(
+						NewResourcesExtender(
+							NewImageExtender(
+								NewLogsExtender(NewBrowser(gvr), s.logOptions),
+							),
+						),
+					),
 				),
 			),
 		),
@@ -78,7 +91,77 @@ func (s *StatefulSet) logOptions(prev bool) (*dao.LogOptions, error) {
 func (s *StatefulSet) bindKeys(aa ui.KeyActions) {
 	aa.Add(ui.KeyActions{
 		ui.KeyShiftR: ui.NewKeyAction("Sort Ready", s.GetTable().SortColCmd(readyCol, true), false),
+		ui.KeyShiftO: ui.NewKeyAction("Ordinals", s.ordinalsCmd, true),
 	})
+	if s.App().Config.K9s.IsReadOnly() {
+		return
+	}
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftK: ui.NewKeyAction("Restart Ordinal", s.restartOrdinalCmd, true),
+	})
+}
+
+func (s *StatefulSet) ordinalsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := s.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	var sts dao.StatefulSet
+	sts.Init(s.App().factory, s.GVR())
+	oo, err := sts.Ordinals(path)
+	if err != nil {
+		s.App().Flash().Err(err)
+		return nil
+	}
+	raw, err := yaml.Marshal(oo)
+	if err != nil {
+		s.App().Flash().Err(err)
+		return nil
+	}
+	details := NewDetails(s.App(), "Ordinals", path, true).Update(string(raw))
+	if err := s.App().inject(details, false); err != nil {
+		s.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (s *StatefulSet) restartOrdinalCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := s.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	ii := []config.PluginInput{
+		{Name: "ORDINAL", Prompt: "Ordinal", Pattern: `^\d+$`, Required: true},
+	}
+	ShowInputs(s.App(), "Restart Ordinal", path, ii, Env{}, func(vals map[string]string) {
+		ordinal, err := strconv.Atoi(vals["ORDINAL"])
+		if err != nil {
+			s.App().Flash().Err(err)
+			return
+		}
+		s.restartOrdinal(path, ordinal)
+	})
+
+	return nil
+}
+
+func (s *StatefulSet) restartOrdinal(path string, ordinal int) {
+	_, n := client.Namespaced(path)
+	msg := fmt.Sprintf("Restart ordinal %d by deleting pod %s-%d?", ordinal, n, ordinal)
+	dialog.ShowConfirm(s.App().Styles.Dialog(), s.App().Content.Pages, "Confirm Restart", msg, func() {
+		var sts dao.StatefulSet
+		sts.Init(s.App().factory, s.GVR())
+		ctx, cancel := context.WithTimeout(context.Background(), s.App().Conn().Config().CallTimeout())
+		defer cancel()
+		if err := sts.RestartOrdinal(ctx, path, ordinal); err != nil {
+			s.App().Flash().Err(err)
+			return
+		}
+		s.App().Flash().Infof("Ordinal %d of %s restarting...", ordinal, path)
+	}, func() {})
 }
 
 func (s *StatefulSet) showPods(app *App, _ ui.Tabular, _, path string) {
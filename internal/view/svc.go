@@ -32,7 +32,7 @@ type Service struct {
 func NewService(gvr client.GVR) ResourceViewer {
 	s := Service{
 		ResourceViewer: NewPortForwardExtender(
-			NewLogsExtender(NewBrowser(gvr), nil),
+			NewLogsExtender(NewXrayExtender(NewBrowser(gvr)), nil),
 		),
 	}
 	s.AddBindKeysFn(s.bindKeys)
@@ -46,10 +46,21 @@ func NewService(gvr client.GVR) ResourceViewer {
 func (s *Service) bindKeys(aa ui.KeyActions) {
 	aa.Add(ui.KeyActions{
 		tcell.KeyCtrlL: ui.NewKeyAction("Bench Run/Stop", s.toggleBenchCmd, true),
+		ui.KeyShiftE:   ui.NewKeyAction("Endpoints", s.endpointsCmd, true),
 		ui.KeyShiftT:   ui.NewKeyAction("Sort Type", s.GetTable().SortColCmd("TYPE", true), false),
 	})
 }
 
+func (s *Service) endpointsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := s.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	showServiceEndpoints(s.App(), path)
+
+	return nil
+}
+
 func (s *Service) showPods(a *App, _ ui.Tabular, gvr, path string) {
 	var res dao.Service
 	res.Init(a.factory, s.GVR())
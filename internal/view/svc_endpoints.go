@@ -0,0 +1,82 @@
+package view
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+const (
+	svcEndpointsGVR = "svcendpoints"
+	svcEndpointsPod = 3
+)
+
+// ServiceEndpoints represents a service endpoints readiness viewer.
+type ServiceEndpoints struct {
+	ResourceViewer
+}
+
+// NewServiceEndpoints returns a new service endpoints view.
+func NewServiceEndpoints(gvr client.GVR) ResourceViewer {
+	s := ServiceEndpoints{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	s.GetTable().SetBorderFocusColor(tcell.ColorMediumSpringGreen)
+	s.GetTable().SetSelectedStyle(tcell.StyleDefault.Foreground(tcell.ColorWhite).Background(tcell.ColorMediumSpringGreen).Attributes(tcell.AttrNone))
+	s.GetTable().SetSortCol("PORT", true)
+	s.AddBindKeysFn(s.bindKeys)
+
+	return &s
+}
+
+// Init initializes the view.
+func (s *ServiceEndpoints) Init(ctx context.Context) error {
+	if err := s.ResourceViewer.Init(ctx); err != nil {
+		return err
+	}
+	s.GetTable().GetModel().SetNamespace(client.AllNamespaces)
+
+	return nil
+}
+
+func (s *ServiceEndpoints) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Delete(tcell.KeyCtrlW, tcell.KeyCtrlL, tcell.KeyCtrlZ)
+	aa.Add(ui.KeyActions{
+		tcell.KeyEnter: ui.NewKeyAction("Goto Pod", s.gotoCmd, true),
+		ui.KeyShiftR:   ui.NewKeyAction("Sort Ready", s.GetTable().SortColCmd("READY", true), false),
+		ui.KeyShiftP:   ui.NewKeyAction("Sort Port", s.GetTable().SortColCmd("PORT", true), false),
+	})
+}
+
+func (s *ServiceEndpoints) gotoCmd(evt *tcell.EventKey) *tcell.EventKey {
+	row, _ := s.GetTable().GetSelection()
+	if row == 0 {
+		return evt
+	}
+	path := ui.TrimCell(s.GetTable().SelectTable, row, svcEndpointsPod)
+	if path == "" {
+		s.App().Flash().Warn("No pod backs this endpoint")
+		return nil
+	}
+	s.App().gotoResource("pods", path, false)
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func showServiceEndpoints(app *App, path string) {
+	v := NewServiceEndpoints(client.NewGVR(svcEndpointsGVR))
+	v.GetTable().Extras = path
+	v.SetContextFn(func(ctx context.Context) context.Context {
+		return context.WithValue(ctx, internal.KeyPath, path)
+	})
+	if err := app.inject(v, false); err != nil {
+		app.Flash().Err(err)
+	}
+}
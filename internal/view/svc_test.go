@@ -74,6 +74,84 @@ func init() {
 		Verbs:        []string{"get", "list", "watch", "delete"},
 		Categories:   []string{"k9s"},
 	})
+	dao.MetaAccess.RegisterMeta("owners", metav1.APIResource{
+		Name:         "owners",
+		SingularName: "owner",
+		Namespaced:   true,
+		Kind:         "Owners",
+		Verbs:        []string{"get", "list", "watch", "delete"},
+		Categories:   []string{"k9s"},
+	})
+	dao.MetaAccess.RegisterMeta("svcendpoints", metav1.APIResource{
+		Name:         "svcendpoints",
+		SingularName: "svcendpoint",
+		Namespaced:   true,
+		Kind:         "ServiceEndpoints",
+		Verbs:        []string{"get", "list", "watch", "delete"},
+		Categories:   []string{"k9s"},
+	})
+	dao.MetaAccess.RegisterMeta("ingroutes", metav1.APIResource{
+		Name:         "ingroutes",
+		SingularName: "ingroute",
+		Namespaced:   true,
+		Kind:         "IngressRoutes",
+		Verbs:        []string{"get", "list", "watch", "delete"},
+		Categories:   []string{"k9s"},
+	})
+	dao.MetaAccess.RegisterMeta("npsim", metav1.APIResource{
+		Name:         "npsim",
+		SingularName: "npsim",
+		Namespaced:   true,
+		Kind:         "NetworkPolicySimulation",
+		Verbs:        []string{"get", "list", "watch", "delete"},
+		Categories:   []string{"k9s"},
+	})
+	dao.MetaAccess.RegisterMeta("helmhistory", metav1.APIResource{
+		Name:         "helmhistory",
+		SingularName: "helmhistory",
+		Namespaced:   true,
+		Kind:         "HelmHistory",
+		Verbs:        []string{"get", "list", "watch", "delete"},
+		Categories:   []string{"k9s"},
+	})
+	dao.MetaAccess.RegisterMeta("podsched", metav1.APIResource{
+		Name:         "podsched",
+		SingularName: "podsched",
+		Namespaced:   true,
+		Kind:         "PodSchedule",
+		Verbs:        []string{"get", "list", "watch", "delete"},
+		Categories:   []string{"k9s"},
+	})
+	dao.MetaAccess.RegisterMeta("alerts", metav1.APIResource{
+		Name:         "alerts",
+		SingularName: "alert",
+		Namespaced:   true,
+		Kind:         "Alerts",
+		Verbs:        []string{"get", "list", "watch", "delete"},
+		Categories:   []string{"k9s"},
+	})
+	dao.MetaAccess.RegisterMeta("nsusage", metav1.APIResource{
+		Name:         "nsusage",
+		SingularName: "nsusage",
+		Kind:         "NamespaceUsage",
+		Verbs:        []string{"get", "list", "watch", "delete"},
+		Categories:   []string{"k9s"},
+	})
+	dao.MetaAccess.RegisterMeta("faults", metav1.APIResource{
+		Name:         "faults",
+		SingularName: "fault",
+		Kind:         "Faults",
+		Verbs:        []string{"get", "list", "watch", "delete"},
+		Categories:   []string{"k9s"},
+	})
+	dao.MetaAccess.RegisterMeta("audit", metav1.APIResource{
+		Name:         "audit",
+		SingularName: "audit",
+		Namespaced:   true,
+		Kind:         "Audit",
+		Verbs:        []string{"get", "list", "watch", "delete"},
+		Categories:   []string{"k9s"},
+	})
 	dao.MetaAccess.RegisterMeta("aliases", metav1.APIResource{
 		Name:         "aliases",
 		SingularName: "alias",
@@ -90,6 +168,14 @@ func init() {
 		Verbs:        []string{"get", "list", "watch", "delete"},
 		Categories:   []string{"k9s"},
 	})
+	dao.MetaAccess.RegisterMeta("top", metav1.APIResource{
+		Name:         "top",
+		SingularName: "top",
+		Namespaced:   true,
+		Kind:         "Top",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	})
 	dao.MetaAccess.RegisterMeta("contexts", metav1.APIResource{
 		Name:         "contexts",
 		SingularName: "context",
@@ -163,6 +249,22 @@ func init() {
 		Verbs:        []string{"get", "list", "watch", "delete"},
 		Categories:   []string{"k9s"},
 	})
+	dao.MetaAccess.RegisterMeta("networking.k8s.io/v1/ingresses", metav1.APIResource{
+		Name:         "ingresses",
+		SingularName: "ingress",
+		Namespaced:   true,
+		Kind:         "Ingresses",
+		Verbs:        []string{"get", "list", "watch", "delete"},
+		Categories:   []string{"k9s"},
+	})
+	dao.MetaAccess.RegisterMeta("networking.k8s.io/v1/networkpolicies", metav1.APIResource{
+		Name:         "networkpolicies",
+		SingularName: "networkpolicy",
+		Namespaced:   true,
+		Kind:         "NetworkPolicies",
+		Verbs:        []string{"get", "list", "watch", "delete"},
+		Categories:   []string{"k9s"},
+	})
 }
 
 func TestServiceNew(t *testing.T) {
@@ -170,5 +272,5 @@ func TestServiceNew(t *testing.T) {
 
 	assert.Nil(t, s.Init(makeCtx()))
 	assert.Equal(t, "Services", s.Name())
-	assert.Equal(t, 10, len(s.Hints()))
+	assert.Equal(t, 13, len(s.Hints()))
 }
@@ -2,6 +2,7 @@ package view
 
 import (
 	"context"
+	"sort"
 	"strings"
 	"time"
 
@@ -48,6 +49,9 @@ func (t *Table) Init(ctx context.Context) (err error) {
 	t.bindKeys()
 	t.GetModel().SetRefreshRate(time.Duration(t.app.Config.K9s.GetRefreshRate()) * time.Second)
 	t.CmdBuff().AddListener(t)
+	if t.app.Config.K9s.Accessible {
+		t.SetAnnounceFn(t.app.Flash().Info)
+	}
 
 	return nil
 }
@@ -55,21 +59,27 @@ func (t *Table) Init(ctx context.Context) (err error) {
 // HeaderIndex returns index of a given column or false if not found.
 func (t *Table) HeaderIndex(colName string) (int, bool) {
 	for i := 0; i < t.GetColumnCount(); i++ {
-		h := t.GetCell(0, i)
-		if h == nil {
-			continue
-		}
-		s := h.Text
-		if idx := strings.Index(s, "["); idx > 0 {
-			s = s[:idx]
-		}
-		if s == colName {
+		if t.columnName(i) == colName {
 			return i, true
 		}
 	}
 	return 0, false
 }
 
+// columnName returns a column name sans sort indicator.
+func (t *Table) columnName(col int) string {
+	h := t.GetCell(0, col)
+	if h == nil {
+		return ""
+	}
+	s := h.Text
+	if idx := strings.Index(s, "["); idx > 0 {
+		s = s[:idx]
+	}
+
+	return s
+}
+
 // SendKey sends an keyboard event (testing only!).
 func (t *Table) SendKey(evt *tcell.EventKey) {
 	t.app.Prompt().SendKey(evt)
@@ -118,6 +128,14 @@ func (t *Table) defaultEnv() Env {
 	env["RESOURCE_GROUP"] = t.GVR().G()
 	env["RESOURCE_VERSION"] = t.GVR().V()
 	env["RESOURCE_NAME"] = t.GVR().R()
+	sels := t.GetSelectedItems()
+	names := make([]string, 0, len(sels))
+	for _, s := range sels {
+		_, n := client.Namespaced(s)
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	env["SELECTIONS"] = strings.Join(names, " ")
 
 	return env
 }
@@ -176,6 +194,11 @@ func (t *Table) saveCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+// Export dumps the table as currently displayed to the screen dump directory.
+func (t *Table) Export(format string) (string, error) {
+	return exportTable(t.app.Config.K9s.GetScreenDumpDir(), t.app.Config.K9s.CurrentContextDir(), t.GVR().R(), t.Path, format, t.RenderedData())
+}
+
 func (t *Table) bindKeys() {
 	t.Actions().Add(ui.KeyActions{
 		ui.KeyHelp:             ui.NewKeyAction("Help", t.App().helpCmd, true),
@@ -186,6 +209,7 @@ func (t *Table) bindKeys() {
 		ui.KeySlash:            ui.NewSharedKeyAction("Filter Mode", t.activateCmd, false),
 		tcell.KeyCtrlZ:         ui.NewKeyAction("Toggle Faults", t.toggleFaultCmd, false),
 		tcell.KeyCtrlW:         ui.NewKeyAction("Toggle Wide", t.toggleWideCmd, false),
+		tcell.KeyCtrlO:         ui.NewKeyAction("Columns", t.columnsCmd, false),
 		ui.KeyShiftN:           ui.NewKeyAction("Sort Name", t.SortColCmd(nameCol, true), false),
 		ui.KeyShiftA:           ui.NewKeyAction("Sort Age", t.SortColCmd(ageCol, true), false),
 	})
@@ -201,6 +225,17 @@ func (t *Table) toggleWideCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (t *Table) columnsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if t.GetModel().Peek().Header == nil {
+		return evt
+	}
+	if err := t.app.inject(NewColumnChooser(t.app, t), false); err != nil {
+		t.app.Flash().Err(err)
+	}
+
+	return nil
+}
+
 func (t *Table) cpCmd(evt *tcell.EventKey) *tcell.EventKey {
 	path := t.GetSelectedItem()
 	if path == "" {
@@ -2,7 +2,9 @@ package view
 
 import (
 	"encoding/csv"
+	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
@@ -13,6 +15,7 @@ import (
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/rs/zerolog/log"
+	"gopkg.in/yaml.v2"
 )
 
 func computeFilename(screenDumpDir, context, ns, title, path string) (string, error) {
@@ -38,7 +41,23 @@ func computeFilename(screenDumpDir, context, ns, title, path string) (string, er
 	return strings.ToLower(filepath.Join(dir, fName)), nil
 }
 
+const (
+	exportCSV  = "csv"
+	exportJSON = "json"
+	exportYAML = "yaml"
+)
+
 func saveTable(screenDumpDir, context, title, path string, data *render.TableData) (string, error) {
+	return exportTable(screenDumpDir, context, title, path, exportCSV, data)
+}
+
+// exportTable dumps a table to a file in the given format.
+func exportTable(screenDumpDir, context, title, path, format string, data *render.TableData) (string, error) {
+	switch format {
+	case exportCSV, exportJSON, exportYAML:
+	default:
+		return "", fmt.Errorf("unsupported export format %q. Must be one of csv, json or yaml", format)
+	}
 	ns := data.Namespace
 	if client.IsClusterWide(ns) {
 		ns = client.NamespaceAll
@@ -48,7 +67,8 @@ func saveTable(screenDumpDir, context, title, path string, data *render.TableDat
 	if err != nil {
 		return "", err
 	}
-	log.Debug().Msgf("Saving Table to %s", fPath)
+	fPath = strings.TrimSuffix(fPath, "."+exportCSV) + "." + format
+	log.Debug().Msgf("Exporting Table to %s", fPath)
 
 	mod := os.O_CREATE | os.O_WRONLY
 	out, err := os.OpenFile(fPath, mod, 0600)
@@ -61,20 +81,89 @@ func saveTable(screenDumpDir, context, title, path string, data *render.TableDat
 		}
 	}()
 
-	w := csv.NewWriter(out)
-	if err := w.Write(data.Header.Columns(true)); err != nil {
+	switch format {
+	case exportJSON:
+		err = writeJSON(out, data)
+	case exportYAML:
+		err = writeYAML(out, data)
+	default:
+		err = writeCSV(out, data)
+	}
+	if err != nil {
 		return "", err
 	}
 
+	return fPath, nil
+}
+
+func writeCSV(out io.Writer, data *render.TableData) error {
+	w := csv.NewWriter(out)
+	if err := w.Write(data.Header.Columns(true)); err != nil {
+		return err
+	}
 	for _, re := range data.RowEvents {
 		if err := w.Write(re.Row.Fields); err != nil {
-			return "", err
+			return err
 		}
 	}
 	w.Flush()
-	if err := w.Error(); err != nil {
-		return "", err
+
+	return w.Error()
+}
+
+func writeJSON(out io.Writer, data *render.TableData) error {
+	cols := data.Header.Columns(true)
+	rows := make([]map[string]string, 0, len(data.RowEvents))
+	for _, re := range data.RowEvents {
+		row := make(map[string]string, len(cols))
+		for i, c := range cols {
+			if i < len(re.Row.Fields) {
+				row[c] = re.Row.Fields[i]
+			}
+		}
+		rows = append(rows, row)
 	}
+	enc := json.NewEncoder(out)
+	enc.SetIndent("", "  ")
 
-	return fPath, nil
+	return enc.Encode(rows)
+}
+
+func writeYAML(out io.Writer, data *render.TableData) error {
+	cols := data.Header.Columns(true)
+	rows := make([]yaml.MapSlice, 0, len(data.RowEvents))
+	for _, re := range data.RowEvents {
+		row := make(yaml.MapSlice, 0, len(cols))
+		for i, c := range cols {
+			if i < len(re.Row.Fields) {
+				row = append(row, yaml.MapItem{Key: c, Value: re.Row.Fields[i]})
+			}
+		}
+		rows = append(rows, row)
+	}
+	raw, err := yaml.Marshal(rows)
+	if err != nil {
+		return err
+	}
+	_, err = out.Write(raw)
+
+	return err
+}
+
+type exporter interface {
+	Export(format string) (string, error)
+}
+
+func (a *App) exportCmd(format string) error {
+	v, ok := a.Content.Top().(exporter)
+	if !ok {
+		return fmt.Errorf("current view can not be exported")
+	}
+	path, err := v.Export(format)
+	if err != nil {
+		return err
+	}
+	a.Flash().Infof("Table exported to %s", path)
+
+	return nil
 }
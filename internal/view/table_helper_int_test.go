@@ -0,0 +1,50 @@
+package view
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTableWriters(t *testing.T) {
+	data := render.NewTableData()
+	data.Header = render.Header{{Name: "NAME"}, {Name: "STATUS"}}
+	data.RowEvents = render.RowEvents{
+		{Row: render.Row{ID: "fred", Fields: render.Fields{"fred", "Running"}}},
+		{Row: render.Row{ID: "blee", Fields: render.Fields{"blee", "Pending"}}},
+	}
+
+	uu := map[string]struct {
+		w func(*bytes.Buffer, *render.TableData) error
+		e string
+	}{
+		"csv": {
+			w: func(b *bytes.Buffer, d *render.TableData) error { return writeCSV(b, d) },
+			e: "NAME,STATUS\nfred,Running\nblee,Pending\n",
+		},
+		"json": {
+			w: func(b *bytes.Buffer, d *render.TableData) error { return writeJSON(b, d) },
+			e: "[\n  {\n    \"NAME\": \"fred\",\n    \"STATUS\": \"Running\"\n  },\n  {\n    \"NAME\": \"blee\",\n    \"STATUS\": \"Pending\"\n  }\n]\n",
+		},
+		"yaml": {
+			w: func(b *bytes.Buffer, d *render.TableData) error { return writeYAML(b, d) },
+			e: "- NAME: fred\n  STATUS: Running\n- NAME: blee\n  STATUS: Pending\n",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var buff bytes.Buffer
+			assert.Nil(t, u.w(&buff, data))
+			assert.Equal(t, u.e, buff.String())
+		})
+	}
+}
+
+func TestExportTableFormat(t *testing.T) {
+	_, err := exportTable(t.TempDir(), "ctx", "po", "", "xml", render.NewTableData())
+	assert.Error(t, err)
+}
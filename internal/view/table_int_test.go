@@ -123,20 +123,22 @@ type mockTableModel struct{}
 
 var _ ui.Tabular = (*mockTableModel)(nil)
 
-func (t *mockTableModel) SetInstance(string)                 {}
-func (t *mockTableModel) SetLabelFilter(string)              {}
-func (t *mockTableModel) Empty() bool                        { return false }
-func (t *mockTableModel) Count() int                         { return 1 }
-func (t *mockTableModel) HasMetrics() bool                   { return true }
-func (t *mockTableModel) Peek() *render.TableData            { return makeTableData() }
-func (t *mockTableModel) Refresh(context.Context) error      { return nil }
-func (t *mockTableModel) ClusterWide() bool                  { return false }
-func (t *mockTableModel) GetNamespace() string               { return "blee" }
-func (t *mockTableModel) SetNamespace(string)                {}
-func (t *mockTableModel) ToggleToast()                       {}
-func (t *mockTableModel) AddListener(model.TableListener)    {}
-func (t *mockTableModel) RemoveListener(model.TableListener) {}
-func (t *mockTableModel) Watch(context.Context) error        { return nil }
+func (t *mockTableModel) SetInstance(string)                    {}
+func (t *mockTableModel) SetLabelFilter(string)                 {}
+func (t *mockTableModel) SetFieldFilter(string)                 {}
+func (t *mockTableModel) SetCustomColumns(render.CustomColumns) {}
+func (t *mockTableModel) Empty() bool                           { return false }
+func (t *mockTableModel) Count() int                            { return 1 }
+func (t *mockTableModel) HasMetrics() bool                      { return true }
+func (t *mockTableModel) Peek() *render.TableData               { return makeTableData() }
+func (t *mockTableModel) Refresh(context.Context) error         { return nil }
+func (t *mockTableModel) ClusterWide() bool                     { return false }
+func (t *mockTableModel) GetNamespace() string                  { return "blee" }
+func (t *mockTableModel) SetNamespace(string)                   {}
+func (t *mockTableModel) ToggleToast()                          {}
+func (t *mockTableModel) AddListener(model.TableListener)       {}
+func (t *mockTableModel) RemoveListener(model.TableListener)    {}
+func (t *mockTableModel) Watch(context.Context) error           { return nil }
 func (t *mockTableModel) Get(context.Context, string) (runtime.Object, error) {
 	return nil, nil
 }
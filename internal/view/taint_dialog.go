@@ -0,0 +1,91 @@
+package view
+
+import (
+	"strings"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	v1 "k8s.io/api/core/v1"
+)
+
+const taintKey = "taint"
+
+// TaintFunc represents a taints update callback function.
+type TaintFunc func(v ResourceViewer, path string, taints []v1.Taint)
+
+// ShowTaints pops a node taints dialog. Clearing a taint removes it.
+func ShowTaints(view ResourceViewer, path string, current []v1.Taint, okFn TaintFunc) {
+	styles := view.App().Styles
+
+	f := newStyledForm(styles.Dialog())
+
+	specs := make([]string, len(current))
+	for i, t := range current {
+		i := i
+		specs[i] = render.TaintSpec(t)
+		f.AddInputField("Taint:", specs[i], 0, nil, func(v string) {
+			specs[i] = v
+		})
+	}
+	var added string
+	f.AddInputField("New Taints:", "", 0, nil, func(v string) {
+		added = v
+	})
+
+	pages := view.App().Content.Pages
+	f.AddButton("Cancel", func() {
+		DismissTaints(view, pages)
+	})
+	f.AddButton("OK", func() {
+		taints, err := parseTaints(append(specs, strings.Fields(added)...))
+		if err != nil {
+			view.App().Flash().Err(err)
+			return
+		}
+		DismissTaints(view, pages)
+		okFn(view, path, taints)
+	})
+
+	modal := tview.NewModalForm("<Taints>", f)
+	modal.SetText("Taints (key=value:effect) " + path)
+	modal.SetDoneFunc(func(_ int, b string) {
+		DismissTaints(view, pages)
+	})
+
+	pages.AddPage(taintKey, modal, false, true)
+	pages.ShowPage(taintKey)
+	view.App().SetFocus(pages.GetPrimitive(taintKey))
+}
+
+// DismissTaints dismiss the taints dialog.
+func DismissTaints(v ResourceViewer, p *ui.Pages) {
+	p.RemovePage(taintKey)
+	v.App().SetFocus(p.CurrentPage().Item)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func parseTaints(specs []string) ([]v1.Taint, error) {
+	taints := make([]v1.Taint, 0, len(specs))
+	seen := make(map[string]struct{}, len(specs))
+	for _, s := range specs {
+		if strings.TrimSpace(s) == "" {
+			continue
+		}
+		t, err := dao.ParseTaint(s)
+		if err != nil {
+			return nil, err
+		}
+		k := t.Key + ":" + string(t.Effect)
+		if _, ok := seen[k]; ok {
+			continue
+		}
+		seen[k] = struct{}{}
+		taints = append(taints, t)
+	}
+
+	return taints, nil
+}
@@ -0,0 +1,193 @@
+package view
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tview"
+	"github.com/rs/zerolog/log"
+)
+
+const templatePickerKey = "templatePicker"
+
+// newCmd creates a resource from a manifest template given a kind and an
+// optional template name.
+func (a *App) newCmd(args []string) error {
+	if a.Config.K9s.IsReadOnly() {
+		return errors.New("resource creation is not allowed in read-only mode")
+	}
+	if len(args) == 0 || len(args) > 2 {
+		return errors.New("usage: new KIND [TEMPLATE]")
+	}
+	gvr, ok := a.command.alias.AsGVR(args[0])
+	if !ok {
+		return fmt.Errorf("`%s` resource not found", args[0])
+	}
+
+	tt := config.NewTemplates()
+	if err := tt.Load(); err != nil {
+		log.Warn().Err(err).Msgf("No custom templates loaded")
+	}
+	names := tt.Names(func(kind string) bool {
+		g, ok := a.command.alias.AsGVR(kind)
+		return ok && g == gvr
+	})
+	if len(args) == 2 {
+		t, ok := tt.Template[args[1]]
+		if !ok {
+			return fmt.Errorf("no template named %q", args[1])
+		}
+		if g, ok := a.command.alias.AsGVR(t.Kind); !ok || g != gvr {
+			return fmt.Errorf("template %q does not create %s", args[1], gvr.R())
+		}
+		names = []string{args[1]}
+	}
+
+	switch len(names) {
+	case 0:
+		return fmt.Errorf("no templates defined for %s", gvr.R())
+	case 1:
+		a.promptTemplate(gvr, names[0], tt.Template[names[0]])
+	default:
+		a.pickTemplate(gvr, tt, names)
+	}
+
+	return nil
+}
+
+// pickTemplate pops a dialog to select a template amongst several.
+func (a *App) pickTemplate(gvr client.GVR, tt config.Templates, names []string) {
+	styles := a.Styles
+	f := newStyledForm(styles.Dialog())
+
+	opts := make([]string, 0, len(names))
+	for _, n := range names {
+		opts = append(opts, fmt.Sprintf("%s - %s", n, tt.Template[n].Description))
+	}
+	sel := names[0]
+	f.AddDropDown("Template:", opts, 0, func(_ string, idx int) {
+		if idx >= 0 && idx < len(names) {
+			sel = names[idx]
+		}
+	})
+
+	pages := a.Content.Pages
+	dismiss := func() {
+		pages.RemovePage(templatePickerKey)
+		a.SetFocus(pages.CurrentPage().Item)
+	}
+	f.AddButton("Cancel", dismiss)
+	f.AddButton("OK", func() {
+		dismiss()
+		a.promptTemplate(gvr, sel, tt.Template[sel])
+	})
+
+	modal := tview.NewModalForm("<New "+singularize(gvr.R())+">", f)
+	modal.SetText("Pick a template")
+	modal.SetDoneFunc(func(int, string) {
+		dismiss()
+	})
+	pages.AddPage(templatePickerKey, modal, false, true)
+	pages.ShowPage(templatePickerKey)
+	a.SetFocus(pages.GetPrimitive(templatePickerKey))
+}
+
+// promptTemplate collects a template inputs and creates the resource.
+func (a *App) promptTemplate(gvr client.GVR, name string, t config.Template) {
+	env := Env{
+		"NAMESPACE": a.templateNamespace(),
+		"CONTEXT":   a.Config.K9s.CurrentContext,
+		"CLUSTER":   a.Config.K9s.CurrentCluster,
+	}
+	if len(t.Inputs) == 0 {
+		a.createFromTemplate(gvr, name, t, env)
+		return
+	}
+	title := t.Description
+	if title == "" {
+		title = name
+	}
+	ShowInputs(a, title, "Template Variables", t.Inputs, env, func(vals map[string]string) {
+		for k, v := range vals {
+			env[strings.ToUpper(k)] = v
+		}
+		a.createFromTemplate(gvr, name, t, env)
+	})
+}
+
+// createFromTemplate validates a rendered template via a server side dry run
+// and creates the resource once confirmed.
+func (a *App) createFromTemplate(gvr client.GVR, name string, t config.Template, env Env) {
+	manifest, err := renderTemplate(t, env)
+	if err != nil {
+		a.Flash().Err(err)
+		return
+	}
+	var g dao.Generic
+	g.Init(a.factory, gvr)
+	ns := env["NAMESPACE"]
+
+	ctx, cancel := context.WithTimeout(context.Background(), a.Conn().Config().CallTimeout())
+	defer cancel()
+	path, err := g.Create(ctx, ns, manifest, true)
+	if err != nil {
+		a.showTemplateErr(name, manifest, err)
+		return
+	}
+
+	msg := fmt.Sprintf("Create %s %s from template %s?", singularize(gvr.R()), path, name)
+	dialog.ShowConfirm(a.Styles.Dialog(), a.Content.Pages, "Confirm Create", msg, func() {
+		ctx, cancel := context.WithTimeout(context.Background(), a.Conn().Config().CallTimeout())
+		defer cancel()
+		if _, err := g.Create(ctx, ns, manifest, false); err != nil {
+			a.showTemplateErr(name, manifest, err)
+			return
+		}
+		a.Flash().Infof("%s %s created", singularize(gvr.R()), path)
+		a.gotoResource(gvr.String(), path, false)
+	}, func() {})
+}
+
+func (a *App) showTemplateErr(name, manifest string, err error) {
+	a.Flash().Err(err)
+	details := NewDetails(a, "Template", name, true).Update(fmt.Sprintf("# %s\n%s", err, manifest))
+	if err := a.inject(details, false); err != nil {
+		a.Flash().Err(err)
+	}
+}
+
+// templateNamespace returns the namespace new resources default to.
+func (a *App) templateNamespace() string {
+	ns := a.Config.ActiveNamespace()
+	if client.IsAllNamespaces(ns) {
+		return client.DefaultNamespace
+	}
+
+	return ns
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// renderTemplate substitutes a template variables. Unlike plugin args, values
+// are inserted verbatim and unknown variables are left untouched.
+func renderTemplate(t config.Template, env Env) (string, error) {
+	if t.Manifest == "" {
+		return "", errors.New("template manifest is empty")
+	}
+
+	return envRX.ReplaceAllStringFunc(t.Manifest, func(m string) string {
+		key, inverse := keyFromSubmatch(envRX.FindStringSubmatch(m))
+		v, ok := env[strings.ToUpper(key)]
+		if !ok || inverse {
+			return m
+		}
+		return v
+	}), nil
+}
@@ -0,0 +1,41 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRenderTemplate(t *testing.T) {
+	uu := map[string]struct {
+		manifest, e string
+		err         bool
+	}{
+		"vars": {
+			manifest: "name: ${NAME}\nnamespace: $NAMESPACE",
+			e:        "name: fred\nnamespace: blee",
+		},
+		"verbatim": {
+			manifest: "replicas: ${REPLICAS}",
+			e:        "replicas: 1",
+		},
+		"unknown": {
+			manifest: "command: [sh, -c, echo $HOME]",
+			e:        "command: [sh, -c, echo $HOME]",
+		},
+		"empty": {
+			err: true,
+		},
+	}
+
+	env := Env{"NAME": "fred", "NAMESPACE": "blee", "REPLICAS": "1"}
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			s, err := renderTemplate(config.Template{Manifest: u.manifest}, env)
+			assert.Equal(t, u.err, err != nil)
+			assert.Equal(t, u.e, s)
+		})
+	}
+}
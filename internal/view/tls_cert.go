@@ -0,0 +1,83 @@
+package view
+
+import (
+	"context"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tcell/v2"
+)
+
+// TLSCert represents a tls certificates expiry viewer.
+type TLSCert struct {
+	ResourceViewer
+}
+
+// NewTLSCert returns a new tls certificates view.
+func NewTLSCert(gvr client.GVR) ResourceViewer {
+	c := TLSCert{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	c.GetTable().SetBorderFocusColor(tcell.ColorMediumSpringGreen)
+	c.GetTable().SetSelectedStyle(tcell.StyleDefault.Foreground(tcell.ColorWhite).Background(tcell.ColorMediumSpringGreen).Attributes(tcell.AttrNone))
+	c.GetTable().SetSortCol("NOT AFTER", true)
+	c.AddBindKeysFn(c.bindKeys)
+
+	return &c
+}
+
+func (c *TLSCert) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Delete(tcell.KeyCtrlW, tcell.KeyCtrlL, tcell.KeyCtrlZ, tcell.KeyCtrlD)
+	aa.Add(ui.KeyActions{
+		tcell.KeyEnter: ui.NewKeyAction("Goto", c.gotoCmd, true),
+		ui.KeyShiftS:   ui.NewKeyAction("Sort Status", c.GetTable().SortColCmd("STATUS", true), false),
+		ui.KeyShiftK:   ui.NewKeyAction("Sort Kind", c.GetTable().SortColCmd("KIND", true), false),
+		ui.KeyShiftX:   ui.NewKeyAction("Sort Expiry", c.GetTable().SortColCmd("NOT AFTER", true), false),
+	})
+	if c.App().Config.K9s.IsReadOnly() {
+		return
+	}
+	aa.Add(ui.KeyActions{
+		ui.KeyR: ui.NewKeyAction("Renew", c.renewCmd, true),
+	})
+}
+
+func (c *TLSCert) gotoCmd(evt *tcell.EventKey) *tcell.EventKey {
+	sel := c.GetTable().GetSelectedItem()
+	if sel == "" {
+		return evt
+	}
+	gvr, path, ok := strings.Cut(sel, "|")
+	if !ok {
+		return nil
+	}
+	c.App().gotoResource(gvr, path, false)
+
+	return nil
+}
+
+func (c *TLSCert) renewCmd(evt *tcell.EventKey) *tcell.EventKey {
+	sel := c.GetTable().GetSelectedItem()
+	if sel == "" {
+		return evt
+	}
+	_, path, _ := strings.Cut(sel, "|")
+	msg := "Renew certificate " + path + "?"
+	dialog.ShowConfirm(c.App().Styles.Dialog(), c.App().Content.Pages, "Confirm Renew", msg, func() {
+		var res dao.TLSCert
+		res.Init(c.App().factory, c.GVR())
+		ctx, cancel := context.WithTimeout(context.Background(), c.App().Conn().Config().CallTimeout())
+		defer cancel()
+		if err := res.Renew(ctx, sel); err != nil {
+			c.App().Flash().Err(err)
+			return
+		}
+		c.App().Flash().Infof("Certificate %s re-issuance triggered", path)
+	}, func() {})
+
+	return nil
+}
@@ -0,0 +1,334 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	transferTitle = "File Transfer"
+	parentDir     = ".."
+	progressRate  = 250 * time.Millisecond
+)
+
+// FileTransfer presents a two pane local/container file browser.
+type FileTransfer struct {
+	*tview.Flex
+
+	app           *App
+	actions       ui.KeyActions
+	local, remote *tview.List
+	status        *tview.TextView
+	localDir      string
+	remoteDir     string
+	remoteFiles   []dao.RemoteFile
+	transfer      *dao.Transfer
+	fqn, co       string
+	cancelFn      context.CancelFunc
+	lastProgress  time.Time
+	mx            sync.Mutex
+}
+
+// NewFileTransfer returns a new file transfer view.
+func NewFileTransfer(app *App, fqn, co string) *FileTransfer {
+	return &FileTransfer{
+		Flex:      tview.NewFlex(),
+		app:       app,
+		actions:   make(ui.KeyActions),
+		local:     tview.NewList(),
+		remote:    tview.NewList(),
+		status:    tview.NewTextView(),
+		remoteDir: "/",
+		fqn:       fqn,
+		co:        co,
+	}
+}
+
+// Init initializes the view.
+func (f *FileTransfer) Init(_ context.Context) error {
+	var err error
+	if f.localDir, err = os.Getwd(); err != nil {
+		return err
+	}
+	f.transfer = dao.NewTransfer(f.app.Conn(), f.fqn, f.co, f.progress)
+
+	f.SetBorder(true)
+	f.SetTitle(fmt.Sprintf(" [aqua::b]%s [fuchsia::b]%s[aqua::b]:%s ", transferTitle, f.fqn, f.co))
+	f.SetDirection(tview.FlexRow)
+	panes := tview.NewFlex().SetDirection(tview.FlexColumn)
+	for _, l := range []*tview.List{f.local, f.remote} {
+		l.SetBorder(true)
+		l.ShowSecondaryText(false)
+		l.SetMainTextColor(tcell.ColorWhite)
+		l.SetSelectedBackgroundColor(tcell.ColorAqua)
+		l.SetInputCapture(f.keyboard)
+		panes.AddItem(l, 0, 1, false)
+	}
+	f.local.SetSelectedFunc(f.openLocal)
+	f.remote.SetSelectedFunc(f.openRemote)
+	f.status.SetDynamicColors(true)
+	f.AddItem(panes, 0, 1, true)
+	f.AddItem(f.status, 1, 0, false)
+	f.bindKeys()
+
+	return nil
+}
+
+func (f *FileTransfer) bindKeys() {
+	f.actions.Add(ui.KeyActions{
+		tcell.KeyEscape: ui.NewKeyAction("Back", f.app.PrevCmd, true),
+		tcell.KeyTab:    ui.NewKeyAction("Switch Pane", f.switchPaneCmd, true),
+		ui.KeyC:         ui.NewKeyAction("Copy", f.copyCmd, true),
+		ui.KeyR:         ui.NewKeyAction("Toggle Resume", f.toggleResumeCmd, true),
+		tcell.KeyCtrlR:  ui.NewKeyAction("Refresh", f.refreshCmd, true),
+		tcell.KeyCtrlX:  ui.NewKeyAction("Cancel Transfer", f.cancelCmd, true),
+	})
+}
+
+func (f *FileTransfer) keyboard(evt *tcell.EventKey) *tcell.EventKey {
+	key := evt.Key()
+	if key == tcell.KeyRune {
+		key = tcell.Key(evt.Rune())
+	}
+	if a, ok := f.actions[key]; ok {
+		return a.Action(evt)
+	}
+
+	return evt
+}
+
+// InCmdMode checks if prompt is active.
+func (*FileTransfer) InCmdMode() bool {
+	return false
+}
+
+// Start starts the view.
+func (f *FileTransfer) Start() {
+	f.app.SetFocus(f.local)
+	f.refreshLocal()
+	go f.refreshRemote()
+}
+
+// Stop stops the view.
+func (f *FileTransfer) Stop() {
+	f.mx.Lock()
+	defer f.mx.Unlock()
+	if f.cancelFn != nil {
+		f.cancelFn()
+		f.cancelFn = nil
+	}
+}
+
+// Name returns the component name.
+func (f *FileTransfer) Name() string { return transferTitle }
+
+// Hints returns the view hints.
+func (f *FileTransfer) Hints() model.MenuHints {
+	return f.actions.Hints()
+}
+
+// ExtraHints returns additional hints.
+func (f *FileTransfer) ExtraHints() map[string]string {
+	return nil
+}
+
+func (f *FileTransfer) switchPaneCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if f.local.HasFocus() {
+		f.app.SetFocus(f.remote)
+	} else {
+		f.app.SetFocus(f.local)
+	}
+
+	return nil
+}
+
+func (f *FileTransfer) refreshCmd(evt *tcell.EventKey) *tcell.EventKey {
+	f.refreshLocal()
+	go f.refreshRemote()
+
+	return nil
+}
+
+func (f *FileTransfer) cancelCmd(evt *tcell.EventKey) *tcell.EventKey {
+	f.Stop()
+	f.setStatus("[orange::b]Transfer canceled")
+
+	return nil
+}
+
+func (f *FileTransfer) toggleResumeCmd(evt *tcell.EventKey) *tcell.EventKey {
+	f.mx.Lock()
+	defer f.mx.Unlock()
+	if f.cancelFn != nil {
+		f.app.Flash().Warn("A transfer is already in progress")
+		return nil
+	}
+	f.transfer.SetResume(!f.transfer.Resume())
+	if f.transfer.Resume() {
+		f.app.Flash().Info("Resuming partial transfers")
+	} else {
+		f.app.Flash().Info("Restarting partial transfers")
+	}
+
+	return nil
+}
+
+func (f *FileTransfer) copyCmd(evt *tcell.EventKey) *tcell.EventKey {
+	f.mx.Lock()
+	defer f.mx.Unlock()
+	if f.cancelFn != nil {
+		f.app.Flash().Warn("A transfer is already in progress")
+		return nil
+	}
+
+	if f.local.HasFocus() {
+		name, _ := f.local.GetItemText(f.local.GetCurrentItem())
+		if name == parentDir {
+			return nil
+		}
+		ctx := f.newTransferContext()
+		src, dst := filepath.Join(f.localDir, name), f.remoteDir
+		go f.run(ctx, fmt.Sprintf("Uploading %s to %s", src, dst), func() error {
+			return f.transfer.Upload(ctx, src, dst)
+		})
+		return nil
+	}
+
+	idx := f.remote.GetCurrentItem() - 1
+	if idx < 0 || idx >= len(f.remoteFiles) {
+		return nil
+	}
+	ctx := f.newTransferContext()
+	rf, src, dst := f.remoteFiles[idx], f.remoteDir, f.localDir
+	go f.run(ctx, fmt.Sprintf("Downloading %s to %s", path.Join(src, rf.Name), dst), func() error {
+		return f.transfer.Download(ctx, rf, src, dst)
+	})
+
+	return nil
+}
+
+func (f *FileTransfer) newTransferContext() context.Context {
+	var ctx context.Context
+	ctx, f.cancelFn = context.WithCancel(context.Background())
+
+	return ctx
+}
+
+func (f *FileTransfer) run(ctx context.Context, msg string, copyFn func() error) {
+	f.setStatus("[orange::b]" + msg + "...")
+	err := copyFn()
+	f.mx.Lock()
+	f.cancelFn = nil
+	f.mx.Unlock()
+	if err != nil {
+		log.Error().Err(err).Msgf("File transfer failed")
+		if ctx.Err() == nil {
+			f.setStatus(fmt.Sprintf("[red::b]Transfer failed: %s", err))
+		}
+		return
+	}
+	f.setStatus("[green::b]Transfer completed")
+	f.app.QueueUpdateDraw(f.refreshLocal)
+	f.refreshRemote()
+}
+
+func (f *FileTransfer) progress(copied int64) {
+	if time.Since(f.lastProgress) < progressRate {
+		return
+	}
+	f.lastProgress = time.Now()
+	f.setStatus(fmt.Sprintf("[orange::b]Transferred %sB...", render.AsThousands(copied)))
+}
+
+func (f *FileTransfer) setStatus(msg string) {
+	f.app.QueueUpdateDraw(func() {
+		f.status.SetText(msg)
+	})
+}
+
+func (f *FileTransfer) openLocal(_ int, name, _ string, _ rune) {
+	dir := filepath.Join(f.localDir, name)
+	if fi, err := os.Stat(dir); err != nil || !fi.IsDir() {
+		return
+	}
+	f.localDir = dir
+	f.refreshLocal()
+}
+
+func (f *FileTransfer) openRemote(i int, _, _ string, _ rune) {
+	if i == 0 {
+		f.remoteDir = path.Dir(f.remoteDir)
+		go f.refreshRemote()
+		return
+	}
+	if i-1 >= len(f.remoteFiles) || !f.remoteFiles[i-1].Dir {
+		return
+	}
+	f.remoteDir = path.Join(f.remoteDir, f.remoteFiles[i-1].Name)
+	go f.refreshRemote()
+}
+
+func (f *FileTransfer) refreshLocal() {
+	f.local.Clear()
+	f.local.SetTitle(fmt.Sprintf(" [aqua::b]Local [white::]%s ", f.localDir))
+	f.local.AddItem(parentDir, "", 0, nil)
+	ee, err := os.ReadDir(f.localDir)
+	if err != nil {
+		f.app.Flash().Err(err)
+		return
+	}
+	for _, e := range ee {
+		n := e.Name()
+		if e.IsDir() {
+			n += "/"
+		}
+		f.local.AddItem(n, "", 0, nil)
+	}
+}
+
+func (f *FileTransfer) refreshRemote() {
+	ctx, cancel := context.WithTimeout(context.Background(), f.app.Conn().Config().CallTimeout())
+	defer cancel()
+	ff, err := f.transfer.List(ctx, f.remoteDir)
+	if err != nil {
+		f.app.QueueUpdateDraw(func() {
+			f.app.Flash().Err(err)
+		})
+		return
+	}
+	sort.Slice(ff, func(i, j int) bool {
+		if ff[i].Dir != ff[j].Dir {
+			return ff[i].Dir
+		}
+		return ff[i].Name < ff[j].Name
+	})
+	f.app.QueueUpdateDraw(func() {
+		f.remoteFiles = ff
+		f.remote.Clear()
+		f.remote.SetTitle(fmt.Sprintf(" [aqua::b]Container [white::]%s ", f.remoteDir))
+		f.remote.AddItem(parentDir, "", 0, nil)
+		for _, rf := range ff {
+			n := rf.Name
+			if rf.Dir {
+				n += "/"
+			} else {
+				n = fmt.Sprintf("%s [gray::](%sB)", n, render.AsThousands(rf.Size))
+			}
+			f.remote.AddItem(n, "", 0, nil)
+		}
+	})
+}
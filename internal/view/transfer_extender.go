@@ -0,0 +1,72 @@
+package view
+
+import (
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+// FileTransferExtender provides for copying files to and from containers.
+type FileTransferExtender struct {
+	ResourceViewer
+}
+
+// NewFileTransferExtender returns a new extender.
+func NewFileTransferExtender(r ResourceViewer) ResourceViewer {
+	f := FileTransferExtender{ResourceViewer: r}
+	f.AddBindKeysFn(f.bindKeys)
+
+	return &f
+}
+
+func (f *FileTransferExtender) bindKeys(aa ui.KeyActions) {
+	if f.App().Config.K9s.IsReadOnly() {
+		return
+	}
+	aa.Add(ui.KeyActions{
+		ui.KeyX: ui.NewKeyAction("File Transfer", f.transferCmd, true),
+	})
+}
+
+func (f *FileTransferExtender) transferCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := f.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	if f.GVR() == client.NewGVR("containers") {
+		f.showTransfer(f.GetTable().Path, path)
+		return nil
+	}
+	if !podIsRunning(f.App().factory, path) {
+		f.App().Flash().Errf("%s is not in a running state", path)
+		return nil
+	}
+	pod, err := fetchPod(f.App().factory, path)
+	if err != nil {
+		f.App().Flash().Err(err)
+		return nil
+	}
+	cc := fetchContainers(pod.Spec, false)
+	if len(cc) == 1 {
+		f.showTransfer(path, cc[0])
+		return nil
+	}
+	picker := NewPicker()
+	picker.populate(cc)
+	picker.SetSelectedFunc(func(_ int, co, _ string, _ rune) {
+		f.App().Content.Pop()
+		f.showTransfer(path, co)
+	})
+	if err := f.App().inject(picker, false); err != nil {
+		f.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (f *FileTransferExtender) showTransfer(fqn, co string) {
+	if err := f.App().inject(NewFileTransfer(f.App(), fqn, co), false); err != nil {
+		f.App().Flash().Err(err)
+	}
+}
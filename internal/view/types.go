@@ -119,6 +119,11 @@ type SubjectViewer interface {
 	SetSubject(s string)
 }
 
+// gvrModel represents a resource model tracking its gvr.
+type gvrModel interface {
+	GVR() client.GVR
+}
+
 // ViewerFunc returns a viewer matching a given gvr.
 type ViewerFunc func(client.GVR) ResourceViewer
 
@@ -0,0 +1,83 @@
+package view
+
+import (
+	"context"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+const webhookTestTimeout = 30 * time.Second
+
+// Webhook represents a validating or mutating webhook configuration viewer.
+type Webhook struct {
+	ResourceViewer
+}
+
+// NewWebhook returns a new viewer.
+func NewWebhook(gvr client.GVR) ResourceViewer {
+	w := Webhook{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	w.GetTable().SetEnterFn(w.inspect)
+	w.AddBindKeysFn(w.bindKeys)
+
+	return &w
+}
+
+func (w *Webhook) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyT: ui.NewKeyAction("Test", w.testCmd, true),
+	})
+}
+
+func (w *Webhook) accessor() *dao.Webhook {
+	var res dao.Webhook
+	res.Init(w.App().factory, w.GVR())
+
+	return &res
+}
+
+func (w *Webhook) inspect(app *App, _ ui.Tabular, _, path string) {
+	ww, err := w.accessor().Webhooks(path)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	details := NewDetails(app, "Webhooks", path, true).Update(render.WebhookReport(ww))
+	if err := app.inject(details, false); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+func (w *Webhook) testCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := w.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	res := w.accessor()
+	w.App().Flash().Infof("Testing webhooks %s...", path)
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), webhookTestTimeout)
+		defer cancel()
+		pp, err := res.Test(ctx, path)
+		w.App().QueueUpdateDraw(func() {
+			if err != nil {
+				w.App().Flash().Err(err)
+				return
+			}
+			w.App().Flash().Infof("Tested webhooks %s", path)
+			details := NewDetails(w.App(), "Webhook Test", path, true).Update(render.WebhookProbesReport(pp))
+			if err := w.App().inject(details, false); err != nil {
+				w.App().Flash().Err(err)
+			}
+		})
+	}()
+
+	return nil
+}
@@ -0,0 +1,108 @@
+package view
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	"github.com/rs/zerolog/log"
+)
+
+const maxWorkspaces = 9
+
+// workspaceActions binds Alt-1..9 to the workspaces.
+func (a *App) workspaceActions() ui.KeyActions {
+	aa := make(ui.KeyActions, maxWorkspaces)
+	for i := 1; i <= maxWorkspaces; i++ {
+		aa[ui.AltNumKeys[i]] = ui.NewSharedKeyAction(fmt.Sprintf("Workspace %d", i), a.workspaceCmd(i-1), false)
+	}
+
+	return aa
+}
+
+func (a *App) workspaceCmd(i int) ui.ActionHandler {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		if a.Prompt().InCmdMode() {
+			return evt
+		}
+		if err := a.switchWorkspace(i); err != nil {
+			a.Flash().Err(err)
+		}
+
+		return nil
+	}
+}
+
+// switchWorkspace activates a given view stack, creating it on first use.
+// Each workspace keeps its own views, namespaces and filters.
+func (a *App) switchWorkspace(i int) error {
+	if i < 0 || i >= maxWorkspaces {
+		return fmt.Errorf("invalid workspace %d", i+1)
+	}
+	if a.workspaces == nil {
+		a.workspaces = make([]*PageStack, maxWorkspaces)
+		a.workspaces[a.activeWS] = a.Content
+	}
+	if i == a.activeWS {
+		return nil
+	}
+
+	ws, fresh := a.workspaces[i], false
+	if ws == nil {
+		ws, fresh = NewPageStack(), true
+		if err := ws.Init(context.WithValue(context.Background(), internal.KeyApp, a)); err != nil {
+			return err
+		}
+		a.workspaces[i] = ws
+	}
+
+	var mode, dir string
+	if a.preview != nil {
+		mode, dir = a.preview.mode, a.preview.dir
+		a.togglePreview("", "")
+	}
+	if top := a.Content.Top(); top != nil {
+		top.Stop()
+	}
+	a.Content.Stack.RemoveListener(a.Crumbs())
+	a.Content.Stack.RemoveListener(a.Menu())
+
+	a.Content, a.activeWS = ws, i
+	a.swapContent()
+	a.Crumbs().Reset(ws.Stack.Peek())
+	ws.Stack.AddListener(a.Crumbs())
+	ws.Stack.AddListener(a.Menu())
+	if mode != "" {
+		a.togglePreview(mode, dir)
+	}
+
+	a.Flash().Infof("Workspace %d", i+1)
+	if fresh {
+		return a.command.defaultCmd()
+	}
+	if top := ws.Top(); top != nil {
+		top.Start()
+		a.SetFocus(top)
+	}
+
+	return nil
+}
+
+// resetWorkspaces drops all workspaces but the active one. Inactive
+// workspaces views are already stopped.
+func (a *App) resetWorkspaces() {
+	a.workspaces, a.activeWS = nil, 0
+}
+
+// swapContent installs the active view stack in the main layout.
+func (a *App) swapContent() {
+	flex, ok := a.Main.GetPrimitive("main").(*tview.Flex)
+	if !ok {
+		log.Fatal().Msg("Expecting valid flex view")
+	}
+	flex.RemoveItemAtIndex(1)
+	flex.AddItemAtIndex(1, a.Content, 0, 10, true)
+}
@@ -77,7 +77,12 @@ func (x *Xray) Init(ctx context.Context) error {
 	x.SetTitle(fmt.Sprintf(" %s-%s ", xrayTitle, cases.Title(language.Und, cases.NoLower).String(x.gvr.R())))
 
 	x.model.SetRefreshRate(time.Duration(x.app.Config.K9s.GetRefreshRate()) * time.Second)
-	x.model.SetNamespace(client.CleanseNamespace(x.app.Config.ActiveNamespace()))
+	if path := x.model.GetInstance(); path != "" {
+		ns, _ := client.Namespaced(path)
+		x.model.SetNamespace(ns)
+	} else {
+		x.model.SetNamespace(client.CleanseNamespace(x.app.Config.ActiveNamespace()))
+	}
 	x.model.AddListener(x)
 
 	x.SetChangedFunc(func(n *tview.TreeNode) {
@@ -101,14 +106,16 @@ func (*Xray) InCmdMode() bool {
 
 // ExtraHints returns additional hints.
 func (x *Xray) ExtraHints() map[string]string {
-	if x.app.Config.K9s.NoIcons {
+	if x.app.Config.K9s.IsNoIcons() {
 		return nil
 	}
 	return xray.EmojiInfo()
 }
 
 // SetInstance sets specific resource instance.
-func (x *Xray) SetInstance(string) {}
+func (x *Xray) SetInstance(path string) {
+	x.model.SetInstance(path)
+}
 
 func (x *Xray) bindKeys() {
 	x.Actions().Add(ui.KeyActions{
@@ -374,27 +381,11 @@ func (x *Xray) describeCmd(evt *tcell.EventKey) *tcell.EventKey {
 		return evt
 	}
 
-	x.describe(spec.GVR(), spec.Path())
+	describeResource(x.app, nil, spec.GVR(), spec.Path())
 
 	return nil
 }
 
-func (x *Xray) describe(gvr, path string) {
-	ctx := context.Background()
-	ctx = context.WithValue(ctx, internal.KeyFactory, x.app.factory)
-
-	yaml, err := x.model.Describe(ctx, gvr, path)
-	if err != nil {
-		x.app.Flash().Errf("Describe command failed: %s", err)
-		return
-	}
-
-	details := NewDetails(x.app, "Describe", path, true).Update(yaml)
-	if err := x.app.inject(details, false); err != nil {
-		x.app.Flash().Err(err)
-	}
-}
-
 func (x *Xray) editCmd(evt *tcell.EventKey) *tcell.EventKey {
 	spec := x.selectedSpec()
 	if spec == nil {
@@ -499,7 +490,7 @@ func (x *Xray) TreeLoadFailed(err error) {
 }
 
 func (x *Xray) update(node *xray.TreeNode) {
-	root := makeTreeNode(node, x.ExpandNodes(), x.app.Config.K9s.NoIcons, x.app.Styles)
+	root := makeTreeNode(node, x.ExpandNodes(), x.app.Config.K9s.IsNoIcons(), x.app.Styles)
 	if node == nil {
 		x.app.QueueUpdateDraw(func() {
 			x.SetRoot(root)
@@ -546,7 +537,7 @@ func (x *Xray) TreeChanged(node *xray.TreeNode) {
 }
 
 func (x *Xray) hydrate(parent *tview.TreeNode, n *xray.TreeNode) {
-	node := makeTreeNode(n, x.ExpandNodes(), x.app.Config.K9s.NoIcons, x.app.Styles)
+	node := makeTreeNode(n, x.ExpandNodes(), x.app.Config.K9s.IsNoIcons(), x.app.Styles)
 	for _, c := range n.Children {
 		x.hydrate(node, c)
 	}
@@ -639,6 +630,9 @@ func (x *Xray) styleTitle() string {
 	if client.IsAllNamespaces(ns) {
 		ns = client.NamespaceAll
 	}
+	if path := x.model.GetInstance(); path != "" {
+		ns = path
+	}
 
 	var title string
 	if ns == client.ClusterScope {
@@ -659,7 +653,7 @@ func (x *Xray) styleTitle() string {
 }
 
 func (x *Xray) resourceDelete(gvr client.GVR, spec *xray.NodeSpec, msg string) {
-	dialog.ShowDelete(x.app.Styles.Dialog(), x.app.Content.Pages, msg, func(propagation *metav1.DeletionPropagation, force bool) {
+	dialog.ShowDelete(x.app.Styles.Dialog(), x.app.Content.Pages, msg, deletePolicy(x.app, gvr, []string{spec.Path()}), func(propagation *metav1.DeletionPropagation, force bool) {
 		x.app.Flash().Infof("Delete resource %s %s", spec.GVR(), spec.Path())
 		accessor, err := dao.AccessorFor(x.app.factory, gvr)
 		if err != nil {
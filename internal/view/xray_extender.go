@@ -0,0 +1,40 @@
+package view
+
+import (
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+// XrayExtender provides for viewing a resource dependency tree.
+type XrayExtender struct {
+	ResourceViewer
+}
+
+// NewXrayExtender returns a new extender.
+func NewXrayExtender(r ResourceViewer) ResourceViewer {
+	x := XrayExtender{ResourceViewer: r}
+	x.AddBindKeysFn(x.bindKeys)
+
+	return &x
+}
+
+func (x *XrayExtender) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftX: ui.NewKeyAction("Xray", x.xrayCmd, true),
+	})
+}
+
+func (x *XrayExtender) xrayCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := x.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	v := NewXray(x.GVR())
+	v.SetInstance(path)
+	if err := x.App().inject(v, false); err != nil {
+		x.App().Flash().Err(err)
+	}
+
+	return nil
+}
@@ -12,6 +12,8 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	di "k8s.io/client-go/dynamic/dynamicinformer"
 	"k8s.io/client-go/informers"
+	"k8s.io/client-go/metadata/metadatainformer"
+	"k8s.io/client-go/tools/cache"
 )
 
 const (
@@ -21,19 +23,27 @@ const (
 
 // Factory tracks various resource informers.
 type Factory struct {
-	factories  map[string]di.DynamicSharedInformerFactory
-	client     client.Connection
-	stopChan   chan struct{}
-	forwarders Forwarders
-	mx         sync.RWMutex
+	factories     map[string]di.DynamicSharedInformerFactory
+	metaFactories map[string]metadatainformer.SharedInformerFactory
+	watches       map[string]struct{}
+	metaWatches   map[string]struct{}
+	selected      map[string]*selectedInformer
+	client        client.Connection
+	stopChan      chan struct{}
+	forwarders    Forwarders
+	mx            sync.RWMutex
 }
 
 // NewFactory returns a new informers factory.
 func NewFactory(client client.Connection) *Factory {
 	return &Factory{
-		client:     client,
-		factories:  make(map[string]di.DynamicSharedInformerFactory),
-		forwarders: NewForwarders(),
+		client:        client,
+		factories:     make(map[string]di.DynamicSharedInformerFactory),
+		metaFactories: make(map[string]metadatainformer.SharedInformerFactory),
+		watches:       make(map[string]struct{}),
+		metaWatches:   make(map[string]struct{}),
+		selected:      make(map[string]*selectedInformer),
+		forwarders:    NewForwarders(),
 	}
 }
 
@@ -62,9 +72,36 @@ func (f *Factory) Terminate() {
 	for k := range f.factories {
 		delete(f.factories, k)
 	}
+	f.metaFactories = make(map[string]metadatainformer.SharedInformerFactory)
+	f.watches = make(map[string]struct{})
+	f.metaWatches = make(map[string]struct{})
+	f.stopSelected()
 	f.forwarders.DeleteAll()
 }
 
+// Restart swaps in fresh informers. The previous informers and port-forwards
+// are torn down in the background so an unreachable cluster does not block.
+func (f *Factory) Restart(ns string) {
+	f.mx.Lock()
+	stopChan, forwarders := f.stopChan, f.forwarders
+	f.factories = make(map[string]di.DynamicSharedInformerFactory)
+	f.metaFactories = make(map[string]metadatainformer.SharedInformerFactory)
+	f.watches = make(map[string]struct{})
+	f.metaWatches = make(map[string]struct{})
+	f.stopSelected()
+	f.forwarders = NewForwarders()
+	f.stopChan = make(chan struct{})
+	f.mx.Unlock()
+	log.Debug().Msgf("Factory RESTART with ns `%q", ns)
+
+	go func() {
+		if stopChan != nil {
+			close(stopChan)
+		}
+		forwarders.DeleteAll()
+	}()
+}
+
 // List returns a resource collection.
 func (f *Factory) List(gvr, ns string, wait bool, labels labels.Selector) ([]runtime.Object, error) {
 	inf, err := f.CanForResource(ns, gvr, client.MonitorAccess)
@@ -209,13 +246,47 @@ func (f *Factory) ForResource(ns, gvr string) (informers.GenericInformer, error)
 		return inf, nil
 	}
 
-	f.mx.RLock()
-	defer f.mx.RUnlock()
+	if client.IsClusterWide(ns) {
+		ns = client.AllNamespaces
+	}
+	f.mx.Lock()
+	defer f.mx.Unlock()
+	f.watches[ns+":"+gvr] = struct{}{}
 	fact.Start(f.stopChan)
 
 	return inf, nil
 }
 
+// AddEventHandler notifies a handler of a resource changes in a given
+// namespace and matching the given selectors. Returns a func to remove the handler.
+func (f *Factory) AddEventHandler(gvr, ns, labelSel, fieldSel string, h cache.ResourceEventHandler) (func(), error) {
+	inf, err := f.ForSelector(ns, gvr, labelSel, fieldSel)
+	if err != nil {
+		return nil, err
+	}
+	if inf == nil {
+		return nil, fmt.Errorf("no informer found for %q:%q", ns, gvr)
+	}
+	reg, err := inf.Informer().AddEventHandler(h)
+	if err != nil {
+		return nil, err
+	}
+
+	return func() {
+		if err := inf.Informer().RemoveEventHandler(reg); err != nil {
+			log.Error().Err(err).Msgf("Removing event handler for %q:%q", ns, gvr)
+		}
+	}, nil
+}
+
+// Watches returns the number of active resource watches.
+func (f *Factory) Watches() int {
+	f.mx.RLock()
+	defer f.mx.RUnlock()
+
+	return len(f.watches) + len(f.metaWatches) + len(f.selected)
+}
+
 func (f *Factory) ensureFactory(ns string) (di.DynamicSharedInformerFactory, error) {
 	if client.IsClusterWide(ns) {
 		ns = client.AllNamespaces
@@ -0,0 +1,73 @@
+package watch
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/port"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/client-go/tools/portforward"
+)
+
+func TestFactoryRestart(t *testing.T) {
+	f := NewFactory(nil)
+	f.Start("default")
+	oldStop := f.stopChan
+	f.watches["v1/pods"] = struct{}{}
+	f.metaWatches["v1/pods"] = struct{}{}
+	f.factories["default"] = nil
+	fwd := newTestForwarder("default/p1|nginx|8080:80")
+	f.forwarders[fwd.ID()] = fwd
+
+	f.Restart("default")
+
+	select {
+	case <-oldStop:
+	case <-time.After(time.Second):
+		t.Fatal("previous informers were not stopped")
+	}
+	select {
+	case <-fwd.stopped:
+	case <-time.After(time.Second):
+		t.Fatal("previous port-forwards were not stopped")
+	}
+
+	f.mx.RLock()
+	defer f.mx.RUnlock()
+	assert.NotEqual(t, oldStop, f.stopChan)
+	select {
+	case <-f.stopChan:
+		t.Fatal("new informers stop channel must be open")
+	default:
+	}
+	assert.Empty(t, f.factories)
+	assert.Empty(t, f.metaFactories)
+	assert.Empty(t, f.watches)
+	assert.Empty(t, f.metaWatches)
+	assert.Empty(t, f.forwarders)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+type testForwarder struct {
+	id      string
+	stopped chan struct{}
+}
+
+func newTestForwarder(id string) *testForwarder {
+	return &testForwarder{id: id, stopped: make(chan struct{})}
+}
+
+func (f *testForwarder) Start(string, port.PortTunnel) (*portforward.PortForwarder, error) {
+	return nil, nil
+}
+func (f *testForwarder) Stop()                      { close(f.stopped) }
+func (f *testForwarder) ID() string                 { return f.id }
+func (f *testForwarder) Container() string          { return "" }
+func (f *testForwarder) Port() string               { return "" }
+func (f *testForwarder) FQN() string                { return "" }
+func (f *testForwarder) Active() bool               { return true }
+func (f *testForwarder) SetActive(bool)             {}
+func (f *testForwarder) Age() string                { return "" }
+func (f *testForwarder) HasPortMapping(string) bool { return false }
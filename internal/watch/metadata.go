@@ -0,0 +1,91 @@
+package watch
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/metadata"
+	"k8s.io/client-go/metadata/metadatainformer"
+	"k8s.io/client-go/tools/cache"
+)
+
+// ListMetadata returns the metadata of the resources matching a selector.
+// Only the resources metadata are transferred and cached.
+func (f *Factory) ListMetadata(gvr, ns string, sel labels.Selector) ([]runtime.Object, error) {
+	auth, err := f.Client().CanI(ns, gvr, client.MonitorAccess)
+	if err != nil {
+		return nil, err
+	}
+	if !auth {
+		return nil, fmt.Errorf("%v access denied on resource %q:%q", client.MonitorAccess, ns, gvr)
+	}
+	inf, err := f.ForMetadata(ns, gvr)
+	if err != nil {
+		return nil, err
+	}
+	if !inf.Informer().HasSynced() {
+		stop := make(chan struct{})
+		t := time.AfterFunc(selectorSyncTimeout, func() { close(stop) })
+		cache.WaitForCacheSync(stop, inf.Informer().HasSynced)
+		t.Stop()
+	}
+	if client.IsAllNamespace(ns) || client.IsClusterScoped(ns) {
+		return inf.Lister().List(sel)
+	}
+
+	return inf.Lister().ByNamespace(ns).List(sel)
+}
+
+// ForMetadata returns a metadata informer for a given resource.
+func (f *Factory) ForMetadata(ns, gvr string) (informers.GenericInformer, error) {
+	if client.IsAllNamespace(ns) || client.IsClusterScoped(ns) {
+		ns = client.AllNamespaces
+	}
+	f.mx.Lock()
+	defer f.mx.Unlock()
+	fac, ok := f.metaFactories[ns]
+	if !ok {
+		cfg, err := f.client.RestConfig()
+		if err != nil {
+			return nil, err
+		}
+		dial, err := metadata.NewForConfig(cfg)
+		if err != nil {
+			return nil, err
+		}
+		fac = metadatainformer.NewFilteredSharedInformerFactory(dial, defaultResync, ns, nil)
+		f.metaFactories[ns] = fac
+	}
+	inf := fac.ForResource(toGVR(gvr))
+	if _, ok := f.metaWatches[ns+":"+gvr]; !ok {
+		log.Debug().Msgf("Metadata informer %q:%q", ns, gvr)
+		f.metaWatches[ns+":"+gvr] = struct{}{}
+	}
+	fac.Start(f.stopChan)
+
+	return inf, nil
+}
+
+// IsMetadataOnly checks if a resource is only cached as metadata.
+func (f *Factory) IsMetadataOnly(gvr string) bool {
+	f.mx.RLock()
+	defer f.mx.RUnlock()
+	for k := range f.watches {
+		if strings.HasSuffix(k, ":"+gvr) {
+			return false
+		}
+	}
+	for k := range f.metaWatches {
+		if strings.HasSuffix(k, ":"+gvr) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,135 @@
+package watch
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/rs/zerolog/log"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	di "k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/tools/cache"
+)
+
+const (
+	// maxSelectedInformers caps the number of selector informers kept alive.
+	maxSelectedInformers = 5
+
+	selectorSyncTimeout = 5 * time.Second
+)
+
+// selectedInformer represents an informer restricted to a label and/or field selector.
+type selectedInformer struct {
+	informers.GenericInformer
+
+	stopChan chan struct{}
+	used     time.Time
+}
+
+// ListSelected returns the resources matching label and field selectors.
+// The selectors are resolved by the api server so only matching resources
+// are cached.
+func (f *Factory) ListSelected(gvr, ns, labelSel, fieldSel string) ([]runtime.Object, error) {
+	auth, err := f.Client().CanI(ns, gvr, client.MonitorAccess)
+	if err != nil {
+		return nil, err
+	}
+	if !auth {
+		return nil, fmt.Errorf("%v access denied on resource %q:%q", client.MonitorAccess, ns, gvr)
+	}
+	inf, err := f.ForSelector(ns, gvr, labelSel, fieldSel)
+	if err != nil {
+		return nil, err
+	}
+	if !inf.Informer().HasSynced() {
+		stop := make(chan struct{})
+		t := time.AfterFunc(selectorSyncTimeout, func() { close(stop) })
+		cache.WaitForCacheSync(stop, inf.Informer().HasSynced)
+		t.Stop()
+	}
+
+	return inf.Lister().List(labels.Everything())
+}
+
+// ForSelector returns an informer for resources matching label and field
+// selectors. Blank selectors yield the shared resource informer.
+func (f *Factory) ForSelector(ns, gvr, labelSel, fieldSel string) (informers.GenericInformer, error) {
+	if labelSel == "" && fieldSel == "" {
+		return f.ForResource(ns, gvr)
+	}
+	if _, err := labels.Parse(labelSel); err != nil {
+		return nil, err
+	}
+	if _, err := fields.ParseSelector(fieldSel); err != nil {
+		return nil, err
+	}
+	if client.IsAllNamespace(ns) || client.IsClusterScoped(ns) {
+		ns = client.AllNamespaces
+	}
+
+	key := strings.Join([]string{ns, gvr, labelSel, fieldSel}, "|")
+	f.mx.Lock()
+	defer f.mx.Unlock()
+	if s, ok := f.selected[key]; ok {
+		s.used = time.Now()
+		return s, nil
+	}
+
+	dial, err := f.client.DynDial()
+	if err != nil {
+		return nil, err
+	}
+	inf := di.NewFilteredDynamicInformer(
+		dial,
+		toGVR(gvr),
+		ns,
+		defaultResync,
+		cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc},
+		func(opts *metav1.ListOptions) {
+			opts.LabelSelector, opts.FieldSelector = labelSel, fieldSel
+		},
+	)
+	s := selectedInformer{
+		GenericInformer: inf,
+		stopChan:        make(chan struct{}),
+		used:            time.Now(),
+	}
+	go inf.Informer().Run(s.stopChan)
+	f.selected[key] = &s
+	f.evictSelected()
+	log.Debug().Msgf("Selector informer %q", key)
+
+	return &s, nil
+}
+
+// evictSelected stops the least recently used selector informers.
+func (f *Factory) evictSelected() {
+	if len(f.selected) <= maxSelectedInformers {
+		return
+	}
+	kk := make([]string, 0, len(f.selected))
+	for k := range f.selected {
+		kk = append(kk, k)
+	}
+	sort.Slice(kk, func(i, j int) bool {
+		return f.selected[kk[i]].used.Before(f.selected[kk[j]].used)
+	})
+	for _, k := range kk[:len(kk)-maxSelectedInformers] {
+		close(f.selected[k].stopChan)
+		delete(f.selected, k)
+	}
+}
+
+// stopSelected stops all selector informers.
+func (f *Factory) stopSelected() {
+	for k, s := range f.selected {
+		close(s.stopChan)
+		delete(f.selected, k)
+	}
+}
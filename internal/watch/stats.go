@@ -0,0 +1,71 @@
+package watch
+
+import (
+	"sort"
+	"strings"
+)
+
+// CacheStat tracks the number of resources held by an informer cache.
+type CacheStat struct {
+	Kind, Namespace, GVR string
+	Selector             string
+	Items                int
+}
+
+// CacheStats returns the size of all active informer caches.
+func (f *Factory) CacheStats() []CacheStat {
+	f.mx.RLock()
+	defer f.mx.RUnlock()
+
+	ss := make([]CacheStat, 0, len(f.watches)+len(f.metaWatches)+len(f.selected))
+	for k := range f.watches {
+		ns, gvr := splitWatchKey(k)
+		fac, ok := f.factories[ns]
+		if !ok {
+			continue
+		}
+		inf := fac.ForResource(toGVR(gvr))
+		ss = append(ss, CacheStat{Kind: "informer", Namespace: ns, GVR: gvr, Items: len(inf.Informer().GetStore().ListKeys())})
+	}
+	for k := range f.metaWatches {
+		ns, gvr := splitWatchKey(k)
+		fac, ok := f.metaFactories[ns]
+		if !ok {
+			continue
+		}
+		inf := fac.ForResource(toGVR(gvr))
+		ss = append(ss, CacheStat{Kind: "metadata", Namespace: ns, GVR: gvr, Items: len(inf.Informer().GetStore().ListKeys())})
+	}
+	for k, s := range f.selected {
+		tokens := strings.SplitN(k, "|", 4)
+		if len(tokens) < 4 {
+			continue
+		}
+		ss = append(ss, CacheStat{
+			Kind:      "selector",
+			Namespace: tokens[0],
+			GVR:       tokens[1],
+			Selector:  strings.Trim(tokens[2]+","+tokens[3], ","),
+			Items:     len(s.Informer().GetStore().ListKeys()),
+		})
+	}
+	sort.Slice(ss, func(i, j int) bool {
+		if ss[i].Kind != ss[j].Kind {
+			return ss[i].Kind < ss[j].Kind
+		}
+		if ss[i].GVR != ss[j].GVR {
+			return ss[i].GVR < ss[j].GVR
+		}
+		return ss[i].Namespace < ss[j].Namespace
+	})
+
+	return ss
+}
+
+func splitWatchKey(k string) (string, string) {
+	if i := strings.Index(k, ":"); i >= 0 {
+		return k[:i], k[i+1:]
+	}
+
+	return "", k
+}
@@ -36,20 +36,16 @@ func (d *Deployment) Render(ctx context.Context, ns string, o interface{}) error
 	}
 
 	root := NewTreeNode("apps/v1/deployments", client.FQN(dp.Namespace, dp.Name))
-	oo, err := locatePods(ctx, dp.Namespace, dp.Spec.Selector)
+	rss, err := locateReplicaSets(ctx, dp)
 	if err != nil {
 		return err
 	}
-	ctx = context.WithValue(ctx, KeyParent, root)
-	var re Pod
-	for _, o := range oo {
-		p, ok := o.(*unstructured.Unstructured)
-		if !ok {
-			return fmt.Errorf("expecting *Unstructured but got %T", o)
-		}
-		if err := re.Render(ctx, ns, &render.PodWithMetrics{Raw: p}); err != nil {
+	if len(rss) > 0 {
+		if err := d.replicaSetRefs(ctx, ns, root, rss); err != nil {
 			return err
 		}
+	} else if err := renderPods(context.WithValue(ctx, KeyParent, root), ns, dp.Namespace, dp.Spec.Selector); err != nil {
+		return err
 	}
 
 	if root.IsLeaf() {
@@ -66,6 +62,27 @@ func (d *Deployment) Render(ctx context.Context, ns string, o interface{}) error
 	return d.validate(root, dp)
 }
 
+// replicaSetRefs renders the deployment replicasets along with their pods.
+func (*Deployment) replicaSetRefs(ctx context.Context, ns string, parent *TreeNode, rss []appsv1.ReplicaSet) error {
+	var re ReplicaSet
+	for _, rs := range rss {
+		node := NewTreeNode("apps/v1/replicasets", client.FQN(rs.Namespace, rs.Name))
+		if err := renderPods(context.WithValue(ctx, KeyParent, node), ns, rs.Namespace, rs.Spec.Selector); err != nil {
+			return err
+		}
+		// Skip stale revisions.
+		if node.IsLeaf() {
+			continue
+		}
+		if err := re.validate(node, rs); err != nil {
+			return err
+		}
+		parent.Add(node)
+	}
+
+	return nil
+}
+
 func (*Deployment) validate(root *TreeNode, dp appsv1.Deployment) error {
 	root.Extras[StatusKey] = OkStatus
 	var r int32
@@ -84,6 +101,56 @@ func (*Deployment) validate(root *TreeNode, dp appsv1.Deployment) error {
 // ----------------------------------------------------------------------------
 // Helpers...
 
+func renderPods(ctx context.Context, ns, podNS string, sel *metav1.LabelSelector) error {
+	oo, err := locatePods(ctx, podNS, sel)
+	if err != nil {
+		return err
+	}
+	var re Pod
+	for _, o := range oo {
+		p, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return fmt.Errorf("expecting *Unstructured but got %T", o)
+		}
+		if err := re.Render(ctx, ns, &render.PodWithMetrics{Raw: p}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// locateReplicaSets returns the replicasets controlled by a given deployment.
+func locateReplicaSets(ctx context.Context, dp appsv1.Deployment) ([]appsv1.ReplicaSet, error) {
+	f, ok := ctx.Value(internal.KeyFactory).(dao.Factory)
+	if !ok {
+		return nil, fmt.Errorf("Expecting a factory but got %T", ctx.Value(internal.KeyFactory))
+	}
+	oo, err := f.List("apps/v1/replicasets", dp.Namespace, false, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	rss := make([]appsv1.ReplicaSet, 0, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting *Unstructured but got %T", o)
+		}
+		ref := metav1.GetControllerOf(u)
+		if ref == nil || ref.UID != dp.UID {
+			continue
+		}
+		var rs appsv1.ReplicaSet
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &rs); err != nil {
+			return nil, err
+		}
+		rss = append(rss, rs)
+	}
+
+	return rss, nil
+}
+
 func locatePods(ctx context.Context, ns string, sel *metav1.LabelSelector) ([]runtime.Object, error) {
 	l, err := metav1.LabelSelectorAsSelector(sel)
 	if err != nil {
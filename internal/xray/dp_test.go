@@ -7,6 +7,7 @@ import (
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/xray"
 	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -45,3 +46,29 @@ func TestDeployRender(t *testing.T) {
 		})
 	}
 }
+
+func TestDeployRenderReplicaSets(t *testing.T) {
+	ctrl := true
+	dp, rs, stale := load(t, "dp"), load(t, "rs"), load(t, "rs")
+	rs.SetOwnerReferences([]metav1.OwnerReference{
+		{APIVersion: "apps/v1", Kind: "Deployment", Name: dp.GetName(), UID: dp.GetUID(), Controller: &ctrl},
+	})
+	stale.SetName("stale")
+
+	f := makeFactory()
+	f.rows = map[string][]runtime.Object{
+		"apps/v1/replicasets": {rs, stale},
+		"v1/pods":             {load(t, "po")},
+		"v1/serviceaccounts":  {load(t, "sa")},
+	}
+	root := xray.NewTreeNode("deployments", "deployments")
+	ctx := context.WithValue(context.Background(), xray.KeyParent, root)
+	ctx = context.WithValue(ctx, internal.KeyFactory, f)
+
+	var re xray.Deployment
+	assert.Nil(t, re.Render(ctx, "", dp))
+	dpn := root.Children[0].Children[0]
+	assert.Equal(t, 1, dpn.CountChildren())
+	assert.Equal(t, "apps/v1/replicasets", dpn.Children[0].GVR)
+	assert.Equal(t, xray.OkStatus, dpn.Children[0].Extras[xray.StatusKey])
+}
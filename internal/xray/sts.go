@@ -16,8 +16,13 @@ type StatefulSet struct{}
 
 // Render renders an xray node.
 func (s *StatefulSet) Render(ctx context.Context, ns string, o interface{}) error {
-	raw, ok := o.(*unstructured.Unstructured)
-	if !ok {
+	var raw *unstructured.Unstructured
+	switch v := o.(type) {
+	case *unstructured.Unstructured:
+		raw = v
+	case *render.StatefulSetWithOrdinals:
+		raw = v.Raw
+	default:
 		return fmt.Errorf("Expected Unstructured, but got %T", o)
 	}
 	var sts appsv1.StatefulSet